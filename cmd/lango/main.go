@@ -18,17 +18,24 @@ import (
 	"github.com/langoai/lango/internal/background"
 	"github.com/langoai/lango/internal/bootstrap"
 	cliagent "github.com/langoai/lango/internal/cli/agent"
+	cliapproval "github.com/langoai/lango/internal/cli/approval"
+	clibackup "github.com/langoai/lango/internal/cli/backup"
 	clibg "github.com/langoai/lango/internal/cli/bg"
 	clicron "github.com/langoai/lango/internal/cli/cron"
 	"github.com/langoai/lango/internal/cli/doctor"
+	clifs "github.com/langoai/lango/internal/cli/fs"
 	cligraph "github.com/langoai/lango/internal/cli/graph"
+	cliknowledge "github.com/langoai/lango/internal/cli/knowledge"
+	clilearning "github.com/langoai/lango/internal/cli/learning"
 	climemory "github.com/langoai/lango/internal/cli/memory"
 	"github.com/langoai/lango/internal/cli/onboard"
 	clip2p "github.com/langoai/lango/internal/cli/p2p"
 	"github.com/langoai/lango/internal/cli/tui"
 	clipayment "github.com/langoai/lango/internal/cli/payment"
+	clipolicy "github.com/langoai/lango/internal/cli/policy"
 	clisecurity "github.com/langoai/lango/internal/cli/security"
 	"github.com/langoai/lango/internal/cli/settings"
+	cliusage "github.com/langoai/lango/internal/cli/usage"
 	cliworkflow "github.com/langoai/lango/internal/cli/workflow"
 	"github.com/langoai/lango/internal/config"
 	"github.com/langoai/lango/internal/configstore"
@@ -42,6 +49,13 @@ var (
 )
 
 func main() {
+	// Check if running as the init process of a Linux sandbox. It sets up
+	// the sandbox and runs the confined command in place of lango.
+	if sandbox.IsInitMode() {
+		sandbox.RunInit()
+		return
+	}
+
 	// Check if running as sandbox worker subprocess.
 	// Worker mode is used for process-isolated tool execution in P2P.
 	if sandbox.IsWorkerMode() {
@@ -101,6 +115,28 @@ func main() {
 	memoryCmd.GroupID = "data"
 	rootCmd.AddCommand(memoryCmd)
 
+	knowledgeCmd := cliknowledge.NewKnowledgeCmd(func() (*config.Config, error) {
+		boot, err := bootstrap.Run(bootstrap.Options{})
+		if err != nil {
+			return nil, err
+		}
+		defer boot.DBClient.Close()
+		return boot.Config, nil
+	})
+	knowledgeCmd.GroupID = "data"
+	rootCmd.AddCommand(knowledgeCmd)
+
+	learningCmd := clilearning.NewLearningCmd(func() (*config.Config, error) {
+		boot, err := bootstrap.Run(bootstrap.Options{})
+		if err != nil {
+			return nil, err
+		}
+		defer boot.DBClient.Close()
+		return boot.Config, nil
+	})
+	learningCmd.GroupID = "data"
+	rootCmd.AddCommand(learningCmd)
+
 	agentCmd := cliagent.NewAgentCmd(func() (*config.Config, error) {
 		boot, err := bootstrap.Run(bootstrap.Options{})
 		if err != nil {
@@ -147,6 +183,36 @@ func main() {
 	workflowCmd.GroupID = "infra"
 	rootCmd.AddCommand(workflowCmd)
 
+	usageCmd := cliusage.NewUsageCmd(func() (*bootstrap.Result, error) {
+		return bootstrap.Run(bootstrap.Options{})
+	})
+	usageCmd.GroupID = "data"
+	rootCmd.AddCommand(usageCmd)
+
+	policyCmd := clipolicy.NewPolicyCmd(func() (*bootstrap.Result, error) {
+		return bootstrap.Run(bootstrap.Options{})
+	})
+	policyCmd.GroupID = "infra"
+	rootCmd.AddCommand(policyCmd)
+
+	approvalCmd := cliapproval.NewApprovalCmd(func() (*bootstrap.Result, error) {
+		return bootstrap.Run(bootstrap.Options{})
+	})
+	approvalCmd.GroupID = "infra"
+	rootCmd.AddCommand(approvalCmd)
+
+	fsCmd := clifs.NewFsCmd(func() (*bootstrap.Result, error) {
+		return bootstrap.Run(bootstrap.Options{})
+	})
+	fsCmd.GroupID = "infra"
+	rootCmd.AddCommand(fsCmd)
+
+	backupCmd := clibackup.NewBackupCmd(func() (*bootstrap.Result, error) {
+		return bootstrap.Run(bootstrap.Options{})
+	}, Version)
+	backupCmd.GroupID = "infra"
+	rootCmd.AddCommand(backupCmd)
+
 	bgCmd := clibg.NewBgCmd(func() (*background.Manager, error) {
 		return nil, fmt.Errorf("bg commands require a running server (use 'lango serve' first)")
 	})
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/genai"
 
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/provider"
 	internal "github.com/langoai/lango/internal/session"
 )
 
@@ -29,6 +30,12 @@ type ErrorFixProvider interface {
 	GetFixForError(ctx context.Context, toolName string, err error) (string, bool)
 }
 
+// FixOutcomeRecorder is optionally implemented by an ErrorFixProvider to
+// learn whether an applied fix resolved the error.
+type FixOutcomeRecorder interface {
+	RecordFixOutcome(ctx context.Context, success bool)
+}
+
 // defaultMaxTurns is the default maximum number of tool-calling iterations per agent run.
 const defaultMaxTurns = 25
 
@@ -164,17 +171,20 @@ func (a *Agent) ADKAgent() adk_agent.Agent {
 }
 
 // Run executes the agent for a given session and returns an event iterator.
+// Media such as images from a channel message is sent along with the input.
 // It enforces a maximum turn limit to prevent unbounded tool-calling loops.
-func (a *Agent) Run(ctx context.Context, sessionID string, input string) iter.Seq2[*session.Event, error] {
-	// Create user content
-	userMsg := &genai.Content{
-		Role:  "user",
-		Parts: []*genai.Part{{Text: input}},
-	}
+func (a *Agent) Run(ctx context.Context, sessionID string, input string, media ...provider.ContentPart) iter.Seq2[*session.Event, error] {
+	return a.run(ctx, sessionID, input, false, media)
+}
 
-	// Config for run
-	runCfg := adk_agent.RunConfig{
-		// Defaults
+// run executes the agent. With stream set, the model streams its response
+// and text arrives as partial events before the final one.
+func (a *Agent) run(ctx context.Context, sessionID, input string, stream bool, media []provider.ContentPart) iter.Seq2[*session.Event, error] {
+	userMsg := userContent(input, media)
+
+	runCfg := adk_agent.RunConfig{}
+	if stream {
+		runCfg.StreamingMode = adk_agent.StreamingModeSSE
 	}
 
 	maxTurns := a.maxTurns
@@ -211,6 +221,20 @@ func (a *Agent) Run(ctx context.Context, sessionID string, input string) iter.Se
 	}
 }
 
+// userContent builds the user message for a run from text and media.
+func userContent(input string, media []provider.ContentPart) *genai.Content {
+	var parts []*genai.Part
+	if input != "" || len(media) == 0 {
+		parts = append(parts, &genai.Part{Text: input})
+	}
+	for _, m := range media {
+		parts = append(parts, &genai.Part{
+			InlineData: &genai.Blob{MIMEType: m.MimeType, DisplayName: m.Name, Data: m.Data},
+		})
+	}
+	return &genai.Content{Role: "user", Parts: parts}
+}
+
 // hasFunctionCalls reports whether the event contains any FunctionCall parts.
 func hasFunctionCalls(e *session.Event) bool {
 	if e.Content == nil {
@@ -227,9 +251,16 @@ func hasFunctionCalls(e *session.Event) bool {
 // RunAndCollect executes the agent and returns the full text response.
 // If the agent encounters a "failed to find agent" error (hallucinated agent
 // name), it sends a correction message and retries once.
-func (a *Agent) RunAndCollect(ctx context.Context, sessionID, input string) (string, error) {
+func (a *Agent) RunAndCollect(ctx context.Context, sessionID, input string, media ...provider.ContentPart) (string, error) {
+	return a.RunAndCollectStreaming(ctx, sessionID, input, StreamHandlers{}, media...)
+}
+
+// RunAndCollectStreaming is RunAndCollect with progress reported to h while
+// the agent runs. Text streamed before a retry is superseded by the returned
+// response.
+func (a *Agent) RunAndCollectStreaming(ctx context.Context, sessionID, input string, h StreamHandlers, media ...provider.ContentPart) (string, error) {
 	start := time.Now()
-	resp, err := a.runAndCollectOnce(ctx, sessionID, input)
+	resp, err := a.runAndCollectOnce(ctx, sessionID, input, h, media...)
 	if err == nil {
 		logger().Debugw("agent run completed",
 			"session", sessionID,
@@ -242,6 +273,9 @@ func (a *Agent) RunAndCollect(ctx context.Context, sessionID, input string) (str
 	if badAgent == "" || len(a.adkAgent.SubAgents()) == 0 {
 		// Try learning-based error correction before giving up.
 		if a.errorFixProvider != nil {
+			if internal.SessionKeyFromContext(ctx) == "" {
+				ctx = internal.WithSessionKey(ctx, sessionID)
+			}
 			if fix, ok := a.errorFixProvider.GetFixForError(ctx, "", err); ok {
 				correction := fmt.Sprintf(
 					"[System: Previous action failed with: %s. Suggested fix: %s. Please retry.]",
@@ -250,7 +284,10 @@ func (a *Agent) RunAndCollect(ctx context.Context, sessionID, input string) (str
 					"session", sessionID,
 					"fix", fix,
 					"elapsed", time.Since(start).String())
-				retryResp, retryErr := a.runAndCollectOnce(ctx, sessionID, correction)
+				retryResp, retryErr := a.runAndCollectOnce(ctx, sessionID, correction, h)
+				if rec, ok := a.errorFixProvider.(FixOutcomeRecorder); ok {
+					rec.RecordFixOutcome(ctx, retryErr == nil)
+				}
 				if retryErr == nil {
 					return retryResp, nil
 				}
@@ -279,7 +316,7 @@ func (a *Agent) RunAndCollect(ctx context.Context, sessionID, input string) (str
 		"elapsed", time.Since(start).String())
 
 	retryStart := time.Now()
-	resp, err = a.runAndCollectOnce(ctx, sessionID, correction)
+	resp, err = a.runAndCollectOnce(ctx, sessionID, correction, h)
 	if err != nil {
 		logger().Errorw("agent hallucination retry failed",
 			"session", sessionID,
@@ -301,11 +338,11 @@ func (a *Agent) RunAndCollect(ctx context.Context, sessionID, input string) (str
 // It tracks whether partial (streaming) events were seen to avoid
 // double-counting text that appears in both partial chunks and the
 // final non-partial response.
-func (a *Agent) runAndCollectOnce(ctx context.Context, sessionID, input string) (string, error) {
+func (a *Agent) runAndCollectOnce(ctx context.Context, sessionID, input string, h StreamHandlers, media ...provider.ContentPart) (string, error) {
 	var b strings.Builder
 	var sawPartial bool
 
-	for event, err := range a.Run(ctx, sessionID, input) {
+	for event, err := range a.run(ctx, sessionID, input, h.OnChunk != nil, media) {
 		if err != nil {
 			return "", fmt.Errorf("agent error: %w", err)
 		}
@@ -334,19 +371,25 @@ func (a *Agent) runAndCollectOnce(ctx context.Context, sessionID, input string)
 			for _, part := range event.Content.Parts {
 				if part.Text != "" {
 					b.WriteString(part.Text)
+					if h.OnChunk != nil {
+						h.OnChunk(part.Text)
+					}
 				}
 			}
-		} else if !sawPartial {
-			// Non-streaming mode: no partial events were seen,
-			// so collect from the final complete response.
-			for _, part := range event.Content.Parts {
-				if part.Text != "" {
-					b.WriteString(part.Text)
-				}
+			continue
+		}
+
+		for _, part := range event.Content.Parts {
+			if part.FunctionCall != nil && h.OnToolCall != nil {
+				h.OnToolCall(part.FunctionCall.Name)
+			}
+			// Non-streaming mode: no partial events were seen, so collect
+			// from the final complete response. In streaming mode the
+			// final text duplicates partial chunks, so skip it.
+			if part.Text != "" && !sawPartial {
+				b.WriteString(part.Text)
 			}
 		}
-		// If sawPartial && !event.Partial: this is the final done event
-		// in streaming mode. Its text duplicates partial chunks, so skip.
 	}
 
 	return b.String(), nil
@@ -378,42 +421,20 @@ func subAgentNames(a adk_agent.Agent) []string {
 // ChunkCallback is called for each streaming text chunk during agent execution.
 type ChunkCallback func(chunk string)
 
-// RunStreaming executes the agent and streams partial text chunks via the callback.
-// It returns the full accumulated response text for backward compatibility.
-func (a *Agent) RunStreaming(ctx context.Context, sessionID, input string, onChunk ChunkCallback) (string, error) {
-	var b strings.Builder
-	var sawPartial bool
+// ToolCallCallback is called with the tool name when the agent calls a tool.
+type ToolCallCallback func(name string)
 
-	for event, err := range a.Run(ctx, sessionID, input) {
-		if err != nil {
-			return "", fmt.Errorf("agent error: %w", err)
-		}
-
-		if event.Content == nil {
-			continue
-		}
-
-		if event.Partial {
-			sawPartial = true
-			for _, part := range event.Content.Parts {
-				if part.Text != "" {
-					b.WriteString(part.Text)
-					if onChunk != nil {
-						onChunk(part.Text)
-					}
-				}
-			}
-		} else if !sawPartial {
-			// Non-streaming mode: collect from final response.
-			for _, part := range event.Content.Parts {
-				if part.Text != "" {
-					b.WriteString(part.Text)
-				}
-			}
-		}
-	}
+// StreamHandlers receives progress while the agent runs. Either callback may
+// be nil. Setting OnChunk makes the model stream its response.
+type StreamHandlers struct {
+	OnChunk    ChunkCallback
+	OnToolCall ToolCallCallback
+}
 
-	return b.String(), nil
+// RunStreaming executes the agent and streams partial text chunks via the callback.
+// It returns the full accumulated response text for backward compatibility.
+func (a *Agent) RunStreaming(ctx context.Context, sessionID, input string, onChunk ChunkCallback, media ...provider.ContentPart) (string, error) {
+	return a.runAndCollectOnce(ctx, sessionID, input, StreamHandlers{OnChunk: onChunk}, media...)
 }
 
 // hasText reports whether the event contains any non-empty text part.
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/langoai/lango/internal/provider"
 )
 
 func TestExtractMissingAgent(t *testing.T) {
@@ -42,3 +44,20 @@ func TestExtractMissingAgent(t *testing.T) {
 		})
 	}
 }
+
+func TestUserContent(t *testing.T) {
+	media := []provider.ContentPart{{Type: provider.ContentPartImage, MimeType: "image/png", Name: "a.png", Data: []byte("png")}}
+
+	c := userContent("hello", nil)
+	assert.Equal(t, "user", c.Role)
+	assert.Len(t, c.Parts, 1)
+	assert.Equal(t, "hello", c.Parts[0].Text)
+
+	c = userContent("", media)
+	assert.Len(t, c.Parts, 1, "empty text is dropped when media is present")
+	assert.Equal(t, "image/png", c.Parts[0].InlineData.MIMEType)
+	assert.Equal(t, "a.png", c.Parts[0].InlineData.DisplayName)
+
+	c = userContent("look", media)
+	assert.Len(t, c.Parts, 2)
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/adk/model"
 	"google.golang.org/genai"
 
+	"github.com/langoai/lango/internal/agent"
 	"github.com/langoai/lango/internal/embedding"
 	"github.com/langoai/lango/internal/graph"
 	"github.com/langoai/lango/internal/knowledge"
@@ -19,12 +20,14 @@ import (
 	"github.com/langoai/lango/internal/session"
 )
 
-// MemoryProvider retrieves observations and reflections for a session.
+// MemoryProvider retrieves observations and reflections for a session,
+// plus user-pinned observations that apply to every session.
 type MemoryProvider interface {
 	ListObservations(ctx context.Context, sessionKey string) ([]memory.Observation, error)
 	ListReflections(ctx context.Context, sessionKey string) ([]memory.Reflection, error)
 	ListRecentReflections(ctx context.Context, sessionKey string, limit int) ([]memory.Reflection, error)
 	ListRecentObservations(ctx context.Context, sessionKey string, limit int) ([]memory.Observation, error)
+	ListPinnedObservations(ctx context.Context) ([]memory.Observation, error)
 }
 
 // ContextAwareModelAdapter wraps a ModelAdapter with context retrieval.
@@ -42,6 +45,7 @@ type ContextAwareModelAdapter struct {
 	maxReflections     int
 	maxObservations    int
 	memoryTokenBudget  int // max tokens for the memory section; 0 = default (4000)
+	injectionGuard     *agent.InjectionGuard
 	logger             *zap.SugaredLogger
 }
 
@@ -107,6 +111,14 @@ func (m *ContextAwareModelAdapter) WithMemoryTokenBudget(budget int) *ContextAwa
 	return m
 }
 
+// WithInjectionGuard screens RAG and Graph RAG chunks for prompt-injection
+// payloads before the sections are assembled. Flagged chunks are quarantined
+// or dropped according to the guard's policy.
+func (m *ContextAwareModelAdapter) WithInjectionGuard(guard *agent.InjectionGuard) *ContextAwareModelAdapter {
+	m.injectionGuard = guard
+	return m
+}
+
 // Name delegates to the inner adapter.
 func (m *ContextAwareModelAdapter) Name() string {
 	return m.inner.Name()
@@ -169,8 +181,8 @@ func (m *ContextAwareModelAdapter) GenerateContent(ctx context.Context, req *mod
 		}
 	}
 
-	// Memory retrieval
-	if m.memoryProvider != nil && sessionKey != "" {
+	// Memory retrieval (pinned entries are injected even without a session)
+	if m.memoryProvider != nil {
 		g.Go(func() error {
 			memorySection = m.assembleMemorySection(gCtx, sessionKey)
 			return nil
@@ -207,32 +219,40 @@ func (m *ContextAwareModelAdapter) GenerateContent(ctx context.Context, req *mod
 const defaultMemoryTokenBudget = 4000
 
 // assembleMemorySection builds the "Conversation Memory" section from observations and reflections.
-// It enforces a token budget: reflections are included first (higher information density),
+// Pinned observations are always included and do not count against the token budget.
+// The remaining budget is enforced: reflections are included first (higher information density),
 // then observations fill the remaining budget.
 func (m *ContextAwareModelAdapter) assembleMemorySection(ctx context.Context, sessionKey string) string {
 	var reflections []memory.Reflection
 	var observations []memory.Observation
 	var err error
 
-	if m.maxReflections > 0 {
-		reflections, err = m.memoryProvider.ListRecentReflections(ctx, sessionKey, m.maxReflections)
-	} else {
-		reflections, err = m.memoryProvider.ListReflections(ctx, sessionKey)
-	}
+	pinned, err := m.memoryProvider.ListPinnedObservations(ctx)
 	if err != nil {
-		m.logger.Warnw("memory reflection retrieval error", "error", err)
+		m.logger.Warnw("memory pinned retrieval error", "error", err)
 	}
 
-	if m.maxObservations > 0 {
-		observations, err = m.memoryProvider.ListRecentObservations(ctx, sessionKey, m.maxObservations)
-	} else {
-		observations, err = m.memoryProvider.ListObservations(ctx, sessionKey)
-	}
-	if err != nil {
-		m.logger.Warnw("memory observation retrieval error", "error", err)
+	if sessionKey != "" {
+		if m.maxReflections > 0 {
+			reflections, err = m.memoryProvider.ListRecentReflections(ctx, sessionKey, m.maxReflections)
+		} else {
+			reflections, err = m.memoryProvider.ListReflections(ctx, sessionKey)
+		}
+		if err != nil {
+			m.logger.Warnw("memory reflection retrieval error", "error", err)
+		}
+
+		if m.maxObservations > 0 {
+			observations, err = m.memoryProvider.ListRecentObservations(ctx, sessionKey, m.maxObservations)
+		} else {
+			observations, err = m.memoryProvider.ListObservations(ctx, sessionKey)
+		}
+		if err != nil {
+			m.logger.Warnw("memory observation retrieval error", "error", err)
+		}
 	}
 
-	if len(reflections) == 0 && len(observations) == 0 {
+	if len(pinned) == 0 && len(reflections) == 0 && len(observations) == 0 {
 		return ""
 	}
 
@@ -246,6 +266,18 @@ func (m *ContextAwareModelAdapter) assembleMemorySection(ctx context.Context, se
 
 	b.WriteString("## Conversation Memory\n")
 
+	// Pinned facts first — explicitly curated by the user, never truncated.
+	pinnedIDs := make(map[string]struct{}, len(pinned))
+	if len(pinned) > 0 {
+		b.WriteString("\n### Pinned Facts\n")
+		for _, obs := range pinned {
+			pinnedIDs[obs.ID.String()] = struct{}{}
+			b.WriteString("- ")
+			b.WriteString(obs.Content)
+			b.WriteString("\n")
+		}
+	}
+
 	// Reflections first — higher information density from compressed summaries.
 	if len(reflections) > 0 {
 		b.WriteString("\n### Summary\n")
@@ -264,6 +296,9 @@ func (m *ContextAwareModelAdapter) assembleMemorySection(ctx context.Context, se
 	if len(observations) > 0 && currentTokens < budget {
 		b.WriteString("\n### Recent Observations\n")
 		for _, obs := range observations {
+			if _, ok := pinnedIDs[obs.ID.String()]; ok {
+				continue
+			}
 			t := memory.EstimateTokens(obs.Content)
 			if currentTokens+t > budget {
 				break
@@ -294,6 +329,18 @@ func (m *ContextAwareModelAdapter) assembleGraphRAGSection(ctx context.Context,
 		m.logger.Warnw("graph rag retrieval error", "error", err)
 		return ""
 	}
+	if m.injectionGuard != nil {
+		screened := result.VectorResults[:0]
+		for _, r := range result.VectorResults {
+			content, ok := m.screenChunk(ctx, r.Collection, r.SourceID, r.Content)
+			if !ok {
+				continue
+			}
+			r.Content = content
+			screened = append(screened, r)
+		}
+		result.VectorResults = screened
+	}
 	return m.graphRAG.AssembleSection(result)
 }
 
@@ -318,13 +365,31 @@ func (m *ContextAwareModelAdapter) assembleRAGSection(ctx context.Context, query
 		if r.Content == "" {
 			continue
 		}
+		content, ok := m.screenChunk(ctx, r.Collection, r.SourceID, r.Content)
+		if !ok {
+			continue
+		}
 		fmt.Fprintf(&b, "\n### [%s] %s\n", r.Collection, r.SourceID)
-		b.WriteString(r.Content)
+		b.WriteString(content)
 		b.WriteString("\n")
 	}
 	return b.String()
 }
 
+// screenChunk passes a retrieved chunk through the injection guard. It
+// reports false for chunks the guard blocks.
+func (m *ContextAwareModelAdapter) screenChunk(ctx context.Context, collection, sourceID, content string) (string, bool) {
+	if m.injectionGuard == nil {
+		return content, true
+	}
+	screened, err := m.injectionGuard.Screen(ctx, "rag:"+collection+"/"+sourceID, content)
+	if err != nil {
+		m.logger.Warnw("retrieved chunk dropped", "collection", collection, "source", sourceID, "error", err)
+		return "", false
+	}
+	return screened, true
+}
+
 // extractLastUserMessage finds the last user message from the content history.
 func extractLastUserMessage(contents []*genai.Content) string {
 	for i := len(contents) - 1; i >= 0; i-- {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/langoai/lango/internal/agent"
 	"github.com/langoai/lango/internal/knowledge"
+	"github.com/langoai/lango/internal/types"
 )
 
 // ToolRegistryAdapter adapts []*agent.Tool to knowledge.ToolRegistryProvider.
@@ -98,7 +99,8 @@ func (a *RuntimeContextAdapter) GetRuntimeContext() knowledge.RuntimeContext {
 }
 
 // deriveChannelType extracts the channel type from a session key.
-// Session keys follow the pattern "channel:id:subid" (e.g., "telegram:123:456").
+// Session keys start with the channel name followed by per-channel parts
+// (e.g., "telegram:123:456" or "slack:C1:thread:1700.1").
 // Returns "direct" if the key has no recognized prefix.
 func deriveChannelType(sessionKey string) string {
 	if sessionKey == "" {
@@ -108,10 +110,8 @@ func deriveChannelType(sessionKey string) string {
 	if !found {
 		return "direct"
 	}
-	switch prefix {
-	case "telegram", "discord", "slack":
+	if types.ChannelType(prefix).Valid() {
 		return prefix
-	default:
-		return "direct"
 	}
+	return "direct"
 }
@@ -122,6 +122,11 @@ func TestDeriveChannelType(t *testing.T) {
 		{give: "telegram:123:456", want: "telegram"},
 		{give: "discord:guild:channel", want: "discord"},
 		{give: "slack:team:channel", want: "slack"},
+		{give: "slack:C1:thread:1700.1", want: "slack"},
+		{give: "discord:C1", want: "discord"},
+		{give: "matrix:!room:example.org:@bob:example.org", want: "matrix"},
+		{give: "email:thread-1:alice@example.com", want: "email"},
+		{give: "webhook:ci:endpoint", want: "webhook"},
 		{give: "unknown:123:456", want: "direct"},
 		{give: "http:something", want: "direct"},
 	}
@@ -20,6 +20,46 @@ func NewModelAdapter(p provider.Provider, model string) *ModelAdapter {
 	return &ModelAdapter{p: p, model: model}
 }
 
+// modelOverrideCtxKey is the context key type for per-request model overrides.
+type modelOverrideCtxKey struct{}
+
+// WithModelOverride makes model requests made with ctx use the given model
+// of the configured provider instead of its default, e.g. for a session that
+// switched models.
+func WithModelOverride(ctx context.Context, model string) context.Context {
+	return context.WithValue(ctx, modelOverrideCtxKey{}, model)
+}
+
+// modelOverrideFromContext returns the model set by WithModelOverride, if any.
+func modelOverrideFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(modelOverrideCtxKey{}).(string); ok {
+		return v
+	}
+	return ""
+}
+
+// UsageRecorder receives the token usage of each model call, e.g. to
+// enforce quotas. model is the model the call was made with.
+type UsageRecorder func(model string, usage provider.Usage)
+
+// usageRecorderCtxKey is the context key type for usage recorders.
+type usageRecorderCtxKey struct{}
+
+// WithUsageRecorder makes model calls made with ctx report their token usage
+// to rec. Calls whose provider reports no usage are not recorded.
+func WithUsageRecorder(ctx context.Context, rec UsageRecorder) context.Context {
+	return context.WithValue(ctx, usageRecorderCtxKey{}, rec)
+}
+
+// recordUsage reports usage to the recorder of ctx, if any.
+func recordUsage(ctx context.Context, model string, usage *provider.Usage) {
+	rec, ok := ctx.Value(usageRecorderCtxKey{}).(UsageRecorder)
+	if !ok || rec == nil || usage == nil {
+		return
+	}
+	rec(model, *usage)
+}
+
 func (m *ModelAdapter) Name() string {
 	return m.model
 }
@@ -52,6 +92,9 @@ func (m *ModelAdapter) GenerateContent(ctx context.Context, req *model.LLMReques
 			Messages: msgs,
 			Tools:    tools,
 		}
+		if override := modelOverrideFromContext(ctx); override != "" {
+			params.Model = override
+		}
 
 		if req.Config != nil {
 			if req.Config.Temperature != nil {
@@ -62,6 +105,10 @@ func (m *ModelAdapter) GenerateContent(ctx context.Context, req *model.LLMReques
 			}
 		}
 		// params.Model may be empty here; the provider will use its default.
+		usageModel := params.Model
+		if usageModel == "" {
+			usageModel = m.model
+		}
 
 		pSeq, err := m.p.Generate(ctx, params)
 		if err != nil {
@@ -97,28 +144,23 @@ func (m *ModelAdapter) GenerateContent(ctx context.Context, req *model.LLMReques
 					}
 
 				case provider.StreamEventToolCall:
+					// Tool calls are only sent with the final event: the
+					// flow executes the calls of every non-partial
+					// response, so yielding them here too would run each
+					// tool twice.
 					if evt.ToolCall != nil {
 						args := make(map[string]any)
 						_ = json.Unmarshal([]byte(evt.ToolCall.Arguments), &args)
-						part := &genai.Part{
+						toolParts = append(toolParts, &genai.Part{
 							FunctionCall: &genai.FunctionCall{
 								Name: evt.ToolCall.Name,
 								Args: args,
 							},
-						}
-						toolParts = append(toolParts, part)
-						resp := &model.LLMResponse{
-							Content: &genai.Content{
-								Role:  "model",
-								Parts: []*genai.Part{part},
-							},
-						}
-						if !yield(resp, nil) {
-							return
-						}
+						})
 					}
 
 				case provider.StreamEventDone:
+					recordUsage(ctx, usageModel, evt.Usage)
 					// Final event: include accumulated full text so ADK
 					// stores a complete assistant message in the session.
 					var finalParts []*genai.Part
@@ -170,7 +212,8 @@ func (m *ModelAdapter) GenerateContent(ctx context.Context, req *model.LLMReques
 						})
 					}
 				case provider.StreamEventDone:
-					// Ignored — we build the final response below.
+					// The final response is built below.
+					recordUsage(ctx, usageModel, evt.Usage)
 				case provider.StreamEventError:
 					yield(nil, evt.Error)
 					return
@@ -208,6 +251,14 @@ func convertMessages(contents []*genai.Content) ([]provider.Message, error) {
 			if p.Text != "" {
 				msg.Content += p.Text
 			}
+			if p.InlineData != nil {
+				msg.Parts = append(msg.Parts, provider.ContentPart{
+					Type:     provider.PartTypeForMIME(p.InlineData.MIMEType),
+					MimeType: p.InlineData.MIMEType,
+					Name:     p.InlineData.DisplayName,
+					Data:     p.InlineData.Data,
+				})
+			}
 			if p.FunctionCall != nil {
 				b, _ := json.Marshal(p.FunctionCall.Args)
 				id := p.FunctionCall.ID
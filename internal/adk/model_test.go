@@ -167,6 +167,47 @@ func TestModelAdapter_GenerateContent_ToolCall(t *testing.T) {
 	}
 }
 
+func TestModelAdapter_GenerateContent_StreamToolCallOnce(t *testing.T) {
+	p := &mockProvider{
+		id: "test",
+		events: []provider.StreamEvent{
+			{Type: provider.StreamEventPlainText, Text: "Checking"},
+			{
+				Type:     provider.StreamEventToolCall,
+				ToolCall: &provider.ToolCall{ID: "call_1", Name: "exec", Arguments: `{"command":"ls"}`},
+			},
+			{Type: provider.StreamEventDone},
+		},
+	}
+	adapter := NewModelAdapter(p, "test-model")
+
+	seq := adapter.GenerateContent(context.Background(), &model.LLMRequest{Model: "test-model"}, true)
+
+	var calls, finals int
+	for resp, err := range seq {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !resp.Partial {
+			finals++
+		}
+		for _, part := range resp.Content.Parts {
+			if part.FunctionCall != nil {
+				calls++
+			}
+		}
+	}
+
+	// The flow runs the calls of every non-partial response, so the call
+	// must appear exactly once, in the final response.
+	if calls != 1 {
+		t.Errorf("expected the tool call once, got %d", calls)
+	}
+	if finals != 1 {
+		t.Errorf("expected 1 non-partial response, got %d", finals)
+	}
+}
+
 func TestModelAdapter_GenerateContent_StreamError(t *testing.T) {
 	p := &mockProvider{
 		id: "test",
@@ -273,3 +314,71 @@ func TestModelAdapter_GenerateContent_NoSystemInstruction(t *testing.T) {
 		t.Errorf("expected role 'user', got %q", msgs[0].Role)
 	}
 }
+
+func TestModelAdapter_GenerateContent_ModelOverride(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "", want: "test-model"},
+		{give: "other-model", want: "other-model"},
+	}
+
+	for _, tt := range tests {
+		p := &mockProvider{
+			id:     "test",
+			events: []provider.StreamEvent{{Type: provider.StreamEventDone}},
+		}
+		adapter := NewModelAdapter(p, "test-model")
+
+		ctx := context.Background()
+		if tt.give != "" {
+			ctx = WithModelOverride(ctx, tt.give)
+		}
+		for _, err := range adapter.GenerateContent(ctx, &model.LLMRequest{Model: "test-model"}, false) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		if p.lastParams.Model != tt.want {
+			t.Errorf("override %q: expected model %q, got %q", tt.give, tt.want, p.lastParams.Model)
+		}
+	}
+}
+
+func TestModelAdapter_GenerateContent_UsageRecorder(t *testing.T) {
+	for _, stream := range []bool{false, true} {
+		p := &mockProvider{
+			id: "test",
+			events: []provider.StreamEvent{
+				{Type: provider.StreamEventPlainText, Text: "hi"},
+				{Type: provider.StreamEventDone, Usage: &provider.Usage{InputTokens: 12, OutputTokens: 3}},
+			},
+		}
+		adapter := NewModelAdapter(p, "test-model")
+
+		var gotModel string
+		var gotUsage provider.Usage
+		calls := 0
+		ctx := WithUsageRecorder(context.Background(), func(model string, usage provider.Usage) {
+			calls++
+			gotModel, gotUsage = model, usage
+		})
+		for _, err := range adapter.GenerateContent(ctx, &model.LLMRequest{}, stream) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		if calls != 1 {
+			t.Fatalf("stream=%v: expected 1 recorded call, got %d", stream, calls)
+		}
+		if gotModel != "test-model" {
+			t.Errorf("stream=%v: expected model %q, got %q", stream, "test-model", gotModel)
+		}
+		if gotUsage.Total() != 15 {
+			t.Errorf("stream=%v: expected 15 tokens, got %d", stream, gotUsage.Total())
+		}
+	}
+}
@@ -131,6 +131,13 @@ func (s *SessionServiceAdapter) AppendEvent(ctx context.Context, sess session.Se
 			if p.Text != "" {
 				msg.Content += p.Text
 			}
+			if p.InlineData != nil {
+				msg.Attachments = append(msg.Attachments, internal.Attachment{
+					MimeType: p.InlineData.MIMEType,
+					Name:     p.InlineData.DisplayName,
+					Data:     p.InlineData.Data,
+				})
+			}
 			if p.FunctionCall != nil {
 				argsBytes, _ := json.Marshal(p.FunctionCall.Args)
 				id := p.FunctionCall.ID
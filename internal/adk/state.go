@@ -340,6 +340,11 @@ func (e *EventsAdapter) All() iter.Seq[*session.Event] {
 				if msg.Content != "" {
 					parts = append(parts, &genai.Part{Text: msg.Content})
 				}
+				for _, a := range msg.Attachments {
+					parts = append(parts, &genai.Part{
+						InlineData: &genai.Blob{MIMEType: a.MimeType, DisplayName: a.Name, Data: a.Data},
+					})
+				}
 			}
 
 			if len(parts) == 0 {
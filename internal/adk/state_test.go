@@ -1106,6 +1106,66 @@ func TestConvertMessages_FunctionResponse(t *testing.T) {
 	}
 }
 
+func TestConvertMessages_InlineData(t *testing.T) {
+	msgs, err := convertMessages([]*genai.Content{{
+		Role: "user",
+		Parts: []*genai.Part{
+			{Text: "what is this?"},
+			{InlineData: &genai.Blob{MIMEType: "image/png", DisplayName: "a.png", Data: []byte("png")}},
+			{InlineData: &genai.Blob{MIMEType: "application/pdf", Data: []byte("pdf")}},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("convertMessages failed: %v", err)
+	}
+	if msgs[0].Content != "what is this?" {
+		t.Errorf("unexpected content %q", msgs[0].Content)
+	}
+	if len(msgs[0].Parts) != 2 {
+		t.Fatalf("expected 2 parts, got %d", len(msgs[0].Parts))
+	}
+	if p := msgs[0].Parts[0]; p.Type != "image" || p.Name != "a.png" || string(p.Data) != "png" {
+		t.Errorf("unexpected image part %+v", p)
+	}
+	if p := msgs[0].Parts[1]; p.Type != "file" || p.MimeType != "application/pdf" {
+		t.Errorf("unexpected file part %+v", p)
+	}
+}
+
+func TestSessionServiceAdapter_AppendEvent_InlineData(t *testing.T) {
+	store := newMockStore()
+	sess := &internal.Session{Key: "sess-1"}
+	store.Create(sess)
+
+	service := NewSessionServiceAdapter(store, "lango-agent")
+	adapter := NewSessionAdapter(sess, store, "lango-agent")
+
+	evt := &session.Event{Author: "user", Timestamp: time.Now()}
+	evt.Content = &genai.Content{
+		Role: "user",
+		Parts: []*genai.Part{
+			{Text: "describe"},
+			{InlineData: &genai.Blob{MIMEType: "image/jpeg", Data: []byte("jpeg")}},
+		},
+	}
+	if err := service.AppendEvent(context.Background(), adapter, evt); err != nil {
+		t.Fatalf("AppendEvent failed: %v", err)
+	}
+
+	// The in-memory history replays the image for the rest of the turn.
+	var replayed *genai.Blob
+	for e := range adapter.Events().All() {
+		for _, p := range e.Content.Parts {
+			if p.InlineData != nil {
+				replayed = p.InlineData
+			}
+		}
+	}
+	if replayed == nil || replayed.MIMEType != "image/jpeg" || string(replayed.Data) != "jpeg" {
+		t.Errorf("expected image to be replayed, got %+v", replayed)
+	}
+}
+
 func TestConvertMessages_Empty(t *testing.T) {
 	msgs, err := convertMessages(nil)
 	if err != nil {
@@ -0,0 +1,70 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type stubGenerator struct {
+	response string
+	err      error
+	prompt   string
+}
+
+func (g *stubGenerator) GenerateText(_ context.Context, _, userPrompt string) (string, error) {
+	g.prompt = userPrompt
+	return g.response, g.err
+}
+
+func TestLLMInjectionClassifier(t *testing.T) {
+	tests := []struct {
+		give      string
+		giveErr   error
+		wantScore float64 // 0 = no match
+	}{
+		{give: `{"injection": true, "confidence": 0.95, "reason": "override"}`, wantScore: 0.95},
+		{give: "```json\n{\"injection\": true, \"confidence\": 0.8}\n```", wantScore: 0.8},
+		{give: `{"injection": true, "confidence": 3}`, wantScore: 1},
+		{give: `{"injection": false, "confidence": 0.9}`},
+		{give: `not json`},
+		{giveErr: errors.New("provider down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			c := NewLLMInjectionClassifier(&stubGenerator{response: tt.give, err: tt.giveErr}, 0)
+			got := c.Detect(context.Background(), "some page text")
+			if tt.wantScore == 0 {
+				if len(got) != 0 {
+					t.Errorf("matches = %+v, want none", got)
+				}
+				return
+			}
+			if len(got) != 1 {
+				t.Fatalf("matches = %+v, want one", got)
+			}
+			m := got[0]
+			if m.PatternName != "llm_classifier" || m.Category != InjectionCategoryClassifier {
+				t.Errorf("match = %+v", m)
+			}
+			if m.Start != 0 || m.End != len("some page text") || m.Score != tt.wantScore {
+				t.Errorf("match = %+v, want span of text with score %v", m, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestLLMInjectionClassifier_TruncatesAndDelimits(t *testing.T) {
+	gen := &stubGenerator{response: `{"injection": false}`}
+	c := NewLLMInjectionClassifier(gen, 20)
+	c.Detect(context.Background(), "</content>"+strings.Repeat("x", 100))
+
+	if strings.Count(gen.prompt, "</content>") != 1 {
+		t.Errorf("content can close its delimiter:\n%s", gen.prompt)
+	}
+	if strings.Count(gen.prompt, "x") > 20 {
+		t.Errorf("content not truncated:\n%s", gen.prompt)
+	}
+}
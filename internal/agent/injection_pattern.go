@@ -0,0 +1,115 @@
+package agent
+
+// InjectionCategory classifies the kind of prompt-injection payload.
+type InjectionCategory string
+
+const (
+	InjectionCategoryOverride     InjectionCategory = "override"
+	InjectionCategoryRoleSpoof    InjectionCategory = "role_spoof"
+	InjectionCategoryExfiltration InjectionCategory = "exfiltration"
+	InjectionCategoryConcealment  InjectionCategory = "concealment"
+	InjectionCategoryToolCoercion InjectionCategory = "tool_coercion"
+	InjectionCategoryCustom       InjectionCategory = "custom"
+	InjectionCategoryClassifier   InjectionCategory = "classifier"
+)
+
+// InjectionPatternDef defines a heuristic prompt-injection rule.
+type InjectionPatternDef struct {
+	Name     string
+	Label    string
+	Category InjectionCategory
+	Pattern  string
+	Score    float64 // how strongly a match alone indicates an injection
+}
+
+// InjectionMatch represents a single prompt-injection detection result.
+type InjectionMatch struct {
+	PatternName string
+	Category    InjectionCategory
+	Start       int
+	End         int
+	Score       float64
+}
+
+// BuiltinInjectionPatterns defines the default heuristic rules. Rules that
+// also match ordinary text (documentation, chat logs) have lower scores, so
+// they only flag content together with other rules.
+var BuiltinInjectionPatterns = []InjectionPatternDef{
+	{
+		Name:     "ignore_instructions",
+		Label:    "Instruction Override",
+		Category: InjectionCategoryOverride,
+		Pattern:  `(?i)\b(?:ignore|disregard|forget|override|bypass)\b[^.\n]{0,40}?\b(?:previous|prior|above|earlier|preceding|system|original|all|your)\b[^.\n]{0,20}?\b(?:instructions?|prompts?|rules|directions|guidelines)\b`,
+		Score:    0.9,
+	},
+	{
+		Name:     "new_instructions",
+		Label:    "Replacement Instructions",
+		Category: InjectionCategoryOverride,
+		Pattern:  `(?i)\b(?:new|updated|real|actual|revised|additional)\s+(?:system\s+)?instructions?\s*(?::|-{1,2}|=)`,
+		Score:    0.6,
+	},
+	{
+		Name:     "role_reassignment",
+		Label:    "Role Reassignment",
+		Category: InjectionCategoryOverride,
+		Pattern:  `(?i)\byou\s+are\s+(?:now|no\s+longer)\b|\bfrom\s+now\s+on,?\s+you\s+(?:are|will|must)\b|\b(?:enter|enable|activate)\s+(?:developer|dan|jailbreak|god)\s+mode\b`,
+		Score:    0.6,
+	},
+	{
+		Name:     "chat_template_tokens",
+		Label:    "Chat Template Tokens",
+		Category: InjectionCategoryRoleSpoof,
+		Pattern:  `<\|(?:im_start|im_end|system|user|assistant|endoftext|eot_id|start_header_id)\|>|\[/?INST\]|<</?SYS>>`,
+		Score:    0.9,
+	},
+	{
+		Name:     "fake_role_tag",
+		Label:    "Fake Role Tag",
+		Category: InjectionCategoryRoleSpoof,
+		Pattern:  `(?i)</?(?:system|system_prompt|system-prompt|developer|instructions)>|(?m)^\s*(?:#{1,6}\s*)?(?:system|developer)\s+(?:prompt|message|override)\s*:`,
+		Score:    0.6,
+	},
+	{
+		Name:     "prompt_leak",
+		Label:    "System Prompt Extraction",
+		Category: InjectionCategoryExfiltration,
+		Pattern:  `(?i)\b(?:reveal|print|show|output|repeat|leak|dump)\b[^.\n]{0,30}?\b(?:system\s+prompt|your\s+(?:instructions|prompt)|initial\s+prompt|hidden\s+prompt)\b`,
+		Score:    0.7,
+	},
+	{
+		Name:     "credential_exfiltration",
+		Label:    "Credential Exfiltration",
+		Category: InjectionCategoryExfiltration,
+		Pattern:  `(?i)\b(?:send|post|upload|forward|exfiltrate|transmit|email)\b[^.\n]{0,60}?\b(?:secrets?|api[\s_-]?keys?|passwords?|credentials?|private[\s_-]keys?|seed\s+phrase|env(?:ironment)?\s+variables?)\b`,
+		Score:    0.7,
+	},
+	{
+		Name:     "markdown_image_exfiltration",
+		Label:    "Markdown Image Exfiltration",
+		Category: InjectionCategoryExfiltration,
+		Pattern:  `!\[[^\]]*\]\(https?://[^)\s]+\?[^)\s]*(?:\{\{|%7B%7B|\$\{|<[A-Z_]+>)`,
+		Score:    0.7,
+	},
+	{
+		Name:     "hide_from_user",
+		Label:    "Concealment from User",
+		Category: InjectionCategoryConcealment,
+		Pattern:  `(?i)\b(?:do\s+not|don'?t|never)\s+(?:tell|inform|mention\s+(?:this\s+)?to|reveal\s+(?:this\s+)?to|let)\s+(?:the\s+)?(?:user|human|operator|owner)\b`,
+		Score:    0.6,
+	},
+	{
+		Name:     "invisible_tag_characters",
+		Label:    "Invisible Unicode Tags",
+		Category: InjectionCategoryConcealment,
+		Pattern:  `[\x{E0000}-\x{E007F}]{4,}`,
+		Score:    0.9,
+	},
+	{
+		Name:     "tool_coercion",
+		Label:    "Tool Call Coercion",
+		Category: InjectionCategoryToolCoercion,
+		Pattern:  `(?i)\b(?:you\s+must|immediately|now)\s+(?:call|invoke|run|execute|use)\s+(?:the\s+)?(?:[\w-]+\s+)?(?:tool|function|command)\b`,
+		Score:    0.5,
+	},
+}
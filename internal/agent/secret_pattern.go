@@ -0,0 +1,71 @@
+package agent
+
+// SecretPatternDef defines a credential detection pattern.
+type SecretPatternDef struct {
+	Name    string
+	Label   string
+	Pattern string
+	Group   int // capture group holding the secret (0 = whole match)
+}
+
+// BuiltinSecretPatterns defines the default credential detection patterns.
+// More specific patterns come first: a span matched by an earlier pattern
+// is not matched again.
+var BuiltinSecretPatterns = []SecretPatternDef{
+	{
+		Name:    "private_key",
+		Label:   "Private Key",
+		Pattern: `-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----[\s\S]+?-----END (?:[A-Z]+ )*PRIVATE KEY-----`,
+	},
+	{
+		Name:    "anthropic_key",
+		Label:   "Anthropic API Key",
+		Pattern: `\bsk-ant-[A-Za-z0-9_\-]{20,}`,
+	},
+	{
+		Name:    "openai_key",
+		Label:   "OpenAI API Key",
+		Pattern: `\bsk-(?:proj-|svcacct-|admin-)?[A-Za-z0-9_\-]{20,}`,
+	},
+	{
+		Name:    "aws_access_key",
+		Label:   "AWS Access Key ID",
+		Pattern: `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`,
+	},
+	{
+		Name:    "github_token",
+		Label:   "GitHub Token",
+		Pattern: `\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{40,})\b`,
+	},
+	{
+		Name:    "slack_token",
+		Label:   "Slack Token",
+		Pattern: `\bxox[abposr]-[A-Za-z0-9\-]{10,}`,
+	},
+	{
+		Name:    "google_api_key",
+		Label:   "Google API Key",
+		Pattern: `\bAIza[0-9A-Za-z_\-]{35}\b`,
+	},
+	{
+		Name:    "stripe_key",
+		Label:   "Stripe Secret Key",
+		Pattern: `\b[rs]k_live_[0-9A-Za-z]{20,}\b`,
+	},
+	{
+		Name:    "telegram_bot_token",
+		Label:   "Telegram Bot Token",
+		Pattern: `\b\d{8,10}:AA[A-Za-z0-9_\-]{33}\b`,
+	},
+	{
+		Name:    "jwt",
+		Label:   "JSON Web Token",
+		Pattern: `\beyJ[A-Za-z0-9_\-]{10,}\.eyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}`,
+	},
+	{
+		Name:    "credential_assignment",
+		Label:   "Password or Token Assignment",
+		Pattern: `(?i)(?:\b|_)(?:password|passwd|pwd|secret|api[_\-]?key|access[_\-]?token|auth[_\-]?token|client[_\-]?secret)["']?\s*[:=]\s*["']?([^\s"'{}]{8,})`,
+		Group:   1,
+	},
+}
@@ -54,6 +54,18 @@ func (s *SecretScanner) Scan(text string) string {
 	return result
 }
 
+// Names returns the registered secret values mapped to their names.
+func (s *SecretScanner) Names() map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	result := make(map[string]string, len(s.secrets))
+	for value, name := range s.secrets {
+		result[value] = name
+	}
+	return result
+}
+
 // HasSecrets returns true if any secrets are registered.
 func (s *SecretScanner) HasSecrets() bool {
 	s.mu.RLock()
@@ -0,0 +1,208 @@
+package agent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RefIssuer issues reference tokens for secret values, such as
+// security.RefStore.
+type RefIssuer interface {
+	Store(name string, value []byte) string
+}
+
+// VaultFunc keeps a detected secret, so that its reference token still
+// resolves after a restart (e.g. SecretsStore.StoreIfChanged).
+type VaultFunc func(ctx context.Context, name string, value []byte) error
+
+// SecretScrubberConfig configures a SecretScrubber.
+type SecretScrubberConfig struct {
+	Scanner          *SecretScanner    // known secret values (optional)
+	Refs             RefIssuer         // issues the replacement tokens
+	Vault            VaultFunc         // keeps detected secrets (optional)
+	DisabledPatterns []string          // builtin pattern names to skip
+	CustomPatterns   map[string]string // name -> regex
+}
+
+// SecretFinding is a secret found in text.
+type SecretFinding struct {
+	Name    string // name of the reference token
+	Pattern string // detecting pattern, or "known" for a registered value
+	Start   int
+	End     int
+}
+
+// knownPattern marks findings of values registered with the SecretScanner.
+const knownPattern = "known"
+
+// refTokenPattern matches reference tokens, which are never scrubbed again.
+var refTokenPattern = regexp.MustCompile(`\{\{(?:secret|decrypt):[^}]+\}\}`)
+
+// SecretScrubber replaces secrets in text before it is persisted: values
+// registered with the SecretScanner and credentials recognized by pattern.
+// Each secret is swapped for a {{secret:name}} reference token.
+type SecretScrubber struct {
+	scanner  *SecretScanner
+	refs     RefIssuer
+	vault    VaultFunc
+	patterns []secretPattern
+
+	mu      sync.Mutex
+	vaulted map[string]bool
+}
+
+type secretPattern struct {
+	name  string
+	re    *regexp.Regexp
+	group int
+}
+
+// NewSecretScrubber creates a SecretScrubber. Invalid custom patterns are
+// skipped.
+func NewSecretScrubber(cfg SecretScrubberConfig) *SecretScrubber {
+	disabled := make(map[string]bool, len(cfg.DisabledPatterns))
+	for _, name := range cfg.DisabledPatterns {
+		disabled[name] = true
+	}
+
+	s := &SecretScrubber{
+		scanner: cfg.Scanner,
+		refs:    cfg.Refs,
+		vault:   cfg.Vault,
+		vaulted: make(map[string]bool),
+	}
+	for _, p := range BuiltinSecretPatterns {
+		if disabled[p.Name] {
+			continue
+		}
+		s.patterns = append(s.patterns, secretPattern{name: p.Name, re: regexp.MustCompile(p.Pattern), group: p.Group})
+	}
+
+	names := make([]string, 0, len(cfg.CustomPatterns))
+	for name := range cfg.CustomPatterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		re := compilePattern(cfg.CustomPatterns[name])
+		if re == nil {
+			continue
+		}
+		s.patterns = append(s.patterns, secretPattern{name: name, re: re})
+	}
+	return s
+}
+
+// Find returns the secrets in text, ordered by position and without
+// overlaps. Registered values take precedence over pattern matches.
+func (s *SecretScrubber) Find(text string) []SecretFinding {
+	var found []SecretFinding
+	tokens := refTokenPattern.FindAllStringIndex(text, -1)
+	taken := func(start, end int) bool {
+		for _, t := range tokens {
+			if start < t[1] && end > t[0] {
+				return true
+			}
+		}
+		for _, f := range found {
+			if start < f.End && end > f.Start {
+				return true
+			}
+		}
+		return false
+	}
+
+	if s.scanner != nil {
+		known := s.scanner.Names()
+		values := make([]string, 0, len(known))
+		for v := range known {
+			values = append(values, v)
+		}
+		// Longest first, so a secret containing another is replaced whole.
+		sort.Slice(values, func(i, j int) bool { return len(values[i]) > len(values[j]) })
+		for _, v := range values {
+			for off := 0; ; {
+				i := strings.Index(text[off:], v)
+				if i < 0 {
+					break
+				}
+				start, end := off+i, off+i+len(v)
+				if !taken(start, end) {
+					found = append(found, SecretFinding{Name: known[v], Pattern: knownPattern, Start: start, End: end})
+				}
+				off = end
+			}
+		}
+	}
+
+	for _, p := range s.patterns {
+		for _, m := range p.re.FindAllStringSubmatchIndex(text, -1) {
+			if 2*p.group+1 >= len(m) || m[2*p.group] < 0 {
+				continue
+			}
+			start, end := m[2*p.group], m[2*p.group+1]
+			if taken(start, end) {
+				continue
+			}
+			found = append(found, SecretFinding{Name: detectedName(p.name, text[start:end]), Pattern: p.name, Start: start, End: end})
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool { return found[i].Start < found[j].Start })
+	return found
+}
+
+// Scrub replaces the secrets in text with reference tokens. Detected
+// secrets are kept with the vault, when one is configured.
+func (s *SecretScrubber) Scrub(text string) string {
+	findings := s.Find(text)
+	if len(findings) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, f := range findings {
+		value := text[f.Start:f.End]
+		if f.Pattern != knownPattern {
+			s.keep(f.Name, value)
+		}
+		b.WriteString(text[last:f.Start])
+		b.WriteString(s.refs.Store(f.Name, []byte(value)))
+		last = f.End
+	}
+	b.WriteString(text[last:])
+
+	secretLogger.Infow("scrubbed secrets before persistence", "count", len(findings))
+	return b.String()
+}
+
+// keep stores a detected secret with the vault once per process. The vault
+// itself skips secrets it already holds, so restarts add no versions.
+func (s *SecretScrubber) keep(name, value string) {
+	if s.vault == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.vaulted[name] {
+		return
+	}
+	if err := s.vault(context.Background(), name, []byte(value)); err != nil {
+		secretLogger.Warnw("keep detected secret", "name", name, "error", err)
+		return
+	}
+	s.vaulted[name] = true
+}
+
+// detectedName derives a stable token name from the pattern and the value,
+// so the same secret always maps to the same token.
+func detectedName(pattern, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return "detected." + pattern + "." + hex.EncodeToString(sum[:4])
+}
@@ -0,0 +1,1054 @@
+package app
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/background"
+	cronpkg "github.com/langoai/lango/internal/cron"
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/gateway"
+	"github.com/langoai/lango/internal/graph"
+	"github.com/langoai/lango/internal/memory"
+	"github.com/langoai/lango/internal/skill"
+	"github.com/langoai/lango/internal/workflow"
+)
+
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// apiResources holds the subsystems exposed by the REST API. Nil fields
+// are disabled subsystems; their routes answer 503.
+type apiResources struct {
+	cron      *cronpkg.Scheduler
+	workflows *workflow.Engine
+	bg        *background.Manager
+	skills    *skill.Registry
+	memory    *memory.Store
+	graph     graph.Store
+
+	// bgDeliverTo lists the channels a background task submitted through
+	// the API may deliver its result to.
+	bgDeliverTo []string
+}
+
+// apiRoutes mounts the REST API for the subsystems of the app.
+func (a *App) apiRoutes(r chi.Router) {
+	registerAPIRoutes(r, apiResources{
+		cron:      a.CronScheduler,
+		workflows: a.WorkflowEngine,
+		bg:        a.BackgroundManager,
+		skills:    a.SkillRegistry,
+		memory:    a.MemoryStore,
+		graph:     a.GraphStore,
+
+		bgDeliverTo: a.Config.Background.DefaultDeliverTo,
+	})
+}
+
+// registerAPIRoutes mounts the automation REST API on an authenticated
+// router. The OpenAPI document describing it is served by handleOpenAPI.
+func registerAPIRoutes(r chi.Router, res apiResources) {
+	r.Route("/api/cron", func(r chi.Router) {
+		r.Use(apiEnabled(res.cron != nil, "cron scheduling"))
+		r.Get("/", apiListCronJobs(res.cron))
+		r.Post("/", apiCreateCronJob(res.cron))
+		r.Get("/{id}", apiGetCronJob(res.cron))
+		r.Delete("/{id}", apiDeleteCronJob(res.cron))
+		r.Post("/{id}/pause", apiPauseCronJob(res.cron))
+		r.Post("/{id}/resume", apiResumeCronJob(res.cron))
+		r.Get("/{id}/history", apiCronHistory(res.cron))
+	})
+	r.Route("/api/workflows", func(r chi.Router) {
+		r.Use(apiEnabled(res.workflows != nil, "workflow engine"))
+		r.Get("/", apiListWorkflowRuns(res.workflows))
+		r.Post("/", apiRunWorkflow(res.workflows))
+		r.Get("/{runID}", apiGetWorkflowRun(res.workflows))
+		r.Post("/{runID}/cancel", apiCancelWorkflowRun(res.workflows))
+	})
+	r.Route("/api/bg", func(r chi.Router) {
+		r.Use(apiEnabled(res.bg != nil, "background tasks"))
+		r.Get("/", apiListTasks(res.bg))
+		r.Post("/", apiSubmitTask(res.bg, res.bgDeliverTo))
+		r.Get("/{id}", apiGetTask(res.bg))
+		r.Post("/{id}/cancel", apiCancelTask(res.bg))
+	})
+	r.Route("/api/skills", func(r chi.Router) {
+		r.Use(apiEnabled(res.skills != nil, "skill system"))
+		r.Get("/", apiListSkills(res.skills))
+		r.Post("/", apiCreateSkill(res.skills))
+		r.Get("/{name}", apiGetSkill(res.skills))
+		r.Delete("/{name}", apiDeleteSkill(res.skills))
+	})
+	r.Route("/api/memory", func(r chi.Router) {
+		r.Use(apiEnabled(res.memory != nil, "observational memory"))
+		r.Get("/", apiListMemory(res.memory))
+		r.Post("/pin", apiPinMemory(res.memory))
+		r.Get("/{id}", apiGetMemory(res.memory))
+		r.Patch("/{id}", apiCorrectMemory(res.memory))
+		r.Delete("/{id}", apiForgetMemory(res.memory))
+	})
+	r.Route("/api/graph", func(r chi.Router) {
+		r.Use(apiEnabled(res.graph != nil, "graph store"))
+		r.Get("/stats", apiGraphStats(res.graph))
+		r.Get("/triples", apiGraphTriples(res.graph))
+		r.Get("/traverse", apiGraphTraverse(res.graph))
+	})
+}
+
+// handleOpenAPI serves the OpenAPI document of the REST API. It is public
+// so clients can discover the API before authenticating.
+func handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPIDocument)
+}
+
+// apiEnabled answers 503 for every route of a disabled subsystem.
+func apiEnabled(enabled bool, name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if enabled {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			writeAPIError(w, http.StatusServiceUnavailable, name+" is not enabled")
+		})
+	}
+}
+
+// writeAPIResponse writes v as a JSON response with the given status.
+func writeAPIResponse(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	writeJSON(w, v)
+}
+
+// writeAPIError writes an {"error": msg} response.
+func writeAPIError(w http.ResponseWriter, status int, msg string) {
+	writeAPIResponse(w, status, map[string]string{"error": msg})
+}
+
+// writeAPIStoreError writes a store error as 404 when the record does not
+// exist and 500 otherwise.
+func writeAPIStoreError(w http.ResponseWriter, err error) {
+	if ent.IsNotFound(err) || errors.Is(err, memory.ErrEntryNotFound) {
+		writeAPIError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	writeAPIError(w, http.StatusInternalServerError, err.Error())
+}
+
+// decodeAPIRequest decodes a JSON request body into v, writing a 415
+// response for other content types and a 400 response on failure. Requiring
+// application/json also keeps browsers from sending the request cross-site
+// without a CORS preflight.
+func decodeAPIRequest(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err != nil || mt != "application/json" {
+		writeAPIError(w, http.StatusUnsupportedMediaType, "Content-Type must be application/json")
+		return false
+	}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1<<20))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// queryLimit parses the "limit" query parameter, falling back to def.
+func queryLimit(r *http.Request, def int) (int, error) {
+	raw := r.URL.Query().Get("limit")
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("limit must be a positive integer")
+	}
+	return n, nil
+}
+
+// --- cron ---
+
+type apiCronJob struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	ScheduleType string     `json:"scheduleType"`
+	Schedule     string     `json:"schedule"`
+	Prompt       string     `json:"prompt"`
+	SessionMode  string     `json:"sessionMode"`
+	DeliverTo    []string   `json:"deliverTo"`
+	Timezone     string     `json:"timezone,omitempty"`
+	Enabled      bool       `json:"enabled"`
+	LastRunAt    *time.Time `json:"lastRunAt,omitempty"`
+	NextRunAt    *time.Time `json:"nextRunAt,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+func toAPICronJob(j cronpkg.Job) apiCronJob {
+	return apiCronJob{
+		ID:           j.ID,
+		Name:         j.Name,
+		ScheduleType: j.ScheduleType,
+		Schedule:     j.Schedule,
+		Prompt:       j.Prompt,
+		SessionMode:  j.SessionMode,
+		DeliverTo:    j.DeliverTo,
+		Timezone:     j.Timezone,
+		Enabled:      j.Enabled,
+		LastRunAt:    j.LastRunAt,
+		NextRunAt:    j.NextRunAt,
+		CreatedAt:    j.CreatedAt,
+	}
+}
+
+// findCronJob looks up a job by ID or name.
+func findCronJob(ctx context.Context, s *cronpkg.Scheduler, ref string) (*cronpkg.Job, error) {
+	jobs, err := s.ListJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs {
+		if jobs[i].ID == ref || jobs[i].Name == ref {
+			return &jobs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// withCronJob resolves the {id} URL parameter and calls fn with the job.
+func withCronJob(s *cronpkg.Scheduler, fn func(w http.ResponseWriter, r *http.Request, job *cronpkg.Job)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ref := chi.URLParam(r, "id")
+		job, err := findCronJob(r.Context(), s, ref)
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if job == nil {
+			writeAPIError(w, http.StatusNotFound, fmt.Sprintf("cron job %q not found", ref))
+			return
+		}
+		fn(w, r, job)
+	}
+}
+
+func apiListCronJobs(s *cronpkg.Scheduler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		jobs, err := s.ListJobs(r.Context())
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		out := make([]apiCronJob, 0, len(jobs))
+		for _, j := range jobs {
+			out = append(out, toAPICronJob(j))
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"jobs": out, "count": len(out)})
+	}
+}
+
+func apiCreateCronJob(s *cronpkg.Scheduler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Name         string   `json:"name"`
+			ScheduleType string   `json:"scheduleType"`
+			Schedule     string   `json:"schedule"`
+			Prompt       string   `json:"prompt"`
+			SessionMode  string   `json:"sessionMode"`
+			DeliverTo    []string `json:"deliverTo"`
+			Timezone     string   `json:"timezone"`
+		}
+		if !decodeAPIRequest(w, r, &req) {
+			return
+		}
+		if req.Name == "" || req.ScheduleType == "" || req.Schedule == "" || req.Prompt == "" {
+			writeAPIError(w, http.StatusBadRequest, "name, scheduleType, schedule and prompt are required")
+			return
+		}
+		switch req.ScheduleType {
+		case "cron", "every", "at":
+		default:
+			writeAPIError(w, http.StatusBadRequest, "scheduleType must be cron, every or at")
+			return
+		}
+		if req.SessionMode == "" {
+			req.SessionMode = "isolated"
+		}
+
+		existing, err := findCronJob(r.Context(), s, req.Name)
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if existing != nil {
+			writeAPIError(w, http.StatusConflict, fmt.Sprintf("cron job %q already exists", req.Name))
+			return
+		}
+
+		err = s.AddJob(r.Context(), cronpkg.Job{
+			Name:         req.Name,
+			ScheduleType: req.ScheduleType,
+			Schedule:     req.Schedule,
+			Prompt:       req.Prompt,
+			SessionMode:  req.SessionMode,
+			DeliverTo:    req.DeliverTo,
+			Timezone:     req.Timezone,
+			Enabled:      true,
+		})
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		job, err := findCronJob(r.Context(), s, req.Name)
+		if err != nil || job == nil {
+			writeAPIError(w, http.StatusInternalServerError, fmt.Sprintf("read back cron job %q: %v", req.Name, err))
+			return
+		}
+		writeAPIResponse(w, http.StatusCreated, toAPICronJob(*job))
+	}
+}
+
+func apiGetCronJob(s *cronpkg.Scheduler) http.HandlerFunc {
+	return withCronJob(s, func(w http.ResponseWriter, _ *http.Request, job *cronpkg.Job) {
+		writeAPIResponse(w, http.StatusOK, toAPICronJob(*job))
+	})
+}
+
+func apiDeleteCronJob(s *cronpkg.Scheduler) http.HandlerFunc {
+	return withCronJob(s, func(w http.ResponseWriter, r *http.Request, job *cronpkg.Job) {
+		if err := s.RemoveJob(r.Context(), job.ID); err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "removed", "id": job.ID})
+	})
+}
+
+func apiPauseCronJob(s *cronpkg.Scheduler) http.HandlerFunc {
+	return withCronJob(s, func(w http.ResponseWriter, r *http.Request, job *cronpkg.Job) {
+		if err := s.PauseJob(r.Context(), job.ID); err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "paused", "id": job.ID})
+	})
+}
+
+func apiResumeCronJob(s *cronpkg.Scheduler) http.HandlerFunc {
+	return withCronJob(s, func(w http.ResponseWriter, r *http.Request, job *cronpkg.Job) {
+		if err := s.ResumeJob(r.Context(), job.ID); err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "resumed", "id": job.ID})
+	})
+}
+
+func apiCronHistory(s *cronpkg.Scheduler) http.HandlerFunc {
+	return withCronJob(s, func(w http.ResponseWriter, r *http.Request, job *cronpkg.Job) {
+		limit, err := queryLimit(r, 20)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		entries, err := s.History(r.Context(), job.ID, limit)
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		type historyEntry struct {
+			ID          string     `json:"id"`
+			Status      string     `json:"status"`
+			Result      string     `json:"result,omitempty"`
+			Error       string     `json:"error,omitempty"`
+			TokensUsed  int        `json:"tokensUsed"`
+			StartedAt   time.Time  `json:"startedAt"`
+			CompletedAt *time.Time `json:"completedAt,omitempty"`
+		}
+		out := make([]historyEntry, 0, len(entries))
+		for _, e := range entries {
+			out = append(out, historyEntry{
+				ID:          e.ID,
+				Status:      e.Status,
+				Result:      e.Result,
+				Error:       e.ErrorMessage,
+				TokensUsed:  e.TokensUsed,
+				StartedAt:   e.StartedAt,
+				CompletedAt: e.CompletedAt,
+			})
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"history": out, "count": len(out)})
+	})
+}
+
+// --- workflows ---
+
+type apiWorkflowStep struct {
+	ID     string `json:"id"`
+	Agent  string `json:"agent,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type apiWorkflowRun struct {
+	RunID          string            `json:"runId"`
+	WorkflowName   string            `json:"workflowName"`
+	Status         string            `json:"status"`
+	TotalSteps     int               `json:"totalSteps"`
+	CompletedSteps int               `json:"completedSteps"`
+	StartedAt      time.Time         `json:"startedAt"`
+	Steps          []apiWorkflowStep `json:"steps,omitempty"`
+}
+
+func toAPIWorkflowRun(s workflow.RunStatus) apiWorkflowRun {
+	run := apiWorkflowRun{
+		RunID:          s.RunID,
+		WorkflowName:   s.WorkflowName,
+		Status:         s.Status,
+		TotalSteps:     s.TotalSteps,
+		CompletedSteps: s.CompletedSteps,
+		StartedAt:      s.StartedAt,
+	}
+	for _, st := range s.StepStatuses {
+		run.Steps = append(run.Steps, apiWorkflowStep{
+			ID:     st.StepID,
+			Agent:  st.Agent,
+			Status: st.Status,
+			Error:  st.Error,
+		})
+	}
+	return run
+}
+
+// workflowRunID validates the {runID} URL parameter.
+func workflowRunID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	runID := chi.URLParam(r, "runID")
+	if _, err := uuid.Parse(runID); err != nil {
+		writeAPIError(w, http.StatusNotFound, fmt.Sprintf("workflow run %q not found", runID))
+		return "", false
+	}
+	return runID, true
+}
+
+func apiListWorkflowRuns(e *workflow.Engine) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		limit, err := queryLimit(r, 20)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		runs, err := e.ListRuns(r.Context(), limit)
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		out := make([]apiWorkflowRun, 0, len(runs))
+		for _, run := range runs {
+			out = append(out, toAPIWorkflowRun(run))
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"runs": out, "count": len(out)})
+	}
+}
+
+func apiRunWorkflow(e *workflow.Engine) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			YAML string `json:"yaml"`
+		}
+		if !decodeAPIRequest(w, r, &req) {
+			return
+		}
+		if strings.TrimSpace(req.YAML) == "" {
+			writeAPIError(w, http.StatusBadRequest, "yaml is required")
+			return
+		}
+		wf, err := workflow.Parse([]byte(req.YAML))
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		runID, err := e.RunAsync(r.Context(), wf)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusAccepted, map[string]string{
+			"runId":        runID,
+			"workflowName": wf.Name,
+			"status":       "running",
+		})
+	}
+}
+
+func apiGetWorkflowRun(e *workflow.Engine) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		runID, ok := workflowRunID(w, r)
+		if !ok {
+			return
+		}
+		status, err := e.Status(r.Context(), runID)
+		if err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, toAPIWorkflowRun(*status))
+	}
+}
+
+func apiCancelWorkflowRun(e *workflow.Engine) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		runID, ok := workflowRunID(w, r)
+		if !ok {
+			return
+		}
+		if _, err := e.Status(r.Context(), runID); err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		if err := e.Cancel(runID); err != nil {
+			writeAPIError(w, http.StatusConflict, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "cancelled", "runId": runID})
+	}
+}
+
+// --- background tasks ---
+
+type apiTask struct {
+	ID            string     `json:"id"`
+	Status        string     `json:"status"`
+	Prompt        string     `json:"prompt"`
+	Result        string     `json:"result,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	OriginChannel string     `json:"originChannel,omitempty"`
+	OriginSession string     `json:"originSession,omitempty"`
+	StartedAt     *time.Time `json:"startedAt,omitempty"`
+	CompletedAt   *time.Time `json:"completedAt,omitempty"`
+	TokensUsed    int        `json:"tokensUsed"`
+}
+
+func toAPITask(s background.TaskSnapshot) apiTask {
+	t := apiTask{
+		ID:            s.ID,
+		Status:        s.StatusText,
+		Prompt:        s.Prompt,
+		Result:        s.Result,
+		Error:         s.Error,
+		OriginChannel: s.OriginChannel,
+		OriginSession: s.OriginSession,
+		TokensUsed:    s.TokensUsed,
+	}
+	if !s.StartedAt.IsZero() {
+		t.StartedAt = &s.StartedAt
+	}
+	if !s.CompletedAt.IsZero() {
+		t.CompletedAt = &s.CompletedAt
+	}
+	return t
+}
+
+func apiListTasks(m *background.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		snaps := m.List()
+		sort.Slice(snaps, func(i, j int) bool { return snaps[i].StartedAt.After(snaps[j].StartedAt) })
+		out := make([]apiTask, 0, len(snaps))
+		for _, s := range snaps {
+			out = append(out, toAPITask(s))
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"tasks": out, "count": len(out)})
+	}
+}
+
+func apiSubmitTask(m *background.Manager, deliverTo []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Prompt  string `json:"prompt"`
+			Channel string `json:"channel"`
+			Session string `json:"session"`
+		}
+		if !decodeAPIRequest(w, r, &req) {
+			return
+		}
+		if strings.TrimSpace(req.Prompt) == "" {
+			writeAPIError(w, http.StatusBadRequest, "prompt is required")
+			return
+		}
+		// Results go only to the caller's own session and to the configured
+		// delivery targets, never to a chat the caller picks.
+		caller := gateway.SessionFromContext(r.Context())
+		if req.Session != "" && req.Session != caller {
+			writeAPIError(w, http.StatusForbidden, "session must be the authenticated session")
+			return
+		}
+		if req.Channel != "" && !slices.Contains(deliverTo, req.Channel) {
+			writeAPIError(w, http.StatusForbidden, fmt.Sprintf("channel %q is not in background.defaultDeliverTo", req.Channel))
+			return
+		}
+		id, err := m.Submit(r.Context(), req.Prompt, background.Origin{Channel: req.Channel, Session: caller})
+		if err != nil {
+			writeAPIError(w, http.StatusTooManyRequests, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusAccepted, map[string]string{"id": id, "status": "pending"})
+	}
+}
+
+func apiGetTask(m *background.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		snap, err := m.Status(chi.URLParam(r, "id"))
+		if err != nil {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, toAPITask(*snap))
+	}
+}
+
+func apiCancelTask(m *background.Manager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := chi.URLParam(r, "id")
+		if _, err := m.Status(id); err != nil {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if err := m.Cancel(id); err != nil {
+			writeAPIError(w, http.StatusConflict, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "cancelled", "id": id})
+	}
+}
+
+// --- skills ---
+
+type apiSkill struct {
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description"`
+	Type             string                 `json:"type"`
+	Status           string                 `json:"status"`
+	CreatedBy        string                 `json:"createdBy,omitempty"`
+	RequiresApproval bool                   `json:"requiresApproval"`
+	Source           string                 `json:"source,omitempty"`
+	AllowedTools     []string               `json:"allowedTools,omitempty"`
+	Definition       map[string]interface{} `json:"definition,omitempty"`
+	Parameters       map[string]interface{} `json:"parameters,omitempty"`
+}
+
+func toAPISkill(e skill.SkillEntry) apiSkill {
+	return apiSkill{
+		Name:             e.Name,
+		Description:      e.Description,
+		Type:             string(e.Type),
+		Status:           string(e.Status),
+		CreatedBy:        e.CreatedBy,
+		RequiresApproval: e.RequiresApproval,
+		Source:           e.Source,
+		AllowedTools:     e.AllowedTools,
+		Definition:       e.Definition,
+		Parameters:       e.Parameters,
+	}
+}
+
+func apiListSkills(reg *skill.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entries, err := reg.ListActiveSkills(r.Context())
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+		out := make([]apiSkill, 0, len(entries))
+		for _, e := range entries {
+			s := toAPISkill(e)
+			s.Definition, s.Parameters = nil, nil
+			out = append(out, s)
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"skills": out, "count": len(out)})
+	}
+}
+
+func apiCreateSkill(reg *skill.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Name        string                 `json:"name"`
+			Description string                 `json:"description"`
+			Type        string                 `json:"type"`
+			Definition  map[string]interface{} `json:"definition"`
+			Parameters  map[string]interface{} `json:"parameters"`
+		}
+		if !decodeAPIRequest(w, r, &req) {
+			return
+		}
+		if req.Name == "" || req.Description == "" {
+			writeAPIError(w, http.StatusBadRequest, "name and description are required")
+			return
+		}
+		if _, err := reg.Store().Get(r.Context(), req.Name); err == nil {
+			writeAPIError(w, http.StatusConflict, fmt.Sprintf("skill %q already exists", req.Name))
+			return
+		}
+
+		entry := skill.SkillEntry{
+			Name:        req.Name,
+			Description: req.Description,
+			Type:        skill.SkillType(req.Type),
+			Definition:  req.Definition,
+			Parameters:  req.Parameters,
+			Status:      skill.SkillStatusActive,
+			CreatedBy:   "api",
+		}
+		if err := reg.CreateSkill(r.Context(), entry); err != nil {
+			writeAPIError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if err := reg.ActivateSkill(r.Context(), req.Name); err != nil {
+			writeAPIError(w, http.StatusInternalServerError, fmt.Sprintf("activate skill: %v", err))
+			return
+		}
+		writeAPIResponse(w, http.StatusCreated, toAPISkill(entry))
+	}
+}
+
+func apiGetSkill(reg *skill.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		entry, err := reg.Store().Get(r.Context(), chi.URLParam(r, "name"))
+		if err != nil {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, toAPISkill(*entry))
+	}
+}
+
+func apiDeleteSkill(reg *skill.Registry) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := chi.URLParam(r, "name")
+		if _, err := reg.Store().Get(r.Context(), name); err != nil {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		if err := reg.Store().Delete(r.Context(), name); err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if err := reg.LoadSkills(r.Context()); err != nil {
+			writeAPIError(w, http.StatusInternalServerError, fmt.Sprintf("reload skills: %v", err))
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "deleted", "name": name})
+	}
+}
+
+// --- memory ---
+
+type apiMemoryEntry struct {
+	ID         string     `json:"id"`
+	Kind       string     `json:"kind"`
+	SessionKey string     `json:"sessionKey"`
+	Content    string     `json:"content"`
+	Pinned     bool       `json:"pinned"`
+	CreatedAt  *time.Time `json:"createdAt,omitempty"`
+}
+
+func toAPIMemoryEntry(e memory.Entry) apiMemoryEntry {
+	return apiMemoryEntry{
+		ID:         e.ID.String(),
+		Kind:       e.Kind,
+		SessionKey: e.SessionKey,
+		Content:    e.Content,
+		Pinned:     e.Pinned,
+	}
+}
+
+// memoryEntryID parses the {id} URL parameter.
+func memoryEntryID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, "id must be a UUID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+func apiListMemory(s *memory.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		sessionKey, kind := q.Get("session"), q.Get("kind")
+		if kind != "" && kind != memory.CollectionObservation && kind != memory.CollectionReflection && kind != "pinned" {
+			writeAPIError(w, http.StatusBadRequest, "kind must be observation, reflection or pinned")
+			return
+		}
+		if sessionKey == "" && kind != "pinned" {
+			writeAPIError(w, http.StatusBadRequest, "session is required unless kind is pinned")
+			return
+		}
+
+		out := []apiMemoryEntry{}
+		addObservations := func(list []memory.Observation) {
+			for _, o := range list {
+				createdAt := o.CreatedAt
+				out = append(out, apiMemoryEntry{
+					ID:         o.ID.String(),
+					Kind:       memory.CollectionObservation,
+					SessionKey: o.SessionKey,
+					Content:    o.Content,
+					Pinned:     o.Pinned,
+					CreatedAt:  &createdAt,
+				})
+			}
+		}
+
+		if kind == "pinned" {
+			list, err := s.ListPinnedObservations(r.Context())
+			if err != nil {
+				writeAPIError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			addObservations(list)
+		}
+		if kind == "" || kind == memory.CollectionObservation {
+			list, err := s.ListObservations(r.Context(), sessionKey)
+			if err != nil {
+				writeAPIError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			addObservations(list)
+		}
+		if kind == "" || kind == memory.CollectionReflection {
+			list, err := s.ListReflections(r.Context(), sessionKey)
+			if err != nil {
+				writeAPIError(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			for _, ref := range list {
+				createdAt := ref.CreatedAt
+				out = append(out, apiMemoryEntry{
+					ID:         ref.ID.String(),
+					Kind:       memory.CollectionReflection,
+					SessionKey: ref.SessionKey,
+					Content:    ref.Content,
+					CreatedAt:  &createdAt,
+				})
+			}
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"entries": out, "count": len(out)})
+	}
+}
+
+func apiPinMemory(s *memory.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Session string `json:"session"`
+			Content string `json:"content"`
+		}
+		if !decodeAPIRequest(w, r, &req) {
+			return
+		}
+		if strings.TrimSpace(req.Content) == "" {
+			writeAPIError(w, http.StatusBadRequest, "content is required")
+			return
+		}
+		obs, err := s.PinFact(r.Context(), req.Session, req.Content)
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusCreated, apiMemoryEntry{
+			ID:         obs.ID.String(),
+			Kind:       memory.CollectionObservation,
+			SessionKey: obs.SessionKey,
+			Content:    obs.Content,
+			Pinned:     true,
+			CreatedAt:  &obs.CreatedAt,
+		})
+	}
+}
+
+func apiGetMemory(s *memory.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := memoryEntryID(w, r)
+		if !ok {
+			return
+		}
+		entry, err := s.GetEntry(r.Context(), id)
+		if err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, toAPIMemoryEntry(*entry))
+	}
+}
+
+func apiCorrectMemory(s *memory.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := memoryEntryID(w, r)
+		if !ok {
+			return
+		}
+		var req struct {
+			Content *string `json:"content"`
+			Pinned  *bool   `json:"pinned"`
+		}
+		if !decodeAPIRequest(w, r, &req) {
+			return
+		}
+		if req.Content == nil && req.Pinned == nil {
+			writeAPIError(w, http.StatusBadRequest, "content or pinned is required")
+			return
+		}
+		if req.Content != nil && strings.TrimSpace(*req.Content) == "" {
+			writeAPIError(w, http.StatusBadRequest, "content must not be empty")
+			return
+		}
+
+		if req.Content != nil {
+			if _, err := s.Correct(r.Context(), id, *req.Content); err != nil {
+				writeAPIStoreError(w, err)
+				return
+			}
+		}
+		if req.Pinned != nil {
+			if err := s.SetPinned(r.Context(), id, *req.Pinned); err != nil {
+				writeAPIStoreError(w, err)
+				return
+			}
+		}
+		entry, err := s.GetEntry(r.Context(), id)
+		if err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, toAPIMemoryEntry(*entry))
+	}
+}
+
+func apiForgetMemory(s *memory.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := memoryEntryID(w, r)
+		if !ok {
+			return
+		}
+		entry, err := s.Forget(r.Context(), id)
+		if err != nil {
+			writeAPIStoreError(w, err)
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]string{"status": "forgotten", "id": entry.ID.String()})
+	}
+}
+
+// --- graph ---
+
+type apiTriple struct {
+	Subject   string            `json:"subject"`
+	Predicate string            `json:"predicate"`
+	Object    string            `json:"object"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+func writeAPITriples(w http.ResponseWriter, triples []graph.Triple) {
+	out := make([]apiTriple, 0, len(triples))
+	for _, t := range triples {
+		out = append(out, apiTriple{Subject: t.Subject, Predicate: t.Predicate, Object: t.Object, Metadata: t.Metadata})
+	}
+	writeAPIResponse(w, http.StatusOK, map[string]interface{}{"triples": out, "count": len(out)})
+}
+
+func apiGraphStats(g graph.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		count, err := g.Count(r.Context())
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		predicates, err := g.PredicateStats(r.Context())
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeAPIResponse(w, http.StatusOK, map[string]interface{}{"triples": count, "predicates": predicates})
+	}
+}
+
+func apiGraphTriples(g graph.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		subject, predicate, object := q.Get("subject"), q.Get("predicate"), q.Get("object")
+
+		var (
+			triples []graph.Triple
+			err     error
+		)
+		switch {
+		case subject != "" && predicate != "":
+			triples, err = g.QueryBySubjectPredicate(r.Context(), subject, predicate)
+		case subject != "":
+			triples, err = g.QueryBySubject(r.Context(), subject)
+		case object != "":
+			triples, err = g.QueryByObject(r.Context(), object)
+		default:
+			writeAPIError(w, http.StatusBadRequest, "subject or object is required")
+			return
+		}
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// Narrow the results by the parameters the query did not use.
+		filtered := triples[:0]
+		for _, t := range triples {
+			if (predicate == "" || t.Predicate == predicate) && (object == "" || t.Object == object) {
+				filtered = append(filtered, t)
+			}
+		}
+		writeAPITriples(w, filtered)
+	}
+}
+
+func apiGraphTraverse(g graph.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		node := q.Get("node")
+		if node == "" {
+			writeAPIError(w, http.StatusBadRequest, "node is required")
+			return
+		}
+		depth := 2
+		if raw := q.Get("depth"); raw != "" {
+			n, err := strconv.Atoi(raw)
+			if err != nil || n < 1 || n > 5 {
+				writeAPIError(w, http.StatusBadRequest, "depth must be between 1 and 5")
+				return
+			}
+			depth = n
+		}
+		var predicates []string
+		if raw := q.Get("predicates"); raw != "" {
+			predicates = strings.Split(raw, ",")
+		}
+
+		triples, err := g.Traverse(r.Context(), node, depth, predicates)
+		if err != nil {
+			writeAPIError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		writeAPITriples(w, triples)
+	}
+}
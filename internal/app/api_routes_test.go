@@ -0,0 +1,228 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/background"
+	cronpkg "github.com/langoai/lango/internal/cron"
+	"github.com/langoai/lango/internal/memory"
+	"github.com/langoai/lango/internal/session"
+)
+
+func newAPITestRouter(t *testing.T, build func(a *App) apiResources) chi.Router {
+	t.Helper()
+	r := chi.NewRouter()
+	registerAPIRoutes(r, build(newCommandTestApp(t)))
+	return r
+}
+
+func apiRequest(t *testing.T, r chi.Router, method, path string, body interface{}) (int, map[string]interface{}) {
+	t.Helper()
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	var resp map[string]interface{}
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	return w.Code, resp
+}
+
+func TestAPIRoutes_Disabled(t *testing.T) {
+	r := newAPITestRouter(t, func(*App) apiResources { return apiResources{} })
+
+	for _, path := range []string{"/api/cron", "/api/workflows", "/api/bg", "/api/skills", "/api/memory", "/api/graph/stats"} {
+		code, resp := apiRequest(t, r, http.MethodGet, path, nil)
+		assert.Equal(t, http.StatusServiceUnavailable, code, path)
+		assert.Contains(t, resp["error"], "not enabled", path)
+	}
+}
+
+func TestAPIRoutes_Cron(t *testing.T) {
+	r := newAPITestRouter(t, func(a *App) apiResources {
+		client := a.Store.(*session.EntStore).Client()
+		return apiResources{cron: cronpkg.New(cronpkg.NewEntStore(client), nil, "UTC", 1, zap.NewNop().Sugar())}
+	})
+
+	code, resp := apiRequest(t, r, http.MethodPost, "/api/cron", map[string]interface{}{
+		"name":         "digest",
+		"scheduleType": "cron",
+		"schedule":     "0 9 * * *",
+		"prompt":       "Summarize the news",
+	})
+	require.Equal(t, http.StatusCreated, code, resp)
+	id, _ := resp["id"].(string)
+	assert.NotEmpty(t, id)
+	assert.Equal(t, "isolated", resp["sessionMode"])
+	assert.Equal(t, true, resp["enabled"])
+
+	code, _ = apiRequest(t, r, http.MethodPost, "/api/cron", map[string]interface{}{
+		"name": "digest", "scheduleType": "cron", "schedule": "0 9 * * *", "prompt": "again",
+	})
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = apiRequest(t, r, http.MethodPost, "/api/cron", map[string]interface{}{"name": "bad", "schedule": "x"})
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, resp = apiRequest(t, r, http.MethodPost, "/api/cron/digest/pause", nil)
+	require.Equal(t, http.StatusOK, code, resp)
+	assert.Equal(t, id, resp["id"])
+
+	code, resp = apiRequest(t, r, http.MethodGet, "/api/cron/"+id, nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, false, resp["enabled"])
+
+	code, resp = apiRequest(t, r, http.MethodGet, "/api/cron/digest/history?limit=5", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(0), resp["count"])
+
+	code, _ = apiRequest(t, r, http.MethodDelete, "/api/cron/digest", nil)
+	require.Equal(t, http.StatusOK, code)
+
+	code, _ = apiRequest(t, r, http.MethodGet, "/api/cron/digest", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+type apiTestRunner struct{}
+
+func (apiTestRunner) Run(_ context.Context, _ string, prompt string) (string, error) {
+	return "echo: " + prompt, nil
+}
+
+func TestAPIRoutes_Background(t *testing.T) {
+	r := newAPITestRouter(t, func(*App) apiResources {
+		return apiResources{bg: background.NewManager(apiTestRunner{}, nil, 2, time.Minute, zap.NewNop().Sugar())}
+	})
+
+	code, resp := apiRequest(t, r, http.MethodPost, "/api/bg", map[string]string{"prompt": "hello"})
+	require.Equal(t, http.StatusAccepted, code, resp)
+	id, _ := resp["id"].(string)
+	require.NotEmpty(t, id)
+
+	require.Eventually(t, func() bool {
+		_, resp = apiRequest(t, r, http.MethodGet, "/api/bg/"+id, nil)
+		return resp["status"] == "done"
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "echo: hello", resp["result"])
+
+	code, _ = apiRequest(t, r, http.MethodPost, "/api/bg/"+id+"/cancel", nil)
+	assert.Equal(t, http.StatusConflict, code)
+
+	code, _ = apiRequest(t, r, http.MethodGet, "/api/bg/missing", nil)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = apiRequest(t, r, http.MethodPost, "/api/bg", map[string]string{"prompt": " "})
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestAPIRoutes_BackgroundDelivery(t *testing.T) {
+	r := newAPITestRouter(t, func(*App) apiResources {
+		return apiResources{
+			bg:          background.NewManager(apiTestRunner{}, nil, 2, time.Minute, zap.NewNop().Sugar()),
+			bgDeliverTo: []string{"telegram:42"},
+		}
+	})
+
+	tests := []struct {
+		give     map[string]string
+		wantCode int
+	}{
+		{give: map[string]string{"prompt": "hi", "channel": "telegram:42"}, wantCode: http.StatusAccepted},
+		{give: map[string]string{"prompt": "hi", "channel": "telegram:99"}, wantCode: http.StatusForbidden},
+		{give: map[string]string{"prompt": "hi", "session": "discord:someone-else"}, wantCode: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		code, resp := apiRequest(t, r, http.MethodPost, "/api/bg", tt.give)
+		assert.Equal(t, tt.wantCode, code, "%v: %v", tt.give, resp)
+	}
+}
+
+func TestAPIRoutes_RequiresJSON(t *testing.T) {
+	r := newAPITestRouter(t, func(*App) apiResources {
+		return apiResources{bg: background.NewManager(apiTestRunner{}, nil, 2, time.Minute, zap.NewNop().Sugar())}
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/bg", strings.NewReader(`{"prompt":"run rm -rf ~"}`))
+	req.Header.Set("Content-Type", "text/plain")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+}
+
+func TestAPIRoutes_Memory(t *testing.T) {
+	r := newAPITestRouter(t, func(a *App) apiResources {
+		client := a.Store.(*session.EntStore).Client()
+		return apiResources{memory: memory.NewStore(client, zap.NewNop().Sugar())}
+	})
+
+	code, resp := apiRequest(t, r, http.MethodPost, "/api/memory/pin", map[string]string{
+		"session": "telegram:1:1",
+		"content": "The user prefers metric units",
+	})
+	require.Equal(t, http.StatusCreated, code, resp)
+	id, _ := resp["id"].(string)
+	assert.Equal(t, true, resp["pinned"])
+
+	code, resp = apiRequest(t, r, http.MethodGet, "/api/memory?kind=pinned", nil)
+	require.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), resp["count"])
+
+	code, resp = apiRequest(t, r, http.MethodPatch, "/api/memory/"+id, map[string]interface{}{
+		"content": "The user prefers imperial units",
+		"pinned":  false,
+	})
+	require.Equal(t, http.StatusOK, code, resp)
+	assert.Equal(t, "The user prefers imperial units", resp["content"])
+	assert.Equal(t, false, resp["pinned"])
+
+	code, _ = apiRequest(t, r, http.MethodGet, "/api/memory", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = apiRequest(t, r, http.MethodDelete, "/api/memory/"+id, nil)
+	require.Equal(t, http.StatusOK, code)
+
+	code, _ = apiRequest(t, r, http.MethodGet, "/api/memory/"+id, nil)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = apiRequest(t, r, http.MethodGet, "/api/memory/not-a-uuid", nil)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestOpenAPIDocument_CoversRoutes(t *testing.T) {
+	var doc struct {
+		Paths map[string]map[string]interface{} `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(openAPIDocument, &doc))
+
+	r := chi.NewRouter()
+	registerAPIRoutes(r, apiResources{})
+	err := chi.Walk(r, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		path := strings.TrimSuffix(route, "/")
+		ops, ok := doc.Paths[path]
+		if assert.True(t, ok, "path %s missing from OpenAPI document", path) {
+			assert.Contains(t, ops, strings.ToLower(method), "operation %s %s missing", method, path)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
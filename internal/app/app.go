@@ -3,8 +3,6 @@ package app
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
 	"sync"
 	"time"
 
@@ -17,13 +15,14 @@ import (
 	"github.com/langoai/lango/internal/config"
 	"github.com/langoai/lango/internal/lifecycle"
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/provider"
 	"github.com/langoai/lango/internal/sandbox"
 	"github.com/langoai/lango/internal/security"
 	"github.com/langoai/lango/internal/session"
+	toolpolicy "github.com/langoai/lango/internal/policy"
 	"github.com/langoai/lango/internal/toolchain"
 	"github.com/langoai/lango/internal/wallet"
 	"github.com/langoai/lango/internal/tools/browser"
-	"github.com/langoai/lango/internal/tools/filesystem"
 	x402pkg "github.com/langoai/lango/internal/x402"
 )
 
@@ -42,6 +41,9 @@ func New(boot *bootstrap.Result) (*App, error) {
 	if err != nil {
 		return nil, fmt.Errorf("create supervisor: %w", err)
 	}
+	app.listModels = func(ctx context.Context) ([]provider.ModelInfo, error) {
+		return sv.ListModels(ctx, cfg.Agent.Provider)
+	}
 
 	// 2. Session Store — reuse the DB client opened during bootstrap.
 	store, err := initSessionStore(cfg, boot)
@@ -58,19 +60,16 @@ func New(boot *bootstrap.Result) (*App, error) {
 	app.Crypto = crypto
 	app.Keys = keys
 	app.Secrets = secrets
+	if secrets != nil {
+		backend, err := security.NewSecretBackend(cfg.Security)
+		if err != nil {
+			return nil, fmt.Errorf("secrets backend: %w", err)
+		}
+		secrets.SetBackend(backend)
+	}
 
 	// 4. Base tools (exec + filesystem + optional browser)
-	// Block agent access to the ~/.lango/ directory.
-	var blockedPaths []string
-	if home, err := os.UserHomeDir(); err == nil {
-		blockedPaths = append(blockedPaths,
-			filepath.Join(home, ".lango")+string(os.PathSeparator))
-	}
-	fsConfig := filesystem.Config{
-		MaxReadSize:  cfg.Tools.Filesystem.MaxReadSize,
-		AllowedPaths: cfg.Tools.Filesystem.AllowedPaths,
-		BlockedPaths: blockedPaths,
-	}
+	fsConfig := buildFilesystemConfig(cfg, store)
 
 	var browserSM *browser.SessionManager
 	if cfg.Tools.Browser.Enabled {
@@ -111,6 +110,8 @@ func New(boot *bootstrap.Result) (*App, error) {
 		tools = append(tools, buildSecretsTools(app.Secrets, refs, scanner)...)
 		logger().Info("secrets tools registered")
 	}
+	wireSecrets(cfg.Security.Secrets, app.Secrets, refs)
+	sv.SetSecretRefs(refs)
 
 	// 5d. Graph Store (optional) — initialized before knowledge so GraphEngine can be wired.
 	gc := initGraphStore(cfg)
@@ -147,6 +148,9 @@ func New(boot *bootstrap.Result) (*App, error) {
 		app.MemoryBuffer = mc.buffer
 	}
 
+	// 5b'. Secret scrubbing of persisted messages, memory, knowledge and triples.
+	wireScrubber(cfg, newSecretScrubber(cfg.Security.Scrub, scanner, refs, app.Secrets), store, kc, mc, gc)
+
 	// 5c. Embedding / RAG (optional)
 	ec := initEmbedding(cfg, boot.RawDB, kc, mc)
 	if ec != nil {
@@ -172,6 +176,8 @@ func New(boot *bootstrap.Result) (*App, error) {
 	if lc != nil {
 		app.LibrarianInquiryStore = lc.inquiryStore
 		app.LibrarianProactiveBuffer = lc.proactiveBuffer
+		app.LibrarianNotifier = lc.notifier
+		app.LibrarianResolver = lc.resolver
 	}
 
 	// 5e. Graph tools (optional)
@@ -242,6 +248,12 @@ func New(boot *bootstrap.Result) (*App, error) {
 		logger().Info("workflow tools registered")
 	}
 
+	// 5l-2. Secret expiry warnings (optional)
+	app.secretExpiry = initSecretExpiry(cfg.Security.Secrets.Expiry, app.Secrets, app)
+
+	// 5m. Usage quotas (optional)
+	app.Quota = initQuota(cfg, store)
+
 	// 6. Auth
 	auth := initAuth(cfg, store)
 
@@ -265,29 +277,55 @@ func New(boot *bootstrap.Result) (*App, error) {
 	}
 	app.ApprovalProvider = composite
 
+	// Grants and approval decisions are persisted when the session store
+	// is backed by ent; otherwise grants are kept in memory.
 	grantStore := approval.NewGrantStore()
+	var approvalAudit approval.AuditRecorder
+	if entStore, ok := store.(*session.EntStore); ok {
+		approvalStore := approval.NewEntStore(entStore.Client())
+		grantStore = approval.NewGrantStoreWithRepository(approvalStore)
+		approvalAudit = approvalStore
+	}
+	if scope := cfg.Security.Interceptor.GrantScope; scope != "" {
+		grantStore.SetScope(scope)
+	}
+	grantStore.SetTTL(cfg.Security.Interceptor.GrantTTL)
 	// P2P grants expire after 1 hour to limit the window of implicit trust.
-	if cfg.P2P.Enabled {
+	if cfg.P2P.Enabled && (cfg.Security.Interceptor.GrantTTL == 0 || cfg.Security.Interceptor.GrantTTL > time.Hour) {
 		grantStore.SetTTL(time.Hour)
 	}
+	if n := grantStore.CleanExpired(); n > 0 {
+		logger().Infow("expired approval grants removed", "count", n)
+	}
 	app.GrantStore = grantStore
 
 	policy := cfg.Security.Interceptor.ApprovalPolicy
 	if policy == "" {
 		policy = config.ApprovalPolicyDangerous
 	}
-	if policy != config.ApprovalPolicyNone {
+	rules, err := toolpolicy.New(cfg.Security.Interceptor.Policies)
+	if err != nil {
+		return nil, fmt.Errorf("compile approval policies: %w", err)
+	}
+	// Prompt-injection screening of untrusted tool output runs inside
+	// approval, on the result of an approved call.
+	guard := initInjectionGuard(cfg, sv)
+	if guard != nil {
+		tools = toolchain.ChainAll(tools, toolchain.WithInjectionGuard(guard, cfg.Security.Injection.Tools))
+	}
+
+	if policy != config.ApprovalPolicyNone || !rules.Empty() {
 		var limiter wallet.SpendingLimiter
 		if pc != nil {
 			limiter = pc.limiter
 		}
 		tools = toolchain.ChainAll(tools,
-			toolchain.WithApproval(cfg.Security.Interceptor, composite, grantStore, limiter))
-		logger().Infow("tool approval enabled", "policy", string(policy))
+			toolchain.WithApproval(cfg.Security.Interceptor, composite, grantStore, limiter, rules, approvalAudit))
+		logger().Infow("tool approval enabled", "policy", string(policy), "rules", len(cfg.Security.Interceptor.Policies))
 	}
 
 	// 9. ADK Agent (scanner is passed for output-side secret scanning)
-	adkAgent, err := initAgent(context.Background(), sv, cfg, store, tools, kc, mc, ec, gc, scanner, registry, lc)
+	adkAgent, err := initAgent(context.Background(), sv, cfg, store, tools, kc, mc, ec, gc, scanner, guard, registry, lc)
 	if err != nil {
 		return nil, fmt.Errorf("create agent: %w", err)
 	}
@@ -406,7 +444,14 @@ func New(boot *bootstrap.Result) (*App, error) {
 		logger().Info("P2P REST API routes registered")
 	}
 
+	// 9d. Automation REST API (authenticated; OpenAPI document is public)
+	app.Gateway.Router().Get("/api/openapi.json", handleOpenAPI)
+	if app.Gateway.MountAPI(app.apiRoutes) {
+		logger().Info("automation REST API routes registered")
+	}
+
 	// 10. Channels
+	app.Transcriber = initTranscriber(cfg)
 	if err := app.initChannels(); err != nil {
 		logger().Errorw("initialize channels", "error", err)
 	}
@@ -436,6 +481,10 @@ func New(boot *bootstrap.Result) (*App, error) {
 		})
 	}
 
+	if app.Quota != nil {
+		app.Gateway.SetRequestGuard(app.gatewayGuard)
+	}
+
 	// 16. Register lifecycle components for ordered startup/shutdown.
 	app.registerLifecycleComponents()
 
@@ -528,6 +577,20 @@ func (a *App) registerLifecycleComponents() {
 		), lifecycle.PriorityAutomation)
 	}
 
+	// Secret Expiry Monitor — Start(ctx, *sync.WaitGroup) / Stop().
+	if a.secretExpiry != nil {
+		reg.Register(lifecycle.NewFuncComponent("secret-expiry",
+			func(ctx context.Context, wg *sync.WaitGroup) error {
+				a.secretExpiry.Start(ctx, wg)
+				return nil
+			},
+			func(_ context.Context) error {
+				a.secretExpiry.Stop()
+				return nil
+			},
+		), lifecycle.PriorityAutomation)
+	}
+
 	// Channels — each runs blocking in a goroutine, Stop() to signal.
 	for i, ch := range a.Channels {
 		ch := ch // capture for closure
@@ -620,6 +683,15 @@ func registerConfigSecrets(scanner *agent.SecretScanner, cfg *config.Config) {
 	register("slack.botToken", cfg.Channels.Slack.BotToken)
 	register("slack.appToken", cfg.Channels.Slack.AppToken)
 	register("slack.signingSecret", cfg.Channels.Slack.SigningSecret)
+	register("matrix.accessToken", cfg.Channels.Matrix.AccessToken)
+	register("email.imap.password", cfg.Channels.Email.IMAP.Password)
+	register("email.smtp.password", cfg.Channels.Email.SMTP.Password)
+	for _, ep := range cfg.Channels.Webhook.Endpoints {
+		register("webhook."+ep.Name+".secret", ep.Secret)
+	}
+	for _, t := range cfg.Channels.Webhook.Targets {
+		register("webhook."+t.Name+".secret", t.Secret)
+	}
 
 	// Auth provider secrets
 	for id, a := range cfg.Auth.Providers {
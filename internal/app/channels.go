@@ -5,119 +5,107 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/langoai/lango/internal/adk"
 	"github.com/langoai/lango/internal/approval"
-	"github.com/langoai/lango/internal/channels/discord"
-	"github.com/langoai/lango/internal/channels/slack"
-	"github.com/langoai/lango/internal/channels/telegram"
+	"github.com/langoai/lango/internal/channels"
+	_ "github.com/langoai/lango/internal/channels/all"
+	"github.com/langoai/lango/internal/provider"
+	"github.com/langoai/lango/internal/quota"
 	"github.com/langoai/lango/internal/session"
 	"github.com/langoai/lango/internal/types"
 )
 
-// initChannels initializes all configured channels and wires them to the agent
+// initChannels creates every enabled channel from the channel registry and
+// wires it to the agent.
 func (a *App) initChannels() error {
-	// Telegram
-	if a.Config.Channels.Telegram.Enabled {
-		tgConfig := telegram.Config{
-			BotToken:           a.Config.Channels.Telegram.BotToken,
-			Allowlist:          a.Config.Channels.Telegram.Allowlist,
-			ApprovalTimeoutSec: a.Config.Security.Interceptor.ApprovalTimeoutSec,
-		}
-		tgChannel, err := telegram.New(tgConfig)
-		if err != nil {
-			logger().Errorw("create telegram channel", "error", err)
-		} else {
-			tgChannel.SetHandler(func(ctx context.Context, msg *telegram.IncomingMessage) (*telegram.OutgoingMessage, error) {
-				return a.handleTelegramMessage(ctx, msg)
-			})
-			a.Channels = append(a.Channels, tgChannel)
-			if composite, ok := a.ApprovalProvider.(*approval.CompositeProvider); ok {
-				composite.Register(tgChannel.GetApprovalProvider())
-			}
-			logger().Info("telegram channel initialized")
-		}
-	}
+	built := channels.Default().Build(a.Config, func(t types.ChannelType, err error) {
+		logger().Errorw("create channel", "channel", t, "error", err)
+	})
+	commands := a.commandRouter()
 
-	// Discord
-	if a.Config.Channels.Discord.Enabled {
-		dcConfig := discord.Config{
-			BotToken:           a.Config.Channels.Discord.BotToken,
-			ApplicationID:      a.Config.Channels.Discord.ApplicationID,
-			AllowedGuilds:      a.Config.Channels.Discord.AllowedGuilds,
-			ApprovalTimeoutSec: a.Config.Security.Interceptor.ApprovalTimeoutSec,
+	for _, ch := range built {
+		ch.SetHandler(a.channelHandler(ch, commands))
+		if cc, ok := ch.(channels.CommandChannel); ok {
+			cc.SetCommands(commands.Commands())
 		}
-		dcChannel, err := discord.New(dcConfig)
-		if err != nil {
-			logger().Errorw("create discord channel", "error", err)
-		} else {
-			dcChannel.SetHandler(func(ctx context.Context, msg *discord.IncomingMessage) (*discord.OutgoingMessage, error) {
-				return a.handleDiscordMessage(ctx, msg)
-			})
-			a.Channels = append(a.Channels, dcChannel)
-			if composite, ok := a.ApprovalProvider.(*approval.CompositeProvider); ok {
-				composite.Register(dcChannel.GetApprovalProvider())
-			}
-			logger().Info("discord channel initialized")
+		a.Channels = append(a.Channels, ch)
+		if composite, ok := a.ApprovalProvider.(*approval.CompositeProvider); ok {
+			composite.Register(ch.ApprovalProvider())
 		}
-	}
-
-	// Slack
-	if a.Config.Channels.Slack.Enabled {
-		slConfig := slack.Config{
-			BotToken:           a.Config.Channels.Slack.BotToken,
-			AppToken:           a.Config.Channels.Slack.AppToken,
-			SigningSecret:      a.Config.Channels.Slack.SigningSecret,
-			ApprovalTimeoutSec: a.Config.Security.Interceptor.ApprovalTimeoutSec,
+		if ic, ok := ch.(channels.InquiryChannel); ok {
+			a.registerInquiryProvider(ic.InquiryProvider())
 		}
-		slChannel, err := slack.New(slConfig)
-		if err != nil {
-			logger().Errorw("create slack channel", "error", err)
-		} else {
-			slChannel.SetHandler(func(ctx context.Context, msg *slack.IncomingMessage) (*slack.OutgoingMessage, error) {
-				return a.handleSlackMessage(ctx, msg)
-			})
-			a.Channels = append(a.Channels, slChannel)
-			if composite, ok := a.ApprovalProvider.(*approval.CompositeProvider); ok {
-				composite.Register(slChannel.GetApprovalProvider())
-			}
-			logger().Info("slack channel initialized")
+		if rc, ok := ch.(channels.RelayChannel); ok {
+			rc.SetSender(newChannelSender(a))
 		}
+		if rc, ok := ch.(channels.RouteChannel); ok && a.Gateway != nil {
+			rc.RegisterRoutes(a.Gateway.Router())
+		}
+		logger().Infow("channel initialized", "channel", ch.Type())
 	}
 
 	return nil
 }
 
-func (a *App) handleTelegramMessage(ctx context.Context, msg *telegram.IncomingMessage) (*telegram.OutgoingMessage, error) {
-	sessionKey := fmt.Sprintf("%s:%d:%d", types.ChannelTelegram, msg.ChatID, msg.UserID)
-	response, err := a.runAgent(ctx, sessionKey, msg.Text)
-	if err != nil {
-		return nil, err
+// registerInquiryProvider routes proactive librarian inquiries to the channel
+// and lets its quick-reply buttons resolve them.
+func (a *App) registerInquiryProvider(p channels.InquiryProvider) {
+	if a.LibrarianNotifier == nil || a.LibrarianResolver == nil {
+		return
 	}
-	return &telegram.OutgoingMessage{Text: response}, nil
+	p.SetResolver(a.LibrarianResolver)
+	a.LibrarianNotifier.Register(p)
 }
 
-func (a *App) handleDiscordMessage(ctx context.Context, msg *discord.IncomingMessage) (*discord.OutgoingMessage, error) {
-	sessionKey := fmt.Sprintf("%s:%s:%s", types.ChannelDiscord, msg.ChannelID, msg.AuthorID)
-	response, err := a.runAgent(ctx, sessionKey, msg.Content)
-	if err != nil {
-		return nil, err
+// channelHandler returns the handler that runs the agent for messages from
+// ch, passing their attachments along and streaming the reply when the
+// channel supports it. Slash commands are answered by commands instead.
+func (a *App) channelHandler(ch channels.Channel, commands *channels.CommandRouter) channels.Handler {
+	return func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		if reply, handled, err := commands.Dispatch(ctx, msg); handled {
+			return reply, err
+		}
+		subject := quota.Subject{Channel: string(msg.Channel), UserID: msg.UserID}
+		ctx, over := a.admitRequest(ctx, subject, msg.SessionKey())
+		if over != "" {
+			return &channels.OutgoingMessage{Text: over, Thread: msg.Thread}, nil
+		}
+		input, media := a.mediaInput(ctx, ch, msg)
+		response, err := a.runAgent(ctx, msg.SessionKey(), input, a.streamHandlers(msg.Stream), media...)
+		if err != nil {
+			return nil, err
+		}
+		return &channels.OutgoingMessage{Text: response, Thread: msg.Thread}, nil
 	}
-	return &discord.OutgoingMessage{Content: response}, nil
 }
 
-func (a *App) handleSlackMessage(ctx context.Context, msg *slack.IncomingMessage) (*slack.OutgoingMessage, error) {
-	sessionKey := fmt.Sprintf("%s:%s:%s", types.ChannelSlack, msg.ChannelID, msg.UserID)
-	response, err := a.runAgent(ctx, sessionKey, msg.Text)
-	if err != nil {
-		return nil, err
+// streamHandlers feeds the agent's progress into a channel's reply stream.
+// Tool calls are shown as a status line only if configured.
+func (a *App) streamHandlers(s *channels.Stream) adk.StreamHandlers {
+	cfg := a.Config.Channels.Streaming
+	if s == nil || !cfg.Enabled {
+		return adk.StreamHandlers{}
+	}
+	h := adk.StreamHandlers{
+		OnChunk: func(chunk string) {
+			s.Status("")
+			s.Write(chunk)
+		},
 	}
-	return &slack.OutgoingMessage{Text: response}, nil
+	if cfg.ShowToolCalls {
+		h.OnToolCall = func(name string) {
+			s.Status(fmt.Sprintf("running %s…", name))
+		}
+	}
+	return h
 }
 
 // runAgent executes the agent and aggregates the response.
 // It injects the session key into the context so that downstream components
 // (approval providers, learning engine, etc.) can route by channel.
 // After each agent turn, buffers (memory, analysis) are triggered for async processing.
-func (a *App) runAgent(ctx context.Context, sessionKey, input string) (string, error) {
+// Progress is reported to h while the agent runs.
+func (a *App) runAgent(ctx context.Context, sessionKey, input string, h adk.StreamHandlers, media ...provider.ContentPart) (string, error) {
 	timeout := a.Config.Agent.RequestTimeout
 	if timeout <= 0 {
 		timeout = 5 * time.Minute
@@ -142,7 +130,10 @@ func (a *App) runAgent(ctx context.Context, sessionKey, input string) (string, e
 	defer warnTimer.Stop()
 
 	ctx = session.WithSessionKey(ctx, sessionKey)
-	response, err := a.Agent.RunAndCollect(ctx, sessionKey, input)
+	if model := a.sessionModel(sessionKey); model != "" {
+		ctx = adk.WithModelOverride(ctx, model)
+	}
+	response, err := a.Agent.RunAndCollectStreaming(ctx, sessionKey, input, h, media...)
 
 	// Trigger async buffers after agent turn regardless of error.
 	if a.MemoryBuffer != nil {
@@ -0,0 +1,88 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+)
+
+// recordingEditor records the latest text of a streamed reply.
+type recordingEditor struct {
+	mu   sync.Mutex
+	text string
+}
+
+func (e *recordingEditor) Post(_ context.Context, text string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.text = text
+	return "1", nil
+}
+
+func (e *recordingEditor) Edit(_ context.Context, _ string, text string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.text = text
+	return nil
+}
+
+func (e *recordingEditor) Delete(context.Context, string) error {
+	return nil
+}
+
+func (e *recordingEditor) shown() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.text
+}
+
+func TestStreamHandlers(t *testing.T) {
+	tests := []struct {
+		give      config.StreamingConfig
+		wantChunk bool
+		wantTool  bool
+	}{
+		{give: config.StreamingConfig{}},
+		{give: config.StreamingConfig{Enabled: true}, wantChunk: true},
+		{give: config.StreamingConfig{Enabled: true, ShowToolCalls: true}, wantChunk: true, wantTool: true},
+	}
+	for _, tt := range tests {
+		cfg := &config.Config{}
+		cfg.Channels.Streaming = tt.give
+		a := &App{Config: cfg}
+
+		h := a.streamHandlers(channels.NewStream(context.Background(), &recordingEditor{}, channels.StreamOptions{}))
+		if (h.OnChunk != nil) != tt.wantChunk || (h.OnToolCall != nil) != tt.wantTool {
+			t.Errorf("%+v: unexpected handlers (chunk %v, tool %v)", tt.give, h.OnChunk != nil, h.OnToolCall != nil)
+		}
+		if h := a.streamHandlers(nil); h.OnChunk != nil || h.OnToolCall != nil {
+			t.Errorf("%+v: expected no handlers without a stream", tt.give)
+		}
+	}
+}
+
+func TestStreamHandlers_ToolStatus(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Channels.Streaming = config.StreamingConfig{Enabled: true, ShowToolCalls: true}
+	a := &App{Config: cfg}
+	ed := &recordingEditor{}
+	s := channels.NewStream(context.Background(), ed, channels.StreamOptions{Interval: time.Millisecond})
+	defer s.Stop()
+
+	h := a.streamHandlers(s)
+	h.OnChunk("Checking the logs.")
+	h.OnToolCall("exec")
+
+	want := "Checking the logs.\n\n_running exec…_"
+	deadline := time.Now().Add(time.Second)
+	for ed.shown() != want {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected %q, got %q", want, ed.shown())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,381 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/background"
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/quota"
+	"github.com/langoai/lango/internal/session"
+)
+
+// sessionModelKey is the session metadata key holding a model chosen with
+// /model. It overrides the configured agent model for that session only.
+const sessionModelKey = "model"
+
+// commandRouter builds the slash commands available in every channel.
+func (a *App) commandRouter() *channels.CommandRouter {
+	return channels.NewCommandRouter(
+		channels.Command{
+			Name:        "reset",
+			Description: "Start a new conversation, forgetting this session's history",
+			Run:         a.cmdReset,
+		},
+		channels.Command{
+			Name:        "model",
+			Description: "Show or switch the model for this session",
+			Usage:       "[model-id|default]",
+			Run:         a.cmdModel,
+		},
+		channels.Command{
+			Name:        "bg",
+			Description: "List, inspect or cancel this session's background tasks",
+			Usage:       "[task-id|cancel <task-id>]",
+			Run:         a.cmdBackground,
+		},
+		channels.Command{
+			Name:        "cron",
+			Description: "List, pause or resume scheduled jobs",
+			Usage:       "[pause|resume <job>]",
+			Run:         a.cmdCron,
+		},
+		channels.Command{
+			Name:        "memory",
+			Description: "Forget one or all observations of this session",
+			Usage:       "forget [id|--pinned]",
+			Run:         a.cmdMemory,
+		},
+		channels.Command{
+			Name:        "status",
+			Description: "Show the model, session and task status",
+			Run:         a.cmdStatus,
+		},
+	)
+}
+
+// loadSession returns the stored session for key, or nil if there is none.
+func (a *App) loadSession(key string) (*session.Session, error) {
+	sess, err := a.Store.Get(key)
+	if errors.Is(err, session.ErrSessionNotFound) || errors.Is(err, session.ErrSessionExpired) {
+		return nil, nil
+	}
+	return sess, err
+}
+
+// sessionModel returns the model chosen for a session with /model, if any.
+func (a *App) sessionModel(key string) string {
+	sess, err := a.loadSession(key)
+	if err != nil || sess == nil {
+		return ""
+	}
+	return sess.Metadata[sessionModelKey]
+}
+
+// cmdReset deletes the session history and its approval grants. A model
+// chosen with /model is kept.
+func (a *App) cmdReset(_ context.Context, msg *channels.IncomingMessage, _ []string) (string, error) {
+	key := msg.SessionKey()
+	sess, err := a.loadSession(key)
+	if err != nil {
+		return "", err
+	}
+	if sess != nil {
+		if err := a.Store.Delete(key); err != nil {
+			return "", fmt.Errorf("delete session: %w", err)
+		}
+		if model := sess.Metadata[sessionModelKey]; model != "" {
+			kept := &session.Session{Key: key, Metadata: map[string]string{sessionModelKey: model}}
+			if err := a.Store.Create(kept); err != nil {
+				return "", fmt.Errorf("keep session model: %w", err)
+			}
+		}
+	}
+	if a.GrantStore != nil {
+		a.GrantStore.RevokeSession(key)
+	}
+	return "Session reset. The next message starts a new conversation.", nil
+}
+
+// cmdModel shows the session model, or switches it for the session. The
+// model must be offered by the configured provider; when the provider cannot
+// list its models, any model is accepted.
+func (a *App) cmdModel(ctx context.Context, msg *channels.IncomingMessage, args []string) (string, error) {
+	key := msg.SessionKey()
+	if len(args) == 0 {
+		if model := a.sessionModel(key); model != "" {
+			return fmt.Sprintf("This session uses `%s` (default `%s`).", model, a.Config.Agent.Model), nil
+		}
+		return fmt.Sprintf("This session uses the default model `%s`.", a.Config.Agent.Model), nil
+	}
+	if len(args) > 1 {
+		return "Usage: `/model [model-id|default]`", nil
+	}
+
+	model := args[0]
+	if model == "default" || model == a.Config.Agent.Model {
+		model = ""
+	}
+	if model != "" {
+		if reply := a.checkModel(ctx, model); reply != "" {
+			return reply, nil
+		}
+	}
+
+	sess, err := a.loadSession(key)
+	if err != nil {
+		return "", err
+	}
+	if sess == nil {
+		sess = &session.Session{Key: key, Metadata: map[string]string{}}
+		if err := a.Store.Create(sess); err != nil {
+			return "", fmt.Errorf("create session: %w", err)
+		}
+	}
+	if sess.Metadata == nil {
+		sess.Metadata = map[string]string{}
+	}
+	if model == "" {
+		delete(sess.Metadata, sessionModelKey)
+	} else {
+		sess.Metadata[sessionModelKey] = model
+	}
+	if err := a.Store.Update(sess); err != nil {
+		return "", fmt.Errorf("update session: %w", err)
+	}
+
+	if model == "" {
+		return fmt.Sprintf("Switched back to the default model `%s`.", a.Config.Agent.Model), nil
+	}
+	return fmt.Sprintf("Switched this session to `%s`.", model), nil
+}
+
+// checkModel returns a reply explaining why model cannot be used, or "" if
+// the provider offers it or cannot list its models.
+func (a *App) checkModel(ctx context.Context, model string) string {
+	if a.listModels == nil {
+		return ""
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	models, err := a.listModels(ctx)
+	if err != nil || len(models) == 0 {
+		logger().Debugw("list models for /model", "error", err)
+		return ""
+	}
+	ids := make([]string, 0, len(models))
+	for _, m := range models {
+		if m.ID == model {
+			return ""
+		}
+		ids = append(ids, m.ID)
+	}
+	sort.Strings(ids)
+	if len(ids) > 10 {
+		ids = append(ids[:10], "…")
+	}
+	return fmt.Sprintf("Provider `%s` does not offer `%s`. Available: %s", a.Config.Agent.Provider, model, strings.Join(ids, ", "))
+}
+
+// cmdBackground lists the background tasks started from the session, shows
+// one of them or cancels it.
+func (a *App) cmdBackground(_ context.Context, msg *channels.IncomingMessage, args []string) (string, error) {
+	if a.BackgroundManager == nil {
+		return "Background tasks are not enabled.", nil
+	}
+	key := msg.SessionKey()
+
+	// own returns the task with the given ID if it was started from the session.
+	own := func(id string) (*background.TaskSnapshot, error) {
+		snap, err := a.BackgroundManager.Status(id)
+		if err != nil || snap.OriginSession != key {
+			return nil, fmt.Errorf("no background task %q in this session", id)
+		}
+		return snap, nil
+	}
+
+	switch {
+	case len(args) == 0:
+		var b strings.Builder
+		for _, snap := range a.BackgroundManager.List() {
+			if snap.OriginSession != key {
+				continue
+			}
+			fmt.Fprintf(&b, "\n`%s` %s — %s", snap.ID, snap.Status, truncate(snap.Prompt, 60))
+		}
+		if b.Len() == 0 {
+			return "No background tasks in this session.", nil
+		}
+		return "Background tasks:" + b.String(), nil
+
+	case args[0] == "cancel" && len(args) == 2:
+		if _, err := own(args[1]); err != nil {
+			return "", err
+		}
+		if err := a.BackgroundManager.Cancel(args[1]); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Cancelled background task `%s`.", args[1]), nil
+
+	case len(args) == 1:
+		snap, err := own(args[0])
+		if err != nil {
+			return "", err
+		}
+		text := fmt.Sprintf("Task `%s` is %s.", snap.ID, snap.Status)
+		switch {
+		case snap.Error != "":
+			text += "\n\nError: " + snap.Error
+		case snap.Result != "":
+			text += "\n\n" + snap.Result
+		}
+		return text, nil
+	}
+	return "Usage: `/bg [task-id|cancel <task-id>]`", nil
+}
+
+// isAdmin reports whether the sender of msg is listed in channels.admins.
+func (a *App) isAdmin(msg *channels.IncomingMessage) bool {
+	return slices.Contains(a.Config.Channels.Admins, string(msg.Channel)+":"+msg.UserID)
+}
+
+// cmdCron lists the scheduled jobs, or pauses or resumes one by ID or name.
+// Jobs are shared by all users, so only admins may pause or resume them.
+func (a *App) cmdCron(ctx context.Context, msg *channels.IncomingMessage, args []string) (string, error) {
+	if a.CronScheduler == nil {
+		return "Cron scheduling is not enabled.", nil
+	}
+	jobs, err := a.CronScheduler.ListJobs(ctx)
+	if err != nil {
+		return "", fmt.Errorf("list jobs: %w", err)
+	}
+
+	if len(args) == 0 {
+		if len(jobs) == 0 {
+			return "No scheduled jobs.", nil
+		}
+		var b strings.Builder
+		b.WriteString("Scheduled jobs:")
+		for _, job := range jobs {
+			state := "active"
+			if !job.Enabled {
+				state = "paused"
+			}
+			fmt.Fprintf(&b, "\n`%s` %s — %s %s", job.Name, state, job.ScheduleType, job.Schedule)
+			if job.Enabled && job.NextRunAt != nil {
+				fmt.Fprintf(&b, ", next %s", job.NextRunAt.Format("2006-01-02 15:04"))
+			}
+		}
+		return b.String(), nil
+	}
+	if len(args) != 2 || (args[0] != "pause" && args[0] != "resume") {
+		return "Usage: `/cron [pause|resume <job>]`", nil
+	}
+	if !a.isAdmin(msg) {
+		return "Only admins can pause or resume jobs (see `channels.admins`).", nil
+	}
+
+	for _, job := range jobs {
+		if job.ID != args[1] && job.Name != args[1] {
+			continue
+		}
+		if args[0] == "pause" {
+			if err := a.CronScheduler.PauseJob(ctx, job.ID); err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Paused job `%s`.", job.Name), nil
+		}
+		if err := a.CronScheduler.ResumeJob(ctx, job.ID); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Resumed job `%s`.", job.Name), nil
+	}
+	return "", fmt.Errorf("no job %q", args[1])
+}
+
+// cmdMemory forgets one observation or reflection of the session, or all
+// of them. Pinned facts are kept unless --pinned is given.
+func (a *App) cmdMemory(ctx context.Context, msg *channels.IncomingMessage, args []string) (string, error) {
+	if a.MemoryStore == nil {
+		return "Observational memory is not enabled.", nil
+	}
+	if len(args) == 0 || args[0] != "forget" || len(args) > 2 {
+		return "Usage: `/memory forget [id|--pinned]`", nil
+	}
+	key := msg.SessionKey()
+
+	if len(args) == 1 || args[1] == "--pinned" {
+		includePinned := len(args) == 2
+		n, err := a.MemoryStore.ForgetSession(ctx, key, includePinned)
+		if err != nil {
+			return "", fmt.Errorf("forget session memory: %w", err)
+		}
+		if includePinned {
+			return fmt.Sprintf("Forgot %d entries of this session, including pinned facts.", n), nil
+		}
+		return fmt.Sprintf("Forgot %d entries of this session. Pinned facts were kept; use `/memory forget --pinned` to remove them too.", n), nil
+	}
+
+	id, err := uuid.Parse(args[1])
+	if err != nil {
+		return "", fmt.Errorf("invalid id %q", args[1])
+	}
+	entry, err := a.MemoryStore.GetEntry(ctx, id)
+	if err != nil || entry.SessionKey != key {
+		return "", fmt.Errorf("no memory entry %s in this session", id)
+	}
+	if _, err := a.MemoryStore.Forget(ctx, id); err != nil {
+		return "", fmt.Errorf("forget: %w", err)
+	}
+	return fmt.Sprintf("Forgot %s %s.", entry.Kind, id), nil
+}
+
+// cmdStatus summarizes the session and the automation state.
+func (a *App) cmdStatus(ctx context.Context, msg *channels.IncomingMessage, _ []string) (string, error) {
+	key := msg.SessionKey()
+	sess, err := a.loadSession(key)
+	if err != nil {
+		return "", err
+	}
+
+	model := a.Config.Agent.Model
+	messages := 0
+	if sess != nil {
+		if m := sess.Metadata[sessionModelKey]; m != "" {
+			model = m
+		}
+		messages = len(sess.History)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Model: `%s` (%s)\n", model, a.Config.Agent.Provider)
+	fmt.Fprintf(&b, "Session: `%s`, %d messages", key, messages)
+	if a.BackgroundManager != nil {
+		running := 0
+		for _, snap := range a.BackgroundManager.List() {
+			if snap.OriginSession == key && (snap.Status == background.Pending || snap.Status == background.Running) {
+				running++
+			}
+		}
+		fmt.Fprintf(&b, "\nBackground tasks: %d active", running)
+	}
+	if a.CronScheduler != nil {
+		if jobs, err := a.CronScheduler.ListJobs(ctx); err == nil {
+			fmt.Fprintf(&b, "\nScheduled jobs: %d", len(jobs))
+		}
+	}
+	if a.Quota != nil {
+		subject := quota.Subject{Channel: string(msg.Channel), UserID: msg.UserID}
+		if u, err := a.Quota.Today(ctx, subject); err == nil {
+			fmt.Fprintf(&b, "\nUsage today: %d requests, %d tokens, $%.4f", u.Requests, u.Tokens(), u.Cost)
+		}
+	}
+	return b.String(), nil
+}
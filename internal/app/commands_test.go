@@ -0,0 +1,200 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	cronpkg "github.com/langoai/lango/internal/cron"
+	"github.com/langoai/lango/internal/memory"
+	"github.com/langoai/lango/internal/provider"
+	"github.com/langoai/lango/internal/session"
+	"github.com/langoai/lango/internal/types"
+)
+
+func newCommandTestApp(t *testing.T) *App {
+	t.Helper()
+	store, err := session.NewEntStore(filepath.Join(t.TempDir(), "sessions.db"))
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	cfg := &config.Config{}
+	cfg.Agent.Provider = "openai"
+	cfg.Agent.Model = "gpt-4o"
+	return &App{Config: cfg, Store: store}
+}
+
+func dispatch(t *testing.T, r *channels.CommandRouter, msg *channels.IncomingMessage, text string) string {
+	t.Helper()
+	msg.Text = text
+	reply, handled, err := r.Dispatch(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("%s: %v", text, err)
+	}
+	if !handled {
+		t.Fatalf("%s: not handled", text)
+	}
+	return reply.Text
+}
+
+func TestCommands_Model(t *testing.T) {
+	a := newCommandTestApp(t)
+	r := a.commandRouter()
+	msg := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "2"}
+	key := msg.SessionKey()
+
+	if got := dispatch(t, r, msg, "/model"); !strings.Contains(got, "default model `gpt-4o`") {
+		t.Errorf("unexpected reply %q", got)
+	}
+
+	dispatch(t, r, msg, "/model gpt-4o-mini")
+	if got := a.sessionModel(key); got != "gpt-4o-mini" {
+		t.Errorf("expected session model gpt-4o-mini, got %q", got)
+	}
+	other := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "3"}
+	if got := a.sessionModel(other.SessionKey()); got != "" {
+		t.Errorf("expected no model for another session, got %q", got)
+	}
+
+	dispatch(t, r, msg, "/model default")
+	if got := a.sessionModel(key); got != "" {
+		t.Errorf("expected default model, got %q", got)
+	}
+}
+
+func TestCommands_Reset(t *testing.T) {
+	a := newCommandTestApp(t)
+	r := a.commandRouter()
+	msg := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "2"}
+	key := msg.SessionKey()
+
+	dispatch(t, r, msg, "/model gpt-4o-mini")
+	if err := a.Store.AppendMessage(key, session.Message{Role: types.RoleUser, Content: "hello"}); err != nil {
+		t.Fatalf("append: %v", err)
+	}
+
+	dispatch(t, r, msg, "/reset")
+
+	sess, err := a.Store.Get(key)
+	if err != nil {
+		t.Fatalf("get session: %v", err)
+	}
+	if len(sess.History) != 0 {
+		t.Errorf("expected empty history, got %d messages", len(sess.History))
+	}
+	if got := sess.Metadata[sessionModelKey]; got != "gpt-4o-mini" {
+		t.Errorf("expected model to survive reset, got %q", got)
+	}
+}
+
+func TestCommands_Disabled(t *testing.T) {
+	a := newCommandTestApp(t)
+	r := a.commandRouter()
+	msg := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "2"}
+
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "/bg", want: "not enabled"},
+		{give: "/cron", want: "not enabled"},
+		{give: "/memory forget", want: "not enabled"},
+		{give: "/status", want: "Model: `gpt-4o` (openai)"},
+	}
+	for _, tt := range tests {
+		if got := dispatch(t, r, msg, tt.give); !strings.Contains(got, tt.want) {
+			t.Errorf("%s: expected reply containing %q, got %q", tt.give, tt.want, got)
+		}
+	}
+}
+
+func TestCommands_ModelValidated(t *testing.T) {
+	a := newCommandTestApp(t)
+	a.listModels = func(context.Context) ([]provider.ModelInfo, error) {
+		return []provider.ModelInfo{{ID: "gpt-4o"}, {ID: "gpt-4o-mini"}}, nil
+	}
+	r := a.commandRouter()
+	msg := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "2"}
+
+	if got := dispatch(t, r, msg, "/model gpt-typo"); !strings.Contains(got, "does not offer `gpt-typo`") {
+		t.Errorf("unexpected reply %q", got)
+	}
+	if got := a.sessionModel(msg.SessionKey()); got != "" {
+		t.Errorf("expected unknown model not to be stored, got %q", got)
+	}
+
+	dispatch(t, r, msg, "/model gpt-4o-mini")
+	if got := a.sessionModel(msg.SessionKey()); got != "gpt-4o-mini" {
+		t.Errorf("expected session model gpt-4o-mini, got %q", got)
+	}
+}
+
+func TestCommands_CronRequiresAdmin(t *testing.T) {
+	a := newCommandTestApp(t)
+	client := a.Store.(*session.EntStore).Client()
+	a.CronScheduler = cronpkg.New(cronpkg.NewEntStore(client), nil, "UTC", 1, zap.NewNop().Sugar())
+	a.Config.Channels.Admins = []string{"telegram:admin"}
+	ctx := context.Background()
+	if err := a.CronScheduler.AddJob(ctx, cronpkg.Job{
+		Name: "digest", ScheduleType: "cron", Schedule: "0 9 * * *", Prompt: "news", Enabled: true,
+	}); err != nil {
+		t.Fatalf("add job: %v", err)
+	}
+	r := a.commandRouter()
+
+	user := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "2"}
+	if got := dispatch(t, r, user, "/cron pause digest"); !strings.Contains(got, "Only admins") {
+		t.Errorf("unexpected reply %q", got)
+	}
+	if got := dispatch(t, r, user, "/cron"); !strings.Contains(got, "`digest` active") {
+		t.Errorf("expected job to stay active, got %q", got)
+	}
+
+	admin := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "admin"}
+	if got := dispatch(t, r, admin, "/cron pause digest"); !strings.Contains(got, "Paused job") {
+		t.Errorf("unexpected reply %q", got)
+	}
+}
+
+func TestCommands_MemoryForgetKeepsPinned(t *testing.T) {
+	a := newCommandTestApp(t)
+	client := a.Store.(*session.EntStore).Client()
+	a.MemoryStore = memory.NewStore(client, zap.NewNop().Sugar())
+	var removed int
+	a.MemoryStore.SetEmbedRemoveCallback(func(string, string) { removed++ })
+	r := a.commandRouter()
+	msg := &channels.IncomingMessage{Channel: types.ChannelTelegram, ChatID: "1", UserID: "2"}
+	key := msg.SessionKey()
+	ctx := context.Background()
+
+	if err := a.MemoryStore.SaveObservation(ctx, memory.Observation{SessionKey: key, Content: "User asked about weather"}); err != nil {
+		t.Fatalf("save observation: %v", err)
+	}
+	if _, err := a.MemoryStore.PinFact(ctx, key, "User prefers Go"); err != nil {
+		t.Fatalf("pin: %v", err)
+	}
+
+	dispatch(t, r, msg, "/memory forget")
+	obs, err := a.MemoryStore.ListObservations(ctx, key)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(obs) != 1 || !obs[0].Pinned {
+		t.Errorf("expected only the pinned fact to remain, got %+v", obs)
+	}
+	if removed != 1 {
+		t.Errorf("expected embeddings of 1 entry removed, got %d", removed)
+	}
+
+	dispatch(t, r, msg, "/memory forget --pinned")
+	if obs, _ := a.MemoryStore.ListObservations(ctx, key); len(obs) != 0 {
+		t.Errorf("expected no observations, got %+v", obs)
+	}
+}
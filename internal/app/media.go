@@ -0,0 +1,93 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/provider"
+	"github.com/langoai/lango/internal/speech"
+)
+
+// defaultMaxAttachmentSize applies when media.maxAttachmentSize is unset.
+const defaultMaxAttachmentSize = 20 << 20 // 20MB
+
+// mediaInput downloads the attachments of a channel message and prepares
+// them for the model. Images, audio and binary documents become content
+// parts; text documents and voice transcripts are inlined into the prompt.
+// Every attachment also leaves a note in the text, so the session history
+// records it after the media itself is gone.
+func (a *App) mediaInput(ctx context.Context, ch channels.Channel, msg *channels.IncomingMessage) (string, []provider.ContentPart) {
+	if len(msg.Attachments) == 0 {
+		return msg.Text, nil
+	}
+
+	maxSize := a.Config.Media.MaxAttachmentSize
+	if maxSize <= 0 {
+		maxSize = defaultMaxAttachmentSize
+	}
+	dl, canDownload := ch.(channels.AttachmentChannel)
+
+	var notes []string
+	var parts []provider.ContentPart
+	for _, att := range msg.Attachments {
+		name := att.Name
+		if name == "" {
+			name = string(att.Type)
+		}
+		if !canDownload {
+			notes = append(notes, fmt.Sprintf("[User attached %s %q, which cannot be downloaded from this channel]", att.Type, name))
+			continue
+		}
+		if att.Size > maxSize {
+			notes = append(notes, fmt.Sprintf("[User attached %s %q, which exceeds the %d byte limit]", att.Type, name, maxSize))
+			continue
+		}
+
+		data, err := dl.DownloadAttachment(ctx, att)
+		if err != nil {
+			logger().Warnw("download attachment", "channel", ch.Type(), "name", name, "error", err)
+			notes = append(notes, fmt.Sprintf("[User attached %s %q, but it could not be downloaded]", att.Type, name))
+			continue
+		}
+		if int64(len(data)) > maxSize {
+			notes = append(notes, fmt.Sprintf("[User attached %s %q, which exceeds the %d byte limit]", att.Type, name, maxSize))
+			continue
+		}
+
+		mimeType := att.MimeType
+		if mimeType == "" {
+			mimeType = http.DetectContentType(data)
+		}
+		part := provider.ContentPart{
+			Type:     provider.PartTypeForMIME(mimeType),
+			MimeType: mimeType,
+			Name:     att.Name,
+			Data:     data,
+		}
+
+		if part.Type == provider.ContentPartAudio && a.Transcriber != nil {
+			text, err := a.Transcriber.Transcribe(ctx, speech.Audio{Data: data, MimeType: mimeType, Name: att.Name})
+			if err == nil {
+				notes = append(notes, "[Voice message transcript]\n"+text)
+				continue
+			}
+			logger().Warnw("transcribe voice message", "channel", ch.Type(), "error", err)
+		}
+		if part.IsText() {
+			notes = append(notes, part.TextFallback())
+			continue
+		}
+
+		parts = append(parts, part)
+		notes = append(notes, fmt.Sprintf("[User attached %s %q]", part.Type, name))
+	}
+
+	text := msg.Text
+	if len(notes) > 0 {
+		text = strings.TrimSpace(text + "\n\n" + strings.Join(notes, "\n\n"))
+	}
+	return text, parts
+}
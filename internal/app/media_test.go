@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/provider"
+	"github.com/langoai/lango/internal/speech"
+	"github.com/langoai/lango/internal/types"
+)
+
+// stubChannel is a channel that cannot download attachments.
+type stubChannel struct{}
+
+func (stubChannel) Type() types.ChannelType                    { return types.ChannelTelegram }
+func (stubChannel) Capabilities() channels.Capabilities        { return channels.Capabilities{} }
+func (stubChannel) SetHandler(channels.Handler)                {}
+func (stubChannel) Start(context.Context) error                { return nil }
+func (stubChannel) Stop()                                      {}
+func (stubChannel) ApprovalProvider() approval.Provider        { return nil }
+func (stubChannel) StartTyping(context.Context, string) func() { return func() {} }
+func (stubChannel) Send(context.Context, string, *channels.OutgoingMessage) error {
+	return nil
+}
+
+// fakeMediaChannel serves attachment contents keyed by file ID.
+type fakeMediaChannel struct {
+	stubChannel
+	files map[string][]byte
+}
+
+func (c *fakeMediaChannel) DownloadAttachment(_ context.Context, a channels.Attachment) ([]byte, error) {
+	data, ok := c.files[a.FileID]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return data, nil
+}
+
+type fakeTranscriber struct{ err error }
+
+func (t *fakeTranscriber) Transcribe(_ context.Context, a speech.Audio) (string, error) {
+	if t.err != nil {
+		return "", t.err
+	}
+	return "transcribed " + a.Name, nil
+}
+
+func TestMediaInput(t *testing.T) {
+	ch := &fakeMediaChannel{files: map[string][]byte{
+		"photo": []byte("\xff\xd8\xffjpeg"),
+		"voice": []byte("OggS"),
+		"notes": []byte("# Notes\n- item"),
+		"big":   make([]byte, 64),
+	}}
+
+	tests := []struct {
+		give        string
+		transcriber speech.Transcriber
+		att         channels.Attachment
+		wantText    string
+		wantPart    provider.ContentPartType
+	}{
+		{
+			give:     "image becomes a content part",
+			att:      channels.Attachment{Type: channels.AttachmentImage, FileID: "photo", Name: "photo.jpg", MimeType: "image/jpeg"},
+			wantText: `[User attached image "photo.jpg"]`,
+			wantPart: provider.ContentPartImage,
+		},
+		{
+			give:     "missing MIME type is detected",
+			att:      channels.Attachment{Type: channels.AttachmentImage, FileID: "photo"},
+			wantText: `[User attached image "image"]`,
+			wantPart: provider.ContentPartImage,
+		},
+		{
+			give:        "voice is transcribed",
+			transcriber: &fakeTranscriber{},
+			att:         channels.Attachment{Type: channels.AttachmentAudio, FileID: "voice", Name: "voice.ogg", MimeType: "audio/ogg"},
+			wantText:    "[Voice message transcript]\ntranscribed voice.ogg",
+		},
+		{
+			give:        "failed transcription passes the audio",
+			transcriber: &fakeTranscriber{err: errors.New("boom")},
+			att:         channels.Attachment{Type: channels.AttachmentAudio, FileID: "voice", Name: "voice.ogg", MimeType: "audio/ogg"},
+			wantText:    `[User attached audio "voice.ogg"]`,
+			wantPart:    provider.ContentPartAudio,
+		},
+		{
+			give:     "text document is inlined",
+			att:      channels.Attachment{Type: channels.AttachmentDocument, FileID: "notes", Name: "notes.md", MimeType: "text/markdown"},
+			wantText: "[Attached file notes.md]\n```\n# Notes\n- item\n```",
+		},
+		{
+			give:     "download failure is noted",
+			att:      channels.Attachment{Type: channels.AttachmentDocument, FileID: "gone", Name: "gone.pdf"},
+			wantText: `[User attached document "gone.pdf", but it could not be downloaded]`,
+		},
+		{
+			give:     "oversized attachment is skipped",
+			att:      channels.Attachment{Type: channels.AttachmentDocument, FileID: "big", Name: "big.bin", Size: 64},
+			wantText: `[User attached document "big.bin", which exceeds the 32 byte limit]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			a := &App{
+				Config:      &config.Config{Media: config.MediaConfig{MaxAttachmentSize: 32}},
+				Transcriber: tt.transcriber,
+			}
+			msg := &channels.IncomingMessage{Text: "look", Attachments: []channels.Attachment{tt.att}}
+
+			text, parts := a.mediaInput(context.Background(), ch, msg)
+			if want := "look\n\n" + tt.wantText; text != want {
+				t.Errorf("expected text %q, got %q", want, text)
+			}
+			if tt.wantPart == "" {
+				if len(parts) != 0 {
+					t.Errorf("expected no content parts, got %d", len(parts))
+				}
+				return
+			}
+			if len(parts) != 1 || parts[0].Type != tt.wantPart || len(parts[0].Data) == 0 {
+				t.Fatalf("expected one %s part, got %+v", tt.wantPart, parts)
+			}
+		})
+	}
+}
+
+func TestMediaInput_NoDownloader(t *testing.T) {
+	a := &App{Config: &config.Config{}}
+	ch := stubChannel{}
+	msg := &channels.IncomingMessage{Attachments: []channels.Attachment{{Type: channels.AttachmentImage, Name: "a.png"}}}
+
+	text, parts := a.mediaInput(context.Background(), ch, msg)
+	if len(parts) != 0 || !strings.Contains(text, "cannot be downloaded from this channel") {
+		t.Errorf("unexpected result %q, %d parts", text, len(parts))
+	}
+}
@@ -3,12 +3,9 @@ package app
 import (
 	"context"
 	"fmt"
-	"strconv"
 	"strings"
 
-	"github.com/langoai/lango/internal/channels/discord"
-	"github.com/langoai/lango/internal/channels/slack"
-	"github.com/langoai/lango/internal/channels/telegram"
+	"github.com/langoai/lango/internal/channels"
 	"github.com/langoai/lango/internal/types"
 )
 
@@ -33,48 +30,16 @@ func parseDeliveryTarget(target string) (types.ChannelType, string) {
 
 // SendMessage sends a text message to the specified delivery target.
 // Target format: "channel" (bare name) or "channel:id" (with routing ID).
-// For telegram: uses chatID from target, falls back to first allowlisted chat ID.
-// For discord/slack: uses channelID from target, returns error if not provided.
-func (s *channelSender) SendMessage(_ context.Context, channel, message string) error {
+// A bare name uses the channel's default chat, if it has one (for telegram,
+// the first allowlisted chat ID).
+func (s *channelSender) SendMessage(ctx context.Context, channel, message string) error {
 	chName, targetID := parseDeliveryTarget(channel)
 
-	for _, c := range s.app.Channels {
-		switch chName {
-		case types.ChannelTelegram:
-			if tg, ok := c.(*telegram.Channel); ok {
-				var chatID int64
-				if targetID != "" {
-					parsed, err := strconv.ParseInt(targetID, 10, 64)
-					if err != nil {
-						return fmt.Errorf("parse telegram chat ID %q: %w", targetID, err)
-					}
-					chatID = parsed
-				} else {
-					chatID = s.firstTelegramChatID()
-					if chatID == 0 {
-						return fmt.Errorf("telegram delivery requires a chat ID (use telegram:CHAT_ID) or at least one allowlisted chat ID")
-					}
-				}
-				return tg.Send(chatID, &telegram.OutgoingMessage{Text: message})
-			}
-		case types.ChannelDiscord:
-			if dc, ok := c.(*discord.Channel); ok {
-				if targetID == "" {
-					return fmt.Errorf("discord delivery requires a channel ID (use discord:CHANNEL_ID)")
-				}
-				return dc.Send(targetID, &discord.OutgoingMessage{Content: message})
-			}
-		case types.ChannelSlack:
-			if sl, ok := c.(*slack.Channel); ok {
-				if targetID == "" {
-					return fmt.Errorf("slack delivery requires a channel ID (use slack:CHANNEL_ID)")
-				}
-				return sl.Send(targetID, &slack.OutgoingMessage{Text: message})
-			}
-		}
+	ch := s.lookup(chName)
+	if ch == nil {
+		return fmt.Errorf("channel %q not available", channel)
 	}
-
-	return fmt.Errorf("channel %q not available", channel)
+	return ch.Send(ctx, targetID, &channels.OutgoingMessage{Text: message})
 }
 
 // StartTyping starts a typing indicator on the specified delivery target.
@@ -82,50 +47,20 @@ func (s *channelSender) SendMessage(_ context.Context, channel, message string)
 // Typing failures are logged but never block execution.
 func (s *channelSender) StartTyping(ctx context.Context, channel string) (func(), error) {
 	chName, targetID := parseDeliveryTarget(channel)
-	noop := func() {}
 
-	for _, c := range s.app.Channels {
-		switch chName {
-		case types.ChannelTelegram:
-			if tg, ok := c.(*telegram.Channel); ok {
-				var chatID int64
-				if targetID != "" {
-					parsed, err := strconv.ParseInt(targetID, 10, 64)
-					if err != nil {
-						return noop, fmt.Errorf("parse telegram chat ID %q: %w", targetID, err)
-					}
-					chatID = parsed
-				} else {
-					chatID = s.firstTelegramChatID()
-					if chatID == 0 {
-						return noop, nil
-					}
-				}
-				return tg.StartTyping(ctx, chatID), nil
-			}
-		case types.ChannelDiscord:
-			if dc, ok := c.(*discord.Channel); ok {
-				if targetID == "" {
-					return noop, nil
-				}
-				return dc.StartTyping(ctx, targetID), nil
-			}
-		case types.ChannelSlack:
-			if sl, ok := c.(*slack.Channel); ok {
-				if targetID == "" {
-					return noop, nil
-				}
-				return sl.StartTyping(targetID), nil
-			}
-		}
+	ch := s.lookup(chName)
+	if ch == nil {
+		return func() {}, nil
 	}
-
-	return noop, nil
+	return ch.StartTyping(ctx, targetID), nil
 }
 
-func (s *channelSender) firstTelegramChatID() int64 {
-	if len(s.app.Config.Channels.Telegram.Allowlist) > 0 {
-		return s.app.Config.Channels.Telegram.Allowlist[0]
+// lookup returns the running channel of the given type, or nil.
+func (s *channelSender) lookup(t types.ChannelType) channels.Channel {
+	for _, c := range s.app.Channels {
+		if c.Type() == t {
+			return c
+		}
 	}
-	return 0
+	return nil
 }
@@ -190,6 +190,124 @@ func buildMemoryAgentTools(ms *memory.Store) []*agent.Tool {
 				return map[string]interface{}{"reflections": reflections, "count": len(reflections)}, nil
 			},
 		},
+		{
+			Name:        "memory_pin",
+			Description: "Pin a fact so it is always remembered across all sessions. Provide content to pin a new fact, or id to pin an existing observation. Set pinned=false to unpin.",
+			SafetyLevel: agent.SafetyLevelModerate,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"content": map[string]interface{}{"type": "string", "description": "Fact to remember (e.g. 'User prefers Go')"},
+					"id":      map[string]interface{}{"type": "string", "description": "UUID of an existing observation to pin or unpin"},
+					"pinned":  map[string]interface{}{"type": "boolean", "description": "Pin state for an existing observation (default: true)"},
+				},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				content, _ := params["content"].(string)
+				idStr, _ := params["id"].(string)
+				if content == "" && idStr == "" {
+					return nil, fmt.Errorf("either content or id is required")
+				}
+
+				if idStr == "" {
+					sessionKey := session.SessionKeyFromContext(ctx)
+					if sessionKey == "" {
+						return nil, fmt.Errorf("pin fact: no active session to attribute the fact to")
+					}
+					obs, err := ms.PinFact(ctx, sessionKey, content)
+					if err != nil {
+						return nil, fmt.Errorf("pin fact: %w", err)
+					}
+					return map[string]interface{}{
+						"status":  "pinned",
+						"id":      obs.ID.String(),
+						"message": fmt.Sprintf("Pinned: %s", content),
+					}, nil
+				}
+
+				id, err := uuid.Parse(idStr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid id: %w", err)
+				}
+				pinned := true
+				if p, ok := params["pinned"].(bool); ok {
+					pinned = p
+				}
+				if err := ms.SetPinned(ctx, id, pinned); err != nil {
+					return nil, fmt.Errorf("set pinned: %w", err)
+				}
+				status := "pinned"
+				if !pinned {
+					status = "unpinned"
+				}
+				return map[string]interface{}{"status": status, "id": idStr}, nil
+			},
+		},
+		{
+			Name:        "memory_forget",
+			Description: "Permanently forget an observation or reflection by ID. Also removes its embeddings and knowledge graph nodes. Use memory_list_observations or memory_list_reflections to find the ID.",
+			SafetyLevel: agent.SafetyLevelDangerous,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"id": map[string]interface{}{"type": "string", "description": "UUID of the observation or reflection to forget"},
+				},
+				"required": []string{"id"},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				idStr, ok := params["id"].(string)
+				if !ok || idStr == "" {
+					return nil, fmt.Errorf("missing id parameter")
+				}
+				id, err := uuid.Parse(idStr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid id: %w", err)
+				}
+				entry, err := ms.Forget(ctx, id)
+				if err != nil {
+					return nil, fmt.Errorf("forget: %w", err)
+				}
+				return map[string]interface{}{
+					"status":  "forgotten",
+					"id":      idStr,
+					"kind":    entry.Kind,
+					"message": fmt.Sprintf("Forgot %s %s", entry.Kind, idStr),
+				}, nil
+			},
+		},
+		{
+			Name:        "memory_correct",
+			Description: "Correct the content of an observation or reflection. Embeddings and graph relationships are rebuilt from the corrected content.",
+			SafetyLevel: agent.SafetyLevelModerate,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"id":      map[string]interface{}{"type": "string", "description": "UUID of the observation or reflection to correct"},
+					"content": map[string]interface{}{"type": "string", "description": "Corrected content"},
+				},
+				"required": []string{"id", "content"},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				idStr, _ := params["id"].(string)
+				content, _ := params["content"].(string)
+				if idStr == "" || content == "" {
+					return nil, fmt.Errorf("id and content are required")
+				}
+				id, err := uuid.Parse(idStr)
+				if err != nil {
+					return nil, fmt.Errorf("invalid id: %w", err)
+				}
+				entry, err := ms.Correct(ctx, id, content)
+				if err != nil {
+					return nil, fmt.Errorf("correct: %w", err)
+				}
+				return map[string]interface{}{
+					"status": "corrected",
+					"id":     idStr,
+					"kind":   entry.Kind,
+				}, nil
+			},
+		},
 	}
 }
 
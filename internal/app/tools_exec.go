@@ -57,7 +57,7 @@ func buildExecTools(sv *supervisor.Supervisor, automationAvailable map[string]bo
 				if msg := blockLangoExec(cmd, automationAvailable); msg != "" {
 					return map[string]interface{}{"blocked": true, "message": msg}, nil
 				}
-				return sv.StartBackground(cmd)
+				return sv.StartBackground(ctx, cmd)
 			},
 		},
 		{
@@ -5,9 +5,17 @@ import (
 	"fmt"
 
 	"github.com/langoai/lango/internal/agent"
+	"github.com/langoai/lango/internal/session"
 	"github.com/langoai/lango/internal/tools/filesystem"
 )
 
+// forceParam is the parameter that overrides the protection of files with
+// uncommitted git changes.
+var forceParam = map[string]interface{}{
+	"type":        "boolean",
+	"description": "Change the file even if it has uncommitted git changes made outside this session (default: false)",
+}
+
 func buildFilesystemTools(fsTool *filesystem.Tool) []*agent.Tool {
 	return []*agent.Tool{
 		{
@@ -48,6 +56,83 @@ func buildFilesystemTools(fsTool *filesystem.Tool) []*agent.Tool {
 				return fsTool.ListDir(path)
 			},
 		},
+		{
+			Name:        "fs_stat",
+			Description: "Show metadata of a file or directory: size, mode, modification time, symlink target, MIME type, whether it is binary and its line count",
+			SafetyLevel: agent.SafetyLevelSafe,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"path": map[string]interface{}{"type": "string", "description": "The path to inspect"},
+				},
+				"required": []string{"path"},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				path, _ := params["path"].(string)
+				if path == "" {
+					return nil, fmt.Errorf("missing path parameter")
+				}
+				return fsTool.Stat(path)
+			},
+		},
+		{
+			Name:        "fs_glob",
+			Description: "Find files by path pattern, skipping files ignored by .gitignore. '**' matches any number of directories: '**/*.go' finds Go files at any depth, '*.go' only in the directory itself",
+			SafetyLevel: agent.SafetyLevelSafe,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"pattern":        map[string]interface{}{"type": "string", "description": "Glob relative to path, e.g. '**/*_test.go' or 'cmd/*/main.go'"},
+					"path":           map[string]interface{}{"type": "string", "description": "The directory to search (default: current directory)"},
+					"limit":          map[string]interface{}{"type": "integer", "description": "Maximum number of files (default: 200)"},
+					"includeIgnored": map[string]interface{}{"type": "boolean", "description": "Also return files ignored by .gitignore (default: false)"},
+				},
+				"required": []string{"pattern"},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				pattern, _ := params["pattern"].(string)
+				path, _ := params["path"].(string)
+				includeIgnored, _ := params["includeIgnored"].(bool)
+				limit := 0
+				if l, ok := params["limit"].(float64); ok && l > 0 {
+					limit = int(l)
+				}
+				return fsTool.Glob(ctx, path, pattern, limit, includeIgnored)
+			},
+		},
+		{
+			Name:        "fs_grep",
+			Description: "Search file contents with a regular expression (RE2 syntax), skipping binary files and files ignored by .gitignore. Returns file, line number and matching line with optional context",
+			SafetyLevel: agent.SafetyLevelSafe,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"pattern":        map[string]interface{}{"type": "string", "description": "The regular expression to search for"},
+					"path":           map[string]interface{}{"type": "string", "description": "The file or directory to search (default: current directory)"},
+					"include":        map[string]interface{}{"type": "string", "description": "Only search matching files, e.g. '*.go' (file name) or 'internal/**/*.go' (path)"},
+					"ignoreCase":     map[string]interface{}{"type": "boolean", "description": "Match case-insensitively (default: false)"},
+					"contextLines":   map[string]interface{}{"type": "integer", "description": "Lines of context before and after each match, up to 10 (default: 0)"},
+					"maxMatches":     map[string]interface{}{"type": "integer", "description": "Maximum number of matches (default: 100)"},
+					"includeIgnored": map[string]interface{}{"type": "boolean", "description": "Also search files ignored by .gitignore (default: false)"},
+				},
+				"required": []string{"pattern"},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				opts := filesystem.GrepOptions{}
+				opts.Pattern, _ = params["pattern"].(string)
+				opts.Path, _ = params["path"].(string)
+				opts.Include, _ = params["include"].(string)
+				opts.IgnoreCase, _ = params["ignoreCase"].(bool)
+				opts.IncludeIgnored, _ = params["includeIgnored"].(bool)
+				if n, ok := params["contextLines"].(float64); ok && n > 0 {
+					opts.ContextLines = int(n)
+				}
+				if n, ok := params["maxMatches"].(float64); ok && n > 0 {
+					opts.MaxMatches = int(n)
+				}
+				return fsTool.Grep(ctx, opts)
+			},
+		},
 		{
 			Name:        "fs_write",
 			Description: "Write content to a file",
@@ -57,16 +142,18 @@ func buildFilesystemTools(fsTool *filesystem.Tool) []*agent.Tool {
 				"properties": map[string]interface{}{
 					"path":    map[string]interface{}{"type": "string", "description": "The file path to write to"},
 					"content": map[string]interface{}{"type": "string", "description": "The content to write"},
+					"force":   forceParam,
 				},
 				"required": []string{"path", "content"},
 			},
 			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 				path, _ := params["path"].(string)
 				content, _ := params["content"].(string)
+				force, _ := params["force"].(bool)
 				if path == "" {
 					return nil, fmt.Errorf("missing path parameter")
 				}
-				return nil, fsTool.Write(path, content)
+				return nil, fsTool.Write(ctx, path, content, force)
 			},
 		},
 		{
@@ -80,6 +167,7 @@ func buildFilesystemTools(fsTool *filesystem.Tool) []*agent.Tool {
 					"startLine": map[string]interface{}{"type": "integer", "description": "The starting line number (1-indexed)"},
 					"endLine":   map[string]interface{}{"type": "integer", "description": "The ending line number (inclusive)"},
 					"content":   map[string]interface{}{"type": "string", "description": "The new content for the specified range"},
+					"force":     forceParam,
 				},
 				"required": []string{"path", "startLine", "endLine", "content"},
 			},
@@ -102,7 +190,33 @@ func buildFilesystemTools(fsTool *filesystem.Tool) []*agent.Tool {
 					endLine = el
 				}
 
-				return nil, fsTool.Edit(path, startLine, endLine, content)
+				force, _ := params["force"].(bool)
+				return nil, fsTool.Edit(ctx, path, startLine, endLine, content, force)
+			},
+		},
+		{
+			Name:        "fs_apply_patch",
+			Description: "Apply a unified diff (diff -u or git diff format) to one or more files. Either all hunks apply or nothing is changed; conflicts are reported per hunk. Use dryRun to check a patch first",
+			SafetyLevel: agent.SafetyLevelDangerous,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"patch":  map[string]interface{}{"type": "string", "description": "The unified diff; '/dev/null' as old or new file creates or deletes a file"},
+					"dir":    map[string]interface{}{"type": "string", "description": "The directory the paths in the patch are relative to (default: current directory)"},
+					"dryRun": map[string]interface{}{"type": "boolean", "description": "Only check whether the patch applies (default: false)"},
+					"force":  forceParam,
+				},
+				"required": []string{"patch"},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				patch, _ := params["patch"].(string)
+				dir, _ := params["dir"].(string)
+				dryRun, _ := params["dryRun"].(bool)
+				force, _ := params["force"].(bool)
+				if patch == "" {
+					return nil, fmt.Errorf("missing patch parameter")
+				}
+				return fsTool.ApplyPatch(ctx, patch, dir, dryRun, force)
 			},
 		},
 		{
@@ -131,16 +245,51 @@ func buildFilesystemTools(fsTool *filesystem.Tool) []*agent.Tool {
 			Parameters: map[string]interface{}{
 				"type": "object",
 				"properties": map[string]interface{}{
-					"path": map[string]interface{}{"type": "string", "description": "The path to delete"},
+					"path":  map[string]interface{}{"type": "string", "description": "The path to delete"},
+					"force": forceParam,
 				},
 				"required": []string{"path"},
 			},
 			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
 				path, _ := params["path"].(string)
+				force, _ := params["force"].(bool)
 				if path == "" {
 					return nil, fmt.Errorf("missing path parameter")
 				}
-				return nil, fsTool.Delete(path)
+				return nil, fsTool.Delete(ctx, path, force)
+			},
+		},
+		{
+			Name:        "fs_undo",
+			Description: "Undo the most recent fs_write, fs_edit or fs_delete changes of this session",
+			SafetyLevel: agent.SafetyLevelDangerous,
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"steps": map[string]interface{}{"type": "integer", "description": "Number of changes to undo, newest first (default: 1)"},
+					"force": map[string]interface{}{"type": "boolean", "description": "Undo even if a file was changed again after the recorded change (default: false)"},
+				},
+			},
+			Handler: func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+				steps := 1
+				if n, ok := params["steps"].(float64); ok {
+					steps = int(n)
+				} else if n, ok := params["steps"].(int); ok {
+					steps = n
+				}
+				force, _ := params["force"].(bool)
+
+				results, err := fsTool.Undo(ctx, session.SessionKeyFromContext(ctx), steps, force)
+				if err != nil {
+					if len(results) == 0 {
+						return nil, err
+					}
+					return map[string]interface{}{"undone": results, "error": err.Error()}, nil
+				}
+				if len(results) == 0 {
+					return map[string]interface{}{"undone": results, "message": "nothing to undo in this session"}, nil
+				}
+				return map[string]interface{}{"undone": results}, nil
 			},
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	entlearning "github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/knowledge"
 	"github.com/langoai/lango/internal/learning"
+	"github.com/langoai/lango/internal/session"
 	"github.com/langoai/lango/internal/skill"
 )
 
@@ -32,6 +33,7 @@ func buildMetaTools(store *knowledge.Store, engine *learning.Engine, registry *s
 					"content":  map[string]interface{}{"type": "string", "description": "The knowledge content to save"},
 					"tags":     map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}, "description": "Optional tags for categorization"},
 					"source":   map[string]interface{}{"type": "string", "description": "Where this knowledge came from"},
+					"reason":   map[string]interface{}{"type": "string", "description": "Why this entry is being created or changed (recorded in the revision history)"},
 				},
 				"required": []string{"key", "category", "content"},
 			},
@@ -67,7 +69,12 @@ func buildMetaTools(store *knowledge.Store, engine *learning.Engine, registry *s
 					Source:   source,
 				}
 
-				if err := store.SaveKnowledge(ctx, "", entry); err != nil {
+				reason, _ := params["reason"].(string)
+				ctx = knowledge.WithRevisionInfo(ctx, knowledge.RevisionInfo{
+					Author: knowledge.AuthorAgent,
+					Reason: reason,
+				})
+				if err := store.SaveKnowledge(ctx, session.SessionKeyFromContext(ctx), entry); err != nil {
 					return nil, fmt.Errorf("save knowledge: %w", err)
 				}
 
@@ -510,18 +517,10 @@ func buildMetaTools(store *knowledge.Store, engine *learning.Engine, registry *s
 
 				if dryRun {
 					// Count matching entries without deleting.
-					_, total, err := store.ListLearnings(ctx, category, 0, olderThan, 0, 0)
+					total, err := store.CountLearningsWhere(ctx, category, maxConfidence, olderThan)
 					if err != nil {
 						return nil, fmt.Errorf("count learnings: %w", err)
 					}
-					// Apply maxConfidence filter for count (ListLearnings uses minConfidence).
-					if maxConfidence > 0 {
-						_, filteredTotal, err := store.ListLearnings(ctx, category, 0, olderThan, 1, 0)
-						if err != nil {
-							return nil, fmt.Errorf("count filtered learnings: %w", err)
-						}
-						_ = filteredTotal
-					}
 					return map[string]interface{}{"would_delete": total, "dry_run": true}, nil
 				}
 
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"strings"
 	"testing"
 )
@@ -131,3 +132,17 @@ func TestBlockLangoExec_DisabledFeature(t *testing.T) {
 		t.Errorf("graph guard should not suggest enabling a feature, got: %s", msg)
 	}
 }
+
+func TestMemoryPinTool_RequiresSession(t *testing.T) {
+	for _, tool := range buildMemoryAgentTools(nil) {
+		if tool.Name != "memory_pin" {
+			continue
+		}
+		_, err := tool.Handler(context.Background(), map[string]interface{}{"content": "User prefers Go"})
+		if err == nil || !strings.Contains(err.Error(), "no active session") {
+			t.Errorf("expected missing session error, got %v", err)
+		}
+		return
+	}
+	t.Fatal("memory_pin tool not found")
+}
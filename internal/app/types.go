@@ -8,6 +8,7 @@ import (
 	"github.com/langoai/lango/internal/adk"
 	"github.com/langoai/lango/internal/approval"
 	"github.com/langoai/lango/internal/background"
+	"github.com/langoai/lango/internal/channels"
 	"github.com/langoai/lango/internal/config"
 	cronpkg "github.com/langoai/lango/internal/cron"
 	"github.com/langoai/lango/internal/embedding"
@@ -20,9 +21,12 @@ import (
 	"github.com/langoai/lango/internal/memory"
 	"github.com/langoai/lango/internal/p2p"
 	"github.com/langoai/lango/internal/payment"
+	"github.com/langoai/lango/internal/provider"
+	"github.com/langoai/lango/internal/quota"
 	"github.com/langoai/lango/internal/security"
 	"github.com/langoai/lango/internal/session"
 	"github.com/langoai/lango/internal/skill"
+	"github.com/langoai/lango/internal/speech"
 	"github.com/langoai/lango/internal/wallet"
 	"github.com/langoai/lango/internal/workflow"
 	x402pkg "github.com/langoai/lango/internal/x402"
@@ -68,6 +72,8 @@ type App struct {
 	// Proactive Librarian Components (optional)
 	LibrarianInquiryStore    *librarian.InquiryStore
 	LibrarianProactiveBuffer *librarian.ProactiveBuffer
+	LibrarianNotifier        *librarian.CompositeNotifier
+	LibrarianResolver        *librarian.QuickReplyResolver
 
 	// Graph Components (optional)
 	GraphStore  graph.Store
@@ -78,6 +84,9 @@ type App struct {
 	PaymentService  *payment.Service
 	X402Interceptor *x402pkg.Interceptor
 
+	// Usage Quota Manager (optional)
+	Quota *quota.Manager
+
 	// Cron Scheduling Components (optional)
 	CronScheduler *cronpkg.Scheduler
 
@@ -87,11 +96,20 @@ type App struct {
 	// Workflow Engine Components (optional)
 	WorkflowEngine *workflow.Engine
 
+	// listModels lists the models of the agent provider for /model (optional)
+	listModels func(ctx context.Context) ([]provider.ModelInfo, error)
+
+	// secretExpiry warns about expiring secrets (optional)
+	secretExpiry *secretExpiryMonitor
+
 	// P2P Components (optional)
 	P2PNode *p2p.Node
 
 	// Channels
-	Channels []Channel
+	Channels []channels.Channel
+
+	// Transcriber converts voice messages to text (optional)
+	Transcriber speech.Transcriber
 
 	// Lifecycle registry manages component startup/shutdown ordering.
 	registry *lifecycle.Registry
@@ -100,8 +118,3 @@ type App struct {
 	wg sync.WaitGroup
 }
 
-// Channel represents a communication channel (Telegram, Discord, Slack)
-type Channel interface {
-	Start(ctx context.Context) error
-	Stop()
-}
@@ -156,7 +156,7 @@ func initSecurity(cfg *config.Config, store session.Store, boot *bootstrap.Resul
 	case "enclave":
 		return nil, nil, nil, fmt.Errorf("enclave provider not yet implemented")
 
-	case "aws-kms", "gcp-kms", "azure-kv", "pkcs11":
+	case "aws-kms", "gcp-kms", "azure-kv", "pkcs11", "vault":
 		kmsProvider, err := security.NewKMSProvider(security.KMSProviderName(cfg.Security.Signer.Provider), cfg.Security.KMS)
 		if err != nil {
 			return nil, nil, nil, fmt.Errorf("KMS provider %q: %w", cfg.Security.Signer.Provider, err)
@@ -212,7 +212,7 @@ func initAuth(cfg *config.Config, store session.Store) *gateway.AuthManager {
 }
 
 // initAgent creates the ADK agent with the given tools and provider proxy.
-func initAgent(ctx context.Context, sv *supervisor.Supervisor, cfg *config.Config, store session.Store, tools []*agent.Tool, kc *knowledgeComponents, mc *memoryComponents, ec *embeddingComponents, gc *graphComponents, scanner *agent.SecretScanner, sr *skill.Registry, lc *librarianComponents) (*adk.Agent, error) {
+func initAgent(ctx context.Context, sv *supervisor.Supervisor, cfg *config.Config, store session.Store, tools []*agent.Tool, kc *knowledgeComponents, mc *memoryComponents, ec *embeddingComponents, gc *graphComponents, scanner *agent.SecretScanner, guard *agent.InjectionGuard, sr *skill.Registry, lc *librarianComponents) (*adk.Agent, error) {
 	// Adapt tools to ADK format with optional per-tool timeout.
 	toolTimeout := cfg.Agent.ToolTimeout
 	var adkTools []adk_tool.Tool
@@ -319,6 +319,9 @@ func initAgent(ctx context.Context, sv *supervisor.Supervisor, cfg *config.Confi
 			if gc != nil && gc.ragService != nil {
 				ctxAdapter.WithGraphRAG(gc.ragService)
 			}
+			if guard != nil && cfg.Security.Injection.ScreenRetrieved {
+				ctxAdapter.WithInjectionGuard(guard)
+			}
 		}
 
 		llm = ctxAdapter
@@ -357,6 +360,9 @@ func initAgent(ctx context.Context, sv *supervisor.Supervisor, cfg *config.Confi
 			if gc != nil && gc.ragService != nil {
 				ctxAdapter.WithGraphRAG(gc.ragService)
 			}
+			if guard != nil && cfg.Security.Injection.ScreenRetrieved {
+				ctxAdapter.WithInjectionGuard(guard)
+			}
 		}
 
 		llm = ctxAdapter
@@ -471,6 +477,7 @@ func initGateway(cfg *config.Config, adkAgent *adk.Agent, store session.Store, a
 		HTTPEnabled:      cfg.Server.HTTPEnabled,
 		WebSocketEnabled: cfg.Server.WebSocketEnabled,
 		AllowedOrigins:   cfg.Server.AllowedOrigins,
+		APITokens:        cfg.Server.APITokens,
 		RequestTimeout:   cfg.Agent.RequestTimeout,
 	}, adkAgent, nil, store, auth)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/langoai/lango/internal/adk"
 	"github.com/langoai/lango/internal/background"
 	"github.com/langoai/lango/internal/config"
 	cronpkg "github.com/langoai/lango/internal/cron"
@@ -17,7 +18,7 @@ type agentRunnerAdapter struct {
 }
 
 func (r *agentRunnerAdapter) Run(ctx context.Context, sessionKey, promptText string) (string, error) {
-	return r.app.runAgent(ctx, sessionKey, promptText)
+	return r.app.runAgent(ctx, sessionKey, promptText, adk.StreamHandlers{})
 }
 
 // initCron creates the cron scheduling system if enabled.
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/langoai/lango/internal/config"
@@ -84,11 +85,18 @@ func initEmbedding(cfg *config.Config, rawDB *sql.DB, kc *knowledgeComponents, m
 			Metadata:   metadata,
 		})
 	}
+	removeCB := func(id, collection string) {
+		if err := vecStore.Delete(context.Background(), collection, []string{id}); err != nil {
+			logger().Warnw("embedding delete error", "id", id, "collection", collection, "error", err)
+		}
+	}
 	if kc != nil {
 		kc.store.SetEmbedCallback(embedCB)
+		kc.store.SetEmbedRemoveCallback(removeCB)
 	}
 	if mc != nil {
 		mc.store.SetEmbedCallback(embedCB)
+		mc.store.SetEmbedRemoveCallback(removeCB)
 	}
 
 	logger().Infow("embedding system initialized",
@@ -0,0 +1,49 @@
+package app
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/session"
+	"github.com/langoai/lango/internal/tools/filesystem"
+)
+
+// buildFilesystemConfig creates the filesystem tool configuration. The undo
+// journal is kept when the session store is backed by ent; snapshots older
+// than tools.filesystem.undoRetention are pruned.
+func buildFilesystemConfig(cfg *config.Config, store session.Store) filesystem.Config {
+	// The ~/.lango/ directory stays blocked even when blockedPaths is
+	// overridden: it holds the database and keys.
+	var blockedPaths []string
+	if home, err := os.UserHomeDir(); err == nil {
+		blockedPaths = append(blockedPaths, filepath.Join(home, ".lango"))
+	}
+	blockedPaths = append(blockedPaths, cfg.Tools.Filesystem.BlockedPaths...)
+
+	fsConfig := filesystem.Config{
+		MaxReadSize:        cfg.Tools.Filesystem.MaxReadSize,
+		AllowedPaths:       cfg.Tools.Filesystem.AllowedPaths,
+		BlockedPaths:       blockedPaths,
+		ProtectUncommitted: cfg.Tools.Filesystem.ProtectUncommitted,
+	}
+
+	entStore, ok := store.(*session.EntStore)
+	if !ok {
+		logger().Warn("filesystem undo journal requires EntStore, fs_undo disabled")
+		return fsConfig
+	}
+	journal := filesystem.NewEntJournal(entStore.Client())
+	if retention := cfg.Tools.Filesystem.UndoRetention; retention > 0 {
+		n, err := journal.Prune(context.Background(), time.Now().Add(-retention))
+		if err != nil {
+			logger().Warnw("prune filesystem undo journal", "error", err)
+		} else if n > 0 {
+			logger().Infow("filesystem undo journal pruned", "deleted", n)
+		}
+	}
+	fsConfig.Journal = journal
+	return fsConfig
+}
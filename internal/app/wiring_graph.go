@@ -97,8 +97,22 @@ func wireGraphCallbacks(gc *graphComponents, kc *knowledgeComponents, mc *memory
 		}
 	}
 
+	// Forgetting or rolling back an entry removes its node (including
+	// hook-generated session/temporal edges) and any triples extracted from
+	// its content.
+	graphRemoveCB := func(id, collection string) {
+		ctx := context.Background()
+		if _, err := gc.store.RemoveNode(ctx, collection+":"+id); err != nil {
+			logger().Warnw("graph node removal error", "id", id, "error", err)
+		}
+		if _, err := gc.store.RemoveBySource(ctx, id); err != nil {
+			logger().Warnw("graph provenance removal error", "id", id, "error", err)
+		}
+	}
+
 	if kc != nil {
 		kc.store.SetGraphCallback(graphCB)
+		kc.store.SetGraphRemoveCallback(graphRemoveCB)
 	}
 	if mc != nil {
 		mc.store.SetGraphCallback(graphCB)
@@ -109,6 +123,8 @@ func wireGraphCallbacks(gc *graphComponents, kc *knowledgeComponents, mc *memory
 		}
 		hooks := memory.NewGraphHooks(tripleCallback, logger())
 		mc.store.SetGraphHooks(hooks)
+
+		mc.store.SetGraphRemoveCallback(graphRemoveCB)
 		logger().Info("memory graph hooks wired")
 	}
 }
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"github.com/langoai/lango/internal/agent"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/supervisor"
+)
+
+// initInjectionGuard builds the prompt-injection guard for tool outputs and
+// retrieved content. It returns nil when screening is disabled.
+func initInjectionGuard(cfg *config.Config, sv *supervisor.Supervisor) *agent.InjectionGuard {
+	ic := cfg.Security.Injection
+	if !ic.Enabled {
+		logger().Info("prompt-injection screening disabled")
+		return nil
+	}
+
+	var detector agent.InjectionDetector = agent.NewRegexInjectionDetector(agent.RegexInjectionDetectorConfig{
+		DisabledRules:  ic.DisabledRules,
+		CustomPatterns: ic.CustomPatterns,
+	})
+	if ic.Classifier.Enabled {
+		provider := ic.Classifier.Provider
+		if provider == "" {
+			provider = cfg.Agent.Provider
+		}
+		model := ic.Classifier.Model
+		if model == "" {
+			model = cfg.Agent.Model
+		}
+		proxy := supervisor.NewProviderProxy(sv, provider, model)
+		classifier := agent.NewLLMInjectionClassifier(&providerTextGenerator{proxy: proxy}, ic.Classifier.MaxChars)
+		detector = agent.NewCompositeInjectionDetector(detector, classifier)
+	}
+
+	logger().Infow("prompt-injection screening enabled",
+		"policy", string(ic.Policy),
+		"threshold", ic.Threshold,
+		"classifier", ic.Classifier.Enabled,
+	)
+	return agent.NewInjectionGuard(agent.InjectionGuardConfig{
+		Detector:  detector,
+		Threshold: ic.Threshold,
+		Block:     ic.Policy == config.InjectionPolicyBlock,
+	})
+}
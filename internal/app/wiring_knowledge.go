@@ -10,6 +10,7 @@ import (
 	"github.com/langoai/lango/internal/learning"
 	"github.com/langoai/lango/internal/config"
 	"github.com/langoai/lango/internal/librarian"
+	"github.com/langoai/lango/internal/sandbox"
 	"github.com/langoai/lango/internal/session"
 	"github.com/langoai/lango/internal/skill"
 	"github.com/langoai/lango/internal/supervisor"
@@ -97,6 +98,13 @@ func initSkills(cfg *config.Config, baseTools []*agent.Tool) *skill.Registry {
 	}
 
 	registry := skill.NewRegistry(store, baseTools, sLogger)
+	if cfg.Skill.Sandbox {
+		if err := sandbox.ProbeLinux(); err != nil {
+			sLogger.Errorw("skill sandbox unavailable, skill system disabled", "error", err)
+			return nil
+		}
+		registry.SetIsolator(sandbox.NewLinuxRuntime(sandbox.Config{}, sandbox.NewPolicy(cfg.Sandbox)))
+	}
 	ctx := context.Background()
 	if err := registry.LoadSkills(ctx); err != nil {
 		sLogger.Warnw("load skills error", "error", err)
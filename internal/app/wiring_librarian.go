@@ -12,6 +12,8 @@ import (
 type librarianComponents struct {
 	inquiryStore    *librarian.InquiryStore
 	proactiveBuffer *librarian.ProactiveBuffer
+	notifier        *librarian.CompositeNotifier
+	resolver        *librarian.QuickReplyResolver
 }
 
 // initLibrarian creates the proactive librarian components if enabled.
@@ -90,6 +92,12 @@ func initLibrarian(
 		getMessages, getObservations, bufCfg, lLogger,
 	)
 
+	// Channels register their inquiry providers on the notifier during
+	// initChannels; quick replies resolve through the resolver.
+	notifier := librarian.NewCompositeNotifier()
+	buffer.SetNotifier(notifier)
+	resolver := librarian.NewQuickReplyResolver(inquiryStore, kc.store, lLogger)
+
 	// Wire graph callback if available.
 	if gc != nil && gc.buffer != nil {
 		buffer.SetGraphCallback(func(triples []librarian.Triple) {
@@ -117,5 +125,7 @@ func initLibrarian(
 	return &librarianComponents{
 		inquiryStore:    inquiryStore,
 		proactiveBuffer: buffer,
+		notifier:        notifier,
+		resolver:        resolver,
 	}
 }
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/speech"
+	"github.com/langoai/lango/internal/types"
+)
+
+// initTranscriber creates the speech-to-text backend for voice messages if
+// configured. Without one, audio is passed to the model as is.
+func initTranscriber(cfg *config.Config) speech.Transcriber {
+	tc := cfg.Media.Transcription
+	if tc.Provider == "" {
+		return nil
+	}
+
+	p, ok := cfg.Providers[tc.Provider]
+	if !ok {
+		logger().Warnw("transcription provider not found in providers, skipping", "provider", tc.Provider)
+		return nil
+	}
+	if p.Type != types.ProviderOpenAI {
+		logger().Warnw("transcription requires an OpenAI-compatible provider, skipping",
+			"provider", tc.Provider, "type", p.Type)
+		return nil
+	}
+
+	t, err := speech.New(speech.Config{
+		Provider: string(p.Type),
+		APIKey:   p.APIKey,
+		BaseURL:  p.BaseURL,
+		Model:    tc.Model,
+		Language: tc.Language,
+	})
+	if err != nil {
+		logger().Warnw("transcription init failed, skipping", "error", err)
+		return nil
+	}
+	logger().Infow("voice transcription enabled", "provider", tc.Provider)
+	return t
+}
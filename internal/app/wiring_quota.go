@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/langoai/lango/internal/adk"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/provider"
+	"github.com/langoai/lango/internal/quota"
+	"github.com/langoai/lango/internal/session"
+)
+
+// gatewayChannel is the quota channel of gateway requests. Gateway users
+// are identified by their authenticated principal, or by their client address
+// without authentication.
+const gatewayChannel = "gateway"
+
+// initQuota creates the usage quota manager if enabled.
+func initQuota(cfg *config.Config, store session.Store) *quota.Manager {
+	if !cfg.Quota.Enabled {
+		logger().Info("usage quotas disabled")
+		return nil
+	}
+
+	entStore, ok := store.(*session.EntStore)
+	if !ok {
+		logger().Warn("usage quotas require EntStore, skipping")
+		return nil
+	}
+
+	logger().Infow("usage quotas enabled",
+		"overrides", len(cfg.Quota.Overrides),
+		"pricedModels", len(cfg.Quota.Pricing))
+	return quota.NewManager(quota.NewEntStore(entStore.Client()), quota.ConfigFrom(cfg.Quota))
+}
+
+// admitRequest checks the quota of subject before an agent request. It
+// returns the context to run the agent with, which records the usage of
+// the request, or a friendly reply when the subject is over quota. A
+// failing quota store is logged and does not block the request.
+func (a *App) admitRequest(ctx context.Context, subject quota.Subject, sessionKey string) (context.Context, string) {
+	if a.Quota == nil {
+		return ctx, ""
+	}
+
+	req, err := a.Quota.Admit(ctx, subject, sessionKey)
+	if e, ok := quota.IsExceeded(err); ok {
+		logger().Infow("quota exceeded",
+			"subject", e.Subject,
+			"limit", e.Limit,
+			"resetAt", e.ResetAt)
+		return ctx, e.Message(time.Now())
+	}
+	if err != nil {
+		logger().Warnw("quota check failed, allowing request", "subject", subject.Identity(), "error", err)
+		return ctx, ""
+	}
+
+	return adk.WithUsageRecorder(ctx, func(model string, usage provider.Usage) {
+		// The request may have timed out by the time its last call reports.
+		if err := req.Record(context.WithoutCancel(ctx), model, usage); err != nil {
+			logger().Warnw("record usage", "subject", subject.Identity(), "error", err)
+		}
+	}), ""
+}
+
+// gatewayGuard enforces quotas on gateway chat requests. Quotas are charged
+// to the principal, since clients choose their session keys freely.
+func (a *App) gatewayGuard(ctx context.Context, principal, sessionKey string) (context.Context, error) {
+	ctx, over := a.admitRequest(ctx, quota.Subject{Channel: gatewayChannel, UserID: principal}, sessionKey)
+	if over != "" {
+		return nil, errors.New(over)
+	}
+	return ctx, nil
+}
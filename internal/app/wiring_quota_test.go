@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/langoai/lango/internal/quota"
+	"github.com/langoai/lango/internal/session"
+)
+
+func TestAdmitRequest(t *testing.T) {
+	a := newCommandTestApp(t)
+	client := a.Store.(*session.EntStore).Client()
+	a.Quota = quota.NewManager(quota.NewEntStore(client), quota.Config{
+		User: quota.Limits{RequestsPerMinute: 1},
+	})
+	subject := quota.Subject{Channel: "telegram", UserID: "2"}
+
+	if _, over := a.admitRequest(context.Background(), subject, "telegram:1:2"); over != "" {
+		t.Fatalf("first request refused: %q", over)
+	}
+	_, over := a.admitRequest(context.Background(), subject, "telegram:1:2")
+	if !strings.Contains(over, "1 request per minute") {
+		t.Errorf("expected over-quota reply, got %q", over)
+	}
+
+	if _, err := a.gatewayGuard(context.Background(), "addr:127.0.0.1", "default"); err != nil {
+		t.Errorf("gateway request refused: %v", err)
+	}
+	if _, err := a.gatewayGuard(context.Background(), "addr:127.0.0.1", "other"); err == nil {
+		t.Error("expected second gateway request to be refused despite a new session key")
+	}
+}
+
+func TestAdmitRequest_Disabled(t *testing.T) {
+	a := newCommandTestApp(t)
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		if got, over := a.admitRequest(ctx, quota.Subject{Channel: "slack", UserID: "U1"}, ""); over != "" || got != ctx {
+			t.Fatalf("request %d: expected pass-through, got %q", i, over)
+		}
+	}
+}
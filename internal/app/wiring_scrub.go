@@ -0,0 +1,49 @@
+package app
+
+import (
+	"github.com/langoai/lango/internal/agent"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/security"
+	"github.com/langoai/lango/internal/session"
+)
+
+// newSecretScrubber builds the scrubber for persisted content from config.
+// Detected secrets are kept in the secrets store when it is available.
+func newSecretScrubber(cfg config.ScrubConfig, scanner *agent.SecretScanner, refs agent.RefIssuer, secrets *security.SecretsStore) *agent.SecretScrubber {
+	var vault agent.VaultFunc
+	if cfg.StoreDetected && secrets != nil {
+		vault = secrets.StoreIfChanged
+	}
+	return agent.NewSecretScrubber(agent.SecretScrubberConfig{
+		Scanner:          scanner,
+		Refs:             refs,
+		Vault:            vault,
+		DisabledPatterns: cfg.DisabledPatterns,
+		CustomPatterns:   cfg.CustomPatterns,
+	})
+}
+
+// wireScrubber installs the secret scrubber on every store that persists
+// conversation-derived content: session messages, observational memory,
+// knowledge and the graph buffer.
+func wireScrubber(cfg *config.Config, scrubber *agent.SecretScrubber, store session.Store, kc *knowledgeComponents, mc *memoryComponents, gc *graphComponents) {
+	if !cfg.Security.Scrub.Enabled {
+		logger().Info("secret scrubbing disabled")
+		return
+	}
+
+	scrub := scrubber.Scrub
+	if entStore, ok := store.(*session.EntStore); ok {
+		entStore.SetScrubber(scrub)
+	}
+	if kc != nil {
+		kc.store.SetScrubber(scrub)
+	}
+	if mc != nil {
+		mc.store.SetScrubber(scrub)
+	}
+	if gc != nil && gc.buffer != nil {
+		gc.buffer.SetScrubber(scrub)
+	}
+	logger().Info("secret scrubbing enabled for persisted content")
+}
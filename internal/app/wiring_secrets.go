@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+	cronpkg "github.com/langoai/lango/internal/cron"
+	"github.com/langoai/lango/internal/security"
+	"github.com/langoai/lango/internal/session"
+)
+
+// wireSecrets applies the secrets config to the secrets store and records
+// every resolved reference token in the access log.
+func wireSecrets(cfg config.SecretsConfig, secrets *security.SecretsStore, refs *security.RefStore) {
+	if secrets == nil {
+		return
+	}
+	secrets.SetKeepVersions(cfg.KeepVersions)
+
+	if !cfg.AccessLog {
+		logger().Info("secret access log disabled")
+		return
+	}
+	refs.SetAccessRecorder(func(ctx context.Context, a security.RefAccess) {
+		err := secrets.RecordAccess(ctx, security.SecretAccess{
+			Name:       a.Name,
+			Kind:       a.Kind,
+			Accessor:   a.Accessor,
+			SessionKey: session.SessionKeyFromContext(ctx),
+		})
+		if err != nil {
+			logger().Warnw("record secret access", "secret", a.Name, "error", err)
+		}
+	})
+}
+
+// secretExpiryMonitor warns the configured channels about secrets that
+// expire soon. Each secret is warned about once per expiry.
+type secretExpiryMonitor struct {
+	secrets  *security.SecretsStore
+	notifier *cronpkg.Delivery
+	cfg      config.SecretExpiryConfig
+	stop     chan struct{}
+}
+
+// initSecretExpiry creates the expiry monitor if secrets are available and
+// a delivery target is configured.
+func initSecretExpiry(cfg config.SecretExpiryConfig, secrets *security.SecretsStore, app *App) *secretExpiryMonitor {
+	if secrets == nil || len(cfg.DeliverTo) == 0 {
+		return nil
+	}
+	logger().Infow("secret expiry warnings enabled",
+		"warnBefore", cfg.WarnBefore,
+		"deliverTo", cfg.DeliverTo,
+	)
+	return &secretExpiryMonitor{
+		secrets:  secrets,
+		notifier: cronpkg.NewDelivery(newChannelSender(app), nil, logger()),
+		cfg:      cfg,
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start checks for expiring secrets now and then every check interval.
+func (m *secretExpiryMonitor) Start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(m.cfg.CheckInterval)
+		defer ticker.Stop()
+		for {
+			m.check(ctx, time.Now())
+			select {
+			case <-ticker.C:
+			case <-m.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the monitor.
+func (m *secretExpiryMonitor) Stop() {
+	close(m.stop)
+}
+
+// check sends one warning for all secrets expiring within the warning
+// window and marks them as notified. On a delivery error they are retried
+// on the next check.
+func (m *secretExpiryMonitor) check(ctx context.Context, now time.Time) {
+	expiring, err := m.secrets.Expiring(ctx, now.Add(m.cfg.WarnBefore))
+	if err != nil {
+		logger().Warnw("check secret expiry", "error", err)
+		return
+	}
+	if len(expiring) == 0 {
+		return
+	}
+
+	if err := m.notifier.Notify(ctx, "Secret expiry", formatExpiryWarning(expiring, now), m.cfg.DeliverTo); err != nil {
+		logger().Warnw("send secret expiry warning", "error", err)
+		return
+	}
+	for _, s := range expiring {
+		if err := m.secrets.MarkExpiryNotified(ctx, s.Name); err != nil {
+			logger().Warnw("mark secret expiry notified", "secret", s.Name, "error", err)
+		}
+	}
+}
+
+// formatExpiryWarning lists the secrets with their expiry and owner.
+func formatExpiryWarning(expiring []*security.SecretInfo, now time.Time) string {
+	var b strings.Builder
+	for i, s := range expiring {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		if s.Expired(now) {
+			fmt.Fprintf(&b, "- %s expired on %s", s.Name, s.ExpiresAt.UTC().Format(time.RFC3339))
+		} else {
+			fmt.Fprintf(&b, "- %s expires on %s", s.Name, s.ExpiresAt.UTC().Format(time.RFC3339))
+		}
+		if s.Owner != "" {
+			fmt.Fprintf(&b, " (owner: %s)", s.Owner)
+		}
+	}
+	b.WriteString("\nRotate with: lango security secrets rotate <name>")
+	return b.String()
+}
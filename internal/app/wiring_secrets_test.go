@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent/enttest"
+	"github.com/langoai/lango/internal/security"
+	"github.com/langoai/lango/internal/session"
+)
+
+func newTestSecretsStore(t *testing.T) *security.SecretsStore {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "secrets.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	crypto := security.NewLocalCryptoProvider()
+	if err := crypto.Initialize("test-passphrase-12345"); err != nil {
+		t.Fatalf("initialize crypto: %v", err)
+	}
+	registry := security.NewKeyRegistry(client)
+	if _, err := registry.RegisterKey(context.Background(), "default", "local", security.KeyTypeEncryption); err != nil {
+		t.Fatalf("register key: %v", err)
+	}
+	return security.NewSecretsStore(client, registry, crypto)
+}
+
+func TestWireSecrets_RecordsAccess(t *testing.T) {
+	secrets := newTestSecretsStore(t)
+	refs := security.NewRefStore()
+	wireSecrets(config.SecretsConfig{KeepVersions: 5, AccessLog: true}, secrets, refs)
+
+	token := refs.Store("api-key", []byte("s3cret"))
+	ctx := session.WithSessionKey(context.Background(), "telegram:1")
+	if got := refs.ResolveAllFor(ctx, "exec", "curl -H "+token); got != "curl -H s3cret" {
+		t.Fatalf("resolved = %q", got)
+	}
+
+	log, err := secrets.AccessLog(context.Background(), "api-key", 0)
+	if err != nil {
+		t.Fatalf("access log: %v", err)
+	}
+	if len(log) != 1 {
+		t.Fatalf("accesses = %d, want 1", len(log))
+	}
+	if log[0].Accessor != "exec" || log[0].SessionKey != "telegram:1" {
+		t.Errorf("access = %+v, want exec in telegram:1", log[0])
+	}
+}
+
+func TestFormatExpiryWarning(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	soon := now.Add(48 * time.Hour)
+
+	got := formatExpiryWarning([]*security.SecretInfo{
+		{Name: "old-token", ExpiresAt: &past},
+		{Name: "api-key", ExpiresAt: &soon, Owner: "ops"},
+	}, now)
+
+	for _, want := range []string{
+		"- old-token expired on 2026-03-01T11:00:00Z",
+		"- api-key expires on 2026-03-03T12:00:00Z (owner: ops)",
+		"lango security secrets rotate <name>",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("warning missing %q:\n%s", want, got)
+		}
+	}
+}
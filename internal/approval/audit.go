@@ -0,0 +1,63 @@
+package approval
+
+import (
+	"context"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// DecisionSource tells what decided an approval.
+type DecisionSource string
+
+const (
+	// SourceUser is an answer of the approval provider: a person, or
+	// headless auto-approve.
+	SourceUser DecisionSource = "user"
+	// SourceGrant is an "always allow" grant.
+	SourceGrant DecisionSource = "grant"
+	// SourcePolicy is a tool approval policy rule.
+	SourcePolicy DecisionSource = "policy"
+	// SourceSpendingLimit is a payment below the auto-approve threshold.
+	SourceSpendingLimit DecisionSource = "spending_limit"
+)
+
+// Decision is an approval decision for the audit trail.
+type Decision struct {
+	SessionKey  string
+	ToolName    string
+	Approved    bool
+	AlwaysAllow bool // the user chose "always allow"
+	Source      DecisionSource
+	Rule        string // deciding policy rule, for policy decisions
+	Grant       *Grant // grant used or created, if any
+	Summary     string
+	Error       string // why no answer was received, if the request failed
+}
+
+// AuditRecorder writes approval decisions to the audit log.
+type AuditRecorder interface {
+	RecordDecision(ctx context.Context, d Decision) error
+}
+
+// HistoryFilter selects audit entries. Empty fields match everything.
+type HistoryFilter struct {
+	SessionKey string
+	ToolName   string
+	Limit      int // 0: all
+}
+
+// HistoryEntry is an approval decision or grant revocation from the audit
+// log.
+type HistoryEntry struct {
+	Time       time.Time
+	SessionKey string
+	ToolName   string
+	Outcome    string // "approved", "denied" or "revoked"
+	Source     string // a DecisionSource, or who revoked the grant
+	Rule       string
+	GrantID    string
+	Scope      config.GrantScope
+	Summary    string
+	Error      string
+}
@@ -1,102 +1,292 @@
 package approval
 
 import (
+	"context"
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/logging"
 )
 
-// grantEntry tracks when a grant was created for TTL expiration.
-type grantEntry struct {
-	grantedAt time.Time
+// Grant is an "always allow" grant of a tool.
+type Grant struct {
+	ID         uuid.UUID
+	ToolName   string
+	Scope      config.GrantScope
+	Subject    string // session key, "channel:user", or empty for global grants
+	Channel    string // channel the grant was created from
+	SessionKey string // session the grant was created from
+	CreatedAt  time.Time
+	ExpiresAt  time.Time // zero: never
+}
+
+// Expired reports whether the grant has expired at now.
+func (g Grant) Expired(now time.Time) bool {
+	return !g.ExpiresAt.IsZero() && now.After(g.ExpiresAt)
+}
+
+// Covers reports whether the grant allows the tool in the session.
+func (g Grant) Covers(sessionKey, toolName string) bool {
+	if g.ToolName != toolName {
+		return false
+	}
+	switch g.Scope {
+	case config.GrantScopeGlobal:
+		return true
+	case config.GrantScopeUser:
+		return g.Subject == userOf(sessionKey)
+	default:
+		return g.Subject == sessionKey
+	}
+}
+
+// GrantFilter selects grants. Empty fields match everything.
+type GrantFilter struct {
+	ID       uuid.UUID
+	ToolName string
+	Scope    config.GrantScope
+	Subject  string
+	Before   time.Time // grants expiring before this time
+}
+
+// GrantRepository persists grants.
+type GrantRepository interface {
+	// SaveGrant stores g, replacing the grant with the same tool, scope
+	// and subject.
+	SaveGrant(ctx context.Context, g Grant) (Grant, error)
+	ListGrants(ctx context.Context, f GrantFilter) ([]Grant, error)
+	// DeleteGrants removes the matching grants and returns them.
+	DeleteGrants(ctx context.Context, f GrantFilter) ([]Grant, error)
 }
 
-// GrantStore tracks per-session, per-tool "always allow" grants in memory.
-// Grants are cleared on application restart (no persistence).
-// An optional TTL causes grants to expire automatically.
+// GrantStore tracks "always allow" grants. Grants are kept in memory and
+// cleared on restart unless the store is backed by a persistent
+// GrantRepository. An optional TTL causes grants to expire automatically.
 type GrantStore struct {
-	mu     sync.RWMutex
-	grants map[string]grantEntry // key = "sessionKey\x00toolName"
-	ttl    time.Duration         // 0 = no expiry (backward compatible default)
-	nowFn  func() time.Time      // for testing; defaults to time.Now
+	mu    sync.RWMutex
+	repo  GrantRepository
+	scope config.GrantScope
+	ttl   time.Duration    // 0 = no expiry (backward compatible default)
+	nowFn func() time.Time // for testing; defaults to time.Now
 }
 
-// NewGrantStore creates an empty GrantStore with no TTL.
+// NewGrantStore creates an empty in-memory GrantStore with session scope and
+// no TTL.
 func NewGrantStore() *GrantStore {
+	return NewGrantStoreWithRepository(newMemoryGrants())
+}
+
+// NewGrantStoreWithRepository creates a GrantStore backed by repo.
+func NewGrantStoreWithRepository(repo GrantRepository) *GrantStore {
 	return &GrantStore{
-		grants: make(map[string]grantEntry),
-		nowFn:  time.Now,
+		repo:  repo,
+		scope: config.GrantScopeSession,
+		nowFn: time.Now,
 	}
 }
 
-// SetTTL sets the time-to-live for grants. Zero disables expiry.
+// SetTTL sets the time-to-live for new grants. Zero disables expiry.
 func (s *GrantStore) SetTTL(ttl time.Duration) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.ttl = ttl
 }
 
-func grantKey(sessionKey, toolName string) string {
-	return sessionKey + "\x00" + toolName
+// SetScope sets the scope of new grants.
+func (s *GrantStore) SetScope(scope config.GrantScope) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scope = scope
+}
+
+// Create records a grant of the tool made in the given session. Grants made
+// from P2P sessions always have session scope, so a remote peer cannot
+// obtain a grant that covers local users.
+func (s *GrantStore) Create(ctx context.Context, sessionKey, toolName string) (Grant, error) {
+	s.mu.RLock()
+	scope, ttl, now := s.scope, s.ttl, s.nowFn()
+	s.mu.RUnlock()
+
+	channel := channelOf(sessionKey)
+	if channel == "p2p" || (scope == config.GrantScopeUser && userOf(sessionKey) == "") {
+		scope = config.GrantScopeSession
+	}
+
+	g := Grant{
+		ToolName:   toolName,
+		Scope:      scope,
+		Channel:    channel,
+		SessionKey: sessionKey,
+		CreatedAt:  now,
+	}
+	switch scope {
+	case config.GrantScopeUser:
+		g.Subject = userOf(sessionKey)
+	case config.GrantScopeGlobal:
+	default:
+		g.Subject = sessionKey
+	}
+	if ttl > 0 {
+		g.ExpiresAt = now.Add(ttl)
+	}
+	return s.repo.SaveGrant(ctx, g)
+}
+
+// Find returns the valid (non-expired) grant that allows the tool in the
+// session.
+func (s *GrantStore) Find(ctx context.Context, sessionKey, toolName string) (Grant, bool) {
+	grants, err := s.repo.ListGrants(ctx, GrantFilter{ToolName: toolName})
+	if err != nil {
+		logging.App().Warnw("list approval grants", "tool", toolName, "error", err)
+		return Grant{}, false
+	}
+	now := s.now()
+	for _, g := range grants {
+		if g.Covers(sessionKey, toolName) && !g.Expired(now) {
+			return g, true
+		}
+	}
+	return Grant{}, false
 }
 
 // Grant records an approval for the given session and tool.
 func (s *GrantStore) Grant(sessionKey, toolName string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	s.grants[grantKey(sessionKey, toolName)] = grantEntry{grantedAt: s.nowFn()}
+	if _, err := s.Create(context.Background(), sessionKey, toolName); err != nil {
+		logging.App().Warnw("save approval grant", "tool", toolName, "error", err)
+	}
 }
 
 // IsGranted reports whether the tool has a valid (non-expired) grant.
 func (s *GrantStore) IsGranted(sessionKey, toolName string) bool {
+	_, ok := s.Find(context.Background(), sessionKey, toolName)
+	return ok
+}
+
+// Revoke removes the grants that allow the tool in the given session.
+func (s *GrantStore) Revoke(sessionKey, toolName string) {
+	ctx := context.Background()
+	grants, err := s.repo.ListGrants(ctx, GrantFilter{ToolName: toolName})
+	if err != nil {
+		logging.App().Warnw("list approval grants", "tool", toolName, "error", err)
+		return
+	}
+	for _, g := range grants {
+		if !g.Covers(sessionKey, toolName) {
+			continue
+		}
+		if _, err := s.repo.DeleteGrants(ctx, GrantFilter{ID: g.ID}); err != nil {
+			logging.App().Warnw("revoke approval grant", "id", g.ID, "error", err)
+		}
+	}
+}
+
+// RevokeSession removes all session-scoped grants of the given session.
+func (s *GrantStore) RevokeSession(sessionKey string) {
+	_, err := s.repo.DeleteGrants(context.Background(), GrantFilter{
+		Scope:   config.GrantScopeSession,
+		Subject: sessionKey,
+	})
+	if err != nil {
+		logging.App().Warnw("revoke session approval grants", "session", sessionKey, "error", err)
+	}
+}
+
+// CleanExpired removes all grants that have expired.
+// Returns the number of entries removed.
+func (s *GrantStore) CleanExpired() int {
+	removed, err := s.repo.DeleteGrants(context.Background(), GrantFilter{Before: s.now()})
+	if err != nil {
+		logging.App().Warnw("clean expired approval grants", "error", err)
+	}
+	return len(removed)
+}
+
+func (s *GrantStore) now() time.Time {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
-	entry, ok := s.grants[grantKey(sessionKey, toolName)]
-	if !ok {
-		return false
+	return s.nowFn()
+}
+
+// channelOf returns the channel prefix of a session key, or "gateway" for
+// keys without one.
+func channelOf(sessionKey string) string {
+	if i := strings.Index(sessionKey, ":"); i > 0 {
+		return sessionKey[:i]
 	}
-	if s.ttl > 0 && s.nowFn().Sub(entry.grantedAt) > s.ttl {
-		return false
+	return "gateway"
+}
+
+// userOf returns the "channel:user" identity of a per-user session key
+// ("channel:chat:user"). Shared and per-thread sessions have no single user
+// and return "".
+func userOf(sessionKey string) string {
+	parts := strings.SplitN(sessionKey, ":", 3)
+	if len(parts) < 3 || parts[2] == "" || strings.HasPrefix(parts[2], "thread:") {
+		return ""
 	}
-	return true
+	return parts[0] + ":" + parts[2]
 }
 
-// Revoke removes a single tool grant for the given session.
-func (s *GrantStore) Revoke(sessionKey, toolName string) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	delete(s.grants, grantKey(sessionKey, toolName))
+// memoryGrants is the in-memory GrantRepository.
+type memoryGrants struct {
+	mu     sync.Mutex
+	grants map[uuid.UUID]Grant
 }
 
-// RevokeSession removes all grants for the given session.
-func (s *GrantStore) RevokeSession(sessionKey string) {
-	prefix := sessionKey + "\x00"
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	for k := range s.grants {
-		if strings.HasPrefix(k, prefix) {
-			delete(s.grants, k)
+func newMemoryGrants() *memoryGrants {
+	return &memoryGrants{grants: make(map[uuid.UUID]Grant)}
+}
+
+func (m *memoryGrants) SaveGrant(_ context.Context, g Grant) (Grant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, old := range m.grants {
+		if old.ToolName == g.ToolName && old.Scope == g.Scope && old.Subject == g.Subject {
+			delete(m.grants, id)
 		}
 	}
+	g.ID = uuid.New()
+	m.grants[g.ID] = g
+	return g, nil
 }
 
-// CleanExpired removes all grants that have exceeded the TTL.
-// Returns the number of entries removed. No-op when TTL is zero.
-func (s *GrantStore) CleanExpired() int {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	if s.ttl == 0 {
-		return 0
+func (m *memoryGrants) ListGrants(_ context.Context, f GrantFilter) ([]Grant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Grant
+	for _, g := range m.grants {
+		if f.match(g) {
+			out = append(out, g)
+		}
 	}
+	return out, nil
+}
 
-	now := s.nowFn()
-	removed := 0
-	for k, entry := range s.grants {
-		if now.Sub(entry.grantedAt) > s.ttl {
-			delete(s.grants, k)
-			removed++
+func (m *memoryGrants) DeleteGrants(_ context.Context, f GrantFilter) ([]Grant, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []Grant
+	for id, g := range m.grants {
+		if f.match(g) {
+			delete(m.grants, id)
+			out = append(out, g)
 		}
 	}
-	return removed
+	return out, nil
+}
+
+func (f GrantFilter) match(g Grant) bool {
+	switch {
+	case f.ID != uuid.Nil && g.ID != f.ID,
+		f.ToolName != "" && g.ToolName != f.ToolName,
+		f.Scope != "" && g.Scope != f.Scope,
+		f.Subject != "" && g.Subject != f.Subject,
+		!f.Before.IsZero() && (g.ExpiresAt.IsZero() || !g.ExpiresAt.Before(f.Before)):
+		return false
+	}
+	return true
 }
@@ -1,9 +1,12 @@
 package approval
 
 import (
+	"context"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/langoai/lango/internal/config"
 )
 
 func TestGrantStore_GrantAndIsGranted(t *testing.T) {
@@ -186,3 +189,64 @@ func TestGrantStore_CleanExpiredNoOpWhenTTLZero(t *testing.T) {
 		t.Error("grant should remain when TTL=0")
 	}
 }
+
+func TestGrantStore_Scopes(t *testing.T) {
+	tests := []struct {
+		give        config.GrantScope
+		giveSession string
+		wantScope   config.GrantScope
+		wantCovers  map[string]bool
+	}{
+		{
+			give:        config.GrantScopeSession,
+			giveSession: "telegram:100:7",
+			wantScope:   config.GrantScopeSession,
+			wantCovers:  map[string]bool{"telegram:100:7": true, "telegram:200:7": false},
+		},
+		{
+			give:        config.GrantScopeUser,
+			giveSession: "telegram:100:7",
+			wantScope:   config.GrantScopeUser,
+			wantCovers:  map[string]bool{"telegram:200:7": true, "telegram:100:8": false, "discord:100:7": false},
+		},
+		{
+			// Shared sessions have no single user and fall back to session scope.
+			give:        config.GrantScopeUser,
+			giveSession: "slack:C1",
+			wantScope:   config.GrantScopeSession,
+			wantCovers:  map[string]bool{"slack:C1": true, "slack:C2": false},
+		},
+		{
+			give:        config.GrantScopeGlobal,
+			giveSession: "telegram:100:7",
+			wantScope:   config.GrantScopeGlobal,
+			wantCovers:  map[string]bool{"discord:1:2": true, "default": true},
+		},
+		{
+			// P2P grants never cover other sessions.
+			give:        config.GrantScopeGlobal,
+			giveSession: "p2p:did:key:abc",
+			wantScope:   config.GrantScopeSession,
+			wantCovers:  map[string]bool{"p2p:did:key:abc": true, "telegram:1:2": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.give)+" "+tt.giveSession, func(t *testing.T) {
+			gs := NewGrantStore()
+			gs.SetScope(tt.give)
+			g, err := gs.Create(context.Background(), tt.giveSession, "exec")
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+			if g.Scope != tt.wantScope {
+				t.Errorf("expected scope %q, got %q", tt.wantScope, g.Scope)
+			}
+			for session, want := range tt.wantCovers {
+				if got := gs.IsGranted(session, "exec"); got != want {
+					t.Errorf("IsGranted(%q) = %v, want %v", session, got, want)
+				}
+			}
+		})
+	}
+}
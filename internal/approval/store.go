@@ -0,0 +1,249 @@
+package approval
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
+	"github.com/langoai/lango/internal/ent/auditlog"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// EntStore persists grants and records approval decisions in the audit log
+// using the Ent ORM client.
+type EntStore struct {
+	client *ent.Client
+}
+
+var (
+	_ GrantRepository = (*EntStore)(nil)
+	_ AuditRecorder   = (*EntStore)(nil)
+)
+
+// NewEntStore creates a new EntStore backed by the given Ent client.
+func NewEntStore(client *ent.Client) *EntStore {
+	return &EntStore{client: client}
+}
+
+// SaveGrant stores g, replacing the grant with the same tool, scope and
+// subject.
+func (s *EntStore) SaveGrant(ctx context.Context, g Grant) (Grant, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return Grant{}, fmt.Errorf("begin grant transaction: %w", err)
+	}
+
+	_, err = tx.ApprovalGrant.Delete().
+		Where(
+			approvalgrant.ToolName(g.ToolName),
+			approvalgrant.ScopeEQ(approvalgrant.Scope(g.Scope)),
+			approvalgrant.Subject(g.Subject),
+		).
+		Exec(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Grant{}, fmt.Errorf("replace grant: %w", err)
+	}
+
+	builder := tx.ApprovalGrant.Create().
+		SetToolName(g.ToolName).
+		SetScope(approvalgrant.Scope(g.Scope)).
+		SetSubject(g.Subject).
+		SetChannel(g.Channel).
+		SetSessionKey(g.SessionKey)
+	// Times are kept in UTC: SQLite compares them as strings.
+	if !g.CreatedAt.IsZero() {
+		builder.SetCreatedAt(g.CreatedAt.UTC())
+	}
+	if !g.ExpiresAt.IsZero() {
+		builder.SetExpiresAt(g.ExpiresAt.UTC())
+	}
+	created, err := builder.Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return Grant{}, fmt.Errorf("create grant: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return Grant{}, fmt.Errorf("commit grant: %w", err)
+	}
+	return grantFromEnt(created), nil
+}
+
+// ListGrants returns the grants matching f, newest first.
+func (s *EntStore) ListGrants(ctx context.Context, f GrantFilter) ([]Grant, error) {
+	rows, err := s.client.ApprovalGrant.Query().
+		Where(grantPredicates(f)...).
+		Order(ent.Desc(approvalgrant.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list grants: %w", err)
+	}
+	grants := make([]Grant, 0, len(rows))
+	for _, row := range rows {
+		grants = append(grants, grantFromEnt(row))
+	}
+	return grants, nil
+}
+
+// DeleteGrants removes the grants matching f and returns them.
+func (s *EntStore) DeleteGrants(ctx context.Context, f GrantFilter) ([]Grant, error) {
+	grants, err := s.ListGrants(ctx, f)
+	if err != nil || len(grants) == 0 {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(grants))
+	for _, g := range grants {
+		ids = append(ids, g.ID)
+	}
+	if _, err := s.client.ApprovalGrant.Delete().Where(approvalgrant.IDIn(ids...)).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("delete grants: %w", err)
+	}
+	return grants, nil
+}
+
+// RecordDecision writes an approval decision to the audit log.
+func (s *EntStore) RecordDecision(ctx context.Context, d Decision) error {
+	details := map[string]interface{}{
+		"approved": d.Approved,
+		"source":   string(d.Source),
+	}
+	if d.AlwaysAllow {
+		details["alwaysAllow"] = true
+	}
+	if d.Rule != "" {
+		details["rule"] = d.Rule
+	}
+	if d.Grant != nil {
+		details["grantId"] = d.Grant.ID.String()
+		details["scope"] = string(d.Grant.Scope)
+	}
+	if d.Summary != "" {
+		details["summary"] = d.Summary
+	}
+	if d.Error != "" {
+		details["error"] = d.Error
+	}
+	return s.saveAudit(ctx, auditlog.ActionApprovalResponse, string(d.Source), d.SessionKey, d.ToolName, details)
+}
+
+// RecordRevoke writes the revocation of a grant by actor to the audit log.
+func (s *EntStore) RecordRevoke(ctx context.Context, g Grant, actor string) error {
+	details := map[string]interface{}{
+		"grantId": g.ID.String(),
+		"scope":   string(g.Scope),
+		"channel": g.Channel,
+	}
+	if g.Subject != "" {
+		details["subject"] = g.Subject
+	}
+	return s.saveAudit(ctx, auditlog.ActionApprovalRevoke, actor, g.SessionKey, g.ToolName, details)
+}
+
+func (s *EntStore) saveAudit(ctx context.Context, action auditlog.Action, actor, sessionKey, target string, details map[string]interface{}) error {
+	builder := s.client.AuditLog.Create().
+		SetAction(action).
+		SetActor(actor).
+		SetTarget(target).
+		SetDetails(details)
+	if sessionKey != "" {
+		builder.SetSessionKey(sessionKey)
+	}
+	if _, err := builder.Save(ctx); err != nil {
+		return fmt.Errorf("create audit log: %w", err)
+	}
+	return nil
+}
+
+// History returns approval decisions and grant revocations, newest first.
+func (s *EntStore) History(ctx context.Context, f HistoryFilter) ([]HistoryEntry, error) {
+	preds := []predicate.AuditLog{
+		auditlog.ActionIn(auditlog.ActionApprovalResponse, auditlog.ActionApprovalRevoke),
+	}
+	if f.SessionKey != "" {
+		preds = append(preds, auditlog.SessionKey(f.SessionKey))
+	}
+	if f.ToolName != "" {
+		preds = append(preds, auditlog.Target(f.ToolName))
+	}
+
+	query := s.client.AuditLog.Query().
+		Where(preds...).
+		Order(ent.Desc(auditlog.FieldTimestamp))
+	if f.Limit > 0 {
+		query = query.Limit(f.Limit)
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query approval history: %w", err)
+	}
+
+	entries := make([]HistoryEntry, 0, len(rows))
+	for _, row := range rows {
+		e := HistoryEntry{
+			Time:       row.Timestamp,
+			SessionKey: row.SessionKey,
+			ToolName:   row.Target,
+			Source:     row.Actor,
+			Rule:       detailString(row.Details, "rule"),
+			GrantID:    detailString(row.Details, "grantId"),
+			Scope:      config.GrantScope(detailString(row.Details, "scope")),
+			Summary:    detailString(row.Details, "summary"),
+			Error:      detailString(row.Details, "error"),
+		}
+		switch {
+		case row.Action == auditlog.ActionApprovalRevoke:
+			e.Outcome = "revoked"
+		case row.Details["approved"] == true:
+			e.Outcome = "approved"
+		default:
+			e.Outcome = "denied"
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func detailString(details map[string]interface{}, key string) string {
+	s, _ := details[key].(string)
+	return s
+}
+
+func grantPredicates(f GrantFilter) []predicate.ApprovalGrant {
+	var preds []predicate.ApprovalGrant
+	if f.ID != uuid.Nil {
+		preds = append(preds, approvalgrant.ID(f.ID))
+	}
+	if f.ToolName != "" {
+		preds = append(preds, approvalgrant.ToolName(f.ToolName))
+	}
+	if f.Scope != "" {
+		preds = append(preds, approvalgrant.ScopeEQ(approvalgrant.Scope(f.Scope)))
+	}
+	if f.Subject != "" {
+		preds = append(preds, approvalgrant.Subject(f.Subject))
+	}
+	if !f.Before.IsZero() {
+		preds = append(preds, approvalgrant.ExpiresAtLT(f.Before.UTC()))
+	}
+	return preds
+}
+
+func grantFromEnt(row *ent.ApprovalGrant) Grant {
+	g := Grant{
+		ID:         row.ID,
+		ToolName:   row.ToolName,
+		Scope:      config.GrantScope(row.Scope),
+		Subject:    row.Subject,
+		Channel:    row.Channel,
+		SessionKey: row.SessionKey,
+		CreatedAt:  row.CreatedAt,
+	}
+	if row.ExpiresAt != nil {
+		g.ExpiresAt = *row.ExpiresAt
+	}
+	return g
+}
@@ -0,0 +1,134 @@
+package approval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent/enttest"
+)
+
+func newTestEntStore(t *testing.T) *EntStore {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return NewEntStore(client)
+}
+
+func TestEntStore_GrantsSurviveRestart(t *testing.T) {
+	store := newTestEntStore(t)
+	ctx := context.Background()
+
+	gs := NewGrantStoreWithRepository(store)
+	gs.SetTTL(time.Hour)
+	g, err := gs.Create(ctx, "telegram:1:2", "exec")
+	if err != nil {
+		t.Fatalf("create grant: %v", err)
+	}
+	if g.Channel != "telegram" || g.Scope != config.GrantScopeSession || g.ExpiresAt.IsZero() {
+		t.Errorf("unexpected grant: %+v", g)
+	}
+
+	// A new GrantStore over the same database sees the grant.
+	restarted := NewGrantStoreWithRepository(store)
+	found, ok := restarted.Find(ctx, "telegram:1:2", "exec")
+	if !ok || found.ID != g.ID {
+		t.Fatalf("expected grant %s after restart, got %+v, %v", g.ID, found, ok)
+	}
+
+	// Granting again replaces the grant instead of adding one.
+	if _, err := gs.Create(ctx, "telegram:1:2", "exec"); err != nil {
+		t.Fatalf("create grant: %v", err)
+	}
+	grants, err := store.ListGrants(ctx, GrantFilter{})
+	if err != nil {
+		t.Fatalf("list grants: %v", err)
+	}
+	if len(grants) != 1 {
+		t.Errorf("expected 1 grant, got %d", len(grants))
+	}
+
+	restarted.RevokeSession("telegram:1:2")
+	if gs.IsGranted("telegram:1:2", "exec") {
+		t.Error("expected grant to be revoked")
+	}
+}
+
+func TestEntStore_CleanExpired(t *testing.T) {
+	store := newTestEntStore(t)
+	now := time.Now()
+
+	gs := NewGrantStoreWithRepository(store)
+	gs.nowFn = func() time.Time { return now }
+	gs.SetTTL(5 * time.Minute)
+	gs.Grant("s1", "exec")
+	gs.SetTTL(0)
+	gs.Grant("s2", "exec")
+
+	gs.nowFn = func() time.Time { return now.Add(6 * time.Minute) }
+	if gs.IsGranted("s1", "exec") {
+		t.Error("expected s1 grant to be expired")
+	}
+	if removed := gs.CleanExpired(); removed != 1 {
+		t.Errorf("expected 1 expired grant removed, got %d", removed)
+	}
+	if !gs.IsGranted("s2", "exec") {
+		t.Error("expected grant without expiry to remain")
+	}
+}
+
+func TestEntStore_History(t *testing.T) {
+	store := newTestEntStore(t)
+	ctx := context.Background()
+
+	g, err := store.SaveGrant(ctx, Grant{ToolName: "exec", Scope: config.GrantScopeGlobal, Channel: "gateway"})
+	if err != nil {
+		t.Fatalf("save grant: %v", err)
+	}
+	decisions := []Decision{
+		{SessionKey: "s1", ToolName: "exec", Approved: true, AlwaysAllow: true, Source: SourceUser, Grant: &g, Summary: "Execute: ls"},
+		{SessionKey: "s1", ToolName: "exec", Source: SourcePolicy, Rule: "no-rm"},
+		{SessionKey: "s2", ToolName: "fs_write", Source: SourceUser, Error: "approval timeout"},
+	}
+	for _, d := range decisions {
+		if err := store.RecordDecision(ctx, d); err != nil {
+			t.Fatalf("record decision: %v", err)
+		}
+	}
+	if err := store.RecordRevoke(ctx, g, "cli"); err != nil {
+		t.Fatalf("record revoke: %v", err)
+	}
+
+	entries, err := store.History(ctx, HistoryFilter{ToolName: "exec"})
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+
+	outcomes := make(map[string]HistoryEntry)
+	for _, e := range entries {
+		outcomes[e.Outcome+"/"+e.Source] = e
+	}
+	if e, ok := outcomes["approved/user"]; !ok || e.GrantID != g.ID.String() || e.Scope != config.GrantScopeGlobal {
+		t.Errorf("unexpected approved entry: %+v", e)
+	}
+	if e, ok := outcomes["denied/policy"]; !ok || e.Rule != "no-rm" {
+		t.Errorf("unexpected denied entry: %+v", e)
+	}
+	if _, ok := outcomes["revoked/cli"]; !ok {
+		t.Errorf("expected a revocation entry, got %+v", entries)
+	}
+
+	limited, err := store.History(ctx, HistoryFilter{SessionKey: "s2", Limit: 5})
+	if err != nil {
+		t.Fatalf("history: %v", err)
+	}
+	if len(limited) != 1 || limited[0].Error != "approval timeout" {
+		t.Errorf("unexpected session history: %+v", limited)
+	}
+}
@@ -0,0 +1,369 @@
+// Package backup creates and restores encrypted archives of Lango's data:
+// the application database (including the sqlite-vec embeddings table), the
+// graph store and the skills directory.
+//
+// A backup file is a header followed by AES-256-GCM encrypted chunks of a
+// gzipped tar archive. The archive starts with a manifest that records the
+// version that wrote it, the database schema and a SHA-256 checksum of every
+// file.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/langoai/lango/internal/dbmigrate"
+	"github.com/langoai/lango/internal/graph"
+)
+
+// Component is a part of Lango's data that can be backed up and restored on
+// its own.
+type Component string
+
+const (
+	ComponentDB     Component = "db"
+	ComponentGraph  Component = "graph"
+	ComponentSkills Component = "skills"
+)
+
+// AllComponents lists every component in archive order.
+var AllComponents = []Component{ComponentDB, ComponentGraph, ComponentSkills}
+
+// ParseComponents parses component names. No names means all components.
+func ParseComponents(names []string) ([]Component, error) {
+	if len(names) == 0 {
+		return AllComponents, nil
+	}
+	var out []Component
+	for _, name := range names {
+		c := Component(strings.TrimSpace(name))
+		if !c.Valid() {
+			return nil, fmt.Errorf("unknown backup component %q (valid: db, graph, skills)", name)
+		}
+		if !containsComponent(out, c) {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+// Valid reports whether c is a known component.
+func (c Component) Valid() bool {
+	return containsComponent(AllComponents, c)
+}
+
+const (
+	// manifestFormat is the version of the archive layout.
+	manifestFormat   = 1
+	manifestName     = "manifest.json"
+	dbArchivePath    = "db/lango.db"
+	graphArchivePath = "graph/graph.db"
+	skillsArchiveDir = "skills/"
+	// graphLockTimeout bounds the wait for the graph database lock.
+	graphLockTimeout = 5 * time.Second
+)
+
+// Manifest describes the content of a backup.
+type Manifest struct {
+	Format       int               `json:"format"`
+	LangoVersion string            `json:"langoVersion"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	DBEncrypted  bool              `json:"dbEncrypted"`
+	Schema       *dbmigrate.Schema `json:"schema,omitempty"`
+	Files        []File            `json:"files"`
+}
+
+// File is a file in a backup.
+type File struct {
+	Component Component `json:"component"`
+	Path      string    `json:"path"`
+	Size      int64     `json:"size"`
+	SHA256    string    `json:"sha256"`
+}
+
+// Components returns the components present in the backup.
+func (m *Manifest) Components() []Component {
+	var out []Component
+	for _, c := range AllComponents {
+		for _, f := range m.Files {
+			if f.Component == c {
+				out = append(out, c)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// CreateOptions configures Create.
+type CreateOptions struct {
+	// DB is the open application database. It is copied with VACUUM INTO,
+	// so the copy is consistent and keeps the database's encryption.
+	DB *sql.DB
+	// DBEncrypted records whether the database is SQLCipher-encrypted.
+	DBEncrypted bool
+	// GraphPath is the graph store file. A missing file is skipped.
+	GraphPath string
+	// SkillsDir is the skills directory. A missing directory is skipped.
+	SkillsDir string
+	// Components selects what to back up; empty means all.
+	Components []Component
+	// Version is the Lango version recorded in the manifest.
+	Version string
+	// Key encrypts the backup.
+	Key Key
+}
+
+// Create writes an encrypted backup to w and returns its manifest.
+func Create(ctx context.Context, w io.Writer, opts CreateOptions) (*Manifest, error) {
+	components := opts.Components
+	if len(components) == 0 {
+		components = AllComponents
+	}
+
+	staging, err := os.MkdirTemp("", "lango-backup-")
+	if err != nil {
+		return nil, fmt.Errorf("create staging dir: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	m := &Manifest{
+		Format:       manifestFormat,
+		LangoVersion: opts.Version,
+		CreatedAt:    time.Now().UTC(),
+		DBEncrypted:  opts.DBEncrypted,
+	}
+
+	if containsComponent(components, ComponentDB) {
+		if opts.DB == nil {
+			return nil, errors.New("back up database: no database connection")
+		}
+		if m.Schema, err = dbmigrate.ReadSchema(ctx, opts.DB); err != nil {
+			return nil, fmt.Errorf("read database schema: %w", err)
+		}
+		if err := stageDB(ctx, opts.DB, filepath.Join(staging, filepath.FromSlash(dbArchivePath))); err != nil {
+			return nil, err
+		}
+	}
+	if containsComponent(components, ComponentGraph) && opts.GraphPath != "" {
+		if err := stageGraph(opts.GraphPath, filepath.Join(staging, filepath.FromSlash(graphArchivePath))); err != nil {
+			return nil, err
+		}
+	}
+	if containsComponent(components, ComponentSkills) && opts.SkillsDir != "" {
+		if err := stageSkills(opts.SkillsDir, filepath.Join(staging, filepath.FromSlash(skillsArchiveDir))); err != nil {
+			return nil, err
+		}
+	}
+
+	if m.Files, err = stagedFiles(staging); err != nil {
+		return nil, err
+	}
+
+	enc, err := Encrypt(ctx, w, opts.Key)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeArchive(enc, staging, m); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func stageDB(ctx context.Context, db *sql.DB, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return fmt.Errorf("create staging dir: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, "VACUUM INTO ?", dst); err != nil {
+		return fmt.Errorf("copy database: %w", err)
+	}
+	return nil
+}
+
+func stageGraph(src, dst string) error {
+	if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return fmt.Errorf("create staging dir: %w", err)
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("create graph copy: %w", err)
+	}
+	if err := graph.Snapshot(src, f, graphLockTimeout); err != nil {
+		f.Close()
+		return fmt.Errorf("copy graph store: %w", err)
+	}
+	return f.Close()
+}
+
+// stageSkills copies the regular files of the skills directory. Symlinks
+// and other special files are skipped.
+func stageSkills(src, dst string) error {
+	if _, err := os.Stat(src); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		return copyFile(p, filepath.Join(dst, rel))
+	})
+}
+
+// stagedFiles lists the staged files with their checksums, in archive order.
+func stagedFiles(staging string) ([]File, error) {
+	var files []File
+	err := filepath.WalkDir(staging, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(staging, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		sum, size, err := fileChecksum(p)
+		if err != nil {
+			return err
+		}
+		files = append(files, File{Component: componentOf(name), Path: name, Size: size, SHA256: sum})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list staged files: %w", err)
+	}
+	return files, nil
+}
+
+// writeArchive writes the manifest and the staged files as a gzipped tar.
+func writeArchive(w io.Writer, staging string, m *Manifest) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	raw, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: manifestName, Mode: 0o600, Size: int64(len(raw)), ModTime: m.CreatedAt}); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	if _, err := tw.Write(raw); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+
+	for _, f := range m.Files {
+		if err := writeArchiveFile(tw, filepath.Join(staging, filepath.FromSlash(f.Path)), f, m.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("write archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("write archive: %w", err)
+	}
+	return nil
+}
+
+func writeArchiveFile(tw *tar.Writer, src string, f File, modTime time.Time) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open %s: %w", f.Path, err)
+	}
+	defer in.Close()
+
+	if err := tw.WriteHeader(&tar.Header{Name: f.Path, Mode: 0o600, Size: f.Size, ModTime: modTime}); err != nil {
+		return fmt.Errorf("write %s: %w", f.Path, err)
+	}
+	if _, err := io.Copy(tw, in); err != nil {
+		return fmt.Errorf("write %s: %w", f.Path, err)
+	}
+	return nil
+}
+
+// componentOf returns the component of an archive path.
+func componentOf(name string) Component {
+	top, _, _ := strings.Cut(name, "/")
+	return Component(top)
+}
+
+func containsComponent(list []Component, c Component) bool {
+	for _, x := range list {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
+
+func fileChecksum(p string) (string, int64, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// validArchivePath reports whether name is a clean relative path inside a
+// known component.
+func validArchivePath(name string) bool {
+	if name != path.Clean(name) || path.IsAbs(name) || strings.HasPrefix(name, "../") {
+		return false
+	}
+	return componentOf(name).Valid() && strings.Contains(name, "/")
+}
@@ -0,0 +1,241 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/dbmigrate"
+	"github.com/langoai/lango/internal/graph"
+)
+
+// fixture is a set of Lango data files to back up.
+type fixture struct {
+	db        *sql.DB
+	dbPath    string
+	graphPath string
+	skillsDir string
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	dir := t.TempDir()
+	f := &fixture{
+		dbPath:    filepath.Join(dir, "lango.db"),
+		graphPath: filepath.Join(dir, "graph.db"),
+		skillsDir: filepath.Join(dir, "skills"),
+	}
+
+	db, err := sql.Open("sqlite3", f.dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec("CREATE TABLE sessions (id INTEGER PRIMARY KEY, key TEXT)")
+	require.NoError(t, err)
+	_, err = db.Exec("INSERT INTO sessions (key) VALUES ('telegram:1')")
+	require.NoError(t, err)
+	f.db = db
+
+	gs, err := graph.NewBoltStore(f.graphPath)
+	require.NoError(t, err)
+	require.NoError(t, gs.AddTriple(context.Background(), graph.Triple{Subject: "lango", Predicate: "is", Object: "agent"}))
+	require.NoError(t, gs.Close())
+
+	require.NoError(t, os.MkdirAll(filepath.Join(f.skillsDir, "deploy"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(f.skillsDir, "deploy", "SKILL.md"), []byte("# deploy"), 0o600))
+	// Spans several encrypted chunks.
+	asset := make([]byte, 3*chunkSize+100)
+	_, err = rand.Read(asset)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(f.skillsDir, "deploy", "asset.bin"), asset, 0o600))
+	return f
+}
+
+func (f *fixture) create(t *testing.T, key Key, components ...Component) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	_, err := Create(context.Background(), &buf, CreateOptions{
+		DB:         f.db,
+		GraphPath:  f.graphPath,
+		SkillsDir:  f.skillsDir,
+		Components: components,
+		Version:    "test",
+		Key:        key,
+	})
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestCreateRestore(t *testing.T) {
+	src := newFixture(t)
+	data := src.create(t, PassphraseKey("correct horse"))
+
+	m, err := Verify(context.Background(), bytes.NewReader(data), PassphraseKey("correct horse"))
+	require.NoError(t, err)
+	assert.Equal(t, "test", m.LangoVersion)
+	assert.Equal(t, AllComponents, m.Components())
+	assert.Contains(t, m.Schema.Tables, "sessions")
+
+	dir := t.TempDir()
+	opts := RestoreOptions{
+		DBPath:    filepath.Join(dir, "lango.db"),
+		GraphPath: filepath.Join(dir, "graph.db"),
+		SkillsDir: filepath.Join(dir, "skills"),
+		Key:       PassphraseKey("correct horse"),
+	}
+	require.NoError(t, os.WriteFile(opts.DBPath, []byte("old"), 0o600))
+	require.NoError(t, os.WriteFile(opts.DBPath+"-wal", []byte("old wal"), 0o600))
+	require.NoError(t, os.WriteFile(opts.DBPath+preRestoreSuffix+"-shm", []byte("stale shm"), 0o600))
+
+	_, restored, err := Restore(context.Background(), bytes.NewReader(data), opts)
+	require.NoError(t, err)
+	assert.Equal(t, AllComponents, restored)
+
+	db, err := sql.Open("sqlite3", opts.DBPath)
+	require.NoError(t, err)
+	defer db.Close()
+	var key string
+	require.NoError(t, db.QueryRow("SELECT key FROM sessions").Scan(&key))
+	assert.Equal(t, "telegram:1", key)
+
+	old, err := os.ReadFile(opts.DBPath + preRestoreSuffix)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(old))
+	wal, err := os.ReadFile(opts.DBPath + preRestoreSuffix + "-wal")
+	require.NoError(t, err)
+	assert.Equal(t, "old wal", string(wal), "the WAL goes with the replaced database")
+	assert.NoFileExists(t, opts.DBPath+preRestoreSuffix+"-shm", "a stale SHM must not pair with the replaced database")
+
+	gs, err := graph.NewBoltStore(opts.GraphPath)
+	require.NoError(t, err)
+	defer gs.Close()
+	triples, err := gs.QueryBySubject(context.Background(), "lango")
+	require.NoError(t, err)
+	assert.Len(t, triples, 1)
+
+	skill, err := os.ReadFile(filepath.Join(opts.SkillsDir, "deploy", "SKILL.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# deploy", string(skill))
+}
+
+func TestRestore_Selective(t *testing.T) {
+	src := newFixture(t)
+	data := src.create(t, PassphraseKey("pass"))
+
+	dir := t.TempDir()
+	opts := RestoreOptions{
+		DBPath:     filepath.Join(dir, "lango.db"),
+		GraphPath:  filepath.Join(dir, "graph.db"),
+		SkillsDir:  filepath.Join(dir, "skills"),
+		Components: []Component{ComponentGraph},
+		Key:        PassphraseKey("pass"),
+	}
+	_, restored, err := Restore(context.Background(), bytes.NewReader(data), opts)
+	require.NoError(t, err)
+	assert.Equal(t, []Component{ComponentGraph}, restored)
+
+	assert.FileExists(t, opts.GraphPath)
+	assert.NoFileExists(t, opts.DBPath)
+	assert.NoDirExists(t, opts.SkillsDir)
+}
+
+func TestRestore_MissingComponent(t *testing.T) {
+	src := newFixture(t)
+	data := src.create(t, PassphraseKey("pass"), ComponentSkills)
+
+	dir := t.TempDir()
+	_, _, err := Restore(context.Background(), bytes.NewReader(data), RestoreOptions{
+		GraphPath:  filepath.Join(dir, "graph.db"),
+		Components: []Component{ComponentGraph},
+		Key:        PassphraseKey("pass"),
+	})
+	assert.EqualError(t, err, "backup does not contain graph")
+}
+
+func TestRestore_NewerSchema(t *testing.T) {
+	src := newFixture(t)
+	data := src.create(t, PassphraseKey("pass"))
+
+	dir := t.TempDir()
+	opts := RestoreOptions{
+		DBPath:        filepath.Join(dir, "lango.db"),
+		CurrentSchema: &dbmigrate.Schema{Tables: map[string][]string{"sessions": {"id"}}},
+		Components:    []Component{ComponentDB},
+		Key:           PassphraseKey("pass"),
+	}
+	_, _, err := Restore(context.Background(), bytes.NewReader(data), opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "column sessions.key")
+	assert.NoFileExists(t, opts.DBPath)
+}
+
+func TestVerify_Errors(t *testing.T) {
+	src := newFixture(t)
+	data := src.create(t, PassphraseKey("pass"))
+
+	flipped := bytes.Clone(data)
+	flipped[len(flipped)-20] ^= 0xff
+
+	tests := []struct {
+		give    string
+		data    []byte
+		key     Key
+		wantErr error
+	}{
+		{give: "wrong passphrase", data: data, key: PassphraseKey("nope"), wantErr: ErrDecrypt},
+		{give: "truncated", data: data[:len(data)-10], key: PassphraseKey("pass"), wantErr: ErrTruncated},
+		{give: "modified", data: flipped, key: PassphraseKey("pass"), wantErr: ErrDecrypt},
+		{give: "not a backup", data: []byte("hello world, not a backup"), key: PassphraseKey("pass"), wantErr: ErrNotBackup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			_, err := Verify(context.Background(), bytes.NewReader(tt.data), tt.key)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// fakeKMS wraps keys by XOR, enough to check the key is wrapped and unwrapped.
+type fakeKMS struct{}
+
+func (fakeKMS) Sign(context.Context, string, []byte) ([]byte, error) { return nil, nil }
+
+func (fakeKMS) Encrypt(_ context.Context, _ string, data []byte) ([]byte, error) {
+	out := bytes.Clone(data)
+	for i := range out {
+		out[i] ^= 0x5a
+	}
+	return out, nil
+}
+
+func (k fakeKMS) Decrypt(ctx context.Context, keyID string, data []byte) ([]byte, error) {
+	return k.Encrypt(ctx, keyID, data)
+}
+
+func TestKMSKey(t *testing.T) {
+	src := newFixture(t)
+	data := src.create(t, KMSKey(fakeKMS{}, "aws-kms", "alias/lango"), ComponentSkills)
+
+	path := filepath.Join(t.TempDir(), "backup.lango")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	h, err := ReadHeader(path)
+	require.NoError(t, err)
+	assert.Equal(t, KDFKMS, h.KDF)
+	assert.Equal(t, "aws-kms", h.KMSProvider)
+	assert.Equal(t, "alias/lango", h.KMSKeyID)
+
+	m, err := Verify(context.Background(), bytes.NewReader(data), KMSKey(fakeKMS{}, h.KMSProvider, h.KMSKeyID))
+	require.NoError(t, err)
+	assert.Equal(t, []Component{ComponentSkills}, m.Components())
+
+	_, err = Verify(context.Background(), bytes.NewReader(data), PassphraseKey("pass"))
+	assert.EqualError(t, err, "backup is encrypted with kms, not a passphrase")
+}
@@ -0,0 +1,367 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/langoai/lango/internal/security"
+)
+
+// magic identifies a Lango backup file.
+var magic = []byte("LANGOBAK")
+
+const (
+	// headerVersion is the version of the encryption header.
+	headerVersion = 1
+	// kdfIterations is the PBKDF2 iteration count for passphrase keys.
+	kdfIterations = 600_000
+	// keySize is the AES-256 key size in bytes.
+	keySize = 32
+	// saltSize is the PBKDF2 salt size in bytes.
+	saltSize = 16
+	// chunkSize is the plaintext size of an encrypted chunk.
+	chunkSize = 64 << 10
+	// maxHeaderSize bounds the header read from untrusted files.
+	maxHeaderSize = 64 << 10
+)
+
+// Key derivation methods recorded in the header.
+const (
+	KDFPassphrase = "pbkdf2-sha256"
+	KDFKMS        = "kms"
+)
+
+var (
+	// ErrNotBackup is returned when a file is not a Lango backup.
+	ErrNotBackup = errors.New("not a lango backup file")
+	// ErrDecrypt is returned when a backup cannot be decrypted: the
+	// passphrase or key is wrong, or the file was modified.
+	ErrDecrypt = errors.New("decrypt backup: wrong passphrase or key, or corrupted file")
+	// ErrTruncated is returned when a backup ends before its last chunk.
+	ErrTruncated = errors.New("backup file is truncated")
+)
+
+// Header is the unencrypted header of a backup file. It tells how to get the
+// data key; it is authenticated as part of every encrypted chunk.
+type Header struct {
+	Version     int    `json:"version"`
+	KDF         string `json:"kdf"`
+	Salt        []byte `json:"salt,omitempty"`
+	Iterations  int    `json:"iterations,omitempty"`
+	KMSProvider string `json:"kmsProvider,omitempty"`
+	KMSKeyID    string `json:"kmsKeyId,omitempty"`
+	WrappedKey  []byte `json:"wrappedKey,omitempty"`
+}
+
+// Key provides the data key of a backup.
+type Key interface {
+	// newKey returns the header and data key for a new backup.
+	newKey(ctx context.Context) (*Header, []byte, error)
+	// openKey returns the data key of an existing backup.
+	openKey(ctx context.Context, h *Header) ([]byte, error)
+}
+
+// PassphraseKey derives the data key from a passphrase.
+func PassphraseKey(passphrase string) Key {
+	return passphraseKey(passphrase)
+}
+
+type passphraseKey string
+
+func (p passphraseKey) newKey(context.Context) (*Header, []byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, nil, fmt.Errorf("generate salt: %w", err)
+	}
+	h := &Header{Version: headerVersion, KDF: KDFPassphrase, Salt: salt, Iterations: kdfIterations}
+	return h, pbkdf2.Key([]byte(p), salt, kdfIterations, keySize, sha256.New), nil
+}
+
+func (p passphraseKey) openKey(_ context.Context, h *Header) ([]byte, error) {
+	if h.KDF != KDFPassphrase {
+		return nil, fmt.Errorf("backup is encrypted with %s, not a passphrase", h.KDF)
+	}
+	if len(h.Salt) == 0 || h.Iterations <= 0 {
+		return nil, fmt.Errorf("invalid backup header: missing salt or iterations")
+	}
+	return pbkdf2.Key([]byte(p), h.Salt, h.Iterations, keySize, sha256.New), nil
+}
+
+// KMSKey generates a random data key and wraps it with a KMS key. The
+// provider name and key ID are recorded in the header, so restore can build
+// the same provider.
+func KMSKey(provider security.CryptoProvider, providerName, keyID string) Key {
+	return &kmsKey{provider: provider, name: providerName, keyID: keyID}
+}
+
+type kmsKey struct {
+	provider security.CryptoProvider
+	name     string
+	keyID    string
+}
+
+func (k *kmsKey) newKey(ctx context.Context) (*Header, []byte, error) {
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, fmt.Errorf("generate data key: %w", err)
+	}
+	wrapped, err := k.provider.Encrypt(ctx, k.keyID, dek)
+	if err != nil {
+		return nil, nil, fmt.Errorf("wrap data key with %s: %w", k.name, err)
+	}
+	h := &Header{Version: headerVersion, KDF: KDFKMS, KMSProvider: k.name, KMSKeyID: k.keyID, WrappedKey: wrapped}
+	return h, dek, nil
+}
+
+func (k *kmsKey) openKey(ctx context.Context, h *Header) ([]byte, error) {
+	if h.KDF != KDFKMS {
+		return nil, fmt.Errorf("backup is encrypted with %s, not a KMS key", h.KDF)
+	}
+	dek, err := k.provider.Decrypt(ctx, h.KMSKeyID, h.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key with %s: %w", h.KMSProvider, err)
+	}
+	if len(dek) != keySize {
+		return nil, ErrDecrypt
+	}
+	return dek, nil
+}
+
+// ReadHeader reads the header of the backup file at path.
+func ReadHeader(path string) (*Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open backup: %w", err)
+	}
+	defer f.Close()
+
+	h, _, err := readHeader(bufio.NewReader(f))
+	return h, err
+}
+
+// Encrypt writes a new header to w and returns a writer that encrypts to w.
+// Close must be called to write the final chunk; it does not close w.
+func Encrypt(ctx context.Context, w io.Writer, key Key) (io.WriteCloser, error) {
+	h, dek, err := key.newKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(h)
+	if err != nil {
+		return nil, fmt.Errorf("encode header: %w", err)
+	}
+
+	var prefix [4]byte
+	binary.BigEndian.PutUint32(prefix[:], uint32(len(raw)))
+	for _, b := range [][]byte{magic, prefix[:], raw} {
+		if _, err := w.Write(b); err != nil {
+			return nil, fmt.Errorf("write header: %w", err)
+		}
+	}
+
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptWriter{w: w, aead: aead, header: raw, buf: make([]byte, 0, chunkSize)}, nil
+}
+
+// Decrypt reads the header from r and returns a reader of the decrypted
+// content. The reader returns ErrDecrypt if a chunk fails authentication and
+// ErrTruncated if the file ends before the final chunk.
+func Decrypt(ctx context.Context, r io.Reader, key Key) (io.Reader, *Header, error) {
+	br := bufio.NewReader(r)
+	h, raw, err := readHeader(br)
+	if err != nil {
+		return nil, nil, err
+	}
+	dek, err := key.openKey(ctx, h)
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &decryptReader{r: br, aead: aead, header: raw}, h, nil
+}
+
+func readHeader(r io.Reader) (*Header, []byte, error) {
+	var prefix [12]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return nil, nil, ErrNotBackup
+	}
+	if string(prefix[:8]) != string(magic) {
+		return nil, nil, ErrNotBackup
+	}
+	n := binary.BigEndian.Uint32(prefix[8:])
+	if n == 0 || n > maxHeaderSize {
+		return nil, nil, fmt.Errorf("invalid backup header size %d", n)
+	}
+	raw := make([]byte, n)
+	if _, err := io.ReadFull(r, raw); err != nil {
+		return nil, nil, ErrTruncated
+	}
+	var h Header
+	if err := json.Unmarshal(raw, &h); err != nil {
+		return nil, nil, fmt.Errorf("decode backup header: %w", err)
+	}
+	if h.Version != headerVersion {
+		return nil, nil, fmt.Errorf("unsupported backup version %d", h.Version)
+	}
+	return &h, raw, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+	return aead, nil
+}
+
+// chunkNonce returns the nonce of chunk n. Chunks are encrypted with a fresh
+// data key per backup, so a counter nonce never repeats under the same key.
+func chunkNonce(n uint64) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], n)
+	return nonce
+}
+
+// chunkAAD binds a chunk to the header and marks the final chunk, so a
+// backup cut at a chunk boundary is detected.
+func chunkAAD(header []byte, final bool) []byte {
+	aad := make([]byte, len(header)+1)
+	copy(aad, header)
+	if final {
+		aad[len(header)] = 1
+	}
+	return aad
+}
+
+type encryptWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte
+	n      uint64
+	closed bool
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed backup writer")
+	}
+	written := 0
+	for len(p) > 0 {
+		take := min(chunkSize-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:take]...)
+		p = p[take:]
+		written += take
+		if len(e.buf) == chunkSize && len(p) > 0 {
+			if err := e.flush(false); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// Close writes the final chunk.
+func (e *encryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.flush(true)
+}
+
+func (e *encryptWriter) flush(final bool) error {
+	sealed := e.aead.Seal(nil, chunkNonce(e.n), e.buf, chunkAAD(e.header, final))
+	e.n++
+	e.buf = e.buf[:0]
+
+	var prefix [4]byte
+	binary.BigEndian.PutUint32(prefix[:], uint32(len(sealed)))
+	if _, err := e.w.Write(prefix[:]); err != nil {
+		return fmt.Errorf("write backup: %w", err)
+	}
+	if _, err := e.w.Write(sealed); err != nil {
+		return fmt.Errorf("write backup: %w", err)
+	}
+	return nil
+}
+
+type decryptReader struct {
+	r      io.Reader
+	aead   cipher.AEAD
+	header []byte
+	buf    []byte
+	n      uint64
+	done   bool
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+// next reads and opens the next chunk. A chunk is final when it opens with
+// the final AAD; the final chunk must be followed by EOF.
+func (d *decryptReader) next() error {
+	var prefix [4]byte
+	if _, err := io.ReadFull(d.r, prefix[:]); err != nil {
+		return ErrTruncated
+	}
+	size := binary.BigEndian.Uint32(prefix[:])
+	if size < uint32(d.aead.Overhead()) || size > chunkSize+uint32(d.aead.Overhead()) {
+		return ErrDecrypt
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		return ErrTruncated
+	}
+
+	nonce := chunkNonce(d.n)
+	d.n++
+	if plain, err := d.aead.Open(nil, nonce, sealed, chunkAAD(d.header, false)); err == nil {
+		d.buf = plain
+		return nil
+	}
+	plain, err := d.aead.Open(nil, nonce, sealed, chunkAAD(d.header, true))
+	if err != nil {
+		return ErrDecrypt
+	}
+	var extra [1]byte
+	if n, _ := d.r.Read(extra[:]); n > 0 {
+		return ErrDecrypt
+	}
+	d.buf = plain
+	d.done = true
+	return nil
+}
@@ -0,0 +1,307 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/langoai/lango/internal/dbmigrate"
+	"github.com/langoai/lango/internal/graph"
+)
+
+// preRestoreSuffix is appended to files and directories replaced by a
+// restore; the previous data is kept until the next restore.
+const preRestoreSuffix = ".pre-restore"
+
+// maxManifestSize bounds the manifest read from the archive.
+const maxManifestSize = 16 << 20
+
+// RestoreOptions configures Restore.
+type RestoreOptions struct {
+	// DBPath, GraphPath and SkillsDir are the restore targets.
+	DBPath    string
+	GraphPath string
+	SkillsDir string
+	// Components selects what to restore; empty means everything in the
+	// backup.
+	Components []Component
+	// CurrentSchema is the schema of the current database. When set, a
+	// database backup is restored only if this version can use it.
+	CurrentSchema *dbmigrate.Schema
+	// Key decrypts the backup.
+	Key Key
+}
+
+// Verify decrypts the backup read from r and verifies the checksum of every
+// file without writing anything. It returns the manifest.
+func Verify(ctx context.Context, r io.Reader, key Key) (*Manifest, error) {
+	return extract(ctx, r, key, "", nil)
+}
+
+// Restore decrypts the backup read from r, verifies it and replaces the
+// selected components. Nothing is replaced unless the whole backup verifies.
+// Replaced files are kept with a ".pre-restore" suffix. It returns the
+// manifest and the restored components.
+//
+// The database and graph store must not be open while they are replaced;
+// Restore refuses to run while another process holds the graph store.
+func Restore(ctx context.Context, r io.Reader, opts RestoreOptions) (*Manifest, []Component, error) {
+	if opts.GraphPath != "" {
+		if err := graph.CheckUnlocked(opts.GraphPath, graphLockTimeout); err != nil {
+			return nil, nil, fmt.Errorf("check graph store: %w", err)
+		}
+	}
+
+	staging, err := os.MkdirTemp("", "lango-restore-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("create staging dir: %w", err)
+	}
+	defer os.RemoveAll(staging)
+
+	var selected []Component
+	check := func(m *Manifest) error {
+		if selected, err = selectComponents(m, opts); err != nil {
+			return err
+		}
+		if containsComponent(selected, ComponentDB) && opts.CurrentSchema != nil && m.Schema != nil {
+			if err := dbmigrate.CheckCompatible(m.Schema, opts.CurrentSchema); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	m, err := extract(ctx, r, opts.Key, staging, check)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, c := range selected {
+		switch c {
+		case ComponentDB:
+			if err := replacePath(filepath.Join(staging, filepath.FromSlash(dbArchivePath)), opts.DBPath); err != nil {
+				return m, nil, fmt.Errorf("restore database: %w", err)
+			}
+			// WAL files of the replaced database must not be applied to
+			// the restored one; they go with it, so the kept copy keeps
+			// its uncheckpointed transactions.
+			for _, suffix := range []string{"-wal", "-shm"} {
+				if err := moveAside(opts.DBPath+suffix, opts.DBPath+preRestoreSuffix+suffix); err != nil {
+					return m, nil, fmt.Errorf("keep %s: %w", opts.DBPath+suffix, err)
+				}
+			}
+		case ComponentGraph:
+			if err := replacePath(filepath.Join(staging, filepath.FromSlash(graphArchivePath)), opts.GraphPath); err != nil {
+				return m, nil, fmt.Errorf("restore graph store: %w", err)
+			}
+		case ComponentSkills:
+			if err := replacePath(filepath.Join(staging, filepath.FromSlash(skillsArchiveDir)), opts.SkillsDir); err != nil {
+				return m, nil, fmt.Errorf("restore skills: %w", err)
+			}
+		}
+	}
+	return m, selected, nil
+}
+
+// selectComponents returns the components to restore and checks that the
+// backup contains them and that they have a target.
+func selectComponents(m *Manifest, opts RestoreOptions) ([]Component, error) {
+	available := m.Components()
+	selected := opts.Components
+	if len(selected) == 0 {
+		selected = available
+	}
+	if len(selected) == 0 {
+		return nil, errors.New("backup contains nothing to restore")
+	}
+
+	targets := map[Component]string{
+		ComponentDB:     opts.DBPath,
+		ComponentGraph:  opts.GraphPath,
+		ComponentSkills: opts.SkillsDir,
+	}
+	for _, c := range selected {
+		if !containsComponent(available, c) {
+			return nil, fmt.Errorf("backup does not contain %s", c)
+		}
+		if targets[c] == "" {
+			return nil, fmt.Errorf("no restore path configured for %s", c)
+		}
+	}
+	return selected, nil
+}
+
+// extract decrypts the archive and verifies every file against the manifest.
+// check, when set, is called with the manifest before any file is read.
+// Files of components accepted by check are written below dir; with an empty
+// dir nothing is written.
+func extract(ctx context.Context, r io.Reader, key Key, dir string, check func(*Manifest) error) (*Manifest, error) {
+	plain, _, err := Decrypt(ctx, r, key)
+	if err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(plain)
+	if err != nil {
+		return nil, archiveError(err)
+	}
+	tr := tar.NewReader(gz)
+
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, archiveError(err)
+	}
+	if hdr.Name != manifestName || hdr.Size > maxManifestSize {
+		return nil, errors.New("invalid backup: manifest missing")
+	}
+	var m Manifest
+	if err := json.NewDecoder(io.LimitReader(tr, maxManifestSize)).Decode(&m); err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	if m.Format != manifestFormat {
+		return nil, fmt.Errorf("unsupported backup format %d", m.Format)
+	}
+	if check != nil {
+		if err := check(&m); err != nil {
+			return &m, err
+		}
+	}
+
+	expected := make(map[string]File, len(m.Files))
+	for _, f := range m.Files {
+		expected[f.Path] = f
+	}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return &m, archiveError(err)
+		}
+		f, ok := expected[hdr.Name]
+		if !ok || hdr.Typeflag != tar.TypeReg || !validArchivePath(hdr.Name) {
+			return &m, fmt.Errorf("invalid backup: unexpected entry %q", hdr.Name)
+		}
+		delete(expected, hdr.Name)
+
+		dst := ""
+		if dir != "" {
+			dst = filepath.Join(dir, filepath.FromSlash(hdr.Name))
+		}
+		if err := extractFile(tr, dst, f); err != nil {
+			return &m, err
+		}
+	}
+	for name := range expected {
+		return &m, fmt.Errorf("invalid backup: %s is missing", name)
+	}
+
+	// Read to the end so the final chunk is authenticated.
+	if _, err := io.Copy(io.Discard, gz); err != nil {
+		return &m, archiveError(err)
+	}
+	if _, err := io.Copy(io.Discard, plain); err != nil {
+		return &m, archiveError(err)
+	}
+	return &m, nil
+}
+
+// extractFile copies a file from the archive to dst, or discards it when dst
+// is empty, and verifies its checksum.
+func extractFile(r io.Reader, dst string, f File) error {
+	w := io.Discard
+	var out *os.File
+	if dst != "" {
+		if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+			return fmt.Errorf("create %s: %w", f.Path, err)
+		}
+		var err error
+		if out, err = os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600); err != nil {
+			return fmt.Errorf("create %s: %w", f.Path, err)
+		}
+		defer out.Close()
+		w = out
+	}
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(w, h), r)
+	if err != nil {
+		return archiveError(err)
+	}
+	if n != f.Size || hex.EncodeToString(h.Sum(nil)) != f.SHA256 {
+		return fmt.Errorf("checksum mismatch for %s", f.Path)
+	}
+	if out != nil {
+		return out.Close()
+	}
+	return nil
+}
+
+// archiveError keeps the decryption errors and reports anything else as a
+// corrupted archive.
+func archiveError(err error) error {
+	if errors.Is(err, ErrDecrypt) || errors.Is(err, ErrTruncated) {
+		return err
+	}
+	return fmt.Errorf("read backup archive: %w", err)
+}
+
+// replacePath moves src to dst. An existing dst is kept as dst.pre-restore,
+// replacing the one kept by an earlier restore.
+func replacePath(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0o700); err != nil {
+		return err
+	}
+	if _, err := os.Lstat(dst); err == nil {
+		prev := dst + preRestoreSuffix
+		if err := os.RemoveAll(prev); err != nil {
+			return err
+		}
+		if err := os.Rename(dst, prev); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	// src and dst may be on different filesystems.
+	return copyTree(src, dst)
+}
+
+// moveAside renames src to dst, replacing dst. A missing src leaves no dst
+// behind, so dst never belongs to another file.
+func moveAside(src, dst string) error {
+	if err := os.Remove(dst); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Rename(src, dst); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func copyTree(src, dst string) error {
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o700)
+		}
+		return copyFile(p, target)
+	})
+}
@@ -0,0 +1,12 @@
+// Package all registers every built-in channel platform with the default
+// channel registry. Import it for side effects.
+package all
+
+import (
+	_ "github.com/langoai/lango/internal/channels/discord"
+	_ "github.com/langoai/lango/internal/channels/email"
+	_ "github.com/langoai/lango/internal/channels/matrix"
+	_ "github.com/langoai/lango/internal/channels/slack"
+	_ "github.com/langoai/lango/internal/channels/telegram"
+	_ "github.com/langoai/lango/internal/channels/webhook"
+)
@@ -0,0 +1,166 @@
+// Package channels defines the platform-neutral messaging channel interface.
+// Each platform (Telegram, Discord, Slack, ...) lives in its own subpackage,
+// converts native events into the normalized types below and registers a
+// factory with the registry, so the app wires every channel the same way.
+package channels
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/librarian"
+	"github.com/langoai/lango/internal/types"
+)
+
+// AttachmentType classifies a message attachment.
+type AttachmentType string
+
+const (
+	AttachmentImage    AttachmentType = "image"
+	AttachmentAudio    AttachmentType = "audio"
+	AttachmentVideo    AttachmentType = "video"
+	AttachmentDocument AttachmentType = "document"
+)
+
+// Attachment is a file attached to a message. Platforms fill in whichever of
+// FileID and URL they expose.
+type Attachment struct {
+	Type     AttachmentType
+	FileID   string // platform file identifier
+	URL      string // download URL, if the platform provides one
+	Name     string
+	MimeType string
+	Size     int64
+}
+
+// Thread identifies a conversation thread within a chat.
+type Thread struct {
+	ID       string // thread identifier (Slack thread_ts, Discord thread channel ID)
+	ParentID string // chat the thread belongs to, if different from the message chat
+}
+
+// IncomingMessage is a message received from any channel.
+type IncomingMessage struct {
+	Channel     types.ChannelType
+	MessageID   string
+	ChatID      string // chat, channel or DM the message arrived in
+	UserID      string
+	Username    string
+	Text        string
+	ReplyToID   string
+	Thread      *Thread
+	Attachments []Attachment
+	IsDM        bool
+	IsMention   bool
+	Scope       SessionScope // how messages are grouped into sessions; empty = per user
+	Stream      *Stream      // set by channels that can show the reply while it is generated
+}
+
+// SessionKey returns the agent session key for the message according to its
+// scope: per sender in its chat (the default), per chat, or per thread.
+func (m *IncomingMessage) SessionKey() string {
+	switch m.Scope {
+	case SessionPerChannel:
+		return fmt.Sprintf("%s:%s", m.Channel, m.ChatID)
+	case SessionPerThread:
+		if m.Thread != nil && m.Thread.ID != "" {
+			return fmt.Sprintf("%s:%s:%s:%s", m.Channel, m.ChatID, threadMarker, m.Thread.ID)
+		}
+	}
+	return fmt.Sprintf("%s:%s:%s", m.Channel, m.ChatID, m.UserID)
+}
+
+// OutgoingMessage is a message to send through any channel. Text is standard
+// Markdown; channels convert it to their native format.
+type OutgoingMessage struct {
+	Text      string
+	ReplyToID string
+	Thread    *Thread
+}
+
+// Handler processes an incoming message and returns the reply, if any.
+type Handler func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error)
+
+// Capabilities describes what a channel supports.
+type Capabilities struct {
+	MaxMessageLength int  // longer messages are split; 0 = unlimited
+	Threads          bool // replies can target a thread
+	Attachments      bool // incoming attachments are reported
+	Buttons          bool // interactive buttons (approvals, quick replies)
+}
+
+// Channel is a messaging platform adapter.
+type Channel interface {
+	// Type returns the platform the channel connects to.
+	Type() types.ChannelType
+
+	// Capabilities reports the features the channel supports.
+	Capabilities() Capabilities
+
+	// SetHandler sets the handler for incoming messages. It must be called
+	// before Start.
+	SetHandler(h Handler)
+
+	// Start connects to the platform and begins dispatching messages.
+	Start(ctx context.Context) error
+
+	// Stop disconnects from the platform.
+	Stop()
+
+	// Send delivers a message to a chat. An empty chatID selects the
+	// channel's default chat, if it has one.
+	Send(ctx context.Context, chatID string, msg *OutgoingMessage) error
+
+	// StartTyping shows a typing indicator in a chat until the returned stop
+	// function is called or ctx is cancelled. The stop function is never nil
+	// and is safe to call multiple times.
+	StartTyping(ctx context.Context, chatID string) func()
+
+	// ApprovalProvider returns the provider that asks for tool approval
+	// through the channel.
+	ApprovalProvider() approval.Provider
+}
+
+// InquiryProvider pushes librarian inquiries to a channel and resolves the
+// quick replies sent back.
+type InquiryProvider interface {
+	librarian.InquiryNotifier
+	SetResolver(r librarian.InquiryResolver)
+}
+
+// InquiryChannel is implemented by channels that can deliver librarian
+// inquiries with quick-reply buttons.
+type InquiryChannel interface {
+	Channel
+	InquiryProvider() InquiryProvider
+}
+
+// AttachmentChannel is implemented by channels that can download the
+// contents of incoming attachments.
+type AttachmentChannel interface {
+	Channel
+	DownloadAttachment(ctx context.Context, a Attachment) ([]byte, error)
+}
+
+// Sender delivers text to a delivery target such as "telegram" or
+// "slack:C123". The app implements it on top of all running channels.
+type Sender interface {
+	SendMessage(ctx context.Context, target, text string) error
+}
+
+// RelayChannel is implemented by channels that forward output to other
+// channels, e.g. a webhook whose events are answered on Telegram.
+type RelayChannel interface {
+	Channel
+	SetSender(s Sender)
+}
+
+// RouteChannel is implemented by channels that receive messages over HTTP
+// endpoints mounted on the gateway router.
+type RouteChannel interface {
+	Channel
+	RegisterRoutes(r chi.Router)
+}
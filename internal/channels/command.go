@@ -0,0 +1,128 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CommandFunc runs a slash command for the sender of msg and returns the
+// reply text. args are the whitespace-separated words after the command.
+type CommandFunc func(ctx context.Context, msg *IncomingMessage, args []string) (string, error)
+
+// Command is a slash command that works the same in every channel, such as
+// /reset or /model.
+type Command struct {
+	Name        string // lowercase letters, digits and underscores
+	Description string // one line, shown in the platform's command menu
+	Usage       string // argument synopsis, e.g. "<model-id>"; empty = no arguments
+	Run         CommandFunc
+}
+
+// CommandChannel is implemented by channels with native slash commands:
+// Telegram's command menu, Discord application commands and Slack slash
+// commands. Commands typed as plain text work in every channel regardless.
+type CommandChannel interface {
+	Channel
+	SetCommands(cmds []Command)
+}
+
+// CommandRouter dispatches slash commands typed in any channel. Messages
+// that are not a known command are left for the agent.
+type CommandRouter struct {
+	commands map[string]Command
+}
+
+// NewCommandRouter creates a router for cmds. A /help command listing them
+// is added automatically.
+func NewCommandRouter(cmds ...Command) *CommandRouter {
+	r := &CommandRouter{commands: make(map[string]Command, len(cmds)+1)}
+	for _, cmd := range cmds {
+		r.commands[cmd.Name] = cmd
+	}
+	r.commands["help"] = Command{
+		Name:        "help",
+		Description: "List the available commands",
+		Run: func(context.Context, *IncomingMessage, []string) (string, error) {
+			return r.help(), nil
+		},
+	}
+	return r
+}
+
+// Commands returns the registered commands sorted by name.
+func (r *CommandRouter) Commands() []Command {
+	cmds := make([]Command, 0, len(r.commands))
+	for _, cmd := range r.commands {
+		cmds = append(cmds, cmd)
+	}
+	sort.Slice(cmds, func(i, j int) bool { return cmds[i].Name < cmds[j].Name })
+	return cmds
+}
+
+// Dispatch runs the command in msg, if it is one. handled is false when the
+// message is not a known command and should go to the agent instead.
+func (r *CommandRouter) Dispatch(ctx context.Context, msg *IncomingMessage) (reply *OutgoingMessage, handled bool, err error) {
+	name, args, ok := ParseCommand(msg.Text)
+	if !ok {
+		return nil, false, nil
+	}
+	cmd, ok := r.commands[name]
+	if !ok {
+		return nil, false, nil
+	}
+
+	text, err := cmd.Run(ctx, msg, args)
+	if err != nil {
+		return nil, true, fmt.Errorf("/%s: %w", name, err)
+	}
+	return &OutgoingMessage{Text: text, Thread: msg.Thread}, true, nil
+}
+
+// IsCommand reports whether text invokes one of cmds.
+func IsCommand(text string, cmds []Command) bool {
+	name, _, ok := ParseCommand(text)
+	if !ok {
+		return false
+	}
+	for _, cmd := range cmds {
+		if cmd.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseCommand splits "/name arg1 arg2" into the lowercased command name and
+// its arguments. A bot suffix as in Telegram groups ("/reset@lango_bot") is
+// dropped.
+func ParseCommand(text string) (name string, args []string, ok bool) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", nil, false
+	}
+	fields := strings.Fields(text[1:])
+	if len(fields) == 0 {
+		return "", nil, false
+	}
+	name, _, _ = strings.Cut(fields[0], "@")
+	if name == "" {
+		return "", nil, false
+	}
+	return strings.ToLower(name), fields[1:], true
+}
+
+// help lists the commands with their usage.
+func (r *CommandRouter) help() string {
+	var b strings.Builder
+	b.WriteString("Available commands:\n")
+	for _, cmd := range r.Commands() {
+		b.WriteString("\n`/" + cmd.Name)
+		if cmd.Usage != "" {
+			b.WriteString(" " + cmd.Usage)
+		}
+		b.WriteString("` — " + cmd.Description)
+	}
+	return b.String()
+}
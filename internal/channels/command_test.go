@@ -0,0 +1,104 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		give     string
+		wantName string
+		wantArgs []string
+		wantOK   bool
+	}{
+		{give: "/reset", wantName: "reset", wantArgs: []string{}, wantOK: true},
+		{give: "  /Model  gpt-4o ", wantName: "model", wantArgs: []string{"gpt-4o"}, wantOK: true},
+		{give: "/reset@lango_bot", wantName: "reset", wantArgs: []string{}, wantOK: true},
+		{give: "/memory forget 1234", wantName: "memory", wantArgs: []string{"forget", "1234"}, wantOK: true},
+		{give: "hello /reset"},
+		{give: "/"},
+		{give: "/@bot"},
+		{give: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			name, args, ok := ParseCommand(tt.give)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantName, name)
+			if tt.wantOK {
+				assert.Equal(t, tt.wantArgs, args)
+			}
+		})
+	}
+}
+
+func TestCommandRouter_Dispatch(t *testing.T) {
+	var gotArgs []string
+	r := NewCommandRouter(
+		Command{
+			Name:        "model",
+			Description: "Switch model",
+			Usage:       "<model-id>",
+			Run: func(_ context.Context, msg *IncomingMessage, args []string) (string, error) {
+				gotArgs = args
+				return "switched for " + msg.UserID, nil
+			},
+		},
+		Command{
+			Name: "fail",
+			Run: func(context.Context, *IncomingMessage, []string) (string, error) {
+				return "", errors.New("boom")
+			},
+		},
+	)
+	thread := &Thread{ID: "t1"}
+
+	reply, handled, err := r.Dispatch(context.Background(), &IncomingMessage{UserID: "u1", Text: "/model gpt-4o", Thread: thread})
+	require.NoError(t, err)
+	assert.True(t, handled)
+	assert.Equal(t, "switched for u1", reply.Text)
+	assert.Same(t, thread, reply.Thread)
+	assert.Equal(t, []string{"gpt-4o"}, gotArgs)
+
+	_, handled, err = r.Dispatch(context.Background(), &IncomingMessage{Text: "/fail"})
+	assert.True(t, handled)
+	assert.EqualError(t, err, "/fail: boom")
+
+	for _, text := range []string{"/unknown", "/etc/hosts is missing", "plain text"} {
+		_, handled, err = r.Dispatch(context.Background(), &IncomingMessage{Text: text})
+		assert.NoError(t, err)
+		assert.False(t, handled, text)
+	}
+}
+
+func TestCommandRouter_Help(t *testing.T) {
+	r := NewCommandRouter(
+		Command{Name: "reset", Description: "Start over"},
+		Command{Name: "model", Description: "Switch model", Usage: "<model-id>"},
+	)
+
+	var names []string
+	for _, cmd := range r.Commands() {
+		names = append(names, cmd.Name)
+	}
+	assert.Equal(t, []string{"help", "model", "reset"}, names)
+
+	reply, handled, err := r.Dispatch(context.Background(), &IncomingMessage{Text: "/help"})
+	require.NoError(t, err)
+	assert.True(t, handled)
+	assert.Contains(t, reply.Text, "`/model <model-id>` — Switch model")
+	assert.Contains(t, reply.Text, "`/reset` — Start over")
+}
+
+func TestIsCommand(t *testing.T) {
+	cmds := []Command{{Name: "reset"}}
+	assert.True(t, IsCommand("/reset", cmds))
+	assert.True(t, IsCommand("/RESET now", cmds))
+	assert.False(t, IsCommand("/status", cmds))
+	assert.False(t, IsCommand("reset", cmds))
+}
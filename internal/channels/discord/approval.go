@@ -9,6 +9,7 @@ import (
 
 	"github.com/bwmarrin/discordgo"
 	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
 )
 
 // approvalPending holds the response channel and message metadata for a pending approval.
@@ -192,11 +193,16 @@ func (p *ApprovalProvider) editExpiredMessage(channelID, messageID string) {
 	}
 }
 
-// parseDiscordChannelID extracts the channelID from a session key like "discord:<channelID>:<userID>".
+// parseDiscordChannelID extracts the channel to post to from a session key
+// like "discord:<channelID>:<userID>". Per-thread keys such as
+// "discord:<channelID>:thread:<threadID>" resolve to the thread channel.
 func parseDiscordChannelID(sessionKey string) (string, error) {
-	parts := strings.SplitN(sessionKey, ":", 3)
-	if len(parts) < 2 {
+	parts, err := channels.ParseSessionKey(sessionKey)
+	if err != nil {
 		return "", fmt.Errorf("invalid discord session key: %s", sessionKey)
 	}
-	return parts[1], nil
+	if parts.ThreadID != "" {
+		return parts.ThreadID, nil
+	}
+	return parts.ChatID, nil
 }
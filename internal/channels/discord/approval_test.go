@@ -70,6 +70,31 @@ func TestDiscordApprovalProvider_CanHandle(t *testing.T) {
 	}
 }
 
+func TestParseDiscordChannelID(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "discord:ch-1:usr", want: "ch-1"},
+		{give: "discord:ch-1", want: "ch-1"},
+		{give: "discord:ch-1:thread:th-2", want: "th-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got, err := parseDiscordChannelID(tt.give)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseDiscordChannelID(%q) = %q, want %q", tt.give, got, tt.want)
+			}
+		})
+	}
+	if _, err := parseDiscordChannelID("discord"); err == nil {
+		t.Error("expected error for invalid key")
+	}
+}
+
 func TestDiscordApprovalProvider_Approve(t *testing.T) {
 	state := &discordgo.State{}
 	state.User = &discordgo.User{ID: "bot-1"}
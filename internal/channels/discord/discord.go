@@ -9,7 +9,10 @@ import (
 	"time"
 
 	"github.com/bwmarrin/discordgo"
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/types"
 )
 
 var logger = logging.SubsystemSugar("channel.discord")
@@ -18,54 +21,37 @@ var logger = logging.SubsystemSugar("channel.discord")
 type Config struct {
 	BotToken           string
 	ApplicationID      string
-	AllowedGuilds      []string     // empty = all
-	ApprovalTimeoutSec int          // 0 = default 30s
-	HTTPClient         *http.Client // optional, for testing
-	Session            Session      // optional, for testing
+	AllowedGuilds      []string              // empty = all
+	SessionScope       channels.SessionScope // default SessionPerUser
+	ApprovalTimeoutSec int                   // 0 = default 30s
+	HTTPClient         *http.Client          // optional, for testing
+	Session            Session               // optional, for testing
 }
 
-// MessageHandler handles incoming messages
-type MessageHandler func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error)
-
-// IncomingMessage represents a message from Discord
-type IncomingMessage struct {
-	MessageID  string
-	ChannelID  string
-	GuildID    string
-	AuthorID   string
-	AuthorName string
-	Content    string
-	IsDM       bool
-	IsMention  bool
-}
+// threadArchiveMinutes is the auto-archive delay for threads the bot starts.
+const threadArchiveMinutes = 1440
 
-// OutgoingMessage represents a message to send
-type OutgoingMessage struct {
-	Content string
-	Embed   *Embed
-}
+// maxThreadNameLength is the Discord thread name size limit.
+const maxThreadNameLength = 100
 
-// Embed represents a Discord embed
-type Embed struct {
-	Title       string
-	Description string
-	Color       int
-	Fields      []EmbedField
-}
+// maxMessageLength is the Discord message size limit.
+const maxMessageLength = 2000
 
-// EmbedField represents an embed field
-type EmbedField struct {
-	Name   string
-	Value  string
-	Inline bool
-}
+// askCommand is the Discord-only slash command whose message goes to the
+// agent like a mention.
+const askCommand = "ask"
+
+// argsOption is the free-text option of slash commands that take arguments.
+const argsOption = "args"
 
 // Channel implements Discord bot
 type Channel struct {
 	config   Config
 	session  Session
-	handler  MessageHandler
+	handler  channels.Handler
+	commands []channels.Command
 	approval *ApprovalProvider
+	inquiry  *InquiryProvider
 	ctx      context.Context
 	botID    string
 	stopChan chan struct{}
@@ -76,6 +62,12 @@ func New(cfg Config) (*Channel, error) {
 	if cfg.BotToken == "" {
 		return nil, fmt.Errorf("bot token is required")
 	}
+	if cfg.SessionScope == "" {
+		cfg.SessionScope = channels.SessionPerUser
+	}
+	if !cfg.SessionScope.Valid() {
+		return nil, fmt.Errorf("invalid session scope %q", cfg.SessionScope)
+	}
 
 	var sess Session
 	if cfg.Session != nil {
@@ -102,20 +94,52 @@ func New(cfg Config) (*Channel, error) {
 		stopChan: make(chan struct{}),
 	}
 	ch.approval = NewApprovalProvider(sess, time.Duration(cfg.ApprovalTimeoutSec)*time.Second)
+	ch.inquiry = NewInquiryProvider(sess, ch.isGuildAllowed)
 
 	return ch, nil
 }
 
+var (
+	_ channels.InquiryChannel    = (*Channel)(nil)
+	_ channels.AttachmentChannel = (*Channel)(nil)
+	_ channels.CommandChannel    = (*Channel)(nil)
+)
+
+// Type returns types.ChannelDiscord.
+func (c *Channel) Type() types.ChannelType {
+	return types.ChannelDiscord
+}
+
+// Capabilities reports the features supported by Discord.
+func (c *Channel) Capabilities() channels.Capabilities {
+	return channels.Capabilities{
+		MaxMessageLength: maxMessageLength,
+		Threads:          true,
+		Attachments:      true,
+		Buttons:          true,
+	}
+}
+
 // SetHandler sets the message handler
-func (c *Channel) SetHandler(handler MessageHandler) {
+func (c *Channel) SetHandler(handler channels.Handler) {
 	c.handler = handler
 }
 
-// GetApprovalProvider returns the channel's approval provider for composite registration.
-func (c *Channel) GetApprovalProvider() *ApprovalProvider {
+// SetCommands sets the slash commands registered as application commands.
+func (c *Channel) SetCommands(cmds []channels.Command) {
+	c.commands = cmds
+}
+
+// ApprovalProvider returns the channel's approval provider for composite registration.
+func (c *Channel) ApprovalProvider() approval.Provider {
 	return c.approval
 }
 
+// InquiryProvider returns the channel's inquiry provider for librarian notifier registration.
+func (c *Channel) InquiryProvider() channels.InquiryProvider {
+	return c.inquiry
+}
+
 // Start starts the Discord bot
 func (c *Channel) Start(ctx context.Context) error {
 	if c.handler == nil {
@@ -166,15 +190,29 @@ func (c *Channel) onMessageCreate(s *discordgo.Session, m *discordgo.MessageCrea
 	// Clean content (remove bot mention)
 	content := c.cleanContent(m.Content)
 
-	incoming := &IncomingMessage{
-		MessageID:  m.ID,
-		ChannelID:  m.ChannelID,
-		GuildID:    m.GuildID,
-		AuthorID:   m.Author.ID,
-		AuthorName: m.Author.Username,
-		Content:    content,
-		IsDM:       isDM,
-		IsMention:  isMention,
+	incoming := &channels.IncomingMessage{
+		Channel:     types.ChannelDiscord,
+		MessageID:   m.ID,
+		ChatID:      m.ChannelID,
+		UserID:      m.Author.ID,
+		Username:    m.Author.Username,
+		Text:        content,
+		Attachments: attachments(m.Attachments),
+		IsDM:        isDM,
+		IsMention:   isMention,
+		Scope:       c.config.SessionScope,
+	}
+	if m.MessageReference != nil {
+		incoming.ReplyToID = m.MessageReference.MessageID
+	}
+	if !isDM {
+		c.resolveThread(incoming)
+	}
+
+	// Replies go to the thread channel, if any.
+	replyID := m.ChannelID
+	if incoming.Thread != nil {
+		replyID = incoming.Thread.ID
 	}
 
 	logger.Infow("received message",
@@ -183,28 +221,152 @@ func (c *Channel) onMessageCreate(s *discordgo.Session, m *discordgo.MessageCrea
 		"authorId", m.Author.ID,
 	)
 
+	stream := channels.NewStream(c.ctx, &streamEditor{session: c.session, channelID: replyID}, channels.StreamOptions{
+		Interval: editInterval,
+		Split:    func(text string) []string { return splitMessage(text, maxMessageLength) },
+	})
+	defer stream.Stop()
+	incoming.Stream = stream
+
 	// Show typing indicator while processing
-	stopThinking := c.startTyping(m.ChannelID)
+	stopThinking := c.StartTyping(c.ctx, replyID)
 	response, err := c.handler(c.ctx, incoming)
 	stopThinking()
 
 	if err != nil {
 		logger.Errorw("handler error", "error", err)
-		c.sendError(m.ChannelID, err)
+		c.sendError(replyID, err)
 		return
 	}
 
 	if response != nil {
-		if err := c.Send(m.ChannelID, response); err != nil {
+		// Replace the streamed reply, or send a new one if nothing was streamed.
+		stream.Stop()
+		if stream.Started() {
+			err = stream.Finish(c.ctx, response.Text)
+		} else {
+			err = c.Send(c.ctx, replyID, response)
+		}
+		if err != nil {
 			logger.Errorw("send error", "error", err)
 		}
 	}
 }
 
+// resolveThread fills in the thread of a guild message. With per-thread
+// sessions a mention outside a thread starts a new thread on that message,
+// unless it is a slash command, which acts on the session it is typed in.
+func (c *Channel) resolveThread(incoming *channels.IncomingMessage) {
+	if c.joinThread(incoming) {
+		return
+	}
+	if incoming.Scope != channels.SessionPerThread || channels.IsCommand(incoming.Text, c.commands) {
+		return
+	}
+
+	th, err := c.session.MessageThreadStartComplex(incoming.ChatID, incoming.MessageID, &discordgo.ThreadStart{
+		Name:                threadName(incoming),
+		AutoArchiveDuration: threadArchiveMinutes,
+	})
+	if err != nil {
+		logger.Warnw("start thread error, replying in channel", "channelId", incoming.ChatID, "error", err)
+		return
+	}
+	incoming.Thread = &channels.Thread{ID: th.ID, ParentID: incoming.ChatID}
+}
+
+// joinThread fills in the thread if the message was posted in one. Discord
+// threads are channels of their own: a message posted in one is reported
+// with ChatID set to the parent channel and Thread.ID to the thread channel.
+func (c *Channel) joinThread(incoming *channels.IncomingMessage) bool {
+	ch := c.lookupChannel(incoming.ChatID)
+	if ch == nil || !ch.IsThread() {
+		return false
+	}
+	incoming.Thread = &channels.Thread{ID: ch.ID, ParentID: ch.ParentID}
+	if ch.ParentID != "" {
+		incoming.ChatID = ch.ParentID
+	}
+	return true
+}
+
+// lookupChannel returns a channel from the state cache, falling back to the
+// API. It returns nil if the channel cannot be found.
+func (c *Channel) lookupChannel(channelID string) *discordgo.Channel {
+	if state := c.session.GetState(); state != nil {
+		if ch, err := state.Channel(channelID); err == nil {
+			return ch
+		}
+	}
+	ch, err := c.session.Channel(channelID)
+	if err != nil {
+		logger.Warnw("lookup channel error", "channelId", channelID, "error", err)
+		return nil
+	}
+	return ch
+}
+
+// threadName derives a thread name from the first line of a message.
+func threadName(incoming *channels.IncomingMessage) string {
+	name, _, _ := strings.Cut(incoming.Text, "\n")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = "Conversation with " + incoming.Username
+	}
+	if r := []rune(name); len(r) > maxThreadNameLength {
+		name = string(r[:maxThreadNameLength-1]) + "…"
+	}
+	return name
+}
+
+// DownloadAttachment downloads a message attachment from the Discord CDN.
+func (c *Channel) DownloadAttachment(ctx context.Context, a channels.Attachment) ([]byte, error) {
+	if a.URL == "" {
+		return nil, fmt.Errorf("attachment %s has no download URL", a.FileID)
+	}
+	return channels.Download(ctx, c.config.HTTPClient, a.URL, nil)
+}
+
+// attachments converts Discord message attachments.
+func attachments(in []*discordgo.MessageAttachment) []channels.Attachment {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make([]channels.Attachment, 0, len(in))
+	for _, a := range in {
+		result = append(result, channels.Attachment{
+			Type:     attachmentType(a.ContentType),
+			FileID:   a.ID,
+			URL:      a.URL,
+			Name:     a.Filename,
+			MimeType: a.ContentType,
+			Size:     int64(a.Size),
+		})
+	}
+	return result
+}
+
+// attachmentType classifies an attachment by its MIME type.
+func attachmentType(mimeType string) channels.AttachmentType {
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return channels.AttachmentImage
+	case strings.HasPrefix(mimeType, "audio/"):
+		return channels.AttachmentAudio
+	case strings.HasPrefix(mimeType, "video/"):
+		return channels.AttachmentVideo
+	default:
+		return channels.AttachmentDocument
+	}
+}
+
 // StartTyping sends a typing indicator to the channel and refreshes it
 // periodically until the returned stop function is called or ctx is cancelled.
 // The returned stop function is safe to call multiple times.
 func (c *Channel) StartTyping(ctx context.Context, channelID string) func() {
+	if channelID == "" {
+		return func() {}
+	}
 	if err := c.session.ChannelTyping(channelID); err != nil {
 		logger.Warnw("typing indicator error", "error", err)
 	}
@@ -231,74 +393,41 @@ func (c *Channel) StartTyping(ctx context.Context, channelID string) func() {
 	return func() { once.Do(func() { close(done) }) }
 }
 
-// startTyping sends a typing indicator to the channel and refreshes it
-// periodically until the returned stop function is called.
-func (c *Channel) startTyping(channelID string) func() {
-	if err := c.session.ChannelTyping(channelID); err != nil {
-		logger.Warnw("typing indicator error", "error", err)
+// Send sends a message to a channel. Replies to a thread are sent to the
+// thread channel.
+func (c *Channel) Send(_ context.Context, channelID string, msg *channels.OutgoingMessage) error {
+	if msg.Thread != nil && msg.Thread.ID != "" {
+		channelID = msg.Thread.ID
+	}
+	if channelID == "" {
+		return fmt.Errorf("discord delivery requires a channel ID (use discord:CHANNEL_ID)")
 	}
 
-	done := make(chan struct{})
-	go func() {
-		ticker := time.NewTicker(8 * time.Second)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-done:
-				return
-			case <-ticker.C:
-				if err := c.session.ChannelTyping(channelID); err != nil {
-					logger.Warnw("typing indicator refresh error", "error", err)
-				}
-			}
-		}
-	}()
-
-	return func() { close(done) }
-}
-
-// Send sends a message
-func (c *Channel) Send(channelID string, msg *OutgoingMessage) error {
 	// Split long messages (Discord limit is 2000)
-	chunks := splitMessage(msg.Content, 2000)
-
-	for _, chunk := range chunks {
-		if msg.Embed != nil {
-			embed := &discordgo.MessageEmbed{
-				Title:       msg.Embed.Title,
-				Description: msg.Embed.Description,
-				Color:       msg.Embed.Color,
-			}
-			for _, f := range msg.Embed.Fields {
-				embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-					Name:   f.Name,
-					Value:  f.Value,
-					Inline: f.Inline,
-				})
-			}
-			_, err := c.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
-				Content: chunk,
-				Embed:   embed,
-			})
-			if err != nil {
-				return err
-			}
-		} else {
-			_, err := c.session.ChannelMessageSend(channelID, chunk)
-			if err != nil {
-				return err
+	chunks := splitMessage(msg.Text, maxMessageLength)
+
+	for i, chunk := range chunks {
+		send := &discordgo.MessageSend{Content: chunk}
+		if i == 0 && msg.ReplyToID != "" {
+			send.Reference = &discordgo.MessageReference{
+				MessageID: msg.ReplyToID,
+				ChannelID: channelID,
 			}
 		}
+		if _, err := c.session.ChannelMessageSendComplex(channelID, send); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-// registerCommands registers slash commands
+// registerCommands replaces the bot's application commands with /ask and
+// the shared slash commands.
 func (c *Channel) registerCommands() {
 	commands := []*discordgo.ApplicationCommand{
 		{
-			Name:        "ask",
+			Name:        askCommand,
 			Description: "Ask the AI assistant",
 			Options: []*discordgo.ApplicationCommandOption{
 				{
@@ -309,29 +438,126 @@ func (c *Channel) registerCommands() {
 				},
 			},
 		},
-		{
-			Name:        "clear",
-			Description: "Clear conversation history",
-		},
 	}
-
-	for _, cmd := range commands {
-		_, err := c.session.ApplicationCommandCreate(c.config.ApplicationID, "", cmd)
-		if err != nil {
-			logger.Warnw("create command", "command", cmd.Name, "error", err)
+	for _, cmd := range c.commands {
+		ac := &discordgo.ApplicationCommand{Name: cmd.Name, Description: cmd.Description}
+		if cmd.Usage != "" {
+			ac.Options = []*discordgo.ApplicationCommandOption{
+				{
+					Type:        discordgo.ApplicationCommandOptionString,
+					Name:        argsOption,
+					Description: cmd.Usage,
+				},
+			}
 		}
+		commands = append(commands, ac)
+	}
+
+	if _, err := c.session.ApplicationCommandBulkOverwrite(c.config.ApplicationID, "", commands); err != nil {
+		logger.Warnw("register slash commands", "error", err)
+		return
 	}
 
-	logger.Info("slash commands registered")
+	logger.Infow("slash commands registered", "count", len(commands))
 }
 
-// onInteractionCreate handles interaction events (button clicks)
+// onInteractionCreate handles interaction events (slash commands, button clicks)
 func (c *Channel) onInteractionCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
-	if i.Type == discordgo.InteractionMessageComponent {
+	switch i.Type {
+	case discordgo.InteractionApplicationCommand:
+		c.handleCommand(i)
+	case discordgo.InteractionMessageComponent:
+		if c.inquiry.HandleInteraction(i) {
+			return
+		}
 		c.approval.HandleInteraction(i)
 	}
 }
 
+// handleCommand runs a slash command through the handler. The interaction is
+// acknowledged right away, since Discord waits only three seconds, and the
+// reply is filled in when the handler returns. /ask sends its message to the
+// agent; other commands are passed on as "/name args".
+func (c *Channel) handleCommand(i *discordgo.InteractionCreate) {
+	if i.GuildID != "" && !c.isGuildAllowed(i.GuildID) {
+		return
+	}
+	user := i.User
+	if i.Member != nil && i.Member.User != nil {
+		user = i.Member.User
+	}
+	if user == nil {
+		return
+	}
+
+	data := i.ApplicationCommandData()
+	var arg string
+	for _, opt := range data.Options {
+		if opt.Type == discordgo.ApplicationCommandOptionString {
+			arg = opt.StringValue()
+		}
+	}
+	text := "/" + data.Name
+	switch {
+	case data.Name == askCommand:
+		text = arg
+	case arg != "":
+		text += " " + arg
+	}
+
+	incoming := &channels.IncomingMessage{
+		Channel:   types.ChannelDiscord,
+		MessageID: i.ID,
+		ChatID:    i.ChannelID,
+		UserID:    user.ID,
+		Username:  user.Username,
+		Text:      text,
+		IsDM:      i.GuildID == "",
+		Scope:     c.config.SessionScope,
+	}
+	if !incoming.IsDM {
+		c.joinThread(incoming)
+	}
+
+	logger.Infow("received command",
+		"command", data.Name,
+		"channelId", i.ChannelID,
+		"userId", user.ID,
+	)
+
+	err := c.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		logger.Warnw("acknowledge command", "command", data.Name, "error", err)
+		return
+	}
+
+	response, err := c.handler(c.ctx, incoming)
+	var reply string
+	switch {
+	case err != nil:
+		logger.Errorw("handler error", "error", err)
+		reply = fmt.Sprintf("❌ Error: %s", err.Error())
+	case response == nil || response.Text == "":
+		reply = "✅ Done"
+	default:
+		reply = response.Text
+	}
+
+	chunks := splitMessage(reply, maxMessageLength)
+	if _, err := c.session.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{Content: &chunks[0]}); err != nil {
+		logger.Errorw("command reply error", "command", data.Name, "error", err)
+		return
+	}
+	for _, chunk := range chunks[1:] {
+		if _, err := c.session.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{Content: chunk}); err != nil {
+			logger.Errorw("command reply error", "command", data.Name, "error", err)
+			return
+		}
+	}
+}
+
 // isBotMentioned checks if the bot is mentioned
 func (c *Channel) isBotMentioned(m *discordgo.MessageCreate) bool {
 	for _, mention := range m.Mentions {
@@ -2,17 +2,27 @@ package discord
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/bwmarrin/discordgo"
+
+	"github.com/langoai/lango/internal/channels"
 )
 
 // MockSession implements Session interface for testing
 type MockSession struct {
 	Handlers      []interface{}
 	SentMessages  []string
+	DeletedMessages []string
 	State         *discordgo.State
 	TypingCalls   []string
+	Channels       map[string]*discordgo.Channel
+	StartedThreads []string
+	Responses      []*discordgo.InteractionResponse
+	ReplyEdits     []string
+	Followups      []string
+	Commands       []*discordgo.ApplicationCommand
 }
 
 func (m *MockSession) Open() error {
@@ -42,17 +52,46 @@ func (m *MockSession) ChannelMessageEditComplex(edit *discordgo.MessageEdit, opt
 	return &discordgo.Message{}, nil
 }
 
+func (m *MockSession) ChannelMessageDelete(channelID, messageID string, options ...discordgo.RequestOption) error {
+	m.DeletedMessages = append(m.DeletedMessages, messageID)
+	return nil
+}
+
 func (m *MockSession) ChannelTyping(channelID string, options ...discordgo.RequestOption) error {
 	m.TypingCalls = append(m.TypingCalls, channelID)
 	return nil
 }
 
+func (m *MockSession) Channel(channelID string, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	if ch, ok := m.Channels[channelID]; ok {
+		return ch, nil
+	}
+	return &discordgo.Channel{ID: channelID, Type: discordgo.ChannelTypeGuildText}, nil
+}
+
+func (m *MockSession) MessageThreadStartComplex(channelID, messageID string, data *discordgo.ThreadStart, options ...discordgo.RequestOption) (*discordgo.Channel, error) {
+	m.StartedThreads = append(m.StartedThreads, data.Name)
+	return &discordgo.Channel{ID: "thread-" + messageID, ParentID: channelID, Type: discordgo.ChannelTypeGuildPublicThread}, nil
+}
+
 func (m *MockSession) InteractionRespond(interaction *discordgo.Interaction, resp *discordgo.InteractionResponse, options ...discordgo.RequestOption) error {
+	m.Responses = append(m.Responses, resp)
 	return nil
 }
 
-func (m *MockSession) ApplicationCommandCreate(appID string, guildID string, cmd *discordgo.ApplicationCommand, options ...discordgo.RequestOption) (*discordgo.ApplicationCommand, error) {
-	return cmd, nil
+func (m *MockSession) InteractionResponseEdit(interaction *discordgo.Interaction, newresp *discordgo.WebhookEdit, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	m.ReplyEdits = append(m.ReplyEdits, *newresp.Content)
+	return &discordgo.Message{}, nil
+}
+
+func (m *MockSession) FollowupMessageCreate(interaction *discordgo.Interaction, wait bool, data *discordgo.WebhookParams, options ...discordgo.RequestOption) (*discordgo.Message, error) {
+	m.Followups = append(m.Followups, data.Content)
+	return &discordgo.Message{}, nil
+}
+
+func (m *MockSession) ApplicationCommandBulkOverwrite(appID string, guildID string, commands []*discordgo.ApplicationCommand, options ...discordgo.RequestOption) ([]*discordgo.ApplicationCommand, error) {
+	m.Commands = commands
+	return commands, nil
 }
 
 func (m *MockSession) GetState() *discordgo.State {
@@ -81,11 +120,11 @@ func TestDiscordChannel(t *testing.T) {
 	}
 
 	// Set a handler that replies
-	channel.SetHandler(func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error) {
-		if msg.Content != "Hello" {
-			t.Errorf("expected 'Hello', got '%s'", msg.Content)
+	channel.SetHandler(func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		if msg.Text != "Hello" {
+			t.Errorf("expected 'Hello', got '%s'", msg.Text)
 		}
-		return &OutgoingMessage{Content: "World"}, nil
+		return &channels.OutgoingMessage{Text: "World"}, nil
 	})
 
 	// Start (registers handler)
@@ -145,9 +184,9 @@ func TestDiscordTypingIndicator(t *testing.T) {
 	}
 
 	handlerCalled := make(chan struct{})
-	channel.SetHandler(func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error) {
+	channel.SetHandler(func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
 		close(handlerCalled)
-		return &OutgoingMessage{Content: "done"}, nil
+		return &channels.OutgoingMessage{Text: "done"}, nil
 	})
 
 	if err := channel.Start(context.Background()); err != nil {
@@ -190,3 +229,179 @@ func TestDiscordTypingIndicator(t *testing.T) {
 		t.Error("expected typing call for 'chan-typing'")
 	}
 }
+
+func TestDiscordChannel_Threads(t *testing.T) {
+	tests := []struct {
+		give        string
+		scope       channels.SessionScope
+		channelID   string
+		wantKey     string
+		wantReplyIn string
+		wantStarted int
+	}{
+		{give: "mention starts thread", scope: channels.SessionPerThread, channelID: "chan-1", wantKey: "discord:chan-1:thread:thread-msg-1", wantReplyIn: "thread-msg-1", wantStarted: 1},
+		{give: "message in thread", scope: channels.SessionPerThread, channelID: "th-9", wantKey: "discord:chan-1:thread:th-9", wantReplyIn: "th-9"},
+		{give: "per-user in thread", scope: channels.SessionPerUser, channelID: "th-9", wantKey: "discord:chan-1:user-1", wantReplyIn: "th-9"},
+		{give: "per-channel", scope: channels.SessionPerChannel, channelID: "chan-1", wantKey: "discord:chan-1", wantReplyIn: "chan-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			state := &discordgo.State{}
+			state.User = &discordgo.User{ID: "bot-123", Username: "TestBot"}
+			mockSession := &MockSession{
+				State: state,
+				Channels: map[string]*discordgo.Channel{
+					"th-9": {ID: "th-9", ParentID: "chan-1", Type: discordgo.ChannelTypeGuildPublicThread},
+				},
+			}
+			channel, err := New(Config{BotToken: "TEST_TOKEN", Session: mockSession, SessionScope: tt.scope})
+			if err != nil {
+				t.Fatalf("new channel: %v", err)
+			}
+
+			var key string
+			channel.SetHandler(func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+				key = msg.SessionKey()
+				return &channels.OutgoingMessage{Text: "ok"}, nil
+			})
+			if err := channel.Start(context.Background()); err != nil {
+				t.Fatalf("start: %v", err)
+			}
+
+			channel.onMessageCreate(nil, &discordgo.MessageCreate{
+				Message: &discordgo.Message{
+					ID:        "msg-1",
+					ChannelID: tt.channelID,
+					GuildID:   "guild-1",
+					Content:   "<@bot-123> deploy status?",
+					Author:    &discordgo.User{ID: "user-1", Username: "User"},
+					Mentions:  []*discordgo.User{{ID: "bot-123"}},
+				},
+			})
+
+			if key != tt.wantKey {
+				t.Errorf("expected session key %q, got %q", tt.wantKey, key)
+			}
+			if len(mockSession.TypingCalls) == 0 || mockSession.TypingCalls[0] != tt.wantReplyIn {
+				t.Errorf("expected reply in %q, got typing calls %v", tt.wantReplyIn, mockSession.TypingCalls)
+			}
+			if len(mockSession.StartedThreads) != tt.wantStarted {
+				t.Errorf("expected %d started threads, got %v", tt.wantStarted, mockSession.StartedThreads)
+			}
+		})
+	}
+}
+
+func TestNew_InvalidSessionScope(t *testing.T) {
+	if _, err := New(Config{BotToken: "TEST_TOKEN", Session: &MockSession{}, SessionScope: "guild"}); err == nil {
+		t.Error("expected invalid session scope error")
+	}
+}
+
+func TestDiscordChannel_Commands(t *testing.T) {
+	state := &discordgo.State{}
+	state.User = &discordgo.User{ID: "bot-123", Username: "TestBot"}
+	mockSession := &MockSession{State: state}
+	channel, err := New(Config{BotToken: "TEST_TOKEN", ApplicationID: "app-1", Session: mockSession})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	channel.SetCommands([]channels.Command{
+		{Name: "reset", Description: "Start over"},
+		{Name: "model", Description: "Switch model", Usage: "[model-id]"},
+	})
+
+	var got []string
+	channel.SetHandler(func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		got = append(got, msg.Text)
+		return &channels.OutgoingMessage{Text: "reply to " + msg.Text}, nil
+	})
+	if err := channel.Start(context.Background()); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+
+	var names []string
+	for _, cmd := range mockSession.Commands {
+		names = append(names, cmd.Name)
+	}
+	if strings.Join(names, ",") != "ask,reset,model" {
+		t.Errorf("expected commands ask,reset,model, got %v", names)
+	}
+	if len(mockSession.Commands[2].Options) != 1 || len(mockSession.Commands[1].Options) != 0 {
+		t.Error("expected an args option only for commands with usage")
+	}
+
+	tests := []struct {
+		give     string
+		giveArgs string
+		want     string
+	}{
+		{give: "reset", want: "/reset"},
+		{give: "model", giveArgs: "gpt-4o", want: "/model gpt-4o"},
+		{give: "ask", giveArgs: "hello there", want: "hello there"},
+	}
+	for _, tt := range tests {
+		data := discordgo.ApplicationCommandInteractionData{Name: tt.give}
+		if tt.giveArgs != "" {
+			data.Options = []*discordgo.ApplicationCommandInteractionDataOption{
+				{Name: argsOption, Type: discordgo.ApplicationCommandOptionString, Value: tt.giveArgs},
+			}
+		}
+		channel.onInteractionCreate(nil, &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+			ID:        "int-" + tt.give,
+			Type:      discordgo.InteractionApplicationCommand,
+			ChannelID: "chan-1",
+			GuildID:   "guild-1",
+			Member:    &discordgo.Member{User: &discordgo.User{ID: "user-1", Username: "User"}},
+			Data:      data,
+		}})
+	}
+
+	if strings.Join(got, "|") != "/reset|/model gpt-4o|hello there" {
+		t.Errorf("unexpected handler texts %q", got)
+	}
+	if len(mockSession.Responses) != 3 || mockSession.Responses[0].Type != discordgo.InteractionResponseDeferredChannelMessageWithSource {
+		t.Errorf("expected deferred responses, got %v", mockSession.Responses)
+	}
+	if len(mockSession.ReplyEdits) != 3 || mockSession.ReplyEdits[1] != "reply to /model gpt-4o" {
+		t.Errorf("unexpected replies %q", mockSession.ReplyEdits)
+	}
+}
+
+func TestDiscordChannel_CommandDoesNotStartThread(t *testing.T) {
+	state := &discordgo.State{}
+	state.User = &discordgo.User{ID: "bot-123", Username: "TestBot"}
+	mockSession := &MockSession{State: state}
+	channel, err := New(Config{BotToken: "TEST_TOKEN", Session: mockSession})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	channel.SetCommands([]channels.Command{{Name: "status", Description: "Show status"}})
+
+	var key string
+	channel.SetHandler(func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		key = msg.SessionKey()
+		return &channels.OutgoingMessage{Text: "ok"}, nil
+	})
+	if err := channel.Start(context.Background()); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+
+	channel.onMessageCreate(nil, &discordgo.MessageCreate{
+		Message: &discordgo.Message{
+			ID:        "msg-1",
+			ChannelID: "chan-1",
+			GuildID:   "guild-1",
+			Content:   "<@bot-123> /status",
+			Author:    &discordgo.User{ID: "user-1", Username: "User"},
+			Mentions:  []*discordgo.User{{ID: "bot-123"}},
+		},
+	})
+
+	if len(mockSession.StartedThreads) != 0 {
+		t.Errorf("expected no thread for a command, got %v", mockSession.StartedThreads)
+	}
+	if key != "discord:chan-1:user-1" {
+		t.Errorf("expected per-user session key, got %q", key)
+	}
+}
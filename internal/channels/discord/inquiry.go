@@ -0,0 +1,186 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/librarian"
+)
+
+// Custom ID prefixes for inquiry quick replies.
+const (
+	inquiryAnswerPrefix  = "inquiry_answer:"
+	inquiryDismissPrefix = "inquiry_dismiss:"
+)
+
+// InquiryProvider implements librarian.InquiryNotifier for Discord using Button components.
+type InquiryProvider struct {
+	session  Session
+	allowed  func(guildID string) bool
+	resolver librarian.InquiryResolver
+}
+
+var _ librarian.InquiryNotifier = (*InquiryProvider)(nil)
+
+// NewInquiryProvider creates a Discord inquiry provider. Inquiries in guilds
+// that do not pass allowed cannot be answered.
+func NewInquiryProvider(sess Session, allowed func(guildID string) bool) *InquiryProvider {
+	return &InquiryProvider{session: sess, allowed: allowed}
+}
+
+// SetResolver sets the resolver that handles quick-reply button presses.
+func (p *InquiryProvider) SetResolver(r librarian.InquiryResolver) {
+	p.resolver = r
+}
+
+// NotifyInquiry sends the inquiry with one button per option and a skip button.
+func (p *InquiryProvider) NotifyInquiry(_ context.Context, inq librarian.Inquiry) error {
+	channelID, err := parseDiscordChannelID(inq.SessionKey)
+	if err != nil {
+		return fmt.Errorf("parse session key: %w", err)
+	}
+
+	id := inq.ID.String()
+	buttons := make([]discordgo.MessageComponent, 0, len(inq.Options)+1)
+	for i, opt := range inq.Options {
+		buttons = append(buttons, discordgo.Button{
+			Label:    opt,
+			Style:    discordgo.PrimaryButton,
+			CustomID: inquiryAnswerPrefix + id + ":" + strconv.Itoa(i),
+		})
+	}
+	buttons = append(buttons, discordgo.Button{
+		Label:    "Skip",
+		Style:    discordgo.SecondaryButton,
+		CustomID: inquiryDismissPrefix + id,
+		Emoji: &discordgo.ComponentEmoji{
+			Name: "⏭",
+		},
+	})
+
+	content := "💡 " + inq.Question
+	if inq.Context != "" {
+		content += "\n*" + inq.Context + "*"
+	}
+	if len(inq.Options) > 0 {
+		content += "\nPick an answer or reply in chat."
+	}
+	_, err = p.session.ChannelMessageSendComplex(channelID, &discordgo.MessageSend{
+		Content: content,
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: buttons},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send inquiry message: %w", err)
+	}
+	return nil
+}
+
+// HandleInteraction processes a button interaction for an inquiry. It returns
+// false when the interaction does not belong to an inquiry. Presses outside
+// allowed guilds or by users outside the inquiry's session are ignored.
+func (p *InquiryProvider) HandleInteraction(i *discordgo.InteractionCreate) bool {
+	if i == nil || i.Type != discordgo.InteractionMessageComponent {
+		return false
+	}
+
+	customID := i.MessageComponentData().CustomID
+	var (
+		id     string
+		option = -1
+	)
+	switch {
+	case strings.HasPrefix(customID, inquiryAnswerPrefix):
+		rest := strings.TrimPrefix(customID, inquiryAnswerPrefix)
+		idx := strings.LastIndex(rest, ":")
+		if idx < 0 {
+			return true
+		}
+		n, err := strconv.Atoi(rest[idx+1:])
+		if err != nil {
+			return true
+		}
+		id, option = rest[:idx], n
+	case strings.HasPrefix(customID, inquiryDismissPrefix):
+		id = strings.TrimPrefix(customID, inquiryDismissPrefix)
+	default:
+		return false
+	}
+
+	inquiryID, err := uuid.Parse(id)
+	if err != nil || p.resolver == nil {
+		return true
+	}
+
+	ctx := context.Background()
+	if !p.mayAnswer(ctx, i, inquiryID) {
+		return true
+	}
+
+	var content string
+	if option >= 0 {
+		inq, err := p.resolver.AnswerInquiry(ctx, inquiryID, option)
+		if err != nil {
+			logger.Warnw("answer inquiry error", "inquiryId", id, "error", err)
+			return true
+		}
+		content = fmt.Sprintf("💡 %s\n✅ %s", inq.Question, inq.Answer)
+	} else {
+		if err := p.resolver.DismissInquiry(ctx, inquiryID); err != nil {
+			logger.Warnw("dismiss inquiry error", "inquiryId", id, "error", err)
+			return true
+		}
+		content = "💡 Inquiry — ⏭ Skipped"
+	}
+
+	err = p.session.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{}, // remove buttons
+		},
+	})
+	if err != nil {
+		logger.Warnw("interaction respond error", "error", err)
+	}
+	return true
+}
+
+// mayAnswer reports whether the user who pressed the button may answer the
+// inquiry: the guild must be allowed and the user must belong to the
+// inquiry's session.
+func (p *InquiryProvider) mayAnswer(ctx context.Context, i *discordgo.InteractionCreate, inquiryID uuid.UUID) bool {
+	user := i.User
+	if i.Member != nil && i.Member.User != nil {
+		user = i.Member.User
+	}
+	if user == nil {
+		return false
+	}
+	if i.GuildID != "" && !p.allowed(i.GuildID) {
+		logger.Warnw("inquiry reply from non-allowed guild ignored", "guildId", i.GuildID, "userId", user.ID)
+		return false
+	}
+	inq, err := p.resolver.GetInquiry(ctx, inquiryID)
+	if err != nil {
+		logger.Warnw("get inquiry error", "inquiryId", inquiryID, "error", err)
+		return false
+	}
+	if !channels.IsSessionUser(inq.SessionKey, user.ID) {
+		logger.Warnw("inquiry reply from another user ignored", "inquiryId", inquiryID, "userId", user.ID)
+		return false
+	}
+	return true
+}
+
+// CanHandle returns true for session keys starting with "discord:".
+func (p *InquiryProvider) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, "discord:")
+}
@@ -0,0 +1,144 @@
+package discord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/librarian"
+)
+
+type fakeInquiryResolver struct {
+	sessionKey string
+	answered   map[uuid.UUID]int
+	dismissed  []uuid.UUID
+}
+
+func (f *fakeInquiryResolver) GetInquiry(_ context.Context, id uuid.UUID) (*librarian.Inquiry, error) {
+	return &librarian.Inquiry{ID: id, SessionKey: f.sessionKey}, nil
+}
+
+func (f *fakeInquiryResolver) AnswerInquiry(_ context.Context, id uuid.UUID, option int) (*librarian.Inquiry, error) {
+	if f.answered == nil {
+		f.answered = make(map[uuid.UUID]int)
+	}
+	f.answered[id] = option
+	return &librarian.Inquiry{ID: id, Question: "Where do you deploy?", Answer: "GCP"}, nil
+}
+
+func (f *fakeInquiryResolver) DismissInquiry(_ context.Context, id uuid.UUID) error {
+	f.dismissed = append(f.dismissed, id)
+	return nil
+}
+
+func TestDiscordInquiryProvider_NotifyInquiry(t *testing.T) {
+	sess := &MockApprovalSession{}
+	p := NewInquiryProvider(sess, allowAll)
+
+	id := uuid.New()
+	err := p.NotifyInquiry(context.Background(), librarian.Inquiry{
+		ID:         id,
+		SessionKey: "discord:ch-1:usr-1",
+		Question:   "Where do you deploy?",
+		Options:    []string{"AWS", "GCP"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sess.SentComplexMessages) != 1 {
+		t.Fatalf("want 1 sent message, got %d", len(sess.SentComplexMessages))
+	}
+	row, ok := sess.SentComplexMessages[0].Components[0].(discordgo.ActionsRow)
+	if !ok {
+		t.Fatalf("unexpected component type %T", sess.SentComplexMessages[0].Components[0])
+	}
+	if len(row.Components) != 3 {
+		t.Fatalf("want 3 buttons, got %d", len(row.Components))
+	}
+	if btn := row.Components[0].(discordgo.Button); btn.CustomID != inquiryAnswerPrefix+id.String()+":0" {
+		t.Errorf("unexpected custom ID %q", btn.CustomID)
+	}
+}
+
+func allowAll(string) bool { return true }
+
+func componentInteraction(guildID, userID, customID string) *discordgo.InteractionCreate {
+	return &discordgo.InteractionCreate{
+		Interaction: &discordgo.Interaction{
+			Type:    discordgo.InteractionMessageComponent,
+			GuildID: guildID,
+			Member:  &discordgo.Member{User: &discordgo.User{ID: userID}},
+			Data:    discordgo.MessageComponentInteractionData{CustomID: customID},
+		},
+	}
+}
+
+func TestDiscordInquiryProvider_HandleInteraction(t *testing.T) {
+	var responded *discordgo.InteractionResponse
+	sess := &MockApprovalSession{
+		InteractionRespondFunc: func(_ *discordgo.Interaction, resp *discordgo.InteractionResponse, _ ...discordgo.RequestOption) error {
+			responded = resp
+			return nil
+		},
+	}
+	resolver := &fakeInquiryResolver{sessionKey: "discord:ch-1:usr-1"}
+	p := NewInquiryProvider(sess, allowAll)
+	p.SetResolver(resolver)
+
+	interaction := func(customID string) *discordgo.InteractionCreate {
+		return componentInteraction("g-1", "usr-1", customID)
+	}
+
+	answerID := uuid.New()
+	if !p.HandleInteraction(interaction(inquiryAnswerPrefix + answerID.String() + ":1")) {
+		t.Fatal("expected answer interaction to be handled")
+	}
+	if got, ok := resolver.answered[answerID]; !ok || got != 1 {
+		t.Errorf("want option 1 answered, got %d (found=%v)", got, ok)
+	}
+	if responded == nil || responded.Data.Content != "💡 Where do you deploy?\n✅ GCP" {
+		t.Errorf("unexpected interaction response %+v", responded)
+	}
+
+	dismissID := uuid.New()
+	if !p.HandleInteraction(interaction(inquiryDismissPrefix + dismissID.String())) {
+		t.Fatal("expected dismiss interaction to be handled")
+	}
+	if len(resolver.dismissed) != 1 || resolver.dismissed[0] != dismissID {
+		t.Errorf("unexpected dismissed %v", resolver.dismissed)
+	}
+
+	if p.HandleInteraction(interaction("approve:req-1")) {
+		t.Error("approval interaction must not be handled by inquiry provider")
+	}
+}
+
+func TestDiscordInquiryProvider_HandleInteractionIgnoresOtherUsers(t *testing.T) {
+	tests := []struct {
+		give    string
+		user    string
+		allowed func(guildID string) bool
+	}{
+		{give: "other session user", user: "usr-2", allowed: allowAll},
+		{give: "guild not allowed", user: "usr-1", allowed: func(string) bool { return false }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			resolver := &fakeInquiryResolver{sessionKey: "discord:ch-1:usr-1"}
+			p := NewInquiryProvider(&MockApprovalSession{}, tt.allowed)
+			p.SetResolver(resolver)
+
+			id := uuid.New()
+			for _, customID := range []string{inquiryAnswerPrefix + id.String() + ":0", inquiryDismissPrefix + id.String()} {
+				if !p.HandleInteraction(componentInteraction("g-1", tt.user, customID)) {
+					t.Fatalf("expected %q to be handled", customID)
+				}
+			}
+			if len(resolver.answered) != 0 || len(resolver.dismissed) != 0 {
+				t.Errorf("press must be ignored, got answered=%v dismissed=%v", resolver.answered, resolver.dismissed)
+			}
+		})
+	}
+}
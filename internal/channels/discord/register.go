@@ -0,0 +1,26 @@
+package discord
+
+import (
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+func init() {
+	channels.Register(types.ChannelDiscord, fromConfig)
+}
+
+// fromConfig creates the Discord channel from the application config.
+func fromConfig(cfg *config.Config) (channels.Channel, error) {
+	dc := cfg.Channels.Discord
+	if !dc.Enabled {
+		return nil, channels.ErrDisabled
+	}
+	return New(Config{
+		BotToken:           dc.BotToken,
+		ApplicationID:      dc.ApplicationID,
+		AllowedGuilds:      dc.AllowedGuilds,
+		SessionScope:       channels.SessionScope(dc.SessionScope),
+		ApprovalTimeoutSec: cfg.Security.Interceptor.ApprovalTimeoutSec,
+	})
+}
@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// editInterval keeps streamed edits within Discord's limit of five message
+// updates per five seconds per channel.
+const editInterval = time.Second
+
+// streamEditor posts and edits a streamed reply in a channel or thread.
+type streamEditor struct {
+	session   Session
+	channelID string
+}
+
+// Post sends a new message and returns its ID.
+func (e *streamEditor) Post(_ context.Context, text string) (string, error) {
+	msg, err := e.session.ChannelMessageSendComplex(e.channelID, &discordgo.MessageSend{Content: text})
+	if err != nil {
+		return "", fmt.Errorf("post streamed reply: %w", err)
+	}
+	return msg.ID, nil
+}
+
+// Edit replaces the text of a posted message.
+func (e *streamEditor) Edit(_ context.Context, messageID, text string) error {
+	if _, err := e.session.ChannelMessageEditComplex(discordgo.NewMessageEdit(e.channelID, messageID).SetContent(text)); err != nil {
+		return fmt.Errorf("edit streamed reply: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a posted message.
+func (e *streamEditor) Delete(_ context.Context, messageID string) error {
+	if err := e.session.ChannelMessageDelete(e.channelID, messageID); err != nil {
+		return fmt.Errorf("delete streamed reply: %w", err)
+	}
+	return nil
+}
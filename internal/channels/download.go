@@ -0,0 +1,45 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// MaxDownloadSize caps the size of a downloaded attachment.
+const MaxDownloadSize = 100 << 20 // 100MB
+
+// Download fetches an attachment from url, sending the given headers (e.g.
+// an Authorization header for private files). It fails if the response is
+// not 200 or exceeds MaxDownloadSize.
+func Download(ctx context.Context, client *http.Client, url string, header http.Header) ([]byte, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create download request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download attachment: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download attachment: status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxDownloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("download attachment: %w", err)
+	}
+	if len(data) > MaxDownloadSize {
+		return nil, fmt.Errorf("download attachment: larger than %d bytes", MaxDownloadSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,182 @@
+package email
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/approval"
+)
+
+// sendFunc sends a Markdown message to a thread or address and returns the
+// Message-ID of the sent mail.
+type sendFunc func(ctx context.Context, chatID, text string) (string, error)
+
+// ApprovalProvider implements approval.Provider for email. Email has no
+// buttons, so the approval request asks the user to reply with a keyword.
+//
+// The From header of a mail can be forged, so a reply only resolves an
+// approval when it comes from the address that started the session and
+// repeats the code sent with the request. Only the requester's mailbox
+// receives the code.
+type ApprovalProvider struct {
+	send    sendFunc
+	pending sync.Map // map[messageID]*pendingApproval
+	timeout time.Duration
+}
+
+// pendingApproval is an approval request waiting for a reply.
+type pendingApproval struct {
+	requester string // address allowed to answer
+	code      string // code the reply must repeat to approve
+	resp      chan approval.ApprovalResponse
+}
+
+// approvalCodeAlphabet leaves out characters that are easy to confuse.
+const approvalCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// newApprovalCode returns a random code of 8 characters.
+func newApprovalCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = approvalCodeAlphabet[int(b[i])%len(approvalCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+var _ approval.Provider = (*ApprovalProvider)(nil)
+
+// NewApprovalProvider creates an email approval provider.
+func NewApprovalProvider(send sendFunc, timeout time.Duration) *ApprovalProvider {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &ApprovalProvider{
+		send:    send,
+		timeout: timeout,
+	}
+}
+
+// RequestApproval mails the approval request into the session's thread and
+// waits for a reply from the requester.
+func (p *ApprovalProvider) RequestApproval(ctx context.Context, req approval.ApprovalRequest) (approval.ApprovalResponse, error) {
+	root, requester, err := parseEmailSession(req.SessionKey)
+	if err != nil {
+		return approval.ApprovalResponse{}, fmt.Errorf("parse session key: %w", err)
+	}
+	code, err := newApprovalCode()
+	if err != nil {
+		return approval.ApprovalResponse{}, fmt.Errorf("generate approval code: %w", err)
+	}
+
+	text := fmt.Sprintf("🔐 Tool `%s` requires approval.", req.ToolName)
+	if req.Summary != "" {
+		text += "\n\n" + req.Summary
+	}
+	text += fmt.Sprintf("\n\nReply with **APPROVE %s**, **ALWAYS %s** (always allow this tool) or **DENY**.", code, code)
+
+	messageID, err := p.send(ctx, root, text)
+	if err != nil {
+		return approval.ApprovalResponse{}, fmt.Errorf("send approval message: %w", err)
+	}
+
+	pending := &pendingApproval{
+		requester: requester,
+		code:      code,
+		resp:      make(chan approval.ApprovalResponse, 1),
+	}
+	p.pending.Store(messageID, pending)
+	defer p.pending.Delete(messageID)
+
+	select {
+	case resp := <-pending.resp:
+		return resp, nil
+	case <-ctx.Done():
+		return approval.ApprovalResponse{}, ctx.Err()
+	case <-time.After(p.timeout):
+		return approval.ApprovalResponse{}, fmt.Errorf("approval timeout")
+	}
+}
+
+// HandleReply resolves a pending approval from a reply to the approval
+// message. It returns false when the mail does not answer a pending
+// approval. Replies from other senders, replies without a recognized
+// keyword and approvals without the right code are consumed and ignored.
+func (p *ApprovalProvider) HandleReply(inReplyTo, from, text string) bool {
+	val, ok := p.pending.Load(inReplyTo)
+	if !ok {
+		return false
+	}
+	pending := val.(*pendingApproval)
+	if !strings.EqualFold(from, pending.requester) {
+		logger.Warnw("ignoring approval reply from another sender", "inReplyTo", inReplyTo, "from", from)
+		return true
+	}
+
+	keyword, code := replyKeyword(text)
+	var resp approval.ApprovalResponse
+	switch keyword {
+	case "APPROVE", "APPROVED", "YES":
+		resp = approval.ApprovalResponse{Approved: true}
+	case "DENY", "DENIED", "NO":
+	case "ALWAYS":
+		resp = approval.ApprovalResponse{Approved: true, AlwaysAllow: true}
+	default:
+		logger.Infow("ignoring approval reply without keyword", "inReplyTo", inReplyTo)
+		return true
+	}
+	if resp.Approved && subtle.ConstantTimeCompare([]byte(code), []byte(pending.code)) != 1 {
+		logger.Warnw("ignoring approval reply with wrong code", "inReplyTo", inReplyTo, "from", from)
+		return true
+	}
+
+	// LoadAndDelete to prevent duplicate replies (TOCTOU)
+	if _, ok := p.pending.LoadAndDelete(inReplyTo); !ok {
+		return true
+	}
+	select {
+	case pending.resp <- resp:
+	default:
+	}
+	return true
+}
+
+// CanHandle returns true for session keys starting with "email:".
+func (p *ApprovalProvider) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, "email:")
+}
+
+// parseEmailSession extracts the thread root and the correspondent from a
+// session key like "email:<rootMessageID>:<address>".
+func parseEmailSession(sessionKey string) (root, address string, err error) {
+	rest, ok := strings.CutPrefix(sessionKey, "email:")
+	if !ok {
+		return "", "", fmt.Errorf("invalid email session key: %s", sessionKey)
+	}
+	idx := strings.LastIndexByte(rest, ':')
+	if idx <= 0 || idx == len(rest)-1 {
+		return "", "", fmt.Errorf("invalid email session key: %s", sessionKey)
+	}
+	return rest[:idx], rest[idx+1:], nil
+}
+
+// replyKeyword returns the first two words of text in upper case, without
+// surrounding punctuation: the keyword and the approval code.
+func replyKeyword(text string) (keyword, code string) {
+	fields := strings.Fields(text)
+	clean := func(s string) string { return strings.ToUpper(strings.Trim(s, "*_.,!:;\"'")) }
+	if len(fields) > 0 {
+		keyword = clean(fields[0])
+	}
+	if len(fields) > 1 {
+		code = clean(fields[1])
+	}
+	return keyword, code
+}
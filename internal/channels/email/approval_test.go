@@ -0,0 +1,151 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/approval"
+)
+
+func TestApprovalProvider_Replies(t *testing.T) {
+	tests := []struct {
+		give string
+		want approval.ApprovalResponse
+	}{
+		{give: "Approve %s", want: approval.ApprovalResponse{Approved: true}},
+		{give: "yes %s, go ahead", want: approval.ApprovalResponse{Approved: true}},
+		{give: "DENY.", want: approval.ApprovalResponse{}},
+		{give: "**ALWAYS %s**", want: approval.ApprovalResponse{Approved: true, AlwaysAllow: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			var mu sync.Mutex
+			var sentTo, sentText string
+			p := NewApprovalProvider(func(_ context.Context, chatID, text string) (string, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				sentTo, sentText = chatID, text
+				return "approval-1@example.com", nil
+			}, 5*time.Second)
+
+			done := make(chan approval.ApprovalResponse, 1)
+			go func() {
+				resp, err := p.RequestApproval(context.Background(), approval.ApprovalRequest{
+					ID:         "req-1",
+					ToolName:   "exec",
+					SessionKey: "email:m1@example.com:alice@example.com",
+				})
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				done <- resp
+			}()
+
+			waitFor(t, func() bool {
+				_, ok := p.pending.Load("approval-1@example.com")
+				return ok
+			})
+
+			val, _ := p.pending.Load("approval-1@example.com")
+			give := tt.give
+			if strings.Contains(give, "%s") {
+				give = fmt.Sprintf(give, strings.ToLower(val.(*pendingApproval).code))
+			}
+
+			if p.HandleReply("other@example.com", "alice@example.com", give) {
+				t.Error("reply to another message must not be handled")
+			}
+			if !p.HandleReply("approval-1@example.com", "alice@example.com", "hmm, what does it do?") {
+				t.Error("reply without keyword must be consumed")
+			}
+			if !p.HandleReply("approval-1@example.com", "mallory@example.com", give) {
+				t.Error("reply from another sender must be consumed")
+			}
+			if !p.HandleReply("approval-1@example.com", "Alice@Example.com", give) {
+				t.Fatal("expected reply to be handled")
+			}
+
+			select {
+			case resp := <-done:
+				if resp != tt.want {
+					t.Errorf("got %+v, want %+v", resp, tt.want)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("timeout waiting for approval")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if sentTo != "m1@example.com" {
+				t.Errorf("expected approval in thread m1@example.com, got %q", sentTo)
+			}
+			if !strings.Contains(sentText, "APPROVE "+val.(*pendingApproval).code) {
+				t.Errorf("expected approval code in text, got %q", sentText)
+			}
+		})
+	}
+}
+
+func TestApprovalProvider_RequiresCode(t *testing.T) {
+	p := NewApprovalProvider(func(context.Context, string, string) (string, error) {
+		return "approval-1@example.com", nil
+	}, 200*time.Millisecond)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := p.RequestApproval(context.Background(), approval.ApprovalRequest{
+			ToolName:   "exec",
+			SessionKey: "email:m1@example.com:alice@example.com",
+		})
+		done <- err
+	}()
+	waitFor(t, func() bool {
+		_, ok := p.pending.Load("approval-1@example.com")
+		return ok
+	})
+
+	for _, reply := range []string{"APPROVE", "APPROVE WRONGCODE", "ALWAYS"} {
+		if !p.HandleReply("approval-1@example.com", "alice@example.com", reply) {
+			t.Errorf("%q: expected reply to be consumed", reply)
+		}
+	}
+	if err := <-done; err == nil {
+		t.Error("approval without the code must not resolve the request")
+	}
+}
+
+func TestApprovalProvider_Timeout(t *testing.T) {
+	p := NewApprovalProvider(func(context.Context, string, string) (string, error) {
+		return "approval-1@example.com", nil
+	}, 20*time.Millisecond)
+
+	_, err := p.RequestApproval(context.Background(), approval.ApprovalRequest{
+		ToolName:   "exec",
+		SessionKey: "email:m1@example.com:alice@example.com",
+	})
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if p.HandleReply("approval-1@example.com", "alice@example.com", "APPROVE") {
+		t.Error("expired approval must not be handled")
+	}
+}
+
+func TestParseEmailSession(t *testing.T) {
+	root, addr, err := parseEmailSession("email:m1@example.com:alice@example.com")
+	if err != nil || root != "m1@example.com" || addr != "alice@example.com" {
+		t.Errorf("got %q, %q, %v", root, addr, err)
+	}
+	if _, _, err := parseEmailSession("slack:C1:U1"); err == nil {
+		t.Error("expected error for non-email session key")
+	}
+	p := NewApprovalProvider(nil, 0)
+	if !p.CanHandle("email:m1@example.com:alice@example.com") || p.CanHandle("matrix:!r:s:@u:s") {
+		t.Error("unexpected CanHandle result")
+	}
+}
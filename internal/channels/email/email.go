@@ -0,0 +1,386 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/types"
+)
+
+var logger = logging.SubsystemSugar("channel.email")
+
+const (
+	defaultPollInterval = 30 * time.Second
+	maxReferences       = 20
+
+	// maxThreads bounds the threads kept for replies. The least recently
+	// active thread is dropped first; a later reply in it starts tracking
+	// it again from its References header.
+	maxThreads = 1000
+)
+
+// Config holds email channel configuration
+type Config struct {
+	Address            string        // address the agent sends from and receives at
+	Allowlist          []string      // allowed sender addresses or @domains; required
+	PollInterval       time.Duration // 0 = 30s
+	ApprovalTimeoutSec int           // 0 = default 30s
+	Source             Source        // incoming mail
+	Sender             Sender        // outgoing mail
+}
+
+// thread tracks the state needed to reply within a conversation.
+type thread struct {
+	address    string   // correspondent
+	subject    string   // original subject without reply prefixes
+	lastID     string   // Message-ID of the latest message
+	references []string // Message-IDs of the conversation, oldest first
+	active     time.Time
+}
+
+// Channel implements an email channel. Each mail thread is its own session:
+// the chat ID of a message is the Message-ID of the first mail in its
+// thread, derived from the References and In-Reply-To headers.
+type Channel struct {
+	config   Config
+	address  string
+	handler  channels.Handler
+	approval *ApprovalProvider
+
+	mu      sync.Mutex
+	threads map[string]*thread // by root Message-ID
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ channels.Channel = (*Channel)(nil)
+
+// New creates a new email channel
+func New(cfg Config) (*Channel, error) {
+	addr, err := mail.ParseAddress(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %w", cfg.Address, err)
+	}
+	if len(cfg.Allowlist) == 0 {
+		return nil, fmt.Errorf("sender allowlist is required")
+	}
+	if cfg.Source == nil {
+		return nil, fmt.Errorf("IMAP server or Maildir is required")
+	}
+	if cfg.Sender == nil {
+		return nil, fmt.Errorf("SMTP server is required")
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+
+	c := &Channel{
+		config:  cfg,
+		address: strings.ToLower(addr.Address),
+		threads: make(map[string]*thread),
+	}
+	c.approval = NewApprovalProvider(c.sendText, time.Duration(cfg.ApprovalTimeoutSec)*time.Second)
+	return c, nil
+}
+
+// Type returns types.ChannelEmail.
+func (c *Channel) Type() types.ChannelType {
+	return types.ChannelEmail
+}
+
+// Capabilities reports the features supported by email.
+func (c *Channel) Capabilities() channels.Capabilities {
+	return channels.Capabilities{
+		Threads:     true,
+		Attachments: true,
+	}
+}
+
+// SetHandler sets the message handler
+func (c *Channel) SetHandler(handler channels.Handler) {
+	c.handler = handler
+}
+
+// ApprovalProvider returns the channel's approval provider for composite registration.
+func (c *Channel) ApprovalProvider() approval.Provider {
+	return c.approval
+}
+
+// Start begins polling for mail.
+func (c *Channel) Start(ctx context.Context) error {
+	if c.handler == nil {
+		return fmt.Errorf("message handler not set")
+	}
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.pollLoop(ctx)
+	}()
+
+	logger.Infow("email channel started", "address", c.address, "pollInterval", c.config.PollInterval.String())
+	return nil
+}
+
+// pollLoop fetches mail immediately and then every poll interval.
+func (c *Channel) pollLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		c.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll fetches new mail and dispatches each message.
+func (c *Channel) poll(ctx context.Context) {
+	msgs, err := c.config.Source.Fetch(ctx)
+	if err != nil && ctx.Err() == nil {
+		logger.Warnw("fetch mail error", "error", err)
+	}
+	for _, raw := range msgs {
+		c.handleRaw(ctx, raw)
+	}
+}
+
+// handleRaw parses an incoming mail and runs the handler for it.
+func (c *Channel) handleRaw(ctx context.Context, raw []byte) {
+	in, err := parseMessage(raw)
+	if err != nil {
+		logger.Warnw("malformed mail", "error", err)
+		return
+	}
+	if in.From == c.address {
+		return
+	}
+	if in.Auto {
+		logger.Debugw("ignoring auto-submitted mail", "messageId", in.MessageID, "from", in.From)
+		return
+	}
+	if !c.isAllowed(in.From) {
+		logger.Warnw("blocked mail from non-allowed sender", "from", in.From, "messageId", in.MessageID)
+		return
+	}
+
+	root := in.ThreadRoot()
+	c.trackIncoming(root, in)
+
+	text := stripQuoted(in.Text)
+	if c.approval.HandleReply(in.InReplyTo, in.From, text) {
+		return
+	}
+	// The subject carries the request when a thread starts.
+	if root == in.MessageID && in.Subject != "" {
+		text = strings.TrimSpace("Subject: " + in.Subject + "\n\n" + text)
+	}
+	if text == "" && len(in.Attachments) == 0 {
+		return
+	}
+
+	username := in.FromName
+	if username == "" {
+		username = in.From
+	}
+	incoming := &channels.IncomingMessage{
+		Channel:     types.ChannelEmail,
+		MessageID:   in.MessageID,
+		ChatID:      root,
+		UserID:      in.From,
+		Username:    username,
+		Text:        text,
+		ReplyToID:   in.InReplyTo,
+		Thread:      &channels.Thread{ID: root},
+		Attachments: in.Attachments,
+		IsDM:        true,
+	}
+
+	logger.Infow("received mail",
+		"messageId", in.MessageID,
+		"thread", root,
+		"from", in.From,
+	)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		response, err := c.handler(ctx, incoming)
+		if err != nil {
+			logger.Errorw("handler error", "error", err)
+			_ = c.Send(ctx, root, &channels.OutgoingMessage{Text: fmt.Sprintf("❌ Error: %s", err.Error())})
+			return
+		}
+
+		if response != nil {
+			if err := c.Send(ctx, root, response); err != nil {
+				logger.Errorw("send error", "error", err)
+			}
+		}
+	}()
+}
+
+// trackIncoming records an incoming mail in its thread.
+func (c *Channel) trackIncoming(root string, in *inbound) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.threads[root]
+	if !ok {
+		t = &thread{
+			address:    in.From,
+			subject:    in.Subject,
+			references: append([]string(nil), in.References...),
+			active:     time.Now(),
+		}
+		c.threads[root] = t
+		c.evictThreads()
+	}
+	t.address = in.From
+	t.lastID = in.MessageID
+	t.references = appendReference(t.references, in.MessageID)
+	t.active = time.Now()
+}
+
+// evictThreads drops the least recently active threads beyond maxThreads.
+// c.mu must be held.
+func (c *Channel) evictThreads() {
+	for len(c.threads) > maxThreads {
+		var oldest string
+		var oldestAt time.Time
+		for root, t := range c.threads {
+			if oldest == "" || t.active.Before(oldestAt) {
+				oldest, oldestAt = root, t.active
+			}
+		}
+		delete(c.threads, oldest)
+	}
+}
+
+// Send sends a message. chatID is either the root Message-ID of a known
+// thread, which produces a reply in that thread, or an allowlisted address,
+// which starts a new conversation. An empty chatID selects the first
+// allowlisted address.
+func (c *Channel) Send(ctx context.Context, chatID string, msg *channels.OutgoingMessage) error {
+	_, err := c.sendText(ctx, chatID, msg.Text)
+	return err
+}
+
+// sendText sends Markdown text and returns the Message-ID of the sent mail.
+func (c *Channel) sendText(ctx context.Context, chatID, text string) (string, error) {
+	out := &outbound{
+		From:      c.address,
+		MessageID: newMessageID(c.address),
+		Text:      text,
+	}
+
+	c.mu.Lock()
+	t, isThread := c.threads[chatID]
+	if isThread {
+		out.To = t.address
+		out.Subject = replySubject(t.subject)
+		out.InReplyTo = t.lastID
+		out.References = append([]string(nil), t.references...)
+	}
+	c.mu.Unlock()
+
+	if !isThread {
+		to, err := c.deliveryAddress(chatID)
+		if err != nil {
+			return "", err
+		}
+		out.To = to
+		out.Subject = deliverySubject(text)
+	}
+
+	data, err := compose(out)
+	if err != nil {
+		return "", fmt.Errorf("compose mail: %w", err)
+	}
+	if err := c.config.Sender.Send(ctx, c.address, []string{out.To}, data); err != nil {
+		return "", err
+	}
+
+	if isThread {
+		c.mu.Lock()
+		t.lastID = out.MessageID
+		t.references = appendReference(t.references, out.MessageID)
+		t.active = time.Now()
+		c.mu.Unlock()
+	}
+	return out.MessageID, nil
+}
+
+// deliveryAddress validates a delivery target. Mail is only sent to
+// allowlisted addresses so the agent cannot mail arbitrary recipients.
+func (c *Channel) deliveryAddress(chatID string) (string, error) {
+	if chatID == "" {
+		for _, a := range c.config.Allowlist {
+			if !strings.HasPrefix(a, "@") {
+				return strings.ToLower(a), nil
+			}
+		}
+		return "", fmt.Errorf("email delivery requires an address (use email:user@example.com) or at least one allowlisted address")
+	}
+	addr, err := mail.ParseAddress(chatID)
+	if err != nil {
+		return "", fmt.Errorf("invalid email address %q: %w", chatID, err)
+	}
+	to := strings.ToLower(addr.Address)
+	if !c.isAllowed(to) {
+		return "", fmt.Errorf("email delivery to %s refused: address not in allowlist", to)
+	}
+	return to, nil
+}
+
+// StartTyping is a no-op; email has no typing indicator.
+func (c *Channel) StartTyping(_ context.Context, _ string) func() {
+	return func() {}
+}
+
+// isAllowed checks whether an address matches the allowlist, either
+// exactly or by an "@domain" entry.
+func (c *Channel) isAllowed(address string) bool {
+	address = strings.ToLower(address)
+	for _, a := range c.config.Allowlist {
+		a = strings.ToLower(strings.TrimSpace(a))
+		if a == address || (strings.HasPrefix(a, "@") && strings.HasSuffix(address, a)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Stop stops the email channel
+func (c *Channel) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+	logger.Info("email channel stopped")
+}
+
+// appendReference appends id to refs, keeping the first (root) entry and the
+// most recent ones.
+func appendReference(refs []string, id string) []string {
+	if len(refs) > 0 && refs[len(refs)-1] == id {
+		return refs
+	}
+	refs = append(refs, id)
+	if len(refs) > maxReferences {
+		refs = append(refs[:1], refs[len(refs)-maxReferences+1:]...)
+	}
+	return refs
+}
@@ -0,0 +1,541 @@
+package email
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/types"
+)
+
+const botAddr = "agent@example.com"
+
+// fakeIMAP is an in-process IMAP server that supports the commands used by
+// IMAPSource.
+type fakeIMAP struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	msgs []*fakeMail
+}
+
+type fakeMail struct {
+	uid  int
+	data []byte
+	seen bool
+}
+
+func newFakeIMAP(t *testing.T) *fakeIMAP {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeIMAP{ln: ln}
+	go s.serve()
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *fakeIMAP) deliver(data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.msgs = append(s.msgs, &fakeMail{uid: len(s.msgs) + 1, data: []byte(strings.ReplaceAll(data, "\n", "\r\n"))})
+}
+
+func (s *fakeIMAP) unseen() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, m := range s.msgs {
+		if !m.seen {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *fakeIMAP) source() *IMAPSource {
+	addr := s.ln.Addr().(*net.TCPAddr)
+	return &IMAPSource{
+		Host:     "127.0.0.1",
+		Port:     addr.Port,
+		Username: botAddr,
+		Password: "secret",
+		Insecure: true,
+	}
+}
+
+func (s *fakeIMAP) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeIMAP) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	fmt.Fprint(conn, "* OK fake IMAP ready\r\n")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		tag, cmd, _ := strings.Cut(strings.TrimRight(line, "\r\n"), " ")
+		upper := strings.ToUpper(cmd)
+		switch {
+		case strings.HasPrefix(upper, "LOGIN "):
+			if cmd != `LOGIN "`+botAddr+`" "secret"` {
+				fmt.Fprintf(conn, "%s NO invalid credentials\r\n", tag)
+				continue
+			}
+		case strings.HasPrefix(upper, "SELECT "):
+			s.mu.Lock()
+			n := len(s.msgs)
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "* %d EXISTS\r\n", n)
+		case upper == "UID SEARCH UNSEEN":
+			s.mu.Lock()
+			var uids []string
+			for _, m := range s.msgs {
+				if !m.seen {
+					uids = append(uids, strconv.Itoa(m.uid))
+				}
+			}
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "* SEARCH %s\r\n", strings.Join(uids, " "))
+		case strings.HasPrefix(upper, "UID FETCH "):
+			uid, _ := strconv.Atoi(strings.Fields(cmd)[2])
+			s.mu.Lock()
+			m := s.msgs[uid-1]
+			s.mu.Unlock()
+			fmt.Fprintf(conn, "* %d FETCH (UID %d BODY[] {%d}\r\n", uid, uid, len(m.data))
+			conn.Write(m.data)
+			fmt.Fprint(conn, ")\r\n")
+		case strings.HasPrefix(upper, "UID STORE "):
+			uid, _ := strconv.Atoi(strings.Fields(cmd)[2])
+			s.mu.Lock()
+			s.msgs[uid-1].seen = true
+			s.mu.Unlock()
+		case upper == "LOGOUT":
+			fmt.Fprintf(conn, "* BYE\r\n%s OK LOGOUT completed\r\n", tag)
+			return
+		default:
+			fmt.Fprintf(conn, "%s BAD unknown command\r\n", tag)
+			continue
+		}
+		fmt.Fprintf(conn, "%s OK done\r\n", tag)
+	}
+}
+
+// fakeSMTP is an in-process SMTP server that records submitted mail.
+type fakeSMTP struct {
+	ln   net.Listener
+	mu   sync.Mutex
+	sent []sentMail
+}
+
+type sentMail struct {
+	from string
+	to   []string
+	data []byte
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeSMTP{ln: ln}
+	go s.serve()
+	t.Cleanup(func() { ln.Close() })
+	return s
+}
+
+func (s *fakeSMTP) sender() *SMTPSender {
+	return &SMTPSender{Host: "127.0.0.1", Port: s.ln.Addr().(*net.TCPAddr).Port}
+}
+
+func (s *fakeSMTP) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.sent)
+}
+
+func (s *fakeSMTP) get(i int) sentMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sent[i]
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	fmt.Fprint(conn, "220 fake SMTP ready\r\n")
+	var cur sentMail
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		upper := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			fmt.Fprint(conn, "250 fake\r\n")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			cur = sentMail{from: strings.Trim(line[len("MAIL FROM:"):], "<> ")}
+			fmt.Fprint(conn, "250 OK\r\n")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			cur.to = append(cur.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			fmt.Fprint(conn, "250 OK\r\n")
+		case upper == "DATA":
+			fmt.Fprint(conn, "354 go ahead\r\n")
+			var data bytes.Buffer
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			cur.data = data.Bytes()
+			s.mu.Lock()
+			s.sent = append(s.sent, cur)
+			s.mu.Unlock()
+			fmt.Fprint(conn, "250 queued\r\n")
+		case upper == "QUIT":
+			fmt.Fprint(conn, "221 bye\r\n")
+			return
+		default:
+			fmt.Fprint(conn, "250 OK\r\n")
+		}
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// parts returns the plain and HTML bodies of a composed mail.
+func parts(t *testing.T, data []byte) (*mail.Message, string, string) {
+	t.Helper()
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("read sent mail: %v", err)
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("content type: %v", err)
+	}
+	var plain, html string
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		body, _ := io.ReadAll(p)
+		if strings.HasPrefix(p.Header.Get("Content-Type"), "text/html") {
+			html = string(body)
+		} else {
+			plain = string(body)
+		}
+	}
+	return msg, plain, html
+}
+
+func newTestChannel(t *testing.T, imap *fakeIMAP, smtp *fakeSMTP, handler channels.Handler, allowlist ...string) *Channel {
+	t.Helper()
+	if len(allowlist) == 0 {
+		allowlist = []string{"alice@example.com"}
+	}
+	ch, err := New(Config{
+		Address:      botAddr,
+		Allowlist:    allowlist,
+		PollInterval: 20 * time.Millisecond,
+		Source:       imap.source(),
+		Sender:       smtp.sender(),
+	})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	ch.SetHandler(handler)
+	if err := ch.Start(context.Background()); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	t.Cleanup(ch.Stop)
+	return ch
+}
+
+func TestEmailChannel_RepliesInThread(t *testing.T) {
+	imap := newFakeIMAP(t)
+	smtp := newFakeSMTP(t)
+
+	var mu sync.Mutex
+	var received []*channels.IncomingMessage
+	newTestChannel(t, imap, smtp, func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		mu.Lock()
+		received = append(received, msg)
+		mu.Unlock()
+		return &channels.OutgoingMessage{Text: "# Answer\n\nIt is **sunny**."}, nil
+	})
+
+	imap.deliver(`From: Alice <alice@example.com>
+To: agent@example.com
+Subject: Weather
+Message-ID: <m1@example.com>
+Content-Type: text/plain; charset=utf-8
+
+How is the weather tomorrow?
+`)
+	waitFor(t, func() bool { return smtp.count() == 1 })
+
+	mu.Lock()
+	first := received[0]
+	mu.Unlock()
+	if first.Channel != types.ChannelEmail || first.ChatID != "m1@example.com" || first.UserID != "alice@example.com" {
+		t.Errorf("unexpected incoming message: %+v", first)
+	}
+	if first.Text != "Subject: Weather\n\nHow is the weather tomorrow?" {
+		t.Errorf("unexpected text: %q", first.Text)
+	}
+	if first.Username != "Alice" {
+		t.Errorf("expected username Alice, got %q", first.Username)
+	}
+	if imap.unseen() != 0 {
+		t.Error("expected fetched mail to be marked seen")
+	}
+
+	sent := smtp.get(0)
+	if sent.from != botAddr || len(sent.to) != 1 || sent.to[0] != "alice@example.com" {
+		t.Errorf("unexpected envelope: %s -> %v", sent.from, sent.to)
+	}
+	msg, plain, html := parts(t, sent.data)
+	if got := msg.Header.Get("Subject"); got != "Re: Weather" {
+		t.Errorf("expected subject %q, got %q", "Re: Weather", got)
+	}
+	if got := msg.Header.Get("In-Reply-To"); got != "<m1@example.com>" {
+		t.Errorf("expected In-Reply-To <m1@example.com>, got %q", got)
+	}
+	if got := msg.Header.Get("References"); got != "<m1@example.com>" {
+		t.Errorf("expected References <m1@example.com>, got %q", got)
+	}
+	if !strings.Contains(plain, "It is **sunny**.") {
+		t.Errorf("expected Markdown in text part, got %q", plain)
+	}
+	if !strings.Contains(html, "<h1>Answer</h1>") || !strings.Contains(html, "<strong>sunny</strong>") {
+		t.Errorf("expected rendered HTML, got %q", html)
+	}
+	replyID := firstID(msg.Header.Get("Message-ID"))
+
+	// Alice replies to the agent's answer: same thread, same session.
+	imap.deliver(fmt.Sprintf(`From: alice@example.com
+To: agent@example.com
+Subject: Re: Weather
+Message-ID: <m2@example.com>
+In-Reply-To: <%s>
+References: <m1@example.com> <%s>
+Content-Type: text/plain; charset=utf-8
+
+And the day after?
+
+On Mon, Lango wrote:
+> It is **sunny**.
+`, replyID, replyID))
+	waitFor(t, func() bool { return smtp.count() == 2 })
+
+	mu.Lock()
+	second := received[1]
+	mu.Unlock()
+	if second.SessionKey() != first.SessionKey() {
+		t.Errorf("expected same session, got %q and %q", first.SessionKey(), second.SessionKey())
+	}
+	if second.Text != "And the day after?" {
+		t.Errorf("expected quoted text to be stripped, got %q", second.Text)
+	}
+	if second.ReplyToID != replyID {
+		t.Errorf("expected ReplyToID %q, got %q", replyID, second.ReplyToID)
+	}
+
+	msg, _, _ = parts(t, smtp.get(1).data)
+	if got := msg.Header.Get("In-Reply-To"); got != "<m2@example.com>" {
+		t.Errorf("expected In-Reply-To <m2@example.com>, got %q", got)
+	}
+	if got := msg.Header.Get("Subject"); got != "Re: Weather" {
+		t.Errorf("expected subject not to stack prefixes, got %q", got)
+	}
+	if refs := parseIDs(msg.Header.Get("References")); len(refs) != 3 || refs[0] != "m1@example.com" {
+		t.Errorf("unexpected References: %v", refs)
+	}
+}
+
+func TestEmailChannel_Filtering(t *testing.T) {
+	imap := newFakeIMAP(t)
+	smtp := newFakeSMTP(t)
+
+	var mu sync.Mutex
+	var senders []string
+	newTestChannel(t, imap, smtp, func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		mu.Lock()
+		senders = append(senders, msg.UserID)
+		mu.Unlock()
+		return nil, nil
+	}, "alice@example.com", "@example.org")
+
+	mails := []struct{ from, extra string }{
+		{from: "mallory@evil.test"},
+		{from: "alice@example.com", extra: "Auto-Submitted: auto-replied\n"},
+		{from: "list@example.com", extra: "Precedence: bulk\n"},
+		{from: botAddr},
+		{from: "bob@example.org"},
+	}
+	for i, m := range mails {
+		imap.deliver(fmt.Sprintf("From: %s\nSubject: Hi\nMessage-ID: <f%d@test>\n%s\nhello\n", m.from, i, m.extra))
+	}
+	waitFor(t, func() bool { return imap.unseen() == 0 })
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(senders) != 1 || senders[0] != "bob@example.org" {
+		t.Errorf("expected only bob@example.org to be handled, got %v", senders)
+	}
+}
+
+func TestEmailChannel_SendDelivery(t *testing.T) {
+	imap := newFakeIMAP(t)
+	smtp := newFakeSMTP(t)
+	ch := newTestChannel(t, imap, smtp, func(context.Context, *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		return nil, nil
+	}, "@example.org", "alice@example.com")
+	ctx := context.Background()
+
+	if err := ch.Send(ctx, "mallory@evil.test", &channels.OutgoingMessage{Text: "secret"}); err == nil {
+		t.Error("expected delivery to non-allowlisted address to fail")
+	}
+
+	if err := ch.Send(ctx, "", &channels.OutgoingMessage{Text: "## Daily digest\n\n- item"}); err != nil {
+		t.Fatalf("send default: %v", err)
+	}
+	sent := smtp.get(0)
+	if sent.to[0] != "alice@example.com" {
+		t.Errorf("expected default recipient alice@example.com, got %v", sent.to)
+	}
+	msg, _, html := parts(t, sent.data)
+	if got := msg.Header.Get("Subject"); got != "Daily digest" {
+		t.Errorf("expected subject from first line, got %q", got)
+	}
+	if msg.Header.Get("In-Reply-To") != "" {
+		t.Error("expected a new conversation")
+	}
+	if !strings.Contains(html, "<li>item</li>") {
+		t.Errorf("expected rendered list, got %q", html)
+	}
+
+	if err := ch.Send(ctx, "Bob@Example.org", &channels.OutgoingMessage{Text: "hi"}); err != nil {
+		t.Fatalf("send to domain-allowlisted address: %v", err)
+	}
+	if got := smtp.get(1).to[0]; got != "bob@example.org" {
+		t.Errorf("expected bob@example.org, got %s", got)
+	}
+}
+
+func TestNew_RequiresAllowlist(t *testing.T) {
+	_, err := New(Config{Address: botAddr, Source: &MaildirSource{}, Sender: &SMTPSender{}})
+	if err == nil || !strings.Contains(err.Error(), "allowlist") {
+		t.Errorf("expected allowlist error, got %v", err)
+	}
+}
+
+func TestMaildirSource(t *testing.T) {
+	dir := t.TempDir()
+	for _, sub := range []string{"new", "cur", "tmp"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0o700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, body := range []string{"first", "second"} {
+		name := filepath.Join(dir, "new", fmt.Sprintf("%d.M1P1.host", 1700000000+i))
+		if err := os.WriteFile(name, []byte(body), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	src := &MaildirSource{Dir: dir}
+	msgs, err := src.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+	if len(msgs) != 2 || string(msgs[0]) != "first" || string(msgs[1]) != "second" {
+		t.Errorf("unexpected messages: %q", msgs)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "cur", "1700000000.M1P1.host:2,S")); err != nil {
+		t.Errorf("expected message moved to cur with Seen flag: %v", err)
+	}
+
+	msgs, err = src.Fetch(context.Background())
+	if err != nil || len(msgs) != 0 {
+		t.Errorf("expected no new messages, got %d (err %v)", len(msgs), err)
+	}
+}
+
+func TestEmailChannel_EvictThreads(t *testing.T) {
+	c := &Channel{threads: make(map[string]*thread)}
+	start := time.Now()
+	for i := 0; i <= maxThreads; i++ {
+		c.threads[fmt.Sprintf("m%d@example.com", i)] = &thread{active: start.Add(time.Duration(i) * time.Second)}
+	}
+	c.evictThreads()
+
+	if len(c.threads) != maxThreads {
+		t.Fatalf("got %d threads, want %d", len(c.threads), maxThreads)
+	}
+	if _, ok := c.threads["m0@example.com"]; ok {
+		t.Error("least recently active thread must be evicted")
+	}
+	if _, ok := c.threads[fmt.Sprintf("m%d@example.com", maxThreads)]; !ok {
+		t.Error("newest thread must be kept")
+	}
+}
@@ -0,0 +1,167 @@
+package email
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	headingRe     = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	orderedItemRe = regexp.MustCompile(`^\d+[.)]\s+(.*)$`)
+	ruleRe        = regexp.MustCompile(`^(-{3,}|\*{3,}|_{3,})$`)
+)
+
+// RenderHTML converts standard Markdown, as produced by the agent, into an
+// HTML document for the text/html part of a message. It covers the subset
+// the agent uses: headings, paragraphs, lists, block quotes, fenced code,
+// rules and inline code, bold, italic, strikethrough and links.
+func RenderHTML(md string) string {
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html><body style=\"font-family: sans-serif; line-height: 1.5\">\n")
+
+	var para, quote []string
+	list := "" // "ul", "ol" or ""
+
+	flushPara := func() {
+		if len(para) > 0 {
+			b.WriteString("<p>" + strings.Join(para, "<br>\n") + "</p>\n")
+			para = nil
+		}
+	}
+	flushQuote := func() {
+		if len(quote) > 0 {
+			b.WriteString("<blockquote>" + strings.Join(quote, "<br>\n") + "</blockquote>\n")
+			quote = nil
+		}
+	}
+	closeList := func() {
+		if list != "" {
+			b.WriteString("</" + list + ">\n")
+			list = ""
+		}
+	}
+	flush := func() {
+		flushPara()
+		flushQuote()
+		closeList()
+	}
+	openList := func(kind string) {
+		flushPara()
+		flushQuote()
+		if list != kind {
+			closeList()
+			b.WriteString("<" + kind + ">\n")
+			list = kind
+		}
+	}
+
+	lines := strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			flush()
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				code = append(code, lines[i])
+			}
+			b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			flush()
+		case headingRe.MatchString(trimmed):
+			flush()
+			m := headingRe.FindStringSubmatch(trimmed)
+			level := string(rune('0' + len(m[1])))
+			b.WriteString("<h" + level + ">" + renderInline(m[2]) + "</h" + level + ">\n")
+		case ruleRe.MatchString(trimmed):
+			flush()
+			b.WriteString("<hr>\n")
+		case strings.HasPrefix(trimmed, ">"):
+			flushPara()
+			closeList()
+			quote = append(quote, renderInline(strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))))
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* ") || strings.HasPrefix(trimmed, "+ "):
+			openList("ul")
+			b.WriteString("<li>" + renderInline(strings.TrimSpace(trimmed[2:])) + "</li>\n")
+		case orderedItemRe.MatchString(trimmed):
+			openList("ol")
+			b.WriteString("<li>" + renderInline(orderedItemRe.FindStringSubmatch(trimmed)[1]) + "</li>\n")
+		default:
+			flushQuote()
+			closeList()
+			para = append(para, renderInline(trimmed))
+		}
+	}
+	flush()
+
+	b.WriteString("</body></html>\n")
+	return b.String()
+}
+
+// renderInline converts inline Markdown to HTML, escaping everything else.
+func renderInline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		rest := s[i:]
+		switch {
+		case rest[0] == '`':
+			if end := strings.IndexByte(rest[1:], '`'); end >= 0 {
+				b.WriteString("<code>" + html.EscapeString(rest[1:1+end]) + "</code>")
+				i += end + 2
+				continue
+			}
+		case strings.HasPrefix(rest, "**"), strings.HasPrefix(rest, "__"):
+			if end := strings.Index(rest[2:], rest[:2]); end > 0 {
+				b.WriteString("<strong>" + renderInline(rest[2:2+end]) + "</strong>")
+				i += end + 4
+				continue
+			}
+		case strings.HasPrefix(rest, "~~"):
+			if end := strings.Index(rest[2:], "~~"); end > 0 {
+				b.WriteString("<del>" + renderInline(rest[2:2+end]) + "</del>")
+				i += end + 4
+				continue
+			}
+		case rest[0] == '*':
+			if end := strings.IndexByte(rest[1:], '*'); end > 0 {
+				b.WriteString("<em>" + renderInline(rest[1:1+end]) + "</em>")
+				i += end + 2
+				continue
+			}
+		case rest[0] == '[':
+			if text, url, n, ok := parseLink(rest); ok {
+				b.WriteString(`<a href="` + html.EscapeString(url) + `">` + renderInline(text) + "</a>")
+				i += n
+				continue
+			}
+		}
+		b.WriteString(html.EscapeString(rest[:1]))
+		i++
+	}
+	return b.String()
+}
+
+// parseLink parses "[text](url)" at the start of s. Only http, https and
+// mailto links are converted.
+func parseLink(s string) (text, url string, n int, ok bool) {
+	closeText := strings.Index(s, "](")
+	if closeText < 0 {
+		return "", "", 0, false
+	}
+	closeURL := strings.IndexByte(s[closeText+2:], ')')
+	if closeURL < 0 {
+		return "", "", 0, false
+	}
+	text = s[1:closeText]
+	url = s[closeText+2 : closeText+2+closeURL]
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "mailto:") {
+		return "", "", 0, false
+	}
+	return text, url, closeText + 2 + closeURL + 1, true
+}
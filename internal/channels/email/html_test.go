@@ -0,0 +1,35 @@
+package email
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderHTML(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "# Title", want: "<h1>Title</h1>"},
+		{give: "### Small", want: "<h3>Small</h3>"},
+		{give: "**bold** and *em* and ~~gone~~", want: "<p><strong>bold</strong> and <em>em</em> and <del>gone</del></p>"},
+		{give: "line one\nline two", want: "<p>line one<br>\nline two</p>"},
+		{give: "- a\n- b", want: "<ul>\n<li>a</li>\n<li>b</li>\n</ul>"},
+		{give: "1. first\n2. second", want: "<ol>\n<li>first</li>\n<li>second</li>\n</ol>"},
+		{give: "> quoted", want: "<blockquote>quoted</blockquote>"},
+		{give: "```go\nif a < b {}\n```", want: "<pre><code>if a &lt; b {}</code></pre>"},
+		{give: "use `x<y` here", want: "<p>use <code>x&lt;y</code> here</p>"},
+		{give: "[docs](https://example.com/a?b=1&c=2)", want: `<p><a href="https://example.com/a?b=1&amp;c=2">docs</a></p>`},
+		{give: "[bad](javascript:alert(1))", want: "<p>[bad](javascript:alert(1))</p>"},
+		{give: "<script>alert(1)</script>", want: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>"},
+		{give: "---", want: "<hr>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got := RenderHTML(tt.give)
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("RenderHTML(%q) = %q, want it to contain %q", tt.give, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const imapTimeout = 60 * time.Second
+
+// Source delivers incoming mail.
+type Source interface {
+	// Fetch returns the raw RFC 5322 messages that arrived since the last
+	// call. Returned messages are marked as read.
+	Fetch(ctx context.Context) ([][]byte, error)
+}
+
+// IMAPSource polls an IMAP mailbox for unseen messages. It implements the
+// small subset of IMAP4rev1 (RFC 3501) the channel needs and opens a fresh
+// connection for every poll.
+type IMAPSource struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	Mailbox  string
+	Insecure bool // plain TCP instead of implicit TLS
+}
+
+var _ Source = (*IMAPSource)(nil)
+
+// Fetch logs in, downloads all unseen messages and flags them \Seen.
+func (s *IMAPSource) Fetch(ctx context.Context) ([][]byte, error) {
+	c, err := s.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("imap connect: %w", err)
+	}
+	defer c.close()
+
+	if _, err := c.cmd("LOGIN %s %s", imapQuote(s.Username), imapQuote(s.Password)); err != nil {
+		return nil, fmt.Errorf("imap login: %w", err)
+	}
+	mailbox := s.Mailbox
+	if mailbox == "" {
+		mailbox = "INBOX"
+	}
+	if _, err := c.cmd("SELECT %s", imapQuote(mailbox)); err != nil {
+		return nil, fmt.Errorf("imap select %s: %w", mailbox, err)
+	}
+
+	resp, err := c.cmd("UID SEARCH UNSEEN")
+	if err != nil {
+		return nil, fmt.Errorf("imap search: %w", err)
+	}
+	var uids []string
+	for _, r := range resp {
+		if rest, ok := strings.CutPrefix(r.text, "* SEARCH"); ok {
+			uids = append(uids, strings.Fields(rest)...)
+		}
+	}
+
+	var msgs [][]byte
+	for _, uid := range uids {
+		if _, err := strconv.ParseUint(uid, 10, 32); err != nil {
+			return msgs, fmt.Errorf("imap search: invalid uid %q", uid)
+		}
+		resp, err := c.cmd("UID FETCH %s BODY.PEEK[]", uid)
+		if err != nil {
+			return msgs, fmt.Errorf("imap fetch %s: %w", uid, err)
+		}
+		for _, r := range resp {
+			if strings.Contains(r.text, " FETCH ") && len(r.literals) > 0 {
+				msgs = append(msgs, r.literals[0])
+				break
+			}
+		}
+		if _, err := c.cmd(`UID STORE %s +FLAGS.SILENT (\Seen)`, uid); err != nil {
+			return msgs, fmt.Errorf("imap store %s: %w", uid, err)
+		}
+	}
+
+	_, _ = c.cmd("LOGOUT")
+	return msgs, nil
+}
+
+// dial connects to the server and reads its greeting.
+func (s *IMAPSource) dial(ctx context.Context) (*imapConn, error) {
+	port := s.Port
+	if port == 0 {
+		port = 993
+		if s.Insecure {
+			port = 143
+		}
+	}
+	addr := net.JoinHostPort(s.Host, strconv.Itoa(port))
+
+	var conn net.Conn
+	var err error
+	if s.Insecure {
+		var d net.Dialer
+		conn, err = d.DialContext(ctx, "tcp", addr)
+	} else {
+		d := tls.Dialer{Config: &tls.Config{ServerName: s.Host}}
+		conn, err = d.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(imapTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	c := &imapConn{conn: conn, r: bufio.NewReader(conn)}
+	greeting, err := c.readLine()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("read greeting: %w", err)
+	}
+	if !strings.HasPrefix(greeting.text, "* OK") && !strings.HasPrefix(greeting.text, "* PREAUTH") {
+		conn.Close()
+		return nil, fmt.Errorf("unexpected greeting: %s", greeting.text)
+	}
+	return c, nil
+}
+
+// imapLine is one server response line with the literals embedded in it.
+type imapLine struct {
+	text     string
+	literals [][]byte
+}
+
+// imapConn is a minimal IMAP command/response connection.
+type imapConn struct {
+	conn net.Conn
+	r    *bufio.Reader
+	tag  int
+}
+
+// cmd sends a tagged command and collects the untagged responses until the
+// tagged completion. A NO or BAD completion is returned as an error.
+func (c *imapConn) cmd(format string, args ...any) ([]imapLine, error) {
+	c.tag++
+	tag := "L" + strconv.Itoa(c.tag)
+	if _, err := fmt.Fprintf(c.conn, "%s %s\r\n", tag, fmt.Sprintf(format, args...)); err != nil {
+		return nil, err
+	}
+
+	var untagged []imapLine
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return nil, err
+		}
+		status, ok := strings.CutPrefix(line.text, tag+" ")
+		if !ok {
+			untagged = append(untagged, line)
+			continue
+		}
+		if !strings.HasPrefix(status, "OK") {
+			return nil, fmt.Errorf("%s", status)
+		}
+		return untagged, nil
+	}
+}
+
+// readLine reads a response line, following "{n}" literals onto the next
+// physical lines.
+func (c *imapConn) readLine() (imapLine, error) {
+	var line imapLine
+	var text strings.Builder
+	for {
+		s, err := c.r.ReadString('\n')
+		if err != nil {
+			return line, err
+		}
+		s = strings.TrimRight(s, "\r\n")
+		text.WriteString(s)
+
+		n, ok := literalSize(s)
+		if !ok {
+			line.text = text.String()
+			return line, nil
+		}
+		lit := make([]byte, n)
+		if _, err := io.ReadFull(c.r, lit); err != nil {
+			return line, err
+		}
+		line.literals = append(line.literals, lit)
+	}
+}
+
+// close closes the underlying connection.
+func (c *imapConn) close() {
+	c.conn.Close()
+}
+
+// literalSize parses a trailing "{n}" literal marker.
+func literalSize(s string) (int, bool) {
+	if !strings.HasSuffix(s, "}") {
+		return 0, false
+	}
+	idx := strings.LastIndexByte(s, '{')
+	if idx < 0 {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s[idx+1 : len(s)-1])
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
+
+// imapQuote returns s as an IMAP quoted string.
+func imapQuote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", "").Replace(s)
+	return `"` + s + `"`
+}
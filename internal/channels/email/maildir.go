@@ -0,0 +1,51 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MaildirSource reads new messages from a local Maildir, e.g. one filled by
+// fetchmail, mbsync or a local MTA. Delivered messages are moved from new/
+// to cur/ with the Seen flag.
+type MaildirSource struct {
+	Dir string
+}
+
+var _ Source = (*MaildirSource)(nil)
+
+// Fetch returns the messages in new/ in delivery order.
+func (s *MaildirSource) Fetch(_ context.Context) ([][]byte, error) {
+	newDir := filepath.Join(s.Dir, "new")
+	entries, err := os.ReadDir(newDir)
+	if err != nil {
+		return nil, fmt.Errorf("read maildir: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.Type().IsRegular() && !strings.HasPrefix(e.Name(), ".") {
+			names = append(names, e.Name())
+		}
+	}
+	// Maildir names start with the delivery timestamp.
+	sort.Strings(names)
+
+	var msgs [][]byte
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(newDir, name))
+		if err != nil {
+			return msgs, fmt.Errorf("read maildir message %s: %w", name, err)
+		}
+		cur := filepath.Join(s.Dir, "cur", name+":2,S")
+		if err := os.Rename(filepath.Join(newDir, name), cur); err != nil {
+			return msgs, fmt.Errorf("mark maildir message %s seen: %w", name, err)
+		}
+		msgs = append(msgs, data)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,378 @@
+package email
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/langoai/lango/internal/channels"
+)
+
+// maxBodySize caps how much of a message part is read.
+const maxBodySize = 1 << 20
+
+var (
+	msgIDRe     = regexp.MustCompile(`<([^<>\s]+)>`)
+	replyPrefix = regexp.MustCompile(`(?i)^((re|aw|sv|fwd?)(\[\d+\])?:\s*)+`)
+	wordDecoder = &mime.WordDecoder{}
+	tagRe       = regexp.MustCompile(`(?s)<[^>]*>`)
+	breakRe     = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>`)
+)
+
+// inbound is a parsed incoming message.
+type inbound struct {
+	MessageID   string
+	InReplyTo   string
+	References  []string
+	From        string // lower-cased address
+	FromName    string
+	Subject     string
+	Text        string
+	Attachments []channels.Attachment
+	Auto        bool // auto-submitted or bulk mail
+}
+
+// ThreadRoot returns the Message-ID of the first message in the thread.
+func (m *inbound) ThreadRoot() string {
+	if len(m.References) > 0 {
+		return m.References[0]
+	}
+	if m.InReplyTo != "" {
+		return m.InReplyTo
+	}
+	return m.MessageID
+}
+
+// parseMessage parses a raw RFC 5322 message.
+func parseMessage(raw []byte) (*inbound, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return nil, fmt.Errorf("read message: %w", err)
+	}
+	h := msg.Header
+
+	from, err := mail.ParseAddress(h.Get("From"))
+	if err != nil {
+		return nil, fmt.Errorf("parse From: %w", err)
+	}
+
+	m := &inbound{
+		From:       strings.ToLower(from.Address),
+		FromName:   from.Name,
+		MessageID:  firstID(h.Get("Message-ID")),
+		InReplyTo:  firstID(h.Get("In-Reply-To")),
+		References: parseIDs(h.Get("References")),
+		Subject:    decodeHeader(h.Get("Subject")),
+	}
+	if m.MessageID == "" {
+		return nil, fmt.Errorf("message has no Message-ID")
+	}
+
+	auto := strings.ToLower(h.Get("Auto-Submitted"))
+	precedence := strings.ToLower(h.Get("Precedence"))
+	m.Auto = (auto != "" && auto != "no") ||
+		precedence == "bulk" || precedence == "junk" || precedence == "list"
+
+	body := decodeTransfer(h.Get("Content-Transfer-Encoding"), msg.Body)
+	text, html, err := readPart(textproto.MIMEHeader(h), body, &m.Attachments)
+	if err != nil {
+		return nil, err
+	}
+	if text == "" && html != "" {
+		text = htmlToText(html)
+	}
+	m.Text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	return m, nil
+}
+
+// readPart walks a MIME entity and returns its first text/plain and
+// text/html bodies. Attachments are appended to atts.
+func readPart(h textproto.MIMEHeader, body io.Reader, atts *[]channels.Attachment) (text, html string, err error) {
+	mediaType, params, err := mime.ParseMediaType(h.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", nil
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return text, html, fmt.Errorf("read multipart: %w", err)
+			}
+			// multipart.Reader already decodes quoted-printable parts.
+			pt, ph, err := readPart(p.Header, decodeTransfer(p.Header.Get("Content-Transfer-Encoding"), p), atts)
+			if err != nil {
+				return text, html, err
+			}
+			if text == "" {
+				text = pt
+			}
+			if html == "" {
+				html = ph
+			}
+		}
+		return text, html, nil
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(h.Get("Content-Disposition"))
+	name := dparams["filename"]
+	if name == "" {
+		name = params["name"]
+	}
+	if disposition == "attachment" || (name != "" && !strings.HasPrefix(mediaType, "text/")) {
+		size, _ := io.Copy(io.Discard, body)
+		*atts = append(*atts, channels.Attachment{
+			Type:     attachmentType(mediaType),
+			Name:     decodeHeader(name),
+			MimeType: mediaType,
+			Size:     size,
+		})
+		return "", "", nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxBodySize))
+	if err != nil {
+		return "", "", fmt.Errorf("read body: %w", err)
+	}
+	switch mediaType {
+	case "text/plain":
+		return string(data), "", nil
+	case "text/html":
+		return "", string(data), nil
+	}
+	return "", "", nil
+}
+
+// decodeTransfer undoes a Content-Transfer-Encoding.
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, &newlineStripper{r: r})
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	}
+	return r
+}
+
+// newlineStripper drops CR and LF so base64 bodies can be decoded.
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (s *newlineStripper) Read(p []byte) (int, error) {
+	for {
+		n, err := s.r.Read(p)
+		j := 0
+		for _, c := range p[:n] {
+			if c != '\r' && c != '\n' {
+				p[j] = c
+				j++
+			}
+		}
+		if j > 0 || err != nil {
+			return j, err
+		}
+	}
+}
+
+// attachmentType maps a MIME type to an attachment type.
+func attachmentType(mediaType string) channels.AttachmentType {
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		return channels.AttachmentImage
+	case strings.HasPrefix(mediaType, "audio/"):
+		return channels.AttachmentAudio
+	case strings.HasPrefix(mediaType, "video/"):
+		return channels.AttachmentVideo
+	default:
+		return channels.AttachmentDocument
+	}
+}
+
+// htmlToText is a crude fallback for HTML-only mail.
+func htmlToText(s string) string {
+	s = breakRe.ReplaceAllString(s, "\n")
+	s = tagRe.ReplaceAllString(s, "")
+	return strings.TrimSpace(htmlUnescape(s))
+}
+
+// htmlUnescape decodes the entities that survive tag stripping.
+func htmlUnescape(s string) string {
+	return strings.NewReplacer("&nbsp;", " ", "&lt;", "<", "&gt;", ">", "&quot;", `"`, "&#39;", "'", "&amp;", "&").Replace(s)
+}
+
+// decodeHeader decodes RFC 2047 encoded words.
+func decodeHeader(s string) string {
+	if d, err := wordDecoder.DecodeHeader(s); err == nil {
+		return d
+	}
+	return s
+}
+
+// parseIDs returns the message IDs in a References or In-Reply-To header
+// without angle brackets.
+func parseIDs(s string) []string {
+	var ids []string
+	for _, m := range msgIDRe.FindAllStringSubmatch(s, -1) {
+		ids = append(ids, m[1])
+	}
+	return ids
+}
+
+// firstID returns the first message ID in a header.
+func firstID(s string) string {
+	if ids := parseIDs(s); len(ids) > 0 {
+		return ids[0]
+	}
+	return strings.Trim(strings.TrimSpace(s), "<>")
+}
+
+// stripQuoted removes the quoted original that mail clients append to
+// replies, along with its attribution line and the signature.
+func stripQuoted(text string) string {
+	lines := strings.Split(text, "\n")
+	end := len(lines)
+	for i, line := range lines {
+		t := strings.TrimSpace(line)
+		if line == "-- " || strings.HasPrefix(t, "-----Original Message-----") {
+			end = i
+			break
+		}
+		if strings.HasPrefix(t, ">") && onlyQuotesFollow(lines[i:]) {
+			end = i
+			// Drop the "On <date>, <name> wrote:" attribution.
+			for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+				end--
+			}
+			if end > 0 && strings.HasSuffix(strings.TrimSpace(lines[end-1]), "wrote:") {
+				end--
+			}
+			break
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines[:end], "\n"))
+}
+
+// onlyQuotesFollow reports whether all non-blank lines are quoted.
+func onlyQuotesFollow(lines []string) bool {
+	for _, l := range lines {
+		if t := strings.TrimSpace(l); t != "" && !strings.HasPrefix(t, ">") {
+			return false
+		}
+	}
+	return true
+}
+
+// replySubject returns "Re: <subject>" without stacking prefixes.
+func replySubject(subject string) string {
+	subject = strings.TrimSpace(replyPrefix.ReplaceAllString(subject, ""))
+	if subject == "" {
+		return "Re: your message"
+	}
+	return "Re: " + subject
+}
+
+// deliverySubject derives a subject from the first line of a message.
+func deliverySubject(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#*_ "))
+		line = strings.TrimRight(line, "*_ ")
+		if line == "" {
+			continue
+		}
+		if r := []rune(line); len(r) > 78 {
+			line = string(r[:77]) + "…"
+		}
+		return line
+	}
+	return "Message from Lango"
+}
+
+// outbound is a message to be composed and sent.
+type outbound struct {
+	From       string
+	To         string
+	Subject    string
+	MessageID  string
+	InReplyTo  string
+	References []string
+	Text       string // Markdown
+}
+
+// newMessageID returns a unique Message-ID in the domain of from.
+func newMessageID(from string) string {
+	domain := "lango.local"
+	if idx := strings.LastIndexByte(from, '@'); idx >= 0 {
+		domain = from[idx+1:]
+	}
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return fmt.Sprintf("lango.%d.%s@%s", time.Now().UnixNano(), hex.EncodeToString(b[:]), domain)
+}
+
+// compose renders m as a multipart/alternative message with the Markdown
+// source as text/plain and its HTML rendering as text/html.
+func compose(m *outbound) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	header := func(k, v string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", k, v)
+	}
+	header("From", (&mail.Address{Name: "Lango", Address: m.From}).String())
+	header("To", (&mail.Address{Address: m.To}).String())
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", "<"+m.MessageID+">")
+	if m.InReplyTo != "" {
+		header("In-Reply-To", "<"+m.InReplyTo+">")
+		header("Auto-Submitted", "auto-replied")
+	} else {
+		header("Auto-Submitted", "auto-generated")
+	}
+	if len(m.References) > 0 {
+		header("References", "<"+strings.Join(m.References, "> <")+">")
+	}
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	buf.WriteString("\r\n")
+
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", RenderHTML(m.Text)},
+	} {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qw := quotedprintable.NewWriter(w)
+		if _, err := io.WriteString(qw, part.body); err != nil {
+			return nil, err
+		}
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,106 @@
+package email
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/langoai/lango/internal/channels"
+)
+
+func TestParseMessage_Multipart(t *testing.T) {
+	raw := strings.ReplaceAll(`From: =?utf-8?q?J=C3=BCrgen?= <Juergen@Example.com>
+Subject: =?utf-8?q?Gr=C3=BC=C3=9Fe?=
+Message-ID: <m3@example.com>
+In-Reply-To: <m2@example.com>
+References: <m1@example.com>
+ <m2@example.com>
+MIME-Version: 1.0
+Content-Type: multipart/mixed; boundary="outer"
+
+--outer
+Content-Type: multipart/alternative; boundary="inner"
+
+--inner
+Content-Type: text/plain; charset=utf-8
+Content-Transfer-Encoding: quoted-printable
+
+Sch=C3=B6ne Gr=C3=BC=C3=9Fe
+--inner
+Content-Type: text/html; charset=utf-8
+
+<p>Sch&ouml;ne Gr&uuml;&szlig;e</p>
+--inner--
+--outer
+Content-Type: image/png; name="chart.png"
+Content-Disposition: attachment; filename="chart.png"
+Content-Transfer-Encoding: base64
+
+iVBORw0KGgo=
+--outer--
+`, "\n", "\r\n")
+
+	m, err := parseMessage([]byte(raw))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if m.From != "juergen@example.com" || m.FromName != "Jürgen" {
+		t.Errorf("unexpected sender: %q %q", m.From, m.FromName)
+	}
+	if m.Subject != "Grüße" {
+		t.Errorf("unexpected subject: %q", m.Subject)
+	}
+	if m.Text != "Schöne Grüße" {
+		t.Errorf("unexpected text: %q", m.Text)
+	}
+	if m.ThreadRoot() != "m1@example.com" || m.InReplyTo != "m2@example.com" {
+		t.Errorf("unexpected threading: root %q, in-reply-to %q", m.ThreadRoot(), m.InReplyTo)
+	}
+	want := channels.Attachment{Type: channels.AttachmentImage, Name: "chart.png", MimeType: "image/png", Size: 8}
+	if len(m.Attachments) != 1 || m.Attachments[0] != want {
+		t.Errorf("unexpected attachments: %+v", m.Attachments)
+	}
+}
+
+func TestParseMessage_HTMLOnly(t *testing.T) {
+	raw := "From: a@example.com\r\nMessage-ID: <h@x>\r\nContent-Type: text/html\r\n\r\n<p>Hello<br>world &amp; more</p>"
+	m, err := parseMessage([]byte(raw))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if m.Text != "Hello\nworld & more" {
+		t.Errorf("unexpected text: %q", m.Text)
+	}
+	if m.ThreadRoot() != "h@x" {
+		t.Errorf("expected a new thread rooted at the message, got %q", m.ThreadRoot())
+	}
+}
+
+func TestStripQuoted(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "Just text", want: "Just text"},
+		{give: "Yes please\n\nOn Tue, 1 Oct 2026, Lango <a@b> wrote:\n> Shall I?\n>\n> More", want: "Yes please"},
+		{give: "> quoted\ninline answer\n> quoted again", want: "> quoted\ninline answer"},
+		{give: "Thanks\n-- \nAlice\nACME Corp", want: "Thanks"},
+		{give: "Done\n\n-----Original Message-----\nFrom: x", want: "Done"},
+	}
+	for _, tt := range tests {
+		if got := stripQuoted(tt.give); got != tt.want {
+			t.Errorf("stripQuoted(%q) = %q, want %q", tt.give, got, tt.want)
+		}
+	}
+}
+
+func TestSubjects(t *testing.T) {
+	if got := replySubject("RE: Re: Fwd: Plan"); got != "Re: Plan" {
+		t.Errorf("replySubject = %q", got)
+	}
+	if got := deliverySubject("\n## **Weekly report**\n\nbody"); got != "Weekly report" {
+		t.Errorf("deliverySubject = %q", got)
+	}
+	if got := deliverySubject(strings.Repeat("x", 100)); len([]rune(got)) != 78 {
+		t.Errorf("expected subject truncated to 78 runes, got %d", len([]rune(got)))
+	}
+}
@@ -0,0 +1,79 @@
+package email
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+func init() {
+	channels.Register(types.ChannelEmail, fromConfig)
+}
+
+// fromConfig creates the email channel from the application config.
+func fromConfig(cfg *config.Config) (channels.Channel, error) {
+	em := cfg.Channels.Email
+	if !em.Enabled {
+		return nil, channels.ErrDisabled
+	}
+
+	var source Source
+	switch {
+	case em.Maildir != "":
+		source = &MaildirSource{Dir: expandHome(em.Maildir)}
+	case em.IMAP.Host != "":
+		username := em.IMAP.Username
+		if username == "" {
+			username = em.Address
+		}
+		source = &IMAPSource{
+			Host:     em.IMAP.Host,
+			Port:     em.IMAP.Port,
+			Username: username,
+			Password: em.IMAP.Password,
+			Mailbox:  em.IMAP.Mailbox,
+			Insecure: em.IMAP.Insecure,
+		}
+	}
+
+	var sender Sender
+	if em.SMTP.Host != "" {
+		// SMTP credentials default to the IMAP login, which most providers share.
+		username, password := em.SMTP.Username, em.SMTP.Password
+		if username == "" && password == "" && em.IMAP.Password != "" {
+			username, password = em.IMAP.Username, em.IMAP.Password
+			if username == "" {
+				username = em.Address
+			}
+		}
+		sender = &SMTPSender{
+			Host:     em.SMTP.Host,
+			Port:     em.SMTP.Port,
+			Username: username,
+			Password: password,
+		}
+	}
+
+	return New(Config{
+		Address:            em.Address,
+		Allowlist:          em.Allowlist,
+		PollInterval:       em.PollInterval,
+		ApprovalTimeoutSec: cfg.Security.Interceptor.ApprovalTimeoutSec,
+		Source:             source,
+		Sender:             sender,
+	})
+}
+
+// expandHome expands a leading "~/" to the user's home directory.
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
@@ -0,0 +1,177 @@
+package matrix
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/approval"
+)
+
+// Reaction keys used as approval buttons.
+const (
+	reactionApprove = "✅"
+	reactionDeny    = "❌"
+	reactionAlways  = "🔓"
+)
+
+// approvalPending holds the response channel and message metadata for a pending approval.
+type approvalPending struct {
+	ch        chan approval.ApprovalResponse
+	roomID    string
+	requester string // user ID whose session asked for approval
+}
+
+// ApprovalProvider implements approval.Provider for Matrix using reactions.
+// The bot pre-reacts to its approval message so users can answer with a
+// single click on ✅, ❌ or 🔓. Only the user whose session requested the
+// approval can answer it.
+type ApprovalProvider struct {
+	client  Client
+	pending sync.Map // map[eventID]*approvalPending
+	timeout time.Duration
+}
+
+var _ approval.Provider = (*ApprovalProvider)(nil)
+
+// NewApprovalProvider creates a Matrix approval provider.
+func NewApprovalProvider(client Client, timeout time.Duration) *ApprovalProvider {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &ApprovalProvider{
+		client:  client,
+		timeout: timeout,
+	}
+}
+
+// RequestApproval posts the approval message to the room and waits for a reaction.
+func (p *ApprovalProvider) RequestApproval(ctx context.Context, req approval.ApprovalRequest) (approval.ApprovalResponse, error) {
+	roomID, userID, err := parseMatrixSession(req.SessionKey)
+	if err != nil {
+		return approval.ApprovalResponse{}, fmt.Errorf("parse session key: %w", err)
+	}
+
+	text := fmt.Sprintf("🔐 Tool '%s' requires approval", req.ToolName)
+	if req.Summary != "" {
+		text += "\n\n" + req.Summary
+	}
+	text += fmt.Sprintf("\n\nReact %s to approve, %s to deny or %s to always allow.",
+		reactionApprove, reactionDeny, reactionAlways)
+
+	eventID, err := p.client.SendEvent(ctx, roomID, eventMessage, MessageContent{MsgType: "m.text", Body: text})
+	if err != nil {
+		return approval.ApprovalResponse{}, fmt.Errorf("send approval message: %w", err)
+	}
+
+	respChan := make(chan approval.ApprovalResponse, 1)
+	p.pending.Store(eventID, &approvalPending{ch: respChan, roomID: roomID, requester: userID})
+	defer p.pending.Delete(eventID)
+
+	// Seed the reactions so they render as clickable buttons.
+	for _, key := range []string{reactionApprove, reactionDeny, reactionAlways} {
+		content := ReactionContent{RelatesTo: RelatesTo{RelType: relAnnotation, EventID: eventID, Key: key}}
+		if _, err := p.client.SendEvent(ctx, roomID, eventReaction, content); err != nil {
+			logger.Warnw("seed approval reaction error", "key", key, "error", err)
+		}
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp, nil
+	case <-ctx.Done():
+		p.editApprovalMessage(roomID, eventID, "🔐 Tool approval — ⏱ Expired")
+		return approval.ApprovalResponse{}, ctx.Err()
+	case <-time.After(p.timeout):
+		p.editApprovalMessage(roomID, eventID, "🔐 Tool approval — ⏱ Expired")
+		return approval.ApprovalResponse{}, fmt.Errorf("approval timeout")
+	}
+}
+
+// HandleReaction processes a reaction by sender to an approval message. It
+// returns false when the reaction does not target a pending approval.
+// Reactions from anyone but the requester are consumed and ignored.
+func (p *ApprovalProvider) HandleReaction(sender string, rel RelatesTo) bool {
+	if rel.RelType != relAnnotation {
+		return false
+	}
+	val, ok := p.pending.Load(rel.EventID)
+	if !ok {
+		return false
+	}
+	if val.(*approvalPending).requester != sender {
+		logger.Debugw("ignoring approval reaction from another user", "eventId", rel.EventID, "sender", sender)
+		return true
+	}
+
+	var resp approval.ApprovalResponse
+	switch rel.Key {
+	case reactionApprove:
+		resp = approval.ApprovalResponse{Approved: true}
+	case reactionDeny:
+	case reactionAlways:
+		resp = approval.ApprovalResponse{Approved: true, AlwaysAllow: true}
+	default:
+		return true // unrelated reaction on an approval message
+	}
+
+	// LoadAndDelete to prevent duplicate reactions (TOCTOU)
+	val, ok = p.pending.LoadAndDelete(rel.EventID)
+	if !ok {
+		return true
+	}
+	pending := val.(*approvalPending)
+
+	// Unblock the waiting agent before editing the message.
+	select {
+	case pending.ch <- resp:
+	default:
+	}
+
+	var status string
+	switch {
+	case resp.AlwaysAllow:
+		status = "🔓 Always Allowed"
+	case resp.Approved:
+		status = "✅ Approved"
+	default:
+		status = "❌ Denied"
+	}
+	p.editApprovalMessage(pending.roomID, rel.EventID, fmt.Sprintf("🔐 Tool approval — %s", status))
+	return true
+}
+
+// CanHandle returns true for session keys starting with "matrix:".
+func (p *ApprovalProvider) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, "matrix:")
+}
+
+// editApprovalMessage replaces the approval message text with a status line.
+func (p *ApprovalProvider) editApprovalMessage(roomID, eventID, text string) {
+	content := MessageContent{
+		MsgType:    "m.text",
+		Body:       "* " + text,
+		NewContent: &MessageContent{MsgType: "m.text", Body: text},
+		RelatesTo:  &RelatesTo{RelType: relReplace, EventID: eventID},
+	}
+	if _, err := p.client.SendEvent(context.Background(), roomID, eventMessage, content); err != nil {
+		logger.Warnw("edit approval message error", "error", err)
+	}
+}
+
+// parseMatrixSession extracts the room and user IDs from a session key like
+// "matrix:<roomID>:<userID>". Both IDs contain colons, so the user ID is
+// located by its leading "@".
+func parseMatrixSession(sessionKey string) (roomID, userID string, err error) {
+	rest, ok := strings.CutPrefix(sessionKey, "matrix:")
+	if !ok {
+		return "", "", fmt.Errorf("invalid matrix session key: %s", sessionKey)
+	}
+	idx := strings.LastIndex(rest, ":@")
+	if idx <= 0 {
+		return "", "", fmt.Errorf("invalid matrix session key: %s", sessionKey)
+	}
+	return rest[:idx], rest[idx+1:], nil
+}
@@ -0,0 +1,193 @@
+package matrix
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/approval"
+)
+
+// fakeClient records sent events in memory.
+type fakeClient struct {
+	mu   sync.Mutex
+	sent []sentEvent
+	raw  []any
+}
+
+var _ Client = (*fakeClient)(nil)
+
+func (f *fakeClient) Whoami(context.Context) (string, error) { return botID, nil }
+
+func (f *fakeClient) Sync(context.Context, string, time.Duration) (*SyncResponse, error) {
+	return &SyncResponse{}, nil
+}
+
+func (f *fakeClient) SendEvent(_ context.Context, roomID, eventType string, content any) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sent = append(f.sent, sentEvent{RoomID: roomID, Type: eventType})
+	f.raw = append(f.raw, content)
+	return fmt.Sprintf("$ev%d", len(f.sent)), nil
+}
+
+func (f *fakeClient) SetTyping(context.Context, string, string, bool, time.Duration) error {
+	return nil
+}
+
+func (f *fakeClient) JoinRoom(context.Context, string) error { return nil }
+
+func (f *fakeClient) ResolveAlias(_ context.Context, alias string) (string, error) {
+	return "!resolved:example.org", nil
+}
+
+func (f *fakeClient) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.sent)
+}
+
+func TestApprovalProvider_Reactions(t *testing.T) {
+	tests := []struct {
+		give     string
+		want     approval.ApprovalResponse
+		wantEdit string
+	}{
+		{give: reactionApprove, want: approval.ApprovalResponse{Approved: true}, wantEdit: "🔐 Tool approval — ✅ Approved"},
+		{give: reactionDeny, want: approval.ApprovalResponse{}, wantEdit: "🔐 Tool approval — ❌ Denied"},
+		{give: reactionAlways, want: approval.ApprovalResponse{Approved: true, AlwaysAllow: true}, wantEdit: "🔐 Tool approval — 🔓 Always Allowed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			client := &fakeClient{}
+			p := NewApprovalProvider(client, 5*time.Second)
+
+			type result struct {
+				resp approval.ApprovalResponse
+				err  error
+			}
+			done := make(chan result, 1)
+			go func() {
+				resp, err := p.RequestApproval(context.Background(), approval.ApprovalRequest{
+					ID:         "req-1",
+					ToolName:   "exec",
+					SessionKey: "matrix:" + dmRoom + ":" + aliceID,
+				})
+				done <- result{resp, err}
+			}()
+
+			// Approval message plus three seeded reactions.
+			waitFor(t, func() bool { return client.count() == 4 })
+
+			// Unrelated reactions are ignored.
+			if p.HandleReaction(aliceID, RelatesTo{RelType: relAnnotation, EventID: "$other", Key: tt.give}) {
+				t.Error("reaction to another event must not be handled")
+			}
+			if !p.HandleReaction(aliceID, RelatesTo{RelType: relAnnotation, EventID: "$ev1", Key: "👍"}) {
+				t.Error("reaction to a pending approval must be consumed")
+			}
+
+			if !p.HandleReaction(bobID, RelatesTo{RelType: relAnnotation, EventID: "$ev1", Key: tt.give}) {
+				t.Error("reaction from another user must be consumed")
+			}
+			if _, ok := p.pending.Load("$ev1"); !ok {
+				t.Fatal("reaction from another user must not resolve the approval")
+			}
+
+			if !p.HandleReaction(aliceID, RelatesTo{RelType: relAnnotation, EventID: "$ev1", Key: tt.give}) {
+				t.Fatal("expected reaction to be handled")
+			}
+
+			select {
+			case r := <-done:
+				if r.err != nil {
+					t.Fatalf("unexpected error: %v", r.err)
+				}
+				if r.resp != tt.want {
+					t.Errorf("got %+v, want %+v", r.resp, tt.want)
+				}
+			case <-time.After(2 * time.Second):
+				t.Fatal("timeout waiting for approval")
+			}
+
+			client.mu.Lock()
+			defer client.mu.Unlock()
+			if client.sent[0].RoomID != dmRoom {
+				t.Errorf("approval sent to %s", client.sent[0].RoomID)
+			}
+			for i := 1; i <= 3; i++ {
+				if client.sent[i].Type != eventReaction {
+					t.Errorf("event %d: expected seeded reaction, got %s", i, client.sent[i].Type)
+				}
+			}
+			edit, ok := client.raw[len(client.raw)-1].(MessageContent)
+			if !ok || edit.NewContent == nil || edit.NewContent.Body != tt.wantEdit {
+				t.Errorf("expected edit %q, got %+v", tt.wantEdit, client.raw[len(client.raw)-1])
+			}
+			if edit.RelatesTo == nil || edit.RelatesTo.RelType != relReplace || edit.RelatesTo.EventID != "$ev1" {
+				t.Errorf("expected m.replace of $ev1, got %+v", edit.RelatesTo)
+			}
+		})
+	}
+}
+
+func TestApprovalProvider_Timeout(t *testing.T) {
+	client := &fakeClient{}
+	p := NewApprovalProvider(client, 20*time.Millisecond)
+
+	_, err := p.RequestApproval(context.Background(), approval.ApprovalRequest{
+		ID:         "req-1",
+		ToolName:   "exec",
+		SessionKey: "matrix:" + dmRoom + ":" + aliceID,
+	})
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+	if p.HandleReaction(aliceID, RelatesTo{RelType: relAnnotation, EventID: "$ev1", Key: reactionApprove}) {
+		t.Error("expired approval must not be handled")
+	}
+}
+
+func TestApprovalProvider_CanHandle(t *testing.T) {
+	p := NewApprovalProvider(&fakeClient{}, 0)
+	if !p.CanHandle("matrix:!room:example.org:@alice:example.org") {
+		t.Error("expected matrix session key to be handled")
+	}
+	if p.CanHandle("slack:C1:U1") {
+		t.Error("expected slack session key to be rejected")
+	}
+}
+
+func TestParseMatrixSession(t *testing.T) {
+	tests := []struct {
+		give     string
+		wantRoom string
+		wantUser string
+		wantErr  bool
+	}{
+		{give: "matrix:!room:example.org:@alice:example.org", wantRoom: "!room:example.org", wantUser: "@alice:example.org"},
+		{give: "matrix:!room:example.org:8448:@alice:other.org", wantRoom: "!room:example.org:8448", wantUser: "@alice:other.org"},
+		{give: "matrix:!room:example.org", wantErr: true},
+		{give: "telegram:1:2", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			room, user, err := parseMatrixSession(tt.give)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %q, %q", room, user)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if room != tt.wantRoom || user != tt.wantUser {
+				t.Errorf("got %q, %q, want %q, %q", room, user, tt.wantRoom, tt.wantUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Client defines the Matrix client-server API operations used by the channel.
+type Client interface {
+	Whoami(ctx context.Context) (string, error)
+	Sync(ctx context.Context, since string, timeout time.Duration) (*SyncResponse, error)
+	SendEvent(ctx context.Context, roomID, eventType string, content any) (string, error)
+	SetTyping(ctx context.Context, roomID, userID string, typing bool, timeout time.Duration) error
+	JoinRoom(ctx context.Context, roomID string) error
+	ResolveAlias(ctx context.Context, alias string) (string, error)
+}
+
+// SyncResponse is the subset of a /sync response the channel consumes.
+type SyncResponse struct {
+	NextBatch string `json:"next_batch"`
+	Rooms     struct {
+		Join   map[string]JoinedRoom  `json:"join"`
+		Invite map[string]InvitedRoom `json:"invite"`
+	} `json:"rooms"`
+}
+
+// JoinedRoom holds the updates for a room the user has joined.
+type JoinedRoom struct {
+	Summary struct {
+		JoinedMemberCount *int `json:"m.joined_member_count,omitempty"`
+	} `json:"summary"`
+	State struct {
+		Events []Event `json:"events"`
+	} `json:"state"`
+	Timeline struct {
+		Events []Event `json:"events"`
+	} `json:"timeline"`
+}
+
+// InvitedRoom holds the stripped state of a room the user is invited to.
+type InvitedRoom struct {
+	InviteState struct {
+		Events []Event `json:"events"`
+	} `json:"invite_state"`
+}
+
+// Event is a Matrix room event.
+type Event struct {
+	Type     string          `json:"type"`
+	EventID  string          `json:"event_id,omitempty"`
+	Sender   string          `json:"sender"`
+	StateKey *string         `json:"state_key,omitempty"`
+	Content  json.RawMessage `json:"content"`
+}
+
+// Event types used by the channel.
+const (
+	eventMessage    = "m.room.message"
+	eventReaction   = "m.reaction"
+	eventMember     = "m.room.member"
+	eventEncryption = "m.room.encryption"
+	eventEncrypted  = "m.room.encrypted"
+)
+
+// MessageContent is the content of an m.room.message event.
+type MessageContent struct {
+	MsgType    string          `json:"msgtype"`
+	Body       string          `json:"body"`
+	URL        string          `json:"url,omitempty"`
+	Info       *FileInfo       `json:"info,omitempty"`
+	RelatesTo  *RelatesTo      `json:"m.relates_to,omitempty"`
+	NewContent *MessageContent `json:"m.new_content,omitempty"`
+	Mentions   *Mentions       `json:"m.mentions,omitempty"`
+}
+
+// FileInfo describes the file attached to a media message.
+type FileInfo struct {
+	MimeType string `json:"mimetype,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+}
+
+// Mentions lists the users a message intentionally mentions.
+type Mentions struct {
+	UserIDs []string `json:"user_ids,omitempty"`
+}
+
+// RelatesTo is the m.relates_to block of replies, threads, edits and reactions.
+type RelatesTo struct {
+	RelType       string     `json:"rel_type,omitempty"`
+	EventID       string     `json:"event_id,omitempty"`
+	Key           string     `json:"key,omitempty"`
+	InReplyTo     *InReplyTo `json:"m.in_reply_to,omitempty"`
+	IsFallingBack bool       `json:"is_falling_back,omitempty"`
+}
+
+// InReplyTo references the event a message replies to.
+type InReplyTo struct {
+	EventID string `json:"event_id"`
+}
+
+// Relation types.
+const (
+	relThread     = "m.thread"
+	relAnnotation = "m.annotation"
+	relReplace    = "m.replace"
+)
+
+// ReactionContent is the content of an m.reaction event.
+type ReactionContent struct {
+	RelatesTo RelatesTo `json:"m.relates_to"`
+}
+
+// memberContent is the content of an m.room.member event.
+type memberContent struct {
+	Membership string `json:"membership"`
+}
+
+// HTTPClient implements Client over the Matrix client-server HTTP API.
+type HTTPClient struct {
+	baseURL string
+	token   string
+	http    *http.Client
+	txn     atomic.Uint64
+}
+
+var _ Client = (*HTTPClient)(nil)
+
+// NewHTTPClient creates a client for the homeserver at baseURL.
+func NewHTTPClient(baseURL, accessToken string, httpClient *http.Client) *HTTPClient {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &HTTPClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   accessToken,
+		http:    httpClient,
+	}
+}
+
+// Whoami returns the user ID of the access token owner.
+func (c *HTTPClient) Whoami(ctx context.Context) (string, error) {
+	var resp struct {
+		UserID string `json:"user_id"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/account/whoami", nil, nil, &resp); err != nil {
+		return "", fmt.Errorf("whoami: %w", err)
+	}
+	return resp.UserID, nil
+}
+
+// Sync long-polls the homeserver for events since the given batch token.
+func (c *HTTPClient) Sync(ctx context.Context, since string, timeout time.Duration) (*SyncResponse, error) {
+	q := url.Values{}
+	q.Set("timeout", strconv.FormatInt(timeout.Milliseconds(), 10))
+	if since != "" {
+		q.Set("since", since)
+	}
+	var resp SyncResponse
+	if err := c.do(ctx, http.MethodGet, "/sync", q, nil, &resp); err != nil {
+		return nil, fmt.Errorf("sync: %w", err)
+	}
+	return &resp, nil
+}
+
+// SendEvent sends a room event and returns its event ID.
+func (c *HTTPClient) SendEvent(ctx context.Context, roomID, eventType string, content any) (string, error) {
+	txnID := fmt.Sprintf("lango-%d-%d", time.Now().UnixNano(), c.txn.Add(1))
+	path := fmt.Sprintf("/rooms/%s/send/%s/%s",
+		url.PathEscape(roomID), url.PathEscape(eventType), url.PathEscape(txnID))
+	var resp struct {
+		EventID string `json:"event_id"`
+	}
+	if err := c.do(ctx, http.MethodPut, path, nil, content, &resp); err != nil {
+		return "", fmt.Errorf("send %s: %w", eventType, err)
+	}
+	return resp.EventID, nil
+}
+
+// SetTyping starts or stops the typing notification of userID in a room.
+func (c *HTTPClient) SetTyping(ctx context.Context, roomID, userID string, typing bool, timeout time.Duration) error {
+	path := fmt.Sprintf("/rooms/%s/typing/%s", url.PathEscape(roomID), url.PathEscape(userID))
+	body := map[string]any{"typing": typing}
+	if typing {
+		body["timeout"] = timeout.Milliseconds()
+	}
+	if err := c.do(ctx, http.MethodPut, path, nil, body, nil); err != nil {
+		return fmt.Errorf("set typing: %w", err)
+	}
+	return nil
+}
+
+// JoinRoom joins a room the user was invited to.
+func (c *HTTPClient) JoinRoom(ctx context.Context, roomID string) error {
+	path := "/join/" + url.PathEscape(roomID)
+	if err := c.do(ctx, http.MethodPost, path, nil, struct{}{}, nil); err != nil {
+		return fmt.Errorf("join room: %w", err)
+	}
+	return nil
+}
+
+// ResolveAlias resolves a room alias (#room:server) to a room ID.
+func (c *HTTPClient) ResolveAlias(ctx context.Context, alias string) (string, error) {
+	var resp struct {
+		RoomID string `json:"room_id"`
+	}
+	path := "/directory/room/" + url.PathEscape(alias)
+	if err := c.do(ctx, http.MethodGet, path, nil, nil, &resp); err != nil {
+		return "", fmt.Errorf("resolve alias %s: %w", alias, err)
+	}
+	return resp.RoomID, nil
+}
+
+// do performs an authenticated client-server API request.
+func (c *HTTPClient) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	u := c.baseURL + "/_matrix/client/v3" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			ErrCode string `json:"errcode"`
+			Error   string `json:"error"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.ErrCode != "" {
+			return fmt.Errorf("%s %s: %s: %s", method, path, apiErr.ErrCode, apiErr.Error)
+		}
+		return fmt.Errorf("%s %s: status %d", method, path, resp.StatusCode)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
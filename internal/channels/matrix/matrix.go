@@ -0,0 +1,556 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/types"
+)
+
+var logger = logging.SubsystemSugar("channel.matrix")
+
+const (
+	defaultSyncTimeout = 30 * time.Second
+	syncRetryDelay     = 5 * time.Second
+	typingTimeout      = 30 * time.Second
+	typingRefresh      = 20 * time.Second
+)
+
+// Config holds Matrix channel configuration
+type Config struct {
+	HomeserverURL      string
+	AccessToken        string
+	Allowlist          []string      // allowed user IDs (@user:server) or room IDs (!room:server); empty = all
+	ApprovalTimeoutSec int           // 0 = default 30s
+	SyncTimeout        time.Duration // long-poll timeout; 0 = 30s
+	HTTPClient         *http.Client  // optional, for testing
+	Client             Client        // optional, for testing
+}
+
+// roomState tracks what the channel knows about a joined room.
+type roomState struct {
+	members   int
+	encrypted bool
+	warned    bool
+}
+
+// Channel implements a Matrix bot over the client-server API.
+type Channel struct {
+	config   Config
+	client   Client
+	handler  channels.Handler
+	approval *ApprovalProvider
+	userID   string
+
+	mu    sync.Mutex
+	rooms map[string]*roomState
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ channels.Channel = (*Channel)(nil)
+
+// New creates a new Matrix channel
+func New(cfg Config) (*Channel, error) {
+	if cfg.AccessToken == "" {
+		return nil, fmt.Errorf("access token is required")
+	}
+	if cfg.HomeserverURL == "" && cfg.Client == nil {
+		return nil, fmt.Errorf("homeserver URL is required")
+	}
+	if cfg.SyncTimeout <= 0 {
+		cfg.SyncTimeout = defaultSyncTimeout
+	}
+
+	client := cfg.Client
+	if client == nil {
+		client = NewHTTPClient(cfg.HomeserverURL, cfg.AccessToken, cfg.HTTPClient)
+	}
+
+	return &Channel{
+		config:   cfg,
+		client:   client,
+		approval: NewApprovalProvider(client, time.Duration(cfg.ApprovalTimeoutSec)*time.Second),
+		rooms:    make(map[string]*roomState),
+	}, nil
+}
+
+// Type returns types.ChannelMatrix.
+func (c *Channel) Type() types.ChannelType {
+	return types.ChannelMatrix
+}
+
+// Capabilities reports the features supported by Matrix.
+func (c *Channel) Capabilities() channels.Capabilities {
+	return channels.Capabilities{
+		Threads:     true,
+		Attachments: true,
+		Buttons:     true,
+	}
+}
+
+// SetHandler sets the message handler
+func (c *Channel) SetHandler(handler channels.Handler) {
+	c.handler = handler
+}
+
+// ApprovalProvider returns the channel's approval provider for composite registration.
+func (c *Channel) ApprovalProvider() approval.Provider {
+	return c.approval
+}
+
+// Start identifies the bot user, performs an initial sync to learn room state
+// and then long-polls the homeserver for new events.
+func (c *Channel) Start(ctx context.Context) error {
+	if c.handler == nil {
+		return fmt.Errorf("message handler not set")
+	}
+
+	userID, err := c.client.Whoami(ctx)
+	if err != nil {
+		return err
+	}
+	c.userID = userID
+
+	// The initial sync only records room state; older messages are not replayed.
+	initial, err := c.client.Sync(ctx, "", 0)
+	if err != nil {
+		return err
+	}
+	c.processSync(ctx, initial, true)
+
+	ctx, c.cancel = context.WithCancel(ctx)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.syncLoop(ctx, initial.NextBatch)
+	}()
+
+	logger.Infow("matrix channel started", "userId", c.userID)
+	return nil
+}
+
+// syncLoop long-polls /sync until ctx is cancelled.
+func (c *Channel) syncLoop(ctx context.Context, since string) {
+	for ctx.Err() == nil {
+		resp, err := c.client.Sync(ctx, since, c.config.SyncTimeout)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Warnw("sync error, retrying", "error", err, "retryIn", syncRetryDelay.String())
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(syncRetryDelay):
+			}
+			continue
+		}
+		since = resp.NextBatch
+		c.processSync(ctx, resp, false)
+	}
+}
+
+// processSync applies a sync response. During the initial sync only state is
+// applied and timeline messages are skipped.
+func (c *Channel) processSync(ctx context.Context, resp *SyncResponse, initial bool) {
+	for roomID, room := range resp.Rooms.Invite {
+		c.handleInvite(ctx, roomID, room)
+	}
+
+	for roomID, room := range resp.Rooms.Join {
+		if n := room.Summary.JoinedMemberCount; n != nil {
+			c.mu.Lock()
+			c.room(roomID).members = *n
+			c.mu.Unlock()
+		}
+		for _, ev := range room.State.Events {
+			c.applyState(roomID, ev)
+		}
+		for _, ev := range room.Timeline.Events {
+			if ev.StateKey != nil {
+				c.applyState(roomID, ev)
+				continue
+			}
+			if !initial {
+				c.handleEvent(ctx, roomID, ev)
+			}
+		}
+	}
+}
+
+// room returns the state of a room, creating it if needed. c.mu must be held.
+func (c *Channel) room(roomID string) *roomState {
+	r, ok := c.rooms[roomID]
+	if !ok {
+		r = &roomState{}
+		c.rooms[roomID] = r
+	}
+	return r
+}
+
+// applyState records room state that affects message handling.
+func (c *Channel) applyState(roomID string, ev Event) {
+	if ev.Type == eventEncryption {
+		c.mu.Lock()
+		c.room(roomID).encrypted = true
+		c.mu.Unlock()
+	}
+}
+
+// handleInvite joins rooms that an allowed user invited the bot to.
+func (c *Channel) handleInvite(ctx context.Context, roomID string, room InvitedRoom) {
+	var inviter string
+	for _, ev := range room.InviteState.Events {
+		if ev.Type == eventMember && ev.StateKey != nil && *ev.StateKey == c.userID {
+			inviter = ev.Sender
+		}
+	}
+	if !c.isAllowed(roomID, inviter) {
+		logger.Warnw("ignoring invite from non-allowed user", "roomId", roomID, "inviter", inviter)
+		return
+	}
+	if err := c.client.JoinRoom(ctx, roomID); err != nil {
+		logger.Warnw("join room error", "roomId", roomID, "error", err)
+		return
+	}
+	logger.Infow("joined room", "roomId", roomID, "inviter", inviter)
+}
+
+// handleEvent dispatches a timeline event from a joined room.
+func (c *Channel) handleEvent(ctx context.Context, roomID string, ev Event) {
+	if ev.Sender == c.userID {
+		return
+	}
+	if !c.isAllowed(roomID, ev.Sender) {
+		logger.Debugw("blocked event from non-allowed user", "roomId", roomID, "sender", ev.Sender)
+		return
+	}
+
+	switch ev.Type {
+	case eventEncrypted:
+		c.warnEncrypted(roomID)
+	case eventReaction:
+		var content ReactionContent
+		if err := json.Unmarshal(ev.Content, &content); err != nil {
+			return
+		}
+		c.approval.HandleReaction(ev.Sender, content.RelatesTo)
+	case eventMessage:
+		var content MessageContent
+		if err := json.Unmarshal(ev.Content, &content); err != nil {
+			logger.Debugw("malformed message event", "eventId", ev.EventID, "error", err)
+			return
+		}
+		// Edits arrive as new messages; only original messages are handled.
+		if content.RelatesTo != nil && content.RelatesTo.RelType == relReplace {
+			return
+		}
+		c.handleMessage(ctx, roomID, ev, content)
+	}
+}
+
+// warnEncrypted logs once per room that encrypted messages are ignored.
+func (c *Channel) warnEncrypted(roomID string) {
+	c.mu.Lock()
+	r := c.room(roomID)
+	r.encrypted = true
+	warned := r.warned
+	r.warned = true
+	c.mu.Unlock()
+	if !warned {
+		logger.Warnw("ignoring encrypted messages; end-to-end encryption is not supported, use an unencrypted room",
+			"roomId", roomID)
+	}
+}
+
+// handleMessage converts a message event and runs the handler. In rooms with
+// more than two members the bot only answers when mentioned.
+func (c *Channel) handleMessage(ctx context.Context, roomID string, ev Event, content MessageContent) {
+	c.mu.Lock()
+	members := c.room(roomID).members
+	c.mu.Unlock()
+
+	isDM := members > 0 && members <= 2
+	isMention := c.isMentioned(content)
+	if !isDM && !isMention {
+		return
+	}
+
+	incoming := &channels.IncomingMessage{
+		Channel:   types.ChannelMatrix,
+		MessageID: ev.EventID,
+		ChatID:    roomID,
+		UserID:    ev.Sender,
+		Username:  localpart(ev.Sender),
+		IsDM:      isDM,
+		IsMention: isMention,
+	}
+
+	if rel := content.RelatesTo; rel != nil {
+		if rel.RelType == relThread {
+			incoming.Thread = &channels.Thread{ID: rel.EventID}
+		}
+		if rel.InReplyTo != nil && !rel.IsFallingBack {
+			incoming.ReplyToID = rel.InReplyTo.EventID
+		}
+	}
+
+	switch content.MsgType {
+	case "m.text", "m.notice", "m.emote":
+		incoming.Text = c.cleanBody(stripReplyFallback(content.Body))
+	case "m.image", "m.audio", "m.video", "m.file":
+		incoming.Attachments = []channels.Attachment{mediaAttachment(content)}
+	default:
+		return
+	}
+
+	logger.Infow("received message",
+		"eventId", ev.EventID,
+		"roomId", roomID,
+		"sender", ev.Sender,
+	)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		// Show typing indicator while processing
+		stopTyping := c.StartTyping(ctx, roomID)
+		response, err := c.handler(ctx, incoming)
+		stopTyping()
+
+		if err != nil {
+			logger.Errorw("handler error", "error", err)
+			c.sendError(ctx, roomID, incoming.Thread, err)
+			return
+		}
+
+		if response != nil {
+			if err := c.Send(ctx, roomID, response); err != nil {
+				logger.Errorw("send error", "error", err)
+			}
+		}
+	}()
+}
+
+// mediaAttachment converts a media message to an attachment.
+func mediaAttachment(content MessageContent) channels.Attachment {
+	a := channels.Attachment{
+		URL:  content.URL, // mxc:// URI
+		Name: content.Body,
+	}
+	if content.Info != nil {
+		a.MimeType = content.Info.MimeType
+		a.Size = content.Info.Size
+	}
+	switch content.MsgType {
+	case "m.image":
+		a.Type = channels.AttachmentImage
+	case "m.audio":
+		a.Type = channels.AttachmentAudio
+	case "m.video":
+		a.Type = channels.AttachmentVideo
+	default:
+		a.Type = channels.AttachmentDocument
+	}
+	return a
+}
+
+// Send sends a message to a room. chatID may be a room ID or a room alias;
+// an empty chatID selects the first allowlisted room.
+func (c *Channel) Send(ctx context.Context, chatID string, msg *channels.OutgoingMessage) error {
+	roomID, err := c.resolveRoom(ctx, chatID)
+	if err != nil {
+		return err
+	}
+	if c.isEncrypted(roomID) {
+		return fmt.Errorf("matrix room %s is end-to-end encrypted, which is not supported", roomID)
+	}
+
+	content := MessageContent{MsgType: "m.text", Body: msg.Text}
+	switch {
+	case msg.Thread != nil && msg.Thread.ID != "":
+		replyTo := msg.ReplyToID
+		if replyTo == "" {
+			replyTo = msg.Thread.ID
+		}
+		content.RelatesTo = &RelatesTo{
+			RelType:       relThread,
+			EventID:       msg.Thread.ID,
+			InReplyTo:     &InReplyTo{EventID: replyTo},
+			IsFallingBack: msg.ReplyToID == "",
+		}
+	case msg.ReplyToID != "":
+		content.RelatesTo = &RelatesTo{InReplyTo: &InReplyTo{EventID: msg.ReplyToID}}
+	}
+
+	if _, err := c.client.SendEvent(ctx, roomID, eventMessage, content); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StartTyping shows the bot as typing in a room and refreshes the
+// notification until the returned stop function is called or ctx is
+// cancelled. The returned stop function is safe to call multiple times.
+func (c *Channel) StartTyping(ctx context.Context, chatID string) func() {
+	roomID, err := c.resolveRoom(ctx, chatID)
+	if err != nil {
+		logger.Warnw("typing indicator skipped", "error", err)
+		return func() {}
+	}
+
+	if err := c.client.SetTyping(ctx, roomID, c.userID, true, typingTimeout); err != nil {
+		logger.Warnw("typing indicator error", "error", err)
+	}
+
+	done := make(chan struct{})
+	var once sync.Once
+	go func() {
+		ticker := time.NewTicker(typingRefresh)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.client.SetTyping(ctx, roomID, c.userID, true, typingTimeout); err != nil {
+					logger.Warnw("typing indicator refresh error", "error", err)
+				}
+			}
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			close(done)
+			if err := c.client.SetTyping(context.Background(), roomID, c.userID, false, 0); err != nil {
+				logger.Debugw("typing indicator stop error", "error", err)
+			}
+		})
+	}
+}
+
+// resolveRoom maps a delivery target to a room ID.
+func (c *Channel) resolveRoom(ctx context.Context, chatID string) (string, error) {
+	if chatID == "" {
+		for _, id := range c.config.Allowlist {
+			if strings.HasPrefix(id, "!") || strings.HasPrefix(id, "#") {
+				chatID = id
+				break
+			}
+		}
+		if chatID == "" {
+			return "", fmt.Errorf("matrix delivery requires a room ID (use matrix:!ROOM:SERVER) or at least one allowlisted room")
+		}
+	}
+	if strings.HasPrefix(chatID, "#") {
+		return c.client.ResolveAlias(ctx, chatID)
+	}
+	return chatID, nil
+}
+
+// isEncrypted reports whether a room is known to use end-to-end encryption.
+func (c *Channel) isEncrypted(roomID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r, ok := c.rooms[roomID]
+	return ok && r.encrypted
+}
+
+// isAllowed checks whether the room or sender is allowlisted.
+func (c *Channel) isAllowed(roomID, sender string) bool {
+	if len(c.config.Allowlist) == 0 {
+		return true
+	}
+	return slices.Contains(c.config.Allowlist, roomID) || slices.Contains(c.config.Allowlist, sender)
+}
+
+// isMentioned reports whether a message addresses the bot, either through
+// m.mentions, its full user ID or its localpart at the start of the body.
+func (c *Channel) isMentioned(content MessageContent) bool {
+	if content.Mentions != nil && slices.Contains(content.Mentions.UserIDs, c.userID) {
+		return true
+	}
+	body := stripReplyFallback(content.Body)
+	if strings.Contains(body, c.userID) {
+		return true
+	}
+	_, ok := trimName(body, localpart(c.userID))
+	return ok
+}
+
+// cleanBody removes the bot mention from a message body.
+func (c *Channel) cleanBody(body string) string {
+	body = strings.TrimSpace(strings.ReplaceAll(body, c.userID, ""))
+	if rest, ok := trimName(body, localpart(c.userID)); ok {
+		body = rest
+	}
+	return strings.TrimSpace(strings.TrimLeft(body, ":,"))
+}
+
+// trimName strips a leading "name:", "name," or "name " addressing prefix
+// from body, matching the name case-insensitively.
+func trimName(body, name string) (string, bool) {
+	if name == "" || len(body) < len(name) || !strings.EqualFold(body[:len(name)], name) {
+		return body, false
+	}
+	rest := body[len(name):]
+	if rest != "" && !strings.ContainsRune(":, ", rune(rest[0])) {
+		return body, false
+	}
+	return rest, true
+}
+
+// sendError sends an error message
+func (c *Channel) sendError(ctx context.Context, roomID string, thread *channels.Thread, err error) {
+	_ = c.Send(ctx, roomID, &channels.OutgoingMessage{
+		Text:   fmt.Sprintf("❌ Error: %s", err.Error()),
+		Thread: thread,
+	})
+}
+
+// Stop stops the Matrix channel
+func (c *Channel) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+	logger.Info("matrix channel stopped")
+}
+
+// localpart returns the name part of a user ID like "@name:server".
+func localpart(userID string) string {
+	name := strings.TrimPrefix(userID, "@")
+	if idx := strings.IndexByte(name, ':'); idx >= 0 {
+		name = name[:idx]
+	}
+	return name
+}
+
+// stripReplyFallback removes the quoted "> " lines that clients prepend to
+// the body of replies.
+func stripReplyFallback(body string) string {
+	if !strings.HasPrefix(body, "> ") {
+		return body
+	}
+	lines := strings.Split(body, "\n")
+	i := 0
+	for i < len(lines) && strings.HasPrefix(lines[i], ">") {
+		i++
+	}
+	return strings.TrimLeft(strings.Join(lines[i:], "\n"), "\n")
+}
@@ -0,0 +1,378 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/channels"
+)
+
+const (
+	botID   = "@lango:example.org"
+	aliceID = "@alice:example.org"
+	bobID   = "@bob:example.org"
+	dmRoom  = "!dm:example.org"
+)
+
+type sentEvent struct {
+	RoomID  string
+	Type    string
+	Content map[string]any
+}
+
+// fakeHomeserver is a minimal stand-in for the Matrix client-server API.
+type fakeHomeserver struct {
+	t       *testing.T
+	server  *httptest.Server
+	initial SyncResponse
+	syncs   chan SyncResponse
+
+	mu      sync.Mutex
+	sent    []sentEvent
+	typing  []bool
+	joined  []string
+	eventID int
+}
+
+func newFakeHomeserver(t *testing.T, initial SyncResponse) *fakeHomeserver {
+	t.Helper()
+	f := &fakeHomeserver{t: t, initial: initial, syncs: make(chan SyncResponse, 8)}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeHomeserver) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer TEST_TOKEN" {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errcode":"M_UNKNOWN_TOKEN","error":"bad token"}`)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/_matrix/client/v3/"), "/")
+	switch {
+	case parts[0] == "account":
+		writeJSON(w, map[string]string{"user_id": botID})
+	case parts[0] == "sync":
+		if r.URL.Query().Get("since") == "" {
+			writeJSON(w, f.initial)
+			return
+		}
+		select {
+		case resp := <-f.syncs:
+			writeJSON(w, resp)
+		case <-r.Context().Done():
+		case <-time.After(20 * time.Millisecond):
+			writeJSON(w, SyncResponse{NextBatch: r.URL.Query().Get("since")})
+		}
+	case parts[0] == "rooms" && len(parts) >= 4 && parts[2] == "send":
+		var content map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&content)
+		f.mu.Lock()
+		f.eventID++
+		id := fmt.Sprintf("$ev%d", f.eventID)
+		f.sent = append(f.sent, sentEvent{RoomID: parts[1], Type: parts[3], Content: content})
+		f.mu.Unlock()
+		writeJSON(w, map[string]string{"event_id": id})
+	case parts[0] == "rooms" && len(parts) >= 3 && parts[2] == "typing":
+		var body struct {
+			Typing bool `json:"typing"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		f.mu.Lock()
+		f.typing = append(f.typing, body.Typing)
+		f.mu.Unlock()
+		writeJSON(w, struct{}{})
+	case parts[0] == "join":
+		f.mu.Lock()
+		f.joined = append(f.joined, parts[1])
+		f.mu.Unlock()
+		writeJSON(w, map[string]string{"room_id": parts[1]})
+	case parts[0] == "directory":
+		writeJSON(w, map[string]string{"room_id": "!aliased:example.org"})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (f *fakeHomeserver) messages() []sentEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var result []sentEvent
+	for _, e := range f.sent {
+		if e.Type == eventMessage {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func (f *fakeHomeserver) push(roomID string, events ...Event) {
+	var resp SyncResponse
+	resp.NextBatch = fmt.Sprintf("batch-%d", time.Now().UnixNano())
+	resp.Rooms.Join = map[string]JoinedRoom{roomID: {}}
+	room := resp.Rooms.Join[roomID]
+	room.Timeline.Events = events
+	resp.Rooms.Join[roomID] = room
+	f.syncs <- resp
+}
+
+func joinedRooms(members map[string]int, state map[string][]Event) SyncResponse {
+	var resp SyncResponse
+	resp.NextBatch = "batch-0"
+	resp.Rooms.Join = make(map[string]JoinedRoom)
+	for id, n := range members {
+		var room JoinedRoom
+		room.Summary.JoinedMemberCount = &n
+		room.State.Events = state[id]
+		resp.Rooms.Join[id] = room
+	}
+	return resp
+}
+
+func textEvent(id, sender, body string) Event {
+	content, _ := json.Marshal(MessageContent{MsgType: "m.text", Body: body})
+	return Event{Type: eventMessage, EventID: id, Sender: sender, Content: content}
+}
+
+func startChannel(t *testing.T, f *fakeHomeserver, allowlist []string, handler channels.Handler) *Channel {
+	t.Helper()
+	ch, err := New(Config{
+		HomeserverURL: f.server.URL,
+		AccessToken:   "TEST_TOKEN",
+		Allowlist:     allowlist,
+		SyncTimeout:   10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	ch.SetHandler(handler)
+	if err := ch.Start(context.Background()); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	t.Cleanup(ch.Stop)
+	return ch
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestMatrixChannel_RepliesInDM(t *testing.T) {
+	f := newFakeHomeserver(t, joinedRooms(map[string]int{dmRoom: 2}, nil))
+
+	received := make(chan *channels.IncomingMessage, 1)
+	startChannel(t, f, nil, func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		received <- msg
+		return &channels.OutgoingMessage{Text: "pong"}, nil
+	})
+
+	f.push(dmRoom, textEvent("$m1", aliceID, "ping"))
+
+	select {
+	case msg := <-received:
+		if msg.Text != "ping" || msg.ChatID != dmRoom || msg.UserID != aliceID || !msg.IsDM {
+			t.Errorf("unexpected message: %+v", msg)
+		}
+		if got := msg.SessionKey(); got != "matrix:!dm:example.org:@alice:example.org" {
+			t.Errorf("session key = %q", got)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for handler")
+	}
+
+	waitFor(t, func() bool { return len(f.messages()) == 1 })
+	sent := f.messages()[0]
+	if sent.RoomID != dmRoom || sent.Content["body"] != "pong" {
+		t.Errorf("unexpected reply: %+v", sent)
+	}
+
+	f.mu.Lock()
+	typing := append([]bool(nil), f.typing...)
+	f.mu.Unlock()
+	if len(typing) < 2 || !typing[0] || typing[len(typing)-1] {
+		t.Errorf("expected typing on then off, got %v", typing)
+	}
+}
+
+func TestMatrixChannel_GroupRoomRequiresMention(t *testing.T) {
+	const group = "!group:example.org"
+	f := newFakeHomeserver(t, joinedRooms(map[string]int{group: 5}, nil))
+
+	received := make(chan string, 2)
+	startChannel(t, f, nil, func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		received <- msg.Text
+		return nil, nil
+	})
+
+	f.push(group,
+		textEvent("$m1", aliceID, "hello everyone"),
+		textEvent("$m2", aliceID, "Lango: what time is it?"),
+	)
+
+	select {
+	case text := <-received:
+		if text != "what time is it?" {
+			t.Errorf("expected cleaned mention text, got %q", text)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for handler")
+	}
+	select {
+	case text := <-received:
+		t.Errorf("unexpected message handled: %q", text)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMatrixChannel_Allowlist(t *testing.T) {
+	initial := joinedRooms(map[string]int{dmRoom: 2}, nil)
+	stateKey := botID
+	initial.Rooms.Invite = map[string]InvitedRoom{}
+	for room, inviter := range map[string]string{
+		"!friend:example.org":   aliceID,
+		"!stranger:example.org": "@mallory:example.org",
+	} {
+		var inv InvitedRoom
+		inv.InviteState.Events = []Event{{
+			Type: eventMember, Sender: inviter, StateKey: &stateKey,
+			Content: json.RawMessage(`{"membership":"invite"}`),
+		}}
+		initial.Rooms.Invite[room] = inv
+	}
+	f := newFakeHomeserver(t, initial)
+
+	received := make(chan string, 2)
+	startChannel(t, f, []string{aliceID}, func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		received <- msg.UserID
+		return nil, nil
+	})
+
+	f.mu.Lock()
+	joined := append([]string(nil), f.joined...)
+	f.mu.Unlock()
+	if len(joined) != 1 || joined[0] != "!friend:example.org" {
+		t.Errorf("expected to join only the allowed invite, got %v", joined)
+	}
+
+	f.push(dmRoom,
+		textEvent("$m1", "@mallory:example.org", "hi"),
+		textEvent("$m2", aliceID, "hi"),
+	)
+	select {
+	case sender := <-received:
+		if sender != aliceID {
+			t.Errorf("expected message from %s, got %s", aliceID, sender)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for handler")
+	}
+	select {
+	case sender := <-received:
+		t.Errorf("unexpected message from %s", sender)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestMatrixChannel_EncryptedRoom(t *testing.T) {
+	const secret = "!secret:example.org"
+	stateKey := ""
+	f := newFakeHomeserver(t, joinedRooms(
+		map[string]int{secret: 2},
+		map[string][]Event{secret: {{
+			Type: eventEncryption, StateKey: &stateKey,
+			Content: json.RawMessage(`{"algorithm":"m.megolm.v1.aes-sha2"}`),
+		}}},
+	))
+
+	called := make(chan struct{}, 1)
+	ch := startChannel(t, f, nil, func(_ context.Context, _ *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		called <- struct{}{}
+		return nil, nil
+	})
+
+	f.push(secret, Event{Type: eventEncrypted, EventID: "$e1", Sender: aliceID, Content: json.RawMessage(`{}`)})
+	select {
+	case <-called:
+		t.Error("encrypted message must not reach the handler")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	err := ch.Send(context.Background(), secret, &channels.OutgoingMessage{Text: "hi"})
+	if err == nil || !strings.Contains(err.Error(), "encrypted") {
+		t.Errorf("expected encrypted room error, got %v", err)
+	}
+}
+
+func TestMatrixChannel_SendTargets(t *testing.T) {
+	f := newFakeHomeserver(t, joinedRooms(nil, nil))
+	ch := startChannel(t, f, []string{aliceID, "!ops:example.org"}, func(context.Context, *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		return nil, nil
+	})
+	ctx := context.Background()
+
+	if err := ch.Send(ctx, "", &channels.OutgoingMessage{Text: "default"}); err != nil {
+		t.Fatalf("send to default room: %v", err)
+	}
+	if err := ch.Send(ctx, "#ops:example.org", &channels.OutgoingMessage{Text: "alias"}); err != nil {
+		t.Fatalf("send to alias: %v", err)
+	}
+	thread := &channels.Thread{ID: "$root"}
+	if err := ch.Send(ctx, "!ops:example.org", &channels.OutgoingMessage{Text: "threaded", Thread: thread}); err != nil {
+		t.Fatalf("send to thread: %v", err)
+	}
+
+	msgs := f.messages()
+	if len(msgs) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(msgs))
+	}
+	if msgs[0].RoomID != "!ops:example.org" {
+		t.Errorf("default room = %s", msgs[0].RoomID)
+	}
+	if msgs[1].RoomID != "!aliased:example.org" {
+		t.Errorf("alias room = %s", msgs[1].RoomID)
+	}
+	rel, _ := msgs[2].Content["m.relates_to"].(map[string]any)
+	if rel["rel_type"] != relThread || rel["event_id"] != "$root" {
+		t.Errorf("expected thread relation, got %v", rel)
+	}
+
+	noRooms, _ := New(Config{HomeserverURL: f.server.URL, AccessToken: "TEST_TOKEN"})
+	if err := noRooms.Send(ctx, "", &channels.OutgoingMessage{Text: "x"}); err == nil {
+		t.Error("expected error without a room ID or allowlisted room")
+	}
+}
+
+func TestStripReplyFallback(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "plain", want: "plain"},
+		{give: "> <@alice:example.org> earlier\n> more\n\nanswer", want: "answer"},
+	}
+	for _, tt := range tests {
+		if got := stripReplyFallback(tt.give); got != tt.want {
+			t.Errorf("stripReplyFallback(%q) = %q, want %q", tt.give, got, tt.want)
+		}
+	}
+}
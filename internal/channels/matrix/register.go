@@ -0,0 +1,25 @@
+package matrix
+
+import (
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+func init() {
+	channels.Register(types.ChannelMatrix, fromConfig)
+}
+
+// fromConfig creates the Matrix channel from the application config.
+func fromConfig(cfg *config.Config) (channels.Channel, error) {
+	mx := cfg.Channels.Matrix
+	if !mx.Enabled {
+		return nil, channels.ErrDisabled
+	}
+	return New(Config{
+		HomeserverURL:      mx.HomeserverURL,
+		AccessToken:        mx.AccessToken,
+		Allowlist:          mx.Allowlist,
+		ApprovalTimeoutSec: cfg.Security.Interceptor.ApprovalTimeoutSec,
+	})
+}
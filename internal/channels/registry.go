@@ -0,0 +1,100 @@
+package channels
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+// ErrDisabled is returned by a factory when its channel is not enabled in
+// the configuration.
+var ErrDisabled = errors.New("channel disabled")
+
+// Factory creates a channel from the application config. It returns
+// ErrDisabled when the channel is not enabled.
+type Factory func(cfg *config.Config) (Channel, error)
+
+// Registry maps channel types to their factories.
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[types.ChannelType]Factory
+}
+
+// NewRegistry creates an empty channel registry.
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[types.ChannelType]Factory)}
+}
+
+// Register adds the factory for a channel type. It panics if the type is
+// already registered or the factory is nil.
+func (r *Registry) Register(t types.ChannelType, f Factory) {
+	if f == nil {
+		panic(fmt.Sprintf("channels: nil factory for %q", t))
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, dup := r.factories[t]; dup {
+		panic(fmt.Sprintf("channels: %q registered twice", t))
+	}
+	r.factories[t] = f
+}
+
+// Lookup returns the factory for a channel type.
+func (r *Registry) Lookup(t types.ChannelType) (Factory, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	f, ok := r.factories[t]
+	return f, ok
+}
+
+// Types returns the registered channel types in sorted order.
+func (r *Registry) Types() []types.ChannelType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	result := make([]types.ChannelType, 0, len(r.factories))
+	for t := range r.factories {
+		result = append(result, t)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Build creates every enabled channel. Channels that fail to initialize are
+// reported through onError and skipped, so one misconfigured platform does
+// not take down the others.
+func (r *Registry) Build(cfg *config.Config, onError func(t types.ChannelType, err error)) []Channel {
+	var result []Channel
+	for _, t := range r.Types() {
+		f, _ := r.Lookup(t)
+		ch, err := f(cfg)
+		if errors.Is(err, ErrDisabled) {
+			continue
+		}
+		if err != nil {
+			if onError != nil {
+				onError(t, err)
+			}
+			continue
+		}
+		result = append(result, ch)
+	}
+	return result
+}
+
+// defaultRegistry holds the channels registered by platform packages.
+var defaultRegistry = NewRegistry()
+
+// Register adds a channel factory to the default registry. Platform packages
+// call it from init.
+func Register(t types.ChannelType, f Factory) {
+	defaultRegistry.Register(t, f)
+}
+
+// Default returns the default registry.
+func Default() *Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,138 @@
+package channels
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+type fakeChannel struct {
+	typ types.ChannelType
+}
+
+func (f *fakeChannel) Type() types.ChannelType                              { return f.typ }
+func (f *fakeChannel) Capabilities() Capabilities                           { return Capabilities{} }
+func (f *fakeChannel) SetHandler(Handler)                                   {}
+func (f *fakeChannel) Start(context.Context) error                          { return nil }
+func (f *fakeChannel) Stop()                                                {}
+func (f *fakeChannel) Send(context.Context, string, *OutgoingMessage) error { return nil }
+func (f *fakeChannel) StartTyping(context.Context, string) func()           { return func() {} }
+func (f *fakeChannel) ApprovalProvider() approval.Provider                  { return nil }
+
+func TestRegistry_Build(t *testing.T) {
+	r := NewRegistry()
+	r.Register("beta", func(*config.Config) (Channel, error) {
+		return &fakeChannel{typ: "beta"}, nil
+	})
+	r.Register("alpha", func(*config.Config) (Channel, error) {
+		return &fakeChannel{typ: "alpha"}, nil
+	})
+	r.Register("off", func(*config.Config) (Channel, error) {
+		return nil, ErrDisabled
+	})
+	r.Register("broken", func(*config.Config) (Channel, error) {
+		return nil, errors.New("bad token")
+	})
+
+	assert.Equal(t, []types.ChannelType{"alpha", "beta", "broken", "off"}, r.Types())
+
+	var failed []types.ChannelType
+	built := r.Build(&config.Config{}, func(t types.ChannelType, _ error) {
+		failed = append(failed, t)
+	})
+	require.Len(t, built, 2)
+	assert.Equal(t, types.ChannelType("alpha"), built[0].Type())
+	assert.Equal(t, types.ChannelType("beta"), built[1].Type())
+	assert.Equal(t, []types.ChannelType{"broken"}, failed)
+}
+
+func TestRegistry_RegisterTwicePanics(t *testing.T) {
+	r := NewRegistry()
+	f := func(*config.Config) (Channel, error) { return nil, ErrDisabled }
+	r.Register("alpha", f)
+	assert.Panics(t, func() { r.Register("alpha", f) })
+	assert.Panics(t, func() { r.Register("beta", nil) })
+
+	_, ok := r.Lookup("alpha")
+	assert.True(t, ok)
+	_, ok = r.Lookup("beta")
+	assert.False(t, ok)
+}
+
+func TestIncomingMessage_SessionKey(t *testing.T) {
+	thread := &Thread{ID: "1700.1"}
+	tests := []struct {
+		give IncomingMessage
+		want string
+	}{
+		{give: IncomingMessage{Channel: types.ChannelTelegram, ChatID: "42", UserID: "7"}, want: "telegram:42:7"},
+		{give: IncomingMessage{Channel: types.ChannelSlack, ChatID: "C1", UserID: "U1", Scope: SessionPerUser, Thread: thread}, want: "slack:C1:U1"},
+		{give: IncomingMessage{Channel: types.ChannelSlack, ChatID: "C1", UserID: "U1", Scope: SessionPerChannel, Thread: thread}, want: "slack:C1"},
+		{give: IncomingMessage{Channel: types.ChannelSlack, ChatID: "C1", UserID: "U1", Scope: SessionPerThread, Thread: thread}, want: "slack:C1:thread:1700.1"},
+		{give: IncomingMessage{Channel: types.ChannelSlack, ChatID: "D1", UserID: "U1", Scope: SessionPerThread}, want: "slack:D1:U1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.SessionKey())
+		})
+	}
+}
+
+func TestParseSessionKey(t *testing.T) {
+	tests := []struct {
+		give string
+		want SessionKeyParts
+	}{
+		{give: "telegram:42:7", want: SessionKeyParts{Channel: types.ChannelTelegram, ChatID: "42", UserID: "7"}},
+		{give: "slack:C1", want: SessionKeyParts{Channel: types.ChannelSlack, ChatID: "C1"}},
+		{give: "slack:C1:thread:1700.1", want: SessionKeyParts{Channel: types.ChannelSlack, ChatID: "C1", ThreadID: "1700.1"}},
+		{give: "webhook:ci:a:b", want: SessionKeyParts{Channel: types.ChannelWebhook, ChatID: "ci", UserID: "a:b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got, err := ParseSessionKey(tt.give)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, give := range []string{"", "slack", "slack:", ":C1"} {
+		_, err := ParseSessionKey(give)
+		assert.Error(t, err, give)
+	}
+}
+
+func TestIsSessionUser(t *testing.T) {
+	tests := []struct {
+		give string
+		user string
+		want bool
+	}{
+		{give: "telegram:42:7", user: "7", want: true},
+		{give: "telegram:42:7", user: "8", want: false},
+		{give: "slack:C1", user: "U1", want: true},
+		{give: "slack:C1:thread:1700.1", user: "U1", want: true},
+		{give: "slack", user: "U1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give+"/"+tt.user, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSessionUser(tt.give, tt.user))
+		})
+	}
+}
+
+func TestIncomingMessage_SessionKeyRoundTrip(t *testing.T) {
+	for _, scope := range SessionPerUser.Values() {
+		msg := &IncomingMessage{Channel: types.ChannelDiscord, ChatID: "c", UserID: "u", Thread: &Thread{ID: "t"}, Scope: scope}
+		parts, err := ParseSessionKey(msg.SessionKey())
+		require.NoError(t, err)
+		assert.Equal(t, "c", parts.ChatID, scope)
+	}
+}
@@ -0,0 +1,81 @@
+package channels
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/langoai/lango/internal/types"
+)
+
+// SessionScope selects which messages share an agent session.
+type SessionScope string
+
+const (
+	// SessionPerUser gives every user their own session in each chat.
+	SessionPerUser SessionScope = "user"
+	// SessionPerChannel shares one session among everyone in a chat.
+	SessionPerChannel SessionScope = "channel"
+	// SessionPerThread gives every thread its own session. Messages outside
+	// a thread fall back to per-user sessions.
+	SessionPerThread SessionScope = "thread"
+)
+
+// Valid reports whether s is a known session scope.
+func (s SessionScope) Valid() bool {
+	switch s {
+	case SessionPerUser, SessionPerChannel, SessionPerThread:
+		return true
+	}
+	return false
+}
+
+// Values returns all known session scopes.
+func (s SessionScope) Values() []SessionScope {
+	return []SessionScope{SessionPerUser, SessionPerChannel, SessionPerThread}
+}
+
+// threadMarker separates the chat from the thread in per-thread session keys.
+const threadMarker = "thread"
+
+// SessionKeyParts holds the components of a session key.
+type SessionKeyParts struct {
+	Channel  types.ChannelType
+	ChatID   string
+	UserID   string // empty for per-channel and per-thread keys
+	ThreadID string // set for per-thread keys
+}
+
+// ParseSessionKey splits a session key built by IncomingMessage.SessionKey.
+// It understands the three key forms:
+//
+//	<channel>:<chatID>:<userID>        per user
+//	<channel>:<chatID>                 per channel
+//	<channel>:<chatID>:thread:<thread> per thread
+//
+// Chat IDs must not contain colons; channels with such IDs (Matrix) parse
+// their keys themselves.
+func ParseSessionKey(key string) (SessionKeyParts, error) {
+	parts := strings.SplitN(key, ":", 4)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return SessionKeyParts{}, fmt.Errorf("invalid session key: %s", key)
+	}
+	p := SessionKeyParts{Channel: types.ChannelType(parts[0]), ChatID: parts[1]}
+	switch {
+	case len(parts) == 4 && parts[2] == threadMarker:
+		p.ThreadID = parts[3]
+	case len(parts) >= 3:
+		p.UserID = strings.Join(parts[2:], ":")
+	}
+	return p, nil
+}
+
+// IsSessionUser reports whether userID may act for the session with key: the
+// user of a per-user key, or anyone in the chat for per-channel and
+// per-thread keys. Keys that cannot be parsed allow no one.
+func IsSessionUser(key, userID string) bool {
+	p, err := ParseSessionKey(key)
+	if err != nil {
+		return false
+	}
+	return p.UserID == "" || p.UserID == userID
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
 	slackapi "github.com/slack-go/slack"
 )
 
@@ -40,7 +41,7 @@ func NewApprovalProvider(api Client, timeout time.Duration) *ApprovalProvider {
 
 // RequestApproval posts a message with approve/deny/always-allow action buttons and waits for interaction.
 func (p *ApprovalProvider) RequestApproval(ctx context.Context, req approval.ApprovalRequest) (approval.ApprovalResponse, error) {
-	channelID, err := parseSlackChannelID(req.SessionKey)
+	channelID, threadTS, err := parseSlackSession(req.SessionKey)
 	if err != nil {
 		return approval.ApprovalResponse{}, fmt.Errorf("parse session key: %w", err)
 	}
@@ -62,7 +63,7 @@ func (p *ApprovalProvider) RequestApproval(ctx context.Context, req approval.App
 	if req.Summary != "" {
 		sectionText += "\n```" + req.Summary + "```"
 	}
-	_, ts, err := p.api.PostMessage(channelID,
+	options := []slackapi.MsgOption{
 		slackapi.MsgOptionText(fmt.Sprintf("🔐 Tool '%s' requires approval", req.ToolName), false),
 		slackapi.MsgOptionBlocks(
 			slackapi.NewSectionBlock(
@@ -76,7 +77,11 @@ func (p *ApprovalProvider) RequestApproval(ctx context.Context, req approval.App
 				alwaysBtn,
 			),
 		),
-	)
+	}
+	if threadTS != "" {
+		options = append(options, slackapi.MsgOptionTS(threadTS))
+	}
+	_, ts, err := p.api.PostMessage(channelID, options...)
 	if err != nil {
 		return approval.ApprovalResponse{}, fmt.Errorf("send approval message: %w", err)
 	}
@@ -171,11 +176,13 @@ func (p *ApprovalProvider) editExpiredMessage(channelID, timestamp string) {
 	}
 }
 
-// parseSlackChannelID extracts the channelID from a session key like "slack:<channelID>:<userID>".
-func parseSlackChannelID(sessionKey string) (string, error) {
-	parts := strings.SplitN(sessionKey, ":", 3)
-	if len(parts) < 2 {
-		return "", fmt.Errorf("invalid slack session key: %s", sessionKey)
+// parseSlackSession extracts the channel ID and, for per-thread sessions,
+// the thread timestamp from a session key like "slack:<channelID>:<userID>"
+// or "slack:<channelID>:thread:<threadTS>".
+func parseSlackSession(sessionKey string) (channelID, threadTS string, err error) {
+	parts, err := channels.ParseSessionKey(sessionKey)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid slack session key: %s", sessionKey)
 	}
-	return parts[1], nil
+	return parts.ChatID, parts.ThreadID, nil
 }
@@ -59,6 +59,29 @@ func TestSlackApprovalProvider_CanHandle(t *testing.T) {
 	}
 }
 
+func TestParseSlackSession(t *testing.T) {
+	tests := []struct {
+		give       string
+		wantChan   string
+		wantThread string
+	}{
+		{give: "slack:C1:U1", wantChan: "C1"},
+		{give: "slack:C1", wantChan: "C1"},
+		{give: "slack:C1:thread:1700.1", wantChan: "C1", wantThread: "1700.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			ch, ts, err := parseSlackSession(tt.give)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if ch != tt.wantChan || ts != tt.wantThread {
+				t.Errorf("parseSlackSession(%q) = %q, %q, want %q, %q", tt.give, ch, ts, tt.wantChan, tt.wantThread)
+			}
+		})
+	}
+}
+
 func TestSlackApprovalProvider_Approve(t *testing.T) {
 	client := &MockApprovalClient{
 		MockClient: MockClient{
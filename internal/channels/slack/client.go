@@ -9,6 +9,7 @@ import (
 type Client interface {
 	AuthTest() (*slack.AuthTestResponse, error)
 	PostMessage(channelID string, options ...slack.MsgOption) (string, string, error)
+	PostEphemeral(channelID, userID string, options ...slack.MsgOption) (string, error)
 	UpdateMessage(channelID, timestamp string, options ...slack.MsgOption) (string, string, string, error)
 	DeleteMessage(channelID, messageTimestamp string) (string, string, error)
 }
@@ -0,0 +1,176 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	slackapi "github.com/slack-go/slack"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/librarian"
+)
+
+// Action ID prefixes for inquiry quick replies.
+const (
+	inquiryAnswerPrefix  = "inquiry_answer:"
+	inquiryDismissPrefix = "inquiry_dismiss:"
+)
+
+// InquiryProvider implements librarian.InquiryNotifier for Slack using Block Kit buttons.
+type InquiryProvider struct {
+	api      Client
+	allowed  func(channelID, userID string) bool
+	resolver librarian.InquiryResolver
+}
+
+var _ librarian.InquiryNotifier = (*InquiryProvider)(nil)
+
+// NewInquiryProvider creates a Slack inquiry provider. Only users that pass
+// allowed can answer inquiries.
+func NewInquiryProvider(api Client, allowed func(channelID, userID string) bool) *InquiryProvider {
+	return &InquiryProvider{api: api, allowed: allowed}
+}
+
+// SetResolver sets the resolver that handles quick-reply button presses.
+func (p *InquiryProvider) SetResolver(r librarian.InquiryResolver) {
+	p.resolver = r
+}
+
+// NotifyInquiry posts the inquiry with one button per option and a skip button.
+func (p *InquiryProvider) NotifyInquiry(_ context.Context, inq librarian.Inquiry) error {
+	channelID, threadTS, err := parseSlackSession(inq.SessionKey)
+	if err != nil {
+		return fmt.Errorf("parse session key: %w", err)
+	}
+
+	id := inq.ID.String()
+	buttons := make([]slackapi.BlockElement, 0, len(inq.Options)+1)
+	for i, opt := range inq.Options {
+		buttons = append(buttons, slackapi.NewButtonBlockElement(
+			inquiryAnswerPrefix+id+":"+strconv.Itoa(i), opt,
+			slackapi.NewTextBlockObject("plain_text", opt, true, false)))
+	}
+	buttons = append(buttons, slackapi.NewButtonBlockElement(
+		inquiryDismissPrefix+id, "skip",
+		slackapi.NewTextBlockObject("plain_text", "⏭ Skip", true, false)))
+
+	sectionText := "💡 " + inq.Question
+	if inq.Context != "" {
+		sectionText += "\n_" + inq.Context + "_"
+	}
+	if len(inq.Options) > 0 {
+		sectionText += "\nPick an answer or reply in chat."
+	}
+	options := []slackapi.MsgOption{
+		slackapi.MsgOptionText("💡 "+inq.Question, false),
+		slackapi.MsgOptionBlocks(
+			slackapi.NewSectionBlock(
+				slackapi.NewTextBlockObject("mrkdwn", sectionText, false, false),
+				nil, nil,
+			),
+			slackapi.NewActionBlock("inquiry_actions", buttons...),
+		),
+	}
+	if threadTS != "" {
+		options = append(options, slackapi.MsgOptionTS(threadTS))
+	}
+	_, _, err = p.api.PostMessage(channelID, options...)
+	if err != nil {
+		return fmt.Errorf("send inquiry message: %w", err)
+	}
+	return nil
+}
+
+// HandleInteractive processes a block_actions callback for an inquiry. It
+// returns false when the action does not belong to an inquiry. Presses by
+// users outside the allowlist or the inquiry's session are ignored.
+func (p *InquiryProvider) HandleInteractive(channelID, timestamp, userID, actionID string) bool {
+	var (
+		id     string
+		option = -1
+	)
+	switch {
+	case strings.HasPrefix(actionID, inquiryAnswerPrefix):
+		rest := strings.TrimPrefix(actionID, inquiryAnswerPrefix)
+		idx := strings.LastIndex(rest, ":")
+		if idx < 0 {
+			return true
+		}
+		n, err := strconv.Atoi(rest[idx+1:])
+		if err != nil {
+			return true
+		}
+		id, option = rest[:idx], n
+	case strings.HasPrefix(actionID, inquiryDismissPrefix):
+		id = strings.TrimPrefix(actionID, inquiryDismissPrefix)
+	default:
+		return false
+	}
+
+	inquiryID, err := uuid.Parse(id)
+	if err != nil || p.resolver == nil {
+		return true
+	}
+
+	ctx := context.Background()
+	if !p.mayAnswer(ctx, channelID, userID, inquiryID) {
+		return true
+	}
+
+	var text string
+	if option >= 0 {
+		inq, err := p.resolver.AnswerInquiry(ctx, inquiryID, option)
+		if err != nil {
+			logger.Warnw("answer inquiry error", "inquiryId", id, "error", err)
+			return true
+		}
+		text = fmt.Sprintf("💡 %s\n✅ %s", inq.Question, inq.Answer)
+	} else {
+		if err := p.resolver.DismissInquiry(ctx, inquiryID); err != nil {
+			logger.Warnw("dismiss inquiry error", "inquiryId", id, "error", err)
+			return true
+		}
+		text = "💡 Inquiry — ⏭ Skipped"
+	}
+
+	if channelID != "" && timestamp != "" {
+		_, _, _, err := p.api.UpdateMessage(channelID, timestamp,
+			slackapi.MsgOptionText(text, false),
+			slackapi.MsgOptionBlocks(), // remove action buttons
+		)
+		if err != nil {
+			logger.Warnw("update inquiry message error", "error", err)
+		}
+	}
+	return true
+}
+
+// mayAnswer reports whether userID may answer the inquiry: they must be
+// allowlisted and belong to the inquiry's session.
+func (p *InquiryProvider) mayAnswer(ctx context.Context, channelID, userID string, inquiryID uuid.UUID) bool {
+	if userID == "" {
+		return false
+	}
+	if !p.allowed(channelID, userID) {
+		logger.Warnw("inquiry reply from non-allowlisted user ignored", "channelId", channelID, "userId", userID)
+		return false
+	}
+	inq, err := p.resolver.GetInquiry(ctx, inquiryID)
+	if err != nil {
+		logger.Warnw("get inquiry error", "inquiryId", inquiryID, "error", err)
+		return false
+	}
+	if !channels.IsSessionUser(inq.SessionKey, userID) {
+		logger.Warnw("inquiry reply from another user ignored", "inquiryId", inquiryID, "userId", userID)
+		return false
+	}
+	return true
+}
+
+// CanHandle returns true for session keys starting with "slack:".
+func (p *InquiryProvider) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, "slack:")
+}
@@ -0,0 +1,126 @@
+package slack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	slackapi "github.com/slack-go/slack"
+
+	"github.com/langoai/lango/internal/librarian"
+)
+
+type fakeInquiryResolver struct {
+	sessionKey string
+	answered   map[uuid.UUID]int
+	dismissed  []uuid.UUID
+}
+
+func (f *fakeInquiryResolver) GetInquiry(_ context.Context, id uuid.UUID) (*librarian.Inquiry, error) {
+	return &librarian.Inquiry{ID: id, SessionKey: f.sessionKey}, nil
+}
+
+func (f *fakeInquiryResolver) AnswerInquiry(_ context.Context, id uuid.UUID, option int) (*librarian.Inquiry, error) {
+	if f.answered == nil {
+		f.answered = make(map[uuid.UUID]int)
+	}
+	f.answered[id] = option
+	return &librarian.Inquiry{ID: id, Question: "Where do you deploy?", Answer: "GCP"}, nil
+}
+
+func (f *fakeInquiryResolver) DismissInquiry(_ context.Context, id uuid.UUID) error {
+	f.dismissed = append(f.dismissed, id)
+	return nil
+}
+
+func TestSlackInquiryProvider_NotifyInquiry(t *testing.T) {
+	var postedChannel string
+	client := &MockApprovalClient{
+		MockClient: MockClient{
+			PostMessageFunc: func(channelID string, options ...slackapi.MsgOption) (string, string, error) {
+				postedChannel = channelID
+				return channelID, "ts-1", nil
+			},
+		},
+	}
+	p := NewInquiryProvider(client, allowAll)
+
+	err := p.NotifyInquiry(context.Background(), librarian.Inquiry{
+		ID:         uuid.New(),
+		SessionKey: "slack:C123:U456",
+		Question:   "Where do you deploy?",
+		Options:    []string{"AWS", "GCP"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if postedChannel != "C123" {
+		t.Errorf("want channel C123, got %q", postedChannel)
+	}
+
+	if err := p.NotifyInquiry(context.Background(), librarian.Inquiry{SessionKey: "slack"}); err == nil {
+		t.Error("expected error for invalid session key")
+	}
+}
+
+func allowAll(_, _ string) bool { return true }
+
+func TestSlackInquiryProvider_HandleInteractive(t *testing.T) {
+	client := &MockApprovalClient{}
+	resolver := &fakeInquiryResolver{sessionKey: "slack:C123:U456"}
+	p := NewInquiryProvider(client, allowAll)
+	p.SetResolver(resolver)
+
+	answerID := uuid.New()
+	if !p.HandleInteractive("C123", "ts-1", "U456", inquiryAnswerPrefix+answerID.String()+":0") {
+		t.Fatal("expected answer action to be handled")
+	}
+	if got, ok := resolver.answered[answerID]; !ok || got != 0 {
+		t.Errorf("want option 0 answered, got %d (found=%v)", got, ok)
+	}
+	if len(client.UpdateMessages) != 1 || client.UpdateMessages[0].Timestamp != "ts-1" {
+		t.Fatalf("expected inquiry message update, got %+v", client.UpdateMessages)
+	}
+
+	dismissID := uuid.New()
+	if !p.HandleInteractive("C123", "ts-2", "U456", inquiryDismissPrefix+dismissID.String()) {
+		t.Fatal("expected dismiss action to be handled")
+	}
+	if len(resolver.dismissed) != 1 || resolver.dismissed[0] != dismissID {
+		t.Errorf("unexpected dismissed %v", resolver.dismissed)
+	}
+
+	if p.HandleInteractive("C123", "ts-3", "U456", "approve:req-1") {
+		t.Error("approval action must not be handled by inquiry provider")
+	}
+}
+
+func TestSlackInquiryProvider_HandleInteractiveIgnoresOtherUsers(t *testing.T) {
+	tests := []struct {
+		give    string
+		user    string
+		allowed func(channelID, userID string) bool
+	}{
+		{give: "other session user", user: "U789", allowed: allowAll},
+		{give: "no user", user: "", allowed: allowAll},
+		{give: "not allowlisted", user: "U456", allowed: func(_, _ string) bool { return false }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			client := &MockApprovalClient{}
+			resolver := &fakeInquiryResolver{sessionKey: "slack:C123:U456"}
+			p := NewInquiryProvider(client, tt.allowed)
+			p.SetResolver(resolver)
+
+			id := uuid.New()
+			for _, action := range []string{inquiryAnswerPrefix + id.String() + ":0", inquiryDismissPrefix + id.String()} {
+				if !p.HandleInteractive("C123", "ts-1", tt.user, action) {
+					t.Fatalf("expected %q to be handled", action)
+				}
+			}
+			if len(resolver.answered) != 0 || len(resolver.dismissed) != 0 || len(client.UpdateMessages) != 0 {
+				t.Errorf("press must be ignored, got answered=%v dismissed=%v", resolver.answered, resolver.dismissed)
+			}
+		})
+	}
+}
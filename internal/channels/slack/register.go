@@ -0,0 +1,27 @@
+package slack
+
+import (
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+func init() {
+	channels.Register(types.ChannelSlack, fromConfig)
+}
+
+// fromConfig creates the Slack channel from the application config.
+func fromConfig(cfg *config.Config) (channels.Channel, error) {
+	sl := cfg.Channels.Slack
+	if !sl.Enabled {
+		return nil, channels.ErrDisabled
+	}
+	return New(Config{
+		BotToken:           sl.BotToken,
+		AppToken:           sl.AppToken,
+		SigningSecret:      sl.SigningSecret,
+		Allowlist:          sl.Allowlist,
+		SessionScope:       channels.SessionScope(sl.SessionScope),
+		ApprovalTimeoutSec: cfg.Security.Interceptor.ApprovalTimeoutSec,
+	})
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/types"
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
 	"github.com/slack-go/slack/socketmode"
@@ -21,43 +25,13 @@ type Config struct {
 	BotToken           string // xoxb-...
 	AppToken           string // xapp-... (for Socket Mode)
 	SigningSecret      string
-	ApprovalTimeoutSec int          // 0 = default 30s
-	APIURL             string       // optional, for testing
-	HTTPClient         *http.Client // optional, for testing
-	Client             Client       // optional, for testing
-	Socket             Socket       // optional, for testing
-}
-
-// MessageHandler handles incoming messages
-type MessageHandler func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error)
-
-// IncomingMessage represents a message from Slack
-type IncomingMessage struct {
-	EventType string // app_mention, message
-	ChannelID string
-	UserID    string
-	Text      string
-	ThreadTS  string
-	IsThread  bool
-}
-
-// OutgoingMessage represents a message to send
-type OutgoingMessage struct {
-	Text     string
-	ThreadTS string
-	Blocks   []Block
-}
-
-// Block represents a Slack Block Kit block
-type Block struct {
-	Type string
-	Text *TextBlock
-}
-
-// TextBlock represents text in a block
-type TextBlock struct {
-	Type string // mrkdwn, plain_text
-	Text string
+	Allowlist          []string              // user or channel IDs; empty = allow all
+	SessionScope       channels.SessionScope // default SessionPerUser
+	ApprovalTimeoutSec int                   // 0 = default 30s
+	APIURL             string                // optional, for testing
+	HTTPClient         *http.Client          // optional, for testing
+	Client             Client                // optional, for testing
+	Socket             Socket                // optional, for testing
 }
 
 // Channel implements Slack bot
@@ -65,8 +39,10 @@ type Channel struct {
 	config   Config
 	api      Client
 	socket   Socket
-	handler  MessageHandler
+	handler  channels.Handler
+	commands []channels.Command
 	approval *ApprovalProvider
+	inquiry  *InquiryProvider
 	botID    string
 	stopChan chan struct{}
 	wg       sync.WaitGroup
@@ -80,6 +56,12 @@ func New(cfg Config) (*Channel, error) {
 	if cfg.AppToken == "" {
 		return nil, fmt.Errorf("app token is required for Socket Mode")
 	}
+	if cfg.SessionScope == "" {
+		cfg.SessionScope = channels.SessionPerUser
+	}
+	if !cfg.SessionScope.Valid() {
+		return nil, fmt.Errorf("invalid session scope %q", cfg.SessionScope)
+	}
 
 	opts := []slack.Option{
 		slack.OptionAppLevelToken(cfg.AppToken),
@@ -128,20 +110,52 @@ func New(cfg Config) (*Channel, error) {
 		stopChan: make(chan struct{}),
 	}
 	ch.approval = NewApprovalProvider(apiClient, time.Duration(cfg.ApprovalTimeoutSec)*time.Second)
+	ch.inquiry = NewInquiryProvider(apiClient, ch.isAllowed)
 
 	return ch, nil
 }
 
+var (
+	_ channels.InquiryChannel    = (*Channel)(nil)
+	_ channels.AttachmentChannel = (*Channel)(nil)
+	_ channels.CommandChannel    = (*Channel)(nil)
+)
+
+// Type returns types.ChannelSlack.
+func (c *Channel) Type() types.ChannelType {
+	return types.ChannelSlack
+}
+
+// Capabilities reports the features supported by Slack.
+func (c *Channel) Capabilities() channels.Capabilities {
+	return channels.Capabilities{
+		Threads:     true,
+		Attachments: true,
+		Buttons:     true,
+	}
+}
+
 // SetHandler sets the message handler
-func (c *Channel) SetHandler(handler MessageHandler) {
+func (c *Channel) SetHandler(handler channels.Handler) {
 	c.handler = handler
 }
 
-// GetApprovalProvider returns the channel's approval provider for composite registration.
-func (c *Channel) GetApprovalProvider() *ApprovalProvider {
+// SetCommands sets the slash commands answered by the bot. Slack apps declare
+// their slash commands in the app configuration, not through the API.
+func (c *Channel) SetCommands(cmds []channels.Command) {
+	c.commands = cmds
+}
+
+// ApprovalProvider returns the channel's approval provider for composite registration.
+func (c *Channel) ApprovalProvider() approval.Provider {
 	return c.approval
 }
 
+// InquiryProvider returns the channel's inquiry provider for librarian notifier registration.
+func (c *Channel) InquiryProvider() channels.InquiryProvider {
+	return c.inquiry
+}
+
 // Start starts the Slack bot
 func (c *Channel) Start(ctx context.Context) error {
 	if c.handler == nil {
@@ -186,7 +200,7 @@ func (c *Channel) handleEvents(ctx context.Context) {
 			case socketmode.EventTypeInteractive:
 				c.handleInteractiveEvent(event)
 			case socketmode.EventTypeSlashCommand:
-				// Handle slash commands if needed
+				c.handleSlashCommand(ctx, event)
 			}
 		}
 	}
@@ -218,47 +232,210 @@ func (c *Channel) handleInteractiveEvent(event socketmode.Event) {
 
 	if callback.Type == slack.InteractionTypeBlockActions {
 		for _, action := range callback.ActionCallback.BlockActions {
+			if c.inquiry.HandleInteractive(callback.Channel.ID, callback.Message.Timestamp, callback.User.ID, action.ActionID) {
+				continue
+			}
 			c.approval.HandleInteractive(action.ActionID)
 		}
 	}
 }
 
+// handleSlashCommand runs a slash command through the handler and answers
+// with a message only the invoking user sees. Each command can be set up in
+// the Slack app as "/reset", "/model", ...; since Slack reserves some names
+// such as /status, a single umbrella command works too: "/lango status".
+func (c *Channel) handleSlashCommand(ctx context.Context, event socketmode.Event) {
+	cmd, ok := event.Data.(slack.SlashCommand)
+	if !ok {
+		return
+	}
+
+	c.socket.Ack(*event.Request)
+
+	if !c.isAllowed(cmd.ChannelID, cmd.UserID) {
+		logger.Warnw("command from non-allowlisted user ignored", "channelId", cmd.ChannelID, "userId", cmd.UserID)
+		return
+	}
+
+	text := strings.TrimSpace(cmd.Command + " " + cmd.Text)
+	if !channels.IsCommand(text, c.commands) {
+		// Umbrella command: the first word names the command.
+		text = "/" + strings.TrimSpace(strings.TrimPrefix(cmd.Text, "/"))
+		if text == "/" {
+			text = "/help"
+		}
+	}
+
+	incoming := &channels.IncomingMessage{
+		Channel:  types.ChannelSlack,
+		ChatID:   cmd.ChannelID,
+		UserID:   cmd.UserID,
+		Username: cmd.UserName,
+		Text:     text,
+		IsDM:     strings.HasPrefix(cmd.ChannelID, "D"),
+		Scope:    c.config.SessionScope,
+	}
+
+	logger.Infow("received command",
+		"command", cmd.Command,
+		"channelId", cmd.ChannelID,
+		"userId", cmd.UserID,
+	)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		response, err := c.handler(ctx, incoming)
+		var reply string
+		switch {
+		case err != nil:
+			logger.Errorw("handler error", "error", err)
+			reply = fmt.Sprintf("❌ Error: %s", err.Error())
+		case response == nil || response.Text == "":
+			reply = "✅ Done"
+		default:
+			reply = response.Text
+		}
+
+		if _, err := c.api.PostEphemeral(cmd.ChannelID, cmd.UserID, slack.MsgOptionText(FormatMrkdwn(reply), false)); err != nil {
+			logger.Errorw("command reply error", "command", cmd.Command, "error", err)
+		}
+	}()
+}
+
 // handleCallbackEvent handles inner callback events
 func (c *Channel) handleCallbackEvent(ctx context.Context, innerEvent slackevents.EventsAPIInnerEvent) {
 	switch ev := innerEvent.Data.(type) {
 	case *slackevents.AppMentionEvent:
-		c.handleMessage(ctx, "app_mention", ev.Channel, ev.User, ev.Text, ev.ThreadTimeStamp)
+		c.handleMessage(ctx, &channels.IncomingMessage{
+			Channel:   types.ChannelSlack,
+			MessageID: ev.TimeStamp,
+			ChatID:    ev.Channel,
+			UserID:    ev.User,
+			Text:      ev.Text,
+			Thread:    thread(ev.ThreadTimeStamp),
+			IsMention: true,
+		})
 	case *slackevents.MessageEvent:
 		// Only handle DMs (channel type = "im")
 		if ev.ChannelType == "im" {
-			c.handleMessage(ctx, "message", ev.Channel, ev.User, ev.Text, ev.ThreadTimeStamp)
+			c.handleMessage(ctx, &channels.IncomingMessage{
+				Channel:     types.ChannelSlack,
+				MessageID:   ev.TimeStamp,
+				ChatID:      ev.Channel,
+				UserID:      ev.User,
+				Text:        ev.Text,
+				Thread:      thread(ev.ThreadTimeStamp),
+				Attachments: attachments(ev.Files),
+				IsDM:        true,
+			})
 		}
 	}
 }
 
+// scope applies the session scope to a message. With per-thread sessions a
+// mention outside a thread starts a new thread on that message, so the reply
+// and the follow-up conversation stay out of the main channel. Slash commands
+// act on the session they are typed in instead.
+func (c *Channel) scope(incoming *channels.IncomingMessage) {
+	incoming.Scope = c.config.SessionScope
+	if channels.IsCommand(incoming.Text, c.commands) {
+		return
+	}
+	if incoming.Scope == channels.SessionPerThread && incoming.Thread == nil && !incoming.IsDM {
+		incoming.Thread = thread(incoming.MessageID)
+	}
+}
+
+// isAllowed checks whether the channel or user is allowlisted.
+func (c *Channel) isAllowed(channelID, userID string) bool {
+	if len(c.config.Allowlist) == 0 {
+		return true
+	}
+	return slices.Contains(c.config.Allowlist, channelID) || slices.Contains(c.config.Allowlist, userID)
+}
+
+// thread returns the thread for a thread timestamp, or nil outside threads.
+func thread(threadTS string) *channels.Thread {
+	if threadTS == "" {
+		return nil
+	}
+	return &channels.Thread{ID: threadTS}
+}
+
+// threadTimestamp returns the thread timestamp of t, or "" for no thread.
+func threadTimestamp(t *channels.Thread) string {
+	if t == nil {
+		return ""
+	}
+	return t.ID
+}
+
+// DownloadAttachment downloads a shared file. Slack serves files only to
+// requests authorized with the bot token.
+func (c *Channel) DownloadAttachment(ctx context.Context, a channels.Attachment) ([]byte, error) {
+	if a.URL == "" {
+		return nil, fmt.Errorf("file %s has no download URL", a.FileID)
+	}
+	header := http.Header{"Authorization": {"Bearer " + c.config.BotToken}}
+	return channels.Download(ctx, c.config.HTTPClient, a.URL, header)
+}
+
+// attachments converts files shared in a Slack message.
+func attachments(files []slackevents.File) []channels.Attachment {
+	if len(files) == 0 {
+		return nil
+	}
+	result := make([]channels.Attachment, 0, len(files))
+	for _, f := range files {
+		result = append(result, channels.Attachment{
+			Type:     attachmentType(f.Mimetype),
+			FileID:   f.ID,
+			URL:      f.URLPrivateDownload,
+			Name:     f.Name,
+			MimeType: f.Mimetype,
+			Size:     int64(f.Size),
+		})
+	}
+	return result
+}
+
+// attachmentType classifies a file by its MIME type.
+func attachmentType(mimeType string) channels.AttachmentType {
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return channels.AttachmentImage
+	case strings.HasPrefix(mimeType, "audio/"):
+		return channels.AttachmentAudio
+	case strings.HasPrefix(mimeType, "video/"):
+		return channels.AttachmentVideo
+	default:
+		return channels.AttachmentDocument
+	}
+}
+
 // handleMessage processes a message event
-func (c *Channel) handleMessage(ctx context.Context, eventType, channelID, userID, text, threadTS string) {
+func (c *Channel) handleMessage(ctx context.Context, incoming *channels.IncomingMessage) {
 	// Ignore bot's own messages
-	if userID == c.botID {
+	if incoming.UserID == c.botID {
+		return
+	}
+	if !c.isAllowed(incoming.ChatID, incoming.UserID) {
+		logger.Warnw("message from non-allowlisted user ignored", "channelId", incoming.ChatID, "userId", incoming.UserID)
 		return
 	}
 
 	// Clean text (remove bot mention)
-	text = c.cleanText(text)
-
-	incoming := &IncomingMessage{
-		EventType: eventType,
-		ChannelID: channelID,
-		UserID:    userID,
-		Text:      text,
-		ThreadTS:  threadTS,
-		IsThread:  threadTS != "",
-	}
+	incoming.Text = c.cleanText(incoming.Text)
+	c.scope(incoming)
+	channelID := incoming.ChatID
+	threadTS := threadTimestamp(incoming.Thread)
 
 	logger.Infow("received message",
-		"eventType", eventType,
+		"isMention", incoming.IsMention,
 		"channelId", channelID,
-		"userId", userID,
+		"userId", incoming.UserID,
 	)
 
 	// Run handler in a separate goroutine to avoid blocking the event loop.
@@ -268,15 +445,29 @@ func (c *Channel) handleMessage(ctx context.Context, eventType, channelID, userI
 	go func() {
 		defer c.wg.Done()
 
-		// Post a placeholder "Thinking..." message while processing
+		// Post a placeholder "Thinking..." message while processing. The
+		// reply is streamed into it as it is generated.
 		placeholderTS, placeholderErr := c.postThinking(channelID, threadTS)
+		var stream *channels.Stream
+		if placeholderErr == nil {
+			stream = channels.NewStream(ctx, &streamEditor{api: c.api, channelID: channelID, threadTS: threadTS}, channels.StreamOptions{
+				Interval:  editInterval,
+				MessageID: placeholderTS,
+			})
+			defer stream.Stop()
+			incoming.Stream = stream
+		}
 
 		response, err := c.handler(ctx, incoming)
+		if response != nil && response.Thread == nil {
+			response.Thread = incoming.Thread
+		}
 		if err != nil {
 			logger.Errorw("handler error", "error", err)
 			c.sendError(channelID, threadTS, err)
 			// Clean up placeholder on error
 			if placeholderErr == nil {
+				stream.Stop()
 				_ = c.updateThinking(channelID, placeholderTS, fmt.Sprintf("Error: %s", err.Error()))
 			}
 			return
@@ -285,16 +476,15 @@ func (c *Channel) handleMessage(ctx context.Context, eventType, channelID, userI
 		if response != nil {
 			// Replace placeholder with actual response
 			if placeholderErr == nil {
-				formattedText := FormatMrkdwn(response.Text)
-				if updateErr := c.updateThinking(channelID, placeholderTS, formattedText); updateErr != nil {
+				if updateErr := stream.Finish(ctx, response.Text); updateErr != nil {
 					logger.Warnw("placeholder update failed, sending new message", "error", updateErr)
-					if err := c.Send(channelID, response); err != nil {
+					if err := c.Send(ctx, channelID, response); err != nil {
 						logger.Errorw("send error", "error", err)
 					}
 				}
 			} else {
 				// Placeholder failed, send normally
-				if err := c.Send(channelID, response); err != nil {
+				if err := c.Send(ctx, channelID, response); err != nil {
 					logger.Errorw("send error", "error", err)
 				}
 			}
@@ -305,7 +495,10 @@ func (c *Channel) handleMessage(ctx context.Context, eventType, channelID, userI
 // StartTyping posts a "_Processing..._ " placeholder message.
 // The returned stop function deletes the placeholder on call.
 // If posting fails, a no-op stop function is returned.
-func (c *Channel) StartTyping(channelID string) func() {
+func (c *Channel) StartTyping(_ context.Context, channelID string) func() {
+	if channelID == "" {
+		return func() {}
+	}
 	options := []slack.MsgOption{
 		slack.MsgOptionText("_Processing..._", false),
 	}
@@ -351,30 +544,22 @@ func (c *Channel) updateThinking(channelID, messageTS, text string) error {
 
 // Send sends a message.
 // Standard Markdown in msg.Text is auto-converted to Slack mrkdwn before sending.
-func (c *Channel) Send(channelID string, msg *OutgoingMessage) error {
-	formattedText := FormatMrkdwn(msg.Text)
+func (c *Channel) Send(_ context.Context, channelID string, msg *channels.OutgoingMessage) error {
+	if channelID == "" {
+		return fmt.Errorf("slack delivery requires a channel ID (use slack:CHANNEL_ID)")
+	}
+	return c.post(channelID, threadTimestamp(msg.Thread), FormatMrkdwn(msg.Text))
+}
 
+// post posts text to a channel, in a thread when threadTS is set.
+func (c *Channel) post(channelID, threadTS, text string) error {
 	options := []slack.MsgOption{
-		slack.MsgOptionText(formattedText, false),
+		slack.MsgOptionText(text, false),
 	}
 
 	// Reply in thread if specified
-	if msg.ThreadTS != "" {
-		options = append(options, slack.MsgOptionTS(msg.ThreadTS))
-	}
-
-	// Add blocks if specified
-	if len(msg.Blocks) > 0 {
-		var blocks []slack.Block
-		for _, b := range msg.Blocks {
-			if b.Type == "section" && b.Text != nil {
-				textBlock := slack.NewTextBlockObject(b.Text.Type, b.Text.Text, false, false)
-				blocks = append(blocks, slack.NewSectionBlock(textBlock, nil, nil))
-			}
-		}
-		if len(blocks) > 0 {
-			options = append(options, slack.MsgOptionBlocks(blocks...))
-		}
+	if threadTS != "" {
+		options = append(options, slack.MsgOptionTS(threadTS))
 	}
 
 	_, _, err := c.api.PostMessage(channelID, options...)
@@ -390,10 +575,7 @@ func (c *Channel) cleanText(text string) string {
 
 // sendError sends an error message
 func (c *Channel) sendError(channelID, threadTS string, err error) {
-	_ = c.Send(channelID, &OutgoingMessage{
-		Text:     fmt.Sprintf("❌ Error: %s", err.Error()),
-		ThreadTS: threadTS,
-	})
+	_ = c.post(channelID, threadTS, FormatMrkdwn(fmt.Sprintf("❌ Error: %s", err.Error())))
 }
 
 // Stop stops the Slack bot
@@ -2,6 +2,8 @@ package slack
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -9,6 +11,9 @@ import (
 	"github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
 	"github.com/slack-go/slack/socketmode"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/types"
 )
 
 // MockClient implements Client interface
@@ -21,6 +26,7 @@ type MockClient struct {
 		ChannelID string
 		Options   []slack.MsgOption
 	}
+	Ephemerals     []string // "channel/user"
 	UpdateMessages []struct {
 		ChannelID string
 		Timestamp string
@@ -66,6 +72,19 @@ func (m *MockClient) PostMessage(channelID string, options ...slack.MsgOption) (
 	return "ts-123", "chan-123", nil
 }
 
+func (m *MockClient) PostEphemeral(channelID, userID string, options ...slack.MsgOption) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Ephemerals = append(m.Ephemerals, channelID+"/"+userID)
+	return "ts-eph", nil
+}
+
+func (m *MockClient) getEphemerals() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]string(nil), m.Ephemerals...)
+}
+
 func (m *MockClient) getPostMessages() []struct {
 	ChannelID string
 	Options   []slack.MsgOption
@@ -131,11 +150,11 @@ func TestSlackChannel(t *testing.T) {
 		t.Fatalf("failed to create channel: %v", err)
 	}
 
-	channel.SetHandler(func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error) {
+	channel.SetHandler(func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
 		if msg.Text != "Hello" {
 			t.Errorf("expected 'Hello', got '%s'", msg.Text)
 		}
-		return &OutgoingMessage{Text: "World"}, nil
+		return &channels.OutgoingMessage{Text: "World"}, nil
 	})
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -203,9 +222,9 @@ func TestSlackThinkingPlaceholder(t *testing.T) {
 	}
 
 	handlerDone := make(chan struct{})
-	channel.SetHandler(func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error) {
+	channel.SetHandler(func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
 		defer close(handlerDone)
-		return &OutgoingMessage{Text: "response text"}, nil
+		return &channels.OutgoingMessage{Text: "response text"}, nil
 	})
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -256,3 +275,201 @@ func TestSlackThinkingPlaceholder(t *testing.T) {
 		t.Errorf("expected update on 'placeholder-ts', got '%s'", updateMsgs[0].Timestamp)
 	}
 }
+
+func TestDownloadAttachment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer TEST_TOKEN" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("file-bytes"))
+	}))
+	defer srv.Close()
+
+	channel, err := New(Config{
+		BotToken: "TEST_TOKEN",
+		AppToken: "APP_TOKEN",
+		Client:   &MockClient{},
+		Socket:   &MockSocket{EventsCh: make(chan socketmode.Event)},
+	})
+	if err != nil {
+		t.Fatalf("failed to create channel: %v", err)
+	}
+
+	data, err := channel.DownloadAttachment(context.Background(), channels.Attachment{FileID: "F1", URL: srv.URL + "/files/F1"})
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	if string(data) != "file-bytes" {
+		t.Errorf("unexpected data %q", data)
+	}
+
+	if _, err := channel.DownloadAttachment(context.Background(), channels.Attachment{FileID: "F2"}); err == nil {
+		t.Error("expected error for file without URL")
+	}
+}
+
+func TestChannel_Scope(t *testing.T) {
+	tests := []struct {
+		give    string
+		scope   channels.SessionScope
+		msg     channels.IncomingMessage
+		wantKey string
+	}{
+		{
+			give:    "mention starts thread",
+			scope:   channels.SessionPerThread,
+			msg:     channels.IncomingMessage{MessageID: "1700.1", ChatID: "C1", UserID: "U1", IsMention: true},
+			wantKey: "slack:C1:thread:1700.1",
+		},
+		{
+			give:    "reply in thread",
+			scope:   channels.SessionPerThread,
+			msg:     channels.IncomingMessage{MessageID: "1700.5", ChatID: "C1", UserID: "U2", Thread: thread("1700.1")},
+			wantKey: "slack:C1:thread:1700.1",
+		},
+		{
+			give:    "top-level DM",
+			scope:   channels.SessionPerThread,
+			msg:     channels.IncomingMessage{MessageID: "1700.1", ChatID: "D1", UserID: "U1", IsDM: true},
+			wantKey: "slack:D1:U1",
+		},
+		{
+			give:    "per channel",
+			scope:   channels.SessionPerChannel,
+			msg:     channels.IncomingMessage{MessageID: "1700.1", ChatID: "C1", UserID: "U1", IsMention: true},
+			wantKey: "slack:C1",
+		},
+		{
+			give:    "command does not start thread",
+			scope:   channels.SessionPerThread,
+			msg:     channels.IncomingMessage{MessageID: "1700.1", ChatID: "C1", UserID: "U1", Text: "/reset", IsMention: true},
+			wantKey: "slack:C1:U1",
+		},
+		{
+			give:    "per user",
+			scope:   channels.SessionPerUser,
+			msg:     channels.IncomingMessage{MessageID: "1700.5", ChatID: "C1", UserID: "U1", Thread: thread("1700.1")},
+			wantKey: "slack:C1:U1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			c := &Channel{config: Config{SessionScope: tt.scope}, commands: []channels.Command{{Name: "reset"}}}
+			msg := tt.msg
+			msg.Channel = types.ChannelSlack
+			c.scope(&msg)
+			if got := msg.SessionKey(); got != tt.wantKey {
+				t.Errorf("expected session key %q, got %q", tt.wantKey, got)
+			}
+		})
+	}
+}
+
+func TestSlackSlashCommand(t *testing.T) {
+	tests := []struct {
+		give     string
+		giveText string
+		want     string
+	}{
+		{give: "/reset", want: "/reset"},
+		{give: "/model", giveText: "gpt-4o", want: "/model gpt-4o"},
+		{give: "/lango", giveText: "status", want: "/status"},
+		{give: "/lango", want: "/help"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give+" "+tt.giveText, func(t *testing.T) {
+			mockClient := &MockClient{}
+			mockSocket := &MockSocket{EventsCh: make(chan socketmode.Event, 1)}
+			channel, err := New(Config{BotToken: "TEST_TOKEN", AppToken: "APP_TOKEN", Client: mockClient, Socket: mockSocket})
+			if err != nil {
+				t.Fatalf("new channel: %v", err)
+			}
+			channel.SetCommands([]channels.Command{{Name: "reset"}, {Name: "model"}, {Name: "status"}})
+
+			got := make(chan *channels.IncomingMessage, 1)
+			channel.SetHandler(func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+				got <- msg
+				return &channels.OutgoingMessage{Text: "ok"}, nil
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if err := channel.Start(ctx); err != nil {
+				t.Fatalf("start: %v", err)
+			}
+			defer channel.Stop()
+
+			mockSocket.EventsCh <- socketmode.Event{
+				Type:    socketmode.EventTypeSlashCommand,
+				Request: &socketmode.Request{},
+				Data:    slack.SlashCommand{Command: tt.give, Text: tt.giveText, ChannelID: "C1", UserID: "U1"},
+			}
+
+			select {
+			case msg := <-got:
+				if msg.Text != tt.want {
+					t.Errorf("expected text %q, got %q", tt.want, msg.Text)
+				}
+				if key := msg.SessionKey(); key != "slack:C1:U1" {
+					t.Errorf("expected session key slack:C1:U1, got %q", key)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("timeout waiting for handler")
+			}
+
+			deadline := time.Now().Add(time.Second)
+			for len(mockClient.getEphemerals()) == 0 && time.Now().Before(deadline) {
+				time.Sleep(5 * time.Millisecond)
+			}
+			if eph := mockClient.getEphemerals(); len(eph) != 1 || eph[0] != "C1/U1" {
+				t.Errorf("expected one ephemeral reply to C1/U1, got %v", eph)
+			}
+		})
+	}
+}
+
+func TestSlackSlashCommand_Allowlist(t *testing.T) {
+	mockClient := &MockClient{}
+	mockSocket := &MockSocket{EventsCh: make(chan socketmode.Event, 2)}
+	channel, err := New(Config{BotToken: "TEST_TOKEN", AppToken: "APP_TOKEN", Allowlist: []string{"U1"}, Client: mockClient, Socket: mockSocket})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	channel.SetCommands([]channels.Command{{Name: "cron"}})
+
+	got := make(chan *channels.IncomingMessage, 2)
+	channel.SetHandler(func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		got <- msg
+		return &channels.OutgoingMessage{Text: "ok"}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := channel.Start(ctx); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	defer channel.Stop()
+
+	for _, user := range []string{"U2", "U1"} {
+		mockSocket.EventsCh <- socketmode.Event{
+			Type:    socketmode.EventTypeSlashCommand,
+			Request: &socketmode.Request{},
+			Data:    slack.SlashCommand{Command: "/cron", Text: "pause digest", ChannelID: "C1", UserID: user},
+		}
+	}
+
+	select {
+	case msg := <-got:
+		if msg.UserID != "U1" {
+			t.Errorf("expected only the allowlisted user, got %q", msg.UserID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for handler")
+	}
+	select {
+	case msg := <-got:
+		t.Errorf("unexpected command from %q", msg.UserID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
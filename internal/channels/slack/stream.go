@@ -0,0 +1,49 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// editInterval keeps streamed edits within the chat.update rate limit of
+// about 50 calls per minute.
+const editInterval = 1500 * time.Millisecond
+
+// streamEditor posts and edits a streamed reply in a channel or thread.
+type streamEditor struct {
+	api       Client
+	channelID string
+	threadTS  string
+}
+
+// Post sends a new message and returns its timestamp.
+func (e *streamEditor) Post(_ context.Context, text string) (string, error) {
+	options := []slack.MsgOption{slack.MsgOptionText(FormatMrkdwn(text), false)}
+	if e.threadTS != "" {
+		options = append(options, slack.MsgOptionTS(e.threadTS))
+	}
+	_, ts, err := e.api.PostMessage(e.channelID, options...)
+	if err != nil {
+		return "", fmt.Errorf("post streamed reply: %w", err)
+	}
+	return ts, nil
+}
+
+// Edit replaces the text of a posted message.
+func (e *streamEditor) Edit(_ context.Context, messageTS, text string) error {
+	if _, _, _, err := e.api.UpdateMessage(e.channelID, messageTS, slack.MsgOptionText(FormatMrkdwn(text), false)); err != nil {
+		return fmt.Errorf("edit streamed reply: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a posted message.
+func (e *streamEditor) Delete(_ context.Context, messageTS string) error {
+	if _, _, err := e.api.DeleteMessage(e.channelID, messageTS); err != nil {
+		return fmt.Errorf("delete streamed reply: %w", err)
+	}
+	return nil
+}
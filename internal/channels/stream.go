@@ -0,0 +1,235 @@
+package channels
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/logging"
+)
+
+var logger = logging.SubsystemSugar("channels")
+
+// DefaultStreamInterval is the minimum time between edits of a streamed reply
+// when a channel does not set its own.
+const DefaultStreamInterval = time.Second
+
+// MessageEditor posts and edits the messages of a streamed reply. Text is
+// standard Markdown; editors convert it to the platform format.
+type MessageEditor interface {
+	// Post sends a new message and returns its ID.
+	Post(ctx context.Context, text string) (string, error)
+
+	// Edit replaces the text of a message posted earlier.
+	Edit(ctx context.Context, messageID, text string) error
+
+	// Delete removes a message posted earlier.
+	Delete(ctx context.Context, messageID string) error
+}
+
+// StreamOptions configures a Stream.
+type StreamOptions struct {
+	// Interval is the minimum time between edits, to stay within the
+	// platform's rate limits. Default DefaultStreamInterval.
+	Interval time.Duration
+
+	// Split breaks text that is too long for one message into chunks; the
+	// chunks after the first become continuation messages. Nil keeps the
+	// whole reply in one message.
+	Split func(text string) []string
+
+	// MessageID is an already posted placeholder that becomes the first
+	// message of the reply, if any.
+	MessageID string
+}
+
+// Stream delivers a reply progressively: it posts a message as soon as the
+// first text arrives and edits it as more follows, at most once per
+// interval. A status line, such as the tool currently running, is shown
+// below the text until the next status or the final reply.
+//
+// Channels create a Stream for each incoming message and hand it to the
+// handler through IncomingMessage.Stream. When the handler returns, they
+// call Finish with the final reply if the stream has started, and Stop
+// otherwise.
+type Stream struct {
+	ctx    context.Context
+	editor MessageEditor
+	opts   StreamOptions
+
+	// flushMu serializes posting and editing.
+	flushMu sync.Mutex
+	ids     []string // posted message IDs, one per chunk
+	shown   []string // text currently shown in each message
+
+	mu      sync.Mutex
+	text    strings.Builder
+	status  string
+	last    time.Time
+	timer   *time.Timer
+	stopped bool
+}
+
+// NewStream creates a stream that posts and edits messages through editor.
+func NewStream(ctx context.Context, editor MessageEditor, opts StreamOptions) *Stream {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultStreamInterval
+	}
+	s := &Stream{ctx: ctx, editor: editor, opts: opts}
+	if opts.MessageID != "" {
+		s.ids = []string{opts.MessageID}
+		s.shown = []string{""}
+	}
+	return s
+}
+
+// Write appends a chunk of reply text.
+func (s *Stream) Write(chunk string) {
+	if chunk == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.text.WriteString(chunk)
+	s.scheduleLocked()
+}
+
+// Status replaces the status line. An empty line removes it.
+func (s *Stream) Status(line string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.status = line
+	s.scheduleLocked()
+}
+
+// Started reports whether the stream has a message to edit, i.e. whether
+// the reply must be delivered with Finish instead of a new message.
+func (s *Stream) Started() bool {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	return len(s.ids) > 0
+}
+
+// Stop cancels pending edits and waits for one in progress. The messages
+// posted so far are left as is.
+func (s *Stream) Stop() {
+	s.mu.Lock()
+	s.stopLocked()
+	s.mu.Unlock()
+
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+}
+
+// Finish replaces the streamed messages with the final reply text, posting
+// continuation messages for chunks beyond those already shown and deleting
+// streamed messages the final reply no longer needs.
+func (s *Stream) Finish(ctx context.Context, text string) error {
+	s.Stop()
+
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	return s.apply(ctx, text)
+}
+
+// scheduleLocked arranges an edit once the interval since the last one has
+// passed. s.mu must be held.
+func (s *Stream) scheduleLocked() {
+	if s.stopped || s.timer != nil {
+		return
+	}
+	delay := s.opts.Interval - time.Since(s.last)
+	if delay < 0 {
+		delay = 0
+	}
+	s.timer = time.AfterFunc(delay, s.flush)
+}
+
+// stopLocked cancels pending edits. s.mu must be held.
+func (s *Stream) stopLocked() {
+	s.stopped = true
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+}
+
+// flush shows the text and status collected so far.
+func (s *Stream) flush() {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+
+	s.mu.Lock()
+	if s.stopped {
+		s.mu.Unlock()
+		return
+	}
+	s.timer = nil
+	s.last = time.Now()
+	text := s.renderLocked()
+	s.mu.Unlock()
+
+	if err := s.apply(s.ctx, text); err != nil {
+		logger.Warnw("stream reply update failed", "error", err)
+	}
+}
+
+// apply makes the posted messages show text, editing changed chunks,
+// posting new ones and deleting surplus ones. s.flushMu must be held.
+func (s *Stream) apply(ctx context.Context, text string) error {
+	if strings.TrimSpace(text) == "" {
+		return nil
+	}
+	chunks := []string{text}
+	if s.opts.Split != nil {
+		chunks = s.opts.Split(text)
+	}
+
+	for i, chunk := range chunks {
+		if i < len(s.ids) {
+			if s.shown[i] == chunk {
+				continue
+			}
+			if err := s.editor.Edit(ctx, s.ids[i], chunk); err != nil {
+				return err
+			}
+			s.shown[i] = chunk
+			continue
+		}
+		id, err := s.editor.Post(ctx, chunk)
+		if err != nil {
+			return err
+		}
+		s.ids = append(s.ids, id)
+		s.shown = append(s.shown, chunk)
+	}
+
+	for len(s.ids) > len(chunks) {
+		last := len(s.ids) - 1
+		if err := s.editor.Delete(ctx, s.ids[last]); err != nil {
+			return err
+		}
+		s.ids, s.shown = s.ids[:last], s.shown[:last]
+	}
+	return nil
+}
+
+// renderLocked combines the reply text so far with the status line. The
+// status is left out when it would start a continuation message of its own:
+// that message would notify the chat only to be deleted again by Finish.
+// s.mu must be held.
+func (s *Stream) renderLocked() string {
+	text := s.text.String()
+	switch {
+	case s.status == "":
+		return text
+	case strings.TrimSpace(text) == "":
+		return "_" + s.status + "_"
+	}
+	draft := text + "\n\n_" + s.status + "_"
+	if s.opts.Split != nil && len(s.opts.Split(draft)) > len(s.opts.Split(text)) {
+		return text
+	}
+	return draft
+}
@@ -0,0 +1,172 @@
+package channels
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEditor struct {
+	mu      sync.Mutex
+	posts   []string
+	edits   []string // "id=text"
+	deleted []string
+	latest  map[string]string
+}
+
+func (f *fakeEditor) Post(_ context.Context, text string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := fmt.Sprintf("m%d", len(f.posts)+1)
+	f.posts = append(f.posts, text)
+	if f.latest == nil {
+		f.latest = make(map[string]string)
+	}
+	f.latest[id] = text
+	return id, nil
+}
+
+func (f *fakeEditor) Edit(_ context.Context, id, text string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.edits = append(f.edits, id+"="+text)
+	if f.latest == nil {
+		f.latest = make(map[string]string)
+	}
+	f.latest[id] = text
+	return nil
+}
+
+func (f *fakeEditor) Delete(_ context.Context, id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deleted = append(f.deleted, id)
+	delete(f.latest, id)
+	return nil
+}
+
+func (f *fakeEditor) shown(id string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.latest[id]
+}
+
+func (f *fakeEditor) counts() (int, int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.posts), len(f.edits)
+}
+
+// splitAt splits text into chunks of at most n bytes.
+func splitAt(n int) func(string) []string {
+	return func(text string) []string {
+		var chunks []string
+		for len(text) > n {
+			chunks = append(chunks, text[:n])
+			text = text[n:]
+		}
+		return append(chunks, text)
+	}
+}
+
+func TestStream_PostsThenEdits(t *testing.T) {
+	ed := &fakeEditor{}
+	s := NewStream(context.Background(), ed, StreamOptions{Interval: 20 * time.Millisecond})
+
+	s.Write("Hello")
+	require.Eventually(t, func() bool { return ed.shown("m1") == "Hello" }, time.Second, 5*time.Millisecond)
+
+	s.Write(", ")
+	s.Write("world")
+	require.Eventually(t, func() bool { return ed.shown("m1") == "Hello, world" }, time.Second, 5*time.Millisecond)
+
+	posts, edits := ed.counts()
+	assert.Equal(t, 1, posts)
+	assert.Equal(t, 1, edits, "writes within one interval are coalesced into one edit")
+
+	require.NoError(t, s.Finish(context.Background(), "Hello, world!"))
+	assert.Equal(t, "Hello, world!", ed.shown("m1"))
+}
+
+func TestStream_FinishSplitsIntoContinuations(t *testing.T) {
+	ed := &fakeEditor{}
+	s := NewStream(context.Background(), ed, StreamOptions{Interval: time.Millisecond, Split: splitAt(5)})
+
+	s.Write("abc")
+	require.Eventually(t, s.Started, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, s.Finish(context.Background(), "abcdefghijkl"))
+	assert.Equal(t, "abcde", ed.shown("m1"))
+	assert.Equal(t, "fghij", ed.shown("m2"))
+	assert.Equal(t, "kl", ed.shown("m3"))
+}
+
+func TestStream_FinishDeletesSurplusMessages(t *testing.T) {
+	ed := &fakeEditor{}
+	s := NewStream(context.Background(), ed, StreamOptions{Interval: time.Millisecond, Split: splitAt(5)})
+
+	s.Write("abcdefghijkl")
+	require.Eventually(t, func() bool { return ed.shown("m3") == "kl" }, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, s.Finish(context.Background(), "short"))
+	assert.Equal(t, "short", ed.shown("m1"))
+	assert.Equal(t, []string{"m3", "m2"}, ed.deleted)
+}
+
+func TestStream_Placeholder(t *testing.T) {
+	ed := &fakeEditor{}
+	s := NewStream(context.Background(), ed, StreamOptions{Interval: time.Millisecond, MessageID: "ph"})
+	assert.True(t, s.Started())
+
+	s.Write("partial")
+	require.Eventually(t, func() bool { return ed.shown("ph") == "partial" }, time.Second, 5*time.Millisecond)
+
+	require.NoError(t, s.Finish(context.Background(), "done"))
+	posts, _ := ed.counts()
+	assert.Equal(t, 0, posts)
+	assert.Equal(t, "done", ed.shown("ph"))
+}
+
+func TestStream_Status(t *testing.T) {
+	ed := &fakeEditor{}
+	s := NewStream(context.Background(), ed, StreamOptions{Interval: time.Millisecond, Split: splitAt(20)})
+
+	s.Status("running exec…")
+	require.Eventually(t, func() bool { return ed.shown("m1") == "_running exec…_" }, time.Second, 5*time.Millisecond)
+
+	s.Status("")
+	s.Write("Files:")
+	s.Status("running ls")
+	require.Eventually(t, func() bool { return ed.shown("m1") == "Files:\n\n_running ls_" }, time.Second, 5*time.Millisecond)
+
+	// A status that would need a continuation message of its own is left out.
+	s.Write(" a b c d e")
+	s.Status("running something long")
+	require.Eventually(t, func() bool { return ed.shown("m1") == "Files: a b c d e" }, time.Second, 5*time.Millisecond)
+	posts, _ := ed.counts()
+	assert.Equal(t, 1, posts)
+}
+
+func TestStream_StopCancelsEdits(t *testing.T) {
+	ed := &fakeEditor{}
+	s := NewStream(context.Background(), ed, StreamOptions{Interval: time.Hour})
+
+	s.Write("first")
+	require.Eventually(t, s.Started, time.Second, 5*time.Millisecond)
+
+	s.Write(" second") // scheduled an hour from now
+	s.Stop()
+	assert.False(t, strings.Contains(ed.shown("m1"), "second"))
+
+	s.Write(" third")
+	time.Sleep(20 * time.Millisecond)
+	posts, edits := ed.counts()
+	assert.Equal(t, 1, posts)
+	assert.Equal(t, 0, edits)
+}
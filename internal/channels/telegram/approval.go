@@ -10,6 +10,7 @@ import (
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
 )
 
 // approvalPending holds the response channel and message metadata for a pending approval.
@@ -189,9 +190,9 @@ func isMessageNotModifiedErr(err error) bool {
 
 // parseTelegramChatID extracts the chatID from a session key like "telegram:<chatID>:<userID>".
 func parseTelegramChatID(sessionKey string) (int64, error) {
-	parts := strings.SplitN(sessionKey, ":", 3)
-	if len(parts) < 2 {
+	parts, err := channels.ParseSessionKey(sessionKey)
+	if err != nil {
 		return 0, fmt.Errorf("invalid telegram session key: %s", sessionKey)
 	}
-	return strconv.ParseInt(parts[1], 10, 64)
+	return strconv.ParseInt(parts.ChatID, 10, 64)
 }
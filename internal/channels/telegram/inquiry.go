@@ -0,0 +1,185 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/librarian"
+)
+
+// Callback data prefixes for inquiry quick replies.
+const (
+	inquiryAnswerPrefix  = "inquiry_answer:"
+	inquiryDismissPrefix = "inquiry_dismiss:"
+)
+
+// InquiryProvider implements librarian.InquiryNotifier for Telegram using
+// InlineKeyboard quick-reply buttons.
+type InquiryProvider struct {
+	bot      BotAPI
+	allowed  func(chatID, userID int64) bool
+	resolver librarian.InquiryResolver
+}
+
+var _ librarian.InquiryNotifier = (*InquiryProvider)(nil)
+
+// NewInquiryProvider creates a Telegram inquiry provider. Only users that
+// pass allowed can answer inquiries.
+func NewInquiryProvider(bot BotAPI, allowed func(chatID, userID int64) bool) *InquiryProvider {
+	return &InquiryProvider{bot: bot, allowed: allowed}
+}
+
+// SetResolver sets the resolver that handles quick-reply button presses.
+func (p *InquiryProvider) SetResolver(r librarian.InquiryResolver) {
+	p.resolver = r
+}
+
+// NotifyInquiry sends the inquiry to the chat with one button per option and a skip button.
+func (p *InquiryProvider) NotifyInquiry(_ context.Context, inq librarian.Inquiry) error {
+	chatID, err := parseTelegramChatID(inq.SessionKey)
+	if err != nil {
+		return fmt.Errorf("parse session key: %w", err)
+	}
+
+	id := inq.ID.String()
+	rows := make([][]tgbotapi.InlineKeyboardButton, 0, len(inq.Options)+1)
+	for i, opt := range inq.Options {
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(opt, inquiryAnswerPrefix+id+":"+strconv.Itoa(i)),
+		))
+	}
+	rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData("⏭ Skip", inquiryDismissPrefix+id),
+	))
+
+	text := "💡 " + inq.Question
+	if inq.Context != "" {
+		text += "\n\n" + inq.Context
+	}
+	if len(inq.Options) > 0 {
+		text += "\n\nTap an answer or reply in chat."
+	}
+	msg := tgbotapi.NewMessage(chatID, text)
+	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(rows...)
+
+	if _, err := p.bot.Send(msg); err != nil {
+		return fmt.Errorf("send inquiry message: %w", err)
+	}
+	return nil
+}
+
+// HandleCallback processes an inquiry quick-reply callback. It returns false
+// when the callback does not belong to an inquiry. Presses by users outside
+// the allowlist or the inquiry's session are ignored.
+func (p *InquiryProvider) HandleCallback(query *tgbotapi.CallbackQuery) bool {
+	if query == nil {
+		return false
+	}
+
+	var (
+		id     string
+		option = -1
+	)
+	switch {
+	case strings.HasPrefix(query.Data, inquiryAnswerPrefix):
+		rest := strings.TrimPrefix(query.Data, inquiryAnswerPrefix)
+		idx := strings.LastIndex(rest, ":")
+		if idx < 0 {
+			return true
+		}
+		n, err := strconv.Atoi(rest[idx+1:])
+		if err != nil {
+			return true
+		}
+		id, option = rest[:idx], n
+	case strings.HasPrefix(query.Data, inquiryDismissPrefix):
+		id = strings.TrimPrefix(query.Data, inquiryDismissPrefix)
+	default:
+		return false
+	}
+
+	// Answer callback to dismiss the loading indicator.
+	if _, err := p.bot.Request(tgbotapi.NewCallback(query.ID, "")); err != nil {
+		if !isCallbackExpiredErr(err) {
+			logger().Debugw("answer inquiry callback error", "error", err)
+		}
+	}
+
+	inquiryID, err := uuid.Parse(id)
+	if err != nil || p.resolver == nil {
+		return true
+	}
+
+	ctx := context.Background()
+	if !p.mayAnswer(ctx, query, inquiryID) {
+		return true
+	}
+
+	var status string
+	if option >= 0 {
+		inq, err := p.resolver.AnswerInquiry(ctx, inquiryID, option)
+		if err != nil {
+			logger().Warnw("answer inquiry error", "inquiryId", id, "error", err)
+			return true
+		}
+		status = "✅ " + inq.Answer
+	} else {
+		if err := p.resolver.DismissInquiry(ctx, inquiryID); err != nil {
+			logger().Warnw("dismiss inquiry error", "inquiryId", id, "error", err)
+			return true
+		}
+		status = "⏭ Skipped"
+	}
+
+	if query.Message != nil {
+		text := status
+		if question, _, _ := strings.Cut(query.Message.Text, "\n"); question != "" {
+			text = question + "\n\n" + status
+		}
+		emptyMarkup := tgbotapi.InlineKeyboardMarkup{
+			InlineKeyboard: [][]tgbotapi.InlineKeyboardButton{},
+		}
+		edit := tgbotapi.NewEditMessageTextAndMarkup(query.Message.Chat.ID, query.Message.MessageID, text, emptyMarkup)
+		if _, err := p.bot.Send(edit); err != nil && !isMessageNotModifiedErr(err) {
+			logger().Warnw("edit inquiry message error", "error", err)
+		}
+	}
+	return true
+}
+
+// CanHandle returns true for session keys starting with "telegram:".
+func (p *InquiryProvider) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, "telegram:")
+}
+
+// mayAnswer reports whether the user who pressed the button may answer the
+// inquiry: they must be allowlisted and belong to the inquiry's session.
+func (p *InquiryProvider) mayAnswer(ctx context.Context, query *tgbotapi.CallbackQuery, inquiryID uuid.UUID) bool {
+	if query.From == nil {
+		return false
+	}
+	var chatID int64
+	if query.Message != nil && query.Message.Chat != nil {
+		chatID = query.Message.Chat.ID
+	}
+	if !p.allowed(chatID, query.From.ID) {
+		logger().Warnw("inquiry reply from non-allowed user ignored", "userId", query.From.ID, "chatId", chatID)
+		return false
+	}
+	inq, err := p.resolver.GetInquiry(ctx, inquiryID)
+	if err != nil {
+		logger().Warnw("get inquiry error", "inquiryId", inquiryID, "error", err)
+		return false
+	}
+	if !channels.IsSessionUser(inq.SessionKey, strconv.FormatInt(query.From.ID, 10)) {
+		logger().Warnw("inquiry reply from another user ignored", "inquiryId", inquiryID, "userId", query.From.ID)
+		return false
+	}
+	return true
+}
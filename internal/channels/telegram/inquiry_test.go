@@ -0,0 +1,148 @@
+package telegram
+
+import (
+	"context"
+	"testing"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/librarian"
+)
+
+type fakeInquiryResolver struct {
+	sessionKey string
+	answered   map[uuid.UUID]int
+	dismissed  []uuid.UUID
+}
+
+func (f *fakeInquiryResolver) GetInquiry(_ context.Context, id uuid.UUID) (*librarian.Inquiry, error) {
+	return &librarian.Inquiry{ID: id, SessionKey: f.sessionKey}, nil
+}
+
+func (f *fakeInquiryResolver) AnswerInquiry(_ context.Context, id uuid.UUID, option int) (*librarian.Inquiry, error) {
+	if f.answered == nil {
+		f.answered = make(map[uuid.UUID]int)
+	}
+	f.answered[id] = option
+	return &librarian.Inquiry{ID: id, Question: "Where do you deploy?", Answer: "GCP"}, nil
+}
+
+func (f *fakeInquiryResolver) DismissInquiry(_ context.Context, id uuid.UUID) error {
+	f.dismissed = append(f.dismissed, id)
+	return nil
+}
+
+func TestInquiryProvider_NotifyInquiry(t *testing.T) {
+	bot := &MockApprovalBotAPI{}
+	p := NewInquiryProvider(bot, allowAll)
+
+	id := uuid.New()
+	err := p.NotifyInquiry(context.Background(), librarian.Inquiry{
+		ID:         id,
+		SessionKey: "telegram:123:456",
+		Question:   "Where do you deploy?",
+		Options:    []string{"AWS", "GCP"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(bot.SentMessages) != 1 {
+		t.Fatalf("want 1 sent message, got %d", len(bot.SentMessages))
+	}
+	msg, ok := bot.SentMessages[0].(tgbotapi.MessageConfig)
+	if !ok {
+		t.Fatalf("unexpected message type %T", bot.SentMessages[0])
+	}
+	if msg.ChatID != 123 {
+		t.Errorf("want chat 123, got %d", msg.ChatID)
+	}
+	markup, ok := msg.ReplyMarkup.(tgbotapi.InlineKeyboardMarkup)
+	if !ok {
+		t.Fatalf("unexpected markup type %T", msg.ReplyMarkup)
+	}
+	if len(markup.InlineKeyboard) != 3 {
+		t.Fatalf("want 3 keyboard rows, got %d", len(markup.InlineKeyboard))
+	}
+	if got := *markup.InlineKeyboard[1][0].CallbackData; got != inquiryAnswerPrefix+id.String()+":1" {
+		t.Errorf("unexpected callback data %q", got)
+	}
+	if got := *markup.InlineKeyboard[2][0].CallbackData; got != inquiryDismissPrefix+id.String() {
+		t.Errorf("unexpected skip callback data %q", got)
+	}
+}
+
+func allowAll(_, _ int64) bool { return true }
+
+func TestInquiryProvider_HandleCallback(t *testing.T) {
+	bot := &MockApprovalBotAPI{}
+	resolver := &fakeInquiryResolver{sessionKey: "telegram:123:456"}
+	p := NewInquiryProvider(bot, allowAll)
+	p.SetResolver(resolver)
+
+	answerID := uuid.New()
+	handled := p.HandleCallback(&tgbotapi.CallbackQuery{
+		ID:   "cb-1",
+		From: &tgbotapi.User{ID: 456},
+		Data: inquiryAnswerPrefix + answerID.String() + ":1",
+		Message: &tgbotapi.Message{
+			MessageID: 100,
+			Chat:      &tgbotapi.Chat{ID: 123},
+			Text:      "💡 Where do you deploy?\n\nTap an answer or reply in chat.",
+		},
+	})
+	if !handled {
+		t.Fatal("expected answer callback to be handled")
+	}
+	if got, ok := resolver.answered[answerID]; !ok || got != 1 {
+		t.Errorf("want option 1 answered, got %d (found=%v)", got, ok)
+	}
+	edit, ok := bot.SentMessages[len(bot.SentMessages)-1].(tgbotapi.EditMessageTextConfig)
+	if !ok {
+		t.Fatalf("expected message edit, got %T", bot.SentMessages[len(bot.SentMessages)-1])
+	}
+	if want := "💡 Where do you deploy?\n\n✅ GCP"; edit.Text != want {
+		t.Errorf("want edit text %q, got %q", want, edit.Text)
+	}
+
+	dismissID := uuid.New()
+	if !p.HandleCallback(&tgbotapi.CallbackQuery{ID: "cb-2", From: &tgbotapi.User{ID: 456}, Data: inquiryDismissPrefix + dismissID.String()}) {
+		t.Fatal("expected dismiss callback to be handled")
+	}
+	if len(resolver.dismissed) != 1 || resolver.dismissed[0] != dismissID {
+		t.Errorf("unexpected dismissed %v", resolver.dismissed)
+	}
+
+	if p.HandleCallback(&tgbotapi.CallbackQuery{ID: "cb-3", Data: "approve:req-1"}) {
+		t.Error("approval callback must not be handled by inquiry provider")
+	}
+}
+
+func TestInquiryProvider_HandleCallbackIgnoresOtherUsers(t *testing.T) {
+	tests := []struct {
+		give    string
+		from    int64
+		allowed func(chatID, userID int64) bool
+	}{
+		{give: "other session user", from: 789, allowed: allowAll},
+		{give: "not allowlisted", from: 456, allowed: func(_, _ int64) bool { return false }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			resolver := &fakeInquiryResolver{sessionKey: "telegram:123:456"}
+			p := NewInquiryProvider(&MockApprovalBotAPI{}, tt.allowed)
+			p.SetResolver(resolver)
+
+			id := uuid.New()
+			for _, data := range []string{inquiryAnswerPrefix + id.String() + ":0", inquiryDismissPrefix + id.String()} {
+				if !p.HandleCallback(&tgbotapi.CallbackQuery{ID: "cb", From: &tgbotapi.User{ID: tt.from}, Data: data}) {
+					t.Fatalf("expected %q to be handled", data)
+				}
+			}
+			if len(resolver.answered) != 0 || len(resolver.dismissed) != 0 {
+				t.Errorf("press must be ignored, got answered=%v dismissed=%v", resolver.answered, resolver.dismissed)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package telegram
+
+import (
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+func init() {
+	channels.Register(types.ChannelTelegram, fromConfig)
+}
+
+// fromConfig creates the Telegram channel from the application config.
+func fromConfig(cfg *config.Config) (channels.Channel, error) {
+	tg := cfg.Channels.Telegram
+	if !tg.Enabled {
+		return nil, channels.ErrDisabled
+	}
+	return New(Config{
+		BotToken:           tg.BotToken,
+		Allowlist:          tg.Allowlist,
+		ApprovalTimeoutSec: cfg.Security.Interceptor.ApprovalTimeoutSec,
+	})
+}
@@ -0,0 +1,65 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+)
+
+// editInterval keeps streamed edits within Telegram's limit of about one
+// message per second per chat.
+const editInterval = time.Second
+
+// streamEditor posts and edits a streamed reply in a chat. Partial Markdown
+// often fails to parse, so text the API rejects is sent as plain text.
+type streamEditor struct {
+	bot    BotAPI
+	chatID int64
+}
+
+// Post sends a new message and returns its ID.
+func (e *streamEditor) Post(_ context.Context, text string) (string, error) {
+	msg := tgbotapi.NewMessage(e.chatID, FormatMarkdown(text))
+	msg.ParseMode = "Markdown"
+	sent, err := e.bot.Send(msg)
+	if err != nil {
+		msg.Text, msg.ParseMode = text, ""
+		if sent, err = e.bot.Send(msg); err != nil {
+			return "", fmt.Errorf("post streamed reply: %w", err)
+		}
+	}
+	return strconv.Itoa(sent.MessageID), nil
+}
+
+// Edit replaces the text of a posted message.
+func (e *streamEditor) Edit(_ context.Context, messageID, text string) error {
+	id, err := strconv.Atoi(messageID)
+	if err != nil {
+		return fmt.Errorf("parse telegram message ID %q: %w", messageID, err)
+	}
+	edit := tgbotapi.NewEditMessageText(e.chatID, id, FormatMarkdown(text))
+	edit.ParseMode = "Markdown"
+	if _, err := e.bot.Send(edit); err == nil || isMessageNotModifiedErr(err) {
+		return nil
+	}
+	edit.Text, edit.ParseMode = text, ""
+	if _, err := e.bot.Send(edit); err != nil && !isMessageNotModifiedErr(err) {
+		return fmt.Errorf("edit streamed reply: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a posted message.
+func (e *streamEditor) Delete(_ context.Context, messageID string) error {
+	id, err := strconv.Atoi(messageID)
+	if err != nil {
+		return fmt.Errorf("parse telegram message ID %q: %w", messageID, err)
+	}
+	if _, err := e.bot.Request(tgbotapi.NewDeleteMessage(e.chatID, id)); err != nil {
+		return fmt.Errorf("delete streamed reply: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -11,7 +12,10 @@ import (
 	"go.uber.org/zap"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/types"
 )
 
 func logger() *zap.SugaredLogger { return logging.Channel().Named("telegram") }
@@ -26,36 +30,17 @@ type Config struct {
 	Bot                BotAPI       // optional, for testing
 }
 
-// MessageHandler handles incoming messages
-type MessageHandler func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error)
-
-// IncomingMessage represents a message from Telegram
-type IncomingMessage struct {
-	MessageID   int
-	ChatID      int64
-	UserID      int64
-	Username    string
-	Text        string
-	ReplyToID   int
-	HasMedia    bool
-	MediaType   string
-	MediaFileID string
-}
-
-// OutgoingMessage represents a message to send
-type OutgoingMessage struct {
-	Text           string
-	ReplyToID      int
-	ParseMode      string // "Markdown", "HTML"
-	DisablePreview bool
-}
+// maxMessageLength is the Telegram message size limit.
+const maxMessageLength = 4096
 
 // Channel implements Telegram bot
 type Channel struct {
 	config   Config
 	bot      BotAPI
-	handler  MessageHandler
+	handler  channels.Handler
+	commands []channels.Command
 	approval *ApprovalProvider
+	inquiry  *InquiryProvider
 	stopChan chan struct{}
 	wg       sync.WaitGroup
 }
@@ -95,26 +80,60 @@ func New(cfg Config) (*Channel, error) {
 		stopChan: make(chan struct{}),
 	}
 	ch.approval = NewApprovalProvider(botAPI, time.Duration(cfg.ApprovalTimeoutSec)*time.Second)
+	ch.inquiry = NewInquiryProvider(botAPI, ch.isAllowed)
 
 	return ch, nil
 }
 
+var (
+	_ channels.InquiryChannel    = (*Channel)(nil)
+	_ channels.AttachmentChannel = (*Channel)(nil)
+	_ channels.CommandChannel    = (*Channel)(nil)
+)
+
+// Type returns types.ChannelTelegram.
+func (c *Channel) Type() types.ChannelType {
+	return types.ChannelTelegram
+}
+
+// Capabilities reports the features supported by Telegram.
+func (c *Channel) Capabilities() channels.Capabilities {
+	return channels.Capabilities{
+		MaxMessageLength: maxMessageLength,
+		Attachments:      true,
+		Buttons:          true,
+	}
+}
+
 // SetHandler sets the message handler
-func (c *Channel) SetHandler(handler MessageHandler) {
+func (c *Channel) SetHandler(handler channels.Handler) {
 	c.handler = handler
 }
 
-// GetApprovalProvider returns the channel's approval provider for composite registration.
-func (c *Channel) GetApprovalProvider() *ApprovalProvider {
+// ApprovalProvider returns the channel's approval provider for composite registration.
+func (c *Channel) ApprovalProvider() approval.Provider {
 	return c.approval
 }
 
+// InquiryProvider returns the channel's inquiry provider for librarian notifier registration.
+func (c *Channel) InquiryProvider() channels.InquiryProvider {
+	return c.inquiry
+}
+
+// SetCommands sets the slash commands published in the bot's command menu.
+// Commands arrive as ordinary messages and are answered by the handler.
+func (c *Channel) SetCommands(cmds []channels.Command) {
+	c.commands = cmds
+}
+
 // Start starts listening for updates
 func (c *Channel) Start(ctx context.Context) error {
 	if c.handler == nil {
 		return fmt.Errorf("message handler not set")
 	}
 
+	c.registerCommands()
+
 	u := tgbotapi.NewUpdate(0)
 	u.Timeout = 60
 
@@ -132,7 +151,9 @@ func (c *Channel) Start(ctx context.Context) error {
 				return
 			case update := <-updates:
 				if update.CallbackQuery != nil {
-					c.approval.HandleCallback(update.CallbackQuery)
+					if !c.inquiry.HandleCallback(update.CallbackQuery) {
+						c.approval.HandleCallback(update.CallbackQuery)
+					}
 					continue
 				}
 
@@ -166,61 +187,109 @@ func (c *Channel) Start(ctx context.Context) error {
 func (c *Channel) handleUpdate(ctx context.Context, update tgbotapi.Update) {
 	msg := update.Message
 
-	incoming := &IncomingMessage{
-		MessageID: msg.MessageID,
-		ChatID:    msg.Chat.ID,
-		UserID:    msg.From.ID,
-		Username:  msg.From.UserName,
-		Text:      msg.Text,
+	incoming := &channels.IncomingMessage{
+		Channel:     types.ChannelTelegram,
+		MessageID:   strconv.Itoa(msg.MessageID),
+		ChatID:      strconv.FormatInt(msg.Chat.ID, 10),
+		UserID:      strconv.FormatInt(msg.From.ID, 10),
+		Username:    msg.From.UserName,
+		Text:        msg.Text,
+		Attachments: attachments(msg),
+		IsDM:        msg.Chat.IsPrivate(),
 	}
-
-	if msg.ReplyToMessage != nil {
-		incoming.ReplyToID = msg.ReplyToMessage.MessageID
+	if incoming.Text == "" {
+		incoming.Text = msg.Caption
 	}
 
-	// Check for media
-	if len(msg.Photo) > 0 {
-		incoming.HasMedia = true
-		incoming.MediaType = "photo"
-		incoming.MediaFileID = msg.Photo[len(msg.Photo)-1].FileID
-	} else if msg.Document != nil {
-		incoming.HasMedia = true
-		incoming.MediaType = "document"
-		incoming.MediaFileID = msg.Document.FileID
-	} else if msg.Voice != nil {
-		incoming.HasMedia = true
-		incoming.MediaType = "voice"
-		incoming.MediaFileID = msg.Voice.FileID
+	if msg.ReplyToMessage != nil {
+		incoming.ReplyToID = strconv.Itoa(msg.ReplyToMessage.MessageID)
 	}
 
 	logger().Infow("received message",
-		"messageId", incoming.MessageID,
-		"chatId", incoming.ChatID,
-		"userId", incoming.UserID,
+		"messageId", msg.MessageID,
+		"chatId", msg.Chat.ID,
+		"userId", msg.From.ID,
 	)
 
+	stream := channels.NewStream(ctx, &streamEditor{bot: c.bot, chatID: msg.Chat.ID}, channels.StreamOptions{
+		Interval: editInterval,
+		Split:    func(text string) []string { return c.splitMessage(text, maxMessageLength) },
+	})
+	defer stream.Stop()
+	incoming.Stream = stream
+
 	// Show typing indicator while processing
-	stopThinking := c.startTyping(incoming.ChatID)
+	stopThinking := c.startTyping(ctx, msg.Chat.ID)
 	response, err := c.handler(ctx, incoming)
 	stopThinking()
 
 	if err != nil {
 		logger().Errorw("handler error", "error", err)
-		c.sendError(incoming.ChatID, msg.MessageID, err)
+		c.sendError(msg.Chat.ID, msg.MessageID, err)
 		return
 	}
 
 	if response != nil {
-		if err := c.Send(incoming.ChatID, response); err != nil {
+		// Replace the streamed reply, or send a new one if nothing was streamed.
+		stream.Stop()
+		if stream.Started() {
+			err = stream.Finish(ctx, response.Text)
+		} else {
+			err = c.Send(ctx, incoming.ChatID, response)
+		}
+		if err != nil {
 			logger().Errorw("send error", "error", err)
 		}
 	}
 }
 
-// StartTyping sends a typing indicator to the chat and refreshes it
+// attachments converts the media of a Telegram message to attachments.
+func attachments(msg *tgbotapi.Message) []channels.Attachment {
+	switch {
+	case len(msg.Photo) > 0:
+		photo := msg.Photo[len(msg.Photo)-1] // largest size
+		return []channels.Attachment{{
+			Type:     channels.AttachmentImage,
+			FileID:   photo.FileID,
+			Name:     "photo.jpg",
+			MimeType: "image/jpeg", // Telegram re-encodes photos as JPEG
+			Size:     int64(photo.FileSize),
+		}}
+	case msg.Document != nil:
+		return []channels.Attachment{{
+			Type:     channels.AttachmentDocument,
+			FileID:   msg.Document.FileID,
+			Name:     msg.Document.FileName,
+			MimeType: msg.Document.MimeType,
+			Size:     int64(msg.Document.FileSize),
+		}}
+	case msg.Voice != nil:
+		return []channels.Attachment{{
+			Type:     channels.AttachmentAudio,
+			FileID:   msg.Voice.FileID,
+			Name:     "voice.ogg",
+			MimeType: msg.Voice.MimeType,
+			Size:     int64(msg.Voice.FileSize),
+		}}
+	}
+	return nil
+}
+
+// StartTyping sends a typing indicator to the chat. An empty chatID selects
+// the first allowlisted chat.
+func (c *Channel) StartTyping(ctx context.Context, chatID string) func() {
+	id, err := c.resolveChatID(chatID)
+	if err != nil {
+		logger().Warnw("typing indicator skipped", "error", err)
+		return func() {}
+	}
+	return c.startTyping(ctx, id)
+}
+
+// startTyping sends a typing action to the chat and refreshes it
 // periodically until the returned stop function is called or ctx is cancelled.
 // The returned stop function is safe to call multiple times.
-func (c *Channel) StartTyping(ctx context.Context, chatID int64) func() {
+func (c *Channel) startTyping(ctx context.Context, chatID int64) func() {
 	action := tgbotapi.NewChatAction(chatID, tgbotapi.ChatTyping)
 	if _, err := c.bot.Request(action); err != nil {
 		logger().Warnw("typing indicator error", "error", err)
@@ -248,64 +317,58 @@ func (c *Channel) StartTyping(ctx context.Context, chatID int64) func() {
 	return func() { once.Do(func() { close(done) }) }
 }
 
-// startTyping sends a typing action to the chat and refreshes it
-// periodically until the returned stop function is called.
-func (c *Channel) startTyping(chatID int64) func() {
-	action := tgbotapi.NewChatAction(chatID, tgbotapi.ChatTyping)
-	if _, err := c.bot.Request(action); err != nil {
-		logger().Warnw("typing indicator error", "error", err)
+// Send sends a message to a chat. An empty chatID selects the first
+// allowlisted chat.
+func (c *Channel) Send(_ context.Context, chatID string, msg *channels.OutgoingMessage) error {
+	id, err := c.resolveChatID(chatID)
+	if err != nil {
+		return err
 	}
-
-	done := make(chan struct{})
-	go func() {
-		ticker := time.NewTicker(4 * time.Second)
-		defer ticker.Stop()
-		for {
-			select {
-			case <-done:
-				return
-			case <-ticker.C:
-				if _, err := c.bot.Request(action); err != nil {
-					logger().Warnw("typing indicator refresh error", "error", err)
-				}
-			}
+	var replyTo int
+	if msg.ReplyToID != "" {
+		if replyTo, err = strconv.Atoi(msg.ReplyToID); err != nil {
+			return fmt.Errorf("parse telegram reply-to message ID %q: %w", msg.ReplyToID, err)
 		}
-	}()
-
-	return func() { close(done) }
+	}
+	return c.send(id, msg.Text, replyTo)
 }
 
-// Send sends a message.
-// When ParseMode is not set, standard Markdown is auto-converted to Telegram v1
-// and sent with ParseMode "Markdown". If the API rejects the formatted text,
-// the original text is re-sent as plain text.
-func (c *Channel) Send(chatID int64, msg *OutgoingMessage) error {
-	text := msg.Text
-	parseMode := msg.ParseMode
-
-	// Auto-format: standard Markdown → Telegram v1
-	if parseMode == "" {
-		text = FormatMarkdown(msg.Text)
-		parseMode = "Markdown"
+// resolveChatID parses a chat ID, falling back to the first allowlisted ID.
+func (c *Channel) resolveChatID(chatID string) (int64, error) {
+	if chatID == "" {
+		if len(c.config.Allowlist) == 0 {
+			return 0, fmt.Errorf("telegram delivery requires a chat ID (use telegram:CHAT_ID) or at least one allowlisted chat ID")
+		}
+		return c.config.Allowlist[0], nil
+	}
+	id, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse telegram chat ID %q: %w", chatID, err)
 	}
+	return id, nil
+}
 
+// send sends a message.
+// Standard Markdown is auto-converted to Telegram v1 and sent with ParseMode
+// "Markdown". If the API rejects the formatted text, the original text is
+// re-sent as plain text.
+func (c *Channel) send(chatID int64, text string, replyTo int) error {
 	// Split long messages (Telegram limit is 4096)
-	chunks := c.splitMessage(text, 4096)
+	chunks := c.splitMessage(FormatMarkdown(text), maxMessageLength)
 
 	for i, chunk := range chunks {
 		tgMsg := tgbotapi.NewMessage(chatID, chunk)
 
-		if i == 0 && msg.ReplyToID > 0 {
-			tgMsg.ReplyToMessageID = msg.ReplyToID
+		if i == 0 && replyTo > 0 {
+			tgMsg.ReplyToMessageID = replyTo
 		}
 
-		tgMsg.ParseMode = parseMode
-		tgMsg.DisableWebPagePreview = msg.DisablePreview
+		tgMsg.ParseMode = "Markdown"
 
 		if _, err := c.bot.Send(tgMsg); err != nil {
 			// Fallback: re-send as plain text if Markdown parsing failed
 			logger().Warnw("markdown send failed, retrying as plain text", "error", err)
-			if fallbackErr := c.sendPlainText(chatID, msg, i); fallbackErr != nil {
+			if fallbackErr := c.sendPlainText(chatID, text, replyTo, i); fallbackErr != nil {
 				return fmt.Errorf("send plain text fallback: %w", fallbackErr)
 			}
 			return nil
@@ -317,18 +380,16 @@ func (c *Channel) Send(chatID int64, msg *OutgoingMessage) error {
 
 // sendPlainText re-sends the original message text without any parse mode,
 // starting from the given chunk index.
-func (c *Channel) sendPlainText(chatID int64, msg *OutgoingMessage, fromChunk int) error {
-	chunks := c.splitMessage(msg.Text, 4096)
+func (c *Channel) sendPlainText(chatID int64, text string, replyTo int, fromChunk int) error {
+	chunks := c.splitMessage(text, maxMessageLength)
 
 	for i := fromChunk; i < len(chunks); i++ {
 		tgMsg := tgbotapi.NewMessage(chatID, chunks[i])
 
-		if i == 0 && msg.ReplyToID > 0 {
-			tgMsg.ReplyToMessageID = msg.ReplyToID
+		if i == 0 && replyTo > 0 {
+			tgMsg.ReplyToMessageID = replyTo
 		}
 
-		tgMsg.DisableWebPagePreview = msg.DisablePreview
-
 		if _, err := c.bot.Send(tgMsg); err != nil {
 			return fmt.Errorf("send chunk %d: %w", i, err)
 		}
@@ -374,24 +435,40 @@ func (c *Channel) splitMessage(text string, maxLen int) []string {
 
 // sendError sends an error message
 func (c *Channel) sendError(chatID int64, replyTo int, err error) {
-	_ = c.Send(chatID, &OutgoingMessage{
-		Text:      fmt.Sprintf("❌ Error: %s", err.Error()),
-		ReplyToID: replyTo,
-	})
+	_ = c.send(chatID, fmt.Sprintf("❌ Error: %s", err.Error()), replyTo)
 }
 
 // DownloadFile downloads a file by file ID
-func (c *Channel) DownloadFile(fileID string) ([]byte, error) {
+func (c *Channel) DownloadFile(ctx context.Context, fileID string) ([]byte, error) {
 	file, err := c.bot.GetFile(tgbotapi.FileConfig{FileID: fileID})
 	if err != nil {
 		return nil, fmt.Errorf("get file: %w", err)
 	}
 
-	url := file.Link(c.config.BotToken)
-	_ = url // Would download from URL
+	endpoint := tgbotapi.FileEndpoint
+	if c.config.APIEndpoint != "" {
+		endpoint = strings.Replace(c.config.APIEndpoint, "/bot%s/", "/file/bot%s/", 1)
+	}
+	return channels.Download(ctx, c.config.HTTPClient, fmt.Sprintf(endpoint, c.config.BotToken, file.FilePath), nil)
+}
+
+// DownloadAttachment downloads the contents of an incoming attachment.
+func (c *Channel) DownloadAttachment(ctx context.Context, a channels.Attachment) ([]byte, error) {
+	return c.DownloadFile(ctx, a.FileID)
+}
 
-	// Note: actual download implementation would fetch from url
-	return nil, fmt.Errorf("download not implemented")
+// registerCommands publishes the slash commands in the bot's command menu.
+func (c *Channel) registerCommands() {
+	if len(c.commands) == 0 {
+		return
+	}
+	cmds := make([]tgbotapi.BotCommand, 0, len(c.commands))
+	for _, cmd := range c.commands {
+		cmds = append(cmds, tgbotapi.BotCommand{Command: cmd.Name, Description: cmd.Description})
+	}
+	if _, err := c.bot.Request(tgbotapi.NewSetMyCommands(cmds...)); err != nil {
+		logger().Warnw("set bot commands", "error", err)
+	}
 }
 
 // isAllowed checks if a user/chat is allowed
@@ -2,11 +2,15 @@ package telegram
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+
+	"github.com/langoai/lango/internal/channels"
 )
 
 // MockBotAPI implements BotAPI interface
@@ -15,6 +19,7 @@ type MockBotAPI struct {
 	GetUpdatesChanFunc func(config tgbotapi.UpdateConfig) tgbotapi.UpdatesChannel
 	SendFunc           func(c tgbotapi.Chattable) (tgbotapi.Message, error)
 	GetSelfFunc        func() tgbotapi.User
+	GetFileFunc        func(config tgbotapi.FileConfig) (tgbotapi.File, error)
 	SentMessages       []tgbotapi.Chattable
 	RequestCalls       []tgbotapi.Chattable
 }
@@ -38,6 +43,9 @@ func (m *MockBotAPI) Send(c tgbotapi.Chattable) (tgbotapi.Message, error) {
 }
 
 func (m *MockBotAPI) GetFile(config tgbotapi.FileConfig) (tgbotapi.File, error) {
+	if m.GetFileFunc != nil {
+		return m.GetFileFunc(config)
+	}
 	return tgbotapi.File{}, nil
 }
 
@@ -95,15 +103,15 @@ func TestTelegramChannel(t *testing.T) {
 
 	msgProcessed := make(chan bool)
 
-	channel.SetHandler(func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error) {
+	channel.SetHandler(func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
 		if msg.Text != "Hello Bot" {
 			t.Errorf("expected 'Hello Bot', got '%s'", msg.Text)
 		}
-		if msg.UserID != 999 {
-			t.Errorf("expected user ID 999, got %d", msg.UserID)
+		if msg.UserID != "999" {
+			t.Errorf("expected user ID 999, got %s", msg.UserID)
 		}
 		msgProcessed <- true
-		return &OutgoingMessage{Text: "Reply"}, nil
+		return &channels.OutgoingMessage{Text: "Reply"}, nil
 	})
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -180,9 +188,9 @@ func TestTelegramTypingIndicator(t *testing.T) {
 	}
 
 	done := make(chan struct{})
-	channel.SetHandler(func(ctx context.Context, msg *IncomingMessage) (*OutgoingMessage, error) {
+	channel.SetHandler(func(ctx context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
 		close(done)
-		return &OutgoingMessage{Text: "ok"}, nil
+		return &channels.OutgoingMessage{Text: "ok"}, nil
 	})
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -223,3 +231,74 @@ func TestTelegramTypingIndicator(t *testing.T) {
 		t.Error("timeout waiting for handler")
 	}
 }
+
+func TestTelegramChannel_SetCommands(t *testing.T) {
+	mockBot := &MockBotAPI{
+		GetUpdatesChanFunc: func(config tgbotapi.UpdateConfig) tgbotapi.UpdatesChannel {
+			return make(chan tgbotapi.Update)
+		},
+	}
+
+	channel, err := New(Config{BotToken: "TEST_TOKEN", Bot: mockBot})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	channel.SetHandler(func(context.Context, *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		return nil, nil
+	})
+	channel.SetCommands([]channels.Command{
+		{Name: "reset", Description: "Start over"},
+		{Name: "status", Description: "Show status"},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := channel.Start(ctx); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	defer channel.Stop()
+
+	calls := mockBot.getRequestCalls()
+	if len(calls) == 0 {
+		t.Fatal("expected setMyCommands request")
+	}
+	set, ok := calls[0].(tgbotapi.SetMyCommandsConfig)
+	if !ok {
+		t.Fatalf("expected SetMyCommandsConfig, got %T", calls[0])
+	}
+	if len(set.Commands) != 2 || set.Commands[0].Command != "reset" || set.Commands[1].Description != "Show status" {
+		t.Errorf("unexpected commands: %+v", set.Commands)
+	}
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/file/botTEST_TOKEN/photos/file_1.jpg" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte("jpeg-bytes"))
+	}))
+	defer srv.Close()
+
+	mockBot := &MockBotAPI{
+		GetFileFunc: func(config tgbotapi.FileConfig) (tgbotapi.File, error) {
+			if config.FileID != "photo-id" {
+				t.Errorf("expected file ID photo-id, got %q", config.FileID)
+			}
+			return tgbotapi.File{FileID: config.FileID, FilePath: "photos/file_1.jpg"}, nil
+		},
+	}
+	ch, err := New(Config{BotToken: "TEST_TOKEN", APIEndpoint: srv.URL + "/bot%s/%s", Bot: mockBot})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+
+	data, err := ch.DownloadAttachment(context.Background(), channels.Attachment{FileID: "photo-id"})
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	if string(data) != "jpeg-bytes" {
+		t.Errorf("unexpected data %q", data)
+	}
+}
@@ -0,0 +1,28 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/langoai/lango/internal/approval"
+)
+
+// ApprovalProvider claims webhook sessions and denies every request.
+// Nobody is on the other end of a webhook to answer, and the payload comes
+// from outside, so falling through to the TTY or headless provider could
+// auto-approve tools on behalf of an external sender.
+type ApprovalProvider struct{}
+
+var _ approval.Provider = (*ApprovalProvider)(nil)
+
+// RequestApproval always denies.
+func (p *ApprovalProvider) RequestApproval(_ context.Context, req approval.ApprovalRequest) (approval.ApprovalResponse, error) {
+	logger.Warnw("denied tool approval in webhook session", "tool", req.ToolName, "session", req.SessionKey)
+	return approval.ApprovalResponse{}, fmt.Errorf("tool approval is not available in webhook sessions")
+}
+
+// CanHandle returns true for session keys starting with "webhook:".
+func (p *ApprovalProvider) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, "webhook:")
+}
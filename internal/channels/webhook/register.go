@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/types"
+)
+
+func init() {
+	channels.Register(types.ChannelWebhook, fromConfig)
+}
+
+// fromConfig creates the webhook channel from the application config.
+func fromConfig(cfg *config.Config) (channels.Channel, error) {
+	wh := cfg.Channels.Webhook
+	if !wh.Enabled {
+		return nil, channels.ErrDisabled
+	}
+
+	c := Config{}
+	for _, e := range wh.Endpoints {
+		c.Endpoints = append(c.Endpoints, Endpoint{
+			Name:            e.Name,
+			Secret:          e.Secret,
+			Auth:            e.Auth,
+			SignatureHeader: e.SignatureHeader,
+			Template:        e.Template,
+			Session:         e.Session,
+			SessionKey:      e.SessionKey,
+			ReplyTo:         e.ReplyTo,
+		})
+	}
+	for _, t := range wh.Targets {
+		c.Targets = append(c.Targets, Target{
+			Name:            t.Name,
+			URL:             t.URL,
+			Secret:          t.Secret,
+			SignatureHeader: t.SignatureHeader,
+			Template:        t.Template,
+			Headers:         t.Headers,
+		})
+	}
+	return New(c)
+}
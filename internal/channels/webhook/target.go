@@ -0,0 +1,99 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const defaultTimeout = 15 * time.Second
+
+// Target configures an outbound webhook.
+type Target struct {
+	Name            string
+	URL             string
+	Secret          string // optional HMAC-SHA256 signing key
+	SignatureHeader string // default X-Signature-256
+	Template        string // JSON body template; default {"text": ...}
+	Headers         map[string]string
+}
+
+// target is a validated outbound webhook.
+type target struct {
+	Target
+	body *template.Template
+}
+
+// bodyData is the data available to target body templates.
+type bodyData struct {
+	Text      string
+	Target    string
+	Timestamp string
+}
+
+// newTarget validates a target and parses its body template.
+func newTarget(t Target) (*target, error) {
+	if !nameRe.MatchString(t.Name) {
+		return nil, fmt.Errorf("invalid webhook target name %q", t.Name)
+	}
+	u, err := url.Parse(t.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("webhook target %q: invalid URL", t.Name)
+	}
+	if t.SignatureHeader == "" {
+		t.SignatureHeader = defaultSignatureHeader
+	}
+	if t.Template == "" {
+		t.Template = defaultBodyTemplate
+	}
+	body, err := parseTemplate(t.Name, t.Template)
+	if err != nil {
+		return nil, err
+	}
+	return &target{Target: t, body: body}, nil
+}
+
+// deliver POSTs text to the target URL.
+func (t *target) deliver(ctx context.Context, client *http.Client, text string) error {
+	var buf bytes.Buffer
+	data := bodyData{Text: text, Target: t.Name, Timestamp: time.Now().UTC().Format(time.RFC3339)}
+	if err := t.body.Execute(&buf, data); err != nil {
+		return fmt.Errorf("render webhook %q body: %w", t.Name, err)
+	}
+	if !json.Valid(buf.Bytes()) {
+		return fmt.Errorf("render webhook %q body: template did not produce valid JSON", t.Name)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.URL, bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		return fmt.Errorf("create webhook %q request: %w", t.Name, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "lango-webhook")
+	for k, v := range t.Headers {
+		req.Header.Set(k, v)
+	}
+	if t.Secret != "" {
+		req.Header.Set(t.SignatureHeader, "sha256="+hex.EncodeToString(sign(t.Secret, buf.Bytes())))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("deliver webhook %q: %w", t.Name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("deliver webhook %q: status %d: %s", t.Name, resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"text/template"
+)
+
+// defaultPromptTemplate renders the raw payload when an endpoint has no template.
+const defaultPromptTemplate = "Webhook event received on endpoint \"{{endpoint}}\":\n\n```json\n{{pretty .}}\n```"
+
+// defaultBodyTemplate is the outbound body when a target has no template.
+const defaultBodyTemplate = `{"text": {{json .Text}}, "target": {{json .Target}}, "timestamp": {{json .Timestamp}}}`
+
+// baseFuncs are available in every template. Request-bound functions are
+// declared here with placeholders and rebound per execution.
+func baseFuncs() template.FuncMap {
+	return template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+		"pretty": func(v any) (string, error) {
+			b, err := json.MarshalIndent(v, "", "  ")
+			return string(b), err
+		},
+		"default": func(def, v any) any {
+			if v == nil || v == "" {
+				return def
+			}
+			return v
+		},
+		"join": func(sep string, v any) string {
+			items, ok := v.([]any)
+			if !ok {
+				return fmt.Sprint(v)
+			}
+			parts := make([]string, len(items))
+			for i, item := range items {
+				parts[i] = fmt.Sprint(item)
+			}
+			return strings.Join(parts, sep)
+		},
+		"endpoint": func() string { return "" },
+		"header":   func(string) string { return "" },
+	}
+}
+
+// parseTemplate parses a named template with the base functions.
+func parseTemplate(name, text string) (*template.Template, error) {
+	t, err := template.New(name).Funcs(baseFuncs()).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s template: %w", name, err)
+	}
+	return t, nil
+}
+
+// renderRequest executes an endpoint template against a request payload.
+func renderRequest(t *template.Template, endpoint string, h http.Header, payload any) (string, error) {
+	t, err := t.Clone()
+	if err != nil {
+		return "", err
+	}
+	t.Funcs(template.FuncMap{
+		"endpoint": func() string { return endpoint },
+		"header":   h.Get,
+	})
+	var b strings.Builder
+	if err := t.Execute(&b, payload); err != nil {
+		return "", fmt.Errorf("render %s template: %w", t.Name(), err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
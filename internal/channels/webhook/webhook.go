@@ -0,0 +1,441 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
+	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/types"
+)
+
+var logger = logging.SubsystemSugar("channel.webhook")
+
+// RoutePrefix is the gateway path under which endpoints are served.
+const RoutePrefix = "/webhooks"
+
+const (
+	defaultSignatureHeader = "X-Signature-256"
+	maxPayloadSize         = 1 << 20
+)
+
+// Authentication modes.
+const (
+	AuthHMAC   = "hmac"
+	AuthBearer = "bearer"
+)
+
+// Session strategies.
+const (
+	SessionEndpoint = "endpoint" // all events of an endpoint share one session
+	SessionEvent    = "event"    // every delivery starts a fresh session
+	SessionTemplate = "template" // the session key template groups events
+)
+
+var nameRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// Endpoint configures an inbound webhook.
+type Endpoint struct {
+	Name            string
+	Secret          string
+	Auth            string // AuthHMAC (default) or AuthBearer
+	SignatureHeader string // default X-Signature-256
+	Template        string // prompt template; default renders the raw payload
+	Session         string // SessionEndpoint (default), SessionEvent or SessionTemplate
+	SessionKey      string // template for SessionTemplate
+	ReplyTo         []string
+}
+
+// Config holds webhook channel configuration
+type Config struct {
+	Endpoints  []Endpoint
+	Targets    []Target
+	HTTPClient *http.Client // optional, for testing
+}
+
+// endpoint is a validated inbound webhook.
+type endpoint struct {
+	Endpoint
+	prompt     *template.Template
+	sessionKey *template.Template
+}
+
+// Channel implements generic webhooks. Inbound endpoints turn signed HTTP
+// requests into agent messages; outbound targets deliver agent output to
+// external URLs.
+type Channel struct {
+	endpoints map[string]*endpoint
+	targets   map[string]*target
+	order     []string // target names in configuration order
+	client    *http.Client
+	handler   channels.Handler
+	sender    channels.Sender
+	approval  *ApprovalProvider
+
+	mu     sync.RWMutex
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var (
+	_ channels.RouteChannel = (*Channel)(nil)
+	_ channels.RelayChannel = (*Channel)(nil)
+)
+
+// New creates a new webhook channel
+func New(cfg Config) (*Channel, error) {
+	c := &Channel{
+		endpoints: make(map[string]*endpoint),
+		targets:   make(map[string]*target),
+		client:    cfg.HTTPClient,
+		approval:  &ApprovalProvider{},
+	}
+	if c.client == nil {
+		c.client = &http.Client{Timeout: defaultTimeout}
+	}
+
+	for _, t := range cfg.Targets {
+		tt, err := newTarget(t)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := c.targets[t.Name]; dup {
+			return nil, fmt.Errorf("duplicate webhook target %q", t.Name)
+		}
+		c.targets[t.Name] = tt
+		c.order = append(c.order, t.Name)
+	}
+
+	for _, e := range cfg.Endpoints {
+		ep, err := c.newEndpoint(e)
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := c.endpoints[e.Name]; dup {
+			return nil, fmt.Errorf("duplicate webhook endpoint %q", e.Name)
+		}
+		c.endpoints[e.Name] = ep
+	}
+
+	if len(c.endpoints) == 0 && len(c.targets) == 0 {
+		return nil, fmt.Errorf("no webhook endpoints or targets configured")
+	}
+	return c, nil
+}
+
+// newEndpoint validates an endpoint and parses its templates.
+func (c *Channel) newEndpoint(e Endpoint) (*endpoint, error) {
+	if !nameRe.MatchString(e.Name) {
+		return nil, fmt.Errorf("invalid webhook endpoint name %q", e.Name)
+	}
+	if e.Secret == "" {
+		return nil, fmt.Errorf("webhook endpoint %q: secret is required", e.Name)
+	}
+	switch e.Auth {
+	case "":
+		e.Auth = AuthHMAC
+	case AuthHMAC, AuthBearer:
+	default:
+		return nil, fmt.Errorf("webhook endpoint %q: unknown auth %q", e.Name, e.Auth)
+	}
+	if e.SignatureHeader == "" {
+		e.SignatureHeader = defaultSignatureHeader
+	}
+	if e.Template == "" {
+		e.Template = defaultPromptTemplate
+	}
+
+	ep := &endpoint{Endpoint: e}
+	var err error
+	if ep.prompt, err = parseTemplate(e.Name, e.Template); err != nil {
+		return nil, err
+	}
+
+	switch e.Session {
+	case "":
+		ep.Session = SessionEndpoint
+	case SessionEndpoint, SessionEvent:
+	case SessionTemplate:
+		if e.SessionKey == "" {
+			return nil, fmt.Errorf("webhook endpoint %q: session strategy %q requires sessionKey", e.Name, SessionTemplate)
+		}
+		if ep.sessionKey, err = parseTemplate(e.Name+" session key", e.SessionKey); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("webhook endpoint %q: unknown session strategy %q", e.Name, e.Session)
+	}
+
+	// Replies to another endpoint would be forwarded back here forever.
+	for _, r := range e.ReplyTo {
+		ch, name, _ := strings.Cut(r, ":")
+		if types.ChannelType(strings.ToLower(ch)) == types.ChannelWebhook {
+			if _, ok := c.targets[name]; !ok {
+				return nil, fmt.Errorf("webhook endpoint %q: replyTo %q is not a webhook target", e.Name, r)
+			}
+		}
+	}
+	return ep, nil
+}
+
+// Type returns types.ChannelWebhook.
+func (c *Channel) Type() types.ChannelType {
+	return types.ChannelWebhook
+}
+
+// Capabilities reports the features supported by webhooks.
+func (c *Channel) Capabilities() channels.Capabilities {
+	return channels.Capabilities{}
+}
+
+// SetHandler sets the message handler
+func (c *Channel) SetHandler(handler channels.Handler) {
+	c.handler = handler
+}
+
+// SetSender sets the sender used to forward answers to replyTo targets.
+func (c *Channel) SetSender(s channels.Sender) {
+	c.sender = s
+}
+
+// ApprovalProvider returns the channel's approval provider for composite registration.
+func (c *Channel) ApprovalProvider() approval.Provider {
+	return c.approval
+}
+
+// RegisterRoutes mounts POST /webhooks/{name} on the gateway router.
+func (c *Channel) RegisterRoutes(r chi.Router) {
+	if len(c.endpoints) == 0 {
+		return
+	}
+	r.Post(RoutePrefix+"/{name}", c.handleRequest)
+	logger.Infow("webhook routes registered", "prefix", RoutePrefix, "endpoints", len(c.endpoints))
+}
+
+// Start enables request handling.
+func (c *Channel) Start(ctx context.Context) error {
+	if c.handler == nil {
+		return fmt.Errorf("message handler not set")
+	}
+	c.mu.Lock()
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	c.mu.Unlock()
+
+	logger.Infow("webhook channel started", "endpoints", len(c.endpoints), "targets", len(c.targets))
+	return nil
+}
+
+// handleRequest authenticates a delivery, renders the prompt and runs the
+// handler in the background. The sender gets 202 Accepted right away.
+func (c *Channel) handleRequest(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	ep, ok := c.endpoints[name]
+	if !ok {
+		http.Error(w, "unknown webhook endpoint", http.StatusNotFound)
+		return
+	}
+
+	c.mu.RLock()
+	ctx := c.ctx
+	c.mu.RUnlock()
+	if ctx == nil || ctx.Err() != nil {
+		http.Error(w, "webhook channel not running", http.StatusServiceUnavailable)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize+1))
+	if err != nil {
+		http.Error(w, "read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxPayloadSize {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if !ep.authenticate(r, body) {
+		logger.Warnw("rejected webhook with invalid signature", "endpoint", name, "remote", r.RemoteAddr)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload any
+	if err := json.Unmarshal(body, &payload); err != nil {
+		payload = string(body)
+	}
+
+	text, err := renderRequest(ep.prompt, name, r.Header, payload)
+	if err != nil {
+		logger.Warnw("render webhook prompt", "endpoint", name, "error", err)
+		http.Error(w, "render prompt: "+err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	deliveryID := eventID(r)
+	key, err := ep.sessionDiscriminator(r.Header, payload, deliveryID)
+	if err != nil {
+		logger.Warnw("render webhook session key", "endpoint", name, "error", err)
+		http.Error(w, "render session key: "+err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	incoming := &channels.IncomingMessage{
+		Channel:   types.ChannelWebhook,
+		MessageID: deliveryID,
+		ChatID:    name,
+		UserID:    key,
+		Username:  name,
+		Text:      text,
+	}
+
+	logger.Infow("received webhook",
+		"endpoint", name,
+		"delivery", deliveryID,
+		"session", incoming.SessionKey(),
+	)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+
+		response, err := c.handler(ctx, incoming)
+		if err != nil {
+			logger.Errorw("handler error", "endpoint", name, "error", err)
+			return
+		}
+		if response != nil {
+			if err := c.Send(ctx, name, response); err != nil {
+				logger.Errorw("send error", "endpoint", name, "error", err)
+			}
+		}
+	}()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"status":     "accepted",
+		"deliveryId": deliveryID,
+		"sessionKey": incoming.SessionKey(),
+	})
+}
+
+// authenticate verifies the request against the endpoint secret.
+func (ep *endpoint) authenticate(r *http.Request, body []byte) bool {
+	if ep.Auth == AuthBearer {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		return ok && subtle.ConstantTimeCompare([]byte(token), []byte(ep.Secret)) == 1
+	}
+	sig := strings.TrimPrefix(r.Header.Get(ep.SignatureHeader), "sha256=")
+	got, err := hex.DecodeString(sig)
+	if err != nil || len(got) == 0 {
+		return false
+	}
+	return hmac.Equal(got, sign(ep.Secret, body))
+}
+
+// sessionDiscriminator returns the per-session part of the session key.
+func (ep *endpoint) sessionDiscriminator(h http.Header, payload any, deliveryID string) (string, error) {
+	switch ep.Session {
+	case SessionEvent:
+		return deliveryID, nil
+	case SessionTemplate:
+		key, err := renderRequest(ep.sessionKey, ep.Name, h, payload)
+		if err != nil {
+			return "", err
+		}
+		if key == "" || key == "<no value>" {
+			return "", fmt.Errorf("empty session key")
+		}
+		return key, nil
+	default:
+		return SessionEndpoint, nil
+	}
+}
+
+// Send delivers a message. chatID names an outbound target, or an inbound
+// endpoint whose replyTo targets receive the message. An empty chatID
+// selects the first configured target.
+func (c *Channel) Send(ctx context.Context, chatID string, msg *channels.OutgoingMessage) error {
+	if chatID == "" {
+		if len(c.order) == 0 {
+			return fmt.Errorf("webhook delivery requires a target (use webhook:<name>)")
+		}
+		chatID = c.order[0]
+	}
+
+	if t, ok := c.targets[chatID]; ok {
+		return t.deliver(ctx, c.client, msg.Text)
+	}
+
+	ep, ok := c.endpoints[chatID]
+	if !ok {
+		return fmt.Errorf("unknown webhook target %q", chatID)
+	}
+	if len(ep.ReplyTo) == 0 {
+		logger.Debugw("no replyTo targets; answer kept in session only", "endpoint", chatID)
+		return nil
+	}
+	if c.sender == nil {
+		return fmt.Errorf("webhook endpoint %q: no sender for replyTo targets", chatID)
+	}
+	var errs []string
+	for _, target := range ep.ReplyTo {
+		if err := c.sender.SendMessage(ctx, target, msg.Text); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", target, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("webhook reply: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// StartTyping is a no-op; webhooks have no typing indicator.
+func (c *Channel) StartTyping(_ context.Context, _ string) func() {
+	return func() {}
+}
+
+// Stop stops accepting requests and waits for running handlers.
+func (c *Channel) Stop() {
+	c.mu.Lock()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.mu.Unlock()
+	c.wg.Wait()
+	logger.Info("webhook channel stopped")
+}
+
+// eventID returns the sender's delivery ID, or a random one.
+func eventID(r *http.Request) string {
+	for _, h := range []string{"X-GitHub-Delivery", "X-Gitlab-Event-UUID", "X-Request-Id", "X-Delivery-Id"} {
+		if v := r.Header.Get(h); v != "" {
+			return v
+		}
+	}
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// sign returns the HMAC-SHA256 of body.
+func sign(secret string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,304 @@
+package webhook
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/channels"
+)
+
+const testSecret = "s3cret"
+
+// recordingSender records messages forwarded to other channels.
+type recordingSender struct {
+	mu   sync.Mutex
+	sent []string
+}
+
+func (s *recordingSender) SendMessage(_ context.Context, target, text string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, target+"|"+text)
+	return nil
+}
+
+func (s *recordingSender) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.sent...)
+}
+
+type harness struct {
+	ch       *Channel
+	server   *httptest.Server
+	sender   *recordingSender
+	mu       sync.Mutex
+	received []*channels.IncomingMessage
+}
+
+func newHarness(t *testing.T, cfg Config) *harness {
+	t.Helper()
+	ch, err := New(cfg)
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	h := &harness{ch: ch, sender: &recordingSender{}}
+	ch.SetSender(h.sender)
+	ch.SetHandler(func(_ context.Context, msg *channels.IncomingMessage) (*channels.OutgoingMessage, error) {
+		h.mu.Lock()
+		h.received = append(h.received, msg)
+		h.mu.Unlock()
+		return &channels.OutgoingMessage{Text: "handled: " + msg.UserID}, nil
+	})
+
+	r := chi.NewRouter()
+	ch.RegisterRoutes(r)
+	h.server = httptest.NewServer(r)
+	t.Cleanup(h.server.Close)
+
+	if err := ch.Start(context.Background()); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+	t.Cleanup(ch.Stop)
+	return h
+}
+
+func (h *harness) post(t *testing.T, name, body string, headers map[string]string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, h.server.URL+RoutePrefix+"/"+name, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("post: %v", err)
+	}
+	resp.Body.Close()
+	return resp
+}
+
+func (h *harness) messages() []*channels.IncomingMessage {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]*channels.IncomingMessage(nil), h.received...)
+}
+
+func signature(body string) string {
+	return "sha256=" + hex.EncodeToString(sign(testSecret, []byte(body)))
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWebhook_HMAC(t *testing.T) {
+	h := newHarness(t, Config{Endpoints: []Endpoint{{
+		Name:     "ci",
+		Secret:   testSecret,
+		Template: `Build {{.build.id}} {{.status}} ({{header "X-Event"}} on {{endpoint}})`,
+		ReplyTo:  []string{"telegram:42"},
+	}}})
+
+	body := `{"build": {"id": 7}, "status": "failed"}`
+
+	tests := []struct {
+		give     string
+		endpoint string
+		headers  map[string]string
+		want     int
+	}{
+		{give: "missing signature", endpoint: "ci", want: http.StatusUnauthorized},
+		{give: "wrong signature", endpoint: "ci", headers: map[string]string{"X-Signature-256": "sha256=00ff"}, want: http.StatusUnauthorized},
+		{give: "unknown endpoint", endpoint: "nope", headers: map[string]string{"X-Signature-256": signature(body)}, want: http.StatusNotFound},
+		{give: "valid", endpoint: "ci", headers: map[string]string{"X-Signature-256": signature(body), "X-Event": "push"}, want: http.StatusAccepted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			resp := h.post(t, tt.endpoint, body, tt.headers)
+			if resp.StatusCode != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, resp.StatusCode)
+			}
+		})
+	}
+
+	waitFor(t, func() bool { return len(h.sender.messages()) == 1 })
+	msgs := h.messages()
+	if len(msgs) != 1 {
+		t.Fatalf("expected 1 handled message, got %d", len(msgs))
+	}
+	if msgs[0].Text != "Build 7 failed (push on ci)" {
+		t.Errorf("unexpected prompt: %q", msgs[0].Text)
+	}
+	if key := msgs[0].SessionKey(); key != "webhook:ci:endpoint" {
+		t.Errorf("unexpected session key: %q", key)
+	}
+	if got := h.sender.messages()[0]; got != "telegram:42|handled: endpoint" {
+		t.Errorf("unexpected forwarded reply: %q", got)
+	}
+}
+
+func TestWebhook_BearerAndDefaultTemplate(t *testing.T) {
+	h := newHarness(t, Config{Endpoints: []Endpoint{{Name: "alerts", Secret: testSecret, Auth: AuthBearer}}})
+
+	if resp := h.post(t, "alerts", `{}`, map[string]string{"Authorization": "Bearer wrong"}); resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected 401, got %d", resp.StatusCode)
+	}
+	resp := h.post(t, "alerts", `{"alert": "disk full"}`, map[string]string{"Authorization": "Bearer " + testSecret})
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d", resp.StatusCode)
+	}
+
+	waitFor(t, func() bool { return len(h.messages()) == 1 })
+	text := h.messages()[0].Text
+	if !strings.Contains(text, `endpoint "alerts"`) || !strings.Contains(text, `"alert": "disk full"`) {
+		t.Errorf("unexpected default prompt: %q", text)
+	}
+}
+
+func TestWebhook_SessionStrategies(t *testing.T) {
+	h := newHarness(t, Config{Endpoints: []Endpoint{
+		{Name: "events", Secret: testSecret, Session: SessionEvent},
+		{Name: "grouped", Secret: testSecret, Session: SessionTemplate, SessionKey: "{{.labels.alertname}}"},
+	}})
+
+	post := func(name, body, delivery string) {
+		resp := h.post(t, name, body, map[string]string{
+			"X-Signature-256":   signature(body),
+			"X-GitHub-Delivery": delivery,
+		})
+		if resp.StatusCode != http.StatusAccepted {
+			t.Fatalf("expected 202, got %d", resp.StatusCode)
+		}
+	}
+	post("events", `{}`, "d1")
+	post("events", `{}`, "d2")
+	post("grouped", `{"labels": {"alertname": "DiskFull"}}`, "d3")
+	post("grouped", `{"labels": {"alertname": "DiskFull"}, "n": 2}`, "d4")
+
+	missing := `{"labels": {}}`
+	if resp := h.post(t, "grouped", missing, map[string]string{"X-Signature-256": signature(missing)}); resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("expected 422 for empty session key, got %d", resp.StatusCode)
+	}
+
+	waitFor(t, func() bool { return len(h.messages()) == 4 })
+	keys := map[string]int{}
+	for _, m := range h.messages() {
+		keys[m.SessionKey()]++
+	}
+	want := map[string]int{"webhook:events:d1": 1, "webhook:events:d2": 1, "webhook:grouped:DiskFull": 2}
+	for k, n := range want {
+		if keys[k] != n {
+			t.Errorf("session %q: expected %d messages, got %d (all: %v)", k, n, keys[k], keys)
+		}
+	}
+}
+
+func TestWebhook_Targets(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []string
+	var sigs []string
+	ext := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		sigs = append(sigs, r.Header.Get("X-Hub-Signature-256"))
+		mu.Unlock()
+		if strings.Contains(string(body), "fail") {
+			http.Error(w, "nope", http.StatusBadGateway)
+		}
+	}))
+	defer ext.Close()
+
+	ch, err := New(Config{Targets: []Target{
+		{Name: "ops", URL: ext.URL, Secret: testSecret, SignatureHeader: "X-Hub-Signature-256"},
+		{Name: "chat", URL: ext.URL, Template: `{"content": {{json .Text}}}`},
+	}})
+	if err != nil {
+		t.Fatalf("new channel: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := ch.Send(ctx, "", &channels.OutgoingMessage{Text: "digest \"ready\""}); err != nil {
+		t.Fatalf("send to default target: %v", err)
+	}
+	if err := ch.Send(ctx, "chat", &channels.OutgoingMessage{Text: "hi"}); err != nil {
+		t.Fatalf("send to chat: %v", err)
+	}
+	if err := ch.Send(ctx, "ops", &channels.OutgoingMessage{Text: "fail"}); err == nil || !strings.Contains(err.Error(), "502") {
+		t.Errorf("expected status error, got %v", err)
+	}
+	if err := ch.Send(ctx, "missing", &channels.OutgoingMessage{Text: "x"}); err == nil {
+		t.Error("expected unknown target error")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	var first map[string]string
+	if err := json.Unmarshal([]byte(bodies[0]), &first); err != nil {
+		t.Fatalf("default body is not JSON: %v", err)
+	}
+	if first["text"] != `digest "ready"` || first["target"] != "ops" || first["timestamp"] == "" {
+		t.Errorf("unexpected default body: %v", first)
+	}
+	if sigs[0] != signature(bodies[0]) {
+		t.Errorf("expected body signature %q, got %q", signature(bodies[0]), sigs[0])
+	}
+	if bodies[1] != `{"content": "hi"}` || sigs[1] != "" {
+		t.Errorf("unexpected templated delivery: %q (sig %q)", bodies[1], sigs[1])
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	tests := []struct {
+		give Config
+		want string
+	}{
+		{give: Config{}, want: "no webhook endpoints"},
+		{give: Config{Endpoints: []Endpoint{{Name: "a/b", Secret: "x"}}}, want: "invalid webhook endpoint name"},
+		{give: Config{Endpoints: []Endpoint{{Name: "a"}}}, want: "secret is required"},
+		{give: Config{Endpoints: []Endpoint{{Name: "a", Secret: "x", Session: SessionTemplate}}}, want: "requires sessionKey"},
+		{give: Config{Endpoints: []Endpoint{{Name: "a", Secret: "x", Template: "{{"}}}, want: "parse a template"},
+		{give: Config{Endpoints: []Endpoint{{Name: "a", Secret: "x", ReplyTo: []string{"webhook:a"}}}}, want: "not a webhook target"},
+		{give: Config{Targets: []Target{{Name: "t", URL: "ftp://x"}}}, want: "invalid URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			_, err := New(tt.give)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestApprovalProvider_Denies(t *testing.T) {
+	p := &ApprovalProvider{}
+	if !p.CanHandle("webhook:ci:endpoint") || p.CanHandle("telegram:1:2") {
+		t.Error("unexpected CanHandle result")
+	}
+	resp, err := p.RequestApproval(context.Background(), approval.ApprovalRequest{ToolName: "exec", SessionKey: "webhook:ci:endpoint"})
+	if err == nil || resp.Approved {
+		t.Errorf("expected denial, got %+v, %v", resp, err)
+	}
+}
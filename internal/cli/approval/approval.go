@@ -0,0 +1,341 @@
+// Package approval provides CLI commands for tool approval grants and
+// history.
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/bootstrap"
+)
+
+// grantEntry is one grant of the JSON output.
+type grantEntry struct {
+	ID         string     `json:"id"`
+	Tool       string     `json:"tool"`
+	Scope      string     `json:"scope"`
+	Subject    string     `json:"subject,omitempty"`
+	Channel    string     `json:"channel"`
+	SessionKey string     `json:"sessionKey,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+}
+
+// historyEntry is one audit entry of the JSON output.
+type historyEntry struct {
+	Time       time.Time `json:"time"`
+	SessionKey string    `json:"sessionKey,omitempty"`
+	Tool       string    `json:"tool"`
+	Outcome    string    `json:"outcome"`
+	Source     string    `json:"source"`
+	Rule       string    `json:"rule,omitempty"`
+	GrantID    string    `json:"grantId,omitempty"`
+	Scope      string    `json:"scope,omitempty"`
+	Summary    string    `json:"summary,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// NewApprovalCmd creates the approval command with lazy bootstrap loading.
+func NewApprovalCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approval",
+		Short: "Manage tool approval grants and history",
+	}
+
+	cmd.AddCommand(newGrantsCmd(bootLoader))
+	cmd.AddCommand(newHistoryCmd(bootLoader))
+
+	return cmd
+}
+
+func newGrantsCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants",
+		Short: `Manage "always allow" grants`,
+	}
+
+	cmd.AddCommand(newGrantsListCmd(bootLoader))
+	cmd.AddCommand(newGrantsRevokeCmd(bootLoader))
+
+	return cmd
+}
+
+func newGrantsListCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		tool       string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: `List "always allow" grants`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			store := approval.NewEntStore(boot.DBClient)
+			grants, err := store.ListGrants(context.Background(), approval.GrantFilter{ToolName: tool})
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				entries := make([]grantEntry, 0, len(grants))
+				for _, g := range grants {
+					entries = append(entries, toGrantEntry(g))
+				}
+				return writeJSON(cmd.OutOrStdout(), entries)
+			}
+
+			if len(grants) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No grants.")
+				return nil
+			}
+			now := time.Now()
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tTOOL\tSCOPE\tSUBJECT\tCHANNEL\tCREATED\tEXPIRES")
+			for _, g := range grants {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					g.ID, g.ToolName, g.Scope, orDash(g.Subject), g.Channel,
+					g.CreatedAt.Local().Format("2006-01-02 15:04"), expires(g, now))
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&tool, "tool", "", "Only grants of this tool")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func newGrantsRevokeCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		tool string
+		all  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "revoke [id]",
+		Short: `Revoke "always allow" grants`,
+		Long: `Revoke a grant by ID (or a unique ID prefix), every grant of a tool, or
+every grant. Revocations are recorded in the approval history and take
+effect immediately, including in a running server.
+
+Examples:
+  lango approval grants revoke 3f2a
+  lango approval grants revoke --tool exec
+  lango approval grants revoke --all`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			selectors := 0
+			for _, set := range []bool{len(args) == 1, tool != "", all} {
+				if set {
+					selectors++
+				}
+			}
+			if selectors != 1 {
+				return fmt.Errorf("specify exactly one of a grant ID, --tool, or --all")
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			ctx := context.Background()
+			store := approval.NewEntStore(boot.DBClient)
+			filter := approval.GrantFilter{ToolName: tool}
+			if len(args) == 1 {
+				g, err := findGrant(ctx, store, args[0])
+				if err != nil {
+					return err
+				}
+				filter = approval.GrantFilter{ID: g.ID}
+			}
+
+			revoked, err := store.DeleteGrants(ctx, filter)
+			if err != nil {
+				return err
+			}
+			for _, g := range revoked {
+				if err := store.RecordRevoke(ctx, g, "cli"); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Revoked %d grant(s).\n", len(revoked))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tool, "tool", "", "Revoke every grant of this tool")
+	cmd.Flags().BoolVar(&all, "all", false, "Revoke every grant")
+
+	return cmd
+}
+
+func newHistoryCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		limit      int
+		sessionKey string
+		tool       string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show recent approval decisions",
+		Long: `Show approval decisions and grant revocations from the audit log, newest
+first. The source tells what decided: user (an approval prompt), grant (an
+"always allow" grant), policy (a policy rule) or spending_limit (a payment
+below the auto-approve threshold).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			store := approval.NewEntStore(boot.DBClient)
+			entries, err := store.History(context.Background(), approval.HistoryFilter{
+				SessionKey: sessionKey,
+				ToolName:   tool,
+				Limit:      limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				out := make([]historyEntry, 0, len(entries))
+				for _, e := range entries {
+					out = append(out, historyEntry{
+						Time:       e.Time,
+						SessionKey: e.SessionKey,
+						Tool:       e.ToolName,
+						Outcome:    e.Outcome,
+						Source:     e.Source,
+						Rule:       e.Rule,
+						GrantID:    e.GrantID,
+						Scope:      string(e.Scope),
+						Summary:    e.Summary,
+						Error:      e.Error,
+					})
+				}
+				return writeJSON(cmd.OutOrStdout(), out)
+			}
+
+			if len(entries) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No approval history.")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tSESSION\tTOOL\tOUTCOME\tSOURCE\tDETAIL")
+			for _, e := range entries {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					e.Time.Local().Format("2006-01-02 15:04:05"), orDash(e.SessionKey),
+					e.ToolName, e.Outcome, e.Source, detail(e))
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of entries (0 for all)")
+	cmd.Flags().StringVar(&sessionKey, "session", "", "Only decisions of this session")
+	cmd.Flags().StringVar(&tool, "tool", "", "Only decisions about this tool")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+// findGrant returns the grant whose ID is or starts with id.
+func findGrant(ctx context.Context, store *approval.EntStore, id string) (approval.Grant, error) {
+	grants, err := store.ListGrants(ctx, approval.GrantFilter{})
+	if err != nil {
+		return approval.Grant{}, err
+	}
+	var found []approval.Grant
+	for _, g := range grants {
+		if strings.HasPrefix(g.ID.String(), strings.ToLower(id)) {
+			found = append(found, g)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return approval.Grant{}, fmt.Errorf("grant %q not found", id)
+	case 1:
+		return found[0], nil
+	default:
+		return approval.Grant{}, fmt.Errorf("grant ID prefix %q is ambiguous (%d grants)", id, len(found))
+	}
+}
+
+func toGrantEntry(g approval.Grant) grantEntry {
+	e := grantEntry{
+		ID:         g.ID.String(),
+		Tool:       g.ToolName,
+		Scope:      string(g.Scope),
+		Subject:    g.Subject,
+		Channel:    g.Channel,
+		SessionKey: g.SessionKey,
+		CreatedAt:  g.CreatedAt,
+	}
+	if !g.ExpiresAt.IsZero() {
+		t := g.ExpiresAt
+		e.ExpiresAt = &t
+	}
+	return e
+}
+
+func expires(g approval.Grant, now time.Time) string {
+	switch {
+	case g.ExpiresAt.IsZero():
+		return "never"
+	case g.Expired(now):
+		return "expired"
+	default:
+		return g.ExpiresAt.Local().Format("2006-01-02 15:04")
+	}
+}
+
+func detail(e approval.HistoryEntry) string {
+	var parts []string
+	if e.Rule != "" {
+		parts = append(parts, fmt.Sprintf("rule %q", e.Rule))
+	}
+	if e.GrantID != "" {
+		parts = append(parts, fmt.Sprintf("grant %s (%s)", e.GrantID[:8], e.Scope))
+	}
+	if e.Error != "" {
+		parts = append(parts, "error: "+e.Error)
+	}
+	if e.Summary != "" {
+		parts = append(parts, e.Summary)
+	}
+	return strings.Join(parts, "; ")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func writeJSON(out io.Writer, v interface{}) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
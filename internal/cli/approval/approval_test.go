@@ -0,0 +1,60 @@
+package approval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/approval"
+	"github.com/langoai/lango/internal/cli/clitest"
+	"github.com/langoai/lango/internal/config"
+)
+
+func TestGrantsRevoke(t *testing.T) {
+	loader, client := clitest.BootLoader(t)
+	store := approval.NewEntStore(client)
+	ctx := context.Background()
+
+	exec, err := store.SaveGrant(ctx, approval.Grant{ToolName: "exec", Scope: config.GrantScopeSession, Subject: "s1", Channel: "gateway"})
+	require.NoError(t, err)
+	_, err = store.SaveGrant(ctx, approval.Grant{ToolName: "fs_write", Scope: config.GrantScopeGlobal, Channel: "telegram"})
+	require.NoError(t, err)
+
+	var grants []grantEntry
+	require.NoError(t, json.Unmarshal([]byte(clitest.Run(t, NewApprovalCmd(loader), "grants", "list", "--json")), &grants))
+	assert.Len(t, grants, 2)
+
+	out := clitest.Run(t, NewApprovalCmd(loader), "grants", "revoke", exec.ID.String()[:8])
+	assert.Contains(t, out, "Revoked 1 grant(s).")
+
+	remaining, err := store.ListGrants(ctx, approval.GrantFilter{})
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "fs_write", remaining[0].ToolName)
+
+	var history []historyEntry
+	require.NoError(t, json.Unmarshal([]byte(clitest.Run(t, NewApprovalCmd(loader), "history", "--json")), &history))
+	require.Len(t, history, 1)
+	assert.Equal(t, "revoked", history[0].Outcome)
+	assert.Equal(t, "cli", history[0].Source)
+	assert.Equal(t, exec.ID.String(), history[0].GrantID)
+}
+
+func TestGrantsRevoke_RequiresOneSelector(t *testing.T) {
+	loader, _ := clitest.BootLoader(t)
+	for _, args := range [][]string{
+		{"grants", "revoke"},
+		{"grants", "revoke", "abc", "--all"},
+		{"grants", "revoke", "--tool", "exec", "--all"},
+	} {
+		cmd := NewApprovalCmd(loader)
+		cmd.SetOut(&bytes.Buffer{})
+		cmd.SetErr(&bytes.Buffer{})
+		cmd.SetArgs(args)
+		assert.ErrorContains(t, cmd.Execute(), "exactly one", args)
+	}
+}
@@ -0,0 +1,443 @@
+// Package backup provides CLI commands to back up and restore Lango's data.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/backup"
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/cli/prompt"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/dbmigrate"
+	"github.com/langoai/lango/internal/security"
+)
+
+// NewBackupCmd creates the backup command with lazy bootstrap loading.
+// version is recorded in the manifest of new backups.
+func NewBackupCmd(bootLoader func() (*bootstrap.Result, error), version string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Create and restore encrypted backups",
+		Long: `Back up and restore Lango's data in a single encrypted file: the
+application database (sessions, memory, knowledge, secrets and the sqlite-vec
+embeddings), the graph store and the skills directory.
+
+Backups are encrypted with a key derived from a passphrase, or with a data key
+wrapped by the KMS configured in security.kms.`,
+	}
+
+	cmd.AddCommand(newCreateCmd(bootLoader, version))
+	cmd.AddCommand(newRestoreCmd(bootLoader))
+	cmd.AddCommand(newInspectCmd(bootLoader))
+
+	return cmd
+}
+
+func newCreateCmd(bootLoader func() (*bootstrap.Result, error), version string) *cobra.Command {
+	var (
+		only           []string
+		useKMS         bool
+		passphraseFile string
+		force          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create <file>",
+		Short: "Create an encrypted backup",
+		Long: `Create an encrypted backup file. The database is copied while Lango is
+running; the graph store is locked by a running server, so stop it first or
+leave the graph out with --only.
+
+With --kms, the backup key is wrapped with security.kms.keyId of the KMS
+provider in security.signer.provider; restoring needs access to the same key.
+Otherwise the key is derived from a backup passphrase, read from
+--passphrase-file or prompted for.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			components, err := backup.ParseComponents(only)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(path); err == nil && !force {
+				return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+			cfg := boot.Config
+
+			var key backup.Key
+			if useKMS {
+				if key, err = kmsKey(cfg, cfg.Security.Signer.Provider, cfg.Security.KMS.KeyID); err != nil {
+					return err
+				}
+			} else {
+				pass, err := readPassphrase(passphraseFile, true)
+				if err != nil {
+					return err
+				}
+				key = backup.PassphraseKey(pass)
+			}
+
+			paths := dataPaths(cfg)
+			m, err := writeBackup(path, backup.CreateOptions{
+				DB:          boot.RawDB,
+				DBEncrypted: bootstrap.IsDBEncrypted(paths.db),
+				GraphPath:   paths.graph,
+				SkillsDir:   paths.skills,
+				Components:  components,
+				Version:     version,
+				Key:         key,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Backup written to %s (%s, %d files).\n", path, joinComponents(m.Components()), len(m.Files))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&only, "only", nil, "Components to back up: db, graph, skills (default: all)")
+	cmd.Flags().BoolVar(&useKMS, "kms", false, "Wrap the backup key with the configured KMS key instead of a passphrase")
+	cmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "Read the backup passphrase from a file")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite an existing file")
+	return cmd
+}
+
+// writeBackup writes the backup to a temporary file next to path and renames
+// it into place, so a failed backup never leaves a partial file.
+func writeBackup(path string, opts backup.CreateOptions) (*backup.Manifest, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("create backup file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	m, err := backup.Create(context.Background(), tmp, opts)
+	if err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return nil, fmt.Errorf("write backup file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, fmt.Errorf("write backup file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return nil, fmt.Errorf("write backup file: %w", err)
+	}
+	return m, nil
+}
+
+func newRestoreCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		only           []string
+		passphraseFile string
+		force          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore <file>",
+		Short: "Restore data from an encrypted backup",
+		Long: `Restore data from a backup file. The whole backup is decrypted and its
+checksums verified before anything is replaced. A database backup is only
+restored if its schema is compatible with this version.
+
+Stop the server before restoring; the restore refuses to run while the graph
+database is locked. Replaced files are kept with a .pre-restore suffix. Use --only to restore some components, e.g. --only graph.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			var components []backup.Component
+			if len(only) > 0 {
+				var err error
+				if components, err = backup.ParseComponents(only); err != nil {
+					return err
+				}
+			}
+
+			header, err := backup.ReadHeader(path)
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				if !prompt.IsInteractive() {
+					return fmt.Errorf("this command requires an interactive terminal (use --force for non-interactive)")
+				}
+				ok, err := prompt.Confirm("This will replace your current data with the backup. Continue?")
+				if err != nil {
+					return err
+				}
+				if !ok {
+					fmt.Fprintln(cmd.OutOrStdout(), "Aborted.")
+					return nil
+				}
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			cfg := boot.Config
+
+			key, err := backupKey(cfg, header, passphraseFile)
+			if err != nil {
+				boot.DBClient.Close()
+				return err
+			}
+
+			var current *dbmigrate.Schema
+			if boot.RawDB != nil {
+				if current, err = dbmigrate.ReadSchema(context.Background(), boot.RawDB); err != nil {
+					boot.DBClient.Close()
+					return fmt.Errorf("read database schema: %w", err)
+				}
+			}
+			// The database must be closed before it is replaced.
+			boot.DBClient.Close()
+
+			f, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("open backup: %w", err)
+			}
+			defer f.Close()
+
+			paths := dataPaths(cfg)
+			m, restored, err := backup.Restore(context.Background(), f, backup.RestoreOptions{
+				DBPath:        paths.db,
+				GraphPath:     paths.graph,
+				SkillsDir:     paths.skills,
+				Components:    components,
+				CurrentSchema: current,
+				Key:           key,
+			})
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Restored %s from backup of %s (lango %s).\n",
+				joinComponents(restored), m.CreatedAt.Local().Format("2006-01-02 15:04"), m.LangoVersion)
+			if containsComponent(restored, backup.ComponentDB) && m.DBEncrypted {
+				fmt.Fprintln(out, "The restored database is encrypted with the passphrase in use when the backup was created.")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&only, "only", nil, "Components to restore: db, graph, skills (default: all in the backup)")
+	cmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "Read the backup passphrase from a file")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+	return cmd
+}
+
+func newInspectCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		passphraseFile string
+		jsonOutput     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "inspect <file>",
+		Short: "Verify a backup and show its manifest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+			header, err := backup.ReadHeader(path)
+			if err != nil {
+				return err
+			}
+
+			// The configuration is only needed to reach the KMS.
+			var cfg *config.Config
+			if header.KDF == backup.KDFKMS {
+				boot, err := bootLoader()
+				if err != nil {
+					return fmt.Errorf("bootstrap: %w", err)
+				}
+				boot.DBClient.Close()
+				cfg = boot.Config
+			}
+			key, err := backupKey(cfg, header, passphraseFile)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(path)
+			if err != nil {
+				return fmt.Errorf("open backup: %w", err)
+			}
+			defer f.Close()
+
+			m, err := backup.Verify(context.Background(), f, key)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(m)
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Created:     %s\n", m.CreatedAt.Local().Format("2006-01-02 15:04:05"))
+			fmt.Fprintf(out, "Version:     %s\n", m.LangoVersion)
+			fmt.Fprintf(out, "Encryption:  %s\n", keyDescription(header))
+			fmt.Fprintf(out, "Components:  %s\n", joinComponents(m.Components()))
+			if m.Schema != nil {
+				fmt.Fprintf(out, "DB tables:   %d\n", len(m.Schema.Tables))
+			}
+			fmt.Fprintln(out)
+
+			w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "COMPONENT\tPATH\tSIZE\tSHA256")
+			for _, f := range m.Files {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", f.Component, f.Path, f.Size, f.SHA256[:16])
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			fmt.Fprintln(out, "\nAll checksums verified.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "Read the backup passphrase from a file")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output the manifest as JSON")
+	return cmd
+}
+
+// backupKey returns the key that opens a backup with the given header.
+func backupKey(cfg *config.Config, h *backup.Header, passphraseFile string) (backup.Key, error) {
+	if h.KDF == backup.KDFKMS {
+		return kmsKey(cfg, h.KMSProvider, h.KMSKeyID)
+	}
+	pass, err := readPassphrase(passphraseFile, false)
+	if err != nil {
+		return nil, err
+	}
+	return backup.PassphraseKey(pass), nil
+}
+
+// kmsKey builds a backup key from the KMS provider with the configured KMS
+// settings.
+func kmsKey(cfg *config.Config, provider, keyID string) (backup.Key, error) {
+	if !security.KMSProviderName(provider).Valid() {
+		return nil, fmt.Errorf("--kms requires a KMS provider in security.signer.provider (aws-kms, gcp-kms, azure-kv, pkcs11, vault), got %q", provider)
+	}
+	if keyID == "" {
+		return nil, fmt.Errorf("--kms requires security.kms.keyId")
+	}
+	kms, err := security.NewKMSProvider(security.KMSProviderName(provider), cfg.Security.KMS)
+	if err != nil {
+		return nil, fmt.Errorf("init KMS provider %q: %w", provider, err)
+	}
+	return backup.KMSKey(kms, provider, keyID), nil
+}
+
+// readPassphrase reads the backup passphrase from file, or prompts for it;
+// with confirm, the prompt asks twice.
+func readPassphrase(file string, confirm bool) (string, error) {
+	var pass string
+	switch {
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", fmt.Errorf("read passphrase file: %w", err)
+		}
+		pass = strings.TrimRight(string(data), "\r\n")
+	case !prompt.IsInteractive():
+		return "", fmt.Errorf("backup passphrase required: use --passphrase-file in non-interactive mode")
+	case confirm:
+		var err error
+		if pass, err = prompt.PassphraseConfirm("Backup passphrase: ", "Confirm backup passphrase: "); err != nil {
+			return "", err
+		}
+	default:
+		var err error
+		if pass, err = prompt.Passphrase("Backup passphrase: "); err != nil {
+			return "", err
+		}
+	}
+	if pass == "" {
+		return "", errors.New("backup passphrase must not be empty")
+	}
+	return pass, nil
+}
+
+// dataLocations are the locations of the backed up data.
+type dataLocations struct {
+	db     string
+	graph  string
+	skills string
+}
+
+// dataPaths resolves the data locations like the application does.
+func dataPaths(cfg *config.Config) dataLocations {
+	p := dataLocations{
+		db:     expandHome(cfg.Session.DatabasePath),
+		graph:  expandHome(cfg.Graph.DatabasePath),
+		skills: expandHome(cfg.Skill.SkillsDir),
+	}
+	if p.graph == "" {
+		p.graph = filepath.Join(filepath.Dir(p.db), "graph.db")
+	}
+	if p.skills == "" {
+		p.skills = expandHome("~/.lango/skills")
+	}
+	return p
+}
+
+func expandHome(p string) string {
+	if strings.HasPrefix(p, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, p[2:])
+		}
+	}
+	return p
+}
+
+func keyDescription(h *backup.Header) string {
+	if h.KDF == backup.KDFKMS {
+		return fmt.Sprintf("KMS (%s, key %s)", h.KMSProvider, h.KMSKeyID)
+	}
+	return "passphrase (PBKDF2-SHA256)"
+}
+
+func joinComponents(components []backup.Component) string {
+	if len(components) == 0 {
+		return "nothing"
+	}
+	names := make([]string, len(components))
+	for i, c := range components {
+		names[i] = string(c)
+	}
+	return strings.Join(names, ", ")
+}
+
+func containsComponent(list []backup.Component, c backup.Component) bool {
+	for _, x := range list {
+		if x == c {
+			return true
+		}
+	}
+	return false
+}
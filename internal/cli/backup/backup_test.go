@@ -0,0 +1,115 @@
+package backup
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/backup"
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent/enttest"
+)
+
+// testBootLoader returns a loader over a database file in dir; the commands
+// close their client, so each run opens a new one.
+func testBootLoader(t *testing.T, dir string) func() (*bootstrap.Result, error) {
+	cfg := config.DefaultConfig()
+	cfg.Session.DatabasePath = filepath.Join(dir, "lango.db")
+	cfg.Graph.DatabasePath = filepath.Join(dir, "graph.db")
+	cfg.Skill.SkillsDir = filepath.Join(dir, "skills")
+	dsn := "file:" + cfg.Session.DatabasePath + "?_fk=1"
+
+	return func() (*bootstrap.Result, error) {
+		raw, err := sql.Open("sqlite3", dsn)
+		if err != nil {
+			return nil, err
+		}
+		t.Cleanup(func() { raw.Close() })
+		return &bootstrap.Result{Config: cfg, DBClient: enttest.Open(t, "sqlite3", dsn), RawDB: raw}, nil
+	}
+}
+
+func run(t *testing.T, loader func() (*bootstrap.Result, error), args ...string) (string, error) {
+	t.Helper()
+	cmd := NewBackupCmd(loader, "v1.2.3")
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestBackupCreateInspectRestore(t *testing.T) {
+	dir := t.TempDir()
+	loader := testBootLoader(t, dir)
+	skill := filepath.Join(dir, "skills", "deploy", "SKILL.md")
+	require.NoError(t, os.MkdirAll(filepath.Dir(skill), 0o700))
+	require.NoError(t, os.WriteFile(skill, []byte("# deploy"), 0o600))
+	passFile := filepath.Join(dir, "pass")
+	require.NoError(t, os.WriteFile(passFile, []byte("backup secret\n"), 0o600))
+	file := filepath.Join(dir, "lango.backup")
+
+	out, err := run(t, loader, "create", file, "--only", "db,skills", "--passphrase-file", passFile)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Backup written to "+file+" (db, skills, 2 files).")
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = run(t, loader, "create", file, "--passphrase-file", passFile)
+	assert.ErrorContains(t, err, "already exists")
+
+	out, err = run(t, loader, "inspect", file, "--json", "--passphrase-file", passFile)
+	require.NoError(t, err)
+	var m backup.Manifest
+	require.NoError(t, json.Unmarshal([]byte(out), &m))
+	assert.Equal(t, "v1.2.3", m.LangoVersion)
+	assert.Equal(t, []backup.Component{backup.ComponentDB, backup.ComponentSkills}, m.Components())
+	assert.Contains(t, m.Schema.Tables, "sessions")
+
+	require.NoError(t, os.WriteFile(skill, []byte("# changed"), 0o600))
+	out, err = run(t, loader, "restore", file, "--only", "skills", "--force", "--passphrase-file", passFile)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Restored skills from backup")
+
+	got, err := os.ReadFile(skill)
+	require.NoError(t, err)
+	assert.Equal(t, "# deploy", string(got))
+	prev, err := os.ReadFile(filepath.Join(dir, "skills.pre-restore", "deploy", "SKILL.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# changed", string(prev))
+
+	_, err = run(t, loader, "restore", file, "--only", "graph", "--force", "--passphrase-file", passFile)
+	assert.EqualError(t, err, "backup does not contain graph")
+}
+
+func TestBackupInspect_WrongPassphrase(t *testing.T) {
+	dir := t.TempDir()
+	loader := testBootLoader(t, dir)
+	passFile := filepath.Join(dir, "pass")
+	require.NoError(t, os.WriteFile(passFile, []byte("right"), 0o600))
+	wrongFile := filepath.Join(dir, "wrong")
+	require.NoError(t, os.WriteFile(wrongFile, []byte("wrong"), 0o600))
+	file := filepath.Join(dir, "lango.backup")
+
+	_, err := run(t, loader, "create", file, "--only", "db", "--passphrase-file", passFile)
+	require.NoError(t, err)
+
+	_, err = run(t, loader, "inspect", file, "--passphrase-file", wrongFile)
+	assert.ErrorIs(t, err, backup.ErrDecrypt)
+}
+
+func TestParseOnly(t *testing.T) {
+	_, err := run(t, testBootLoader(t, t.TempDir()), "create", "x.backup", "--only", "sessions")
+	assert.ErrorContains(t, err, `unknown backup component "sessions"`)
+}
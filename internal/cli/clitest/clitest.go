@@ -0,0 +1,47 @@
+// Package clitest provides fixtures for testing CLI commands that load their
+// dependencies through bootstrap.
+package clitest
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// BootLoader returns a bootstrap loader backed by a shared in-memory
+// database, and a client on that database for seeding and assertions.
+// Commands close their client, so each load opens a fresh client on the
+// same database.
+func BootLoader(t *testing.T) (func() (*bootstrap.Result, error), *ent.Client) {
+	t.Helper()
+	dsn := "file:" + t.Name() + "?mode=memory&cache=shared&_fk=1"
+	keep := enttest.Open(t, "sqlite3", dsn)
+	t.Cleanup(func() { keep.Close() })
+	rawDB, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { rawDB.Close() })
+	return func() (*bootstrap.Result, error) {
+		return &bootstrap.Result{Config: config.DefaultConfig(), DBClient: enttest.Open(t, "sqlite3", dsn), RawDB: rawDB}, nil
+	}, keep
+}
+
+// Run executes cmd with args and returns its combined output. The test
+// fails if the command returns an error.
+func Run(t *testing.T, cmd *cobra.Command, args ...string) string {
+	t.Helper()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+	return out.String()
+}
@@ -2,6 +2,7 @@ package checks
 
 import (
 	"context"
+	"fmt"
 	"os"
 
 	"github.com/langoai/lango/internal/config"
@@ -61,8 +62,58 @@ func (c *ChannelCheck) Run(ctx context.Context, cfg *config.Config) Result {
 		}
 	}
 
+	// Check Matrix
+	if cfg.Channels.Matrix.Enabled {
+		token := resolveEnvValue(cfg.Channels.Matrix.AccessToken)
+		if cfg.Channels.Matrix.HomeserverURL == "" {
+			issues = append(issues, "Matrix: homeserver URL not set")
+		} else if token == "" {
+			issues = append(issues, "Matrix: access token not set")
+		} else {
+			configured = append(configured, "Matrix")
+		}
+	}
+
+	// Check Email
+	if em := cfg.Channels.Email; em.Enabled {
+		switch {
+		case em.Address == "":
+			issues = append(issues, "Email: address not set")
+		case em.IMAP.Host == "" && em.Maildir == "":
+			issues = append(issues, "Email: neither IMAP host nor Maildir set")
+		case em.SMTP.Host == "":
+			issues = append(issues, "Email: SMTP host not set")
+		case len(em.Allowlist) == 0:
+			issues = append(issues, "Email: sender allowlist is empty")
+		default:
+			configured = append(configured, "Email")
+		}
+	}
+
+	// Check Webhook
+	if wh := cfg.Channels.Webhook; wh.Enabled {
+		before := len(issues)
+		for _, ep := range wh.Endpoints {
+			if resolveEnvValue(ep.Secret) == "" {
+				issues = append(issues, fmt.Sprintf("Webhook: endpoint %q has no secret", ep.Name))
+			}
+		}
+		for _, t := range wh.Targets {
+			if t.URL == "" {
+				issues = append(issues, fmt.Sprintf("Webhook: target %q has no URL", t.Name))
+			}
+		}
+		if len(wh.Endpoints) == 0 && len(wh.Targets) == 0 {
+			issues = append(issues, "Webhook: no endpoints or targets configured")
+		}
+		if len(issues) == before {
+			configured = append(configured, "Webhook")
+		}
+	}
+
 	// No channels enabled
-	if !cfg.Channels.Telegram.Enabled && !cfg.Channels.Discord.Enabled && !cfg.Channels.Slack.Enabled {
+	if !cfg.Channels.Telegram.Enabled && !cfg.Channels.Discord.Enabled && !cfg.Channels.Slack.Enabled &&
+		!cfg.Channels.Matrix.Enabled && !cfg.Channels.Email.Enabled && !cfg.Channels.Webhook.Enabled {
 		return Result{
 			Name:    c.Name(),
 			Status:  StatusWarn,
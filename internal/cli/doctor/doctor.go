@@ -33,7 +33,7 @@ Checks performed (14 total):
   - Configuration profile validity
   - AI provider configuration and API keys
   - API key security (env-var best practices)
-  - Channel token validation (Telegram, Discord, Slack)
+  - Channel token validation (Telegram, Discord, Slack, Matrix, Email, Webhook)
   - Session database accessibility
   - Server port availability
   - Security configuration (signer, interceptor, encryption)
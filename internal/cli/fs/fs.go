@@ -0,0 +1,182 @@
+// Package fs provides CLI commands for the filesystem tool undo journal.
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/tools/filesystem"
+)
+
+// historyEntry is one journal entry of the JSON output.
+type historyEntry struct {
+	ID         string    `json:"id"`
+	Time       time.Time `json:"time"`
+	SessionKey string    `json:"sessionKey,omitempty"`
+	Operation  string    `json:"operation"`
+	Path       string    `json:"path"`
+	Previous   string    `json:"previous"`
+	Undone     bool      `json:"undone"`
+}
+
+// NewFsCmd creates the fs command with lazy bootstrap loading.
+func NewFsCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fs",
+		Short: "Review and undo file changes made by the agent",
+	}
+
+	cmd.AddCommand(newHistoryCmd(bootLoader))
+	cmd.AddCommand(newUndoCmd(bootLoader))
+
+	return cmd
+}
+
+func newHistoryCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		limit      int
+		sessionKey string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show recent file changes recorded for undo",
+		Long: `Show the changes of fs_write, fs_edit, fs_apply_patch and fs_delete
+recorded in the undo journal, newest first. PREVIOUS tells what existed before
+the change: a file, a directory, a symlink or nothing (the change created the
+path).`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			journal := filesystem.NewEntJournal(boot.DBClient)
+			snapshots, err := journal.List(context.Background(), sessionKey, limit)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				out := make([]historyEntry, 0, len(snapshots))
+				for _, s := range snapshots {
+					out = append(out, historyEntry{
+						ID:         s.ID.String(),
+						Time:       s.CreatedAt,
+						SessionKey: s.SessionKey,
+						Operation:  string(s.Operation),
+						Path:       s.Path,
+						Previous:   string(s.Kind),
+						Undone:     s.Undone,
+					})
+				}
+				return writeJSON(cmd.OutOrStdout(), out)
+			}
+
+			if len(snapshots) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No file changes recorded.")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tSESSION\tOPERATION\tPATH\tPREVIOUS\tUNDONE")
+			for _, s := range snapshots {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n",
+					s.CreatedAt.Local().Format("2006-01-02 15:04:05"), orDash(s.SessionKey),
+					s.Operation, s.Path, s.Kind, s.Undone)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 20, "Maximum number of entries (0 for all)")
+	cmd.Flags().StringVar(&sessionKey, "session", "", "Only changes of this session")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func newUndoCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		sessionKey string
+		steps      int
+		force      bool
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "undo",
+		Short: "Undo the latest file changes of a session",
+		Long: `Restore the state before the latest fs_write, fs_edit, fs_apply_patch and
+fs_delete changes of a session, newest first. Find session keys with
+'lango fs history'.
+
+A path changed again after the recorded change is not overwritten unless
+--force is given.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if sessionKey == "" {
+				return fmt.Errorf("--session is required")
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			tool := filesystem.New(filesystem.Config{Journal: filesystem.NewEntJournal(boot.DBClient)})
+			results, undoErr := tool.Undo(context.Background(), sessionKey, steps, force)
+
+			if jsonOutput {
+				if err := writeJSON(cmd.OutOrStdout(), results); err != nil {
+					return err
+				}
+				return undoErr
+			}
+
+			if len(results) == 0 && undoErr == nil {
+				fmt.Fprintln(cmd.OutOrStdout(), "Nothing to undo.")
+				return nil
+			}
+			for _, r := range results {
+				fmt.Fprintf(cmd.OutOrStdout(), "Undid %s of %s (%s)\n", r.Operation, r.Path, restored(r.Restored))
+			}
+			return undoErr
+		},
+	}
+
+	cmd.Flags().StringVar(&sessionKey, "session", "", "Session whose changes to undo (required)")
+	cmd.Flags().IntVar(&steps, "steps", 1, "Number of changes to undo")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite paths changed after the recorded change")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func restored(kind string) string {
+	if kind == "removed" {
+		return "removed the created path"
+	}
+	return "restored the previous " + kind
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func writeJSON(out io.Writer, v interface{}) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
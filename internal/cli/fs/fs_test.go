@@ -0,0 +1,40 @@
+package fs
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/cli/clitest"
+	"github.com/langoai/lango/internal/session"
+	"github.com/langoai/lango/internal/tools/filesystem"
+)
+
+func TestUndo(t *testing.T) {
+	loader, client := clitest.BootLoader(t)
+	tool := filesystem.New(filesystem.Config{Journal: filesystem.NewEntJournal(client)})
+	ctx := session.WithSessionKey(context.Background(), "telegram:1:2")
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	require.NoError(t, os.WriteFile(path, []byte("mine"), 0644))
+	require.NoError(t, tool.Write(ctx, path, "agent", false))
+
+	var history []historyEntry
+	require.NoError(t, json.Unmarshal([]byte(clitest.Run(t, NewFsCmd(loader), "history", "--json")), &history))
+	require.Len(t, history, 1)
+	assert.Equal(t, "telegram:1:2", history[0].SessionKey)
+	assert.Equal(t, "file", history[0].Previous)
+
+	out := clitest.Run(t, NewFsCmd(loader), "undo", "--session", "telegram:1:2")
+	assert.Contains(t, out, "Undid write of "+path)
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "mine", string(got))
+
+	out = clitest.Run(t, NewFsCmd(loader), "undo", "--session", "telegram:1:2")
+	assert.Contains(t, out, "Nothing to undo.")
+}
@@ -0,0 +1,64 @@
+package knowledge
+
+import (
+	"fmt"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		force  bool
+		reason string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "delete <key>",
+		Short: "Delete a knowledge entry",
+		Long: `Delete removes a knowledge entry and its embedding and graph node. The final
+content stays in the entry's history, so it can be restored with
+'lango knowledge rollback'.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initSyncedKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := userContext(reason)
+
+			if !force {
+				entry, err := store.GetKnowledge(ctx, key)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("This will delete knowledge %q:\n  %s\n", key, truncate(entry.Content, 200))
+				if !confirm("Continue?") {
+					fmt.Println("Aborted.")
+					return nil
+				}
+			}
+
+			if err := store.DeleteKnowledge(ctx, key); err != nil {
+				return err
+			}
+
+			fmt.Printf("Deleted knowledge %q.\n", key)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+	cmd.Flags().StringVar(&reason, "reason", "", "Why the entry is deleted (recorded in its history)")
+
+	return cmd
+}
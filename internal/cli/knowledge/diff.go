@@ -0,0 +1,78 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/spf13/cobra"
+)
+
+func newDiffCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff <key> <from-version> [to-version]",
+		Short: "Show the changes between two revisions of a knowledge entry",
+		Long: `Diff prints a line diff of the content between two revisions. Without
+to-version, from-version is compared with the latest revision.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			from, err := parseVersion(args[1])
+			if err != nil {
+				return err
+			}
+			to := 0
+			if len(args) == 3 {
+				if to, err = parseVersion(args[2]); err != nil {
+					return err
+				}
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+			fromRev, err := store.GetKnowledgeRevision(ctx, key, from)
+			if err != nil {
+				return err
+			}
+
+			var toRev *knowledge.Revision
+			if to == 0 {
+				revs, err := store.ListKnowledgeRevisions(ctx, key)
+				if err != nil {
+					return err
+				}
+				latest := revs[len(revs)-1]
+				toRev = &latest
+			} else if toRev, err = store.GetKnowledgeRevision(ctx, key, to); err != nil {
+				return err
+			}
+
+			fmt.Print(knowledge.DiffRevisions(fromRev, toRev))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// parseVersion accepts a revision number with an optional "v" prefix.
+func parseVersion(s string) (int, error) {
+	v, err := strconv.Atoi(strings.TrimPrefix(s, "v"))
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("invalid version %q (want a positive number such as 3 or v3)", s)
+	}
+	return v, nil
+}
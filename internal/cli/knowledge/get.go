@@ -0,0 +1,66 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newGetCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "get <key>",
+		Short: "Show a knowledge entry",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			entry, err := store.GetKnowledge(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(record{
+					Key:      entry.Key,
+					Category: string(entry.Category),
+					Content:  entry.Content,
+					Tags:     entry.Tags,
+					Source:   entry.Source,
+				})
+			}
+
+			fmt.Printf("Key:       %s\n", entry.Key)
+			fmt.Printf("Category:  %s\n", entry.Category)
+			if len(entry.Tags) > 0 {
+				fmt.Printf("Tags:      %s\n", strings.Join(entry.Tags, ", "))
+			}
+			if entry.Source != "" {
+				fmt.Printf("Source:    %s\n", entry.Source)
+			}
+			fmt.Printf("\n%s\n", entry.Content)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
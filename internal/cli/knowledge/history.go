@@ -0,0 +1,79 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newHistoryCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "history <key>",
+		Short: "Show the revision history of a knowledge entry",
+		Long: `History lists every recorded revision of a knowledge key, oldest first, with
+the author (agent, user or librarian), session and reason of each change.
+Deleted keys keep their history.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			revs, err := store.ListKnowledgeRevisions(context.Background(), key)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(revs)
+			}
+
+			if len(revs) == 0 {
+				fmt.Printf("No history recorded for %q.\n", key)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tACTION\tAUTHOR\tCREATED\tSESSION\tREASON\tCONTENT")
+			for _, r := range revs {
+				fmt.Fprintf(w, "v%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					r.Version, r.Action, r.Author,
+					r.CreatedAt.Format("2006-01-02 15:04"),
+					orDash(r.SessionKey),
+					orDash(truncate(r.Reason, 30)),
+					truncate(r.Content, 50),
+				)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
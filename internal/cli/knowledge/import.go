@@ -0,0 +1,92 @@
+package knowledge
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/spf13/cobra"
+)
+
+func newImportCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import knowledge entries from JSONL",
+		Long: `Import reads one JSON object per line (as written by "lango knowledge export")
+and saves each entry by key, replacing existing entries with the same key.
+Use "-" to read from stdin. The whole file is validated before anything is written.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var r io.Reader = os.Stdin
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return fmt.Errorf("open input: %w", err)
+				}
+				defer f.Close()
+				r = f
+			}
+
+			entries, err := readKnowledgeJSONL(r)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := userContext("import")
+			for _, e := range entries {
+				if err := store.SaveKnowledge(ctx, "", e); err != nil {
+					return fmt.Errorf("import %q: %w", e.Key, err)
+				}
+			}
+
+			fmt.Printf("Imported %d knowledge entries.\n", len(entries))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// readKnowledgeJSONL parses and validates JSONL knowledge records.
+// Blank lines are skipped.
+func readKnowledgeJSONL(r io.Reader) ([]knowledge.KnowledgeEntry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	var entries []knowledge.KnowledgeEntry
+	for line := 1; scanner.Scan(); line++ {
+		raw := scanner.Bytes()
+		if len(bytes.TrimSpace(raw)) == 0 {
+			continue
+		}
+		var rec record
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entry, err := rec.toEntry()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read input: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,85 @@
+package knowledge
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/ent/enttest"
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/knowledge"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestStore(t *testing.T) *knowledge.Store {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return knowledge.NewStore(client, zap.NewNop().Sugar())
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	src := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, src.SaveKnowledge(ctx, "s", knowledge.KnowledgeEntry{
+		Key: "tabs", Category: entknowledge.CategoryPreference, Content: "User prefers tabs", Tags: []string{"style"},
+	}))
+	require.NoError(t, src.SaveKnowledge(ctx, "s", knowledge.KnowledgeEntry{
+		Key: "deploy", Category: entknowledge.CategoryRule, Content: "Never deploy on Friday",
+	}))
+
+	var buf bytes.Buffer
+	n, err := exportKnowledge(ctx, src, "rule", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n, "category filter applies")
+
+	buf.Reset()
+	n, err = exportKnowledge(ctx, src, "", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	entries, err := readKnowledgeJSONL(&buf)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	dst := newTestStore(t)
+	for _, e := range entries {
+		require.NoError(t, dst.SaveKnowledge(ctx, "", e))
+	}
+	got, err := dst.GetKnowledge(ctx, "tabs")
+	require.NoError(t, err)
+	assert.Equal(t, "User prefers tabs", got.Content)
+	assert.Equal(t, []string{"style"}, got.Tags)
+}
+
+func TestReadKnowledgeJSONL(t *testing.T) {
+	tests := []struct {
+		give    string
+		wantErr string
+		wantLen int
+	}{
+		{give: `{"key":"a","category":"fact","content":"x"}` + "\n\n" + `{"key":"b","category":"rule","content":"y"}`, wantLen: 2},
+		{give: `{"category":"fact","content":"x"}`, wantErr: "line 1: key is required"},
+		{give: `{"key":"a","category":"fact"}`, wantErr: "line 1: content is required"},
+		{give: "\n" + `{"key":"a","category":"gossip","content":"x"}`, wantErr: "line 2: invalid category"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got, err := readKnowledgeJSONL(strings.NewReader(tt.give))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got, tt.wantLen)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package knowledge implements the lango knowledge command.
+package knowledge
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent"
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/langoai/lango/internal/session"
+	"github.com/spf13/cobra"
+)
+
+// NewKnowledgeCmd creates the knowledge command with lazy config loading.
+func NewKnowledgeCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "knowledge",
+		Short: "Inspect and curate stored knowledge",
+	}
+
+	cmd.AddCommand(newListCmd(cfgLoader))
+	cmd.AddCommand(newGetCmd(cfgLoader))
+	cmd.AddCommand(newSaveCmd(cfgLoader))
+	cmd.AddCommand(newDeleteCmd(cfgLoader))
+	cmd.AddCommand(newSearchCmd(cfgLoader))
+	cmd.AddCommand(newStatsCmd(cfgLoader))
+	cmd.AddCommand(newExportCmd(cfgLoader))
+	cmd.AddCommand(newImportCmd(cfgLoader))
+	cmd.AddCommand(newHistoryCmd(cfgLoader))
+	cmd.AddCommand(newDiffCmd(cfgLoader))
+	cmd.AddCommand(newRollbackCmd(cfgLoader))
+
+	return cmd
+}
+
+// record is the JSON and JSONL representation of a knowledge entry.
+type record struct {
+	Key            string    `json:"key"`
+	Category       string    `json:"category"`
+	Content        string    `json:"content"`
+	Tags           []string  `json:"tags,omitempty"`
+	Source         string    `json:"source,omitempty"`
+	UseCount       int       `json:"use_count"`
+	RelevanceScore float64   `json:"relevance_score"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func toRecord(k *ent.Knowledge) record {
+	return record{
+		Key:            k.Key,
+		Category:       string(k.Category),
+		Content:        k.Content,
+		Tags:           k.Tags,
+		Source:         k.Source,
+		UseCount:       k.UseCount,
+		RelevanceScore: k.RelevanceScore,
+		CreatedAt:      k.CreatedAt,
+		UpdatedAt:      k.UpdatedAt,
+	}
+}
+
+// toEntry validates a record and converts it to a knowledge entry for saving.
+func (r record) toEntry() (knowledge.KnowledgeEntry, error) {
+	if r.Key == "" {
+		return knowledge.KnowledgeEntry{}, fmt.Errorf("key is required")
+	}
+	if r.Content == "" {
+		return knowledge.KnowledgeEntry{}, fmt.Errorf("content is required")
+	}
+	cat, err := parseCategory(r.Category)
+	if err != nil {
+		return knowledge.KnowledgeEntry{}, err
+	}
+	return knowledge.KnowledgeEntry{
+		Key:      r.Key,
+		Category: cat,
+		Content:  r.Content,
+		Tags:     r.Tags,
+		Source:   r.Source,
+	}, nil
+}
+
+func parseCategory(s string) (entknowledge.Category, error) {
+	cat := entknowledge.Category(s)
+	if err := entknowledge.CategoryValidator(cat); err != nil {
+		return "", fmt.Errorf("invalid category %q (want rule, definition, preference, fact, pattern or correction)", s)
+	}
+	return cat, nil
+}
+
+func initKnowledgeStore(cfg *config.Config) (*knowledge.Store, func(), error) {
+	store, err := session.NewEntStore(cfg.Session.DatabasePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open session store: %w", err)
+	}
+
+	ks := knowledge.NewStore(store.Client(), zap.NewNop().Sugar())
+	cleanup := func() {
+		store.Close()
+	}
+	return ks, cleanup, nil
+}
+
+// userContext attributes knowledge changes made from the CLI to the user.
+func userContext(reason string) context.Context {
+	return knowledge.WithRevisionInfo(context.Background(), knowledge.RevisionInfo{
+		Author: knowledge.AuthorUser,
+		Reason: reason,
+	})
+}
+
+// confirm asks a yes/no question on stdin and reports whether the answer was yes.
+func confirm(prompt string) bool {
+	fmt.Print(prompt + " [y/N] ")
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return false
+	}
+	answer := strings.TrimSpace(strings.ToLower(scanner.Text()))
+	return answer == "y" || answer == "yes"
+}
+
+func truncate(s string, n int) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	if len(s) > n {
+		return s[:n-3] + "..."
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		category   string
+		limit      int
+		offset     int
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List knowledge entries",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if category != "" {
+				if _, err := parseCategory(category); err != nil {
+					return err
+				}
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			entries, total, err := store.ListKnowledge(context.Background(), category, limit, offset)
+			if err != nil {
+				return err
+			}
+
+			records := make([]record, 0, len(entries))
+			for _, e := range entries {
+				records = append(records, toRecord(e))
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(map[string]interface{}{
+					"entries": records,
+					"total":   total,
+				})
+			}
+
+			if len(records) == 0 {
+				fmt.Println("No knowledge entries found.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "KEY\tCATEGORY\tUSES\tUPDATED\tCONTENT")
+			for _, r := range records {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
+					r.Key, r.Category, r.UseCount,
+					r.UpdatedAt.Format("2006-01-02 15:04"),
+					truncate(r.Content, 60),
+				)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if shown := offset + len(records); shown < total {
+				fmt.Printf("\nShowing %d-%d of %d. Use --offset to see more.\n", offset+1, shown, total)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "Filter by category: rule, definition, preference, fact, pattern, correction")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of entries to show")
+	cmd.Flags().IntVar(&offset, "offset", 0, "Number of entries to skip")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
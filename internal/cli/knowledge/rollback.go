@@ -0,0 +1,86 @@
+package knowledge
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/spf13/cobra"
+)
+
+func newRollbackCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		force  bool
+		reason string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rollback <key> <version>",
+		Short: "Restore a knowledge entry to an earlier revision",
+		Long: `Rollback restores the category, content, tags and source of a knowledge key
+from an earlier revision and records the restore as a new revision. Deleted
+keys are recreated. The entry's embedding and graph node are rebuilt when
+embedding or the graph store is configured.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			version, err := parseVersion(args[1])
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initSyncedKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := userContext(reason)
+
+			target, err := store.GetKnowledgeRevision(ctx, key, version)
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				current, err := store.GetKnowledge(ctx, key)
+				switch {
+				case errors.Is(err, knowledge.ErrKnowledgeNotFound):
+					fmt.Printf("Knowledge %q is deleted. This will recreate it from v%d:\n  %s\n",
+						key, version, truncate(target.Content, 200))
+				case err != nil:
+					return err
+				default:
+					fmt.Printf("This will restore %q to v%d:\n", key, version)
+					fmt.Print(knowledge.DiffContent(
+						fmt.Sprintf("%s current (%s)", key, current.Category), current.Content,
+						fmt.Sprintf("%s v%d (%s)", key, version, target.Category), target.Content,
+					))
+				}
+				if !confirm("Continue?") {
+					fmt.Println("Aborted.")
+					return nil
+				}
+			}
+
+			rev, err := store.RollbackKnowledge(ctx, key, version)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Rolled back %q to v%d (recorded as v%d).\n", key, version, rev.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+	cmd.Flags().StringVar(&reason, "reason", "", "Why the entry is rolled back (recorded in its history)")
+
+	return cmd
+}
@@ -0,0 +1,65 @@
+package knowledge
+
+import (
+	"fmt"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newSaveCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		category string
+		content  string
+		tags     []string
+		source   string
+		reason   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "save <key>",
+		Short: "Create or update a knowledge entry",
+		Long: `Save creates a knowledge entry, or replaces the category, content, tags and
+source of an existing entry with the same key.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entry, err := record{
+				Key:      args[0],
+				Category: category,
+				Content:  content,
+				Tags:     tags,
+				Source:   source,
+			}.toEntry()
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			if err := store.SaveKnowledge(userContext(reason), "", entry); err != nil {
+				return err
+			}
+
+			fmt.Printf("Saved knowledge %q.\n", entry.Key)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "fact", "Category: rule, definition, preference, fact, pattern, correction")
+	cmd.Flags().StringVar(&content, "content", "", "Knowledge content (required)")
+	cmd.Flags().StringSliceVar(&tags, "tags", nil, "Comma-separated tags")
+	cmd.Flags().StringVar(&source, "source", "", "Where the knowledge came from")
+	cmd.Flags().StringVar(&reason, "reason", "", "Why the entry is changing (recorded in its history)")
+	_ = cmd.MarkFlagRequired("content")
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newSearchCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		category   string
+		limit      int
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search knowledge entries by keyword",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if category != "" {
+				if _, err := parseCategory(category); err != nil {
+					return err
+				}
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			query := strings.Join(args, " ")
+			entries, err := store.SearchKnowledge(context.Background(), query, category, limit)
+			if err != nil {
+				return err
+			}
+
+			records := make([]record, 0, len(entries))
+			for _, e := range entries {
+				records = append(records, record{
+					Key:      e.Key,
+					Category: string(e.Category),
+					Content:  e.Content,
+					Tags:     e.Tags,
+					Source:   e.Source,
+				})
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(records)
+			}
+
+			if len(records) == 0 {
+				fmt.Println("No matching knowledge.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "KEY\tCATEGORY\tCONTENT")
+			for _, r := range records {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", r.Key, r.Category, truncate(r.Content, 70))
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "Filter by category")
+	cmd.Flags().IntVar(&limit, "limit", 10, "Maximum number of results")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package knowledge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/langoai/lango/internal/config"
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/spf13/cobra"
+)
+
+func newStatsCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show knowledge statistics",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			stats, err := store.GetKnowledgeStats(context.Background())
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(stats)
+			}
+
+			fmt.Printf("Knowledge Statistics\n")
+			fmt.Printf("  Total Entries: %d\n", stats.TotalCount)
+			fmt.Printf("  Total Uses:    %d\n", stats.TotalUseCount)
+			if stats.TotalCount > 0 {
+				fmt.Printf("  Oldest:        %s\n", stats.OldestEntry.Format("2006-01-02 15:04"))
+				fmt.Printf("  Newest:        %s\n", stats.NewestEntry.Format("2006-01-02 15:04"))
+			}
+			fmt.Println()
+
+			if len(stats.ByCategory) == 0 {
+				return nil
+			}
+
+			cats := make([]entknowledge.Category, 0, len(stats.ByCategory))
+			for c := range stats.ByCategory {
+				cats = append(cats, c)
+			}
+			sort.Slice(cats, func(i, j int) bool { return cats[i] < cats[j] })
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CATEGORY\tCOUNT")
+			for _, c := range cats {
+				fmt.Fprintf(w, "%s\t%d\n", c, stats.ByCategory[c])
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
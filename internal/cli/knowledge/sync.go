@@ -0,0 +1,124 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/embedding"
+	graphstore "github.com/langoai/lango/internal/graph"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/langoai/lango/internal/session"
+)
+
+// initSyncedKnowledgeStore opens the knowledge store with its vector and
+// graph hooks connected, so deletions and rollbacks made from the CLI keep
+// derived data in sync. Stores that are not configured are skipped.
+func initSyncedKnowledgeStore(cfg *config.Config) (*knowledge.Store, func(), error) {
+	store, err := session.NewEntStore(cfg.Session.DatabasePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open session store: %w", err)
+	}
+
+	ks := knowledge.NewStore(store.Client(), zap.NewNop().Sugar())
+	closers := []func(){func() { store.Close() }}
+
+	if cfg.Embedding.Provider != "" && store.DB() != nil {
+		wireEmbedding(cfg, store, ks)
+	}
+	if cfg.Graph.Enabled && cfg.Graph.DatabasePath != "" {
+		if closeGraph := wireGraph(cfg, ks); closeGraph != nil {
+			closers = append(closers, closeGraph)
+		}
+	}
+
+	cleanup := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+	return ks, cleanup, nil
+}
+
+// wireEmbedding re-embeds restored content synchronously and drops stale vectors.
+func wireEmbedding(cfg *config.Config, store *session.EntStore, ks *knowledge.Store) {
+	backendType, apiKey := cfg.ResolveEmbeddingProvider()
+	if backendType == "" {
+		return
+	}
+	registry, err := embedding.NewRegistry(embedding.ProviderConfig{
+		Provider:   backendType,
+		Model:      cfg.Embedding.Model,
+		Dimensions: cfg.Embedding.Dimensions,
+		APIKey:     apiKey,
+		BaseURL:    cfg.Embedding.Local.BaseURL,
+	}, nil, zap.NewNop().Sugar())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: embedding provider unavailable, vectors not re-synced: %v\n", err)
+		return
+	}
+	provider := registry.Provider()
+
+	vecStore, err := embedding.NewSQLiteVecStore(store.DB(), provider.Dimensions())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: vector store unavailable, vectors not re-synced: %v\n", err)
+		return
+	}
+
+	ks.SetEmbedRemoveCallback(func(id, collection string) {
+		if err := vecStore.Delete(context.Background(), collection, []string{id}); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: remove embedding: %v\n", err)
+		}
+	})
+	ks.SetEmbedCallback(func(id, collection, content string, metadata map[string]string) {
+		ctx := context.Background()
+		vectors, err := provider.Embed(ctx, []string{content})
+		if err != nil || len(vectors) != 1 {
+			fmt.Fprintf(os.Stderr, "warning: embed %s: %v\n", id, err)
+			return
+		}
+		if err := vecStore.Upsert(ctx, []embedding.VectorRecord{{
+			ID:         id,
+			Collection: collection,
+			Embedding:  vectors[0],
+			Metadata:   metadata,
+		}}); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: store embedding: %v\n", err)
+		}
+	})
+}
+
+// wireGraph rebuilds the containment node of restored content and drops
+// stale nodes and derived triples. Entity extraction runs only inside the
+// server, so restored content gains extracted triples on its next update.
+func wireGraph(cfg *config.Config, ks *knowledge.Store) func() {
+	gs, err := graphstore.NewBoltStore(cfg.Graph.DatabasePath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: graph store unavailable, graph not re-synced: %v\n", err)
+		return nil
+	}
+
+	ks.SetGraphRemoveCallback(func(id, collection string) {
+		ctx := context.Background()
+		if _, err := gs.RemoveNode(ctx, collection+":"+id); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: remove graph node: %v\n", err)
+		}
+		if _, err := gs.RemoveBySource(ctx, id); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: remove derived triples: %v\n", err)
+		}
+	})
+	ks.SetGraphCallback(func(id, collection, _ string, metadata map[string]string) {
+		if err := gs.AddTriple(context.Background(), graphstore.Triple{
+			Subject:   collection + ":" + id,
+			Predicate: graphstore.Contains,
+			Object:    "collection:" + collection,
+			Metadata:  metadata,
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: add graph node: %v\n", err)
+		}
+	})
+	return func() { gs.Close() }
+}
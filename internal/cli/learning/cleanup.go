@@ -0,0 +1,105 @@
+package learning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newCleanupCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		category      string
+		maxConfidence float64
+		olderThanDays int
+		dryRun        bool
+		force         bool
+		jsonOutput    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "Bulk-delete learnings by category, confidence or age",
+		Long: `Cleanup deletes every learning matching all of the given filters. At least one
+of --category, --max-confidence or --older-than-days is required. Use --dry-run
+to see how many learnings would be deleted.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if category != "" {
+				if _, err := parseCategory(category); err != nil {
+					return err
+				}
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+
+			var olderThan time.Time
+			if olderThanDays > 0 {
+				olderThan = time.Now().AddDate(0, 0, -olderThanDays)
+			}
+
+			n, err := store.CountLearningsWhere(ctx, category, maxConfidence, olderThan)
+			if err != nil {
+				return err
+			}
+
+			if dryRun {
+				if jsonOutput {
+					return json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
+						"would_delete": n,
+						"dry_run":      true,
+					})
+				}
+				fmt.Printf("%d learnings would be deleted.\n", n)
+				return nil
+			}
+
+			if n == 0 {
+				fmt.Println("No learnings match.")
+				return nil
+			}
+
+			if !force && !confirm(fmt.Sprintf("This will delete %d learnings. Continue?", n)) {
+				fmt.Println("Aborted.")
+				return nil
+			}
+
+			deleted, err := store.DeleteLearningsWhere(ctx, category, maxConfidence, olderThan)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				return json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
+					"deleted": deleted,
+					"dry_run": false,
+				})
+			}
+			fmt.Printf("Deleted %d learnings.\n", deleted)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "Delete only learnings in this category")
+	cmd.Flags().Float64Var(&maxConfidence, "max-confidence", 0, "Delete learnings with confidence at or below this value")
+	cmd.Flags().IntVar(&olderThanDays, "older-than-days", 0, "Delete learnings older than N days")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only report how many learnings would be deleted")
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
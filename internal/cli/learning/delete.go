@@ -0,0 +1,61 @@
+package learning
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newDeleteCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "delete <id>",
+		Short: "Delete a single learning",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+
+			if !force {
+				l, err := store.GetLearningEntity(ctx, id)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("This will delete learning %s:\n  %s -> %s\n", id, l.Trigger, truncate(l.Fix, 120))
+				if !confirm("Continue?") {
+					fmt.Println("Aborted.")
+					return nil
+				}
+			}
+
+			if err := store.DeleteLearning(ctx, id); err != nil {
+				return err
+			}
+
+			fmt.Printf("Deleted learning %s.\n", id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,99 @@
+package learning
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/spf13/cobra"
+)
+
+// exportPageSize is the number of learnings fetched per page during export.
+const exportPageSize = 500
+
+func newExportCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		category string
+		output   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export learnings as JSONL",
+		Long: `Export writes one JSON object per line to stdout, or to --output. Counters,
+confidence and review state are included so "lango learning import" can restore
+them exactly.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if category != "" {
+				if _, err := parseCategory(category); err != nil {
+					return err
+				}
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			var w io.Writer = os.Stdout
+			if output != "" && output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return fmt.Errorf("create output: %w", err)
+				}
+				defer f.Close()
+				w = f
+			}
+
+			n, err := exportLearnings(context.Background(), store, category, w)
+			if err != nil {
+				return err
+			}
+			if output != "" && output != "-" {
+				fmt.Printf("Exported %d learnings to %s.\n", n, output)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "Export only this category")
+	cmd.Flags().StringVarP(&output, "output", "o", "", "Output file (default stdout)")
+
+	return cmd
+}
+
+// exportLearnings writes all matching learnings to w as JSONL and returns the count.
+func exportLearnings(ctx context.Context, store *knowledge.Store, category string, w io.Writer) (int, error) {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	n := 0
+	for offset := 0; ; offset += exportPageSize {
+		entries, total, err := store.ListLearnings(ctx, category, 0, time.Time{}, exportPageSize, offset)
+		if err != nil {
+			return n, err
+		}
+		for _, e := range entries {
+			if err := enc.Encode(toRecord(e)); err != nil {
+				return n, fmt.Errorf("encode %s: %w", e.ID, err)
+			}
+			n++
+		}
+		if len(entries) == 0 || offset+len(entries) >= total {
+			break
+		}
+	}
+	return n, bw.Flush()
+}
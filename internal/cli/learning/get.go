@@ -0,0 +1,77 @@
+package learning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newGetCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Show a learning",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := parseID(args[0])
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			l, err := store.GetLearningEntity(context.Background(), id)
+			if err != nil {
+				return err
+			}
+			r := toRecord(l)
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(r)
+			}
+
+			fmt.Printf("ID:          %s\n", r.ID)
+			fmt.Printf("Category:    %s\n", r.Category)
+			fmt.Printf("Status:      %s\n", r.ReviewStatus)
+			fmt.Printf("Confidence:  %.2f\n", r.Confidence)
+			fmt.Printf("Outcomes:    %d occurrences, %d successes, %d failures\n",
+				r.OccurrenceCount, r.SuccessCount, r.FailureCount)
+			fmt.Printf("Trigger:     %s\n", r.Trigger)
+			if r.ErrorPattern != "" {
+				fmt.Printf("Error:       %s\n", r.ErrorPattern)
+			}
+			if r.Diagnosis != "" {
+				fmt.Printf("Diagnosis:   %s\n", r.Diagnosis)
+			}
+			if r.Fix != "" {
+				fmt.Printf("Fix:         %s\n", r.Fix)
+			}
+			if len(r.Tags) > 0 {
+				fmt.Printf("Tags:        %s\n", strings.Join(r.Tags, ", "))
+			}
+			fmt.Printf("Created:     %s\n", r.CreatedAt.Format("2006-01-02 15:04"))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package learning
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent"
+	"github.com/spf13/cobra"
+)
+
+func newImportCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import learnings from JSONL",
+		Long: `Import reads one JSON object per line (as written by "lango learning export").
+Learnings keep their ID, counters, confidence and review state; an existing
+learning with the same ID is overwritten. Records without a review_status are
+imported as pending. Use "-" to read from stdin. The whole file is validated
+before anything is written.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var r io.Reader = os.Stdin
+			if args[0] != "-" {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return fmt.Errorf("open input: %w", err)
+				}
+				defer f.Close()
+				r = f
+			}
+
+			learnings, err := readLearningJSONL(r)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+			var created, updated int
+			for _, l := range learnings {
+				isNew, err := store.ImportLearning(ctx, l)
+				if err != nil {
+					return fmt.Errorf("import %s: %w", l.ID, err)
+				}
+				if isNew {
+					created++
+				} else {
+					updated++
+				}
+			}
+
+			fmt.Printf("Imported %d learnings (%d new, %d updated).\n", len(learnings), created, updated)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// readLearningJSONL parses and validates JSONL learning records.
+// Blank lines are skipped.
+func readLearningJSONL(r io.Reader) ([]*ent.Learning, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	var learnings []*ent.Learning
+	for line := 1; scanner.Scan(); line++ {
+		raw := scanner.Bytes()
+		if len(bytes.TrimSpace(raw)) == 0 {
+			continue
+		}
+		var rec record
+		if err := json.Unmarshal(raw, &rec); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		l, err := rec.toLearning()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		learnings = append(learnings, l)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read input: %w", err)
+	}
+	return learnings, nil
+}
@@ -0,0 +1,100 @@
+package learning
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/ent/enttest"
+	entlearning "github.com/langoai/lango/internal/ent/learning"
+	"github.com/langoai/lango/internal/knowledge"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestStore(t *testing.T) *knowledge.Store {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return knowledge.NewStore(client, zap.NewNop().Sugar())
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	src := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, src.SaveLearning(ctx, "s", knowledge.LearningEntry{
+		Trigger:  "tool:exec",
+		Fix:      "retry",
+		Category: entlearning.CategoryToolError,
+	}))
+	require.NoError(t, src.SaveLearning(ctx, "s", knowledge.LearningEntry{
+		Trigger:      "slow provider",
+		Fix:          "raise timeout",
+		Category:     entlearning.CategoryTimeout,
+		ReviewStatus: entlearning.ReviewStatusApproved,
+	}))
+
+	var buf bytes.Buffer
+	n, err := exportLearnings(ctx, src, "", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, strings.Count(buf.String(), "\n"))
+
+	learnings, err := readLearningJSONL(&buf)
+	require.NoError(t, err)
+	require.Len(t, learnings, 2)
+
+	dst := newTestStore(t)
+	for _, l := range learnings {
+		isNew, err := dst.ImportLearning(ctx, l)
+		require.NoError(t, err)
+		assert.True(t, isNew)
+	}
+
+	srcStats, err := src.GetLearningStats(ctx)
+	require.NoError(t, err)
+	dstStats, err := dst.GetLearningStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, srcStats.TotalCount, dstStats.TotalCount)
+	assert.Equal(t, srcStats.PendingReview, dstStats.PendingReview)
+	assert.Equal(t, srcStats.ByCategory, dstStats.ByCategory)
+}
+
+func TestReadLearningJSONL(t *testing.T) {
+	tests := []struct {
+		give    string
+		wantErr string
+		wantLen int
+	}{
+		{
+			give:    `{"trigger":"t","category":"general"}` + "\n\n" + `{"trigger":"u","category":"timeout","review_status":"approved"}`,
+			wantLen: 2,
+		},
+		{give: `{"category":"general"}`, wantErr: "line 1: trigger is required"},
+		{give: `{"trigger":"t","category":"nope"}`, wantErr: "line 1: invalid category"},
+		{give: "\n" + `{"trigger":"t","category":"general","review_status":"maybe"}`, wantErr: "line 2: invalid review_status"},
+		{give: `{"trigger":`, wantErr: "line 1:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			got, err := readLearningJSONL(strings.NewReader(tt.give))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got, tt.wantLen)
+			for _, l := range got {
+				assert.NotZero(t, l.ID, "missing IDs are generated")
+				assert.Equal(t, 0.5, l.Confidence, "missing confidence defaults to 0.5")
+			}
+		})
+	}
+}
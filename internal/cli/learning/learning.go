@@ -0,0 +1,176 @@
+// Package learning implements the lango learning command.
+package learning
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent"
+	entlearning "github.com/langoai/lango/internal/ent/learning"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/langoai/lango/internal/session"
+	"github.com/spf13/cobra"
+)
+
+// NewLearningCmd creates the learning command with lazy config loading.
+func NewLearningCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "learning",
+		Short: "Review and manage learned error fixes",
+	}
+
+	cmd.AddCommand(newListCmd(cfgLoader))
+	cmd.AddCommand(newGetCmd(cfgLoader))
+	cmd.AddCommand(newSaveCmd(cfgLoader))
+	cmd.AddCommand(newDeleteCmd(cfgLoader))
+	cmd.AddCommand(newSearchCmd(cfgLoader))
+	cmd.AddCommand(newStatsCmd(cfgLoader))
+	cmd.AddCommand(newCleanupCmd(cfgLoader))
+	cmd.AddCommand(newExportCmd(cfgLoader))
+	cmd.AddCommand(newImportCmd(cfgLoader))
+	cmd.AddCommand(newReviewCmd(cfgLoader))
+
+	return cmd
+}
+
+// record is the JSON and JSONL representation of a learning.
+type record struct {
+	ID              uuid.UUID `json:"id"`
+	Trigger         string    `json:"trigger"`
+	ErrorPattern    string    `json:"error_pattern,omitempty"`
+	Diagnosis       string    `json:"diagnosis,omitempty"`
+	Fix             string    `json:"fix,omitempty"`
+	Category        string    `json:"category"`
+	Tags            []string  `json:"tags,omitempty"`
+	OccurrenceCount int       `json:"occurrence_count"`
+	SuccessCount    int       `json:"success_count"`
+	FailureCount    int       `json:"failure_count"`
+	Confidence      float64   `json:"confidence"`
+	ReviewStatus    string    `json:"review_status"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func toRecord(l *ent.Learning) record {
+	return record{
+		ID:              l.ID,
+		Trigger:         l.Trigger,
+		ErrorPattern:    l.ErrorPattern,
+		Diagnosis:       l.Diagnosis,
+		Fix:             l.Fix,
+		Category:        string(l.Category),
+		Tags:            l.Tags,
+		OccurrenceCount: l.OccurrenceCount,
+		SuccessCount:    l.SuccessCount,
+		FailureCount:    l.FailureCount,
+		Confidence:      l.Confidence,
+		ReviewStatus:    string(l.ReviewStatus),
+		CreatedAt:       l.CreatedAt,
+		UpdatedAt:       l.UpdatedAt,
+	}
+}
+
+// toLearning validates a record and converts it for ImportLearning.
+// Records without an ID get a fresh one.
+func (r record) toLearning() (*ent.Learning, error) {
+	if r.Trigger == "" {
+		return nil, fmt.Errorf("trigger is required")
+	}
+	cat, err := parseCategory(r.Category)
+	if err != nil {
+		return nil, err
+	}
+	status := entlearning.ReviewStatus(r.ReviewStatus)
+	if status != "" {
+		if err := entlearning.ReviewStatusValidator(status); err != nil {
+			return nil, fmt.Errorf("invalid review_status %q", r.ReviewStatus)
+		}
+	}
+	if r.Confidence < 0 || r.Confidence > 1 {
+		return nil, fmt.Errorf("confidence must be in [0, 1], got %v", r.Confidence)
+	}
+
+	id := r.ID
+	if id == uuid.Nil {
+		id = uuid.New()
+	}
+	confidence := r.Confidence
+	if confidence == 0 {
+		confidence = 0.5
+	}
+	occurrences := r.OccurrenceCount
+	if occurrences == 0 {
+		occurrences = 1
+	}
+
+	return &ent.Learning{
+		ID:              id,
+		Trigger:         r.Trigger,
+		ErrorPattern:    r.ErrorPattern,
+		Diagnosis:       r.Diagnosis,
+		Fix:             r.Fix,
+		Category:        cat,
+		Tags:            r.Tags,
+		OccurrenceCount: occurrences,
+		SuccessCount:    r.SuccessCount,
+		FailureCount:    r.FailureCount,
+		Confidence:      confidence,
+		ReviewStatus:    status,
+		CreatedAt:       r.CreatedAt,
+	}, nil
+}
+
+func parseCategory(s string) (entlearning.Category, error) {
+	cat := entlearning.Category(s)
+	if err := entlearning.CategoryValidator(cat); err != nil {
+		return "", fmt.Errorf("invalid category %q (want tool_error, provider_error, user_correction, timeout, permission or general)", s)
+	}
+	return cat, nil
+}
+
+func parseID(s string) (uuid.UUID, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid id %q: %w", s, err)
+	}
+	return id, nil
+}
+
+func initKnowledgeStore(cfg *config.Config) (*knowledge.Store, func(), error) {
+	store, err := session.NewEntStore(cfg.Session.DatabasePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open session store: %w", err)
+	}
+
+	ks := knowledge.NewStore(store.Client(), zap.NewNop().Sugar())
+	cleanup := func() {
+		store.Close()
+	}
+	return ks, cleanup, nil
+}
+
+// confirm asks a yes/no question on stdin and reports whether the answer was yes.
+func confirm(prompt string) bool {
+	fmt.Print(prompt + " [y/N] ")
+	scanner := bufio.NewScanner(os.Stdin)
+	if !scanner.Scan() {
+		return false
+	}
+	answer := strings.TrimSpace(strings.ToLower(scanner.Text()))
+	return answer == "y" || answer == "yes"
+}
+
+func truncate(s string, n int) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	if len(s) > n {
+		return s[:n-3] + "..."
+	}
+	return s
+}
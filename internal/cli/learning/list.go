@@ -0,0 +1,102 @@
+package learning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newListCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		category      string
+		minConfidence float64
+		olderThanDays int
+		limit         int
+		offset        int
+		jsonOutput    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List learnings, newest first",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if category != "" {
+				if _, err := parseCategory(category); err != nil {
+					return err
+				}
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			var olderThan time.Time
+			if olderThanDays > 0 {
+				olderThan = time.Now().AddDate(0, 0, -olderThanDays)
+			}
+
+			entries, total, err := store.ListLearnings(context.Background(), category, minConfidence, olderThan, limit, offset)
+			if err != nil {
+				return err
+			}
+
+			records := make([]record, 0, len(entries))
+			for _, e := range entries {
+				records = append(records, toRecord(e))
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(map[string]interface{}{
+					"entries": records,
+					"total":   total,
+				})
+			}
+
+			if len(records) == 0 {
+				fmt.Println("No learnings found.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tCATEGORY\tCONF\tSTATUS\tCREATED\tTRIGGER\tFIX")
+			for _, r := range records {
+				fmt.Fprintf(w, "%s\t%s\t%.2f\t%s\t%s\t%s\t%s\n",
+					r.ID, r.Category, r.Confidence, r.ReviewStatus,
+					r.CreatedAt.Format("2006-01-02 15:04"),
+					truncate(r.Trigger, 30), truncate(r.Fix, 40),
+				)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			if shown := offset + len(records); shown < total {
+				fmt.Printf("\nShowing %d-%d of %d. Use --offset to see more.\n", offset+1, shown, total)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&category, "category", "", "Filter by category: tool_error, provider_error, user_correction, timeout, permission, general")
+	cmd.Flags().Float64Var(&minConfidence, "min-confidence", 0, "Only show learnings with at least this confidence")
+	cmd.Flags().IntVar(&olderThanDays, "older-than-days", 0, "Only show learnings older than N days")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of entries to show")
+	cmd.Flags().IntVar(&offset, "offset", 0, "Number of entries to skip")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
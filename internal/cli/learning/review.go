@@ -0,0 +1,305 @@
+package learning
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/cli/tui"
+	"github.com/langoai/lango/internal/cli/tuicore"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent"
+	entlearning "github.com/langoai/lango/internal/ent/learning"
+	"github.com/langoai/lango/internal/knowledge"
+)
+
+func newReviewCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "review",
+		Short: "Approve, edit or reject pending learnings",
+		Long: `Open an interactive queue of learnings awaiting review.
+
+Learnings extracted from tool errors and conversation analysis start as
+pending and are never auto-applied until approved. User corrections are
+approved on save. Rejected learnings are kept for reference but excluded
+from search and auto-apply.
+
+Keys:
+  a  approve    r  reject    e  edit    s  skip    q  quit`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+			pending, err := store.ListLearningsByReviewStatus(ctx, entlearning.ReviewStatusPending, limit)
+			if err != nil {
+				return err
+			}
+			if len(pending) == 0 {
+				fmt.Println("No learnings awaiting review.")
+				return nil
+			}
+
+			model, err := tea.NewProgram(newReviewModel(ctx, store, pending)).Run()
+			if err != nil {
+				return fmt.Errorf("learning review: %w", err)
+			}
+
+			r := model.(*reviewModel)
+			fmt.Printf("Reviewed: %d approved, %d rejected, %d edited, %d skipped.\n",
+				r.approved, r.rejected, r.edited, len(r.items)-r.approved-r.rejected)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 0, "Maximum number of pending learnings to load (0 = all)")
+
+	return cmd
+}
+
+// reviewStore is the subset of knowledge.Store used by the review TUI.
+type reviewStore interface {
+	SetLearningReviewStatus(ctx context.Context, id uuid.UUID, status entlearning.ReviewStatus) error
+	UpdateLearning(ctx context.Context, id uuid.UUID, entry knowledge.LearningEntry, confidence float64) error
+}
+
+// reviewModel is the bubbletea model for the learning review queue.
+type reviewModel struct {
+	ctx   context.Context
+	store reviewStore
+
+	items  []*ent.Learning
+	cursor int
+	form   *tuicore.FormModel
+	err    error
+
+	approved int
+	rejected int
+	edited   int
+}
+
+func newReviewModel(ctx context.Context, store reviewStore, items []*ent.Learning) *reviewModel {
+	return &reviewModel{ctx: ctx, store: store, items: items}
+}
+
+// Init implements tea.Model.
+func (m *reviewModel) Init() tea.Cmd {
+	return tea.ClearScreen
+}
+
+// Update implements tea.Model.
+func (m *reviewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	if key.String() == "ctrl+c" {
+		return m, tea.Quit
+	}
+
+	if m.form != nil {
+		if key.String() == "esc" && !m.form.HasOpenDropdown() {
+			m.saveForm()
+			m.form = nil
+			return m, nil
+		}
+		form, cmd := m.form.Update(msg)
+		m.form = &form
+		return m, cmd
+	}
+
+	if m.done() {
+		return m, tea.Quit
+	}
+
+	switch key.String() {
+	case "a":
+		m.setStatus(entlearning.ReviewStatusApproved)
+	case "r":
+		m.setStatus(entlearning.ReviewStatusRejected)
+	case "e":
+		m.form = newEditForm(m.current())
+		m.err = nil
+		return m, m.form.Init()
+	case "s", "n", "right":
+		m.cursor++
+		m.err = nil
+	case "q", "esc":
+		return m, tea.Quit
+	}
+
+	if m.done() {
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+func (m *reviewModel) done() bool {
+	return m.cursor >= len(m.items)
+}
+
+func (m *reviewModel) current() *ent.Learning {
+	return m.items[m.cursor]
+}
+
+func (m *reviewModel) setStatus(status entlearning.ReviewStatus) {
+	l := m.current()
+	if err := m.store.SetLearningReviewStatus(m.ctx, l.ID, status); err != nil {
+		m.err = err
+		return
+	}
+	l.ReviewStatus = status
+	switch status {
+	case entlearning.ReviewStatusApproved:
+		m.approved++
+	case entlearning.ReviewStatusRejected:
+		m.rejected++
+	}
+	m.err = nil
+	m.cursor++
+}
+
+// saveForm writes the edit form back to the current learning. The learning
+// stays pending so it can be approved or rejected after editing.
+func (m *reviewModel) saveForm() {
+	l := m.current()
+	values := make(map[string]string, len(m.form.Fields))
+	for _, f := range m.form.Fields {
+		values[f.Key] = strings.TrimSpace(f.Value)
+	}
+
+	confidence, err := strconv.ParseFloat(values["confidence"], 64)
+	if err != nil || confidence <= 0 || confidence > 1 {
+		m.err = fmt.Errorf("confidence must be in (0, 1], got %q", values["confidence"])
+		return
+	}
+
+	entry := knowledge.LearningEntry{
+		Trigger:      l.Trigger,
+		ErrorPattern: l.ErrorPattern,
+		Diagnosis:    values["diagnosis"],
+		Fix:          values["fix"],
+		Category:     entlearning.Category(values["category"]),
+		Tags:         l.Tags,
+	}
+	if err := m.store.UpdateLearning(m.ctx, l.ID, entry, confidence); err != nil {
+		m.err = err
+		return
+	}
+
+	l.Diagnosis = entry.Diagnosis
+	l.Fix = entry.Fix
+	l.Category = entry.Category
+	l.Confidence = confidence
+	m.edited++
+	m.err = nil
+}
+
+func newEditForm(l *ent.Learning) *tuicore.FormModel {
+	form := tuicore.NewFormModel("Edit Learning")
+	form.AddField(&tuicore.Field{
+		Key: "diagnosis", Label: "Diagnosis", Type: tuicore.InputText,
+		Value: l.Diagnosis, Width: 60,
+		Description: "Why the error happened",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "fix", Label: "Fix", Type: tuicore.InputText,
+		Value: l.Fix, Width: 60,
+		Description: "Instruction injected into the agent when the error recurs",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "category", Label: "Category", Type: tuicore.InputSelect,
+		Value: string(l.Category),
+		Options: []string{
+			string(entlearning.CategoryToolError),
+			string(entlearning.CategoryProviderError),
+			string(entlearning.CategoryUserCorrection),
+			string(entlearning.CategoryTimeout),
+			string(entlearning.CategoryPermission),
+			string(entlearning.CategoryGeneral),
+		},
+	})
+	form.AddField(&tuicore.Field{
+		Key: "confidence", Label: "Confidence", Type: tuicore.InputText,
+		Value:       strconv.FormatFloat(l.Confidence, 'f', 2, 64),
+		Description: "Fixes are auto-applied above 0.70 once approved",
+	})
+	form.Focus = true
+	return &form
+}
+
+// View implements tea.Model.
+func (m *reviewModel) View() string {
+	if m.form != nil {
+		var b strings.Builder
+		b.WriteString(m.form.View())
+		b.WriteString("\n")
+		b.WriteString(tui.FieldDescStyle.Render("Esc saves changes and returns to the queue."))
+		b.WriteString("\n")
+		return b.String()
+	}
+	if m.done() {
+		return ""
+	}
+
+	l := m.current()
+	label := lipgloss.NewStyle().Width(14).Foreground(tui.Muted)
+
+	var b strings.Builder
+	b.WriteString(tui.FormTitleBarStyle.Render(
+		fmt.Sprintf("Learning Review (%d/%d)", m.cursor+1, len(m.items))))
+	b.WriteString("\n")
+
+	rows := []struct{ k, v string }{
+		{"ID", l.ID.String()},
+		{"Category", string(l.Category)},
+		{"Trigger", l.Trigger},
+		{"Error", l.ErrorPattern},
+		{"Diagnosis", l.Diagnosis},
+		{"Fix", l.Fix},
+		{"Confidence", fmt.Sprintf("%.2f", l.Confidence)},
+		{"Outcomes", fmt.Sprintf("%d success / %d failure", l.SuccessCount, l.FailureCount)},
+		{"Created", l.CreatedAt.Format("2006-01-02 15:04")},
+	}
+	for _, r := range rows {
+		v := r.v
+		if v == "" {
+			v = tui.MutedStyle.Render("(none)")
+		}
+		b.WriteString(label.Render(r.k))
+		b.WriteString(v)
+		b.WriteString("\n")
+	}
+
+	if m.err != nil {
+		b.WriteString("\n")
+		b.WriteString(tui.ErrorStyle.Render(m.err.Error()))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(tui.HelpBar(
+		tui.HelpEntry("a", "Approve"),
+		tui.HelpEntry("r", "Reject"),
+		tui.HelpEntry("e", "Edit"),
+		tui.HelpEntry("s", "Skip"),
+		tui.HelpEntry("q", "Quit"),
+	))
+	return b.String()
+}
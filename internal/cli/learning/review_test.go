@@ -0,0 +1,113 @@
+package learning
+
+import (
+	"context"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/ent"
+	entlearning "github.com/langoai/lango/internal/ent/learning"
+	"github.com/langoai/lango/internal/knowledge"
+)
+
+type fakeReviewStore struct {
+	statuses map[uuid.UUID]entlearning.ReviewStatus
+	updates  map[uuid.UUID]knowledge.LearningEntry
+}
+
+func newFakeReviewStore() *fakeReviewStore {
+	return &fakeReviewStore{
+		statuses: make(map[uuid.UUID]entlearning.ReviewStatus),
+		updates:  make(map[uuid.UUID]knowledge.LearningEntry),
+	}
+}
+
+func (f *fakeReviewStore) SetLearningReviewStatus(_ context.Context, id uuid.UUID, status entlearning.ReviewStatus) error {
+	f.statuses[id] = status
+	return nil
+}
+
+func (f *fakeReviewStore) UpdateLearning(_ context.Context, id uuid.UUID, entry knowledge.LearningEntry, _ float64) error {
+	f.updates[id] = entry
+	return nil
+}
+
+func testLearnings(n int) []*ent.Learning {
+	items := make([]*ent.Learning, n)
+	for i := range items {
+		items[i] = &ent.Learning{
+			ID:           uuid.New(),
+			Trigger:      "tool:exec",
+			Fix:          "retry",
+			Category:     entlearning.CategoryToolError,
+			Confidence:   0.5,
+			ReviewStatus: entlearning.ReviewStatusPending,
+		}
+	}
+	return items
+}
+
+func key(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestReviewModel_ApproveRejectSkip(t *testing.T) {
+	store := newFakeReviewStore()
+	items := testLearnings(3)
+	m := newReviewModel(context.Background(), store, items)
+
+	_, cmd := m.Update(key("a"))
+	assert.Nil(t, cmd)
+	_, cmd = m.Update(key("s"))
+	assert.Nil(t, cmd)
+	_, cmd = m.Update(key("r"))
+	assert.NotNil(t, cmd, "reviewing the last item should quit")
+
+	assert.Equal(t, entlearning.ReviewStatusApproved, store.statuses[items[0].ID])
+	assert.NotContains(t, store.statuses, items[1].ID)
+	assert.Equal(t, entlearning.ReviewStatusRejected, store.statuses[items[2].ID])
+	assert.Equal(t, 1, m.approved)
+	assert.Equal(t, 1, m.rejected)
+}
+
+func TestReviewModel_EditSavesOnEsc(t *testing.T) {
+	store := newFakeReviewStore()
+	items := testLearnings(1)
+	m := newReviewModel(context.Background(), store, items)
+
+	m.Update(key("e"))
+	require.NotNil(t, m.form)
+
+	// Replace the fix field (second field).
+	m.form.Cursor = 1
+	m.form.Fields[1].Value = "use --force"
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Nil(t, m.form)
+	require.Contains(t, store.updates, items[0].ID)
+	assert.Equal(t, "use --force", store.updates[items[0].ID].Fix)
+	assert.Equal(t, "use --force", items[0].Fix)
+	assert.Equal(t, 0, m.cursor, "editing keeps the learning in the queue")
+	assert.NotContains(t, store.statuses, items[0].ID)
+
+	m.Update(key("a"))
+	assert.Equal(t, entlearning.ReviewStatusApproved, store.statuses[items[0].ID])
+}
+
+func TestReviewModel_EditRejectsBadConfidence(t *testing.T) {
+	store := newFakeReviewStore()
+	items := testLearnings(1)
+	m := newReviewModel(context.Background(), store, items)
+
+	m.Update(key("e"))
+	require.NotNil(t, m.form)
+	m.form.Fields[3].Value = "1.5"
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Error(t, m.err)
+	assert.Empty(t, store.updates)
+}
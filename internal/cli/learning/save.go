@@ -0,0 +1,72 @@
+package learning
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/langoai/lango/internal/config"
+	entlearning "github.com/langoai/lango/internal/ent/learning"
+	"github.com/langoai/lango/internal/knowledge"
+	"github.com/spf13/cobra"
+)
+
+func newSaveCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		errorPattern string
+		diagnosis    string
+		fix          string
+		category     string
+		tags         []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "save <trigger>",
+		Short: "Record an error pattern and its fix",
+		Long: `Save records a learning written by an operator. Operator-authored learnings
+are approved immediately and can be auto-applied once their confidence exceeds
+the auto-apply threshold.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cat, err := parseCategory(category)
+			if err != nil {
+				return err
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			err = store.SaveLearning(context.Background(), "", knowledge.LearningEntry{
+				Trigger:      args[0],
+				ErrorPattern: errorPattern,
+				Diagnosis:    diagnosis,
+				Fix:          fix,
+				Category:     cat,
+				Tags:         tags,
+				ReviewStatus: entlearning.ReviewStatusApproved,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Saved learning for %q.\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&errorPattern, "error-pattern", "", "Error pattern to match")
+	cmd.Flags().StringVar(&diagnosis, "diagnosis", "", "Why the error happens")
+	cmd.Flags().StringVar(&fix, "fix", "", "Fix or workaround (required)")
+	cmd.Flags().StringVar(&category, "category", "general", "Category: tool_error, provider_error, user_correction, timeout, permission, general")
+	cmd.Flags().StringSliceVar(&tags, "tags", nil, "Comma-separated tags")
+	_ = cmd.MarkFlagRequired("fix")
+
+	return cmd
+}
@@ -0,0 +1,80 @@
+package learning
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/langoai/lango/internal/config"
+	entlearning "github.com/langoai/lango/internal/ent/learning"
+	"github.com/spf13/cobra"
+)
+
+func newStatsCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show learning statistics",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, cleanup, err := initKnowledgeStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			stats, err := store.GetLearningStats(context.Background())
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(stats)
+			}
+
+			fmt.Printf("Learning Statistics\n")
+			fmt.Printf("  Total Learnings:  %d\n", stats.TotalCount)
+			fmt.Printf("  Pending Review:   %d\n", stats.PendingReview)
+			fmt.Printf("  Avg Confidence:   %.2f\n", stats.AvgConfidence)
+			fmt.Printf("  Occurrences:      %d\n", stats.TotalOccurrences)
+			fmt.Printf("  Successes:        %d\n", stats.TotalSuccesses)
+			fmt.Printf("  Failures:         %d\n", stats.TotalFailures)
+			if stats.TotalCount > 0 {
+				fmt.Printf("  Oldest:           %s\n", stats.OldestEntry.Format("2006-01-02 15:04"))
+				fmt.Printf("  Newest:           %s\n", stats.NewestEntry.Format("2006-01-02 15:04"))
+			}
+			fmt.Println()
+
+			if len(stats.ByCategory) == 0 {
+				return nil
+			}
+
+			cats := make([]entlearning.Category, 0, len(stats.ByCategory))
+			for c := range stats.ByCategory {
+				cats = append(cats, c)
+			}
+			sort.Slice(cats, func(i, j int) bool { return cats[i] < cats[j] })
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "CATEGORY\tCOUNT")
+			for _, c := range cats {
+				fmt.Fprintf(w, "%s\t%d\n", c, stats.ByCategory[c])
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+func newEditCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var content string
+
+	cmd := &cobra.Command{
+		Use:   "edit <id>",
+		Short: "Correct the content of an observation or reflection",
+		Long: `Edit replaces the content of a memory entry. Without --content, the entry is
+opened in $EDITOR (falling back to vi). Embeddings are re-queued and graph
+nodes are rebuilt from the corrected content.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid id %q: %w", args[0], err)
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, memStore, cleanup, err := initMemoryStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+
+			entry, err := memStore.GetEntry(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			if content == "" {
+				content, err = editInEditor(entry.Content)
+				if err != nil {
+					return err
+				}
+			}
+			content = strings.TrimSpace(content)
+			if content == "" {
+				return fmt.Errorf("content must not be empty (use 'lango memory forget' to delete)")
+			}
+			if content == entry.Content {
+				fmt.Println("No changes.")
+				return nil
+			}
+
+			closeCascade := wireCascade(cfg, store, memStore)
+			defer closeCascade()
+
+			if _, err := memStore.Correct(ctx, id, content); err != nil {
+				return fmt.Errorf("correct: %w", err)
+			}
+
+			fmt.Printf("Updated %s %s.\n", entry.Kind, id)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&content, "content", "", "New content (opens $EDITOR when omitted)")
+
+	return cmd
+}
+
+// editInEditor opens initial content in the user's editor and returns the result.
+func editInEditor(initial string) (string, error) {
+	f, err := os.CreateTemp("", "lango-memory-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("create temp file: %w", err)
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	if _, err := f.WriteString(initial); err != nil {
+		f.Close()
+		return "", fmt.Errorf("write temp file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("close temp file: %w", err)
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+	c := exec.Command(editor, path)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return "", fmt.Errorf("run editor: %w", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("read edited content: %w", err)
+	}
+	return string(data), nil
+}
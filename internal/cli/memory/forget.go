@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/embedding"
+	graphstore "github.com/langoai/lango/internal/graph"
+	"github.com/langoai/lango/internal/memory"
+	"github.com/langoai/lango/internal/session"
+)
+
+func newForgetCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "forget <id>",
+		Short: "Permanently forget an observation or reflection",
+		Long: `Forget deletes a single observation or reflection and cascades the deletion
+to its embeddings (when embedding is configured) and knowledge graph nodes
+(when the graph store is enabled).`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid id %q: %w", args[0], err)
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			store, memStore, cleanup, err := initMemoryStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+
+			entry, err := memStore.GetEntry(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			if !force {
+				fmt.Printf("This will permanently forget %s %s:\n  %s\n", entry.Kind, id, entry.Content)
+				fmt.Print("Continue? [y/N] ")
+				scanner := bufio.NewScanner(os.Stdin)
+				if scanner.Scan() {
+					answer := strings.TrimSpace(strings.ToLower(scanner.Text()))
+					if answer != "y" && answer != "yes" {
+						fmt.Println("Aborted.")
+						return nil
+					}
+				}
+			}
+
+			closeCascade := wireCascade(cfg, store, memStore)
+			defer closeCascade()
+
+			if _, err := memStore.Forget(ctx, id); err != nil {
+				return fmt.Errorf("forget: %w", err)
+			}
+
+			fmt.Printf("Forgot %s %s.\n", entry.Kind, id)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+// wireCascade connects the memory store's remove callbacks to the vector
+// and graph stores when they are configured, so CLI deletions and edits
+// leave no stale derived data behind. The returned func closes any store
+// opened here.
+func wireCascade(cfg *config.Config, store *session.EntStore, memStore *memory.Store) func() {
+	closers := []func(){}
+
+	if cfg.Embedding.Provider != "" && store.DB() != nil {
+		vecStore, err := embedding.NewSQLiteVecStore(store.DB(), cfg.Embedding.Dimensions)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: vector store unavailable, embeddings not removed: %v\n", err)
+		} else {
+			memStore.SetEmbedRemoveCallback(func(id, collection string) {
+				if err := vecStore.Delete(context.Background(), collection, []string{id}); err != nil {
+					fmt.Fprintf(os.Stderr, "warning: remove embedding: %v\n", err)
+				}
+			})
+		}
+	}
+
+	if cfg.Graph.Enabled && cfg.Graph.DatabasePath != "" {
+		gs, err := graphstore.NewBoltStore(cfg.Graph.DatabasePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: graph store unavailable, graph nodes not removed: %v\n", err)
+		} else {
+			closers = append(closers, func() { gs.Close() })
+			memStore.SetGraphRemoveCallback(func(id, collection string) {
+				ctx := context.Background()
+				if _, err := gs.RemoveNode(ctx, collection+":"+id); err != nil {
+					fmt.Fprintf(os.Stderr, "warning: remove graph node: %v\n", err)
+				}
+				if _, err := gs.RemoveBySource(ctx, id); err != nil {
+					fmt.Fprintf(os.Stderr, "warning: remove derived triples: %v\n", err)
+				}
+			})
+		}
+	}
+
+	return func() {
+		for _, c := range closers {
+			c()
+		}
+	}
+}
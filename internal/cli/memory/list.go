@@ -45,6 +45,7 @@ func newListCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
 				ID        string    `json:"id"`
 				Type      string    `json:"type"`
 				Tokens    int       `json:"tokens"`
+				Pinned    bool      `json:"pinned"`
 				CreatedAt time.Time `json:"created_at"`
 				Content   string    `json:"content"`
 			}
@@ -61,6 +62,7 @@ func newListCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
 						ID:        o.ID.String(),
 						Type:      "observation",
 						Tokens:    o.TokenCount,
+						Pinned:    o.Pinned,
 						CreatedAt: o.CreatedAt,
 						Content:   o.Content,
 					})
@@ -95,14 +97,18 @@ func newListCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "ID\tTYPE\tTOKENS\tCREATED\tCONTENT")
+			fmt.Fprintln(w, "ID\tTYPE\tTOKENS\tPINNED\tCREATED\tCONTENT")
 			for _, e := range entries {
 				content := e.Content
 				if len(content) > 60 {
 					content = content[:57] + "..."
 				}
-				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n",
-					e.ID[:8], e.Type, e.Tokens,
+				pinned := ""
+				if e.Pinned {
+					pinned = "yes"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\n",
+					e.ID, e.Type, e.Tokens, pinned,
 					e.CreatedAt.Format("2006-01-02 15:04"),
 					content,
 				)
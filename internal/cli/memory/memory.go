@@ -15,6 +15,9 @@ func NewMemoryCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
 	cmd.AddCommand(newListCmd(cfgLoader))
 	cmd.AddCommand(newStatusCmd(cfgLoader))
 	cmd.AddCommand(newClearCmd(cfgLoader))
+	cmd.AddCommand(newPinCmd(cfgLoader))
+	cmd.AddCommand(newForgetCmd(cfgLoader))
+	cmd.AddCommand(newEditCmd(cfgLoader))
 
 	return cmd
 }
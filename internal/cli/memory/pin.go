@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+func newPinCmd(cfgLoader func() (*config.Config, error)) *cobra.Command {
+	var (
+		content    string
+		sessionKey string
+		unpin      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "pin [id]",
+		Short: "Pin a memory entry so it is always injected into the agent context",
+		Long: `Pin an existing observation by ID, or pin a new fact with --content.
+Pinned entries are injected into every session and are never condensed.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && content == "" {
+				return fmt.Errorf("either an observation ID or --content is required")
+			}
+			if len(args) == 1 && content != "" {
+				return fmt.Errorf("an observation ID and --content are mutually exclusive")
+			}
+
+			cfg, err := cfgLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+
+			_, memStore, cleanup, err := initMemoryStore(cfg)
+			if err != nil {
+				return err
+			}
+			defer cleanup()
+
+			ctx := context.Background()
+
+			if content != "" {
+				obs, err := memStore.PinFact(ctx, sessionKey, content)
+				if err != nil {
+					return fmt.Errorf("pin fact: %w", err)
+				}
+				fmt.Printf("Pinned new fact %s.\n", obs.ID)
+				return nil
+			}
+
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid id %q: %w", args[0], err)
+			}
+			if err := memStore.SetPinned(ctx, id, !unpin); err != nil {
+				return err
+			}
+
+			if unpin {
+				fmt.Printf("Unpinned observation %s.\n", id)
+			} else {
+				fmt.Printf("Pinned observation %s.\n", id)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&content, "content", "", "Pin a new fact with this content")
+	cmd.Flags().StringVar(&sessionKey, "session", "cli", "Session key to attribute a new fact to")
+	cmd.Flags().BoolVar(&unpin, "unpin", false, "Unpin the observation instead")
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/langoai/lango/internal/cli/settings"
 	"github.com/langoai/lango/internal/cli/tuicore"
@@ -118,6 +119,10 @@ func NewChannelStepForm(channel string, cfg *config.Config) *tuicore.FormModel {
 		return newDiscordForm(cfg)
 	case types.ChannelSlack:
 		return newSlackForm(cfg)
+	case types.ChannelMatrix:
+		return newMatrixForm(cfg)
+	case types.ChannelEmail:
+		return newEmailForm(cfg)
 	default:
 		return nil
 	}
@@ -162,6 +167,57 @@ func newSlackForm(cfg *config.Config) *tuicore.FormModel {
 	return &form
 }
 
+func newMatrixForm(cfg *config.Config) *tuicore.FormModel {
+	form := tuicore.NewFormModel("Matrix Setup")
+	form.AddField(&tuicore.Field{
+		Key: "matrix_homeserver", Label: "Homeserver URL", Type: tuicore.InputText,
+		Value:       cfg.Channels.Matrix.HomeserverURL,
+		Placeholder: "https://matrix.example.org",
+		Description: "Base URL of the Matrix homeserver",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "matrix_token", Label: "Access Token", Type: tuicore.InputPassword,
+		Value:       cfg.Channels.Matrix.AccessToken,
+		Placeholder: "syt_...",
+		Description: "Access token of the bot account",
+	})
+	return &form
+}
+
+func newEmailForm(cfg *config.Config) *tuicore.FormModel {
+	form := tuicore.NewFormModel("Email Setup")
+	form.AddField(&tuicore.Field{
+		Key: "email_address", Label: "Address", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.Address,
+		Placeholder: "agent@example.com",
+		Description: "Address the agent sends from and receives at",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_imap_host", Label: "IMAP Host", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.IMAP.Host,
+		Placeholder: "imap.example.com",
+		Description: "IMAP server to poll for incoming mail",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_imap_password", Label: "IMAP Password", Type: tuicore.InputPassword,
+		Value:       cfg.Channels.Email.IMAP.Password,
+		Description: "IMAP password or app password (the address is used as login)",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_smtp_host", Label: "SMTP Host", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.SMTP.Host,
+		Placeholder: "smtp.example.com",
+		Description: "SMTP server for outgoing mail",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_allowlist", Label: "Allowed Senders", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Channels.Email.Allowlist, ","),
+		Placeholder: "you@example.com",
+		Description: "Sender addresses or @domains the agent answers (comma-separated)",
+	})
+	return &form
+}
+
 // NewSecurityStepForm creates the Step 4 form: Security & Auth.
 func NewSecurityStepForm(cfg *config.Config) *tuicore.FormModel {
 	form := tuicore.NewFormModel("Security & Auth")
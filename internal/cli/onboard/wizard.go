@@ -36,6 +36,8 @@ var channelOptions = []channelOption{
 	{ID: string(types.ChannelTelegram), Name: "Telegram", Desc: "Bot via BotFather"},
 	{ID: string(types.ChannelDiscord), Name: "Discord", Desc: "Bot via Developer Portal"},
 	{ID: string(types.ChannelSlack), Name: "Slack", Desc: "App via Socket Mode"},
+	{ID: string(types.ChannelMatrix), Name: "Matrix", Desc: "Bot account on a homeserver"},
+	{ID: string(types.ChannelEmail), Name: "Email", Desc: "Mailbox via IMAP and SMTP"},
 	{ID: "skip", Name: "Skip", Desc: "Configure later in settings"},
 }
 
@@ -272,6 +274,10 @@ func (w *Wizard) enableChannel(ch string) {
 		w.state.Current.Channels.Discord.Enabled = true
 	case types.ChannelSlack:
 		w.state.Current.Channels.Slack.Enabled = true
+	case types.ChannelMatrix:
+		w.state.Current.Channels.Matrix.Enabled = true
+	case types.ChannelEmail:
+		w.state.Current.Channels.Email.Enabled = true
 	}
 }
 
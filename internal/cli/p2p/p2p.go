@@ -68,6 +68,11 @@ func initP2PDeps(boot *bootstrap.Result) (*p2pDeps, error) {
 	if boot.Crypto != nil && boot.DBClient != nil {
 		keys := security.NewKeyRegistry(boot.DBClient)
 		secrets = security.NewSecretsStore(boot.DBClient, keys, boot.Crypto)
+		backend, err := security.NewSecretBackend(cfg.Security)
+		if err != nil {
+			return nil, fmt.Errorf("secrets backend: %w", err)
+		}
+		secrets.SetBackend(backend)
 		keyStorage = "secrets-store"
 	}
 
@@ -57,6 +57,11 @@ func initPaymentDeps(boot *bootstrap.Result) (*paymentDeps, error) {
 		return nil, fmt.Errorf("register default key: %w", err)
 	}
 	secrets := security.NewSecretsStore(boot.DBClient, registry, boot.Crypto)
+	backend, err := security.NewSecretBackend(cfg.Security)
+	if err != nil {
+		return nil, fmt.Errorf("secrets backend: %w", err)
+	}
+	secrets.SetBackend(backend)
 
 	// Get ent client for payment records.
 	client := session.NewEntStoreWithClient(boot.DBClient).Client()
@@ -0,0 +1,203 @@
+// Package policy provides CLI commands for tool approval policies.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/config"
+	toolpolicy "github.com/langoai/lango/internal/policy"
+)
+
+// testResult is the JSON output of "lango policy test".
+type testResult struct {
+	Tool     string             `json:"tool"`
+	Session  string             `json:"session,omitempty"`
+	Channel  string             `json:"channel"`
+	Time     time.Time          `json:"time"`
+	Rules    []toolpolicy.Trace `json:"rules"`
+	Action   string             `json:"action"`
+	Rule     string             `json:"rule,omitempty"`
+	Fallback string             `json:"fallback,omitempty"`
+}
+
+// NewPolicyCmd creates the policy command with lazy bootstrap loading.
+func NewPolicyCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Inspect tool approval policies",
+	}
+
+	cmd.AddCommand(newTestCmd(bootLoader))
+
+	return cmd
+}
+
+func newTestCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		sessionKey string
+		at         string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "test <tool> <params.json>",
+		Short: "Explain how the approval policies decide a tool call",
+		Long: `Evaluate security.interceptor.policies against a tool call and show, rule by
+rule, why each rule matched or not. The parameters are read from a JSON file,
+or from stdin when the file is "-".
+
+Examples:
+  lango policy test exec params.json
+  echo '{"command": "rm -rf build"}' | lango policy test exec -
+  lango policy test fs_write params.json --session telegram:123:456 --at 2026-03-02T22:00:00Z`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := readParams(args[1], cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			if at != "" {
+				if now, err = time.Parse(time.RFC3339, at); err != nil {
+					return fmt.Errorf("--at must be an RFC 3339 time: %w", err)
+				}
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			ic := boot.Config.Security.Interceptor
+			engine, err := toolpolicy.New(ic.Policies)
+			if err != nil {
+				return fmt.Errorf("compile policies: %w", err)
+			}
+
+			req := toolpolicy.Request{Tool: args[0], Params: params, SessionKey: sessionKey, Time: now}
+			res := testResult{
+				Tool:    req.Tool,
+				Session: sessionKey,
+				Channel: toolpolicy.ChannelOf(sessionKey),
+				Time:    now,
+				Rules:   engine.Explain(req),
+			}
+			if res.Rules == nil {
+				res.Rules = []toolpolicy.Trace{}
+			}
+			if d, ok := engine.Evaluate(req); ok {
+				res.Action = string(d.Action)
+				res.Rule = d.Rule
+			} else {
+				res.Action, res.Fallback = fallback(req.Tool, ic)
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(res)
+			}
+			printResult(cmd.OutOrStdout(), res)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&sessionKey, "session", "", "Session key of the call (e.g. telegram:123:456)")
+	cmd.Flags().StringVar(&at, "at", "", "Evaluate at this RFC 3339 time instead of now")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+func readParams(path string, stdin io.Reader) (map[string]interface{}, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read params: %w", err)
+	}
+
+	params := make(map[string]interface{})
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("parse params: %w", err)
+	}
+	return params, nil
+}
+
+// fallback describes the decision of the approval settings when no policy
+// rule matches. The safety level of the tool is only known to a running
+// agent, so the "dangerous" policy is reported as depending on it.
+func fallback(tool string, ic config.InterceptorConfig) (action, reason string) {
+	for _, name := range ic.ExemptTools {
+		if name == tool {
+			return string(config.PolicyActionAllow), "listed in exemptTools"
+		}
+	}
+	for _, name := range ic.SensitiveTools {
+		if name == tool {
+			return string(config.PolicyActionAsk), "listed in sensitiveTools"
+		}
+	}
+
+	switch ic.ApprovalPolicy {
+	case config.ApprovalPolicyAll:
+		return string(config.PolicyActionAsk), "approvalPolicy is all"
+	case config.ApprovalPolicyConfigured, config.ApprovalPolicyNone:
+		return string(config.PolicyActionAllow), fmt.Sprintf("approvalPolicy is %s", ic.ApprovalPolicy)
+	case config.ApprovalPolicyDangerous:
+		return "ask if dangerous", "approvalPolicy is dangerous; depends on the tool's safety level"
+	default:
+		return string(config.PolicyActionAsk), fmt.Sprintf("unknown approvalPolicy %q", ic.ApprovalPolicy)
+	}
+}
+
+func printResult(out io.Writer, res testResult) {
+	fmt.Fprintf(out, "Tool:     %s\n", res.Tool)
+	fmt.Fprintf(out, "Session:  %s (channel %s)\n", orNone(res.Session), res.Channel)
+	fmt.Fprintf(out, "Time:     %s\n\n", res.Time.Format(time.RFC3339))
+
+	if len(res.Rules) == 0 {
+		fmt.Fprintln(out, "No policy rules configured.")
+	} else {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "RULE\tACTION\tMATCH\tREASON")
+		for _, t := range res.Rules {
+			match := "no"
+			if t.Matched {
+				match = "yes"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.Rule, t.Action, match, t.Reason)
+		}
+		w.Flush()
+	}
+
+	fmt.Fprintln(out)
+	if res.Rule != "" {
+		fmt.Fprintf(out, "Decision: %s (policy %q)\n", res.Action, res.Rule)
+		return
+	}
+	fmt.Fprintf(out, "Decision: %s (no rule matched; %s)\n", res.Action, res.Fallback)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "(none)"
+	}
+	return s
+}
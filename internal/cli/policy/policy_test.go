@@ -0,0 +1,87 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func testBootLoader(t *testing.T, ic config.InterceptorConfig) func() (*bootstrap.Result, error) {
+	return func() (*bootstrap.Result, error) {
+		cfg := config.DefaultConfig()
+		cfg.Security.Interceptor = ic
+		client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+		return &bootstrap.Result{Config: cfg, DBClient: client}, nil
+	}
+}
+
+func runTest(t *testing.T, ic config.InterceptorConfig, params string, args ...string) testResult {
+	t.Helper()
+	cmd := NewPolicyCmd(testBootLoader(t, ic))
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetIn(strings.NewReader(params))
+	cmd.SetArgs(append([]string{"test"}, append(args, "--json")...))
+	require.NoError(t, cmd.Execute())
+
+	var res testResult
+	require.NoError(t, json.Unmarshal(out.Bytes(), &res))
+	return res
+}
+
+func TestPolicyTest_MatchingRule(t *testing.T) {
+	ic := config.InterceptorConfig{
+		ApprovalPolicy: config.ApprovalPolicyDangerous,
+		Policies: []config.PolicyRule{
+			{Name: "ask-rm", Tools: []string{"exec"}, Params: []config.PolicyParam{{Path: "command", Regex: `\brm\b`}}, Action: config.PolicyActionAsk},
+			{Name: "deny-telegram", Channels: []string{"telegram"}, Action: config.PolicyActionDeny},
+		},
+	}
+
+	res := runTest(t, ic, `{"command": "ls"}`, "exec", "-", "--session", "telegram:1:2")
+	assert.Equal(t, "deny", res.Action)
+	assert.Equal(t, "deny-telegram", res.Rule)
+	assert.Equal(t, "telegram", res.Channel)
+	require.Len(t, res.Rules, 2)
+	assert.False(t, res.Rules[0].Matched)
+	assert.Contains(t, res.Rules[0].Reason, `"ls"`)
+}
+
+func TestPolicyTest_Fallback(t *testing.T) {
+	tests := []struct {
+		give config.InterceptorConfig
+		want string
+	}{
+		{give: config.InterceptorConfig{ApprovalPolicy: config.ApprovalPolicyAll}, want: "ask"},
+		{give: config.InterceptorConfig{ApprovalPolicy: config.ApprovalPolicyAll, ExemptTools: []string{"exec"}}, want: "allow"},
+		{give: config.InterceptorConfig{ApprovalPolicy: config.ApprovalPolicyNone, SensitiveTools: []string{"exec"}}, want: "ask"},
+		{give: config.InterceptorConfig{ApprovalPolicy: config.ApprovalPolicyDangerous}, want: "ask if dangerous"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.give.ApprovalPolicy), func(t *testing.T) {
+			res := runTest(t, tt.give, `{}`, "exec", "-")
+			assert.Equal(t, tt.want, res.Action)
+			assert.Empty(t, res.Rule)
+			assert.NotEmpty(t, res.Fallback)
+		})
+	}
+}
+
+func TestPolicyTest_InvalidParams(t *testing.T) {
+	cmd := NewPolicyCmd(testBootLoader(t, config.InterceptorConfig{}))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetIn(strings.NewReader("not json"))
+	cmd.SetArgs([]string{"test", "exec", "-"})
+	assert.ErrorContains(t, cmd.Execute(), "parse params")
+}
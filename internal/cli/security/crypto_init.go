@@ -17,5 +17,11 @@ func secretsStoreFromBoot(boot *bootstrap.Result) (*sec.SecretsStore, error) {
 	if _, err := registry.RegisterKey(ctx, "default", "local", sec.KeyTypeEncryption); err != nil {
 		return nil, fmt.Errorf("register default key: %w", err)
 	}
-	return sec.NewSecretsStore(boot.DBClient, registry, boot.Crypto), nil
+	secrets := sec.NewSecretsStore(boot.DBClient, registry, boot.Crypto)
+	backend, err := sec.NewSecretBackend(boot.Config.Security)
+	if err != nil {
+		return nil, fmt.Errorf("secrets backend: %w", err)
+	}
+	secrets.SetBackend(backend)
+	return secrets, nil
 }
@@ -1,6 +1,7 @@
 package security
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
 	"encoding/json"
@@ -10,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/config"
 	sec "github.com/langoai/lango/internal/security"
 )
 
@@ -45,8 +47,10 @@ func newKMSStatusCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comman
 				Provider string `json:"provider"`
 				KeyID    string `json:"key_id"`
 				Region   string `json:"region,omitempty"`
+				Address  string `json:"address,omitempty"`
 				Fallback string `json:"fallback"`
 				Status   string `json:"status"`
+				Backend  string `json:"secrets_backend"`
 			}
 
 			provider := cfg.Security.Signer.Provider
@@ -58,6 +62,16 @@ func newKMSStatusCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comman
 				Region:   cfg.Security.KMS.Region,
 				Fallback: boolToStatus(cfg.Security.KMS.FallbackToLocal),
 				Status:   "not configured",
+				Backend:  cfg.Security.Secrets.Backend,
+			}
+			if provider == string(sec.KMSProviderVault) || s.Backend == "vault" {
+				s.Address = cfg.Security.KMS.Vault.Address
+				if s.Address == "" {
+					s.Address = os.Getenv("VAULT_ADDR")
+				}
+			}
+			if s.Backend == "" {
+				s.Backend = "local"
 			}
 
 			if isKMS {
@@ -87,8 +101,12 @@ func newKMSStatusCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comman
 			if s.Region != "" {
 				fmt.Printf("  Region:        %s\n", s.Region)
 			}
+			if s.Address != "" {
+				fmt.Printf("  Address:       %s\n", s.Address)
+			}
 			fmt.Printf("  Fallback:      %s\n", s.Fallback)
 			fmt.Printf("  Status:        %s\n", s.Status)
+			fmt.Printf("  Secrets:       %s\n", s.Backend)
 
 			return nil
 		},
@@ -102,6 +120,10 @@ func newKMSTestCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command
 	return &cobra.Command{
 		Use:   "test",
 		Short: "Test KMS encrypt/decrypt roundtrip",
+		Long: `Test KMS encrypt/decrypt roundtrip with the configured key.
+
+When security.secrets.backend is "vault", also writes, reads and deletes a
+throwaway secret in the Vault KV v2 engine.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			boot, err := bootLoader()
 			if err != nil {
@@ -111,54 +133,108 @@ func newKMSTestCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command
 
 			cfg := boot.Config
 			provider := cfg.Security.Signer.Provider
-			if !isKMSProvider(provider) {
+			vaultKV := cfg.Security.Secrets.Backend == "vault"
+			if !isKMSProvider(provider) && !vaultKV {
 				return fmt.Errorf("current provider %q is not a KMS provider", provider)
 			}
 
-			kmsProvider, err := sec.NewKMSProvider(sec.KMSProviderName(provider), cfg.Security.KMS)
-			if err != nil {
-				return fmt.Errorf("create KMS provider: %w", err)
+			ctx := context.Background()
+			if isKMSProvider(provider) {
+				if err := testKMSRoundtrip(ctx, sec.KMSProviderName(provider), cfg.Security.KMS); err != nil {
+					return err
+				}
+			}
+			if vaultKV {
+				return testVaultKVRoundtrip(ctx, cfg.Security.KMS)
 			}
+			return nil
+		},
+	}
+}
 
-			ctx := context.Background()
-			keyID := cfg.Security.KMS.KeyID
+// testKMSRoundtrip encrypts and decrypts random data with the configured key.
+func testKMSRoundtrip(ctx context.Context, provider sec.KMSProviderName, kmsConfig config.KMSConfig) error {
+	kmsProvider, err := sec.NewKMSProvider(provider, kmsConfig)
+	if err != nil {
+		return fmt.Errorf("create KMS provider: %w", err)
+	}
 
-			// Generate random test data.
-			testData := make([]byte, 32)
-			if _, err := rand.Read(testData); err != nil {
-				return fmt.Errorf("generate test data: %w", err)
-			}
+	keyID := kmsConfig.KeyID
 
-			fmt.Printf("Testing KMS roundtrip with key %q...\n", keyID)
+	// Generate random test data.
+	testData := make([]byte, 32)
+	if _, err := rand.Read(testData); err != nil {
+		return fmt.Errorf("generate test data: %w", err)
+	}
 
-			// Encrypt.
-			ciphertext, err := kmsProvider.Encrypt(ctx, keyID, testData)
-			if err != nil {
-				return fmt.Errorf("encrypt: %w", err)
-			}
-			fmt.Printf("  Encrypt: OK (%d bytes → %d bytes)\n", len(testData), len(ciphertext))
+	fmt.Printf("Testing KMS roundtrip with key %q...\n", keyID)
 
-			// Decrypt.
-			plaintext, err := kmsProvider.Decrypt(ctx, keyID, ciphertext)
-			if err != nil {
-				return fmt.Errorf("decrypt: %w", err)
-			}
-			fmt.Printf("  Decrypt: OK (%d bytes)\n", len(plaintext))
+	// Encrypt.
+	ciphertext, err := kmsProvider.Encrypt(ctx, keyID, testData)
+	if err != nil {
+		return fmt.Errorf("encrypt: %w", err)
+	}
+	fmt.Printf("  Encrypt: OK (%d bytes → %d bytes)\n", len(testData), len(ciphertext))
 
-			// Verify roundtrip.
-			if len(plaintext) != len(testData) {
-				return fmt.Errorf("roundtrip mismatch: got %d bytes, want %d", len(plaintext), len(testData))
-			}
-			for i := range testData {
-				if plaintext[i] != testData[i] {
-					return fmt.Errorf("roundtrip mismatch at byte %d", i)
-				}
-			}
+	// Decrypt.
+	plaintext, err := kmsProvider.Decrypt(ctx, keyID, ciphertext)
+	if err != nil {
+		return fmt.Errorf("decrypt: %w", err)
+	}
+	fmt.Printf("  Decrypt: OK (%d bytes)\n", len(plaintext))
 
-			fmt.Println("  Roundtrip: PASS")
-			return nil
-		},
+	// Verify roundtrip.
+	if len(plaintext) != len(testData) {
+		return fmt.Errorf("roundtrip mismatch: got %d bytes, want %d", len(plaintext), len(testData))
+	}
+	for i := range testData {
+		if plaintext[i] != testData[i] {
+			return fmt.Errorf("roundtrip mismatch at byte %d", i)
+		}
+	}
+
+	fmt.Println("  Roundtrip: PASS")
+	return nil
+}
+
+// testVaultKVRoundtrip writes, reads and deletes a throwaway secret in the
+// Vault KV v2 secret backend.
+func testVaultKVRoundtrip(ctx context.Context, kmsConfig config.KMSConfig) error {
+	kv, err := sec.NewVaultKV(kmsConfig)
+	if err != nil {
+		return fmt.Errorf("create Vault KV backend: %w", err)
+	}
+
+	name := "lango-kms-test"
+	testData := make([]byte, 32)
+	if _, err := rand.Read(testData); err != nil {
+		return fmt.Errorf("generate test data: %w", err)
+	}
+
+	fmt.Printf("Testing Vault KV roundtrip with secret %q...\n", name)
+
+	ref, err := kv.Put(ctx, name, testData)
+	if err != nil {
+		return fmt.Errorf("kv put: %w", err)
+	}
+	fmt.Printf("  Put: OK (%s)\n", ref)
+
+	value, _, err := kv.Get(ctx, name, ref)
+	if err != nil {
+		return fmt.Errorf("kv get: %w", err)
+	}
+	fmt.Printf("  Get: OK (%d bytes)\n", len(value))
+
+	if err := kv.Delete(ctx, name); err != nil {
+		return fmt.Errorf("kv delete: %w", err)
+	}
+	fmt.Println("  Delete: OK")
+
+	if !bytes.Equal(value, testData) {
+		return fmt.Errorf("roundtrip mismatch")
 	}
+	fmt.Println("  Roundtrip: PASS")
+	return nil
 }
 
 func newKMSKeysCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
@@ -28,6 +28,7 @@ func NewSecurityCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command
 	cmd.AddCommand(newDBMigrateCmd(bootLoader))
 	cmd.AddCommand(newDBDecryptCmd(bootLoader))
 	cmd.AddCommand(newKMSCmd(bootLoader))
+	cmd.AddCommand(newScanDBCmd(bootLoader))
 
 	return cmd
 }
@@ -85,6 +86,10 @@ func migrateSecrets(ctx context.Context, store *session.EntStore, oldCrypto secu
 
 	// Define re-encryption callback
 	reencryptFn := func(ciphertext []byte) ([]byte, error) {
+		// Values kept in Vault KV are not encrypted with the passphrase.
+		if security.IsVaultKVRef(ciphertext) {
+			return ciphertext, nil
+		}
 		plain, err := oldCrypto.Decrypt(ctx, "local", ciphertext)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,329 @@
+package security
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/agent"
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/ent"
+	entschema "github.com/langoai/lango/internal/ent/schema"
+	graphstore "github.com/langoai/lango/internal/graph"
+	sec "github.com/langoai/lango/internal/security"
+)
+
+// scanFinding is a stored field that contains secrets.
+type scanFinding struct {
+	Table    string   `json:"table"`
+	ID       string   `json:"id"`
+	Field    string   `json:"field"`
+	Patterns []string `json:"patterns"`
+}
+
+// scanReport is the result of scanning the database.
+type scanReport struct {
+	Scanned  int           `json:"scanned"`
+	Findings []scanFinding `json:"findings"`
+	Redacted bool          `json:"redacted"`
+	Warnings []string      `json:"warnings,omitempty"`
+}
+
+func newScanDBCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		redact         bool
+		discardSecrets bool
+		jsonOutput     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "scan-db",
+		Short: "Find secrets stored in messages, memory, knowledge and the graph",
+		Long: `Scan stored session messages, observations, reflections, knowledge entries
+and their revisions, and graph triples for secrets: stored secret values and
+credentials recognized by the security.scrub patterns. Secret values are
+never printed.
+
+With --redact, every secret is replaced with a {{secret:name}} reference
+token, as done for new content when security.scrub.enabled is set. Detected
+credentials are kept in the secrets store so their tokens still resolve; this
+needs the secrets store and security.scrub.storeDetected. Without them,
+--redact refuses to run unless --discard-secrets is given, which deletes the
+detected credentials for good. Stop the server before redacting graph triples:
+the graph database is locked while it runs.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			ctx := context.Background()
+			cfg := boot.Config
+			scanner := agent.NewSecretScanner()
+
+			var vault agent.VaultFunc
+			var warnings []string
+			if boot.Crypto != nil {
+				secrets, err := secretsStoreFromBoot(boot)
+				if err != nil {
+					return err
+				}
+				if err := registerStoredSecrets(ctx, secrets, scanner); err != nil {
+					warnings = append(warnings, fmt.Sprintf("stored secrets not loaded: %v", err))
+				}
+				if cfg.Security.Scrub.StoreDetected {
+					vault = secrets.StoreIfChanged
+				}
+			}
+
+			if redact && vault == nil {
+				if !discardSecrets {
+					return fmt.Errorf("redact: detected secrets cannot be kept without the secrets store and security.scrub.storeDetected (use --discard-secrets to delete them)")
+				}
+				warnings = append(warnings, "detected secrets are not kept: their reference tokens will not resolve")
+			}
+
+			scrubber := agent.NewSecretScrubber(agent.SecretScrubberConfig{
+				Scanner:          scanner,
+				Refs:             sec.NewRefStore(),
+				Vault:            vault,
+				DisabledPatterns: cfg.Security.Scrub.DisabledPatterns,
+				CustomPatterns:   cfg.Security.Scrub.CustomPatterns,
+			})
+
+			report, err := scanDatabase(ctx, boot.DBClient, boot.RawDB, scrubber, redact)
+			if err != nil {
+				return err
+			}
+			report.Warnings = append(warnings, report.Warnings...)
+
+			if cfg.Graph.Enabled && cfg.Graph.DatabasePath != "" {
+				gs, err := graphstore.NewBoltStore(cfg.Graph.DatabasePath)
+				if err != nil {
+					report.Warnings = append(report.Warnings, fmt.Sprintf("graph store not scanned: %v", err))
+				} else {
+					err = scanGraph(ctx, gs, scrubber, redact, report)
+					gs.Close()
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(cmd.OutOrStdout())
+				enc.SetIndent("", "  ")
+				return enc.Encode(report)
+			}
+			return printScanReport(cmd, report)
+		},
+	}
+
+	cmd.Flags().BoolVar(&redact, "redact", false, "Replace found secrets with reference tokens")
+	cmd.Flags().BoolVar(&discardSecrets, "discard-secrets", false, "With --redact, delete detected secrets that cannot be kept in the secrets store")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	return cmd
+}
+
+// registerStoredSecrets registers the values of the secrets store, so they
+// are found under their own names.
+func registerStoredSecrets(ctx context.Context, secrets *sec.SecretsStore, scanner *agent.SecretScanner) error {
+	infos, err := secrets.List(ctx)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		value, err := secrets.Get(ctx, info.Name)
+		if err != nil {
+			return fmt.Errorf("get secret %q: %w", info.Name, err)
+		}
+		scanner.Register(info.Name, value)
+	}
+	return nil
+}
+
+// scanDatabase scans the text fields of persisted conversation-derived
+// content and, with redact, rewrites the fields that contain secrets.
+// Knowledge revisions are immutable through ent, so they are rewritten with
+// SQL on rawDB; without rawDB they are only scanned.
+func scanDatabase(ctx context.Context, client *ent.Client, rawDB *sql.DB, scrubber *agent.SecretScrubber, redact bool) (*scanReport, error) {
+	report := &scanReport{Redacted: redact}
+
+	// check records the secrets in text and returns the scrubbed text, or
+	// false when the text is clean or nothing is to be rewritten.
+	check := func(table, id, field, text string) (string, bool) {
+		report.Scanned++
+		findings := scrubber.Find(text)
+		if len(findings) == 0 {
+			return text, false
+		}
+		report.Findings = append(report.Findings, scanFinding{Table: table, ID: id, Field: field, Patterns: findingPatterns(findings)})
+		if !redact {
+			return text, false
+		}
+		return scrubber.Scrub(text), true
+	}
+
+	messages, err := client.Message.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list messages: %w", err)
+	}
+	for _, m := range messages {
+		id := strconv.Itoa(m.ID)
+		content, changed := check("messages", id, "content", m.Content)
+		calls := make([]entschema.ToolCall, len(m.ToolCalls))
+		for i, tc := range m.ToolCalls {
+			var inChanged, outChanged bool
+			tc.Input, inChanged = check("messages", id, "tool_calls."+tc.Name+".input", tc.Input)
+			tc.Output, outChanged = check("messages", id, "tool_calls."+tc.Name+".output", tc.Output)
+			changed = changed || inChanged || outChanged
+			calls[i] = tc
+		}
+		if changed {
+			if err := client.Message.UpdateOneID(m.ID).SetContent(content).SetToolCalls(calls).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("redact message %d: %w", m.ID, err)
+			}
+		}
+	}
+
+	observations, err := client.Observation.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list observations: %w", err)
+	}
+	for _, o := range observations {
+		if content, changed := check("observations", o.ID.String(), "content", o.Content); changed {
+			if err := client.Observation.UpdateOneID(o.ID).SetContent(content).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("redact observation %s: %w", o.ID, err)
+			}
+		}
+	}
+
+	reflections, err := client.Reflection.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reflections: %w", err)
+	}
+	for _, r := range reflections {
+		if content, changed := check("reflections", r.ID.String(), "content", r.Content); changed {
+			if err := client.Reflection.UpdateOneID(r.ID).SetContent(content).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("redact reflection %s: %w", r.ID, err)
+			}
+		}
+	}
+
+	entries, err := client.Knowledge.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list knowledge: %w", err)
+	}
+	for _, k := range entries {
+		if content, changed := check("knowledge", k.Key, "content", k.Content); changed {
+			if err := client.Knowledge.UpdateOneID(k.ID).SetContent(content).Exec(ctx); err != nil {
+				return nil, fmt.Errorf("redact knowledge %q: %w", k.Key, err)
+			}
+		}
+	}
+
+	revisions, err := client.KnowledgeRevision.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list knowledge revisions: %w", err)
+	}
+	revisionRedact := redact && rawDB != nil
+	if redact && rawDB == nil && len(revisions) > 0 {
+		report.Warnings = append(report.Warnings, "knowledge revisions scanned but not redacted: no direct database access")
+	}
+	for _, r := range revisions {
+		id := fmt.Sprintf("%s@%d", r.KnowledgeKey, r.Version)
+		content, changed := check("knowledge_revisions", id, "content", r.Content)
+		if !changed || !revisionRedact {
+			continue
+		}
+		if _, err := rawDB.ExecContext(ctx, "UPDATE knowledge_revisions SET content = ? WHERE id = ?", content, r.ID); err != nil {
+			return nil, fmt.Errorf("redact knowledge revision %s: %w", id, err)
+		}
+	}
+
+	return report, nil
+}
+
+// scanGraph scans graph triples and, with redact, rewrites the triples that
+// contain secrets.
+func scanGraph(ctx context.Context, gs *graphstore.BoltStore, scrubber *agent.SecretScrubber, redact bool, report *scanReport) error {
+	rewrite := func(t graphstore.Triple) (graphstore.Triple, bool) {
+		report.Scanned++
+		var findings []agent.SecretFinding
+		findings = append(findings, scrubber.Find(t.Subject)...)
+		findings = append(findings, scrubber.Find(t.Object)...)
+		for _, v := range t.Metadata {
+			findings = append(findings, scrubber.Find(v)...)
+		}
+		if len(findings) == 0 {
+			return t, false
+		}
+		report.Findings = append(report.Findings, scanFinding{
+			Table:    "graph",
+			ID:       t.Subject + " " + t.Predicate,
+			Field:    "triple",
+			Patterns: findingPatterns(findings),
+		})
+		if !redact {
+			return t, true
+		}
+		return graphstore.ScrubTriple(t, scrubber.Scrub), true
+	}
+
+	if _, err := gs.RewriteTriples(ctx, rewrite, !redact); err != nil {
+		return fmt.Errorf("scan graph: %w", err)
+	}
+	return nil
+}
+
+// findingPatterns returns the distinct pattern names of findings, sorted.
+func findingPatterns(findings []agent.SecretFinding) []string {
+	seen := make(map[string]bool, len(findings))
+	var patterns []string
+	for _, f := range findings {
+		if !seen[f.Pattern] {
+			seen[f.Pattern] = true
+			patterns = append(patterns, f.Pattern)
+		}
+	}
+	sort.Strings(patterns)
+	return patterns
+}
+
+func printScanReport(cmd *cobra.Command, report *scanReport) error {
+	out := cmd.OutOrStdout()
+	for _, w := range report.Warnings {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+	}
+
+	if len(report.Findings) == 0 {
+		fmt.Fprintf(out, "No secrets found in %d field(s).\n", report.Scanned)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TABLE\tID\tFIELD\tPATTERNS")
+	for _, f := range report.Findings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", f.Table, f.ID, f.Field, strings.Join(f.Patterns, ", "))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	if report.Redacted {
+		fmt.Fprintf(out, "Redacted secrets in %d of %d field(s).\n", len(report.Findings), report.Scanned)
+	} else {
+		fmt.Fprintf(out, "Found secrets in %d of %d field(s). Run with --redact to replace them with reference tokens.\n", len(report.Findings), report.Scanned)
+	}
+	return nil
+}
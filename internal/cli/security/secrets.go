@@ -8,11 +8,13 @@ import (
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/langoai/lango/internal/bootstrap"
 	"github.com/langoai/lango/internal/cli/prompt"
+	"github.com/langoai/lango/internal/security"
 )
 
 func newSecretsCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
@@ -24,6 +26,10 @@ func newSecretsCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command
 	cmd.AddCommand(newSecretsListCmd(bootLoader))
 	cmd.AddCommand(newSecretsSetCmd(bootLoader))
 	cmd.AddCommand(newSecretsDeleteCmd(bootLoader))
+	cmd.AddCommand(newSecretsRotateCmd(bootLoader))
+	cmd.AddCommand(newSecretsHistoryCmd(bootLoader))
+	cmd.AddCommand(newSecretsRollbackCmd(bootLoader))
+	cmd.AddCommand(newSecretsAccessCmd(bootLoader))
 
 	return cmd
 }
@@ -63,14 +69,17 @@ func newSecretsListCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comm
 				return nil
 			}
 
+			now := time.Now()
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "NAME\tKEY\tCREATED\tUPDATED\tACCESS_COUNT")
+			fmt.Fprintln(w, "NAME\tKEY\tVERSION\tCREATED\tUPDATED\tEXPIRES\tACCESS_COUNT")
 			for _, s := range secrets {
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\n",
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%d\n",
 					s.Name,
 					s.KeyName,
+					s.Version,
 					s.CreatedAt.Format("2006-01-02 15:04"),
 					s.UpdatedAt.Format("2006-01-02 15:04"),
+					formatExpiry(s, now),
 					s.AccessCount,
 				)
 			}
@@ -83,12 +92,23 @@ func newSecretsListCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comm
 }
 
 func newSecretsSetCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
-	var valueHex string
+	var (
+		valueHex    string
+		owner       string
+		expiresIn   time.Duration
+		rotateEvery time.Duration
+	)
 
 	cmd := &cobra.Command{
 		Use:   "set <name>",
 		Short: "Store an encrypted secret",
-		Args:  cobra.ExactArgs(1),
+		Long: `Store an encrypted secret. Replacing a secret keeps its previous value
+as an earlier version (see "secrets history").
+
+--owner, --expires-in and --rotate-every set the secret's expiry metadata;
+metadata not given on the command line is kept. With --rotate-every and no
+--expires-in, the secret expires one rotation interval from now.`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 
@@ -103,23 +123,9 @@ func newSecretsSetCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comma
 				return err
 			}
 
-			var raw []byte
-			if valueHex != "" {
-				// Non-interactive: decode hex value (with optional 0x prefix).
-				decoded, err := hex.DecodeString(strings.TrimPrefix(valueHex, "0x"))
-				if err != nil {
-					return fmt.Errorf("decode hex value: %w", err)
-				}
-				raw = decoded
-			} else {
-				if !prompt.IsInteractive() {
-					return fmt.Errorf("this command requires an interactive terminal (use --value-hex for non-interactive)")
-				}
-				value, err := prompt.Passphrase("Enter secret value: ")
-				if err != nil {
-					return fmt.Errorf("read secret value: %w", err)
-				}
-				raw = []byte(value)
+			raw, err := readSecretValue(valueHex)
+			if err != nil {
+				return err
 			}
 
 			ctx := context.Background()
@@ -127,15 +133,70 @@ func newSecretsSetCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Comma
 				return fmt.Errorf("store secret: %w", err)
 			}
 
+			flags := cmd.Flags()
+			if flags.Changed("owner") || flags.Changed("expires-in") || flags.Changed("rotate-every") {
+				info, err := secretsStore.Info(ctx, name)
+				if err != nil {
+					return err
+				}
+				meta := security.SecretMeta{
+					Owner:            info.Owner,
+					ExpiresAt:        info.ExpiresAt,
+					RotationInterval: info.RotationInterval,
+				}
+				if flags.Changed("owner") {
+					meta.Owner = owner
+				}
+				if flags.Changed("rotate-every") {
+					meta.RotationInterval = rotateEvery
+					if !flags.Changed("expires-in") && rotateEvery > 0 {
+						expiresIn = rotateEvery
+					}
+				}
+				if expiresIn > 0 {
+					at := time.Now().Add(expiresIn)
+					meta.ExpiresAt = &at
+				} else if flags.Changed("expires-in") {
+					meta.ExpiresAt = nil
+				}
+				if err := secretsStore.SetMeta(ctx, name, meta); err != nil {
+					return fmt.Errorf("set secret metadata: %w", err)
+				}
+			}
+
 			fmt.Printf("Secret '%s' stored successfully.\n", name)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVar(&valueHex, "value-hex", "", "Hex-encoded value to store (non-interactive, optional 0x prefix)")
+	cmd.Flags().StringVar(&owner, "owner", "", "Person or team responsible for the secret")
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, "Expire the secret after this duration (e.g. 720h; 0 clears the expiry)")
+	cmd.Flags().DurationVar(&rotateEvery, "rotate-every", 0, "Rotation interval; each rotation moves the expiry this far ahead (0 clears it)")
 	return cmd
 }
 
+// readSecretValue decodes valueHex or, when it is empty, prompts for the
+// value.
+func readSecretValue(valueHex string) ([]byte, error) {
+	if valueHex != "" {
+		// Non-interactive: decode hex value (with optional 0x prefix).
+		decoded, err := hex.DecodeString(strings.TrimPrefix(valueHex, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("decode hex value: %w", err)
+		}
+		return decoded, nil
+	}
+	if !prompt.IsInteractive() {
+		return nil, fmt.Errorf("this command requires an interactive terminal (use --value-hex for non-interactive)")
+	}
+	value, err := prompt.Passphrase("Enter secret value: ")
+	if err != nil {
+		return nil, fmt.Errorf("read secret value: %w", err)
+	}
+	return []byte(value), nil
+}
+
 func newSecretsDeleteCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
 	var force bool
 
@@ -183,3 +244,239 @@ func newSecretsDeleteCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Co
 	cmd.Flags().BoolVar(&force, "force", false, "Skip confirmation prompt")
 	return cmd
 }
+
+func newSecretsRotateCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var valueHex string
+
+	cmd := &cobra.Command{
+		Use:   "rotate <name>",
+		Short: "Replace a secret's value, keeping the previous value for rollback",
+		Long: `Replace the value of an existing secret. The previous value is kept as an
+earlier version. If the secret has a rotation interval, its expiry moves
+that far ahead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			secretsStore, err := secretsStoreFromBoot(boot)
+			if err != nil {
+				return err
+			}
+
+			raw, err := readSecretValue(valueHex)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			if err := secretsStore.Rotate(ctx, name, raw); err != nil {
+				return fmt.Errorf("rotate secret: %w", err)
+			}
+			info, err := secretsStore.Info(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Secret '%s' rotated to version %d.\n", name, info.Version)
+			if info.ExpiresAt != nil {
+				fmt.Printf("Expires: %s\n", info.ExpiresAt.Format("2006-01-02 15:04"))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&valueHex, "value-hex", "", "Hex-encoded new value (non-interactive, optional 0x prefix)")
+	return cmd
+}
+
+func newSecretsHistoryCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var jsonOutput bool
+
+	cmd := &cobra.Command{
+		Use:   "history <name>",
+		Short: "List the earlier versions kept for a secret",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			secretsStore, err := secretsStoreFromBoot(boot)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			info, err := secretsStore.Info(ctx, name)
+			if err != nil {
+				return err
+			}
+			versions, err := secretsStore.Versions(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(map[string]interface{}{
+					"current":  info.Version,
+					"versions": versions,
+				})
+			}
+
+			fmt.Printf("Secret '%s' is at version %d.\n", name, info.Version)
+			if len(versions) == 0 {
+				fmt.Println("No earlier versions kept.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tCREATED\tRETIRED")
+			for _, v := range versions {
+				fmt.Fprintf(w, "%d\t%s\t%s\n",
+					v.Version,
+					v.CreatedAt.Format("2006-01-02 15:04"),
+					v.RetiredAt.Format("2006-01-02 15:04"),
+				)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	return cmd
+}
+
+func newSecretsRollbackCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var version int
+
+	cmd := &cobra.Command{
+		Use:   "rollback <name>",
+		Short: "Restore an earlier version of a secret",
+		Long: `Restore an earlier version of a secret. The restored value becomes a new
+version, so the value it replaces can itself be rolled back to.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if version <= 0 {
+				return fmt.Errorf("--version is required (see 'lango security secrets history %s')", name)
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			secretsStore, err := secretsStoreFromBoot(boot)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			if err := secretsStore.Rollback(ctx, name, version); err != nil {
+				return fmt.Errorf("roll back secret: %w", err)
+			}
+			info, err := secretsStore.Info(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Secret '%s' rolled back to the value of version %d (now version %d).\n", name, version, info.Version)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&version, "version", 0, "Version to restore")
+	return cmd
+}
+
+func newSecretsAccessCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		limit      int
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "access [name]",
+		Short: "Show which tools and sessions resolved secret references",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var name string
+			if len(args) == 1 {
+				name = args[0]
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("load config: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			secretsStore, err := secretsStoreFromBoot(boot)
+			if err != nil {
+				return err
+			}
+
+			accesses, err := secretsStore.AccessLog(context.Background(), name, limit)
+			if err != nil {
+				return err
+			}
+
+			if jsonOutput {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(accesses)
+			}
+
+			if len(accesses) == 0 {
+				fmt.Println("No secret accesses recorded.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tSECRET\tKIND\tTOOL\tSESSION")
+			for _, a := range accesses {
+				session := a.SessionKey
+				if session == "" {
+					session = "-"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					a.Time.Format("2006-01-02 15:04:05"),
+					a.Name,
+					a.Kind,
+					a.Accessor,
+					session,
+				)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of entries (0 for all)")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+	return cmd
+}
+
+// formatExpiry describes when a secret expires.
+func formatExpiry(s *security.SecretInfo, now time.Time) string {
+	switch {
+	case s.ExpiresAt == nil:
+		return "-"
+	case s.Expired(now):
+		return s.ExpiresAt.Format("2006-01-02 15:04") + " (expired)"
+	default:
+		return s.ExpiresAt.Format("2006-01-02 15:04")
+	}
+}
@@ -23,7 +23,7 @@ func TestNewSecurityCmd_Structure(t *testing.T) {
 
 	expected := []string{
 		"migrate-passphrase", "secrets", "status",
-		"keyring", "db-migrate", "db-decrypt", "kms",
+		"keyring", "db-migrate", "db-decrypt", "kms", "scan-db",
 	}
 
 	subCmds := make(map[string]bool)
@@ -38,7 +38,7 @@ func TestNewSecurityCmd_Structure(t *testing.T) {
 
 func TestNewSecurityCmd_SubcommandCount(t *testing.T) {
 	cmd := NewSecurityCmd(dummyBootLoader())
-	assert.Equal(t, 7, len(cmd.Commands()), "expected 7 security subcommands")
+	assert.Equal(t, 8, len(cmd.Commands()), "expected 8 security subcommands")
 }
 
 func TestSecretsCmd_HasSubcommands(t *testing.T) {
@@ -52,6 +52,10 @@ func TestSecretsCmd_HasSubcommands(t *testing.T) {
 			assert.True(t, secretsSubs["list"], "secrets should have list subcommand")
 			assert.True(t, secretsSubs["set <name>"], "secrets should have set subcommand")
 			assert.True(t, secretsSubs["delete <name>"], "secrets should have delete subcommand")
+			assert.True(t, secretsSubs["rotate <name>"], "secrets should have rotate subcommand")
+			assert.True(t, secretsSubs["history <name>"], "secrets should have history subcommand")
+			assert.True(t, secretsSubs["rollback <name>"], "secrets should have rollback subcommand")
+			assert.True(t, secretsSubs["access [name]"], "secrets should have access subcommand")
 			return
 		}
 	}
@@ -280,6 +280,10 @@ func (e *Editor) handleMenuSelection(id string) tea.Cmd {
 		e.activeForm = NewCronForm(e.state.Current)
 		e.activeForm.Focus = true
 		e.step = StepForm
+	case "quota":
+		e.activeForm = NewQuotaForm(e.state.Current)
+		e.activeForm.Focus = true
+		e.step = StepForm
 	case "background":
 		e.activeForm = NewBackgroundForm(e.state.Current)
 		e.activeForm.Focus = true
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/langoai/lango/internal/cli/tuicore"
 	"github.com/langoai/lango/internal/config"
@@ -161,6 +162,13 @@ func NewServerForm(cfg *config.Config) *tuicore.FormModel {
 		Description: "Enable WebSocket endpoint for real-time bidirectional communication",
 	})
 
+	form.AddField(&tuicore.Field{
+		Key: "api_tokens", Label: "REST API Tokens", Type: tuicore.InputPassword,
+		Value:       strings.Join(cfg.Server.APITokens, ","),
+		Placeholder: "token1,token2 (comma-separated)",
+		Description: "Bearer tokens accepted by the /api REST endpoints; supports ${ENV_VAR}",
+	})
+
 	return &form
 }
 
@@ -194,6 +202,13 @@ func NewChannelsForm(cfg *config.Config) *tuicore.FormModel {
 		Description: "Bot token from Discord Developer Portal; use ${ENV_VAR} for security",
 		VisibleWhen: func() bool { return discordEnabled.Checked },
 	})
+	form.AddField(&tuicore.Field{
+		Key: "discord_session_scope", Label: "  Sessions", Type: tuicore.InputSelect,
+		Value:       sessionScope(cfg.Channels.Discord.SessionScope),
+		Options:     sessionScopeOptions,
+		Description: "user: per user in each channel; channel: shared per channel; thread: one conversation per thread (mentions start one)",
+		VisibleWhen: func() bool { return discordEnabled.Checked },
+	})
 
 	slackEnabled := &tuicore.Field{
 		Key: "slack_enabled", Label: "Slack", Type: tuicore.InputBool,
@@ -215,6 +230,159 @@ func NewChannelsForm(cfg *config.Config) *tuicore.FormModel {
 		Description: "Slack App-Level Token for Socket Mode (starts with xapp-)",
 		VisibleWhen: func() bool { return slackEnabled.Checked },
 	})
+	form.AddField(&tuicore.Field{
+		Key: "slack_session_scope", Label: "  Sessions", Type: tuicore.InputSelect,
+		Value:       sessionScope(cfg.Channels.Slack.SessionScope),
+		Options:     sessionScopeOptions,
+		Description: "user: per user in each channel; channel: shared per channel; thread: one conversation per thread (mentions start one)",
+		VisibleWhen: func() bool { return slackEnabled.Checked },
+	})
+	form.AddField(&tuicore.Field{
+		Key: "slack_allowlist", Label: "  Allowlist", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Channels.Slack.Allowlist, ","),
+		Placeholder: "U024BE7LH,C0123ABCD (comma-separated)",
+		Description: "User or channel IDs the bot answers, for messages and slash commands; empty = everyone",
+		VisibleWhen: func() bool { return slackEnabled.Checked },
+	})
+
+	matrixEnabled := &tuicore.Field{
+		Key: "matrix_enabled", Label: "Matrix", Type: tuicore.InputBool,
+		Checked:     cfg.Channels.Matrix.Enabled,
+		Description: "Enable Matrix bot channel on a Matrix homeserver",
+	}
+	form.AddField(matrixEnabled)
+	form.AddField(&tuicore.Field{
+		Key: "matrix_homeserver", Label: "  Homeserver URL", Type: tuicore.InputText,
+		Value:       cfg.Channels.Matrix.HomeserverURL,
+		Placeholder: "https://matrix.example.org",
+		Description: "Base URL of the Matrix homeserver the bot account lives on",
+		VisibleWhen: func() bool { return matrixEnabled.Checked },
+	})
+	form.AddField(&tuicore.Field{
+		Key: "matrix_token", Label: "  Access Token", Type: tuicore.InputPassword,
+		Value:       cfg.Channels.Matrix.AccessToken,
+		Description: "Access token of the bot account; use ${ENV_VAR} to reference environment variables",
+		VisibleWhen: func() bool { return matrixEnabled.Checked },
+	})
+
+	emailEnabled := &tuicore.Field{
+		Key: "email_enabled", Label: "Email", Type: tuicore.InputBool,
+		Checked:     cfg.Channels.Email.Enabled,
+		Description: "Enable email channel (IMAP or Maildir in, SMTP out)",
+	}
+	form.AddField(emailEnabled)
+	isEmailOn := func() bool { return emailEnabled.Checked }
+	form.AddField(&tuicore.Field{
+		Key: "email_address", Label: "  Address", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.Address,
+		Placeholder: "agent@example.com",
+		Description: "Address the agent sends from and receives at",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_imap_host", Label: "  IMAP Host", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.IMAP.Host,
+		Placeholder: "imap.example.com",
+		Description: "IMAP server to poll; leave empty when reading a local Maildir",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_imap_username", Label: "  IMAP Username", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.IMAP.Username,
+		Description: "IMAP login; usually the full address",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_imap_password", Label: "  IMAP Password", Type: tuicore.InputPassword,
+		Value:       cfg.Channels.Email.IMAP.Password,
+		Description: "IMAP password or app password; use ${ENV_VAR} to reference environment variables",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_maildir", Label: "  Maildir", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.Maildir,
+		Placeholder: "~/Maildir",
+		Description: "Local Maildir to read instead of IMAP",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_smtp_host", Label: "  SMTP Host", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.SMTP.Host,
+		Placeholder: "smtp.example.com",
+		Description: "SMTP server for replies and deliveries (port 587 with STARTTLS by default)",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_smtp_username", Label: "  SMTP Username", Type: tuicore.InputText,
+		Value:       cfg.Channels.Email.SMTP.Username,
+		Description: "SMTP login; leave empty for unauthenticated relays",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_smtp_password", Label: "  SMTP Password", Type: tuicore.InputPassword,
+		Value:       cfg.Channels.Email.SMTP.Password,
+		Description: "SMTP password or app password; use ${ENV_VAR} to reference environment variables",
+		VisibleWhen: isEmailOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "email_allowlist", Label: "  Allowed Senders", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Channels.Email.Allowlist, ","),
+		Placeholder: "alice@example.com,@example.org (comma-separated)",
+		Description: "Sender addresses or @domains the agent answers; mail from anyone else is ignored",
+		VisibleWhen: isEmailOn,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "webhook_enabled", Label: "Webhook", Type: tuicore.InputBool,
+		Checked:     cfg.Channels.Webhook.Enabled,
+		Description: "Enable signed webhook endpoints and targets; configure them under channels.webhook in the config file",
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "channel_admins", Label: "Admins", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Channels.Admins, ","),
+		Placeholder: "telegram:123456789,slack:U024BE7LH (comma-separated)",
+		Description: "Users allowed to run admin commands such as /cron pause, as channel:userID",
+	})
+
+	streamEnabled := &tuicore.Field{
+		Key: "stream_enabled", Label: "Stream Replies", Type: tuicore.InputBool,
+		Checked:     cfg.Channels.Streaming.Enabled,
+		Description: "Post replies on Telegram, Discord and Slack as soon as they start and edit them as they grow",
+	}
+	form.AddField(streamEnabled)
+	form.AddField(&tuicore.Field{
+		Key: "stream_tool_calls", Label: "  Show Tool Progress", Type: tuicore.InputBool,
+		Checked:     cfg.Channels.Streaming.ShowToolCalls,
+		Description: "Show a line such as \"running exec…\" in the streamed reply while a tool runs",
+		VisibleWhen: func() bool { return streamEnabled.Checked },
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "media_max_size", Label: "Max Attachment Size", Type: tuicore.InputInt,
+		Value:       strconv.FormatInt(cfg.Media.MaxAttachmentSize, 10),
+		Description: "Largest image, voice message or document in bytes passed from a channel to the model",
+		Validate: func(s string) error {
+			if i, err := strconv.ParseInt(s, 10, 64); err != nil || i <= 0 {
+				return fmt.Errorf("must be a positive integer")
+			}
+			return nil
+		},
+	})
+	sttProvider := &tuicore.Field{
+		Key: "media_stt_provider", Label: "Voice Transcription", Type: tuicore.InputSelect,
+		Value:       cfg.Media.Transcription.Provider,
+		Options:     append([]string{""}, buildProviderOptions(cfg)...),
+		Description: "OpenAI-compatible provider that transcribes voice messages; empty sends audio to the model as is",
+	}
+	form.AddField(sttProvider)
+	form.AddField(&tuicore.Field{
+		Key: "media_stt_model", Label: "  Transcription Model", Type: tuicore.InputText,
+		Value:       cfg.Media.Transcription.Model,
+		Placeholder: "whisper-1",
+		Description: "Speech-to-text model used for voice messages",
+		VisibleWhen: func() bool { return sttProvider.Value != "" },
+	})
 
 	return &form
 }
@@ -234,6 +402,11 @@ func NewToolsForm(cfg *config.Config) *tuicore.FormModel {
 		Checked:     cfg.Tools.Exec.AllowBackground,
 		Description: "Allow the agent to run shell commands in the background",
 	})
+	form.AddField(&tuicore.Field{
+		Key: "exec_sandbox", Label: "Exec Sandbox", Type: tuicore.InputBool,
+		Checked:     cfg.Tools.Exec.Sandbox,
+		Description: "Run shell commands in the Linux sandbox (read-only root, no network)",
+	})
 
 	form.AddField(&tuicore.Field{
 		Key: "browser_enabled", Label: "Browser Enabled", Type: tuicore.InputBool,
@@ -263,6 +436,29 @@ func NewToolsForm(cfg *config.Config) *tuicore.FormModel {
 			return nil
 		},
 	})
+	form.AddField(&tuicore.Field{
+		Key: "fs_blocked_paths", Label: "Blocked Paths", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Tools.Filesystem.BlockedPaths, ","),
+		Placeholder: "~/.ssh,~/.lango,.env (comma-separated globs)",
+		Description: "Path globs the filesystem tool may never access; a glob without '/' matches any path element",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "fs_protect_uncommitted", Label: "Protect Uncommitted", Type: tuicore.InputBool,
+		Checked:     cfg.Tools.Filesystem.ProtectUncommitted,
+		Description: "Refuse to change files with uncommitted git changes unless the agent passes force",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "fs_undo_retention", Label: "Undo Retention", Type: tuicore.InputText,
+		Value:       cfg.Tools.Filesystem.UndoRetention.String(),
+		Placeholder: "168h",
+		Description: "How long file snapshots for fs_undo and 'lango fs undo' are kept (0 = forever)",
+		Validate: func(s string) error {
+			if d, err := time.ParseDuration(s); err != nil || d < 0 {
+				return fmt.Errorf("must be a non-negative duration")
+			}
+			return nil
+		},
+	})
 
 	return &form
 }
@@ -292,6 +488,63 @@ func NewSessionForm(cfg *config.Config) *tuicore.FormModel {
 	return &form
 }
 
+// NewQuotaForm creates the Usage Quotas configuration form. Overrides and
+// model pricing are lists and are edited in the JSON config.
+func NewQuotaForm(cfg *config.Config) *tuicore.FormModel {
+	form := tuicore.NewFormModel("Usage Quotas Configuration")
+
+	form.AddField(&tuicore.Field{
+		Key: "quota_enabled", Label: "Enabled", Type: tuicore.InputBool,
+		Checked:     cfg.Quota.Enabled,
+		Description: "Enforce usage limits and record usage for 'lango usage'",
+	})
+
+	validateInt := func(s string) error {
+		if i, err := strconv.Atoi(s); err != nil || i < 0 {
+			return fmt.Errorf("must be a non-negative integer")
+		}
+		return nil
+	}
+	validateCost := func(s string) error {
+		if f, err := strconv.ParseFloat(s, 64); err != nil || f < 0 {
+			return fmt.Errorf("must be a non-negative number")
+		}
+		return nil
+	}
+
+	for _, scope := range []struct {
+		prefix, label, who string
+		limits             config.QuotaLimits
+	}{
+		{"quota_user", "User", "each user", cfg.Quota.User},
+		{"quota_channel", "Channel", "each channel (all users combined)", cfg.Quota.Channel},
+	} {
+		form.AddField(&tuicore.Field{
+			Key: scope.prefix + "_rpm", Label: scope.label + " Requests/Minute", Type: tuicore.InputInt,
+			Value:       strconv.Itoa(scope.limits.RequestsPerMinute),
+			Placeholder: "0 = unlimited",
+			Description: "Maximum agent requests per minute for " + scope.who,
+			Validate:    validateInt,
+		})
+		form.AddField(&tuicore.Field{
+			Key: scope.prefix + "_tokens", Label: scope.label + " Tokens/Day", Type: tuicore.InputInt,
+			Value:       strconv.Itoa(scope.limits.TokensPerDay),
+			Placeholder: "0 = unlimited",
+			Description: "Maximum input plus output tokens per UTC day for " + scope.who,
+			Validate:    validateInt,
+		})
+		form.AddField(&tuicore.Field{
+			Key: scope.prefix + "_cost", Label: scope.label + " Cost/Day (USD)", Type: tuicore.InputText,
+			Value:       strconv.FormatFloat(scope.limits.CostPerDay, 'f', -1, 64),
+			Placeholder: "0 = unlimited",
+			Description: "Maximum estimated cost per UTC day for " + scope.who + "; needs quota.pricing",
+			Validate:    validateCost,
+		})
+	}
+
+	return &form
+}
+
 // formatCustomPatterns formats a map of custom patterns into a comma-separated
 // "name:regex" string for display in the TUI.
 func formatCustomPatterns(patterns map[string]string) string {
@@ -400,6 +653,17 @@ func NewOIDCProviderForm(id string, cfg config.OIDCProviderConfig) *tuicore.Form
 }
 
 // derefBool safely dereferences a *bool with a default value.
+// sessionScopeOptions lists the session scopes of threaded channels.
+var sessionScopeOptions = []string{"user", "channel", "thread"}
+
+// sessionScope returns the configured session scope or the default.
+func sessionScope(v string) string {
+	if v == "" {
+		return sessionScopeOptions[0]
+	}
+	return v
+}
+
 func derefBool(p *bool, def bool) bool {
 	if p == nil {
 		return def
@@ -55,9 +55,9 @@ func TestNewToolsForm_AllFields(t *testing.T) {
 	form := NewToolsForm(cfg)
 
 	wantKeys := []string{
-		"exec_timeout", "exec_bg",
+		"exec_timeout", "exec_bg", "exec_sandbox",
 		"browser_enabled", "browser_headless", "browser_session_timeout",
-		"fs_max_read",
+		"fs_max_read", "fs_blocked_paths", "fs_protect_uncommitted", "fs_undo_retention",
 	}
 
 	if len(form.Fields) != len(wantKeys) {
@@ -108,9 +108,17 @@ func TestNewSecurityForm_AllFields(t *testing.T) {
 	wantKeys := []string{
 		"interceptor_enabled", "interceptor_pii", "interceptor_policy",
 		"interceptor_timeout", "interceptor_notify", "interceptor_sensitive_tools",
-		"interceptor_exempt_tools",
+		"interceptor_exempt_tools", "interceptor_grant_scope", "interceptor_grant_ttl",
 		"interceptor_pii_disabled", "interceptor_pii_custom",
 		"presidio_enabled", "presidio_url", "presidio_language",
+		"scrub_enabled", "scrub_store_detected", "scrub_disabled", "scrub_custom",
+		"injection_enabled", "injection_policy", "injection_threshold",
+		"injection_tools", "injection_screen_retrieved", "injection_disabled",
+		"injection_custom", "injection_classifier_enabled",
+		"injection_classifier_provider", "injection_classifier_model",
+		"injection_classifier_max_chars",
+		"secrets_backend", "secrets_keep_versions", "secrets_access_log", "secrets_expiry_deliver_to",
+		"secrets_expiry_warn_before", "secrets_expiry_check_interval",
 		"signer_provider", "signer_rpc", "signer_keyid",
 	}
 
@@ -470,6 +478,7 @@ func TestNewSkillForm_AllFields(t *testing.T) {
 		"skill_enabled", "skill_dir",
 		"skill_allow_import", "skill_max_bulk",
 		"skill_import_concurrency", "skill_import_timeout",
+		"skill_sandbox",
 	}
 
 	if len(form.Fields) != len(wantKeys) {
@@ -640,6 +649,10 @@ func TestNewKMSForm_AllFields(t *testing.T) {
 		"kms_azure_vault_url", "kms_azure_key_version",
 		"kms_pkcs11_module", "kms_pkcs11_slot_id",
 		"kms_pkcs11_pin", "kms_pkcs11_key_label",
+		"kms_vault_address", "kms_vault_namespace", "kms_vault_auth_method",
+		"kms_vault_token", "kms_vault_role_id", "kms_vault_secret_id",
+		"kms_vault_approle_mount", "kms_vault_transit_mount",
+		"kms_vault_kv_mount", "kms_vault_kv_prefix",
 	}
 
 	if len(form.Fields) != len(wantKeys) {
@@ -824,3 +837,49 @@ func TestValidatePort(t *testing.T) {
 		})
 	}
 }
+
+func TestNewQuotaForm_AllFields(t *testing.T) {
+	cfg := defaultTestConfig()
+	form := NewQuotaForm(cfg)
+
+	wantKeys := []string{
+		"quota_enabled",
+		"quota_user_rpm", "quota_user_tokens", "quota_user_cost",
+		"quota_channel_rpm", "quota_channel_tokens", "quota_channel_cost",
+	}
+
+	if len(form.Fields) != len(wantKeys) {
+		t.Fatalf("expected %d fields, got %d", len(wantKeys), len(form.Fields))
+	}
+
+	for _, key := range wantKeys {
+		if f := fieldByKey(form, key); f == nil {
+			t.Errorf("missing field %q", key)
+		}
+	}
+}
+
+func TestUpdateConfigFromForm_QuotaFields(t *testing.T) {
+	state := tuicore.NewConfigState()
+	form := tuicore.NewFormModel("test")
+	form.AddField(&tuicore.Field{Key: "quota_enabled", Type: tuicore.InputBool, Checked: true})
+	form.AddField(&tuicore.Field{Key: "quota_user_rpm", Type: tuicore.InputInt, Value: "10"})
+	form.AddField(&tuicore.Field{Key: "quota_user_cost", Type: tuicore.InputText, Value: "1.5"})
+	form.AddField(&tuicore.Field{Key: "quota_channel_tokens", Type: tuicore.InputInt, Value: "500000"})
+
+	state.UpdateConfigFromForm(&form)
+
+	q := state.Current.Quota
+	if !q.Enabled {
+		t.Error("Quota.Enabled: want true")
+	}
+	if q.User.RequestsPerMinute != 10 {
+		t.Errorf("User.RequestsPerMinute: want 10, got %d", q.User.RequestsPerMinute)
+	}
+	if q.User.CostPerDay != 1.5 {
+		t.Errorf("User.CostPerDay: want 1.5, got %f", q.User.CostPerDay)
+	}
+	if q.Channel.TokensPerDay != 500000 {
+		t.Errorf("Channel.TokensPerDay: want 500000, got %d", q.Channel.TokensPerDay)
+	}
+}
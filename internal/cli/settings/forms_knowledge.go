@@ -91,6 +91,12 @@ func NewSkillForm(cfg *config.Config) *tuicore.FormModel {
 		Description: "Maximum time allowed for a single skill import operation",
 	})
 
+	form.AddField(&tuicore.Field{
+		Key: "skill_sandbox", Label: "Sandbox Scripts", Type: tuicore.InputBool,
+		Checked:     cfg.Skill.Sandbox,
+		Description: "Run script skills in the Linux sandbox (read-only root, no network)",
+	})
+
 	return &form
 }
 
@@ -285,8 +285,8 @@ func NewP2PSandboxForm(cfg *config.Config) *tuicore.FormModel {
 	form.AddField(&tuicore.Field{
 		Key: "container_runtime", Label: "  Runtime", Type: tuicore.InputSelect,
 		Value:       runtime,
-		Options:     []string{"auto", "docker", "gvisor", "native"},
-		Description: "Container runtime: auto=detect best, gvisor=strongest isolation",
+		Options:     []string{"auto", "docker", "gvisor", "linux", "native"},
+		Description: "Container runtime: auto=detect best, gvisor=strongest isolation, linux=namespaces without Docker",
 		VisibleWhen: isContainerOn,
 	})
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/langoai/lango/internal/cli/tuicore"
 	"github.com/langoai/lango/internal/config"
@@ -56,7 +57,7 @@ func NewSecurityForm(cfg *config.Config) *tuicore.FormModel {
 	form.AddField(&tuicore.Field{
 		Key: "interceptor_notify", Label: "  Notify Channel", Type: tuicore.InputSelect,
 		Value:       cfg.Security.Interceptor.NotifyChannel,
-		Options:     []string{"", string(types.ChannelTelegram), string(types.ChannelDiscord), string(types.ChannelSlack)},
+		Options:     []string{"", string(types.ChannelTelegram), string(types.ChannelDiscord), string(types.ChannelSlack), string(types.ChannelMatrix), string(types.ChannelEmail)},
 		Description: "Channel to send approval notifications to; empty = no notification",
 		VisibleWhen: isInterceptorOn,
 	})
@@ -77,6 +78,32 @@ func NewSecurityForm(cfg *config.Config) *tuicore.FormModel {
 		VisibleWhen: isInterceptorOn,
 	})
 
+	grantScope := string(cfg.Security.Interceptor.GrantScope)
+	if grantScope == "" {
+		grantScope = string(config.GrantScopeSession)
+	}
+	form.AddField(&tuicore.Field{
+		Key: "interceptor_grant_scope", Label: "  Grant Scope", Type: tuicore.InputSelect,
+		Value:       grantScope,
+		Options:     []string{"session", "user", "global"},
+		Description: "Who an \"always allow\" answer covers: the session, the user in every chat, or everyone",
+		VisibleWhen: isInterceptorOn,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "interceptor_grant_ttl", Label: "  Grant TTL", Type: tuicore.InputText,
+		Value:       cfg.Security.Interceptor.GrantTTL.String(),
+		Placeholder: "0s (e.g. 24h, 720h; 0s = until revoked)",
+		Description: "How long \"always allow\" grants last before approval is asked again",
+		VisibleWhen: isInterceptorOn,
+		Validate: func(s string) error {
+			if d, err := time.ParseDuration(s); err != nil || d < 0 {
+				return fmt.Errorf("must be a non-negative duration such as 24h")
+			}
+			return nil
+		},
+	})
+
 	// PII Pattern Management
 	form.AddField(&tuicore.Field{
 		Key: "interceptor_pii_disabled", Label: "  Disabled PII Patterns", Type: tuicore.InputText,
@@ -121,11 +148,195 @@ func NewSecurityForm(cfg *config.Config) *tuicore.FormModel {
 		VisibleWhen: isPresidioOn,
 	})
 
+	// Secret Scrubbing
+	scrubEnabled := &tuicore.Field{
+		Key: "scrub_enabled", Label: "Scrub Secrets Before Saving", Type: tuicore.InputBool,
+		Checked:     cfg.Security.Scrub.Enabled,
+		Description: "Replace secrets in messages, memory, knowledge and graph triples with reference tokens",
+	}
+	form.AddField(scrubEnabled)
+	isScrubOn := func() bool { return scrubEnabled.Checked }
+	form.AddField(&tuicore.Field{
+		Key: "scrub_store_detected", Label: "  Keep Detected Secrets", Type: tuicore.InputBool,
+		Checked:     cfg.Security.Scrub.StoreDetected,
+		Description: "Keep detected secrets in the encrypted secrets store so their tokens still resolve",
+		VisibleWhen: isScrubOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "scrub_disabled", Label: "  Disabled Secret Patterns", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Security.Scrub.DisabledPatterns, ","),
+		Placeholder: "jwt,credential_assignment (comma-separated)",
+		Description: "Built-in secret pattern names to disable (e.g. jwt, credential_assignment)",
+		VisibleWhen: isScrubOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "scrub_custom", Label: "  Custom Secret Patterns", Type: tuicore.InputText,
+		Value:       formatCustomPatterns(cfg.Security.Scrub.CustomPatterns),
+		Placeholder: `internal_token:\bitk_[a-z0-9]{32}\b (name:regex, comma-sep)`,
+		Description: "Custom regex patterns for secret detection in name:regex format",
+		VisibleWhen: isScrubOn,
+	})
+
+	// Prompt-Injection Screening
+	injectionEnabled := &tuicore.Field{
+		Key: "injection_enabled", Label: "Screen for Prompt Injection", Type: tuicore.InputBool,
+		Checked:     cfg.Security.Injection.Enabled,
+		Description: "Screen tool outputs and retrieved content for instructions aimed at the agent",
+	}
+	form.AddField(injectionEnabled)
+	isInjectionOn := func() bool { return injectionEnabled.Checked }
+	injectionPolicy := string(cfg.Security.Injection.Policy)
+	if injectionPolicy == "" {
+		injectionPolicy = string(config.InjectionPolicyQuarantine)
+	}
+	form.AddField(&tuicore.Field{
+		Key: "injection_policy", Label: "  Injection Policy", Type: tuicore.InputSelect,
+		Value:       injectionPolicy,
+		Options:     []string{string(config.InjectionPolicyQuarantine), string(config.InjectionPolicyBlock)},
+		Description: "quarantine = pass flagged content on in an untrusted-content wrapper; block = withhold it",
+		VisibleWhen: isInjectionOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_threshold", Label: "  Detection Threshold", Type: tuicore.InputText,
+		Value:       strconv.FormatFloat(cfg.Security.Injection.Threshold, 'f', -1, 64),
+		Placeholder: "0.7",
+		Description: "Combined rule score that flags content (0.0 to 1.0)",
+		VisibleWhen: isInjectionOn,
+		Validate: func(s string) error {
+			if f, err := strconv.ParseFloat(s, 64); err != nil || f < 0 || f > 1 {
+				return fmt.Errorf("must be between 0.0 and 1.0")
+			}
+			return nil
+		},
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_tools", Label: "  Screened Tools", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Security.Injection.Tools, ","),
+		Placeholder: "browser_*,p2p_query,skill_* (comma-separated globs)",
+		Description: "Tools whose output is screened",
+		VisibleWhen: isInjectionOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_screen_retrieved", Label: "  Screen RAG Chunks", Type: tuicore.InputBool,
+		Checked:     cfg.Security.Injection.ScreenRetrieved,
+		Description: "Screen RAG and Graph RAG chunks before they are added to the system prompt",
+		VisibleWhen: isInjectionOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_disabled", Label: "  Disabled Injection Rules", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Security.Injection.DisabledRules, ","),
+		Placeholder: "tool_coercion,fake_role_tag (comma-separated)",
+		Description: "Built-in injection rule names to disable",
+		VisibleWhen: isInjectionOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_custom", Label: "  Custom Injection Patterns", Type: tuicore.InputText,
+		Value:       formatCustomPatterns(cfg.Security.Injection.CustomPatterns),
+		Placeholder: `canary:CANARY-[0-9]+ (name:regex, comma-sep)`,
+		Description: "Custom regex rules in name:regex format; a match alone flags the content",
+		VisibleWhen: isInjectionOn,
+	})
+	classifierEnabled := &tuicore.Field{
+		Key: "injection_classifier_enabled", Label: "  LLM Classifier", Type: tuicore.InputBool,
+		Checked:     cfg.Security.Injection.Classifier.Enabled,
+		Description: "Also ask an LLM to classify screened content; costs one LLM call per item",
+		VisibleWhen: isInjectionOn,
+	}
+	form.AddField(classifierEnabled)
+	isClassifierOn := func() bool { return isInjectionOn() && classifierEnabled.Checked }
+	form.AddField(&tuicore.Field{
+		Key: "injection_classifier_provider", Label: "    Classifier Provider", Type: tuicore.InputSelect,
+		Value:       cfg.Security.Injection.Classifier.Provider,
+		Options:     append([]string{""}, buildProviderOptions(cfg)...),
+		Placeholder: "(inherits from Agent)",
+		Description: fmt.Sprintf("LLM provider for classification; empty = inherit from Agent (%s)", cfg.Agent.Provider),
+		VisibleWhen: isClassifierOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_classifier_model", Label: "    Classifier Model", Type: tuicore.InputText,
+		Value:       cfg.Security.Injection.Classifier.Model,
+		Placeholder: "(inherits from Agent)",
+		Description: "Model for classification; a small, fast model is usually enough",
+		VisibleWhen: isClassifierOn,
+	})
+	form.AddField(&tuicore.Field{
+		Key: "injection_classifier_max_chars", Label: "    Classifier Max Chars", Type: tuicore.InputInt,
+		Value:       strconv.Itoa(cfg.Security.Injection.Classifier.MaxChars),
+		Placeholder: "8000",
+		Description: "Characters of each item sent to the classifier",
+		VisibleWhen: isClassifierOn,
+		Validate: func(s string) error {
+			if i, err := strconv.Atoi(s); err != nil || i <= 0 {
+				return fmt.Errorf("must be a positive integer")
+			}
+			return nil
+		},
+	})
+
+	// Secret Lifecycle
+	secretsBackend := cfg.Security.Secrets.Backend
+	if secretsBackend == "" {
+		secretsBackend = "local"
+	}
+	form.AddField(&tuicore.Field{
+		Key: "secrets_backend", Label: "Secrets Backend", Type: tuicore.InputSelect,
+		Value:       secretsBackend,
+		Options:     []string{"local", "vault"},
+		Description: "Where secret values are kept; vault uses the KV v2 engine set up under Security KMS",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "secrets_keep_versions", Label: "Kept Secret Versions", Type: tuicore.InputInt,
+		Value:       strconv.Itoa(cfg.Security.Secrets.KeepVersions),
+		Placeholder: "5",
+		Description: "Earlier values kept per secret for rollback; 0 = keep none",
+		Validate: func(s string) error {
+			if i, err := strconv.Atoi(s); err != nil || i < 0 {
+				return fmt.Errorf("must be a non-negative integer")
+			}
+			return nil
+		},
+	})
+	form.AddField(&tuicore.Field{
+		Key: "secrets_access_log", Label: "Secret Access Log", Type: tuicore.InputBool,
+		Checked:     cfg.Security.Secrets.AccessLog,
+		Description: "Record which tool and session resolved each secret reference",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "secrets_expiry_deliver_to", Label: "Secret Expiry Warnings To", Type: tuicore.InputText,
+		Value:       strings.Join(cfg.Security.Secrets.Expiry.DeliverTo, ","),
+		Placeholder: "telegram:123456789 (comma-separated; empty = off)",
+		Description: "Channels warned when secrets near expiry",
+	})
+	form.AddField(&tuicore.Field{
+		Key: "secrets_expiry_warn_before", Label: "  Warn Before Expiry", Type: tuicore.InputText,
+		Value:       cfg.Security.Secrets.Expiry.WarnBefore.String(),
+		Placeholder: "168h",
+		Description: "How long before expiry to send the warning",
+		Validate: func(s string) error {
+			if d, err := time.ParseDuration(s); err != nil || d < 0 {
+				return fmt.Errorf("must be a non-negative duration such as 168h")
+			}
+			return nil
+		},
+	})
+	form.AddField(&tuicore.Field{
+		Key: "secrets_expiry_check_interval", Label: "  Expiry Check Interval", Type: tuicore.InputText,
+		Value:       cfg.Security.Secrets.Expiry.CheckInterval.String(),
+		Placeholder: "1h",
+		Description: "How often to check for expiring secrets",
+		Validate: func(s string) error {
+			if d, err := time.ParseDuration(s); err != nil || d <= 0 {
+				return fmt.Errorf("must be a positive duration such as 1h")
+			}
+			return nil
+		},
+	})
+
 	// Signer Configuration
 	signerField := &tuicore.Field{
 		Key: "signer_provider", Label: "Signer Provider", Type: tuicore.InputSelect,
 		Value:       cfg.Security.Signer.Provider,
-		Options:     []string{"local", "rpc", "enclave", "aws-kms", "gcp-kms", "azure-kv", "pkcs11"},
+		Options:     []string{"local", "rpc", "enclave", "aws-kms", "gcp-kms", "azure-kv", "pkcs11", "vault"},
 		Description: "Cryptographic signer backend for message signing and verification",
 	}
 	form.AddField(signerField)
@@ -143,7 +354,7 @@ func NewSecurityForm(cfg *config.Config) *tuicore.FormModel {
 		Description: "Key identifier for the signer (ARN for AWS, key name for GCP/Azure)",
 		VisibleWhen: func() bool {
 			v := signerField.Value
-			return v == "rpc" || v == "aws-kms" || v == "gcp-kms" || v == "azure-kv" || v == "pkcs11"
+			return v == "rpc" || v == "aws-kms" || v == "gcp-kms" || v == "azure-kv" || v == "pkcs11" || v == "vault"
 		},
 	})
 
@@ -188,7 +399,7 @@ func NewKMSForm(cfg *config.Config) *tuicore.FormModel {
 	backendField := &tuicore.Field{
 		Key: "kms_backend", Label: "KMS Backend", Type: tuicore.InputSelect,
 		Value:       signerProv,
-		Options:     []string{"local", "aws-kms", "gcp-kms", "azure-kv", "pkcs11"},
+		Options:     []string{"local", "aws-kms", "gcp-kms", "azure-kv", "pkcs11", "vault"},
 		Description: "Cloud KMS or HSM backend; must match Signer Provider in Security settings",
 	}
 	form.AddField(backendField)
@@ -213,7 +424,7 @@ func NewKMSForm(cfg *config.Config) *tuicore.FormModel {
 		Key: "kms_key_id", Label: "Key ID", Type: tuicore.InputText,
 		Value:       cfg.Security.KMS.KeyID,
 		Placeholder: "arn:aws:kms:... or alias/my-key",
-		Description: "KMS key identifier (AWS ARN, GCP resource name, or Azure key name)",
+		Description: "KMS key identifier (AWS ARN, GCP resource name, Azure or Vault Transit key name)",
 		VisibleWhen: isAnyKMS,
 	})
 
@@ -310,5 +521,90 @@ func NewKMSForm(cfg *config.Config) *tuicore.FormModel {
 		VisibleWhen: isPKCS11,
 	})
 
+	// Vault fields also serve the Vault KV secrets backend.
+	isVault := func() bool {
+		return backendField.Value == "vault" || cfg.Security.Secrets.Backend == "vault"
+	}
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_address", Label: "Vault Address", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.Address,
+		Placeholder: "https://vault.example.com:8200 (empty = VAULT_ADDR)",
+		Description: "HashiCorp Vault or OpenBao server address",
+		VisibleWhen: isVault,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_namespace", Label: "Vault Namespace", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.Namespace,
+		Placeholder: "empty = root namespace",
+		Description: "Vault Enterprise / OpenBao namespace",
+		VisibleWhen: isVault,
+	})
+
+	authField := &tuicore.Field{
+		Key: "kms_vault_auth_method", Label: "Vault Auth Method", Type: tuicore.InputSelect,
+		Value:       cfg.Security.KMS.Vault.AuthMethod,
+		Options:     []string{"token", "approle"},
+		Description: "How to authenticate to Vault",
+		VisibleWhen: isVault,
+	}
+	form.AddField(authField)
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_token", Label: "  Vault Token", Type: tuicore.InputPassword,
+		Value:       cfg.Security.KMS.Vault.Token,
+		Placeholder: "prefer VAULT_TOKEN env var",
+		Description: "Vault token; strongly prefer VAULT_TOKEN env var for security",
+		VisibleWhen: func() bool { return isVault() && authField.Value == "token" },
+	})
+
+	isAppRole := func() bool { return isVault() && authField.Value == "approle" }
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_role_id", Label: "  AppRole Role ID", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.RoleID,
+		Description: "AppRole role ID",
+		VisibleWhen: isAppRole,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_secret_id", Label: "  AppRole Secret ID", Type: tuicore.InputPassword,
+		Value:       cfg.Security.KMS.Vault.SecretID,
+		Placeholder: "prefer LANGO_VAULT_SECRET_ID env var",
+		Description: "AppRole secret ID; strongly prefer LANGO_VAULT_SECRET_ID env var for security",
+		VisibleWhen: isAppRole,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_approle_mount", Label: "  AppRole Mount", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.AppRoleMount,
+		Placeholder: "approle",
+		Description: "Mount path of the AppRole auth method",
+		VisibleWhen: isAppRole,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_transit_mount", Label: "Vault Transit Mount", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.TransitMount,
+		Placeholder: "transit",
+		Description: "Mount path of the Transit secrets engine",
+		VisibleWhen: func() bool { return backendField.Value == "vault" },
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_kv_mount", Label: "Vault KV Mount", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.KVMount,
+		Placeholder: "secret",
+		Description: "Mount path of the KV v2 engine used by the vault secrets backend",
+		VisibleWhen: isVault,
+	})
+
+	form.AddField(&tuicore.Field{
+		Key: "kms_vault_kv_prefix", Label: "Vault KV Prefix", Type: tuicore.InputText,
+		Value:       cfg.Security.KMS.Vault.KVPrefix,
+		Placeholder: "lango",
+		Description: "Path prefix for secrets in the KV v2 engine",
+		VisibleWhen: isVault,
+	})
+
 	return &form
 }
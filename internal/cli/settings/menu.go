@@ -83,12 +83,13 @@ func NewMenuModel() MenuModel {
 					{"agent", "Agent", "Provider, Model, Key"},
 					{"server", "Server", "Host, Port, Networking"},
 					{"session", "Session", "Database, TTL, History"},
+					{"quota", "Usage Quotas", "Per-user and per-channel limits"},
 				},
 			},
 			{
 				Title: "Communication",
 				Categories: []Category{
-					{"channels", "Channels", "Telegram, Discord, Slack"},
+					{"channels", "Channels", "Telegram, Discord, Slack, Matrix, Email, Webhook, streaming, media"},
 					{"tools", "Tools", "Exec, Browser, Filesystem"},
 					{"multi_agent", "Multi-Agent", "Orchestration mode"},
 					{"a2a", "A2A Protocol", "Agent-to-Agent, remote agents"},
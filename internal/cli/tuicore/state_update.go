@@ -61,6 +61,8 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			s.Current.Server.HTTPEnabled = f.Checked
 		case "ws":
 			s.Current.Server.WebSocketEnabled = f.Checked
+		case "api_tokens":
+			s.Current.Server.APITokens = splitCSV(val)
 
 		// Channels - Telegram
 		case "telegram_enabled":
@@ -73,6 +75,8 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			s.Current.Channels.Discord.Enabled = f.Checked
 		case "discord_token":
 			s.Current.Channels.Discord.BotToken = val
+		case "discord_session_scope":
+			s.Current.Channels.Discord.SessionScope = val
 
 		// Channels - Slack
 		case "slack_enabled":
@@ -81,6 +85,60 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			s.Current.Channels.Slack.BotToken = val
 		case "slack_app_token":
 			s.Current.Channels.Slack.AppToken = val
+		case "slack_session_scope":
+			s.Current.Channels.Slack.SessionScope = val
+		case "slack_allowlist":
+			s.Current.Channels.Slack.Allowlist = splitCSV(val)
+
+		// Channels - Matrix
+		case "matrix_enabled":
+			s.Current.Channels.Matrix.Enabled = f.Checked
+		case "matrix_homeserver":
+			s.Current.Channels.Matrix.HomeserverURL = val
+		case "matrix_token":
+			s.Current.Channels.Matrix.AccessToken = val
+
+		// Channels - Email
+		case "email_enabled":
+			s.Current.Channels.Email.Enabled = f.Checked
+		case "email_address":
+			s.Current.Channels.Email.Address = val
+		case "email_imap_host":
+			s.Current.Channels.Email.IMAP.Host = val
+		case "email_imap_username":
+			s.Current.Channels.Email.IMAP.Username = val
+		case "email_imap_password":
+			s.Current.Channels.Email.IMAP.Password = val
+		case "email_maildir":
+			s.Current.Channels.Email.Maildir = val
+		case "email_smtp_host":
+			s.Current.Channels.Email.SMTP.Host = val
+		case "email_smtp_username":
+			s.Current.Channels.Email.SMTP.Username = val
+		case "email_smtp_password":
+			s.Current.Channels.Email.SMTP.Password = val
+		case "email_allowlist":
+			s.Current.Channels.Email.Allowlist = splitCSV(val)
+
+		// Channels - Webhook
+		case "webhook_enabled":
+			s.Current.Channels.Webhook.Enabled = f.Checked
+		case "channel_admins":
+			s.Current.Channels.Admins = splitCSV(val)
+		case "stream_enabled":
+			s.Current.Channels.Streaming.Enabled = f.Checked
+		case "stream_tool_calls":
+			s.Current.Channels.Streaming.ShowToolCalls = f.Checked
+
+		// Channels - Media
+		case "media_max_size":
+			if i, err := strconv.ParseInt(val, 10, 64); err == nil {
+				s.Current.Media.MaxAttachmentSize = i
+			}
+		case "media_stt_provider":
+			s.Current.Media.Transcription.Provider = val
+		case "media_stt_model":
+			s.Current.Media.Transcription.Model = val
 
 		// Tools
 		case "exec_timeout":
@@ -89,6 +147,8 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			}
 		case "exec_bg":
 			s.Current.Tools.Exec.AllowBackground = f.Checked
+		case "exec_sandbox":
+			s.Current.Tools.Exec.Sandbox = f.Checked
 		case "browser_enabled":
 			s.Current.Tools.Browser.Enabled = f.Checked
 		case "browser_headless":
@@ -101,6 +161,14 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			if i, err := strconv.ParseInt(val, 10, 64); err == nil {
 				s.Current.Tools.Filesystem.MaxReadSize = i
 			}
+		case "fs_blocked_paths":
+			s.Current.Tools.Filesystem.BlockedPaths = splitCSV(val)
+		case "fs_protect_uncommitted":
+			s.Current.Tools.Filesystem.ProtectUncommitted = f.Checked
+		case "fs_undo_retention":
+			if d, err := time.ParseDuration(val); err == nil {
+				s.Current.Tools.Filesystem.UndoRetention = d
+			}
 
 		// Session
 		case "ttl":
@@ -129,6 +197,12 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			s.Current.Security.Interceptor.NotifyChannel = val
 		case "interceptor_sensitive_tools":
 			s.Current.Security.Interceptor.SensitiveTools = splitCSV(val)
+		case "interceptor_grant_scope":
+			s.Current.Security.Interceptor.GrantScope = config.GrantScope(val)
+		case "interceptor_grant_ttl":
+			if d, err := time.ParseDuration(val); err == nil {
+				s.Current.Security.Interceptor.GrantTTL = d
+			}
 		case "interceptor_pii_disabled":
 			s.Current.Security.Interceptor.PIIDisabledPatterns = splitCSV(val)
 		case "interceptor_pii_custom":
@@ -139,6 +213,58 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			s.Current.Security.Interceptor.Presidio.URL = val
 		case "presidio_language":
 			s.Current.Security.Interceptor.Presidio.Language = val
+		case "scrub_enabled":
+			s.Current.Security.Scrub.Enabled = f.Checked
+		case "scrub_store_detected":
+			s.Current.Security.Scrub.StoreDetected = f.Checked
+		case "scrub_disabled":
+			s.Current.Security.Scrub.DisabledPatterns = splitCSV(val)
+		case "scrub_custom":
+			s.Current.Security.Scrub.CustomPatterns = parseCustomPatterns(val)
+		case "injection_enabled":
+			s.Current.Security.Injection.Enabled = f.Checked
+		case "injection_policy":
+			s.Current.Security.Injection.Policy = config.InjectionPolicy(val)
+		case "injection_threshold":
+			if fv, err := strconv.ParseFloat(val, 64); err == nil {
+				s.Current.Security.Injection.Threshold = fv
+			}
+		case "injection_tools":
+			s.Current.Security.Injection.Tools = splitCSV(val)
+		case "injection_screen_retrieved":
+			s.Current.Security.Injection.ScreenRetrieved = f.Checked
+		case "injection_disabled":
+			s.Current.Security.Injection.DisabledRules = splitCSV(val)
+		case "injection_custom":
+			s.Current.Security.Injection.CustomPatterns = parseCustomPatterns(val)
+		case "injection_classifier_enabled":
+			s.Current.Security.Injection.Classifier.Enabled = f.Checked
+		case "injection_classifier_provider":
+			s.Current.Security.Injection.Classifier.Provider = val
+		case "injection_classifier_model":
+			s.Current.Security.Injection.Classifier.Model = val
+		case "injection_classifier_max_chars":
+			if i, err := strconv.Atoi(val); err == nil {
+				s.Current.Security.Injection.Classifier.MaxChars = i
+			}
+		case "secrets_backend":
+			s.Current.Security.Secrets.Backend = val
+		case "secrets_keep_versions":
+			if i, err := strconv.Atoi(val); err == nil {
+				s.Current.Security.Secrets.KeepVersions = i
+			}
+		case "secrets_access_log":
+			s.Current.Security.Secrets.AccessLog = f.Checked
+		case "secrets_expiry_deliver_to":
+			s.Current.Security.Secrets.Expiry.DeliverTo = splitCSV(val)
+		case "secrets_expiry_warn_before":
+			if d, err := time.ParseDuration(val); err == nil {
+				s.Current.Security.Secrets.Expiry.WarnBefore = d
+			}
+		case "secrets_expiry_check_interval":
+			if d, err := time.ParseDuration(val); err == nil {
+				s.Current.Security.Secrets.Expiry.CheckInterval = d
+			}
 
 		// Security - Signer
 		case "signer_provider":
@@ -175,6 +301,8 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			if d, err := time.ParseDuration(val); err == nil {
 				s.Current.Skill.ImportTimeout = d
 			}
+		case "skill_sandbox":
+			s.Current.Skill.Sandbox = f.Checked
 
 			// Observational Memory
 		case "om_enabled":
@@ -271,6 +399,34 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 		case "cron_default_deliver":
 			s.Current.Cron.DefaultDeliverTo = splitCSV(val)
 
+		// Usage Quotas
+		case "quota_enabled":
+			s.Current.Quota.Enabled = f.Checked
+		case "quota_user_rpm":
+			if i, err := strconv.Atoi(val); err == nil {
+				s.Current.Quota.User.RequestsPerMinute = i
+			}
+		case "quota_user_tokens":
+			if i, err := strconv.Atoi(val); err == nil {
+				s.Current.Quota.User.TokensPerDay = i
+			}
+		case "quota_user_cost":
+			if fv, err := strconv.ParseFloat(val, 64); err == nil {
+				s.Current.Quota.User.CostPerDay = fv
+			}
+		case "quota_channel_rpm":
+			if i, err := strconv.Atoi(val); err == nil {
+				s.Current.Quota.Channel.RequestsPerMinute = i
+			}
+		case "quota_channel_tokens":
+			if i, err := strconv.Atoi(val); err == nil {
+				s.Current.Quota.Channel.TokensPerDay = i
+			}
+		case "quota_channel_cost":
+			if fv, err := strconv.ParseFloat(val, 64); err == nil {
+				s.Current.Quota.Channel.CostPerDay = fv
+			}
+
 		// Background
 		case "bg_enabled":
 			s.Current.Background.Enabled = f.Checked
@@ -473,6 +629,26 @@ func (s *ConfigState) UpdateConfigFromForm(form *FormModel) {
 			s.Current.Security.KMS.PKCS11.Pin = val
 		case "kms_pkcs11_key_label":
 			s.Current.Security.KMS.PKCS11.KeyLabel = val
+		case "kms_vault_address":
+			s.Current.Security.KMS.Vault.Address = val
+		case "kms_vault_namespace":
+			s.Current.Security.KMS.Vault.Namespace = val
+		case "kms_vault_auth_method":
+			s.Current.Security.KMS.Vault.AuthMethod = val
+		case "kms_vault_token":
+			s.Current.Security.KMS.Vault.Token = val
+		case "kms_vault_role_id":
+			s.Current.Security.KMS.Vault.RoleID = val
+		case "kms_vault_secret_id":
+			s.Current.Security.KMS.Vault.SecretID = val
+		case "kms_vault_approle_mount":
+			s.Current.Security.KMS.Vault.AppRoleMount = val
+		case "kms_vault_transit_mount":
+			s.Current.Security.KMS.Vault.TransitMount = val
+		case "kms_vault_kv_mount":
+			s.Current.Security.KMS.Vault.KVMount = val
+		case "kms_vault_kv_prefix":
+			s.Current.Security.KMS.Vault.KVPrefix = val
 
 		// Librarian
 		case "lib_enabled":
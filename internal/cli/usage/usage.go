@@ -0,0 +1,118 @@
+// Package usage provides the CLI command for usage reporting.
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/langoai/lango/internal/bootstrap"
+	"github.com/langoai/lango/internal/quota"
+)
+
+// usageEntry is one row of the JSON report.
+type usageEntry struct {
+	Channel      string  `json:"channel"`
+	UserID       string  `json:"userId"`
+	Requests     int     `json:"requests"`
+	InputTokens  int     `json:"inputTokens"`
+	OutputTokens int     `json:"outputTokens"`
+	Cost         float64 `json:"cost"`
+}
+
+// NewUsageCmd creates the usage command with lazy bootstrap loading.
+func NewUsageCmd(bootLoader func() (*bootstrap.Result, error)) *cobra.Command {
+	var (
+		days       int
+		channel    string
+		user       string
+		jsonOutput bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Report agent usage per user",
+		Long: `Report the requests, tokens and estimated cost of agent requests per user
+identity, highest cost first. Usage is recorded while quotas are enabled
+(quota.enabled); costs use the model prices in quota.pricing. Days are UTC.
+
+Examples:
+  lango usage                       # today
+  lango usage --days 7              # the last 7 days, including today
+  lango usage --channel telegram --user 12345`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if days < 1 {
+				return fmt.Errorf("--days must be at least 1")
+			}
+
+			boot, err := bootLoader()
+			if err != nil {
+				return fmt.Errorf("bootstrap: %w", err)
+			}
+			defer boot.DBClient.Close()
+
+			since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1-days)
+			store := quota.NewEntStore(boot.DBClient)
+			rows, err := store.Report(context.Background(), quota.Filter{
+				Channel: channel,
+				UserID:  user,
+				Since:   since,
+			})
+			if err != nil {
+				return fmt.Errorf("report usage: %w", err)
+			}
+
+			if jsonOutput {
+				entries := make([]usageEntry, 0, len(rows))
+				for _, r := range rows {
+					entries = append(entries, usageEntry{
+						Channel:      r.Channel,
+						UserID:       r.UserID,
+						Requests:     r.Requests,
+						InputTokens:  r.InputTokens,
+						OutputTokens: r.OutputTokens,
+						Cost:         r.Cost,
+					})
+				}
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(entries)
+			}
+
+			if !boot.Config.Quota.Enabled {
+				fmt.Println("Note: quotas are disabled (quota.enabled), so no new usage is recorded.")
+			}
+			if len(rows) == 0 {
+				fmt.Printf("No usage since %s.\n", since.Format(time.DateOnly))
+				return nil
+			}
+
+			var total quota.Usage
+			w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+			fmt.Fprintln(w, "CHANNEL\tUSER\tREQUESTS\tINPUT\tOUTPUT\tCOST (USD)")
+			for _, r := range rows {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%.4f\n",
+					r.Channel, r.UserID, r.Requests, r.InputTokens, r.OutputTokens, r.Cost)
+				total.Requests += r.Requests
+				total.InputTokens += r.InputTokens
+				total.OutputTokens += r.OutputTokens
+				total.Cost += r.Cost
+			}
+			fmt.Fprintf(w, "TOTAL\t\t%d\t%d\t%d\t%.4f\n",
+				total.Requests, total.InputTokens, total.OutputTokens, total.Cost)
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().IntVar(&days, "days", 1, "number of days to report, including today")
+	cmd.Flags().StringVar(&channel, "channel", "", "only report this channel")
+	cmd.Flags().StringVar(&user, "user", "", "only report this platform user ID")
+	cmd.Flags().BoolVar(&jsonOutput, "json", false, "Output as JSON")
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -30,6 +31,15 @@ func DefaultConfig() *Config {
 			RequestTimeout: 5 * time.Minute,
 			ToolTimeout:    2 * time.Minute,
 		},
+		Channels: ChannelsConfig{
+			Streaming: StreamingConfig{Enabled: true},
+		},
+		Media: MediaConfig{
+			MaxAttachmentSize: 20 * 1024 * 1024, // 20MB
+			Transcription: TranscriptionConfig{
+				Model: "whisper-1",
+			},
+		},
 		Logging: LoggingConfig{
 			Level:  "info",
 			Format: "console",
@@ -45,7 +55,10 @@ func DefaultConfig() *Config {
 				AllowBackground: true,
 			},
 			Filesystem: FilesystemToolConfig{
-				MaxReadSize: 10 * 1024 * 1024, // 10MB
+				MaxReadSize:        10 * 1024 * 1024, // 10MB
+				BlockedPaths:       []string{"~/.ssh", "~/.lango", ".env"},
+				ProtectUncommitted: true,
+				UndoRetention:      7 * 24 * time.Hour,
 			},
 			Browser: BrowserToolConfig{
 				Enabled:        false,
@@ -57,6 +70,7 @@ func DefaultConfig() *Config {
 			Interceptor: InterceptorConfig{
 				Enabled:        true,
 				ApprovalPolicy: ApprovalPolicyDangerous,
+				GrantScope:     GrantScopeSession,
 			},
 			DBEncryption: DBEncryptionConfig{
 				Enabled:        false,
@@ -66,6 +80,36 @@ func DefaultConfig() *Config {
 				FallbackToLocal:     true,
 				TimeoutPerOperation: 5 * time.Second,
 				MaxRetries:          3,
+				Vault: VaultConfig{
+					AuthMethod:   "token",
+					AppRoleMount: "approle",
+					TransitMount: "transit",
+					KVMount:      "secret",
+					KVPrefix:     "lango",
+				},
+			},
+			Scrub: ScrubConfig{
+				Enabled:       true,
+				StoreDetected: true,
+			},
+			Injection: InjectionConfig{
+				Enabled:         true,
+				Policy:          InjectionPolicyQuarantine,
+				Threshold:       0.7,
+				Tools:           []string{"browser_*", "p2p_query", "skill_*", "import_skill", "rag_retrieve", "exec", "exec_status"},
+				ScreenRetrieved: true,
+				Classifier: InjectionClassifierConfig{
+					MaxChars: 8000,
+				},
+			},
+			Secrets: SecretsConfig{
+				Backend:      "local",
+				KeepVersions: 5,
+				AccessLog:    true,
+				Expiry: SecretExpiryConfig{
+					WarnBefore:    7 * 24 * time.Hour,
+					CheckInterval: time.Hour,
+				},
 			},
 		},
 		Knowledge: KnowledgeConfig{
@@ -201,6 +245,9 @@ func Load(configPath string) (*Config, error) {
 	v.SetDefault("agent.temperature", defaults.Agent.Temperature)
 	v.SetDefault("agent.requestTimeout", defaults.Agent.RequestTimeout)
 	v.SetDefault("agent.toolTimeout", defaults.Agent.ToolTimeout)
+	v.SetDefault("channels.streaming.enabled", defaults.Channels.Streaming.Enabled)
+	v.SetDefault("media.maxAttachmentSize", defaults.Media.MaxAttachmentSize)
+	v.SetDefault("media.transcription.model", defaults.Media.Transcription.Model)
 	v.SetDefault("logging.level", defaults.Logging.Level)
 	v.SetDefault("logging.format", defaults.Logging.Format)
 	v.SetDefault("session.databasePath", defaults.Session.DatabasePath)
@@ -208,17 +255,41 @@ func Load(configPath string) (*Config, error) {
 	v.SetDefault("session.maxHistoryTurns", defaults.Session.MaxHistoryTurns)
 	v.SetDefault("tools.exec.defaultTimeout", defaults.Tools.Exec.DefaultTimeout)
 	v.SetDefault("tools.exec.allowBackground", defaults.Tools.Exec.AllowBackground)
+	v.SetDefault("tools.exec.sandbox", defaults.Tools.Exec.Sandbox)
+	v.SetDefault("sandbox.network", defaults.Sandbox.Network)
 	v.SetDefault("tools.filesystem.maxReadSize", defaults.Tools.Filesystem.MaxReadSize)
+	v.SetDefault("tools.filesystem.blockedPaths", defaults.Tools.Filesystem.BlockedPaths)
+	v.SetDefault("tools.filesystem.protectUncommitted", defaults.Tools.Filesystem.ProtectUncommitted)
+	v.SetDefault("tools.filesystem.undoRetention", defaults.Tools.Filesystem.UndoRetention)
 	v.SetDefault("tools.browser.enabled", defaults.Tools.Browser.Enabled)
 	v.SetDefault("tools.browser.headless", defaults.Tools.Browser.Headless)
 	v.SetDefault("tools.browser.sessionTimeout", defaults.Tools.Browser.SessionTimeout)
 	v.SetDefault("security.interceptor.enabled", defaults.Security.Interceptor.Enabled)
 	v.SetDefault("security.interceptor.approvalPolicy", string(defaults.Security.Interceptor.ApprovalPolicy))
+	v.SetDefault("security.interceptor.grantScope", string(defaults.Security.Interceptor.GrantScope))
 	v.SetDefault("security.dbEncryption.enabled", defaults.Security.DBEncryption.Enabled)
 	v.SetDefault("security.dbEncryption.cipherPageSize", defaults.Security.DBEncryption.CipherPageSize)
 	v.SetDefault("security.kms.fallbackToLocal", defaults.Security.KMS.FallbackToLocal)
 	v.SetDefault("security.kms.timeoutPerOperation", defaults.Security.KMS.TimeoutPerOperation)
 	v.SetDefault("security.kms.maxRetries", defaults.Security.KMS.MaxRetries)
+	v.SetDefault("security.kms.vault.authMethod", defaults.Security.KMS.Vault.AuthMethod)
+	v.SetDefault("security.kms.vault.appRoleMount", defaults.Security.KMS.Vault.AppRoleMount)
+	v.SetDefault("security.kms.vault.transitMount", defaults.Security.KMS.Vault.TransitMount)
+	v.SetDefault("security.kms.vault.kvMount", defaults.Security.KMS.Vault.KVMount)
+	v.SetDefault("security.kms.vault.kvPrefix", defaults.Security.KMS.Vault.KVPrefix)
+	v.SetDefault("security.scrub.enabled", defaults.Security.Scrub.Enabled)
+	v.SetDefault("security.scrub.storeDetected", defaults.Security.Scrub.StoreDetected)
+	v.SetDefault("security.secrets.backend", defaults.Security.Secrets.Backend)
+	v.SetDefault("security.injection.enabled", defaults.Security.Injection.Enabled)
+	v.SetDefault("security.injection.policy", defaults.Security.Injection.Policy)
+	v.SetDefault("security.injection.threshold", defaults.Security.Injection.Threshold)
+	v.SetDefault("security.injection.tools", defaults.Security.Injection.Tools)
+	v.SetDefault("security.injection.screenRetrieved", defaults.Security.Injection.ScreenRetrieved)
+	v.SetDefault("security.injection.classifier.maxChars", defaults.Security.Injection.Classifier.MaxChars)
+	v.SetDefault("security.secrets.keepVersions", defaults.Security.Secrets.KeepVersions)
+	v.SetDefault("security.secrets.accessLog", defaults.Security.Secrets.AccessLog)
+	v.SetDefault("security.secrets.expiry.warnBefore", defaults.Security.Secrets.Expiry.WarnBefore)
+	v.SetDefault("security.secrets.expiry.checkInterval", defaults.Security.Secrets.Expiry.CheckInterval)
 	v.SetDefault("graph.enabled", defaults.Graph.Enabled)
 	v.SetDefault("graph.backend", defaults.Graph.Backend)
 	v.SetDefault("graph.maxTraversalDepth", defaults.Graph.MaxTraversalDepth)
@@ -239,6 +310,7 @@ func Load(configPath string) (*Config, error) {
 	v.SetDefault("cron.defaultSessionMode", defaults.Cron.DefaultSessionMode)
 	v.SetDefault("cron.historyRetention", defaults.Cron.HistoryRetention)
 	v.SetDefault("cron.defaultDeliverTo", defaults.Cron.DefaultDeliverTo)
+	v.SetDefault("quota.enabled", defaults.Quota.Enabled)
 	v.SetDefault("background.enabled", defaults.Background.Enabled)
 	v.SetDefault("background.yieldMs", defaults.Background.YieldMs)
 	v.SetDefault("background.maxConcurrentTasks", defaults.Background.MaxConcurrentTasks)
@@ -270,6 +342,7 @@ func Load(configPath string) (*Config, error) {
 	v.SetDefault("skill.maxBulkImport", defaults.Skill.MaxBulkImport)
 	v.SetDefault("skill.importConcurrency", defaults.Skill.ImportConcurrency)
 	v.SetDefault("skill.importTimeout", defaults.Skill.ImportTimeout)
+	v.SetDefault("skill.sandbox", defaults.Skill.Sandbox)
 	v.SetDefault("p2p.enabled", defaults.P2P.Enabled)
 	v.SetDefault("p2p.listenAddrs", defaults.P2P.ListenAddrs)
 	v.SetDefault("p2p.keyDir", defaults.P2P.KeyDir)
@@ -345,6 +418,26 @@ func substituteEnvVars(cfg *Config) {
 	cfg.Channels.Slack.BotToken = expandEnvVars(cfg.Channels.Slack.BotToken)
 	cfg.Channels.Slack.AppToken = expandEnvVars(cfg.Channels.Slack.AppToken)
 	cfg.Channels.Slack.SigningSecret = expandEnvVars(cfg.Channels.Slack.SigningSecret)
+	cfg.Channels.Matrix.AccessToken = expandEnvVars(cfg.Channels.Matrix.AccessToken)
+	cfg.Channels.Email.IMAP.Password = expandEnvVars(cfg.Channels.Email.IMAP.Password)
+	cfg.Channels.Email.SMTP.Password = expandEnvVars(cfg.Channels.Email.SMTP.Password)
+	for i := range cfg.Channels.Webhook.Endpoints {
+		ep := &cfg.Channels.Webhook.Endpoints[i]
+		ep.Secret = expandEnvVars(ep.Secret)
+	}
+	for i := range cfg.Channels.Webhook.Targets {
+		t := &cfg.Channels.Webhook.Targets[i]
+		t.URL = expandEnvVars(t.URL)
+		t.Secret = expandEnvVars(t.Secret)
+		for k, v := range t.Headers {
+			t.Headers[k] = expandEnvVars(v)
+		}
+	}
+
+	// REST API tokens
+	for i, tok := range cfg.Server.APITokens {
+		cfg.Server.APITokens[i] = expandEnvVars(tok)
+	}
 
 	// Auth OIDC provider credentials
 	for id, aCfg := range cfg.Auth.Providers {
@@ -401,10 +494,10 @@ func Validate(cfg *Config) error {
 	if cfg.Security.Signer.Provider != "" {
 		validProviders := map[string]bool{
 			"local": true, "rpc": true, "enclave": true,
-			"aws-kms": true, "gcp-kms": true, "azure-kv": true, "pkcs11": true,
+			"aws-kms": true, "gcp-kms": true, "azure-kv": true, "pkcs11": true, "vault": true,
 		}
 		if !validProviders[cfg.Security.Signer.Provider] {
-			errs = append(errs, fmt.Sprintf("invalid security.signer.provider: %q (must be local, rpc, enclave, aws-kms, gcp-kms, azure-kv, or pkcs11)", cfg.Security.Signer.Provider))
+			errs = append(errs, fmt.Sprintf("invalid security.signer.provider: %q (must be local, rpc, enclave, aws-kms, gcp-kms, azure-kv, pkcs11, or vault)", cfg.Security.Signer.Provider))
 		}
 		if cfg.Security.Signer.Provider == "rpc" && cfg.Security.Signer.RPCUrl == "" {
 			errs = append(errs, "security.signer.rpcUrl is required when provider is 'rpc'")
@@ -426,6 +519,10 @@ func Validate(cfg *Config) error {
 			if cfg.Security.KMS.PKCS11.ModulePath == "" {
 				errs = append(errs, "security.kms.pkcs11.modulePath is required when provider is 'pkcs11'")
 			}
+		case "vault":
+			if cfg.Security.KMS.KeyID == "" {
+				errs = append(errs, "security.kms.keyId (Transit key name) is required when provider is 'vault'")
+			}
 		}
 	}
 
@@ -455,6 +552,71 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	// Validate quota config
+	if cfg.Quota.Enabled {
+		for i, o := range cfg.Quota.Overrides {
+			if o.Subject == "" {
+				errs = append(errs, fmt.Sprintf("quota.overrides[%d].subject is required", i))
+			}
+		}
+		for i, p := range cfg.Quota.Pricing {
+			if p.Model == "" {
+				errs = append(errs, fmt.Sprintf("quota.pricing[%d].model is required", i))
+			}
+		}
+	}
+
+	// Validate approval policy rules (patterns are compiled at startup)
+	for i, rule := range cfg.Security.Interceptor.Policies {
+		if !rule.Action.Valid() {
+			errs = append(errs, fmt.Sprintf("invalid security.interceptor.policies[%d].action: %q (must be allow, ask, or deny)", i, rule.Action))
+		}
+	}
+	if gs := cfg.Security.Interceptor.GrantScope; gs != "" && !gs.Valid() {
+		errs = append(errs, fmt.Sprintf("invalid security.interceptor.grantScope: %q (must be session, user, or global)", gs))
+	}
+	if cfg.Security.Interceptor.GrantTTL < 0 {
+		errs = append(errs, "security.interceptor.grantTTL must not be negative")
+	}
+	for name, pattern := range cfg.Security.Scrub.CustomPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid security.scrub.customPatterns.%s: %v", name, err))
+		}
+	}
+	if p := cfg.Security.Injection.Policy; p != "" && !p.Valid() {
+		errs = append(errs, fmt.Sprintf("invalid security.injection.policy: %q (must be quarantine or block)", p))
+	}
+	if t := cfg.Security.Injection.Threshold; t < 0 || t > 1 {
+		errs = append(errs, "security.injection.threshold must be between 0 and 1")
+	}
+	for name, pattern := range cfg.Security.Injection.CustomPatterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid security.injection.customPatterns.%s: %v", name, err))
+		}
+	}
+	switch cfg.Security.Secrets.Backend {
+	case "", "local", "vault":
+	default:
+		errs = append(errs, fmt.Sprintf("invalid security.secrets.backend: %q (must be local or vault)", cfg.Security.Secrets.Backend))
+	}
+	if cfg.Security.Signer.Provider == "vault" || cfg.Security.Secrets.Backend == "vault" {
+		switch cfg.Security.KMS.Vault.AuthMethod {
+		case "", "token":
+		case "approle":
+			if cfg.Security.KMS.Vault.RoleID == "" {
+				errs = append(errs, "security.kms.vault.roleId is required when authMethod is 'approle'")
+			}
+		default:
+			errs = append(errs, fmt.Sprintf("invalid security.kms.vault.authMethod: %q (must be token or approle)", cfg.Security.KMS.Vault.AuthMethod))
+		}
+	}
+	if cfg.Security.Secrets.KeepVersions < 0 {
+		errs = append(errs, "security.secrets.keepVersions must not be negative")
+	}
+	if len(cfg.Security.Secrets.Expiry.DeliverTo) > 0 && cfg.Security.Secrets.Expiry.CheckInterval <= 0 {
+		errs = append(errs, "security.secrets.expiry.checkInterval must be positive when deliverTo is set")
+	}
+
 	// Validate P2P config
 	if cfg.P2P.Enabled {
 		if !cfg.Payment.Enabled {
@@ -468,9 +630,26 @@ func Validate(cfg *Config) error {
 
 	// Validate container sandbox config
 	if cfg.P2P.ToolIsolation.Container.Enabled {
-		validRuntimes := map[string]bool{"auto": true, "docker": true, "gvisor": true, "native": true}
+		validRuntimes := map[string]bool{"auto": true, "docker": true, "gvisor": true, "linux": true, "native": true}
 		if !validRuntimes[cfg.P2P.ToolIsolation.Container.Runtime] {
-			errs = append(errs, fmt.Sprintf("invalid p2p.toolIsolation.container.runtime: %q (must be auto, docker, gvisor, or native)", cfg.P2P.ToolIsolation.Container.Runtime))
+			errs = append(errs, fmt.Sprintf("invalid p2p.toolIsolation.container.runtime: %q (must be auto, docker, gvisor, linux, or native)", cfg.P2P.ToolIsolation.Container.Runtime))
+		}
+	}
+
+	// Validate Matrix channel config
+	if cfg.Channels.Matrix.Enabled && cfg.Channels.Matrix.E2EE {
+		errs = append(errs, "channels.matrix.e2ee is not supported yet: the Matrix channel only works in unencrypted rooms")
+	}
+
+	// Validate Linux sandbox config
+	if cfg.Sandbox.MemoryLimitMB < 0 || cfg.Sandbox.CPUQuotaUS < 0 || cfg.Sandbox.PidsLimit < 0 {
+		errs = append(errs, "sandbox.memoryLimitMB, sandbox.cpuQuotaUs and sandbox.pidsLimit must not be negative")
+	}
+
+	// Validate filesystem tool deny globs
+	for _, pattern := range cfg.Tools.Filesystem.BlockedPaths {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			errs = append(errs, fmt.Sprintf("invalid tools.filesystem.blockedPaths glob %q: %v", pattern, err))
 		}
 	}
 
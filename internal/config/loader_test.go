@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -67,4 +68,12 @@ func TestValidate(t *testing.T) {
 	if err := Validate(cfg); err == nil {
 		t.Error("expected error for invalid log level")
 	}
+	cfg.Logging.Level = "info"
+
+	// Unsupported Matrix end-to-end encryption
+	cfg.Channels.Matrix.Enabled = true
+	cfg.Channels.Matrix.E2EE = true
+	if err := Validate(cfg); err == nil || !strings.Contains(err.Error(), "channels.matrix.e2ee") {
+		t.Errorf("expected error for matrix e2ee, got %v", err)
+	}
 }
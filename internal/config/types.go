@@ -17,15 +17,24 @@ type Config struct {
 	// Channel configurations
 	Channels ChannelsConfig `mapstructure:"channels" json:"channels"`
 
+	// Media handling for channel attachments
+	Media MediaConfig `mapstructure:"media" json:"media"`
+
 	// Logging configuration
 	Logging LoggingConfig `mapstructure:"logging" json:"logging"`
 
 	// Session configuration
 	Session SessionConfig `mapstructure:"session" json:"session"`
 
+	// Usage quota configuration
+	Quota QuotaConfig `mapstructure:"quota" json:"quota"`
+
 	// Tools configuration
 	Tools ToolsConfig `mapstructure:"tools" json:"tools"`
 
+	// Sandbox configuration (Linux sandbox for exec commands and skill scripts)
+	Sandbox SandboxConfig `mapstructure:"sandbox" json:"sandbox"`
+
 	// Auth configuration
 	Auth AuthConfig `mapstructure:"auth" json:"auth"`
 
@@ -88,6 +97,10 @@ type ServerConfig struct {
 
 	// Allowed origins for WebSocket CORS (empty = same-origin, ["*"] = allow all)
 	AllowedOrigins []string `mapstructure:"allowedOrigins" json:"allowedOrigins"`
+
+	// Bearer tokens accepted by the REST API under /api, for scripts and
+	// dashboards. Without tokens or OIDC auth the API is open (local mode).
+	APITokens []string `mapstructure:"apiTokens" json:"apiTokens,omitempty"`
 }
 
 // AgentConfig defines LLM agent settings
@@ -149,11 +162,53 @@ type ProviderConfig struct {
 	BaseURL string `mapstructure:"baseUrl" json:"baseUrl"`
 }
 
+// MediaConfig controls how images, voice messages and documents from
+// channels are passed to the model
+type MediaConfig struct {
+	// Largest attachment downloaded, in bytes (default: 20MB)
+	MaxAttachmentSize int64 `mapstructure:"maxAttachmentSize" json:"maxAttachmentSize"`
+
+	// Speech-to-text for voice messages
+	Transcription TranscriptionConfig `mapstructure:"transcription" json:"transcription"`
+}
+
+// TranscriptionConfig configures speech-to-text for voice messages
+type TranscriptionConfig struct {
+	// Key in the providers map of an OpenAI-compatible provider.
+	// Empty passes audio to the model as is.
+	Provider string `mapstructure:"provider" json:"provider"`
+
+	// Transcription model (default: whisper-1)
+	Model string `mapstructure:"model" json:"model"`
+
+	// Spoken language as an ISO-639-1 code; empty detects it
+	Language string `mapstructure:"language" json:"language,omitempty"`
+}
+
 // ChannelsConfig holds all channel configurations
 type ChannelsConfig struct {
 	Telegram TelegramConfig `mapstructure:"telegram" json:"telegram"`
 	Discord  DiscordConfig  `mapstructure:"discord" json:"discord"`
 	Slack    SlackConfig    `mapstructure:"slack" json:"slack"`
+	Matrix   MatrixConfig   `mapstructure:"matrix" json:"matrix"`
+	Email    EmailConfig    `mapstructure:"email" json:"email"`
+	Webhook  WebhookConfig  `mapstructure:"webhook" json:"webhook"`
+
+	// Progressive delivery of replies on Telegram, Discord and Slack
+	Streaming StreamingConfig `mapstructure:"streaming" json:"streaming"`
+
+	// Users allowed to run admin commands such as /cron pause, as
+	// "channel:userID" (e.g. "telegram:123456789", "slack:U024BE7LH")
+	Admins []string `mapstructure:"admins" json:"admins"`
+}
+
+// StreamingConfig defines how replies are streamed into chat channels.
+type StreamingConfig struct {
+	// Post a reply as soon as it starts and edit it as it grows (default: true)
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Show a progress line such as "running exec…" while tools run
+	ShowToolCalls bool `mapstructure:"showToolCalls" json:"showToolCalls"`
 }
 
 // TelegramConfig defines Telegram bot settings
@@ -181,6 +236,9 @@ type DiscordConfig struct {
 
 	// Allowed guild IDs (empty = allow all)
 	AllowedGuilds []string `mapstructure:"allowedGuilds" json:"allowedGuilds"`
+
+	// Session grouping: "user", "channel" or "thread" (default: user)
+	SessionScope string `mapstructure:"sessionScope" json:"sessionScope"`
 }
 
 // SlackConfig defines Slack app settings
@@ -196,6 +254,137 @@ type SlackConfig struct {
 
 	// Signing secret for request verification
 	SigningSecret string `mapstructure:"signingSecret" json:"signingSecret"`
+
+	// Allowed user IDs (U...) or channel IDs (C..., D...) (empty = allow all)
+	Allowlist []string `mapstructure:"allowlist" json:"allowlist"`
+
+	// Session grouping: "user", "channel" or "thread" (default: user)
+	SessionScope string `mapstructure:"sessionScope" json:"sessionScope"`
+}
+
+// MatrixConfig defines Matrix bot settings
+type MatrixConfig struct {
+	// Enable Matrix channel
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Homeserver base URL (e.g. https://matrix.example.org)
+	HomeserverURL string `mapstructure:"homeserverUrl" json:"homeserverUrl"`
+
+	// Access token of the bot account
+	AccessToken string `mapstructure:"accessToken" json:"accessToken"`
+
+	// Allowed user IDs (@user:server) or room IDs (!room:server) (empty = allow all)
+	Allowlist []string `mapstructure:"allowlist" json:"allowlist"`
+
+	// E2EE enables end-to-end encrypted rooms. Not supported yet; setting
+	// it fails validation instead of silently ignoring encrypted rooms.
+	E2EE bool `mapstructure:"e2ee" json:"e2ee"`
+}
+
+// EmailConfig defines email channel settings
+type EmailConfig struct {
+	// Enable email channel
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Address the agent sends from and receives at
+	Address string `mapstructure:"address" json:"address"`
+
+	// IMAP mailbox to poll for incoming mail
+	IMAP EmailIMAPConfig `mapstructure:"imap" json:"imap"`
+
+	// Local Maildir to read instead of IMAP (e.g. ~/Maildir)
+	Maildir string `mapstructure:"maildir" json:"maildir"`
+
+	// SMTP server for outgoing mail
+	SMTP EmailSMTPConfig `mapstructure:"smtp" json:"smtp"`
+
+	// Allowed sender addresses or @domains (required)
+	Allowlist []string `mapstructure:"allowlist" json:"allowlist"`
+
+	// How often to check for new mail (default: 30s)
+	PollInterval time.Duration `mapstructure:"pollInterval" json:"pollInterval"`
+}
+
+// EmailIMAPConfig defines the IMAP server used by the email channel
+type EmailIMAPConfig struct {
+	Host     string `mapstructure:"host" json:"host"`
+	Port     int    `mapstructure:"port" json:"port"` // default: 993 (143 when insecure)
+	Username string `mapstructure:"username" json:"username"`
+	Password string `mapstructure:"password" json:"password"`
+
+	// Mailbox to poll (default: INBOX)
+	Mailbox string `mapstructure:"mailbox" json:"mailbox"`
+
+	// Connect without TLS (local test servers only)
+	Insecure bool `mapstructure:"insecure" json:"insecure"`
+}
+
+// EmailSMTPConfig defines the SMTP server used by the email channel
+type EmailSMTPConfig struct {
+	Host     string `mapstructure:"host" json:"host"`
+	Port     int    `mapstructure:"port" json:"port"` // default: 587; 465 uses implicit TLS
+	Username string `mapstructure:"username" json:"username"`
+	Password string `mapstructure:"password" json:"password"`
+}
+
+// WebhookConfig defines generic inbound and outbound webhooks
+type WebhookConfig struct {
+	// Enable webhook channel
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Inbound endpoints served at POST /webhooks/<name>
+	Endpoints []WebhookEndpointConfig `mapstructure:"endpoints" json:"endpoints"`
+
+	// Outbound delivery targets addressed as webhook:<name>
+	Targets []WebhookTargetConfig `mapstructure:"targets" json:"targets"`
+}
+
+// WebhookEndpointConfig defines an inbound webhook endpoint
+type WebhookEndpointConfig struct {
+	// Endpoint name, used in the URL path (letters, digits, - and _)
+	Name string `mapstructure:"name" json:"name"`
+
+	// Shared secret used to verify requests (required)
+	Secret string `mapstructure:"secret" json:"secret"`
+
+	// How requests are authenticated: "hmac" (default) or "bearer"
+	Auth string `mapstructure:"auth" json:"auth,omitempty"`
+
+	// Header carrying the HMAC-SHA256 signature (default: X-Signature-256)
+	SignatureHeader string `mapstructure:"signatureHeader" json:"signatureHeader,omitempty"`
+
+	// Go template rendering the JSON payload into the prompt
+	Template string `mapstructure:"template" json:"template,omitempty"`
+
+	// Session strategy: "endpoint" (default), "event" or "template"
+	Session string `mapstructure:"session" json:"session,omitempty"`
+
+	// Go template producing the session key for the "template" strategy
+	SessionKey string `mapstructure:"sessionKey" json:"sessionKey,omitempty"`
+
+	// Delivery targets for the agent's answer (e.g. ["telegram", "webhook:ci"])
+	ReplyTo []string `mapstructure:"replyTo" json:"replyTo,omitempty"`
+}
+
+// WebhookTargetConfig defines an outbound webhook delivery target
+type WebhookTargetConfig struct {
+	// Target name, addressed as webhook:<name>
+	Name string `mapstructure:"name" json:"name"`
+
+	// URL the message is POSTed to
+	URL string `mapstructure:"url" json:"url"`
+
+	// Optional secret; when set the body is signed with HMAC-SHA256
+	Secret string `mapstructure:"secret" json:"secret,omitempty"`
+
+	// Header carrying the signature (default: X-Signature-256)
+	SignatureHeader string `mapstructure:"signatureHeader" json:"signatureHeader,omitempty"`
+
+	// Go template producing the JSON body (default: {"text": ...})
+	Template string `mapstructure:"template" json:"template,omitempty"`
+
+	// Extra request headers
+	Headers map[string]string `mapstructure:"headers" json:"headers,omitempty"`
 }
 
 // LoggingConfig defines logging settings
@@ -225,6 +414,54 @@ type SessionConfig struct {
 	MaxHistoryTurns int `mapstructure:"maxHistoryTurns" json:"maxHistoryTurns"`
 }
 
+// QuotaConfig defines per-user and per-channel usage limits. Limits apply
+// to requests that run the agent; slash commands are not counted.
+type QuotaConfig struct {
+	// Enable quota enforcement and usage recording.
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Limits for each user identity (channel and platform user ID).
+	User QuotaLimits `mapstructure:"user" json:"user"`
+
+	// Limits for each channel, all of its users combined.
+	Channel QuotaLimits `mapstructure:"channel" json:"channel"`
+
+	// Overrides replace the user or channel limits for specific subjects.
+	Overrides []QuotaOverride `mapstructure:"overrides" json:"overrides,omitempty"`
+
+	// Pricing of the models in use, for the cost limits and usage reports.
+	// Models without pricing cost nothing.
+	Pricing []ModelPricing `mapstructure:"pricing" json:"pricing,omitempty"`
+}
+
+// QuotaLimits caps the usage of a quota subject. Zero means unlimited.
+type QuotaLimits struct {
+	// Maximum agent requests per minute.
+	RequestsPerMinute int `mapstructure:"requestsPerMinute" json:"requestsPerMinute"`
+
+	// Maximum input plus output tokens per UTC day.
+	TokensPerDay int `mapstructure:"tokensPerDay" json:"tokensPerDay"`
+
+	// Maximum estimated cost in USD per UTC day.
+	CostPerDay float64 `mapstructure:"costPerDay" json:"costPerDay"`
+}
+
+// QuotaOverride sets the limits of one user identity or channel.
+type QuotaOverride struct {
+	// Subject is a user identity such as "telegram:12345" or a channel
+	// name such as "discord".
+	Subject string `mapstructure:"subject" json:"subject"`
+
+	Limits QuotaLimits `mapstructure:"limits" json:"limits"`
+}
+
+// ModelPricing is the price of a model in USD per million tokens.
+type ModelPricing struct {
+	Model  string  `mapstructure:"model" json:"model"`
+	Input  float64 `mapstructure:"input" json:"input"`
+	Output float64 `mapstructure:"output" json:"output"`
+}
+
 // ToolsConfig defines tool-specific settings
 type ToolsConfig struct {
 	Exec       ExecToolConfig       `mapstructure:"exec" json:"exec"`
@@ -242,6 +479,34 @@ type ExecToolConfig struct {
 
 	// Working directory (empty = current)
 	WorkDir string `mapstructure:"workDir" json:"workDir"`
+
+	// Sandbox runs commands in the Linux sandbox (see SandboxConfig)
+	Sandbox bool `mapstructure:"sandbox" json:"sandbox"`
+}
+
+// SandboxConfig defines the confinement of commands run in the Linux
+// sandbox: a read-only root filesystem with a writable working directory,
+// no network by default, landlock and seccomp restrictions and optional
+// cgroup v2 limits.
+type SandboxConfig struct {
+	// Network keeps host network access inside the sandbox
+	Network bool `mapstructure:"network" json:"network"`
+
+	// WritablePaths are host paths writable in addition to the working directory
+	WritablePaths []string `mapstructure:"writablePaths" json:"writablePaths"`
+
+	// MemoryLimitMB is the memory limit in megabytes (0 = unlimited)
+	MemoryLimitMB int64 `mapstructure:"memoryLimitMB" json:"memoryLimitMB"`
+
+	// CPUQuotaUS is the CPU time in microseconds per 100ms period (0 = unlimited)
+	CPUQuotaUS int64 `mapstructure:"cpuQuotaUs" json:"cpuQuotaUs"`
+
+	// PidsLimit is the maximum number of processes (0 = unlimited)
+	PidsLimit int64 `mapstructure:"pidsLimit" json:"pidsLimit"`
+
+	// AllowMissingLimits runs commands without the limits above when no
+	// delegated cgroup v2 subtree is available instead of failing them
+	AllowMissingLimits bool `mapstructure:"allowMissingLimits" json:"allowMissingLimits"`
 }
 
 // FilesystemToolConfig defines file access settings
@@ -251,6 +516,18 @@ type FilesystemToolConfig struct {
 
 	// Allowed paths (empty = allow all)
 	AllowedPaths []string `mapstructure:"allowedPaths" json:"allowedPaths"`
+
+	// Denied path globs. A glob without a slash matches any path element
+	// (".env", "*.pem"); other globs match the path and everything below
+	// it ("~/.ssh").
+	BlockedPaths []string `mapstructure:"blockedPaths" json:"blockedPaths"`
+
+	// Refuse to change files with uncommitted git changes unless the tool
+	// call sets force. Changes made by the same session are allowed.
+	ProtectUncommitted bool `mapstructure:"protectUncommitted" json:"protectUncommitted"`
+
+	// How long undo snapshots are kept (0 = forever)
+	UndoRetention time.Duration `mapstructure:"undoRetention" json:"undoRetention"`
 }
 
 // BrowserToolConfig defines browser automation settings
@@ -163,6 +163,9 @@ type SkillConfig struct {
 
 	// ImportTimeout is the overall timeout for skill import operations (default: 2m).
 	ImportTimeout time.Duration `mapstructure:"importTimeout" json:"importTimeout"`
+
+	// Sandbox runs script skills in the Linux sandbox (see SandboxConfig).
+	Sandbox bool `mapstructure:"sandbox" json:"sandbox"`
 }
 
 // ProviderTypeToEmbeddingType maps a provider config type to the corresponding
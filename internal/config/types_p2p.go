@@ -88,7 +88,7 @@ type ContainerSandboxConfig struct {
 	// Enabled activates container-based sandbox instead of subprocess isolation.
 	Enabled bool `mapstructure:"enabled" json:"enabled"`
 
-	// Runtime selects the container runtime: "auto", "docker", "gvisor", or "native" (default: "auto").
+	// Runtime selects the container runtime: "auto", "docker", "gvisor", "linux", or "native" (default: "auto").
 	Runtime string `mapstructure:"runtime" json:"runtime"`
 
 	// Image is the Docker image for the sandbox container (default: "lango-sandbox:latest").
@@ -12,6 +12,131 @@ type SecurityConfig struct {
 	DBEncryption DBEncryptionConfig `mapstructure:"dbEncryption" json:"dbEncryption"`
 	// KMS configuration (Cloud KMS / HSM backends)
 	KMS KMSConfig `mapstructure:"kms" json:"kms"`
+	// Scrub configuration (secret removal before persistence)
+	Scrub ScrubConfig `mapstructure:"scrub" json:"scrub"`
+	// Secrets configuration (versions, expiry warnings, access log)
+	Secrets SecretsConfig `mapstructure:"secrets" json:"secrets"`
+	// Injection configuration (prompt-injection screening of untrusted content)
+	Injection InjectionConfig `mapstructure:"injection" json:"injection"`
+}
+
+// InjectionConfig defines how tool outputs and retrieved content are
+// screened for prompt-injection payloads before they reach the model.
+type InjectionConfig struct {
+	// Enabled screens untrusted content (default: true).
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Policy decides what happens to flagged content (default: "quarantine").
+	Policy InjectionPolicy `mapstructure:"policy" json:"policy"`
+
+	// Threshold is the combined detection score that flags content (default: 0.7).
+	Threshold float64 `mapstructure:"threshold" json:"threshold"`
+
+	// Tools lists the tools whose output is screened, as name globs
+	// (default: browser, P2P, skill, RAG and exec tools).
+	Tools []string `mapstructure:"tools" json:"tools"`
+
+	// ScreenRetrieved screens RAG and Graph RAG chunks before they are added
+	// to the system prompt (default: true).
+	ScreenRetrieved bool `mapstructure:"screenRetrieved" json:"screenRetrieved"`
+
+	// DisabledRules lists builtin heuristic rule names to skip.
+	DisabledRules []string `mapstructure:"disabledRules" json:"disabledRules"`
+
+	// CustomPatterns maps additional rule names to regular expressions.
+	CustomPatterns map[string]string `mapstructure:"customPatterns" json:"customPatterns"`
+
+	// Classifier configures the optional LLM classifier.
+	Classifier InjectionClassifierConfig `mapstructure:"classifier" json:"classifier"`
+}
+
+// InjectionClassifierConfig defines the optional LLM prompt-injection
+// classifier. It runs in addition to the heuristic rules and costs one LLM
+// call per screened item.
+type InjectionClassifierConfig struct {
+	// Enabled turns on the LLM classifier (default: false).
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// Provider is the provider ID used for classification (empty = agent provider).
+	Provider string `mapstructure:"provider" json:"provider"`
+
+	// Model is the model used for classification (empty = agent model).
+	Model string `mapstructure:"model" json:"model"`
+
+	// MaxChars limits how much of each item is sent to the classifier (default: 8000).
+	MaxChars int `mapstructure:"maxChars" json:"maxChars"`
+}
+
+// InjectionPolicy is the action taken on content flagged as a prompt injection.
+type InjectionPolicy string
+
+const (
+	// InjectionPolicyQuarantine passes flagged content on inside an
+	// untrusted-content wrapper (default).
+	InjectionPolicyQuarantine InjectionPolicy = "quarantine"
+	// InjectionPolicyBlock withholds flagged content from the model.
+	InjectionPolicyBlock InjectionPolicy = "block"
+)
+
+// Valid reports whether p is a known injection policy.
+func (p InjectionPolicy) Valid() bool {
+	switch p {
+	case InjectionPolicyQuarantine, InjectionPolicyBlock:
+		return true
+	}
+	return false
+}
+
+// Values returns all known injection policies.
+func (p InjectionPolicy) Values() []InjectionPolicy {
+	return []InjectionPolicy{InjectionPolicyQuarantine, InjectionPolicyBlock}
+}
+
+// SecretsConfig defines how the secrets store keeps earlier values, warns
+// about expiring secrets and records secret access.
+type SecretsConfig struct {
+	// KeepVersions is the number of earlier values kept per secret for rollback (default: 5).
+	KeepVersions int `mapstructure:"keepVersions" json:"keepVersions"`
+
+	// Backend is where secret values are stored: "local" (encrypted in the
+	// database) or "vault" (Vault KV v2, see security.kms.vault) (default: "local").
+	Backend string `mapstructure:"backend" json:"backend"`
+
+	// AccessLog records every secret reference resolved by a tool in the audit log (default: true).
+	AccessLog bool `mapstructure:"accessLog" json:"accessLog"`
+
+	// Expiry configures warnings about expiring secrets.
+	Expiry SecretExpiryConfig `mapstructure:"expiry" json:"expiry"`
+}
+
+// SecretExpiryConfig defines when and where expiry warnings are sent.
+type SecretExpiryConfig struct {
+	// WarnBefore is how long before expiry a secret is reported (default: 168h).
+	WarnBefore time.Duration `mapstructure:"warnBefore" json:"warnBefore"`
+
+	// CheckInterval is how often expiring secrets are checked for (default: 1h).
+	CheckInterval time.Duration `mapstructure:"checkInterval" json:"checkInterval"`
+
+	// DeliverTo lists the channels warnings are sent to (e.g. "telegram:123456").
+	// No channels disables the warnings.
+	DeliverTo []string `mapstructure:"deliverTo" json:"deliverTo"`
+}
+
+// ScrubConfig defines how secrets are removed from messages, memory,
+// knowledge and graph triples before they are written to the database.
+type ScrubConfig struct {
+	// Enabled replaces detected secrets with {{secret:name}} reference tokens (default: true).
+	Enabled bool `mapstructure:"enabled" json:"enabled"`
+
+	// StoreDetected keeps detected secrets in the encrypted secrets store, so
+	// their reference tokens still resolve after a restart (default: true).
+	StoreDetected bool `mapstructure:"storeDetected" json:"storeDetected"`
+
+	// DisabledPatterns lists builtin detection pattern names to skip.
+	DisabledPatterns []string `mapstructure:"disabledPatterns" json:"disabledPatterns"`
+
+	// CustomPatterns maps additional pattern names to regular expressions.
+	CustomPatterns map[string]string `mapstructure:"customPatterns" json:"customPatterns"`
 }
 
 // KMSConfig defines Cloud KMS and HSM backend settings.
@@ -39,6 +164,43 @@ type KMSConfig struct {
 
 	// PKCS11 holds PKCS#11 HSM specific settings.
 	PKCS11 PKCS11Config `mapstructure:"pkcs11" json:"pkcs11"`
+
+	// Vault holds HashiCorp Vault / OpenBao specific settings.
+	Vault VaultConfig `mapstructure:"vault" json:"vault"`
+}
+
+// VaultConfig defines HashiCorp Vault / OpenBao settings, used by the "vault"
+// signer provider (Transit engine) and the "vault" secrets backend (KV v2).
+type VaultConfig struct {
+	// Address is the Vault server URL (e.g. "https://vault.example.com:8200"; default: VAULT_ADDR env var).
+	Address string `mapstructure:"address" json:"address"`
+
+	// Namespace is the Vault Enterprise / OpenBao namespace (default: VAULT_NAMESPACE env var).
+	Namespace string `mapstructure:"namespace" json:"namespace,omitempty"`
+
+	// AuthMethod is "token" or "approle" (default: "token").
+	AuthMethod string `mapstructure:"authMethod" json:"authMethod"`
+
+	// Token is the Vault token for token auth (prefer VAULT_TOKEN env var).
+	Token string `mapstructure:"token" json:"token,omitempty"`
+
+	// RoleID is the AppRole role ID for approle auth.
+	RoleID string `mapstructure:"roleId" json:"roleId,omitempty"`
+
+	// SecretID is the AppRole secret ID (prefer LANGO_VAULT_SECRET_ID env var).
+	SecretID string `mapstructure:"secretId" json:"secretId,omitempty"`
+
+	// AppRoleMount is the mount path of the AppRole auth method (default: "approle").
+	AppRoleMount string `mapstructure:"appRoleMount" json:"appRoleMount"`
+
+	// TransitMount is the mount path of the Transit engine (default: "transit").
+	TransitMount string `mapstructure:"transitMount" json:"transitMount"`
+
+	// KVMount is the mount path of the KV v2 engine (default: "secret").
+	KVMount string `mapstructure:"kvMount" json:"kvMount"`
+
+	// KVPrefix is the path below KVMount where secrets are stored (default: "lango").
+	KVPrefix string `mapstructure:"kvPrefix" json:"kvPrefix"`
 }
 
 // AzureKVConfig defines Azure Key Vault specific settings.
@@ -115,6 +277,97 @@ type InterceptorConfig struct {
 	PIIDisabledPatterns []string          `mapstructure:"piiDisabledPatterns" json:"piiDisabledPatterns"`
 	PIICustomPatterns   map[string]string `mapstructure:"piiCustomPatterns" json:"piiCustomPatterns"`
 	Presidio            PresidioConfig    `mapstructure:"presidio" json:"presidio"`
+
+	// Policies are argument-aware approval rules evaluated before
+	// ApprovalPolicy. The first matching rule decides; tools no rule
+	// matches fall back to ApprovalPolicy.
+	Policies []PolicyRule `mapstructure:"policies" json:"policies,omitempty"`
+
+	// GrantScope is the scope of "always allow" grants (default: "session").
+	GrantScope GrantScope `mapstructure:"grantScope" json:"grantScope"`
+	// GrantTTL expires "always allow" grants; zero keeps them until revoked.
+	GrantTTL time.Duration `mapstructure:"grantTTL" json:"grantTTL"`
+}
+
+// GrantScope selects which requests an "always allow" grant covers.
+type GrantScope string
+
+const (
+	// GrantScopeSession covers the session that granted the tool (default).
+	GrantScopeSession GrantScope = "session"
+	// GrantScopeUser covers the granting user in every chat of the channel.
+	GrantScopeUser GrantScope = "user"
+	// GrantScopeGlobal covers every session.
+	GrantScopeGlobal GrantScope = "global"
+)
+
+// Valid reports whether s is a known grant scope.
+func (s GrantScope) Valid() bool {
+	switch s {
+	case GrantScopeSession, GrantScopeUser, GrantScopeGlobal:
+		return true
+	}
+	return false
+}
+
+// Values returns all known grant scopes.
+func (s GrantScope) Values() []GrantScope {
+	return []GrantScope{GrantScopeSession, GrantScopeUser, GrantScopeGlobal}
+}
+
+// PolicyAction is the outcome of a tool approval policy rule.
+type PolicyAction string
+
+const (
+	// PolicyActionAllow runs the tool without asking.
+	PolicyActionAllow PolicyAction = "allow"
+	// PolicyActionAsk asks for approval, ignoring "always allow" grants.
+	PolicyActionAsk PolicyAction = "ask"
+	// PolicyActionDeny refuses to run the tool.
+	PolicyActionDeny PolicyAction = "deny"
+)
+
+// Valid reports whether a is a known policy action.
+func (a PolicyAction) Valid() bool {
+	switch a {
+	case PolicyActionAllow, PolicyActionAsk, PolicyActionDeny:
+		return true
+	}
+	return false
+}
+
+// Values returns all known policy actions.
+func (a PolicyAction) Values() []PolicyAction {
+	return []PolicyAction{PolicyActionAllow, PolicyActionAsk, PolicyActionDeny}
+}
+
+// PolicyRule is a declarative tool approval rule. A rule matches when all of
+// its conditions match; empty conditions match anything.
+type PolicyRule struct {
+	Name     string           `mapstructure:"name" json:"name"`
+	Tools    []string         `mapstructure:"tools" json:"tools"`                 // tool name globs, e.g. "fs_*"
+	Params   []PolicyParam    `mapstructure:"params" json:"params,omitempty"`     // all must match
+	Sessions []string         `mapstructure:"sessions" json:"sessions,omitempty"` // session key globs
+	Channels []string         `mapstructure:"channels" json:"channels,omitempty"` // e.g. "telegram", "gateway"
+	Time     PolicyTimeWindow `mapstructure:"time" json:"time"`
+	Action   PolicyAction     `mapstructure:"action" json:"action"`
+}
+
+// PolicyParam matches a tool parameter selected by a JSON path such as
+// "command" or "$.edits[*].path". When the path selects several values, allow
+// rules need all of them to match and ask/deny rules any of them. With
+// neither Glob nor Regex set, the parameter only has to be present.
+type PolicyParam struct {
+	Path  string `mapstructure:"path" json:"path"`
+	Glob  string `mapstructure:"glob" json:"glob,omitempty"`
+	Regex string `mapstructure:"regex" json:"regex,omitempty"`
+}
+
+// PolicyTimeWindow restricts a rule to days of the week and hours of the day.
+type PolicyTimeWindow struct {
+	Days     []string `mapstructure:"days" json:"days,omitempty"`         // "mon".."sun"
+	Hours    string   `mapstructure:"hours" json:"hours,omitempty"`       // "09:00-18:00"; may wrap midnight
+	Timezone string   `mapstructure:"timezone" json:"timezone,omitempty"` // IANA name; default local time
 }
 
 // PresidioConfig defines Microsoft Presidio integration settings.
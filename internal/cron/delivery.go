@@ -65,6 +65,32 @@ func (d *Delivery) Deliver(ctx context.Context, result *JobResult, targets []str
 	return nil
 }
 
+// Notify sends a system notification, such as an expiring secret warning,
+// to the specified target channels.
+func (d *Delivery) Notify(ctx context.Context, subject, body string, targets []string) error {
+	if d.sender == nil {
+		d.logger.Warnw("no channel sender configured, skipping notification",
+			"subject", subject,
+			"targets", targets,
+		)
+		return nil
+	}
+
+	msg := fmt.Sprintf("[%s]\n%s", subject, body)
+
+	var errs []string
+	for _, target := range targets {
+		if err := d.sender.SendMessage(ctx, target, msg); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", target, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("deliver to channels: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 // DeliverStart sends a notification that a cron job has started execution.
 func (d *Delivery) DeliverStart(ctx context.Context, jobName string, targets []string) {
 	if d.sender == nil {
@@ -0,0 +1,122 @@
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Schema describes the tables and columns of a database. Virtual tables
+// and their shadow tables (e.g. sqlite-vec) are not included: they are
+// created on demand by the features that use them.
+type Schema struct {
+	Tables map[string][]string `json:"tables"`
+}
+
+// ReadSchema reads the schema of an open database.
+func ReadSchema(ctx context.Context, db *sql.DB) (*Schema, error) {
+	rows, err := db.QueryContext(ctx,
+		"SELECT name, COALESCE(sql, '') FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return nil, fmt.Errorf("list tables: %w", err)
+	}
+	var names, virtual []string
+	for rows.Next() {
+		var name, ddl string
+		if err := rows.Scan(&name, &ddl); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan table: %w", err)
+		}
+		if strings.HasPrefix(strings.ToUpper(ddl), "CREATE VIRTUAL TABLE") {
+			virtual = append(virtual, name)
+			continue
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list tables: %w", err)
+	}
+
+	schema := &Schema{Tables: make(map[string][]string, len(names))}
+	for _, name := range names {
+		if isShadowTable(name, virtual) {
+			continue
+		}
+		columns, err := tableColumns(ctx, db, name)
+		if err != nil {
+			return nil, err
+		}
+		schema.Tables[name] = columns
+	}
+	return schema, nil
+}
+
+// CheckCompatible reports whether a database with the backup schema can be
+// used by a binary whose database has the current schema. Tables and
+// columns missing from the backup are fine: they are added by the schema
+// migration at startup. Tables or columns unknown to the current schema
+// mean the backup was made by a newer version.
+func CheckCompatible(backup, current *Schema) error {
+	var problems []string
+	for table, columns := range backup.Tables {
+		have, ok := current.Tables[table]
+		if !ok {
+			problems = append(problems, "table "+table)
+			continue
+		}
+		known := make(map[string]bool, len(have))
+		for _, c := range have {
+			known[c] = true
+		}
+		for _, c := range columns {
+			if !known[c] {
+				problems = append(problems, "column "+table+"."+c)
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("backup schema is newer than this version (unknown %s): upgrade before restoring",
+		strings.Join(problems, ", "))
+}
+
+func tableColumns(ctx context.Context, db *sql.DB, table string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%q)", table))
+	if err != nil {
+		return nil, fmt.Errorf("table info %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var (
+			cid     int
+			name    string
+			typ     string
+			notNull int
+			dflt    sql.NullString
+			pk      int
+		)
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk); err != nil {
+			return nil, fmt.Errorf("scan column of %s: %w", table, err)
+		}
+		columns = append(columns, name)
+	}
+	sort.Strings(columns)
+	return columns, rows.Err()
+}
+
+// isShadowTable reports whether name is a shadow table of a virtual table.
+func isShadowTable(name string, virtual []string) bool {
+	for _, v := range virtual {
+		if strings.HasPrefix(name, v+"_") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package dbmigrate
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	sqlite_vec "github.com/asg017/sqlite-vec-go-bindings/cgo"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSchema(t *testing.T) {
+	sqlite_vec.Auto()
+	db, err := sql.Open("sqlite3", "file:"+filepath.Join(t.TempDir(), "schema.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.Exec("CREATE TABLE sessions (id INTEGER PRIMARY KEY, key TEXT, created_at DATETIME)")
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE VIRTUAL TABLE vec_notes USING vec0(embedding float[4])")
+	require.NoError(t, err)
+
+	schema, err := ReadSchema(context.Background(), db)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"sessions": {"created_at", "id", "key"}}, schema.Tables)
+}
+
+func TestCheckCompatible(t *testing.T) {
+	current := &Schema{Tables: map[string][]string{
+		"sessions": {"id", "key", "model"},
+		"messages": {"content", "id"},
+	}}
+
+	tests := []struct {
+		give    string
+		backup  *Schema
+		wantErr string
+	}{
+		{give: "same", backup: current},
+		{give: "older backup", backup: &Schema{Tables: map[string][]string{"sessions": {"id", "key"}}}},
+		{
+			give:    "unknown column",
+			backup:  &Schema{Tables: map[string][]string{"sessions": {"id", "key", "pinned"}}},
+			wantErr: "column sessions.pinned",
+		},
+		{
+			give:    "unknown table",
+			backup:  &Schema{Tables: map[string][]string{"snapshots": {"id"}}},
+			wantErr: "table snapshots",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			err := CheckCompatible(tt.backup, current)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
+)
+
+// ApprovalGrant is the model entity for the ApprovalGrant schema.
+type ApprovalGrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ToolName holds the value of the "tool_name" field.
+	ToolName string `json:"tool_name,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope approvalgrant.Scope `json:"scope,omitempty"`
+	// Session key for session grants, channel:user for user grants, empty for global grants
+	Subject string `json:"subject,omitempty"`
+	// Channel the grant was created from (telegram, discord, gateway, ...)
+	Channel string `json:"channel,omitempty"`
+	// Session the grant was created from
+	SessionKey string `json:"session_key,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Nil for grants that never expire
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ApprovalGrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case approvalgrant.FieldToolName, approvalgrant.FieldScope, approvalgrant.FieldSubject, approvalgrant.FieldChannel, approvalgrant.FieldSessionKey:
+			values[i] = new(sql.NullString)
+		case approvalgrant.FieldCreatedAt, approvalgrant.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case approvalgrant.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ApprovalGrant fields.
+func (_m *ApprovalGrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case approvalgrant.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case approvalgrant.FieldToolName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tool_name", values[i])
+			} else if value.Valid {
+				_m.ToolName = value.String
+			}
+		case approvalgrant.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				_m.Scope = approvalgrant.Scope(value.String)
+			}
+		case approvalgrant.FieldSubject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field subject", values[i])
+			} else if value.Valid {
+				_m.Subject = value.String
+			}
+		case approvalgrant.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = value.String
+			}
+		case approvalgrant.FieldSessionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_key", values[i])
+			} else if value.Valid {
+				_m.SessionKey = value.String
+			}
+		case approvalgrant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case approvalgrant.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ApprovalGrant.
+// This includes values selected through modifiers, order, etc.
+func (_m *ApprovalGrant) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ApprovalGrant.
+// Note that you need to call ApprovalGrant.Unwrap() before calling this method if this ApprovalGrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ApprovalGrant) Update() *ApprovalGrantUpdateOne {
+	return NewApprovalGrantClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ApprovalGrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ApprovalGrant) Unwrap() *ApprovalGrant {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ApprovalGrant is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ApprovalGrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ApprovalGrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("tool_name=")
+	builder.WriteString(_m.ToolName)
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scope))
+	builder.WriteString(", ")
+	builder.WriteString("subject=")
+	builder.WriteString(_m.Subject)
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(_m.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("session_key=")
+	builder.WriteString(_m.SessionKey)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ApprovalGrants is a parsable slice of ApprovalGrant.
+type ApprovalGrants []*ApprovalGrant
@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package approvalgrant
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the approvalgrant type in the database.
+	Label = "approval_grant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldToolName holds the string denoting the tool_name field in the database.
+	FieldToolName = "tool_name"
+	// FieldScope holds the string denoting the scope field in the database.
+	FieldScope = "scope"
+	// FieldSubject holds the string denoting the subject field in the database.
+	FieldSubject = "subject"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
+	// FieldSessionKey holds the string denoting the session_key field in the database.
+	FieldSessionKey = "session_key"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// Table holds the table name of the approvalgrant in the database.
+	Table = "approval_grants"
+)
+
+// Columns holds all SQL columns for approvalgrant fields.
+var Columns = []string{
+	FieldID,
+	FieldToolName,
+	FieldScope,
+	FieldSubject,
+	FieldChannel,
+	FieldSessionKey,
+	FieldCreatedAt,
+	FieldExpiresAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ToolNameValidator is a validator for the "tool_name" field. It is called by the builders before save.
+	ToolNameValidator func(string) error
+	// ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	ChannelValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Scope defines the type for the "scope" enum field.
+type Scope string
+
+// ScopeSession is the default value of the Scope enum.
+const DefaultScope = ScopeSession
+
+// Scope values.
+const (
+	ScopeSession Scope = "session"
+	ScopeUser    Scope = "user"
+	ScopeGlobal  Scope = "global"
+)
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// ScopeValidator is a validator for the "scope" field enum values. It is called by the builders before save.
+func ScopeValidator(s Scope) error {
+	switch s {
+	case ScopeSession, ScopeUser, ScopeGlobal:
+		return nil
+	default:
+		return fmt.Errorf("approvalgrant: invalid enum value for scope field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ApprovalGrant queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByToolName orders the results by the tool_name field.
+func ByToolName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToolName, opts...).ToFunc()
+}
+
+// ByScope orders the results by the scope field.
+func ByScope(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScope, opts...).ToFunc()
+}
+
+// BySubject orders the results by the subject field.
+func BySubject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubject, opts...).ToFunc()
+}
+
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
+// BySessionKey orders the results by the session_key field.
+func BySessionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionKey, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package approvalgrant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldID, id))
+}
+
+// ToolName applies equality check predicate on the "tool_name" field. It's identical to ToolNameEQ.
+func ToolName(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldToolName, v))
+}
+
+// Subject applies equality check predicate on the "subject" field. It's identical to SubjectEQ.
+func Subject(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldSubject, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldChannel, v))
+}
+
+// SessionKey applies equality check predicate on the "session_key" field. It's identical to SessionKeyEQ.
+func SessionKey(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ToolNameEQ applies the EQ predicate on the "tool_name" field.
+func ToolNameEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldToolName, v))
+}
+
+// ToolNameNEQ applies the NEQ predicate on the "tool_name" field.
+func ToolNameNEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldToolName, v))
+}
+
+// ToolNameIn applies the In predicate on the "tool_name" field.
+func ToolNameIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldToolName, vs...))
+}
+
+// ToolNameNotIn applies the NotIn predicate on the "tool_name" field.
+func ToolNameNotIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldToolName, vs...))
+}
+
+// ToolNameGT applies the GT predicate on the "tool_name" field.
+func ToolNameGT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldToolName, v))
+}
+
+// ToolNameGTE applies the GTE predicate on the "tool_name" field.
+func ToolNameGTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldToolName, v))
+}
+
+// ToolNameLT applies the LT predicate on the "tool_name" field.
+func ToolNameLT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldToolName, v))
+}
+
+// ToolNameLTE applies the LTE predicate on the "tool_name" field.
+func ToolNameLTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldToolName, v))
+}
+
+// ToolNameContains applies the Contains predicate on the "tool_name" field.
+func ToolNameContains(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContains(FieldToolName, v))
+}
+
+// ToolNameHasPrefix applies the HasPrefix predicate on the "tool_name" field.
+func ToolNameHasPrefix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasPrefix(FieldToolName, v))
+}
+
+// ToolNameHasSuffix applies the HasSuffix predicate on the "tool_name" field.
+func ToolNameHasSuffix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasSuffix(FieldToolName, v))
+}
+
+// ToolNameEqualFold applies the EqualFold predicate on the "tool_name" field.
+func ToolNameEqualFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEqualFold(FieldToolName, v))
+}
+
+// ToolNameContainsFold applies the ContainsFold predicate on the "tool_name" field.
+func ToolNameContainsFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContainsFold(FieldToolName, v))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v Scope) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v Scope) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...Scope) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...Scope) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// SubjectEQ applies the EQ predicate on the "subject" field.
+func SubjectEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldSubject, v))
+}
+
+// SubjectNEQ applies the NEQ predicate on the "subject" field.
+func SubjectNEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldSubject, v))
+}
+
+// SubjectIn applies the In predicate on the "subject" field.
+func SubjectIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldSubject, vs...))
+}
+
+// SubjectNotIn applies the NotIn predicate on the "subject" field.
+func SubjectNotIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldSubject, vs...))
+}
+
+// SubjectGT applies the GT predicate on the "subject" field.
+func SubjectGT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldSubject, v))
+}
+
+// SubjectGTE applies the GTE predicate on the "subject" field.
+func SubjectGTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldSubject, v))
+}
+
+// SubjectLT applies the LT predicate on the "subject" field.
+func SubjectLT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldSubject, v))
+}
+
+// SubjectLTE applies the LTE predicate on the "subject" field.
+func SubjectLTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldSubject, v))
+}
+
+// SubjectContains applies the Contains predicate on the "subject" field.
+func SubjectContains(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContains(FieldSubject, v))
+}
+
+// SubjectHasPrefix applies the HasPrefix predicate on the "subject" field.
+func SubjectHasPrefix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasPrefix(FieldSubject, v))
+}
+
+// SubjectHasSuffix applies the HasSuffix predicate on the "subject" field.
+func SubjectHasSuffix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasSuffix(FieldSubject, v))
+}
+
+// SubjectIsNil applies the IsNil predicate on the "subject" field.
+func SubjectIsNil() predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIsNull(FieldSubject))
+}
+
+// SubjectNotNil applies the NotNil predicate on the "subject" field.
+func SubjectNotNil() predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotNull(FieldSubject))
+}
+
+// SubjectEqualFold applies the EqualFold predicate on the "subject" field.
+func SubjectEqualFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEqualFold(FieldSubject, v))
+}
+
+// SubjectContainsFold applies the ContainsFold predicate on the "subject" field.
+func SubjectContainsFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContainsFold(FieldSubject, v))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// SessionKeyEQ applies the EQ predicate on the "session_key" field.
+func SessionKeyEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// SessionKeyNEQ applies the NEQ predicate on the "session_key" field.
+func SessionKeyNEQ(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldSessionKey, v))
+}
+
+// SessionKeyIn applies the In predicate on the "session_key" field.
+func SessionKeyIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyNotIn applies the NotIn predicate on the "session_key" field.
+func SessionKeyNotIn(vs ...string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyGT applies the GT predicate on the "session_key" field.
+func SessionKeyGT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldSessionKey, v))
+}
+
+// SessionKeyGTE applies the GTE predicate on the "session_key" field.
+func SessionKeyGTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldSessionKey, v))
+}
+
+// SessionKeyLT applies the LT predicate on the "session_key" field.
+func SessionKeyLT(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldSessionKey, v))
+}
+
+// SessionKeyLTE applies the LTE predicate on the "session_key" field.
+func SessionKeyLTE(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldSessionKey, v))
+}
+
+// SessionKeyContains applies the Contains predicate on the "session_key" field.
+func SessionKeyContains(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContains(FieldSessionKey, v))
+}
+
+// SessionKeyHasPrefix applies the HasPrefix predicate on the "session_key" field.
+func SessionKeyHasPrefix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasPrefix(FieldSessionKey, v))
+}
+
+// SessionKeyHasSuffix applies the HasSuffix predicate on the "session_key" field.
+func SessionKeyHasSuffix(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldHasSuffix(FieldSessionKey, v))
+}
+
+// SessionKeyIsNil applies the IsNil predicate on the "session_key" field.
+func SessionKeyIsNil() predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIsNull(FieldSessionKey))
+}
+
+// SessionKeyNotNil applies the NotNil predicate on the "session_key" field.
+func SessionKeyNotNil() predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotNull(FieldSessionKey))
+}
+
+// SessionKeyEqualFold applies the EqualFold predicate on the "session_key" field.
+func SessionKeyEqualFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEqualFold(FieldSessionKey, v))
+}
+
+// SessionKeyContainsFold applies the ContainsFold predicate on the "session_key" field.
+func SessionKeyContainsFold(v string) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldContainsFold(FieldSessionKey, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ApprovalGrant) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ApprovalGrant) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ApprovalGrant) predicate.ApprovalGrant {
+	return predicate.ApprovalGrant(sql.NotPredicates(p))
+}
@@ -0,0 +1,346 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
+)
+
+// ApprovalGrantCreate is the builder for creating a ApprovalGrant entity.
+type ApprovalGrantCreate struct {
+	config
+	mutation *ApprovalGrantMutation
+	hooks    []Hook
+}
+
+// SetToolName sets the "tool_name" field.
+func (_c *ApprovalGrantCreate) SetToolName(v string) *ApprovalGrantCreate {
+	_c.mutation.SetToolName(v)
+	return _c
+}
+
+// SetScope sets the "scope" field.
+func (_c *ApprovalGrantCreate) SetScope(v approvalgrant.Scope) *ApprovalGrantCreate {
+	_c.mutation.SetScope(v)
+	return _c
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_c *ApprovalGrantCreate) SetNillableScope(v *approvalgrant.Scope) *ApprovalGrantCreate {
+	if v != nil {
+		_c.SetScope(*v)
+	}
+	return _c
+}
+
+// SetSubject sets the "subject" field.
+func (_c *ApprovalGrantCreate) SetSubject(v string) *ApprovalGrantCreate {
+	_c.mutation.SetSubject(v)
+	return _c
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_c *ApprovalGrantCreate) SetNillableSubject(v *string) *ApprovalGrantCreate {
+	if v != nil {
+		_c.SetSubject(*v)
+	}
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *ApprovalGrantCreate) SetChannel(v string) *ApprovalGrantCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_c *ApprovalGrantCreate) SetSessionKey(v string) *ApprovalGrantCreate {
+	_c.mutation.SetSessionKey(v)
+	return _c
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_c *ApprovalGrantCreate) SetNillableSessionKey(v *string) *ApprovalGrantCreate {
+	if v != nil {
+		_c.SetSessionKey(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ApprovalGrantCreate) SetCreatedAt(v time.Time) *ApprovalGrantCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ApprovalGrantCreate) SetNillableCreatedAt(v *time.Time) *ApprovalGrantCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ApprovalGrantCreate) SetExpiresAt(v time.Time) *ApprovalGrantCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ApprovalGrantCreate) SetNillableExpiresAt(v *time.Time) *ApprovalGrantCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ApprovalGrantCreate) SetID(v uuid.UUID) *ApprovalGrantCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ApprovalGrantCreate) SetNillableID(v *uuid.UUID) *ApprovalGrantCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ApprovalGrantMutation object of the builder.
+func (_c *ApprovalGrantCreate) Mutation() *ApprovalGrantMutation {
+	return _c.mutation
+}
+
+// Save creates the ApprovalGrant in the database.
+func (_c *ApprovalGrantCreate) Save(ctx context.Context) (*ApprovalGrant, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ApprovalGrantCreate) SaveX(ctx context.Context) *ApprovalGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalGrantCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalGrantCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ApprovalGrantCreate) defaults() {
+	if _, ok := _c.mutation.Scope(); !ok {
+		v := approvalgrant.DefaultScope
+		_c.mutation.SetScope(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := approvalgrant.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := approvalgrant.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ApprovalGrantCreate) check() error {
+	if _, ok := _c.mutation.ToolName(); !ok {
+		return &ValidationError{Name: "tool_name", err: errors.New(`ent: missing required field "ApprovalGrant.tool_name"`)}
+	}
+	if v, ok := _c.mutation.ToolName(); ok {
+		if err := approvalgrant.ToolNameValidator(v); err != nil {
+			return &ValidationError{Name: "tool_name", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.tool_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "ApprovalGrant.scope"`)}
+	}
+	if v, ok := _c.mutation.Scope(); ok {
+		if err := approvalgrant.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.scope": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "ApprovalGrant.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := approvalgrant.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ApprovalGrant.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ApprovalGrantCreate) sqlSave(ctx context.Context) (*ApprovalGrant, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ApprovalGrantCreate) createSpec() (*ApprovalGrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ApprovalGrant{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(approvalgrant.Table, sqlgraph.NewFieldSpec(approvalgrant.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ToolName(); ok {
+		_spec.SetField(approvalgrant.FieldToolName, field.TypeString, value)
+		_node.ToolName = value
+	}
+	if value, ok := _c.mutation.Scope(); ok {
+		_spec.SetField(approvalgrant.FieldScope, field.TypeEnum, value)
+		_node.Scope = value
+	}
+	if value, ok := _c.mutation.Subject(); ok {
+		_spec.SetField(approvalgrant.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(approvalgrant.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.SessionKey(); ok {
+		_spec.SetField(approvalgrant.FieldSessionKey, field.TypeString, value)
+		_node.SessionKey = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(approvalgrant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalgrant.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// ApprovalGrantCreateBulk is the builder for creating many ApprovalGrant entities in bulk.
+type ApprovalGrantCreateBulk struct {
+	config
+	err      error
+	builders []*ApprovalGrantCreate
+}
+
+// Save creates the ApprovalGrant entities in the database.
+func (_c *ApprovalGrantCreateBulk) Save(ctx context.Context) ([]*ApprovalGrant, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ApprovalGrant, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ApprovalGrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ApprovalGrantCreateBulk) SaveX(ctx context.Context) []*ApprovalGrant {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ApprovalGrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ApprovalGrantCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// ApprovalGrantDelete is the builder for deleting a ApprovalGrant entity.
+type ApprovalGrantDelete struct {
+	config
+	hooks    []Hook
+	mutation *ApprovalGrantMutation
+}
+
+// Where appends a list predicates to the ApprovalGrantDelete builder.
+func (_d *ApprovalGrantDelete) Where(ps ...predicate.ApprovalGrant) *ApprovalGrantDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ApprovalGrantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ApprovalGrantDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ApprovalGrantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(approvalgrant.Table, sqlgraph.NewFieldSpec(approvalgrant.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ApprovalGrantDeleteOne is the builder for deleting a single ApprovalGrant entity.
+type ApprovalGrantDeleteOne struct {
+	_d *ApprovalGrantDelete
+}
+
+// Where appends a list predicates to the ApprovalGrantDelete builder.
+func (_d *ApprovalGrantDeleteOne) Where(ps ...predicate.ApprovalGrant) *ApprovalGrantDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ApprovalGrantDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{approvalgrant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ApprovalGrantDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// ApprovalGrantUpdate is the builder for updating ApprovalGrant entities.
+type ApprovalGrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ApprovalGrantMutation
+}
+
+// Where appends a list predicates to the ApprovalGrantUpdate builder.
+func (_u *ApprovalGrantUpdate) Where(ps ...predicate.ApprovalGrant) *ApprovalGrantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetToolName sets the "tool_name" field.
+func (_u *ApprovalGrantUpdate) SetToolName(v string) *ApprovalGrantUpdate {
+	_u.mutation.SetToolName(v)
+	return _u
+}
+
+// SetNillableToolName sets the "tool_name" field if the given value is not nil.
+func (_u *ApprovalGrantUpdate) SetNillableToolName(v *string) *ApprovalGrantUpdate {
+	if v != nil {
+		_u.SetToolName(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ApprovalGrantUpdate) SetScope(v approvalgrant.Scope) *ApprovalGrantUpdate {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ApprovalGrantUpdate) SetNillableScope(v *approvalgrant.Scope) *ApprovalGrantUpdate {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ApprovalGrantUpdate) SetSubject(v string) *ApprovalGrantUpdate {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ApprovalGrantUpdate) SetNillableSubject(v *string) *ApprovalGrantUpdate {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *ApprovalGrantUpdate) ClearSubject() *ApprovalGrantUpdate {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ApprovalGrantUpdate) SetChannel(v string) *ApprovalGrantUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ApprovalGrantUpdate) SetNillableChannel(v *string) *ApprovalGrantUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *ApprovalGrantUpdate) SetSessionKey(v string) *ApprovalGrantUpdate {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *ApprovalGrantUpdate) SetNillableSessionKey(v *string) *ApprovalGrantUpdate {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (_u *ApprovalGrantUpdate) ClearSessionKey() *ApprovalGrantUpdate {
+	_u.mutation.ClearSessionKey()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApprovalGrantUpdate) SetExpiresAt(v time.Time) *ApprovalGrantUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApprovalGrantUpdate) SetNillableExpiresAt(v *time.Time) *ApprovalGrantUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ApprovalGrantUpdate) ClearExpiresAt() *ApprovalGrantUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the ApprovalGrantMutation object of the builder.
+func (_u *ApprovalGrantUpdate) Mutation() *ApprovalGrantMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ApprovalGrantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApprovalGrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ApprovalGrantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApprovalGrantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ApprovalGrantUpdate) check() error {
+	if v, ok := _u.mutation.ToolName(); ok {
+		if err := approvalgrant.ToolNameValidator(v); err != nil {
+			return &ValidationError{Name: "tool_name", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.tool_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := approvalgrant.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := approvalgrant.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.channel": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ApprovalGrantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(approvalgrant.Table, approvalgrant.Columns, sqlgraph.NewFieldSpec(approvalgrant.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ToolName(); ok {
+		_spec.SetField(approvalgrant.FieldToolName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(approvalgrant.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(approvalgrant.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(approvalgrant.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(approvalgrant.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(approvalgrant.FieldSessionKey, field.TypeString, value)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(approvalgrant.FieldSessionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalgrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(approvalgrant.FieldExpiresAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{approvalgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ApprovalGrantUpdateOne is the builder for updating a single ApprovalGrant entity.
+type ApprovalGrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ApprovalGrantMutation
+}
+
+// SetToolName sets the "tool_name" field.
+func (_u *ApprovalGrantUpdateOne) SetToolName(v string) *ApprovalGrantUpdateOne {
+	_u.mutation.SetToolName(v)
+	return _u
+}
+
+// SetNillableToolName sets the "tool_name" field if the given value is not nil.
+func (_u *ApprovalGrantUpdateOne) SetNillableToolName(v *string) *ApprovalGrantUpdateOne {
+	if v != nil {
+		_u.SetToolName(*v)
+	}
+	return _u
+}
+
+// SetScope sets the "scope" field.
+func (_u *ApprovalGrantUpdateOne) SetScope(v approvalgrant.Scope) *ApprovalGrantUpdateOne {
+	_u.mutation.SetScope(v)
+	return _u
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (_u *ApprovalGrantUpdateOne) SetNillableScope(v *approvalgrant.Scope) *ApprovalGrantUpdateOne {
+	if v != nil {
+		_u.SetScope(*v)
+	}
+	return _u
+}
+
+// SetSubject sets the "subject" field.
+func (_u *ApprovalGrantUpdateOne) SetSubject(v string) *ApprovalGrantUpdateOne {
+	_u.mutation.SetSubject(v)
+	return _u
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (_u *ApprovalGrantUpdateOne) SetNillableSubject(v *string) *ApprovalGrantUpdateOne {
+	if v != nil {
+		_u.SetSubject(*v)
+	}
+	return _u
+}
+
+// ClearSubject clears the value of the "subject" field.
+func (_u *ApprovalGrantUpdateOne) ClearSubject() *ApprovalGrantUpdateOne {
+	_u.mutation.ClearSubject()
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *ApprovalGrantUpdateOne) SetChannel(v string) *ApprovalGrantUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *ApprovalGrantUpdateOne) SetNillableChannel(v *string) *ApprovalGrantUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *ApprovalGrantUpdateOne) SetSessionKey(v string) *ApprovalGrantUpdateOne {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *ApprovalGrantUpdateOne) SetNillableSessionKey(v *string) *ApprovalGrantUpdateOne {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (_u *ApprovalGrantUpdateOne) ClearSessionKey() *ApprovalGrantUpdateOne {
+	_u.mutation.ClearSessionKey()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *ApprovalGrantUpdateOne) SetExpiresAt(v time.Time) *ApprovalGrantUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *ApprovalGrantUpdateOne) SetNillableExpiresAt(v *time.Time) *ApprovalGrantUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *ApprovalGrantUpdateOne) ClearExpiresAt() *ApprovalGrantUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// Mutation returns the ApprovalGrantMutation object of the builder.
+func (_u *ApprovalGrantUpdateOne) Mutation() *ApprovalGrantMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ApprovalGrantUpdate builder.
+func (_u *ApprovalGrantUpdateOne) Where(ps ...predicate.ApprovalGrant) *ApprovalGrantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ApprovalGrantUpdateOne) Select(field string, fields ...string) *ApprovalGrantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ApprovalGrant entity.
+func (_u *ApprovalGrantUpdateOne) Save(ctx context.Context) (*ApprovalGrant, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ApprovalGrantUpdateOne) SaveX(ctx context.Context) *ApprovalGrant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ApprovalGrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ApprovalGrantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ApprovalGrantUpdateOne) check() error {
+	if v, ok := _u.mutation.ToolName(); ok {
+		if err := approvalgrant.ToolNameValidator(v); err != nil {
+			return &ValidationError{Name: "tool_name", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.tool_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Scope(); ok {
+		if err := approvalgrant.ScopeValidator(v); err != nil {
+			return &ValidationError{Name: "scope", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.scope": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := approvalgrant.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "ApprovalGrant.channel": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ApprovalGrantUpdateOne) sqlSave(ctx context.Context) (_node *ApprovalGrant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(approvalgrant.Table, approvalgrant.Columns, sqlgraph.NewFieldSpec(approvalgrant.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApprovalGrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, approvalgrant.FieldID)
+		for _, f := range fields {
+			if !approvalgrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != approvalgrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ToolName(); ok {
+		_spec.SetField(approvalgrant.FieldToolName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scope(); ok {
+		_spec.SetField(approvalgrant.FieldScope, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Subject(); ok {
+		_spec.SetField(approvalgrant.FieldSubject, field.TypeString, value)
+	}
+	if _u.mutation.SubjectCleared() {
+		_spec.ClearField(approvalgrant.FieldSubject, field.TypeString)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(approvalgrant.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(approvalgrant.FieldSessionKey, field.TypeString, value)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(approvalgrant.FieldSessionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(approvalgrant.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(approvalgrant.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &ApprovalGrant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{approvalgrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
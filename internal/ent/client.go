@@ -16,14 +16,17 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
 	"github.com/langoai/lango/internal/ent/auditlog"
 	"github.com/langoai/lango/internal/ent/configprofile"
 	"github.com/langoai/lango/internal/ent/cronjob"
 	"github.com/langoai/lango/internal/ent/cronjobhistory"
 	"github.com/langoai/lango/internal/ent/externalref"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
 	"github.com/langoai/lango/internal/ent/inquiry"
 	"github.com/langoai/lango/internal/ent/key"
 	"github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
 	"github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/ent/message"
 	"github.com/langoai/lango/internal/ent/observation"
@@ -31,7 +34,9 @@ import (
 	"github.com/langoai/lango/internal/ent/peerreputation"
 	"github.com/langoai/lango/internal/ent/reflection"
 	"github.com/langoai/lango/internal/ent/secret"
+	"github.com/langoai/lango/internal/ent/secretversion"
 	"github.com/langoai/lango/internal/ent/session"
+	"github.com/langoai/lango/internal/ent/usagerecord"
 	"github.com/langoai/lango/internal/ent/workflowrun"
 	"github.com/langoai/lango/internal/ent/workflowsteprun"
 )
@@ -41,6 +46,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApprovalGrant is the client for interacting with the ApprovalGrant builders.
+	ApprovalGrant *ApprovalGrantClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
 	// ConfigProfile is the client for interacting with the ConfigProfile builders.
@@ -51,12 +58,16 @@ type Client struct {
 	CronJobHistory *CronJobHistoryClient
 	// ExternalRef is the client for interacting with the ExternalRef builders.
 	ExternalRef *ExternalRefClient
+	// FileSnapshot is the client for interacting with the FileSnapshot builders.
+	FileSnapshot *FileSnapshotClient
 	// Inquiry is the client for interacting with the Inquiry builders.
 	Inquiry *InquiryClient
 	// Key is the client for interacting with the Key builders.
 	Key *KeyClient
 	// Knowledge is the client for interacting with the Knowledge builders.
 	Knowledge *KnowledgeClient
+	// KnowledgeRevision is the client for interacting with the KnowledgeRevision builders.
+	KnowledgeRevision *KnowledgeRevisionClient
 	// Learning is the client for interacting with the Learning builders.
 	Learning *LearningClient
 	// Message is the client for interacting with the Message builders.
@@ -71,8 +82,12 @@ type Client struct {
 	Reflection *ReflectionClient
 	// Secret is the client for interacting with the Secret builders.
 	Secret *SecretClient
+	// SecretVersion is the client for interacting with the SecretVersion builders.
+	SecretVersion *SecretVersionClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
 	// WorkflowRun is the client for interacting with the WorkflowRun builders.
 	WorkflowRun *WorkflowRunClient
 	// WorkflowStepRun is the client for interacting with the WorkflowStepRun builders.
@@ -88,14 +103,17 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApprovalGrant = NewApprovalGrantClient(c.config)
 	c.AuditLog = NewAuditLogClient(c.config)
 	c.ConfigProfile = NewConfigProfileClient(c.config)
 	c.CronJob = NewCronJobClient(c.config)
 	c.CronJobHistory = NewCronJobHistoryClient(c.config)
 	c.ExternalRef = NewExternalRefClient(c.config)
+	c.FileSnapshot = NewFileSnapshotClient(c.config)
 	c.Inquiry = NewInquiryClient(c.config)
 	c.Key = NewKeyClient(c.config)
 	c.Knowledge = NewKnowledgeClient(c.config)
+	c.KnowledgeRevision = NewKnowledgeRevisionClient(c.config)
 	c.Learning = NewLearningClient(c.config)
 	c.Message = NewMessageClient(c.config)
 	c.Observation = NewObservationClient(c.config)
@@ -103,7 +121,9 @@ func (c *Client) init() {
 	c.PeerReputation = NewPeerReputationClient(c.config)
 	c.Reflection = NewReflectionClient(c.config)
 	c.Secret = NewSecretClient(c.config)
+	c.SecretVersion = NewSecretVersionClient(c.config)
 	c.Session = NewSessionClient(c.config)
+	c.UsageRecord = NewUsageRecordClient(c.config)
 	c.WorkflowRun = NewWorkflowRunClient(c.config)
 	c.WorkflowStepRun = NewWorkflowStepRunClient(c.config)
 }
@@ -196,26 +216,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AuditLog:        NewAuditLogClient(cfg),
-		ConfigProfile:   NewConfigProfileClient(cfg),
-		CronJob:         NewCronJobClient(cfg),
-		CronJobHistory:  NewCronJobHistoryClient(cfg),
-		ExternalRef:     NewExternalRefClient(cfg),
-		Inquiry:         NewInquiryClient(cfg),
-		Key:             NewKeyClient(cfg),
-		Knowledge:       NewKnowledgeClient(cfg),
-		Learning:        NewLearningClient(cfg),
-		Message:         NewMessageClient(cfg),
-		Observation:     NewObservationClient(cfg),
-		PaymentTx:       NewPaymentTxClient(cfg),
-		PeerReputation:  NewPeerReputationClient(cfg),
-		Reflection:      NewReflectionClient(cfg),
-		Secret:          NewSecretClient(cfg),
-		Session:         NewSessionClient(cfg),
-		WorkflowRun:     NewWorkflowRunClient(cfg),
-		WorkflowStepRun: NewWorkflowStepRunClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ApprovalGrant:     NewApprovalGrantClient(cfg),
+		AuditLog:          NewAuditLogClient(cfg),
+		ConfigProfile:     NewConfigProfileClient(cfg),
+		CronJob:           NewCronJobClient(cfg),
+		CronJobHistory:    NewCronJobHistoryClient(cfg),
+		ExternalRef:       NewExternalRefClient(cfg),
+		FileSnapshot:      NewFileSnapshotClient(cfg),
+		Inquiry:           NewInquiryClient(cfg),
+		Key:               NewKeyClient(cfg),
+		Knowledge:         NewKnowledgeClient(cfg),
+		KnowledgeRevision: NewKnowledgeRevisionClient(cfg),
+		Learning:          NewLearningClient(cfg),
+		Message:           NewMessageClient(cfg),
+		Observation:       NewObservationClient(cfg),
+		PaymentTx:         NewPaymentTxClient(cfg),
+		PeerReputation:    NewPeerReputationClient(cfg),
+		Reflection:        NewReflectionClient(cfg),
+		Secret:            NewSecretClient(cfg),
+		SecretVersion:     NewSecretVersionClient(cfg),
+		Session:           NewSessionClient(cfg),
+		UsageRecord:       NewUsageRecordClient(cfg),
+		WorkflowRun:       NewWorkflowRunClient(cfg),
+		WorkflowStepRun:   NewWorkflowStepRunClient(cfg),
 	}, nil
 }
 
@@ -233,33 +258,38 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AuditLog:        NewAuditLogClient(cfg),
-		ConfigProfile:   NewConfigProfileClient(cfg),
-		CronJob:         NewCronJobClient(cfg),
-		CronJobHistory:  NewCronJobHistoryClient(cfg),
-		ExternalRef:     NewExternalRefClient(cfg),
-		Inquiry:         NewInquiryClient(cfg),
-		Key:             NewKeyClient(cfg),
-		Knowledge:       NewKnowledgeClient(cfg),
-		Learning:        NewLearningClient(cfg),
-		Message:         NewMessageClient(cfg),
-		Observation:     NewObservationClient(cfg),
-		PaymentTx:       NewPaymentTxClient(cfg),
-		PeerReputation:  NewPeerReputationClient(cfg),
-		Reflection:      NewReflectionClient(cfg),
-		Secret:          NewSecretClient(cfg),
-		Session:         NewSessionClient(cfg),
-		WorkflowRun:     NewWorkflowRunClient(cfg),
-		WorkflowStepRun: NewWorkflowStepRunClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ApprovalGrant:     NewApprovalGrantClient(cfg),
+		AuditLog:          NewAuditLogClient(cfg),
+		ConfigProfile:     NewConfigProfileClient(cfg),
+		CronJob:           NewCronJobClient(cfg),
+		CronJobHistory:    NewCronJobHistoryClient(cfg),
+		ExternalRef:       NewExternalRefClient(cfg),
+		FileSnapshot:      NewFileSnapshotClient(cfg),
+		Inquiry:           NewInquiryClient(cfg),
+		Key:               NewKeyClient(cfg),
+		Knowledge:         NewKnowledgeClient(cfg),
+		KnowledgeRevision: NewKnowledgeRevisionClient(cfg),
+		Learning:          NewLearningClient(cfg),
+		Message:           NewMessageClient(cfg),
+		Observation:       NewObservationClient(cfg),
+		PaymentTx:         NewPaymentTxClient(cfg),
+		PeerReputation:    NewPeerReputationClient(cfg),
+		Reflection:        NewReflectionClient(cfg),
+		Secret:            NewSecretClient(cfg),
+		SecretVersion:     NewSecretVersionClient(cfg),
+		Session:           NewSessionClient(cfg),
+		UsageRecord:       NewUsageRecordClient(cfg),
+		WorkflowRun:       NewWorkflowRunClient(cfg),
+		WorkflowStepRun:   NewWorkflowStepRunClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		AuditLog.
+//		ApprovalGrant.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -282,10 +312,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuditLog, c.ConfigProfile, c.CronJob, c.CronJobHistory, c.ExternalRef,
-		c.Inquiry, c.Key, c.Knowledge, c.Learning, c.Message, c.Observation,
-		c.PaymentTx, c.PeerReputation, c.Reflection, c.Secret, c.Session,
-		c.WorkflowRun, c.WorkflowStepRun,
+		c.ApprovalGrant, c.AuditLog, c.ConfigProfile, c.CronJob, c.CronJobHistory,
+		c.ExternalRef, c.FileSnapshot, c.Inquiry, c.Key, c.Knowledge,
+		c.KnowledgeRevision, c.Learning, c.Message, c.Observation, c.PaymentTx,
+		c.PeerReputation, c.Reflection, c.Secret, c.SecretVersion, c.Session,
+		c.UsageRecord, c.WorkflowRun, c.WorkflowStepRun,
 	} {
 		n.Use(hooks...)
 	}
@@ -295,10 +326,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuditLog, c.ConfigProfile, c.CronJob, c.CronJobHistory, c.ExternalRef,
-		c.Inquiry, c.Key, c.Knowledge, c.Learning, c.Message, c.Observation,
-		c.PaymentTx, c.PeerReputation, c.Reflection, c.Secret, c.Session,
-		c.WorkflowRun, c.WorkflowStepRun,
+		c.ApprovalGrant, c.AuditLog, c.ConfigProfile, c.CronJob, c.CronJobHistory,
+		c.ExternalRef, c.FileSnapshot, c.Inquiry, c.Key, c.Knowledge,
+		c.KnowledgeRevision, c.Learning, c.Message, c.Observation, c.PaymentTx,
+		c.PeerReputation, c.Reflection, c.Secret, c.SecretVersion, c.Session,
+		c.UsageRecord, c.WorkflowRun, c.WorkflowStepRun,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -307,6 +339,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApprovalGrantMutation:
+		return c.ApprovalGrant.mutate(ctx, m)
 	case *AuditLogMutation:
 		return c.AuditLog.mutate(ctx, m)
 	case *ConfigProfileMutation:
@@ -317,12 +351,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.CronJobHistory.mutate(ctx, m)
 	case *ExternalRefMutation:
 		return c.ExternalRef.mutate(ctx, m)
+	case *FileSnapshotMutation:
+		return c.FileSnapshot.mutate(ctx, m)
 	case *InquiryMutation:
 		return c.Inquiry.mutate(ctx, m)
 	case *KeyMutation:
 		return c.Key.mutate(ctx, m)
 	case *KnowledgeMutation:
 		return c.Knowledge.mutate(ctx, m)
+	case *KnowledgeRevisionMutation:
+		return c.KnowledgeRevision.mutate(ctx, m)
 	case *LearningMutation:
 		return c.Learning.mutate(ctx, m)
 	case *MessageMutation:
@@ -337,8 +375,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Reflection.mutate(ctx, m)
 	case *SecretMutation:
 		return c.Secret.mutate(ctx, m)
+	case *SecretVersionMutation:
+		return c.SecretVersion.mutate(ctx, m)
 	case *SessionMutation:
 		return c.Session.mutate(ctx, m)
+	case *UsageRecordMutation:
+		return c.UsageRecord.mutate(ctx, m)
 	case *WorkflowRunMutation:
 		return c.WorkflowRun.mutate(ctx, m)
 	case *WorkflowStepRunMutation:
@@ -348,6 +390,139 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ApprovalGrantClient is a client for the ApprovalGrant schema.
+type ApprovalGrantClient struct {
+	config
+}
+
+// NewApprovalGrantClient returns a client for the ApprovalGrant from the given config.
+func NewApprovalGrantClient(c config) *ApprovalGrantClient {
+	return &ApprovalGrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `approvalgrant.Hooks(f(g(h())))`.
+func (c *ApprovalGrantClient) Use(hooks ...Hook) {
+	c.hooks.ApprovalGrant = append(c.hooks.ApprovalGrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `approvalgrant.Intercept(f(g(h())))`.
+func (c *ApprovalGrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApprovalGrant = append(c.inters.ApprovalGrant, interceptors...)
+}
+
+// Create returns a builder for creating a ApprovalGrant entity.
+func (c *ApprovalGrantClient) Create() *ApprovalGrantCreate {
+	mutation := newApprovalGrantMutation(c.config, OpCreate)
+	return &ApprovalGrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApprovalGrant entities.
+func (c *ApprovalGrantClient) CreateBulk(builders ...*ApprovalGrantCreate) *ApprovalGrantCreateBulk {
+	return &ApprovalGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApprovalGrantClient) MapCreateBulk(slice any, setFunc func(*ApprovalGrantCreate, int)) *ApprovalGrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApprovalGrantCreateBulk{err: fmt.Errorf("calling to ApprovalGrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApprovalGrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApprovalGrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApprovalGrant.
+func (c *ApprovalGrantClient) Update() *ApprovalGrantUpdate {
+	mutation := newApprovalGrantMutation(c.config, OpUpdate)
+	return &ApprovalGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApprovalGrantClient) UpdateOne(_m *ApprovalGrant) *ApprovalGrantUpdateOne {
+	mutation := newApprovalGrantMutation(c.config, OpUpdateOne, withApprovalGrant(_m))
+	return &ApprovalGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApprovalGrantClient) UpdateOneID(id uuid.UUID) *ApprovalGrantUpdateOne {
+	mutation := newApprovalGrantMutation(c.config, OpUpdateOne, withApprovalGrantID(id))
+	return &ApprovalGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApprovalGrant.
+func (c *ApprovalGrantClient) Delete() *ApprovalGrantDelete {
+	mutation := newApprovalGrantMutation(c.config, OpDelete)
+	return &ApprovalGrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApprovalGrantClient) DeleteOne(_m *ApprovalGrant) *ApprovalGrantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApprovalGrantClient) DeleteOneID(id uuid.UUID) *ApprovalGrantDeleteOne {
+	builder := c.Delete().Where(approvalgrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApprovalGrantDeleteOne{builder}
+}
+
+// Query returns a query builder for ApprovalGrant.
+func (c *ApprovalGrantClient) Query() *ApprovalGrantQuery {
+	return &ApprovalGrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApprovalGrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApprovalGrant entity by its id.
+func (c *ApprovalGrantClient) Get(ctx context.Context, id uuid.UUID) (*ApprovalGrant, error) {
+	return c.Query().Where(approvalgrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApprovalGrantClient) GetX(ctx context.Context, id uuid.UUID) *ApprovalGrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApprovalGrantClient) Hooks() []Hook {
+	return c.hooks.ApprovalGrant
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApprovalGrantClient) Interceptors() []Interceptor {
+	return c.inters.ApprovalGrant
+}
+
+func (c *ApprovalGrantClient) mutate(ctx context.Context, m *ApprovalGrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApprovalGrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApprovalGrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApprovalGrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApprovalGrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApprovalGrant mutation op: %q", m.Op())
+	}
+}
+
 // AuditLogClient is a client for the AuditLog schema.
 type AuditLogClient struct {
 	config
@@ -1013,6 +1188,139 @@ func (c *ExternalRefClient) mutate(ctx context.Context, m *ExternalRefMutation)
 	}
 }
 
+// FileSnapshotClient is a client for the FileSnapshot schema.
+type FileSnapshotClient struct {
+	config
+}
+
+// NewFileSnapshotClient returns a client for the FileSnapshot from the given config.
+func NewFileSnapshotClient(c config) *FileSnapshotClient {
+	return &FileSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filesnapshot.Hooks(f(g(h())))`.
+func (c *FileSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.FileSnapshot = append(c.hooks.FileSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `filesnapshot.Intercept(f(g(h())))`.
+func (c *FileSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FileSnapshot = append(c.inters.FileSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a FileSnapshot entity.
+func (c *FileSnapshotClient) Create() *FileSnapshotCreate {
+	mutation := newFileSnapshotMutation(c.config, OpCreate)
+	return &FileSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FileSnapshot entities.
+func (c *FileSnapshotClient) CreateBulk(builders ...*FileSnapshotCreate) *FileSnapshotCreateBulk {
+	return &FileSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FileSnapshotClient) MapCreateBulk(slice any, setFunc func(*FileSnapshotCreate, int)) *FileSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FileSnapshotCreateBulk{err: fmt.Errorf("calling to FileSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FileSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FileSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FileSnapshot.
+func (c *FileSnapshotClient) Update() *FileSnapshotUpdate {
+	mutation := newFileSnapshotMutation(c.config, OpUpdate)
+	return &FileSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileSnapshotClient) UpdateOne(_m *FileSnapshot) *FileSnapshotUpdateOne {
+	mutation := newFileSnapshotMutation(c.config, OpUpdateOne, withFileSnapshot(_m))
+	return &FileSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileSnapshotClient) UpdateOneID(id uuid.UUID) *FileSnapshotUpdateOne {
+	mutation := newFileSnapshotMutation(c.config, OpUpdateOne, withFileSnapshotID(id))
+	return &FileSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileSnapshot.
+func (c *FileSnapshotClient) Delete() *FileSnapshotDelete {
+	mutation := newFileSnapshotMutation(c.config, OpDelete)
+	return &FileSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FileSnapshotClient) DeleteOne(_m *FileSnapshot) *FileSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FileSnapshotClient) DeleteOneID(id uuid.UUID) *FileSnapshotDeleteOne {
+	builder := c.Delete().Where(filesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for FileSnapshot.
+func (c *FileSnapshotClient) Query() *FileSnapshotQuery {
+	return &FileSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFileSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FileSnapshot entity by its id.
+func (c *FileSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*FileSnapshot, error) {
+	return c.Query().Where(filesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *FileSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *FileSnapshotClient) Hooks() []Hook {
+	return c.hooks.FileSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *FileSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.FileSnapshot
+}
+
+func (c *FileSnapshotClient) mutate(ctx context.Context, m *FileSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FileSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FileSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FileSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FileSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FileSnapshot mutation op: %q", m.Op())
+	}
+}
+
 // InquiryClient is a client for the Inquiry schema.
 type InquiryClient struct {
 	config
@@ -1428,6 +1736,139 @@ func (c *KnowledgeClient) mutate(ctx context.Context, m *KnowledgeMutation) (Val
 	}
 }
 
+// KnowledgeRevisionClient is a client for the KnowledgeRevision schema.
+type KnowledgeRevisionClient struct {
+	config
+}
+
+// NewKnowledgeRevisionClient returns a client for the KnowledgeRevision from the given config.
+func NewKnowledgeRevisionClient(c config) *KnowledgeRevisionClient {
+	return &KnowledgeRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `knowledgerevision.Hooks(f(g(h())))`.
+func (c *KnowledgeRevisionClient) Use(hooks ...Hook) {
+	c.hooks.KnowledgeRevision = append(c.hooks.KnowledgeRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `knowledgerevision.Intercept(f(g(h())))`.
+func (c *KnowledgeRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KnowledgeRevision = append(c.inters.KnowledgeRevision, interceptors...)
+}
+
+// Create returns a builder for creating a KnowledgeRevision entity.
+func (c *KnowledgeRevisionClient) Create() *KnowledgeRevisionCreate {
+	mutation := newKnowledgeRevisionMutation(c.config, OpCreate)
+	return &KnowledgeRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KnowledgeRevision entities.
+func (c *KnowledgeRevisionClient) CreateBulk(builders ...*KnowledgeRevisionCreate) *KnowledgeRevisionCreateBulk {
+	return &KnowledgeRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KnowledgeRevisionClient) MapCreateBulk(slice any, setFunc func(*KnowledgeRevisionCreate, int)) *KnowledgeRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KnowledgeRevisionCreateBulk{err: fmt.Errorf("calling to KnowledgeRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KnowledgeRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KnowledgeRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KnowledgeRevision.
+func (c *KnowledgeRevisionClient) Update() *KnowledgeRevisionUpdate {
+	mutation := newKnowledgeRevisionMutation(c.config, OpUpdate)
+	return &KnowledgeRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KnowledgeRevisionClient) UpdateOne(_m *KnowledgeRevision) *KnowledgeRevisionUpdateOne {
+	mutation := newKnowledgeRevisionMutation(c.config, OpUpdateOne, withKnowledgeRevision(_m))
+	return &KnowledgeRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KnowledgeRevisionClient) UpdateOneID(id uuid.UUID) *KnowledgeRevisionUpdateOne {
+	mutation := newKnowledgeRevisionMutation(c.config, OpUpdateOne, withKnowledgeRevisionID(id))
+	return &KnowledgeRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KnowledgeRevision.
+func (c *KnowledgeRevisionClient) Delete() *KnowledgeRevisionDelete {
+	mutation := newKnowledgeRevisionMutation(c.config, OpDelete)
+	return &KnowledgeRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KnowledgeRevisionClient) DeleteOne(_m *KnowledgeRevision) *KnowledgeRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KnowledgeRevisionClient) DeleteOneID(id uuid.UUID) *KnowledgeRevisionDeleteOne {
+	builder := c.Delete().Where(knowledgerevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KnowledgeRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for KnowledgeRevision.
+func (c *KnowledgeRevisionClient) Query() *KnowledgeRevisionQuery {
+	return &KnowledgeRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKnowledgeRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KnowledgeRevision entity by its id.
+func (c *KnowledgeRevisionClient) Get(ctx context.Context, id uuid.UUID) (*KnowledgeRevision, error) {
+	return c.Query().Where(knowledgerevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KnowledgeRevisionClient) GetX(ctx context.Context, id uuid.UUID) *KnowledgeRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KnowledgeRevisionClient) Hooks() []Hook {
+	return c.hooks.KnowledgeRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *KnowledgeRevisionClient) Interceptors() []Interceptor {
+	return c.inters.KnowledgeRevision
+}
+
+func (c *KnowledgeRevisionClient) mutate(ctx context.Context, m *KnowledgeRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KnowledgeRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KnowledgeRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KnowledgeRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KnowledgeRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KnowledgeRevision mutation op: %q", m.Op())
+	}
+}
+
 // LearningClient is a client for the Learning schema.
 type LearningClient struct {
 	config
@@ -2391,6 +2832,139 @@ func (c *SecretClient) mutate(ctx context.Context, m *SecretMutation) (Value, er
 	}
 }
 
+// SecretVersionClient is a client for the SecretVersion schema.
+type SecretVersionClient struct {
+	config
+}
+
+// NewSecretVersionClient returns a client for the SecretVersion from the given config.
+func NewSecretVersionClient(c config) *SecretVersionClient {
+	return &SecretVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `secretversion.Hooks(f(g(h())))`.
+func (c *SecretVersionClient) Use(hooks ...Hook) {
+	c.hooks.SecretVersion = append(c.hooks.SecretVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `secretversion.Intercept(f(g(h())))`.
+func (c *SecretVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecretVersion = append(c.inters.SecretVersion, interceptors...)
+}
+
+// Create returns a builder for creating a SecretVersion entity.
+func (c *SecretVersionClient) Create() *SecretVersionCreate {
+	mutation := newSecretVersionMutation(c.config, OpCreate)
+	return &SecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecretVersion entities.
+func (c *SecretVersionClient) CreateBulk(builders ...*SecretVersionCreate) *SecretVersionCreateBulk {
+	return &SecretVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecretVersionClient) MapCreateBulk(slice any, setFunc func(*SecretVersionCreate, int)) *SecretVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecretVersionCreateBulk{err: fmt.Errorf("calling to SecretVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecretVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecretVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecretVersion.
+func (c *SecretVersionClient) Update() *SecretVersionUpdate {
+	mutation := newSecretVersionMutation(c.config, OpUpdate)
+	return &SecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecretVersionClient) UpdateOne(_m *SecretVersion) *SecretVersionUpdateOne {
+	mutation := newSecretVersionMutation(c.config, OpUpdateOne, withSecretVersion(_m))
+	return &SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecretVersionClient) UpdateOneID(id uuid.UUID) *SecretVersionUpdateOne {
+	mutation := newSecretVersionMutation(c.config, OpUpdateOne, withSecretVersionID(id))
+	return &SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecretVersion.
+func (c *SecretVersionClient) Delete() *SecretVersionDelete {
+	mutation := newSecretVersionMutation(c.config, OpDelete)
+	return &SecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecretVersionClient) DeleteOne(_m *SecretVersion) *SecretVersionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecretVersionClient) DeleteOneID(id uuid.UUID) *SecretVersionDeleteOne {
+	builder := c.Delete().Where(secretversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecretVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for SecretVersion.
+func (c *SecretVersionClient) Query() *SecretVersionQuery {
+	return &SecretVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecretVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecretVersion entity by its id.
+func (c *SecretVersionClient) Get(ctx context.Context, id uuid.UUID) (*SecretVersion, error) {
+	return c.Query().Where(secretversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecretVersionClient) GetX(ctx context.Context, id uuid.UUID) *SecretVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SecretVersionClient) Hooks() []Hook {
+	return c.hooks.SecretVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecretVersionClient) Interceptors() []Interceptor {
+	return c.inters.SecretVersion
+}
+
+func (c *SecretVersionClient) mutate(ctx context.Context, m *SecretVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecretVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecretVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecretVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecretVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SecretVersion mutation op: %q", m.Op())
+	}
+}
+
 // SessionClient is a client for the Session schema.
 type SessionClient struct {
 	config
@@ -2540,6 +3114,139 @@ func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value,
 	}
 }
 
+// UsageRecordClient is a client for the UsageRecord schema.
+type UsageRecordClient struct {
+	config
+}
+
+// NewUsageRecordClient returns a client for the UsageRecord from the given config.
+func NewUsageRecordClient(c config) *UsageRecordClient {
+	return &UsageRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usagerecord.Hooks(f(g(h())))`.
+func (c *UsageRecordClient) Use(hooks ...Hook) {
+	c.hooks.UsageRecord = append(c.hooks.UsageRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usagerecord.Intercept(f(g(h())))`.
+func (c *UsageRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UsageRecord = append(c.inters.UsageRecord, interceptors...)
+}
+
+// Create returns a builder for creating a UsageRecord entity.
+func (c *UsageRecordClient) Create() *UsageRecordCreate {
+	mutation := newUsageRecordMutation(c.config, OpCreate)
+	return &UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UsageRecord entities.
+func (c *UsageRecordClient) CreateBulk(builders ...*UsageRecordCreate) *UsageRecordCreateBulk {
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UsageRecordClient) MapCreateBulk(slice any, setFunc func(*UsageRecordCreate, int)) *UsageRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UsageRecordCreateBulk{err: fmt.Errorf("calling to UsageRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UsageRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UsageRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UsageRecord.
+func (c *UsageRecordClient) Update() *UsageRecordUpdate {
+	mutation := newUsageRecordMutation(c.config, OpUpdate)
+	return &UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UsageRecordClient) UpdateOne(_m *UsageRecord) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecord(_m))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UsageRecordClient) UpdateOneID(id uuid.UUID) *UsageRecordUpdateOne {
+	mutation := newUsageRecordMutation(c.config, OpUpdateOne, withUsageRecordID(id))
+	return &UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UsageRecord.
+func (c *UsageRecordClient) Delete() *UsageRecordDelete {
+	mutation := newUsageRecordMutation(c.config, OpDelete)
+	return &UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UsageRecordClient) DeleteOne(_m *UsageRecord) *UsageRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UsageRecordClient) DeleteOneID(id uuid.UUID) *UsageRecordDeleteOne {
+	builder := c.Delete().Where(usagerecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UsageRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for UsageRecord.
+func (c *UsageRecordClient) Query() *UsageRecordQuery {
+	return &UsageRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUsageRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UsageRecord entity by its id.
+func (c *UsageRecordClient) Get(ctx context.Context, id uuid.UUID) (*UsageRecord, error) {
+	return c.Query().Where(usagerecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UsageRecordClient) GetX(ctx context.Context, id uuid.UUID) *UsageRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UsageRecordClient) Hooks() []Hook {
+	return c.hooks.UsageRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *UsageRecordClient) Interceptors() []Interceptor {
+	return c.inters.UsageRecord
+}
+
+func (c *UsageRecordClient) mutate(ctx context.Context, m *UsageRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UsageRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UsageRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UsageRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UsageRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UsageRecord mutation op: %q", m.Op())
+	}
+}
+
 // WorkflowRunClient is a client for the WorkflowRun schema.
 type WorkflowRunClient struct {
 	config
@@ -2809,13 +3516,15 @@ func (c *WorkflowStepRunClient) mutate(ctx context.Context, m *WorkflowStepRunMu
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditLog, ConfigProfile, CronJob, CronJobHistory, ExternalRef, Inquiry, Key,
-		Knowledge, Learning, Message, Observation, PaymentTx, PeerReputation,
-		Reflection, Secret, Session, WorkflowRun, WorkflowStepRun []ent.Hook
+		ApprovalGrant, AuditLog, ConfigProfile, CronJob, CronJobHistory, ExternalRef,
+		FileSnapshot, Inquiry, Key, Knowledge, KnowledgeRevision, Learning, Message,
+		Observation, PaymentTx, PeerReputation, Reflection, Secret, SecretVersion,
+		Session, UsageRecord, WorkflowRun, WorkflowStepRun []ent.Hook
 	}
 	inters struct {
-		AuditLog, ConfigProfile, CronJob, CronJobHistory, ExternalRef, Inquiry, Key,
-		Knowledge, Learning, Message, Observation, PaymentTx, PeerReputation,
-		Reflection, Secret, Session, WorkflowRun, WorkflowStepRun []ent.Interceptor
+		ApprovalGrant, AuditLog, ConfigProfile, CronJob, CronJobHistory, ExternalRef,
+		FileSnapshot, Inquiry, Key, Knowledge, KnowledgeRevision, Learning, Message,
+		Observation, PaymentTx, PeerReputation, Reflection, Secret, SecretVersion,
+		Session, UsageRecord, WorkflowRun, WorkflowStepRun []ent.Interceptor
 	}
 )
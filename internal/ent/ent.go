@@ -12,14 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
 	"github.com/langoai/lango/internal/ent/auditlog"
 	"github.com/langoai/lango/internal/ent/configprofile"
 	"github.com/langoai/lango/internal/ent/cronjob"
 	"github.com/langoai/lango/internal/ent/cronjobhistory"
 	"github.com/langoai/lango/internal/ent/externalref"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
 	"github.com/langoai/lango/internal/ent/inquiry"
 	"github.com/langoai/lango/internal/ent/key"
 	"github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
 	"github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/ent/message"
 	"github.com/langoai/lango/internal/ent/observation"
@@ -27,7 +30,9 @@ import (
 	"github.com/langoai/lango/internal/ent/peerreputation"
 	"github.com/langoai/lango/internal/ent/reflection"
 	"github.com/langoai/lango/internal/ent/secret"
+	"github.com/langoai/lango/internal/ent/secretversion"
 	"github.com/langoai/lango/internal/ent/session"
+	"github.com/langoai/lango/internal/ent/usagerecord"
 	"github.com/langoai/lango/internal/ent/workflowrun"
 	"github.com/langoai/lango/internal/ent/workflowsteprun"
 )
@@ -90,24 +95,29 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			auditlog.Table:        auditlog.ValidColumn,
-			configprofile.Table:   configprofile.ValidColumn,
-			cronjob.Table:         cronjob.ValidColumn,
-			cronjobhistory.Table:  cronjobhistory.ValidColumn,
-			externalref.Table:     externalref.ValidColumn,
-			inquiry.Table:         inquiry.ValidColumn,
-			key.Table:             key.ValidColumn,
-			knowledge.Table:       knowledge.ValidColumn,
-			learning.Table:        learning.ValidColumn,
-			message.Table:         message.ValidColumn,
-			observation.Table:     observation.ValidColumn,
-			paymenttx.Table:       paymenttx.ValidColumn,
-			peerreputation.Table:  peerreputation.ValidColumn,
-			reflection.Table:      reflection.ValidColumn,
-			secret.Table:          secret.ValidColumn,
-			session.Table:         session.ValidColumn,
-			workflowrun.Table:     workflowrun.ValidColumn,
-			workflowsteprun.Table: workflowsteprun.ValidColumn,
+			approvalgrant.Table:     approvalgrant.ValidColumn,
+			auditlog.Table:          auditlog.ValidColumn,
+			configprofile.Table:     configprofile.ValidColumn,
+			cronjob.Table:           cronjob.ValidColumn,
+			cronjobhistory.Table:    cronjobhistory.ValidColumn,
+			externalref.Table:       externalref.ValidColumn,
+			filesnapshot.Table:      filesnapshot.ValidColumn,
+			inquiry.Table:           inquiry.ValidColumn,
+			key.Table:               key.ValidColumn,
+			knowledge.Table:         knowledge.ValidColumn,
+			knowledgerevision.Table: knowledgerevision.ValidColumn,
+			learning.Table:          learning.ValidColumn,
+			message.Table:           message.ValidColumn,
+			observation.Table:       observation.ValidColumn,
+			paymenttx.Table:         paymenttx.ValidColumn,
+			peerreputation.Table:    peerreputation.ValidColumn,
+			reflection.Table:        reflection.ValidColumn,
+			secret.Table:            secret.ValidColumn,
+			secretversion.Table:     secretversion.ValidColumn,
+			session.Table:           session.ValidColumn,
+			usagerecord.Table:       usagerecord.ValidColumn,
+			workflowrun.Table:       workflowrun.ValidColumn,
+			workflowsteprun.Table:   workflowsteprun.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
+)
+
+// FileSnapshot is the model entity for the FileSnapshot schema.
+type FileSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Session whose tool call changed the path
+	SessionKey string `json:"session_key,omitempty"`
+	// Absolute path that was changed
+	Path string `json:"path,omitempty"`
+	// Operation holds the value of the "operation" field.
+	Operation filesnapshot.Operation `json:"operation,omitempty"`
+	// What existed at the path before the change; dir content is a tar archive, link content the target
+	Kind filesnapshot.Kind `json:"kind,omitempty"`
+	// Content holds the value of the "content" field.
+	Content []byte `json:"content,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode uint32 `json:"mode,omitempty"`
+	// SHA-256 of the file content after the change, empty for deletions
+	AfterHash string `json:"after_hash,omitempty"`
+	// Undone holds the value of the "undone" field.
+	Undone bool `json:"undone,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case filesnapshot.FieldContent:
+			values[i] = new([]byte)
+		case filesnapshot.FieldUndone:
+			values[i] = new(sql.NullBool)
+		case filesnapshot.FieldMode:
+			values[i] = new(sql.NullInt64)
+		case filesnapshot.FieldSessionKey, filesnapshot.FieldPath, filesnapshot.FieldOperation, filesnapshot.FieldKind, filesnapshot.FieldAfterHash:
+			values[i] = new(sql.NullString)
+		case filesnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case filesnapshot.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileSnapshot fields.
+func (_m *FileSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case filesnapshot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case filesnapshot.FieldSessionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_key", values[i])
+			} else if value.Valid {
+				_m.SessionKey = value.String
+			}
+		case filesnapshot.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case filesnapshot.FieldOperation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field operation", values[i])
+			} else if value.Valid {
+				_m.Operation = filesnapshot.Operation(value.String)
+			}
+		case filesnapshot.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = filesnapshot.Kind(value.String)
+			}
+		case filesnapshot.FieldContent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value != nil {
+				_m.Content = *value
+			}
+		case filesnapshot.FieldMode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = uint32(value.Int64)
+			}
+		case filesnapshot.FieldAfterHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field after_hash", values[i])
+			} else if value.Valid {
+				_m.AfterHash = value.String
+			}
+		case filesnapshot.FieldUndone:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field undone", values[i])
+			} else if value.Valid {
+				_m.Undone = value.Bool
+			}
+		case filesnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FileSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *FileSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this FileSnapshot.
+// Note that you need to call FileSnapshot.Unwrap() before calling this method if this FileSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FileSnapshot) Update() *FileSnapshotUpdateOne {
+	return NewFileSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FileSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FileSnapshot) Unwrap() *FileSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FileSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("session_key=")
+	builder.WriteString(_m.SessionKey)
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("operation=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Operation))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Content))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("after_hash=")
+	builder.WriteString(_m.AfterHash)
+	builder.WriteString(", ")
+	builder.WriteString("undone=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Undone))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileSnapshots is a parsable slice of FileSnapshot.
+type FileSnapshots []*FileSnapshot
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filesnapshot
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the filesnapshot type in the database.
+	Label = "file_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSessionKey holds the string denoting the session_key field in the database.
+	FieldSessionKey = "session_key"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldOperation holds the string denoting the operation field in the database.
+	FieldOperation = "operation"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldAfterHash holds the string denoting the after_hash field in the database.
+	FieldAfterHash = "after_hash"
+	// FieldUndone holds the string denoting the undone field in the database.
+	FieldUndone = "undone"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the filesnapshot in the database.
+	Table = "file_snapshots"
+)
+
+// Columns holds all SQL columns for filesnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldSessionKey,
+	FieldPath,
+	FieldOperation,
+	FieldKind,
+	FieldContent,
+	FieldMode,
+	FieldAfterHash,
+	FieldUndone,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PathValidator is a validator for the "path" field. It is called by the builders before save.
+	PathValidator func(string) error
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode uint32
+	// DefaultUndone holds the default value on creation for the "undone" field.
+	DefaultUndone bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Operation defines the type for the "operation" enum field.
+type Operation string
+
+// Operation values.
+const (
+	OperationWrite  Operation = "write"
+	OperationEdit   Operation = "edit"
+	OperationDelete Operation = "delete"
+)
+
+func (o Operation) String() string {
+	return string(o)
+}
+
+// OperationValidator is a validator for the "operation" field enum values. It is called by the builders before save.
+func OperationValidator(o Operation) error {
+	switch o {
+	case OperationWrite, OperationEdit, OperationDelete:
+		return nil
+	default:
+		return fmt.Errorf("filesnapshot: invalid enum value for operation field: %q", o)
+	}
+}
+
+// Kind defines the type for the "kind" enum field.
+type Kind string
+
+// Kind values.
+const (
+	KindNone Kind = "none"
+	KindFile Kind = "file"
+	KindDir  Kind = "dir"
+	KindLink Kind = "link"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
+
+// KindValidator is a validator for the "kind" field enum values. It is called by the builders before save.
+func KindValidator(k Kind) error {
+	switch k {
+	case KindNone, KindFile, KindDir, KindLink:
+		return nil
+	default:
+		return fmt.Errorf("filesnapshot: invalid enum value for kind field: %q", k)
+	}
+}
+
+// OrderOption defines the ordering options for the FileSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySessionKey orders the results by the session_key field.
+func BySessionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionKey, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// ByOperation orders the results by the operation field.
+func ByOperation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperation, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByAfterHash orders the results by the after_hash field.
+func ByAfterHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAfterHash, opts...).ToFunc()
+}
+
+// ByUndone orders the results by the undone field.
+func ByUndone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUndone, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package filesnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// SessionKey applies equality check predicate on the "session_key" field. It's identical to SessionKeyEQ.
+func SessionKey(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldPath, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldContent, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldMode, v))
+}
+
+// AfterHash applies equality check predicate on the "after_hash" field. It's identical to AfterHashEQ.
+func AfterHash(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldAfterHash, v))
+}
+
+// Undone applies equality check predicate on the "undone" field. It's identical to UndoneEQ.
+func Undone(v bool) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldUndone, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SessionKeyEQ applies the EQ predicate on the "session_key" field.
+func SessionKeyEQ(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// SessionKeyNEQ applies the NEQ predicate on the "session_key" field.
+func SessionKeyNEQ(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldSessionKey, v))
+}
+
+// SessionKeyIn applies the In predicate on the "session_key" field.
+func SessionKeyIn(vs ...string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyNotIn applies the NotIn predicate on the "session_key" field.
+func SessionKeyNotIn(vs ...string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyGT applies the GT predicate on the "session_key" field.
+func SessionKeyGT(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldSessionKey, v))
+}
+
+// SessionKeyGTE applies the GTE predicate on the "session_key" field.
+func SessionKeyGTE(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldSessionKey, v))
+}
+
+// SessionKeyLT applies the LT predicate on the "session_key" field.
+func SessionKeyLT(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldSessionKey, v))
+}
+
+// SessionKeyLTE applies the LTE predicate on the "session_key" field.
+func SessionKeyLTE(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldSessionKey, v))
+}
+
+// SessionKeyContains applies the Contains predicate on the "session_key" field.
+func SessionKeyContains(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldContains(FieldSessionKey, v))
+}
+
+// SessionKeyHasPrefix applies the HasPrefix predicate on the "session_key" field.
+func SessionKeyHasPrefix(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldHasPrefix(FieldSessionKey, v))
+}
+
+// SessionKeyHasSuffix applies the HasSuffix predicate on the "session_key" field.
+func SessionKeyHasSuffix(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldHasSuffix(FieldSessionKey, v))
+}
+
+// SessionKeyIsNil applies the IsNil predicate on the "session_key" field.
+func SessionKeyIsNil() predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIsNull(FieldSessionKey))
+}
+
+// SessionKeyNotNil applies the NotNil predicate on the "session_key" field.
+func SessionKeyNotNil() predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotNull(FieldSessionKey))
+}
+
+// SessionKeyEqualFold applies the EqualFold predicate on the "session_key" field.
+func SessionKeyEqualFold(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEqualFold(FieldSessionKey, v))
+}
+
+// SessionKeyContainsFold applies the ContainsFold predicate on the "session_key" field.
+func SessionKeyContainsFold(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldContainsFold(FieldSessionKey, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldContainsFold(FieldPath, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v Operation) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v Operation) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...Operation) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...Operation) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v Kind) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v Kind) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...Kind) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...Kind) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...[]byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...[]byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v []byte) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotNull(FieldContent))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v uint32) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldMode, v))
+}
+
+// AfterHashEQ applies the EQ predicate on the "after_hash" field.
+func AfterHashEQ(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldAfterHash, v))
+}
+
+// AfterHashNEQ applies the NEQ predicate on the "after_hash" field.
+func AfterHashNEQ(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldAfterHash, v))
+}
+
+// AfterHashIn applies the In predicate on the "after_hash" field.
+func AfterHashIn(vs ...string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldAfterHash, vs...))
+}
+
+// AfterHashNotIn applies the NotIn predicate on the "after_hash" field.
+func AfterHashNotIn(vs ...string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldAfterHash, vs...))
+}
+
+// AfterHashGT applies the GT predicate on the "after_hash" field.
+func AfterHashGT(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldAfterHash, v))
+}
+
+// AfterHashGTE applies the GTE predicate on the "after_hash" field.
+func AfterHashGTE(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldAfterHash, v))
+}
+
+// AfterHashLT applies the LT predicate on the "after_hash" field.
+func AfterHashLT(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldAfterHash, v))
+}
+
+// AfterHashLTE applies the LTE predicate on the "after_hash" field.
+func AfterHashLTE(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldAfterHash, v))
+}
+
+// AfterHashContains applies the Contains predicate on the "after_hash" field.
+func AfterHashContains(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldContains(FieldAfterHash, v))
+}
+
+// AfterHashHasPrefix applies the HasPrefix predicate on the "after_hash" field.
+func AfterHashHasPrefix(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldHasPrefix(FieldAfterHash, v))
+}
+
+// AfterHashHasSuffix applies the HasSuffix predicate on the "after_hash" field.
+func AfterHashHasSuffix(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldHasSuffix(FieldAfterHash, v))
+}
+
+// AfterHashIsNil applies the IsNil predicate on the "after_hash" field.
+func AfterHashIsNil() predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIsNull(FieldAfterHash))
+}
+
+// AfterHashNotNil applies the NotNil predicate on the "after_hash" field.
+func AfterHashNotNil() predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotNull(FieldAfterHash))
+}
+
+// AfterHashEqualFold applies the EqualFold predicate on the "after_hash" field.
+func AfterHashEqualFold(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEqualFold(FieldAfterHash, v))
+}
+
+// AfterHashContainsFold applies the ContainsFold predicate on the "after_hash" field.
+func AfterHashContainsFold(v string) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldContainsFold(FieldAfterHash, v))
+}
+
+// UndoneEQ applies the EQ predicate on the "undone" field.
+func UndoneEQ(v bool) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldUndone, v))
+}
+
+// UndoneNEQ applies the NEQ predicate on the "undone" field.
+func UndoneNEQ(v bool) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldUndone, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FileSnapshot) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FileSnapshot) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileSnapshot) predicate.FileSnapshot {
+	return predicate.FileSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
+)
+
+// FileSnapshotCreate is the builder for creating a FileSnapshot entity.
+type FileSnapshotCreate struct {
+	config
+	mutation *FileSnapshotMutation
+	hooks    []Hook
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_c *FileSnapshotCreate) SetSessionKey(v string) *FileSnapshotCreate {
+	_c.mutation.SetSessionKey(v)
+	return _c
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_c *FileSnapshotCreate) SetNillableSessionKey(v *string) *FileSnapshotCreate {
+	if v != nil {
+		_c.SetSessionKey(*v)
+	}
+	return _c
+}
+
+// SetPath sets the "path" field.
+func (_c *FileSnapshotCreate) SetPath(v string) *FileSnapshotCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetOperation sets the "operation" field.
+func (_c *FileSnapshotCreate) SetOperation(v filesnapshot.Operation) *FileSnapshotCreate {
+	_c.mutation.SetOperation(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *FileSnapshotCreate) SetKind(v filesnapshot.Kind) *FileSnapshotCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *FileSnapshotCreate) SetContent(v []byte) *FileSnapshotCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *FileSnapshotCreate) SetMode(v uint32) *FileSnapshotCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *FileSnapshotCreate) SetNillableMode(v *uint32) *FileSnapshotCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetAfterHash sets the "after_hash" field.
+func (_c *FileSnapshotCreate) SetAfterHash(v string) *FileSnapshotCreate {
+	_c.mutation.SetAfterHash(v)
+	return _c
+}
+
+// SetNillableAfterHash sets the "after_hash" field if the given value is not nil.
+func (_c *FileSnapshotCreate) SetNillableAfterHash(v *string) *FileSnapshotCreate {
+	if v != nil {
+		_c.SetAfterHash(*v)
+	}
+	return _c
+}
+
+// SetUndone sets the "undone" field.
+func (_c *FileSnapshotCreate) SetUndone(v bool) *FileSnapshotCreate {
+	_c.mutation.SetUndone(v)
+	return _c
+}
+
+// SetNillableUndone sets the "undone" field if the given value is not nil.
+func (_c *FileSnapshotCreate) SetNillableUndone(v *bool) *FileSnapshotCreate {
+	if v != nil {
+		_c.SetUndone(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FileSnapshotCreate) SetCreatedAt(v time.Time) *FileSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FileSnapshotCreate) SetNillableCreatedAt(v *time.Time) *FileSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FileSnapshotCreate) SetID(v uuid.UUID) *FileSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FileSnapshotCreate) SetNillableID(v *uuid.UUID) *FileSnapshotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the FileSnapshotMutation object of the builder.
+func (_c *FileSnapshotCreate) Mutation() *FileSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the FileSnapshot in the database.
+func (_c *FileSnapshotCreate) Save(ctx context.Context) (*FileSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FileSnapshotCreate) SaveX(ctx context.Context) *FileSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FileSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := filesnapshot.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.Undone(); !ok {
+		v := filesnapshot.DefaultUndone
+		_c.mutation.SetUndone(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := filesnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := filesnapshot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FileSnapshotCreate) check() error {
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "FileSnapshot.path"`)}
+	}
+	if v, ok := _c.mutation.Path(); ok {
+		if err := filesnapshot.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.path": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Operation(); !ok {
+		return &ValidationError{Name: "operation", err: errors.New(`ent: missing required field "FileSnapshot.operation"`)}
+	}
+	if v, ok := _c.mutation.Operation(); ok {
+		if err := filesnapshot.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.operation": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "FileSnapshot.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := filesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "FileSnapshot.mode"`)}
+	}
+	if _, ok := _c.mutation.Undone(); !ok {
+		return &ValidationError{Name: "undone", err: errors.New(`ent: missing required field "FileSnapshot.undone"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FileSnapshot.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *FileSnapshotCreate) sqlSave(ctx context.Context) (*FileSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FileSnapshotCreate) createSpec() (*FileSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FileSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(filesnapshot.Table, sqlgraph.NewFieldSpec(filesnapshot.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.SessionKey(); ok {
+		_spec.SetField(filesnapshot.FieldSessionKey, field.TypeString, value)
+		_node.SessionKey = value
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(filesnapshot.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Operation(); ok {
+		_spec.SetField(filesnapshot.FieldOperation, field.TypeEnum, value)
+		_node.Operation = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(filesnapshot.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(filesnapshot.FieldContent, field.TypeBytes, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(filesnapshot.FieldMode, field.TypeUint32, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.AfterHash(); ok {
+		_spec.SetField(filesnapshot.FieldAfterHash, field.TypeString, value)
+		_node.AfterHash = value
+	}
+	if value, ok := _c.mutation.Undone(); ok {
+		_spec.SetField(filesnapshot.FieldUndone, field.TypeBool, value)
+		_node.Undone = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(filesnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// FileSnapshotCreateBulk is the builder for creating many FileSnapshot entities in bulk.
+type FileSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*FileSnapshotCreate
+}
+
+// Save creates the FileSnapshot entities in the database.
+func (_c *FileSnapshotCreateBulk) Save(ctx context.Context) ([]*FileSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FileSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FileSnapshotCreateBulk) SaveX(ctx context.Context) []*FileSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FileSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FileSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
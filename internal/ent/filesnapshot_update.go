@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// FileSnapshotUpdate is the builder for updating FileSnapshot entities.
+type FileSnapshotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FileSnapshotMutation
+}
+
+// Where appends a list predicates to the FileSnapshotUpdate builder.
+func (_u *FileSnapshotUpdate) Where(ps ...predicate.FileSnapshot) *FileSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *FileSnapshotUpdate) SetSessionKey(v string) *FileSnapshotUpdate {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillableSessionKey(v *string) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (_u *FileSnapshotUpdate) ClearSessionKey() *FileSnapshotUpdate {
+	_u.mutation.ClearSessionKey()
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *FileSnapshotUpdate) SetPath(v string) *FileSnapshotUpdate {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillablePath(v *string) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *FileSnapshotUpdate) SetOperation(v filesnapshot.Operation) *FileSnapshotUpdate {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillableOperation(v *filesnapshot.Operation) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *FileSnapshotUpdate) SetKind(v filesnapshot.Kind) *FileSnapshotUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillableKind(v *filesnapshot.Kind) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *FileSnapshotUpdate) SetContent(v []byte) *FileSnapshotUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *FileSnapshotUpdate) ClearContent() *FileSnapshotUpdate {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *FileSnapshotUpdate) SetMode(v uint32) *FileSnapshotUpdate {
+	_u.mutation.ResetMode()
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillableMode(v *uint32) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// AddMode adds value to the "mode" field.
+func (_u *FileSnapshotUpdate) AddMode(v int32) *FileSnapshotUpdate {
+	_u.mutation.AddMode(v)
+	return _u
+}
+
+// SetAfterHash sets the "after_hash" field.
+func (_u *FileSnapshotUpdate) SetAfterHash(v string) *FileSnapshotUpdate {
+	_u.mutation.SetAfterHash(v)
+	return _u
+}
+
+// SetNillableAfterHash sets the "after_hash" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillableAfterHash(v *string) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetAfterHash(*v)
+	}
+	return _u
+}
+
+// ClearAfterHash clears the value of the "after_hash" field.
+func (_u *FileSnapshotUpdate) ClearAfterHash() *FileSnapshotUpdate {
+	_u.mutation.ClearAfterHash()
+	return _u
+}
+
+// SetUndone sets the "undone" field.
+func (_u *FileSnapshotUpdate) SetUndone(v bool) *FileSnapshotUpdate {
+	_u.mutation.SetUndone(v)
+	return _u
+}
+
+// SetNillableUndone sets the "undone" field if the given value is not nil.
+func (_u *FileSnapshotUpdate) SetNillableUndone(v *bool) *FileSnapshotUpdate {
+	if v != nil {
+		_u.SetUndone(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FileSnapshotMutation object of the builder.
+func (_u *FileSnapshotUpdate) Mutation() *FileSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FileSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FileSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileSnapshotUpdate) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := filesnapshot.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := filesnapshot.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := filesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FileSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filesnapshot.Table, filesnapshot.Columns, sqlgraph.NewFieldSpec(filesnapshot.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(filesnapshot.FieldSessionKey, field.TypeString, value)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(filesnapshot.FieldSessionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(filesnapshot.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(filesnapshot.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(filesnapshot.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(filesnapshot.FieldContent, field.TypeBytes, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(filesnapshot.FieldContent, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(filesnapshot.FieldMode, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMode(); ok {
+		_spec.AddField(filesnapshot.FieldMode, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AfterHash(); ok {
+		_spec.SetField(filesnapshot.FieldAfterHash, field.TypeString, value)
+	}
+	if _u.mutation.AfterHashCleared() {
+		_spec.ClearField(filesnapshot.FieldAfterHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Undone(); ok {
+		_spec.SetField(filesnapshot.FieldUndone, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FileSnapshotUpdateOne is the builder for updating a single FileSnapshot entity.
+type FileSnapshotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FileSnapshotMutation
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *FileSnapshotUpdateOne) SetSessionKey(v string) *FileSnapshotUpdateOne {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillableSessionKey(v *string) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (_u *FileSnapshotUpdateOne) ClearSessionKey() *FileSnapshotUpdateOne {
+	_u.mutation.ClearSessionKey()
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *FileSnapshotUpdateOne) SetPath(v string) *FileSnapshotUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillablePath(v *string) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *FileSnapshotUpdateOne) SetOperation(v filesnapshot.Operation) *FileSnapshotUpdateOne {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillableOperation(v *filesnapshot.Operation) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *FileSnapshotUpdateOne) SetKind(v filesnapshot.Kind) *FileSnapshotUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillableKind(v *filesnapshot.Kind) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *FileSnapshotUpdateOne) SetContent(v []byte) *FileSnapshotUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *FileSnapshotUpdateOne) ClearContent() *FileSnapshotUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *FileSnapshotUpdateOne) SetMode(v uint32) *FileSnapshotUpdateOne {
+	_u.mutation.ResetMode()
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillableMode(v *uint32) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// AddMode adds value to the "mode" field.
+func (_u *FileSnapshotUpdateOne) AddMode(v int32) *FileSnapshotUpdateOne {
+	_u.mutation.AddMode(v)
+	return _u
+}
+
+// SetAfterHash sets the "after_hash" field.
+func (_u *FileSnapshotUpdateOne) SetAfterHash(v string) *FileSnapshotUpdateOne {
+	_u.mutation.SetAfterHash(v)
+	return _u
+}
+
+// SetNillableAfterHash sets the "after_hash" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillableAfterHash(v *string) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetAfterHash(*v)
+	}
+	return _u
+}
+
+// ClearAfterHash clears the value of the "after_hash" field.
+func (_u *FileSnapshotUpdateOne) ClearAfterHash() *FileSnapshotUpdateOne {
+	_u.mutation.ClearAfterHash()
+	return _u
+}
+
+// SetUndone sets the "undone" field.
+func (_u *FileSnapshotUpdateOne) SetUndone(v bool) *FileSnapshotUpdateOne {
+	_u.mutation.SetUndone(v)
+	return _u
+}
+
+// SetNillableUndone sets the "undone" field if the given value is not nil.
+func (_u *FileSnapshotUpdateOne) SetNillableUndone(v *bool) *FileSnapshotUpdateOne {
+	if v != nil {
+		_u.SetUndone(*v)
+	}
+	return _u
+}
+
+// Mutation returns the FileSnapshotMutation object of the builder.
+func (_u *FileSnapshotUpdateOne) Mutation() *FileSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FileSnapshotUpdate builder.
+func (_u *FileSnapshotUpdateOne) Where(ps ...predicate.FileSnapshot) *FileSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FileSnapshotUpdateOne) Select(field string, fields ...string) *FileSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FileSnapshot entity.
+func (_u *FileSnapshotUpdateOne) Save(ctx context.Context) (*FileSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FileSnapshotUpdateOne) SaveX(ctx context.Context) *FileSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FileSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FileSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FileSnapshotUpdateOne) check() error {
+	if v, ok := _u.mutation.Path(); ok {
+		if err := filesnapshot.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := filesnapshot.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Kind(); ok {
+		if err := filesnapshot.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "FileSnapshot.kind": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *FileSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *FileSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(filesnapshot.Table, filesnapshot.Columns, sqlgraph.NewFieldSpec(filesnapshot.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FileSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, filesnapshot.FieldID)
+		for _, f := range fields {
+			if !filesnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != filesnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(filesnapshot.FieldSessionKey, field.TypeString, value)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(filesnapshot.FieldSessionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(filesnapshot.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(filesnapshot.FieldOperation, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(filesnapshot.FieldKind, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(filesnapshot.FieldContent, field.TypeBytes, value)
+	}
+	if _u.mutation.ContentCleared() {
+		_spec.ClearField(filesnapshot.FieldContent, field.TypeBytes)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(filesnapshot.FieldMode, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMode(); ok {
+		_spec.AddField(filesnapshot.FieldMode, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AfterHash(); ok {
+		_spec.SetField(filesnapshot.FieldAfterHash, field.TypeString, value)
+	}
+	if _u.mutation.AfterHashCleared() {
+		_spec.ClearField(filesnapshot.FieldAfterHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.Undone(); ok {
+		_spec.SetField(filesnapshot.FieldUndone, field.TypeBool, value)
+	}
+	_node = &FileSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filesnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,18 @@ import (
 	"github.com/langoai/lango/internal/ent"
 )
 
+// The ApprovalGrantFunc type is an adapter to allow the use of ordinary
+// function as ApprovalGrant mutator.
+type ApprovalGrantFunc func(context.Context, *ent.ApprovalGrantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApprovalGrantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApprovalGrantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApprovalGrantMutation", m)
+}
+
 // The AuditLogFunc type is an adapter to allow the use of ordinary
 // function as AuditLog mutator.
 type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
@@ -69,6 +81,18 @@ func (f ExternalRefFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExternalRefMutation", m)
 }
 
+// The FileSnapshotFunc type is an adapter to allow the use of ordinary
+// function as FileSnapshot mutator.
+type FileSnapshotFunc func(context.Context, *ent.FileSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FileSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileSnapshotMutation", m)
+}
+
 // The InquiryFunc type is an adapter to allow the use of ordinary
 // function as Inquiry mutator.
 type InquiryFunc func(context.Context, *ent.InquiryMutation) (ent.Value, error)
@@ -105,6 +129,18 @@ func (f KnowledgeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KnowledgeMutation", m)
 }
 
+// The KnowledgeRevisionFunc type is an adapter to allow the use of ordinary
+// function as KnowledgeRevision mutator.
+type KnowledgeRevisionFunc func(context.Context, *ent.KnowledgeRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KnowledgeRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KnowledgeRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KnowledgeRevisionMutation", m)
+}
+
 // The LearningFunc type is an adapter to allow the use of ordinary
 // function as Learning mutator.
 type LearningFunc func(context.Context, *ent.LearningMutation) (ent.Value, error)
@@ -189,6 +225,18 @@ func (f SecretFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecretMutation", m)
 }
 
+// The SecretVersionFunc type is an adapter to allow the use of ordinary
+// function as SecretVersion mutator.
+type SecretVersionFunc func(context.Context, *ent.SecretVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecretVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SecretVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SecretVersionMutation", m)
+}
+
 // The SessionFunc type is an adapter to allow the use of ordinary
 // function as Session mutator.
 type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
@@ -201,6 +249,18 @@ func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
 }
 
+// The UsageRecordFunc type is an adapter to allow the use of ordinary
+// function as UsageRecord mutator.
+type UsageRecordFunc func(context.Context, *ent.UsageRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UsageRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UsageRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UsageRecordMutation", m)
+}
+
 // The WorkflowRunFunc type is an adapter to allow the use of ordinary
 // function as WorkflowRun mutator.
 type WorkflowRunFunc func(context.Context, *ent.WorkflowRunMutation) (ent.Value, error)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +27,8 @@ type Inquiry struct {
 	Question string `json:"question,omitempty"`
 	// Context holds the value of the "context" field.
 	Context *string `json:"context,omitempty"`
+	// Options holds the value of the "options" field.
+	Options []string `json:"options,omitempty"`
 	// Priority holds the value of the "priority" field.
 	Priority inquiry.Priority `json:"priority,omitempty"`
 	// Status holds the value of the "status" field.
@@ -48,6 +51,8 @@ func (*Inquiry) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case inquiry.FieldOptions:
+			values[i] = new([]byte)
 		case inquiry.FieldSessionKey, inquiry.FieldTopic, inquiry.FieldQuestion, inquiry.FieldContext, inquiry.FieldPriority, inquiry.FieldStatus, inquiry.FieldAnswer, inquiry.FieldKnowledgeKey, inquiry.FieldSourceObservationID:
 			values[i] = new(sql.NullString)
 		case inquiry.FieldCreatedAt, inquiry.FieldResolvedAt:
@@ -100,6 +105,14 @@ func (_m *Inquiry) assignValues(columns []string, values []any) error {
 				_m.Context = new(string)
 				*_m.Context = value.String
 			}
+		case inquiry.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
 		case inquiry.FieldPriority:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field priority", values[i])
@@ -196,6 +209,9 @@ func (_m *Inquiry) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Options))
+	builder.WriteString(", ")
 	builder.WriteString("priority=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
 	builder.WriteString(", ")
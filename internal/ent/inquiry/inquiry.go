@@ -23,6 +23,8 @@ const (
 	FieldQuestion = "question"
 	// FieldContext holds the string denoting the context field in the database.
 	FieldContext = "context"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
 	// FieldPriority holds the string denoting the priority field in the database.
 	FieldPriority = "priority"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -48,6 +50,7 @@ var Columns = []string{
 	FieldTopic,
 	FieldQuestion,
 	FieldContext,
+	FieldOptions,
 	FieldPriority,
 	FieldStatus,
 	FieldAnswer,
@@ -370,6 +370,16 @@ func ContextContainsFold(v string) predicate.Inquiry {
 	return predicate.Inquiry(sql.FieldContainsFold(FieldContext, v))
 }
 
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.Inquiry {
+	return predicate.Inquiry(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.Inquiry {
+	return predicate.Inquiry(sql.FieldNotNull(FieldOptions))
+}
+
 // PriorityEQ applies the EQ predicate on the "priority" field.
 func PriorityEQ(v Priority) predicate.Inquiry {
 	return predicate.Inquiry(sql.FieldEQ(FieldPriority, v))
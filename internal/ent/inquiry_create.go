@@ -53,6 +53,12 @@ func (_c *InquiryCreate) SetNillableContext(v *string) *InquiryCreate {
 	return _c
 }
 
+// SetOptions sets the "options" field.
+func (_c *InquiryCreate) SetOptions(v []string) *InquiryCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
 // SetPriority sets the "priority" field.
 func (_c *InquiryCreate) SetPriority(v inquiry.Priority) *InquiryCreate {
 	_c.mutation.SetPriority(v)
@@ -314,6 +320,10 @@ func (_c *InquiryCreate) createSpec() (*Inquiry, *sqlgraph.CreateSpec) {
 		_spec.SetField(inquiry.FieldContext, field.TypeString, value)
 		_node.Context = &value
 	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(inquiry.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
 	if value, ok := _c.mutation.Priority(); ok {
 		_spec.SetField(inquiry.FieldPriority, field.TypeEnum, value)
 		_node.Priority = value
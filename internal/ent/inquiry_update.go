@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/langoai/lango/internal/ent/inquiry"
 	"github.com/langoai/lango/internal/ent/predicate"
@@ -90,6 +91,24 @@ func (_u *InquiryUpdate) ClearContext() *InquiryUpdate {
 	return _u
 }
 
+// SetOptions sets the "options" field.
+func (_u *InquiryUpdate) SetOptions(v []string) *InquiryUpdate {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *InquiryUpdate) AppendOptions(v []string) *InquiryUpdate {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *InquiryUpdate) ClearOptions() *InquiryUpdate {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
 // SetPriority sets the "priority" field.
 func (_u *InquiryUpdate) SetPriority(v inquiry.Priority) *InquiryUpdate {
 	_u.mutation.SetPriority(v)
@@ -287,6 +306,17 @@ func (_u *InquiryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ContextCleared() {
 		_spec.ClearField(inquiry.FieldContext, field.TypeString)
 	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(inquiry.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inquiry.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(inquiry.FieldOptions, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Priority(); ok {
 		_spec.SetField(inquiry.FieldPriority, field.TypeEnum, value)
 	}
@@ -399,6 +429,24 @@ func (_u *InquiryUpdateOne) ClearContext() *InquiryUpdateOne {
 	return _u
 }
 
+// SetOptions sets the "options" field.
+func (_u *InquiryUpdateOne) SetOptions(v []string) *InquiryUpdateOne {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *InquiryUpdateOne) AppendOptions(v []string) *InquiryUpdateOne {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *InquiryUpdateOne) ClearOptions() *InquiryUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
 // SetPriority sets the "priority" field.
 func (_u *InquiryUpdateOne) SetPriority(v inquiry.Priority) *InquiryUpdateOne {
 	_u.mutation.SetPriority(v)
@@ -626,6 +674,17 @@ func (_u *InquiryUpdateOne) sqlSave(ctx context.Context) (_node *Inquiry, err er
 	if _u.mutation.ContextCleared() {
 		_spec.ClearField(inquiry.FieldContext, field.TypeString)
 	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(inquiry.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, inquiry.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(inquiry.FieldOptions, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Priority(); ok {
 		_spec.SetField(inquiry.FieldPriority, field.TypeEnum, value)
 	}
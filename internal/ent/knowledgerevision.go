@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
+)
+
+// KnowledgeRevision is the model entity for the KnowledgeRevision schema.
+type KnowledgeRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// KnowledgeKey holds the value of the "knowledge_key" field.
+	KnowledgeKey string `json:"knowledge_key,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Action holds the value of the "action" field.
+	Action knowledgerevision.Action `json:"action,omitempty"`
+	// Category holds the value of the "category" field.
+	Category knowledgerevision.Category `json:"category,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Author holds the value of the "author" field.
+	Author knowledgerevision.Author `json:"author,omitempty"`
+	// SessionKey holds the value of the "session_key" field.
+	SessionKey string `json:"session_key,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KnowledgeRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case knowledgerevision.FieldTags:
+			values[i] = new([]byte)
+		case knowledgerevision.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case knowledgerevision.FieldKnowledgeKey, knowledgerevision.FieldAction, knowledgerevision.FieldCategory, knowledgerevision.FieldContent, knowledgerevision.FieldSource, knowledgerevision.FieldAuthor, knowledgerevision.FieldSessionKey, knowledgerevision.FieldReason:
+			values[i] = new(sql.NullString)
+		case knowledgerevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case knowledgerevision.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KnowledgeRevision fields.
+func (_m *KnowledgeRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case knowledgerevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case knowledgerevision.FieldKnowledgeKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field knowledge_key", values[i])
+			} else if value.Valid {
+				_m.KnowledgeKey = value.String
+			}
+		case knowledgerevision.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case knowledgerevision.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				_m.Action = knowledgerevision.Action(value.String)
+			}
+		case knowledgerevision.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = knowledgerevision.Category(value.String)
+			}
+		case knowledgerevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case knowledgerevision.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case knowledgerevision.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case knowledgerevision.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = knowledgerevision.Author(value.String)
+			}
+		case knowledgerevision.FieldSessionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_key", values[i])
+			} else if value.Valid {
+				_m.SessionKey = value.String
+			}
+		case knowledgerevision.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case knowledgerevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KnowledgeRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *KnowledgeRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KnowledgeRevision.
+// Note that you need to call KnowledgeRevision.Unwrap() before calling this method if this KnowledgeRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KnowledgeRevision) Update() *KnowledgeRevisionUpdateOne {
+	return NewKnowledgeRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KnowledgeRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KnowledgeRevision) Unwrap() *KnowledgeRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KnowledgeRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KnowledgeRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("KnowledgeRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("knowledge_key=")
+	builder.WriteString(_m.KnowledgeKey)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Action))
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Category))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Author))
+	builder.WriteString(", ")
+	builder.WriteString("session_key=")
+	builder.WriteString(_m.SessionKey)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KnowledgeRevisions is a parsable slice of KnowledgeRevision.
+type KnowledgeRevisions []*KnowledgeRevision
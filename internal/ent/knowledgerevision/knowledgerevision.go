@@ -0,0 +1,218 @@
+// Code generated by ent, DO NOT EDIT.
+
+package knowledgerevision
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the knowledgerevision type in the database.
+	Label = "knowledge_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldKnowledgeKey holds the string denoting the knowledge_key field in the database.
+	FieldKnowledgeKey = "knowledge_key"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldSessionKey holds the string denoting the session_key field in the database.
+	FieldSessionKey = "session_key"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the knowledgerevision in the database.
+	Table = "knowledge_revisions"
+)
+
+// Columns holds all SQL columns for knowledgerevision fields.
+var Columns = []string{
+	FieldID,
+	FieldKnowledgeKey,
+	FieldVersion,
+	FieldAction,
+	FieldCategory,
+	FieldContent,
+	FieldTags,
+	FieldSource,
+	FieldAuthor,
+	FieldSessionKey,
+	FieldReason,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// KnowledgeKeyValidator is a validator for the "knowledge_key" field. It is called by the builders before save.
+	KnowledgeKeyValidator func(string) error
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	ContentValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionCreate   Action = "create"
+	ActionUpdate   Action = "update"
+	ActionDelete   Action = "delete"
+	ActionRollback Action = "rollback"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionCreate, ActionUpdate, ActionDelete, ActionRollback:
+		return nil
+	default:
+		return fmt.Errorf("knowledgerevision: invalid enum value for action field: %q", a)
+	}
+}
+
+// Category defines the type for the "category" enum field.
+type Category string
+
+// Category values.
+const (
+	CategoryRule       Category = "rule"
+	CategoryDefinition Category = "definition"
+	CategoryPreference Category = "preference"
+	CategoryFact       Category = "fact"
+	CategoryPattern    Category = "pattern"
+	CategoryCorrection Category = "correction"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// CategoryValidator is a validator for the "category" field enum values. It is called by the builders before save.
+func CategoryValidator(c Category) error {
+	switch c {
+	case CategoryRule, CategoryDefinition, CategoryPreference, CategoryFact, CategoryPattern, CategoryCorrection:
+		return nil
+	default:
+		return fmt.Errorf("knowledgerevision: invalid enum value for category field: %q", c)
+	}
+}
+
+// Author defines the type for the "author" enum field.
+type Author string
+
+// AuthorAgent is the default value of the Author enum.
+const DefaultAuthor = AuthorAgent
+
+// Author values.
+const (
+	AuthorAgent     Author = "agent"
+	AuthorUser      Author = "user"
+	AuthorLibrarian Author = "librarian"
+)
+
+func (a Author) String() string {
+	return string(a)
+}
+
+// AuthorValidator is a validator for the "author" field enum values. It is called by the builders before save.
+func AuthorValidator(a Author) error {
+	switch a {
+	case AuthorAgent, AuthorUser, AuthorLibrarian:
+		return nil
+	default:
+		return fmt.Errorf("knowledgerevision: invalid enum value for author field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the KnowledgeRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByKnowledgeKey orders the results by the knowledge_key field.
+func ByKnowledgeKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKnowledgeKey, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// BySessionKey orders the results by the session_key field.
+func BySessionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionKey, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package knowledgerevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldID, id))
+}
+
+// KnowledgeKey applies equality check predicate on the "knowledge_key" field. It's identical to KnowledgeKeyEQ.
+func KnowledgeKey(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldKnowledgeKey, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldSource, v))
+}
+
+// SessionKey applies equality check predicate on the "session_key" field. It's identical to SessionKeyEQ.
+func SessionKey(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldReason, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KnowledgeKeyEQ applies the EQ predicate on the "knowledge_key" field.
+func KnowledgeKeyEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyNEQ applies the NEQ predicate on the "knowledge_key" field.
+func KnowledgeKeyNEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyIn applies the In predicate on the "knowledge_key" field.
+func KnowledgeKeyIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldKnowledgeKey, vs...))
+}
+
+// KnowledgeKeyNotIn applies the NotIn predicate on the "knowledge_key" field.
+func KnowledgeKeyNotIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldKnowledgeKey, vs...))
+}
+
+// KnowledgeKeyGT applies the GT predicate on the "knowledge_key" field.
+func KnowledgeKeyGT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyGTE applies the GTE predicate on the "knowledge_key" field.
+func KnowledgeKeyGTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyLT applies the LT predicate on the "knowledge_key" field.
+func KnowledgeKeyLT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyLTE applies the LTE predicate on the "knowledge_key" field.
+func KnowledgeKeyLTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyContains applies the Contains predicate on the "knowledge_key" field.
+func KnowledgeKeyContains(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContains(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyHasPrefix applies the HasPrefix predicate on the "knowledge_key" field.
+func KnowledgeKeyHasPrefix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasPrefix(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyHasSuffix applies the HasSuffix predicate on the "knowledge_key" field.
+func KnowledgeKeyHasSuffix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasSuffix(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyEqualFold applies the EqualFold predicate on the "knowledge_key" field.
+func KnowledgeKeyEqualFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEqualFold(FieldKnowledgeKey, v))
+}
+
+// KnowledgeKeyContainsFold applies the ContainsFold predicate on the "knowledge_key" field.
+func KnowledgeKeyContainsFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContainsFold(FieldKnowledgeKey, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldVersion, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v Category) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v Category) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...Category) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...Category) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContainsFold(FieldContent, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotNull(FieldTags))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContainsFold(FieldSource, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v Author) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v Author) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...Author) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...Author) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// SessionKeyEQ applies the EQ predicate on the "session_key" field.
+func SessionKeyEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldSessionKey, v))
+}
+
+// SessionKeyNEQ applies the NEQ predicate on the "session_key" field.
+func SessionKeyNEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldSessionKey, v))
+}
+
+// SessionKeyIn applies the In predicate on the "session_key" field.
+func SessionKeyIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyNotIn applies the NotIn predicate on the "session_key" field.
+func SessionKeyNotIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldSessionKey, vs...))
+}
+
+// SessionKeyGT applies the GT predicate on the "session_key" field.
+func SessionKeyGT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldSessionKey, v))
+}
+
+// SessionKeyGTE applies the GTE predicate on the "session_key" field.
+func SessionKeyGTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldSessionKey, v))
+}
+
+// SessionKeyLT applies the LT predicate on the "session_key" field.
+func SessionKeyLT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldSessionKey, v))
+}
+
+// SessionKeyLTE applies the LTE predicate on the "session_key" field.
+func SessionKeyLTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldSessionKey, v))
+}
+
+// SessionKeyContains applies the Contains predicate on the "session_key" field.
+func SessionKeyContains(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContains(FieldSessionKey, v))
+}
+
+// SessionKeyHasPrefix applies the HasPrefix predicate on the "session_key" field.
+func SessionKeyHasPrefix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasPrefix(FieldSessionKey, v))
+}
+
+// SessionKeyHasSuffix applies the HasSuffix predicate on the "session_key" field.
+func SessionKeyHasSuffix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasSuffix(FieldSessionKey, v))
+}
+
+// SessionKeyIsNil applies the IsNil predicate on the "session_key" field.
+func SessionKeyIsNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIsNull(FieldSessionKey))
+}
+
+// SessionKeyNotNil applies the NotNil predicate on the "session_key" field.
+func SessionKeyNotNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotNull(FieldSessionKey))
+}
+
+// SessionKeyEqualFold applies the EqualFold predicate on the "session_key" field.
+func SessionKeyEqualFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEqualFold(FieldSessionKey, v))
+}
+
+// SessionKeyContainsFold applies the ContainsFold predicate on the "session_key" field.
+func SessionKeyContainsFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContainsFold(FieldSessionKey, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldContainsFold(FieldReason, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KnowledgeRevision) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KnowledgeRevision) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KnowledgeRevision) predicate.KnowledgeRevision {
+	return predicate.KnowledgeRevision(sql.NotPredicates(p))
+}
@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
+)
+
+// KnowledgeRevisionCreate is the builder for creating a KnowledgeRevision entity.
+type KnowledgeRevisionCreate struct {
+	config
+	mutation *KnowledgeRevisionMutation
+	hooks    []Hook
+}
+
+// SetKnowledgeKey sets the "knowledge_key" field.
+func (_c *KnowledgeRevisionCreate) SetKnowledgeKey(v string) *KnowledgeRevisionCreate {
+	_c.mutation.SetKnowledgeKey(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *KnowledgeRevisionCreate) SetVersion(v int) *KnowledgeRevisionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *KnowledgeRevisionCreate) SetAction(v knowledgerevision.Action) *KnowledgeRevisionCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *KnowledgeRevisionCreate) SetCategory(v knowledgerevision.Category) *KnowledgeRevisionCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *KnowledgeRevisionCreate) SetContent(v string) *KnowledgeRevisionCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *KnowledgeRevisionCreate) SetTags(v []string) *KnowledgeRevisionCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *KnowledgeRevisionCreate) SetSource(v string) *KnowledgeRevisionCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *KnowledgeRevisionCreate) SetNillableSource(v *string) *KnowledgeRevisionCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *KnowledgeRevisionCreate) SetAuthor(v knowledgerevision.Author) *KnowledgeRevisionCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *KnowledgeRevisionCreate) SetNillableAuthor(v *knowledgerevision.Author) *KnowledgeRevisionCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_c *KnowledgeRevisionCreate) SetSessionKey(v string) *KnowledgeRevisionCreate {
+	_c.mutation.SetSessionKey(v)
+	return _c
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_c *KnowledgeRevisionCreate) SetNillableSessionKey(v *string) *KnowledgeRevisionCreate {
+	if v != nil {
+		_c.SetSessionKey(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *KnowledgeRevisionCreate) SetReason(v string) *KnowledgeRevisionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *KnowledgeRevisionCreate) SetNillableReason(v *string) *KnowledgeRevisionCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *KnowledgeRevisionCreate) SetCreatedAt(v time.Time) *KnowledgeRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *KnowledgeRevisionCreate) SetNillableCreatedAt(v *time.Time) *KnowledgeRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *KnowledgeRevisionCreate) SetID(v uuid.UUID) *KnowledgeRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *KnowledgeRevisionCreate) SetNillableID(v *uuid.UUID) *KnowledgeRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the KnowledgeRevisionMutation object of the builder.
+func (_c *KnowledgeRevisionCreate) Mutation() *KnowledgeRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the KnowledgeRevision in the database.
+func (_c *KnowledgeRevisionCreate) Save(ctx context.Context) (*KnowledgeRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KnowledgeRevisionCreate) SaveX(ctx context.Context) *KnowledgeRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KnowledgeRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KnowledgeRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KnowledgeRevisionCreate) defaults() {
+	if _, ok := _c.mutation.Author(); !ok {
+		v := knowledgerevision.DefaultAuthor
+		_c.mutation.SetAuthor(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := knowledgerevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := knowledgerevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KnowledgeRevisionCreate) check() error {
+	if _, ok := _c.mutation.KnowledgeKey(); !ok {
+		return &ValidationError{Name: "knowledge_key", err: errors.New(`ent: missing required field "KnowledgeRevision.knowledge_key"`)}
+	}
+	if v, ok := _c.mutation.KnowledgeKey(); ok {
+		if err := knowledgerevision.KnowledgeKeyValidator(v); err != nil {
+			return &ValidationError{Name: "knowledge_key", err: fmt.Errorf(`ent: validator failed for field "KnowledgeRevision.knowledge_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "KnowledgeRevision.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := knowledgerevision.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "KnowledgeRevision.version": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "KnowledgeRevision.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := knowledgerevision.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "KnowledgeRevision.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "KnowledgeRevision.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := knowledgerevision.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "KnowledgeRevision.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "KnowledgeRevision.content"`)}
+	}
+	if v, ok := _c.mutation.Content(); ok {
+		if err := knowledgerevision.ContentValidator(v); err != nil {
+			return &ValidationError{Name: "content", err: fmt.Errorf(`ent: validator failed for field "KnowledgeRevision.content": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "KnowledgeRevision.author"`)}
+	}
+	if v, ok := _c.mutation.Author(); ok {
+		if err := knowledgerevision.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "KnowledgeRevision.author": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KnowledgeRevision.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *KnowledgeRevisionCreate) sqlSave(ctx context.Context) (*KnowledgeRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KnowledgeRevisionCreate) createSpec() (*KnowledgeRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KnowledgeRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(knowledgerevision.Table, sqlgraph.NewFieldSpec(knowledgerevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.KnowledgeKey(); ok {
+		_spec.SetField(knowledgerevision.FieldKnowledgeKey, field.TypeString, value)
+		_node.KnowledgeKey = value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(knowledgerevision.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(knowledgerevision.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(knowledgerevision.FieldCategory, field.TypeEnum, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(knowledgerevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(knowledgerevision.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(knowledgerevision.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(knowledgerevision.FieldAuthor, field.TypeEnum, value)
+		_node.Author = value
+	}
+	if value, ok := _c.mutation.SessionKey(); ok {
+		_spec.SetField(knowledgerevision.FieldSessionKey, field.TypeString, value)
+		_node.SessionKey = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(knowledgerevision.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(knowledgerevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// KnowledgeRevisionCreateBulk is the builder for creating many KnowledgeRevision entities in bulk.
+type KnowledgeRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*KnowledgeRevisionCreate
+}
+
+// Save creates the KnowledgeRevision entities in the database.
+func (_c *KnowledgeRevisionCreateBulk) Save(ctx context.Context) ([]*KnowledgeRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KnowledgeRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KnowledgeRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KnowledgeRevisionCreateBulk) SaveX(ctx context.Context) []*KnowledgeRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KnowledgeRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KnowledgeRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// KnowledgeRevisionDelete is the builder for deleting a KnowledgeRevision entity.
+type KnowledgeRevisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *KnowledgeRevisionMutation
+}
+
+// Where appends a list predicates to the KnowledgeRevisionDelete builder.
+func (_d *KnowledgeRevisionDelete) Where(ps ...predicate.KnowledgeRevision) *KnowledgeRevisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *KnowledgeRevisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KnowledgeRevisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *KnowledgeRevisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(knowledgerevision.Table, sqlgraph.NewFieldSpec(knowledgerevision.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// KnowledgeRevisionDeleteOne is the builder for deleting a single KnowledgeRevision entity.
+type KnowledgeRevisionDeleteOne struct {
+	_d *KnowledgeRevisionDelete
+}
+
+// Where appends a list predicates to the KnowledgeRevisionDelete builder.
+func (_d *KnowledgeRevisionDeleteOne) Where(ps ...predicate.KnowledgeRevision) *KnowledgeRevisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *KnowledgeRevisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{knowledgerevision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *KnowledgeRevisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// KnowledgeRevisionUpdate is the builder for updating KnowledgeRevision entities.
+type KnowledgeRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KnowledgeRevisionMutation
+}
+
+// Where appends a list predicates to the KnowledgeRevisionUpdate builder.
+func (_u *KnowledgeRevisionUpdate) Where(ps ...predicate.KnowledgeRevision) *KnowledgeRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the KnowledgeRevisionMutation object of the builder.
+func (_u *KnowledgeRevisionUpdate) Mutation() *KnowledgeRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KnowledgeRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KnowledgeRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KnowledgeRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KnowledgeRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *KnowledgeRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(knowledgerevision.Table, knowledgerevision.Columns, sqlgraph.NewFieldSpec(knowledgerevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(knowledgerevision.FieldTags, field.TypeJSON)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(knowledgerevision.FieldSource, field.TypeString)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(knowledgerevision.FieldSessionKey, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(knowledgerevision.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knowledgerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KnowledgeRevisionUpdateOne is the builder for updating a single KnowledgeRevision entity.
+type KnowledgeRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KnowledgeRevisionMutation
+}
+
+// Mutation returns the KnowledgeRevisionMutation object of the builder.
+func (_u *KnowledgeRevisionUpdateOne) Mutation() *KnowledgeRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the KnowledgeRevisionUpdate builder.
+func (_u *KnowledgeRevisionUpdateOne) Where(ps ...predicate.KnowledgeRevision) *KnowledgeRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KnowledgeRevisionUpdateOne) Select(field string, fields ...string) *KnowledgeRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KnowledgeRevision entity.
+func (_u *KnowledgeRevisionUpdateOne) Save(ctx context.Context) (*KnowledgeRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KnowledgeRevisionUpdateOne) SaveX(ctx context.Context) *KnowledgeRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KnowledgeRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KnowledgeRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *KnowledgeRevisionUpdateOne) sqlSave(ctx context.Context) (_node *KnowledgeRevision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(knowledgerevision.Table, knowledgerevision.Columns, sqlgraph.NewFieldSpec(knowledgerevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KnowledgeRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, knowledgerevision.FieldID)
+		for _, f := range fields {
+			if !knowledgerevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != knowledgerevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(knowledgerevision.FieldTags, field.TypeJSON)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(knowledgerevision.FieldSource, field.TypeString)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(knowledgerevision.FieldSessionKey, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(knowledgerevision.FieldReason, field.TypeString)
+	}
+	_node = &KnowledgeRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{knowledgerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -37,6 +37,10 @@ type Learning struct {
 	SuccessCount int `json:"success_count,omitempty"`
 	// Confidence holds the value of the "confidence" field.
 	Confidence float64 `json:"confidence,omitempty"`
+	// Times a suggested fix was followed by another failure
+	FailureCount int `json:"failure_count,omitempty"`
+	// Human review state; only approved fixes are auto-applied
+	ReviewStatus learning.ReviewStatus `json:"review_status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -53,9 +57,9 @@ func (*Learning) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case learning.FieldConfidence:
 			values[i] = new(sql.NullFloat64)
-		case learning.FieldOccurrenceCount, learning.FieldSuccessCount:
+		case learning.FieldOccurrenceCount, learning.FieldSuccessCount, learning.FieldFailureCount:
 			values[i] = new(sql.NullInt64)
-		case learning.FieldTrigger, learning.FieldErrorPattern, learning.FieldDiagnosis, learning.FieldFix, learning.FieldCategory:
+		case learning.FieldTrigger, learning.FieldErrorPattern, learning.FieldDiagnosis, learning.FieldFix, learning.FieldCategory, learning.FieldReviewStatus:
 			values[i] = new(sql.NullString)
 		case learning.FieldCreatedAt, learning.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -138,6 +142,18 @@ func (_m *Learning) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Confidence = value.Float64
 			}
+		case learning.FieldFailureCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_count", values[i])
+			} else if value.Valid {
+				_m.FailureCount = int(value.Int64)
+			}
+		case learning.FieldReviewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_status", values[i])
+			} else if value.Valid {
+				_m.ReviewStatus = learning.ReviewStatus(value.String)
+			}
 		case learning.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -213,6 +229,12 @@ func (_m *Learning) String() string {
 	builder.WriteString("confidence=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Confidence))
 	builder.WriteString(", ")
+	builder.WriteString("failure_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailureCount))
+	builder.WriteString(", ")
+	builder.WriteString("review_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewStatus))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
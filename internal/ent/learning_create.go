@@ -123,6 +123,34 @@ func (_c *LearningCreate) SetNillableConfidence(v *float64) *LearningCreate {
 	return _c
 }
 
+// SetFailureCount sets the "failure_count" field.
+func (_c *LearningCreate) SetFailureCount(v int) *LearningCreate {
+	_c.mutation.SetFailureCount(v)
+	return _c
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_c *LearningCreate) SetNillableFailureCount(v *int) *LearningCreate {
+	if v != nil {
+		_c.SetFailureCount(*v)
+	}
+	return _c
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (_c *LearningCreate) SetReviewStatus(v learning.ReviewStatus) *LearningCreate {
+	_c.mutation.SetReviewStatus(v)
+	return _c
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_c *LearningCreate) SetNillableReviewStatus(v *learning.ReviewStatus) *LearningCreate {
+	if v != nil {
+		_c.SetReviewStatus(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *LearningCreate) SetCreatedAt(v time.Time) *LearningCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -212,6 +240,14 @@ func (_c *LearningCreate) defaults() {
 		v := learning.DefaultConfidence
 		_c.mutation.SetConfidence(v)
 	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		v := learning.DefaultFailureCount
+		_c.mutation.SetFailureCount(v)
+	}
+	if _, ok := _c.mutation.ReviewStatus(); !ok {
+		v := learning.DefaultReviewStatus
+		_c.mutation.SetReviewStatus(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := learning.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -253,6 +289,17 @@ func (_c *LearningCreate) check() error {
 	if _, ok := _c.mutation.Confidence(); !ok {
 		return &ValidationError{Name: "confidence", err: errors.New(`ent: missing required field "Learning.confidence"`)}
 	}
+	if _, ok := _c.mutation.FailureCount(); !ok {
+		return &ValidationError{Name: "failure_count", err: errors.New(`ent: missing required field "Learning.failure_count"`)}
+	}
+	if _, ok := _c.mutation.ReviewStatus(); !ok {
+		return &ValidationError{Name: "review_status", err: errors.New(`ent: missing required field "Learning.review_status"`)}
+	}
+	if v, ok := _c.mutation.ReviewStatus(); ok {
+		if err := learning.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "Learning.review_status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Learning.created_at"`)}
 	}
@@ -330,6 +377,14 @@ func (_c *LearningCreate) createSpec() (*Learning, *sqlgraph.CreateSpec) {
 		_spec.SetField(learning.FieldConfidence, field.TypeFloat64, value)
 		_node.Confidence = value
 	}
+	if value, ok := _c.mutation.FailureCount(); ok {
+		_spec.SetField(learning.FieldFailureCount, field.TypeInt, value)
+		_node.FailureCount = value
+	}
+	if value, ok := _c.mutation.ReviewStatus(); ok {
+		_spec.SetField(learning.FieldReviewStatus, field.TypeEnum, value)
+		_node.ReviewStatus = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(learning.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
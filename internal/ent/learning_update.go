@@ -198,6 +198,41 @@ func (_u *LearningUpdate) AddConfidence(v float64) *LearningUpdate {
 	return _u
 }
 
+// SetFailureCount sets the "failure_count" field.
+func (_u *LearningUpdate) SetFailureCount(v int) *LearningUpdate {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *LearningUpdate) SetNillableFailureCount(v *int) *LearningUpdate {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *LearningUpdate) AddFailureCount(v int) *LearningUpdate {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (_u *LearningUpdate) SetReviewStatus(v learning.ReviewStatus) *LearningUpdate {
+	_u.mutation.SetReviewStatus(v)
+	return _u
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_u *LearningUpdate) SetNillableReviewStatus(v *learning.ReviewStatus) *LearningUpdate {
+	if v != nil {
+		_u.SetReviewStatus(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LearningUpdate) SetUpdatedAt(v time.Time) *LearningUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -257,6 +292,11 @@ func (_u *LearningUpdate) check() error {
 			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Learning.category": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReviewStatus(); ok {
+		if err := learning.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "Learning.review_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -325,6 +365,15 @@ func (_u *LearningUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedConfidence(); ok {
 		_spec.AddField(learning.FieldConfidence, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(learning.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(learning.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReviewStatus(); ok {
+		_spec.SetField(learning.FieldReviewStatus, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(learning.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -517,6 +566,41 @@ func (_u *LearningUpdateOne) AddConfidence(v float64) *LearningUpdateOne {
 	return _u
 }
 
+// SetFailureCount sets the "failure_count" field.
+func (_u *LearningUpdateOne) SetFailureCount(v int) *LearningUpdateOne {
+	_u.mutation.ResetFailureCount()
+	_u.mutation.SetFailureCount(v)
+	return _u
+}
+
+// SetNillableFailureCount sets the "failure_count" field if the given value is not nil.
+func (_u *LearningUpdateOne) SetNillableFailureCount(v *int) *LearningUpdateOne {
+	if v != nil {
+		_u.SetFailureCount(*v)
+	}
+	return _u
+}
+
+// AddFailureCount adds value to the "failure_count" field.
+func (_u *LearningUpdateOne) AddFailureCount(v int) *LearningUpdateOne {
+	_u.mutation.AddFailureCount(v)
+	return _u
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (_u *LearningUpdateOne) SetReviewStatus(v learning.ReviewStatus) *LearningUpdateOne {
+	_u.mutation.SetReviewStatus(v)
+	return _u
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_u *LearningUpdateOne) SetNillableReviewStatus(v *learning.ReviewStatus) *LearningUpdateOne {
+	if v != nil {
+		_u.SetReviewStatus(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *LearningUpdateOne) SetUpdatedAt(v time.Time) *LearningUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -589,6 +673,11 @@ func (_u *LearningUpdateOne) check() error {
 			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Learning.category": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReviewStatus(); ok {
+		if err := learning.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "Learning.review_status": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -674,6 +763,15 @@ func (_u *LearningUpdateOne) sqlSave(ctx context.Context) (_node *Learning, err
 	if value, ok := _u.mutation.AddedConfidence(); ok {
 		_spec.AddField(learning.FieldConfidence, field.TypeFloat64, value)
 	}
+	if value, ok := _u.mutation.FailureCount(); ok {
+		_spec.SetField(learning.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailureCount(); ok {
+		_spec.AddField(learning.FieldFailureCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReviewStatus(); ok {
+		_spec.SetField(learning.FieldReviewStatus, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(learning.FieldUpdatedAt, field.TypeTime, value)
 	}
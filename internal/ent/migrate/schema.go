@@ -8,11 +8,40 @@ import (
 )
 
 var (
+	// ApprovalGrantsColumns holds the columns for the "approval_grants" table.
+	ApprovalGrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "tool_name", Type: field.TypeString},
+		{Name: "scope", Type: field.TypeEnum, Enums: []string{"session", "user", "global"}, Default: "session"},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "session_key", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// ApprovalGrantsTable holds the schema information for the "approval_grants" table.
+	ApprovalGrantsTable = &schema.Table{
+		Name:       "approval_grants",
+		Columns:    ApprovalGrantsColumns,
+		PrimaryKey: []*schema.Column{ApprovalGrantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "approvalgrant_tool_name_scope_subject",
+				Unique:  false,
+				Columns: []*schema.Column{ApprovalGrantsColumns[1], ApprovalGrantsColumns[2], ApprovalGrantsColumns[3]},
+			},
+			{
+				Name:    "approvalgrant_session_key",
+				Unique:  false,
+				Columns: []*schema.Column{ApprovalGrantsColumns[5]},
+			},
+		},
+	}
 	// AuditLogsColumns holds the columns for the "audit_logs" table.
 	AuditLogsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "session_key", Type: field.TypeString, Nullable: true},
-		{Name: "action", Type: field.TypeEnum, Enums: []string{"tool_call", "knowledge_save", "learning_save", "skill_create", "skill_execute", "skill_import", "skill_import_bulk", "knowledge_search", "approval_request", "approval_response"}},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"tool_call", "knowledge_save", "learning_save", "skill_create", "skill_execute", "skill_import", "skill_import_bulk", "knowledge_search", "approval_request", "approval_response", "approval_revoke", "secret_access"}},
 		{Name: "actor", Type: field.TypeString},
 		{Name: "target", Type: field.TypeString, Nullable: true},
 		{Name: "details", Type: field.TypeJSON, Nullable: true},
@@ -163,6 +192,37 @@ var (
 			},
 		},
 	}
+	// FileSnapshotsColumns holds the columns for the "file_snapshots" table.
+	FileSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "session_key", Type: field.TypeString, Nullable: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"write", "edit", "delete"}},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"none", "file", "dir", "link"}},
+		{Name: "content", Type: field.TypeBytes, Nullable: true},
+		{Name: "mode", Type: field.TypeUint32, Default: 0},
+		{Name: "after_hash", Type: field.TypeString, Nullable: true},
+		{Name: "undone", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// FileSnapshotsTable holds the schema information for the "file_snapshots" table.
+	FileSnapshotsTable = &schema.Table{
+		Name:       "file_snapshots",
+		Columns:    FileSnapshotsColumns,
+		PrimaryKey: []*schema.Column{FileSnapshotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filesnapshot_session_key_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{FileSnapshotsColumns[1], FileSnapshotsColumns[9]},
+			},
+			{
+				Name:    "filesnapshot_path",
+				Unique:  false,
+				Columns: []*schema.Column{FileSnapshotsColumns[2]},
+			},
+		},
+	}
 	// InquiriesColumns holds the columns for the "inquiries" table.
 	InquiriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -170,6 +230,7 @@ var (
 		{Name: "topic", Type: field.TypeString},
 		{Name: "question", Type: field.TypeString, Size: 2147483647},
 		{Name: "context", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
 		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "medium", "high"}, Default: "medium"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "resolved", "dismissed"}, Default: "pending"},
 		{Name: "answer", Type: field.TypeString, Nullable: true, Size: 2147483647},
@@ -187,12 +248,12 @@ var (
 			{
 				Name:    "inquiry_session_key_status",
 				Unique:  false,
-				Columns: []*schema.Column{InquiriesColumns[1], InquiriesColumns[6]},
+				Columns: []*schema.Column{InquiriesColumns[1], InquiriesColumns[7]},
 			},
 			{
 				Name:    "inquiry_status",
 				Unique:  false,
-				Columns: []*schema.Column{InquiriesColumns[6]},
+				Columns: []*schema.Column{InquiriesColumns[7]},
 			},
 		},
 	}
@@ -249,6 +310,34 @@ var (
 			},
 		},
 	}
+	// KnowledgeRevisionsColumns holds the columns for the "knowledge_revisions" table.
+	KnowledgeRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "knowledge_key", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete", "rollback"}},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"rule", "definition", "preference", "fact", "pattern", "correction"}},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "source", Type: field.TypeString, Nullable: true},
+		{Name: "author", Type: field.TypeEnum, Enums: []string{"agent", "user", "librarian"}, Default: "agent"},
+		{Name: "session_key", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// KnowledgeRevisionsTable holds the schema information for the "knowledge_revisions" table.
+	KnowledgeRevisionsTable = &schema.Table{
+		Name:       "knowledge_revisions",
+		Columns:    KnowledgeRevisionsColumns,
+		PrimaryKey: []*schema.Column{KnowledgeRevisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "knowledgerevision_knowledge_key_version",
+				Unique:  true,
+				Columns: []*schema.Column{KnowledgeRevisionsColumns[1], KnowledgeRevisionsColumns[2]},
+			},
+		},
+	}
 	// LearningsColumns holds the columns for the "learnings" table.
 	LearningsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -261,6 +350,8 @@ var (
 		{Name: "occurrence_count", Type: field.TypeInt, Default: 1},
 		{Name: "success_count", Type: field.TypeInt, Default: 0},
 		{Name: "confidence", Type: field.TypeFloat64, Default: 0.5},
+		{Name: "failure_count", Type: field.TypeInt, Default: 0},
+		{Name: "review_status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "approved"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -280,6 +371,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{LearningsColumns[9]},
 			},
+			{
+				Name:    "learning_review_status",
+				Unique:  false,
+				Columns: []*schema.Column{LearningsColumns[11]},
+			},
 		},
 	}
 	// MessagesColumns holds the columns for the "messages" table.
@@ -314,6 +410,7 @@ var (
 		{Name: "token_count", Type: field.TypeInt, Default: 0},
 		{Name: "source_start_index", Type: field.TypeInt, Default: 0},
 		{Name: "source_end_index", Type: field.TypeInt, Default: 0},
+		{Name: "pinned", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// ObservationsTable holds the schema information for the "observations" table.
@@ -330,6 +427,11 @@ var (
 			{
 				Name:    "observation_created_at",
 				Unique:  false,
+				Columns: []*schema.Column{ObservationsColumns[7]},
+			},
+			{
+				Name:    "observation_pinned",
+				Unique:  false,
 				Columns: []*schema.Column{ObservationsColumns[6]},
 			},
 		},
@@ -450,6 +552,12 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "access_count", Type: field.TypeInt, Default: 0},
+		{Name: "version", Type: field.TypeInt, Default: 1},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "rotation_interval", Type: field.TypeInt64, Nullable: true},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expiry_notified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "key_secrets", Type: field.TypeUUID},
 	}
 	// SecretsTable holds the schema information for the "secrets" table.
@@ -460,7 +568,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "secrets_keys_secrets",
-				Columns:    []*schema.Column{SecretsColumns[6]},
+				Columns:    []*schema.Column{SecretsColumns[12]},
 				RefColumns: []*schema.Column{KeysColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -476,6 +584,34 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SecretsColumns[3]},
 			},
+			{
+				Name:    "secret_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecretsColumns[8]},
+			},
+		},
+	}
+	// SecretVersionsColumns holds the columns for the "secret_versions" table.
+	SecretVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "secret_name", Type: field.TypeString},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "encrypted_value", Type: field.TypeBytes},
+		{Name: "key_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "retired_at", Type: field.TypeTime},
+	}
+	// SecretVersionsTable holds the schema information for the "secret_versions" table.
+	SecretVersionsTable = &schema.Table{
+		Name:       "secret_versions",
+		Columns:    SecretVersionsColumns,
+		PrimaryKey: []*schema.Column{SecretVersionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "secretversion_secret_name_version",
+				Unique:  true,
+				Columns: []*schema.Column{SecretVersionsColumns[1], SecretVersionsColumns[2]},
+			},
 		},
 	}
 	// SessionsColumns holds the columns for the "sessions" table.
@@ -503,6 +639,41 @@ var (
 			},
 		},
 	}
+	// UsageRecordsColumns holds the columns for the "usage_records" table.
+	UsageRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "channel", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "session_key", Type: field.TypeString, Nullable: true},
+		{Name: "model", Type: field.TypeString, Nullable: true},
+		{Name: "input_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "output_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsageRecordsTable holds the schema information for the "usage_records" table.
+	UsageRecordsTable = &schema.Table{
+		Name:       "usage_records",
+		Columns:    UsageRecordsColumns,
+		PrimaryKey: []*schema.Column{UsageRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usagerecord_channel_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[1], UsageRecordsColumns[2], UsageRecordsColumns[8]},
+			},
+			{
+				Name:    "usagerecord_channel_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[1], UsageRecordsColumns[8]},
+			},
+			{
+				Name:    "usagerecord_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsageRecordsColumns[8]},
+			},
+		},
+	}
 	// WorkflowRunsColumns holds the columns for the "workflow_runs" table.
 	WorkflowRunsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -576,14 +747,17 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		ApprovalGrantsTable,
 		AuditLogsTable,
 		ConfigProfilesTable,
 		CronJobsTable,
 		CronJobHistoriesTable,
 		ExternalRefsTable,
+		FileSnapshotsTable,
 		InquiriesTable,
 		KeysTable,
 		KnowledgesTable,
+		KnowledgeRevisionsTable,
 		LearningsTable,
 		MessagesTable,
 		ObservationsTable,
@@ -591,7 +765,9 @@ var (
 		PeerReputationsTable,
 		ReflectionsTable,
 		SecretsTable,
+		SecretVersionsTable,
 		SessionsTable,
+		UsageRecordsTable,
 		WorkflowRunsTable,
 		WorkflowStepRunsTable,
 	}
@@ -12,14 +12,17 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
 	"github.com/langoai/lango/internal/ent/auditlog"
 	"github.com/langoai/lango/internal/ent/configprofile"
 	"github.com/langoai/lango/internal/ent/cronjob"
 	"github.com/langoai/lango/internal/ent/cronjobhistory"
 	"github.com/langoai/lango/internal/ent/externalref"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
 	"github.com/langoai/lango/internal/ent/inquiry"
 	"github.com/langoai/lango/internal/ent/key"
 	"github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
 	"github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/ent/message"
 	"github.com/langoai/lango/internal/ent/observation"
@@ -29,7 +32,9 @@ import (
 	"github.com/langoai/lango/internal/ent/reflection"
 	"github.com/langoai/lango/internal/ent/schema"
 	"github.com/langoai/lango/internal/ent/secret"
+	"github.com/langoai/lango/internal/ent/secretversion"
 	"github.com/langoai/lango/internal/ent/session"
+	"github.com/langoai/lango/internal/ent/usagerecord"
 	"github.com/langoai/lango/internal/ent/workflowrun"
 	"github.com/langoai/lango/internal/ent/workflowsteprun"
 )
@@ -43,55 +48,61 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuditLog        = "AuditLog"
-	TypeConfigProfile   = "ConfigProfile"
-	TypeCronJob         = "CronJob"
-	TypeCronJobHistory  = "CronJobHistory"
-	TypeExternalRef     = "ExternalRef"
-	TypeInquiry         = "Inquiry"
-	TypeKey             = "Key"
-	TypeKnowledge       = "Knowledge"
-	TypeLearning        = "Learning"
-	TypeMessage         = "Message"
-	TypeObservation     = "Observation"
-	TypePaymentTx       = "PaymentTx"
-	TypePeerReputation  = "PeerReputation"
-	TypeReflection      = "Reflection"
-	TypeSecret          = "Secret"
-	TypeSession         = "Session"
-	TypeWorkflowRun     = "WorkflowRun"
-	TypeWorkflowStepRun = "WorkflowStepRun"
+	TypeApprovalGrant     = "ApprovalGrant"
+	TypeAuditLog          = "AuditLog"
+	TypeConfigProfile     = "ConfigProfile"
+	TypeCronJob           = "CronJob"
+	TypeCronJobHistory    = "CronJobHistory"
+	TypeExternalRef       = "ExternalRef"
+	TypeFileSnapshot      = "FileSnapshot"
+	TypeInquiry           = "Inquiry"
+	TypeKey               = "Key"
+	TypeKnowledge         = "Knowledge"
+	TypeKnowledgeRevision = "KnowledgeRevision"
+	TypeLearning          = "Learning"
+	TypeMessage           = "Message"
+	TypeObservation       = "Observation"
+	TypePaymentTx         = "PaymentTx"
+	TypePeerReputation    = "PeerReputation"
+	TypeReflection        = "Reflection"
+	TypeSecret            = "Secret"
+	TypeSecretVersion     = "SecretVersion"
+	TypeSession           = "Session"
+	TypeUsageRecord       = "UsageRecord"
+	TypeWorkflowRun       = "WorkflowRun"
+	TypeWorkflowStepRun   = "WorkflowStepRun"
 )
 
-// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
-type AuditLogMutation struct {
+// ApprovalGrantMutation represents an operation that mutates the ApprovalGrant nodes in the graph.
+type ApprovalGrantMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
+	tool_name     *string
+	scope         *approvalgrant.Scope
+	subject       *string
+	channel       *string
 	session_key   *string
-	action        *auditlog.Action
-	actor         *string
-	target        *string
-	details       *map[string]interface{}
-	timestamp     *time.Time
+	created_at    *time.Time
+	expires_at    *time.Time
 	clearedFields map[string]struct{}
 	done          bool
-	oldValue      func(context.Context) (*AuditLog, error)
-	predicates    []predicate.AuditLog
+	oldValue      func(context.Context) (*ApprovalGrant, error)
+	predicates    []predicate.ApprovalGrant
 }
 
-var _ ent.Mutation = (*AuditLogMutation)(nil)
+var _ ent.Mutation = (*ApprovalGrantMutation)(nil)
 
-// auditlogOption allows management of the mutation configuration using functional options.
-type auditlogOption func(*AuditLogMutation)
+// approvalgrantOption allows management of the mutation configuration using functional options.
+type approvalgrantOption func(*ApprovalGrantMutation)
 
-// newAuditLogMutation creates new mutation for the AuditLog entity.
-func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
-	m := &AuditLogMutation{
+// newApprovalGrantMutation creates new mutation for the ApprovalGrant entity.
+func newApprovalGrantMutation(c config, op Op, opts ...approvalgrantOption) *ApprovalGrantMutation {
+	m := &ApprovalGrantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuditLog,
+		typ:           TypeApprovalGrant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -100,20 +111,20 @@ func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutat
 	return m
 }
 
-// withAuditLogID sets the ID field of the mutation.
-func withAuditLogID(id uuid.UUID) auditlogOption {
-	return func(m *AuditLogMutation) {
+// withApprovalGrantID sets the ID field of the mutation.
+func withApprovalGrantID(id uuid.UUID) approvalgrantOption {
+	return func(m *ApprovalGrantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuditLog
+			value *ApprovalGrant
 		)
-		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+		m.oldValue = func(ctx context.Context) (*ApprovalGrant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuditLog.Get(ctx, id)
+					value, err = m.Client().ApprovalGrant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -122,10 +133,10 @@ func withAuditLogID(id uuid.UUID) auditlogOption {
 	}
 }
 
-// withAuditLog sets the old AuditLog of the mutation.
-func withAuditLog(node *AuditLog) auditlogOption {
-	return func(m *AuditLogMutation) {
-		m.oldValue = func(context.Context) (*AuditLog, error) {
+// withApprovalGrant sets the old ApprovalGrant of the mutation.
+func withApprovalGrant(node *ApprovalGrant) approvalgrantOption {
+	return func(m *ApprovalGrantMutation) {
+		m.oldValue = func(context.Context) (*ApprovalGrant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -134,7 +145,7 @@ func withAuditLog(node *AuditLog) auditlogOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuditLogMutation) Client() *Client {
+func (m ApprovalGrantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -142,7 +153,7 @@ func (m AuditLogMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuditLogMutation) Tx() (*Tx, error) {
+func (m ApprovalGrantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -152,14 +163,14 @@ func (m AuditLogMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuditLog entities.
-func (m *AuditLogMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ApprovalGrant entities.
+func (m *ApprovalGrantMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuditLogMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ApprovalGrantMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -170,7 +181,7 @@ func (m *AuditLogMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ApprovalGrantMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -179,276 +190,312 @@ func (m *AuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ApprovalGrant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSessionKey sets the "session_key" field.
-func (m *AuditLogMutation) SetSessionKey(s string) {
-	m.session_key = &s
+// SetToolName sets the "tool_name" field.
+func (m *ApprovalGrantMutation) SetToolName(s string) {
+	m.tool_name = &s
 }
 
-// SessionKey returns the value of the "session_key" field in the mutation.
-func (m *AuditLogMutation) SessionKey() (r string, exists bool) {
-	v := m.session_key
+// ToolName returns the value of the "tool_name" field in the mutation.
+func (m *ApprovalGrantMutation) ToolName() (r string, exists bool) {
+	v := m.tool_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionKey returns the old "session_key" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldToolName returns the old "tool_name" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+func (m *ApprovalGrantMutation) OldToolName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldToolName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionKey requires an ID field in the mutation")
+		return v, errors.New("OldToolName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldToolName: %w", err)
 	}
-	return oldValue.SessionKey, nil
+	return oldValue.ToolName, nil
 }
 
-// ClearSessionKey clears the value of the "session_key" field.
-func (m *AuditLogMutation) ClearSessionKey() {
-	m.session_key = nil
-	m.clearedFields[auditlog.FieldSessionKey] = struct{}{}
+// ResetToolName resets all changes to the "tool_name" field.
+func (m *ApprovalGrantMutation) ResetToolName() {
+	m.tool_name = nil
 }
 
-// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
-func (m *AuditLogMutation) SessionKeyCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldSessionKey]
-	return ok
+// SetScope sets the "scope" field.
+func (m *ApprovalGrantMutation) SetScope(a approvalgrant.Scope) {
+	m.scope = &a
 }
 
-// ResetSessionKey resets all changes to the "session_key" field.
-func (m *AuditLogMutation) ResetSessionKey() {
-	m.session_key = nil
-	delete(m.clearedFields, auditlog.FieldSessionKey)
+// Scope returns the value of the "scope" field in the mutation.
+func (m *ApprovalGrantMutation) Scope() (r approvalgrant.Scope, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetAction sets the "action" field.
-func (m *AuditLogMutation) SetAction(a auditlog.Action) {
-	m.action = &a
+// OldScope returns the old "scope" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ApprovalGrantMutation) OldScope(ctx context.Context) (v approvalgrant.Scope, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *AuditLogMutation) Action() (r auditlog.Action, exists bool) {
-	v := m.action
+// ResetScope resets all changes to the "scope" field.
+func (m *ApprovalGrantMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetSubject sets the "subject" field.
+func (m *ApprovalGrantMutation) SetSubject(s string) {
+	m.subject = &s
+}
+
+// Subject returns the value of the "subject" field in the mutation.
+func (m *ApprovalGrantMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldAction(ctx context.Context) (v auditlog.Action, err error) {
+func (m *ApprovalGrantMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.Subject, nil
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *AuditLogMutation) ResetAction() {
-	m.action = nil
+// ClearSubject clears the value of the "subject" field.
+func (m *ApprovalGrantMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[approvalgrant.FieldSubject] = struct{}{}
 }
 
-// SetActor sets the "actor" field.
-func (m *AuditLogMutation) SetActor(s string) {
-	m.actor = &s
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *ApprovalGrantMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[approvalgrant.FieldSubject]
+	return ok
 }
 
-// Actor returns the value of the "actor" field in the mutation.
-func (m *AuditLogMutation) Actor() (r string, exists bool) {
-	v := m.actor
+// ResetSubject resets all changes to the "subject" field.
+func (m *ApprovalGrantMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, approvalgrant.FieldSubject)
+}
+
+// SetChannel sets the "channel" field.
+func (m *ApprovalGrantMutation) SetChannel(s string) {
+	m.channel = &s
+}
+
+// Channel returns the value of the "channel" field in the mutation.
+func (m *ApprovalGrantMutation) Channel() (r string, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActor returns the old "actor" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldChannel returns the old "channel" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
+func (m *ApprovalGrantMutation) OldChannel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActor requires an ID field in the mutation")
+		return v, errors.New("OldChannel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
 	}
-	return oldValue.Actor, nil
+	return oldValue.Channel, nil
 }
 
-// ResetActor resets all changes to the "actor" field.
-func (m *AuditLogMutation) ResetActor() {
-	m.actor = nil
+// ResetChannel resets all changes to the "channel" field.
+func (m *ApprovalGrantMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// SetTarget sets the "target" field.
-func (m *AuditLogMutation) SetTarget(s string) {
-	m.target = &s
+// SetSessionKey sets the "session_key" field.
+func (m *ApprovalGrantMutation) SetSessionKey(s string) {
+	m.session_key = &s
 }
 
-// Target returns the value of the "target" field in the mutation.
-func (m *AuditLogMutation) Target() (r string, exists bool) {
-	v := m.target
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *ApprovalGrantMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTarget returns the old "target" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldTarget(ctx context.Context) (v string, err error) {
+func (m *ApprovalGrantMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTarget requires an ID field in the mutation")
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
 	}
-	return oldValue.Target, nil
+	return oldValue.SessionKey, nil
 }
 
-// ClearTarget clears the value of the "target" field.
-func (m *AuditLogMutation) ClearTarget() {
-	m.target = nil
-	m.clearedFields[auditlog.FieldTarget] = struct{}{}
+// ClearSessionKey clears the value of the "session_key" field.
+func (m *ApprovalGrantMutation) ClearSessionKey() {
+	m.session_key = nil
+	m.clearedFields[approvalgrant.FieldSessionKey] = struct{}{}
 }
 
-// TargetCleared returns if the "target" field was cleared in this mutation.
-func (m *AuditLogMutation) TargetCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldTarget]
+// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
+func (m *ApprovalGrantMutation) SessionKeyCleared() bool {
+	_, ok := m.clearedFields[approvalgrant.FieldSessionKey]
 	return ok
 }
 
-// ResetTarget resets all changes to the "target" field.
-func (m *AuditLogMutation) ResetTarget() {
-	m.target = nil
-	delete(m.clearedFields, auditlog.FieldTarget)
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *ApprovalGrantMutation) ResetSessionKey() {
+	m.session_key = nil
+	delete(m.clearedFields, approvalgrant.FieldSessionKey)
 }
 
-// SetDetails sets the "details" field.
-func (m *AuditLogMutation) SetDetails(value map[string]interface{}) {
-	m.details = &value
+// SetCreatedAt sets the "created_at" field.
+func (m *ApprovalGrantMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Details returns the value of the "details" field in the mutation.
-func (m *AuditLogMutation) Details() (r map[string]interface{}, exists bool) {
-	v := m.details
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ApprovalGrantMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDetails returns the old "details" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ApprovalGrantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDetails requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Details, nil
-}
-
-// ClearDetails clears the value of the "details" field.
-func (m *AuditLogMutation) ClearDetails() {
-	m.details = nil
-	m.clearedFields[auditlog.FieldDetails] = struct{}{}
-}
-
-// DetailsCleared returns if the "details" field was cleared in this mutation.
-func (m *AuditLogMutation) DetailsCleared() bool {
-	_, ok := m.clearedFields[auditlog.FieldDetails]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDetails resets all changes to the "details" field.
-func (m *AuditLogMutation) ResetDetails() {
-	m.details = nil
-	delete(m.clearedFields, auditlog.FieldDetails)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ApprovalGrantMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *AuditLogMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *ApprovalGrantMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *AuditLogMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ApprovalGrantMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the AuditLog entity.
-// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the ApprovalGrant entity.
+// If the ApprovalGrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuditLogMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *ApprovalGrantMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *AuditLogMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ApprovalGrantMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[approvalgrant.FieldExpiresAt] = struct{}{}
 }
 
-// Where appends a list predicates to the AuditLogMutation builder.
-func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ApprovalGrantMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[approvalgrant.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ApprovalGrantMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, approvalgrant.FieldExpiresAt)
+}
+
+// Where appends a list predicates to the ApprovalGrantMutation builder.
+func (m *ApprovalGrantMutation) Where(ps ...predicate.ApprovalGrant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ApprovalGrantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuditLog, len(ps))
+func (m *ApprovalGrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ApprovalGrant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -456,42 +503,45 @@ func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuditLogMutation) Op() Op {
+func (m *ApprovalGrantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuditLogMutation) SetOp(op Op) {
+func (m *ApprovalGrantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuditLog).
-func (m *AuditLogMutation) Type() string {
+// Type returns the node type of this mutation (ApprovalGrant).
+func (m *ApprovalGrantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuditLogMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.session_key != nil {
-		fields = append(fields, auditlog.FieldSessionKey)
+func (m *ApprovalGrantMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.tool_name != nil {
+		fields = append(fields, approvalgrant.FieldToolName)
 	}
-	if m.action != nil {
-		fields = append(fields, auditlog.FieldAction)
+	if m.scope != nil {
+		fields = append(fields, approvalgrant.FieldScope)
 	}
-	if m.actor != nil {
-		fields = append(fields, auditlog.FieldActor)
+	if m.subject != nil {
+		fields = append(fields, approvalgrant.FieldSubject)
 	}
-	if m.target != nil {
-		fields = append(fields, auditlog.FieldTarget)
+	if m.channel != nil {
+		fields = append(fields, approvalgrant.FieldChannel)
 	}
-	if m.details != nil {
-		fields = append(fields, auditlog.FieldDetails)
+	if m.session_key != nil {
+		fields = append(fields, approvalgrant.FieldSessionKey)
 	}
-	if m.timestamp != nil {
-		fields = append(fields, auditlog.FieldTimestamp)
+	if m.created_at != nil {
+		fields = append(fields, approvalgrant.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, approvalgrant.FieldExpiresAt)
 	}
 	return fields
 }
@@ -499,20 +549,22 @@ func (m *AuditLogMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
+func (m *ApprovalGrantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case auditlog.FieldSessionKey:
+	case approvalgrant.FieldToolName:
+		return m.ToolName()
+	case approvalgrant.FieldScope:
+		return m.Scope()
+	case approvalgrant.FieldSubject:
+		return m.Subject()
+	case approvalgrant.FieldChannel:
+		return m.Channel()
+	case approvalgrant.FieldSessionKey:
 		return m.SessionKey()
-	case auditlog.FieldAction:
-		return m.Action()
-	case auditlog.FieldActor:
-		return m.Actor()
-	case auditlog.FieldTarget:
-		return m.Target()
-	case auditlog.FieldDetails:
-		return m.Details()
-	case auditlog.FieldTimestamp:
-		return m.Timestamp()
+	case approvalgrant.FieldCreatedAt:
+		return m.CreatedAt()
+	case approvalgrant.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -520,241 +572,252 @@ func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ApprovalGrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case auditlog.FieldSessionKey:
+	case approvalgrant.FieldToolName:
+		return m.OldToolName(ctx)
+	case approvalgrant.FieldScope:
+		return m.OldScope(ctx)
+	case approvalgrant.FieldSubject:
+		return m.OldSubject(ctx)
+	case approvalgrant.FieldChannel:
+		return m.OldChannel(ctx)
+	case approvalgrant.FieldSessionKey:
 		return m.OldSessionKey(ctx)
-	case auditlog.FieldAction:
-		return m.OldAction(ctx)
-	case auditlog.FieldActor:
-		return m.OldActor(ctx)
-	case auditlog.FieldTarget:
-		return m.OldTarget(ctx)
-	case auditlog.FieldDetails:
-		return m.OldDetails(ctx)
-	case auditlog.FieldTimestamp:
-		return m.OldTimestamp(ctx)
+	case approvalgrant.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case approvalgrant.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuditLog field %s", name)
+	return nil, fmt.Errorf("unknown ApprovalGrant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
+func (m *ApprovalGrantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case auditlog.FieldSessionKey:
+	case approvalgrant.FieldToolName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionKey(v)
+		m.SetToolName(v)
 		return nil
-	case auditlog.FieldAction:
-		v, ok := value.(auditlog.Action)
+	case approvalgrant.FieldScope:
+		v, ok := value.(approvalgrant.Scope)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAction(v)
+		m.SetScope(v)
 		return nil
-	case auditlog.FieldActor:
+	case approvalgrant.FieldSubject:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActor(v)
+		m.SetSubject(v)
 		return nil
-	case auditlog.FieldTarget:
+	case approvalgrant.FieldChannel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTarget(v)
+		m.SetChannel(v)
 		return nil
-	case auditlog.FieldDetails:
-		v, ok := value.(map[string]interface{})
+	case approvalgrant.FieldSessionKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDetails(v)
+		m.SetSessionKey(v)
 		return nil
-	case auditlog.FieldTimestamp:
+	case approvalgrant.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetCreatedAt(v)
+		return nil
+	case approvalgrant.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog field %s", name)
+	return fmt.Errorf("unknown ApprovalGrant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuditLogMutation) AddedFields() []string {
+func (m *ApprovalGrantMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ApprovalGrantMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+func (m *ApprovalGrantMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+	return fmt.Errorf("unknown ApprovalGrant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuditLogMutation) ClearedFields() []string {
+func (m *ApprovalGrantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(auditlog.FieldSessionKey) {
-		fields = append(fields, auditlog.FieldSessionKey)
+	if m.FieldCleared(approvalgrant.FieldSubject) {
+		fields = append(fields, approvalgrant.FieldSubject)
 	}
-	if m.FieldCleared(auditlog.FieldTarget) {
-		fields = append(fields, auditlog.FieldTarget)
+	if m.FieldCleared(approvalgrant.FieldSessionKey) {
+		fields = append(fields, approvalgrant.FieldSessionKey)
 	}
-	if m.FieldCleared(auditlog.FieldDetails) {
-		fields = append(fields, auditlog.FieldDetails)
+	if m.FieldCleared(approvalgrant.FieldExpiresAt) {
+		fields = append(fields, approvalgrant.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuditLogMutation) FieldCleared(name string) bool {
+func (m *ApprovalGrantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuditLogMutation) ClearField(name string) error {
+func (m *ApprovalGrantMutation) ClearField(name string) error {
 	switch name {
-	case auditlog.FieldSessionKey:
-		m.ClearSessionKey()
+	case approvalgrant.FieldSubject:
+		m.ClearSubject()
 		return nil
-	case auditlog.FieldTarget:
-		m.ClearTarget()
+	case approvalgrant.FieldSessionKey:
+		m.ClearSessionKey()
 		return nil
-	case auditlog.FieldDetails:
-		m.ClearDetails()
+	case approvalgrant.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+	return fmt.Errorf("unknown ApprovalGrant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuditLogMutation) ResetField(name string) error {
+func (m *ApprovalGrantMutation) ResetField(name string) error {
 	switch name {
-	case auditlog.FieldSessionKey:
-		m.ResetSessionKey()
+	case approvalgrant.FieldToolName:
+		m.ResetToolName()
 		return nil
-	case auditlog.FieldAction:
-		m.ResetAction()
+	case approvalgrant.FieldScope:
+		m.ResetScope()
 		return nil
-	case auditlog.FieldActor:
-		m.ResetActor()
+	case approvalgrant.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case auditlog.FieldTarget:
-		m.ResetTarget()
+	case approvalgrant.FieldChannel:
+		m.ResetChannel()
 		return nil
-	case auditlog.FieldDetails:
-		m.ResetDetails()
+	case approvalgrant.FieldSessionKey:
+		m.ResetSessionKey()
 		return nil
-	case auditlog.FieldTimestamp:
-		m.ResetTimestamp()
+	case approvalgrant.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case approvalgrant.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AuditLog field %s", name)
+	return fmt.Errorf("unknown ApprovalGrant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuditLogMutation) AddedEdges() []string {
+func (m *ApprovalGrantMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+func (m *ApprovalGrantMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuditLogMutation) RemovedEdges() []string {
+func (m *ApprovalGrantMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+func (m *ApprovalGrantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuditLogMutation) ClearedEdges() []string {
+func (m *ApprovalGrantMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuditLogMutation) EdgeCleared(name string) bool {
+func (m *ApprovalGrantMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuditLogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+func (m *ApprovalGrantMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ApprovalGrant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuditLogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuditLog edge %s", name)
+func (m *ApprovalGrantMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ApprovalGrant edge %s", name)
 }
 
-// ConfigProfileMutation represents an operation that mutates the ConfigProfile nodes in the graph.
-type ConfigProfileMutation struct {
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	name           *string
-	encrypted_data *[]byte
-	active         *bool
-	version        *int
-	addversion     *int
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*ConfigProfile, error)
-	predicates     []predicate.ConfigProfile
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	session_key   *string
+	action        *auditlog.Action
+	actor         *string
+	target        *string
+	details       *map[string]interface{}
+	timestamp     *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditLog, error)
+	predicates    []predicate.AuditLog
 }
 
-var _ ent.Mutation = (*ConfigProfileMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// configprofileOption allows management of the mutation configuration using functional options.
-type configprofileOption func(*ConfigProfileMutation)
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
 
-// newConfigProfileMutation creates new mutation for the ConfigProfile entity.
-func newConfigProfileMutation(c config, op Op, opts ...configprofileOption) *ConfigProfileMutation {
-	m := &ConfigProfileMutation{
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeConfigProfile,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -763,20 +826,20 @@ func newConfigProfileMutation(c config, op Op, opts ...configprofileOption) *Con
 	return m
 }
 
-// withConfigProfileID sets the ID field of the mutation.
-func withConfigProfileID(id uuid.UUID) configprofileOption {
-	return func(m *ConfigProfileMutation) {
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id uuid.UUID) auditlogOption {
+	return func(m *AuditLogMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ConfigProfile
+			value *AuditLog
 		)
-		m.oldValue = func(ctx context.Context) (*ConfigProfile, error) {
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ConfigProfile.Get(ctx, id)
+					value, err = m.Client().AuditLog.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -785,10 +848,10 @@ func withConfigProfileID(id uuid.UUID) configprofileOption {
 	}
 }
 
-// withConfigProfile sets the old ConfigProfile of the mutation.
-func withConfigProfile(node *ConfigProfile) configprofileOption {
-	return func(m *ConfigProfileMutation) {
-		m.oldValue = func(context.Context) (*ConfigProfile, error) {
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -797,7 +860,7 @@ func withConfigProfile(node *ConfigProfile) configprofileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ConfigProfileMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -805,7 +868,7 @@ func (m ConfigProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ConfigProfileMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -815,14 +878,14 @@ func (m ConfigProfileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ConfigProfile entities.
-func (m *ConfigProfileMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ConfigProfileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditLogMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -833,7 +896,7 @@ func (m *ConfigProfileMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ConfigProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -842,257 +905,276 @@ func (m *ConfigProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ConfigProfile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ConfigProfileMutation) SetName(s string) {
-	m.name = &s
+// SetSessionKey sets the "session_key" field.
+func (m *AuditLogMutation) SetSessionKey(s string) {
+	m.session_key = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ConfigProfileMutation) Name() (r string, exists bool) {
-	v := m.name
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *AuditLogMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ConfigProfile entity.
-// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigProfileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditLogMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.SessionKey, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ConfigProfileMutation) ResetName() {
-	m.name = nil
+// ClearSessionKey clears the value of the "session_key" field.
+func (m *AuditLogMutation) ClearSessionKey() {
+	m.session_key = nil
+	m.clearedFields[auditlog.FieldSessionKey] = struct{}{}
 }
 
-// SetEncryptedData sets the "encrypted_data" field.
-func (m *ConfigProfileMutation) SetEncryptedData(b []byte) {
-	m.encrypted_data = &b
+// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
+func (m *AuditLogMutation) SessionKeyCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldSessionKey]
+	return ok
 }
 
-// EncryptedData returns the value of the "encrypted_data" field in the mutation.
-func (m *ConfigProfileMutation) EncryptedData() (r []byte, exists bool) {
-	v := m.encrypted_data
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *AuditLogMutation) ResetSessionKey() {
+	m.session_key = nil
+	delete(m.clearedFields, auditlog.FieldSessionKey)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(a auditlog.Action) {
+	m.action = &a
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r auditlog.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedData returns the old "encrypted_data" field's value of the ConfigProfile entity.
-// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigProfileMutation) OldEncryptedData(ctx context.Context) (v []byte, err error) {
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v auditlog.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedData is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedData requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedData: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.EncryptedData, nil
+	return oldValue.Action, nil
 }
 
-// ResetEncryptedData resets all changes to the "encrypted_data" field.
-func (m *ConfigProfileMutation) ResetEncryptedData() {
-	m.encrypted_data = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetActive sets the "active" field.
-func (m *ConfigProfileMutation) SetActive(b bool) {
-	m.active = &b
+// SetActor sets the "actor" field.
+func (m *AuditLogMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *ConfigProfileMutation) Active() (r bool, exists bool) {
-	v := m.active
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditLogMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the ConfigProfile entity.
-// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigProfileMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *AuditLogMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.Actor, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *ConfigProfileMutation) ResetActive() {
-	m.active = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditLogMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *ConfigProfileMutation) SetVersion(i int) {
-	m.version = &i
-	m.addversion = nil
+// SetTarget sets the "target" field.
+func (m *AuditLogMutation) SetTarget(s string) {
+	m.target = &s
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *ConfigProfileMutation) Version() (r int, exists bool) {
-	v := m.version
+// Target returns the value of the "target" field in the mutation.
+func (m *AuditLogMutation) Target() (r string, exists bool) {
+	v := m.target
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the ConfigProfile entity.
-// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldTarget returns the old "target" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigProfileMutation) OldVersion(ctx context.Context) (v int, err error) {
+func (m *AuditLogMutation) OldTarget(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldTarget requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.Target, nil
 }
 
-// AddVersion adds i to the "version" field.
-func (m *ConfigProfileMutation) AddVersion(i int) {
-	if m.addversion != nil {
-		*m.addversion += i
-	} else {
-		m.addversion = &i
-	}
+// ClearTarget clears the value of the "target" field.
+func (m *AuditLogMutation) ClearTarget() {
+	m.target = nil
+	m.clearedFields[auditlog.FieldTarget] = struct{}{}
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *ConfigProfileMutation) AddedVersion() (r int, exists bool) {
-	v := m.addversion
-	if v == nil {
-		return
-	}
-	return *v, true
+// TargetCleared returns if the "target" field was cleared in this mutation.
+func (m *AuditLogMutation) TargetCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTarget]
+	return ok
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *ConfigProfileMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// ResetTarget resets all changes to the "target" field.
+func (m *AuditLogMutation) ResetTarget() {
+	m.target = nil
+	delete(m.clearedFields, auditlog.FieldTarget)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ConfigProfileMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDetails sets the "details" field.
+func (m *AuditLogMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ConfigProfileMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditLogMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ConfigProfile entity.
-// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldDetails returns the old "details" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDetails requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Details, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ConfigProfileMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearDetails clears the value of the "details" field.
+func (m *AuditLogMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditlog.FieldDetails] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ConfigProfileMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditLogMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldDetails]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ConfigProfileMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditLogMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditlog.FieldDetails)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *AuditLogMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *AuditLogMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ConfigProfile entity.
-// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ConfigProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditLogMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Timestamp, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ConfigProfileMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *AuditLogMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// Where appends a list predicates to the ConfigProfileMutation builder.
-func (m *ConfigProfileMutation) Where(ps ...predicate.ConfigProfile) {
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ConfigProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ConfigProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ConfigProfile, len(ps))
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1100,42 +1182,42 @@ func (m *ConfigProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ConfigProfileMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ConfigProfileMutation) SetOp(op Op) {
+func (m *AuditLogMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ConfigProfile).
-func (m *ConfigProfileMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ConfigProfileMutation) Fields() []string {
+func (m *AuditLogMutation) Fields() []string {
 	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, configprofile.FieldName)
+	if m.session_key != nil {
+		fields = append(fields, auditlog.FieldSessionKey)
 	}
-	if m.encrypted_data != nil {
-		fields = append(fields, configprofile.FieldEncryptedData)
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
 	}
-	if m.active != nil {
-		fields = append(fields, configprofile.FieldActive)
+	if m.actor != nil {
+		fields = append(fields, auditlog.FieldActor)
 	}
-	if m.version != nil {
-		fields = append(fields, configprofile.FieldVersion)
+	if m.target != nil {
+		fields = append(fields, auditlog.FieldTarget)
 	}
-	if m.created_at != nil {
-		fields = append(fields, configprofile.FieldCreatedAt)
+	if m.details != nil {
+		fields = append(fields, auditlog.FieldDetails)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, configprofile.FieldUpdatedAt)
+	if m.timestamp != nil {
+		fields = append(fields, auditlog.FieldTimestamp)
 	}
 	return fields
 }
@@ -1143,20 +1225,20 @@ func (m *ConfigProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ConfigProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case configprofile.FieldName:
-		return m.Name()
-	case configprofile.FieldEncryptedData:
-		return m.EncryptedData()
-	case configprofile.FieldActive:
-		return m.Active()
-	case configprofile.FieldVersion:
-		return m.Version()
-	case configprofile.FieldCreatedAt:
-		return m.CreatedAt()
-	case configprofile.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case auditlog.FieldSessionKey:
+		return m.SessionKey()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldActor:
+		return m.Actor()
+	case auditlog.FieldTarget:
+		return m.Target()
+	case auditlog.FieldDetails:
+		return m.Details()
+	case auditlog.FieldTimestamp:
+		return m.Timestamp()
 	}
 	return nil, false
 }
@@ -1164,241 +1246,241 @@ func (m *ConfigProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ConfigProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case configprofile.FieldName:
-		return m.OldName(ctx)
-	case configprofile.FieldEncryptedData:
-		return m.OldEncryptedData(ctx)
-	case configprofile.FieldActive:
-		return m.OldActive(ctx)
-	case configprofile.FieldVersion:
-		return m.OldVersion(ctx)
-	case configprofile.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case configprofile.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case auditlog.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldActor:
+		return m.OldActor(ctx)
+	case auditlog.FieldTarget:
+		return m.OldTarget(ctx)
+	case auditlog.FieldDetails:
+		return m.OldDetails(ctx)
+	case auditlog.FieldTimestamp:
+		return m.OldTimestamp(ctx)
 	}
-	return nil, fmt.Errorf("unknown ConfigProfile field %s", name)
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigProfileMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case configprofile.FieldName:
+	case auditlog.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSessionKey(v)
 		return nil
-	case configprofile.FieldEncryptedData:
-		v, ok := value.([]byte)
+	case auditlog.FieldAction:
+		v, ok := value.(auditlog.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptedData(v)
+		m.SetAction(v)
 		return nil
-	case configprofile.FieldActive:
-		v, ok := value.(bool)
+	case auditlog.FieldActor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetActor(v)
 		return nil
-	case configprofile.FieldVersion:
-		v, ok := value.(int)
+	case auditlog.FieldTarget:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetTarget(v)
 		return nil
-	case configprofile.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldDetails:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetDetails(v)
 		return nil
-	case configprofile.FieldUpdatedAt:
+	case auditlog.FieldTimestamp:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigProfile field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ConfigProfileMutation) AddedFields() []string {
-	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, configprofile.FieldVersion)
-	}
-	return fields
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ConfigProfileMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case configprofile.FieldVersion:
-		return m.AddedVersion()
-	}
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ConfigProfileMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case configprofile.FieldVersion:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVersion(v)
-		return nil
 	}
-	return fmt.Errorf("unknown ConfigProfile numeric field %s", name)
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ConfigProfileMutation) ClearedFields() []string {
-	return nil
+func (m *AuditLogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditlog.FieldSessionKey) {
+		fields = append(fields, auditlog.FieldSessionKey)
+	}
+	if m.FieldCleared(auditlog.FieldTarget) {
+		fields = append(fields, auditlog.FieldTarget)
+	}
+	if m.FieldCleared(auditlog.FieldDetails) {
+		fields = append(fields, auditlog.FieldDetails)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ConfigProfileMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ConfigProfileMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ConfigProfile nullable field %s", name)
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldSessionKey:
+		m.ClearSessionKey()
+		return nil
+	case auditlog.FieldTarget:
+		m.ClearTarget()
+		return nil
+	case auditlog.FieldDetails:
+		m.ClearDetails()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ConfigProfileMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case configprofile.FieldName:
-		m.ResetName()
+	case auditlog.FieldSessionKey:
+		m.ResetSessionKey()
 		return nil
-	case configprofile.FieldEncryptedData:
-		m.ResetEncryptedData()
+	case auditlog.FieldAction:
+		m.ResetAction()
 		return nil
-	case configprofile.FieldActive:
-		m.ResetActive()
+	case auditlog.FieldActor:
+		m.ResetActor()
 		return nil
-	case configprofile.FieldVersion:
-		m.ResetVersion()
+	case auditlog.FieldTarget:
+		m.ResetTarget()
 		return nil
-	case configprofile.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case auditlog.FieldDetails:
+		m.ResetDetails()
 		return nil
-	case configprofile.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case auditlog.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown ConfigProfile field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ConfigProfileMutation) AddedEdges() []string {
+func (m *AuditLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ConfigProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ConfigProfileMutation) RemovedEdges() []string {
+func (m *AuditLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ConfigProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ConfigProfileMutation) ClearedEdges() []string {
+func (m *AuditLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ConfigProfileMutation) EdgeCleared(name string) bool {
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ConfigProfileMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ConfigProfile unique edge %s", name)
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ConfigProfileMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ConfigProfile edge %s", name)
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// CronJobMutation represents an operation that mutates the CronJob nodes in the graph.
-type CronJobMutation struct {
+// ConfigProfileMutation represents an operation that mutates the ConfigProfile nodes in the graph.
+type ConfigProfileMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	name             *string
-	schedule_type    *cronjob.ScheduleType
-	schedule         *string
-	prompt           *string
-	session_mode     *string
-	deliver_to       *[]string
-	appenddeliver_to []string
-	timezone         *string
-	enabled          *bool
-	last_run_at      *time.Time
-	next_run_at      *time.Time
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	done             bool
-	oldValue         func(context.Context) (*CronJob, error)
-	predicates       []predicate.CronJob
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	name           *string
+	encrypted_data *[]byte
+	active         *bool
+	version        *int
+	addversion     *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*ConfigProfile, error)
+	predicates     []predicate.ConfigProfile
 }
 
-var _ ent.Mutation = (*CronJobMutation)(nil)
+var _ ent.Mutation = (*ConfigProfileMutation)(nil)
 
-// cronjobOption allows management of the mutation configuration using functional options.
-type cronjobOption func(*CronJobMutation)
+// configprofileOption allows management of the mutation configuration using functional options.
+type configprofileOption func(*ConfigProfileMutation)
 
-// newCronJobMutation creates new mutation for the CronJob entity.
-func newCronJobMutation(c config, op Op, opts ...cronjobOption) *CronJobMutation {
-	m := &CronJobMutation{
+// newConfigProfileMutation creates new mutation for the ConfigProfile entity.
+func newConfigProfileMutation(c config, op Op, opts ...configprofileOption) *ConfigProfileMutation {
+	m := &ConfigProfileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCronJob,
+		typ:           TypeConfigProfile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1407,20 +1489,20 @@ func newCronJobMutation(c config, op Op, opts ...cronjobOption) *CronJobMutation
 	return m
 }
 
-// withCronJobID sets the ID field of the mutation.
-func withCronJobID(id uuid.UUID) cronjobOption {
-	return func(m *CronJobMutation) {
+// withConfigProfileID sets the ID field of the mutation.
+func withConfigProfileID(id uuid.UUID) configprofileOption {
+	return func(m *ConfigProfileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CronJob
+			value *ConfigProfile
 		)
-		m.oldValue = func(ctx context.Context) (*CronJob, error) {
+		m.oldValue = func(ctx context.Context) (*ConfigProfile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CronJob.Get(ctx, id)
+					value, err = m.Client().ConfigProfile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1429,10 +1511,10 @@ func withCronJobID(id uuid.UUID) cronjobOption {
 	}
 }
 
-// withCronJob sets the old CronJob of the mutation.
-func withCronJob(node *CronJob) cronjobOption {
-	return func(m *CronJobMutation) {
-		m.oldValue = func(context.Context) (*CronJob, error) {
+// withConfigProfile sets the old ConfigProfile of the mutation.
+func withConfigProfile(node *ConfigProfile) configprofileOption {
+	return func(m *ConfigProfileMutation) {
+		m.oldValue = func(context.Context) (*ConfigProfile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1441,7 +1523,7 @@ func withCronJob(node *CronJob) cronjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CronJobMutation) Client() *Client {
+func (m ConfigProfileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1449,7 +1531,7 @@ func (m CronJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CronJobMutation) Tx() (*Tx, error) {
+func (m ConfigProfileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1459,14 +1541,14 @@ func (m CronJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CronJob entities.
-func (m *CronJobMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConfigProfile entities.
+func (m *ConfigProfileMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CronJobMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConfigProfileMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1477,7 +1559,7 @@ func (m *CronJobMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CronJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConfigProfileMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1486,19 +1568,19 @@ func (m *CronJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CronJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConfigProfile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *CronJobMutation) SetName(s string) {
+func (m *ConfigProfileMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *CronJobMutation) Name() (r string, exists bool) {
+func (m *ConfigProfileMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1506,10 +1588,10 @@ func (m *CronJobMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ConfigProfile entity.
+// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ConfigProfileMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1524,531 +1606,4634 @@ func (m *CronJobMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *CronJobMutation) ResetName() {
+func (m *ConfigProfileMutation) ResetName() {
 	m.name = nil
 }
 
-// SetScheduleType sets the "schedule_type" field.
-func (m *CronJobMutation) SetScheduleType(ct cronjob.ScheduleType) {
-	m.schedule_type = &ct
+// SetEncryptedData sets the "encrypted_data" field.
+func (m *ConfigProfileMutation) SetEncryptedData(b []byte) {
+	m.encrypted_data = &b
 }
 
-// ScheduleType returns the value of the "schedule_type" field in the mutation.
-func (m *CronJobMutation) ScheduleType() (r cronjob.ScheduleType, exists bool) {
-	v := m.schedule_type
+// EncryptedData returns the value of the "encrypted_data" field in the mutation.
+func (m *ConfigProfileMutation) EncryptedData() (r []byte, exists bool) {
+	v := m.encrypted_data
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduleType returns the old "schedule_type" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptedData returns the old "encrypted_data" field's value of the ConfigProfile entity.
+// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldScheduleType(ctx context.Context) (v cronjob.ScheduleType, err error) {
+func (m *ConfigProfileMutation) OldEncryptedData(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduleType is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptedData is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduleType requires an ID field in the mutation")
+		return v, errors.New("OldEncryptedData requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduleType: %w", err)
+		return v, fmt.Errorf("querying old value for OldEncryptedData: %w", err)
 	}
-	return oldValue.ScheduleType, nil
-}
-
-// ResetScheduleType resets all changes to the "schedule_type" field.
-func (m *CronJobMutation) ResetScheduleType() {
-	m.schedule_type = nil
+	return oldValue.EncryptedData, nil
 }
 
-// SetSchedule sets the "schedule" field.
-func (m *CronJobMutation) SetSchedule(s string) {
-	m.schedule = &s
+// ResetEncryptedData resets all changes to the "encrypted_data" field.
+func (m *ConfigProfileMutation) ResetEncryptedData() {
+	m.encrypted_data = nil
 }
 
-// Schedule returns the value of the "schedule" field in the mutation.
-func (m *CronJobMutation) Schedule() (r string, exists bool) {
-	v := m.schedule
+// SetActive sets the "active" field.
+func (m *ConfigProfileMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *ConfigProfileMutation) Active() (r bool, exists bool) {
+	v := m.active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSchedule returns the old "schedule" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// OldActive returns the old "active" field's value of the ConfigProfile entity.
+// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldSchedule(ctx context.Context) (v string, err error) {
+func (m *ConfigProfileMutation) OldActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSchedule requires an ID field in the mutation")
+		return v, errors.New("OldActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
 	}
-	return oldValue.Schedule, nil
+	return oldValue.Active, nil
 }
 
-// ResetSchedule resets all changes to the "schedule" field.
-func (m *CronJobMutation) ResetSchedule() {
-	m.schedule = nil
+// ResetActive resets all changes to the "active" field.
+func (m *ConfigProfileMutation) ResetActive() {
+	m.active = nil
 }
 
-// SetPrompt sets the "prompt" field.
-func (m *CronJobMutation) SetPrompt(s string) {
-	m.prompt = &s
+// SetVersion sets the "version" field.
+func (m *ConfigProfileMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Prompt returns the value of the "prompt" field in the mutation.
-func (m *CronJobMutation) Prompt() (r string, exists bool) {
-	v := m.prompt
+// Version returns the value of the "version" field in the mutation.
+func (m *ConfigProfileMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrompt returns the old "prompt" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the ConfigProfile entity.
+// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldPrompt(ctx context.Context) (v string, err error) {
+func (m *ConfigProfileMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrompt requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Prompt, nil
-}
-
-// ResetPrompt resets all changes to the "prompt" field.
-func (m *CronJobMutation) ResetPrompt() {
-	m.prompt = nil
+	return oldValue.Version, nil
 }
 
-// SetSessionMode sets the "session_mode" field.
-func (m *CronJobMutation) SetSessionMode(s string) {
-	m.session_mode = &s
+// AddVersion adds i to the "version" field.
+func (m *ConfigProfileMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SessionMode returns the value of the "session_mode" field in the mutation.
-func (m *CronJobMutation) SessionMode() (r string, exists bool) {
-	v := m.session_mode
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ConfigProfileMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionMode returns the old "session_mode" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldSessionMode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionMode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionMode: %w", err)
-	}
-	return oldValue.SessionMode, nil
-}
-
-// ResetSessionMode resets all changes to the "session_mode" field.
-func (m *CronJobMutation) ResetSessionMode() {
-	m.session_mode = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *ConfigProfileMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetDeliverTo sets the "deliver_to" field.
-func (m *CronJobMutation) SetDeliverTo(s []string) {
-	m.deliver_to = &s
-	m.appenddeliver_to = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *ConfigProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// DeliverTo returns the value of the "deliver_to" field in the mutation.
-func (m *CronJobMutation) DeliverTo() (r []string, exists bool) {
-	v := m.deliver_to
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ConfigProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeliverTo returns the old "deliver_to" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConfigProfile entity.
+// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldDeliverTo(ctx context.Context) (v []string, err error) {
+func (m *ConfigProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeliverTo is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeliverTo requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeliverTo: %w", err)
-	}
-	return oldValue.DeliverTo, nil
-}
-
-// AppendDeliverTo adds s to the "deliver_to" field.
-func (m *CronJobMutation) AppendDeliverTo(s []string) {
-	m.appenddeliver_to = append(m.appenddeliver_to, s...)
-}
-
-// AppendedDeliverTo returns the list of values that were appended to the "deliver_to" field in this mutation.
-func (m *CronJobMutation) AppendedDeliverTo() ([]string, bool) {
-	if len(m.appenddeliver_to) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return m.appenddeliver_to, true
-}
-
-// ClearDeliverTo clears the value of the "deliver_to" field.
-func (m *CronJobMutation) ClearDeliverTo() {
-	m.deliver_to = nil
-	m.appenddeliver_to = nil
-	m.clearedFields[cronjob.FieldDeliverTo] = struct{}{}
-}
-
-// DeliverToCleared returns if the "deliver_to" field was cleared in this mutation.
-func (m *CronJobMutation) DeliverToCleared() bool {
-	_, ok := m.clearedFields[cronjob.FieldDeliverTo]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDeliverTo resets all changes to the "deliver_to" field.
-func (m *CronJobMutation) ResetDeliverTo() {
-	m.deliver_to = nil
-	m.appenddeliver_to = nil
-	delete(m.clearedFields, cronjob.FieldDeliverTo)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ConfigProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTimezone sets the "timezone" field.
-func (m *CronJobMutation) SetTimezone(s string) {
-	m.timezone = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ConfigProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// Timezone returns the value of the "timezone" field in the mutation.
-func (m *CronJobMutation) Timezone() (r string, exists bool) {
-	v := m.timezone
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ConfigProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimezone returns the old "timezone" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ConfigProfile entity.
+// If the ConfigProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldTimezone(ctx context.Context) (v string, err error) {
+func (m *ConfigProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimezone requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.Timezone, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetTimezone resets all changes to the "timezone" field.
-func (m *CronJobMutation) ResetTimezone() {
-	m.timezone = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ConfigProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *CronJobMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// Where appends a list predicates to the ConfigProfileMutation builder.
+func (m *ConfigProfileMutation) Where(ps ...predicate.ConfigProfile) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *CronJobMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the ConfigProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ConfigProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConfigProfile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldEnabled returns the old "enabled" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
-	}
-	return oldValue.Enabled, nil
+// Op returns the operation name.
+func (m *ConfigProfileMutation) Op() Op {
+	return m.op
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *CronJobMutation) ResetEnabled() {
-	m.enabled = nil
+// SetOp allows setting the mutation operation.
+func (m *ConfigProfileMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetLastRunAt sets the "last_run_at" field.
-func (m *CronJobMutation) SetLastRunAt(t time.Time) {
-	m.last_run_at = &t
+// Type returns the node type of this mutation (ConfigProfile).
+func (m *ConfigProfileMutation) Type() string {
+	return m.typ
 }
 
-// LastRunAt returns the value of the "last_run_at" field in the mutation.
-func (m *CronJobMutation) LastRunAt() (r time.Time, exists bool) {
-	v := m.last_run_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ConfigProfileMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, configprofile.FieldName)
 	}
-	return *v, true
-}
-
-// OldLastRunAt returns the old "last_run_at" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	if m.encrypted_data != nil {
+		fields = append(fields, configprofile.FieldEncryptedData)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	if m.active != nil {
+		fields = append(fields, configprofile.FieldActive)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	if m.version != nil {
+		fields = append(fields, configprofile.FieldVersion)
 	}
-	return oldValue.LastRunAt, nil
+	if m.created_at != nil {
+		fields = append(fields, configprofile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, configprofile.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearLastRunAt clears the value of the "last_run_at" field.
-func (m *CronJobMutation) ClearLastRunAt() {
-	m.last_run_at = nil
-	m.clearedFields[cronjob.FieldLastRunAt] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ConfigProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case configprofile.FieldName:
+		return m.Name()
+	case configprofile.FieldEncryptedData:
+		return m.EncryptedData()
+	case configprofile.FieldActive:
+		return m.Active()
+	case configprofile.FieldVersion:
+		return m.Version()
+	case configprofile.FieldCreatedAt:
+		return m.CreatedAt()
+	case configprofile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
-func (m *CronJobMutation) LastRunAtCleared() bool {
-	_, ok := m.clearedFields[cronjob.FieldLastRunAt]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ConfigProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case configprofile.FieldName:
+		return m.OldName(ctx)
+	case configprofile.FieldEncryptedData:
+		return m.OldEncryptedData(ctx)
+	case configprofile.FieldActive:
+		return m.OldActive(ctx)
+	case configprofile.FieldVersion:
+		return m.OldVersion(ctx)
+	case configprofile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case configprofile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ConfigProfile field %s", name)
 }
 
-// ResetLastRunAt resets all changes to the "last_run_at" field.
-func (m *CronJobMutation) ResetLastRunAt() {
-	m.last_run_at = nil
-	delete(m.clearedFields, cronjob.FieldLastRunAt)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case configprofile.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case configprofile.FieldEncryptedData:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEncryptedData(v)
+		return nil
+	case configprofile.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case configprofile.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case configprofile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case configprofile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigProfile field %s", name)
 }
 
-// SetNextRunAt sets the "next_run_at" field.
-func (m *CronJobMutation) SetNextRunAt(t time.Time) {
-	m.next_run_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ConfigProfileMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, configprofile.FieldVersion)
+	}
+	return fields
 }
 
-// NextRunAt returns the value of the "next_run_at" field in the mutation.
-func (m *CronJobMutation) NextRunAt() (r time.Time, exists bool) {
-	v := m.next_run_at
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ConfigProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case configprofile.FieldVersion:
+		return m.AddedVersion()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldNextRunAt returns the old "next_run_at" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ConfigProfileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case configprofile.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return oldValue.NextRunAt, nil
+	return fmt.Errorf("unknown ConfigProfile numeric field %s", name)
 }
 
-// ClearNextRunAt clears the value of the "next_run_at" field.
-func (m *CronJobMutation) ClearNextRunAt() {
-	m.next_run_at = nil
-	m.clearedFields[cronjob.FieldNextRunAt] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ConfigProfileMutation) ClearedFields() []string {
+	return nil
 }
 
-// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
-func (m *CronJobMutation) NextRunAtCleared() bool {
-	_, ok := m.clearedFields[cronjob.FieldNextRunAt]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ConfigProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetNextRunAt resets all changes to the "next_run_at" field.
-func (m *CronJobMutation) ResetNextRunAt() {
-	m.next_run_at = nil
-	delete(m.clearedFields, cronjob.FieldNextRunAt)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ConfigProfileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ConfigProfile nullable field %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CronJobMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ConfigProfileMutation) ResetField(name string) error {
+	switch name {
+	case configprofile.FieldName:
+		m.ResetName()
+		return nil
+	case configprofile.FieldEncryptedData:
+		m.ResetEncryptedData()
+		return nil
+	case configprofile.FieldActive:
+		m.ResetActive()
+		return nil
+	case configprofile.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case configprofile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case configprofile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ConfigProfile field %s", name)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CronJobMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ConfigProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CronJob entity.
-// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ConfigProfileMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ConfigProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ConfigProfileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ConfigProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ConfigProfileMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ConfigProfileMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ConfigProfile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ConfigProfileMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ConfigProfile edge %s", name)
+}
+
+// CronJobMutation represents an operation that mutates the CronJob nodes in the graph.
+type CronJobMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	schedule_type    *cronjob.ScheduleType
+	schedule         *string
+	prompt           *string
+	session_mode     *string
+	deliver_to       *[]string
+	appenddeliver_to []string
+	timezone         *string
+	enabled          *bool
+	last_run_at      *time.Time
+	next_run_at      *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*CronJob, error)
+	predicates       []predicate.CronJob
+}
+
+var _ ent.Mutation = (*CronJobMutation)(nil)
+
+// cronjobOption allows management of the mutation configuration using functional options.
+type cronjobOption func(*CronJobMutation)
+
+// newCronJobMutation creates new mutation for the CronJob entity.
+func newCronJobMutation(c config, op Op, opts ...cronjobOption) *CronJobMutation {
+	m := &CronJobMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCronJob,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.CreatedAt, nil
+	return m
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CronJobMutation) ResetCreatedAt() {
-	m.created_at = nil
+// withCronJobID sets the ID field of the mutation.
+func withCronJobID(id uuid.UUID) cronjobOption {
+	return func(m *CronJobMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CronJob
+		)
+		m.oldValue = func(ctx context.Context) (*CronJob, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CronJob.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CronJobMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// withCronJob sets the old CronJob of the mutation.
+func withCronJob(node *CronJob) cronjobOption {
+	return func(m *CronJobMutation) {
+		m.oldValue = func(context.Context) (*CronJob, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CronJobMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CronJobMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CronJobMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CronJob entities.
+func (m *CronJobMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CronJobMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CronJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CronJob.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CronJobMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CronJobMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CronJob entity.
+// OldName returns the old "name" field's value of the CronJob entity.
 // If the CronJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CronJobMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Name, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CronJobMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetName resets all changes to the "name" field.
+func (m *CronJobMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the CronJobMutation builder.
-func (m *CronJobMutation) Where(ps ...predicate.CronJob) {
-	m.predicates = append(m.predicates, ps...)
+// SetScheduleType sets the "schedule_type" field.
+func (m *CronJobMutation) SetScheduleType(ct cronjob.ScheduleType) {
+	m.schedule_type = &ct
 }
 
-// WhereP appends storage-level predicates to the CronJobMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CronJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CronJob, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ScheduleType returns the value of the "schedule_type" field in the mutation.
+func (m *CronJobMutation) ScheduleType() (r cronjob.ScheduleType, exists bool) {
+	v := m.schedule_type
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *CronJobMutation) Op() Op {
-	return m.op
+// OldScheduleType returns the old "schedule_type" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldScheduleType(ctx context.Context) (v cronjob.ScheduleType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScheduleType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScheduleType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScheduleType: %w", err)
+	}
+	return oldValue.ScheduleType, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *CronJobMutation) SetOp(op Op) {
-	m.op = op
+// ResetScheduleType resets all changes to the "schedule_type" field.
+func (m *CronJobMutation) ResetScheduleType() {
+	m.schedule_type = nil
 }
 
-// Type returns the node type of this mutation (CronJob).
-func (m *CronJobMutation) Type() string {
-	return m.typ
+// SetSchedule sets the "schedule" field.
+func (m *CronJobMutation) SetSchedule(s string) {
+	m.schedule = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *CronJobMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+// Schedule returns the value of the "schedule" field in the mutation.
+func (m *CronJobMutation) Schedule() (r string, exists bool) {
+	v := m.schedule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSchedule returns the old "schedule" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldSchedule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchedule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchedule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchedule: %w", err)
+	}
+	return oldValue.Schedule, nil
+}
+
+// ResetSchedule resets all changes to the "schedule" field.
+func (m *CronJobMutation) ResetSchedule() {
+	m.schedule = nil
+}
+
+// SetPrompt sets the "prompt" field.
+func (m *CronJobMutation) SetPrompt(s string) {
+	m.prompt = &s
+}
+
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *CronJobMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrompt returns the old "prompt" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
+}
+
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *CronJobMutation) ResetPrompt() {
+	m.prompt = nil
+}
+
+// SetSessionMode sets the "session_mode" field.
+func (m *CronJobMutation) SetSessionMode(s string) {
+	m.session_mode = &s
+}
+
+// SessionMode returns the value of the "session_mode" field in the mutation.
+func (m *CronJobMutation) SessionMode() (r string, exists bool) {
+	v := m.session_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionMode returns the old "session_mode" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldSessionMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionMode: %w", err)
+	}
+	return oldValue.SessionMode, nil
+}
+
+// ResetSessionMode resets all changes to the "session_mode" field.
+func (m *CronJobMutation) ResetSessionMode() {
+	m.session_mode = nil
+}
+
+// SetDeliverTo sets the "deliver_to" field.
+func (m *CronJobMutation) SetDeliverTo(s []string) {
+	m.deliver_to = &s
+	m.appenddeliver_to = nil
+}
+
+// DeliverTo returns the value of the "deliver_to" field in the mutation.
+func (m *CronJobMutation) DeliverTo() (r []string, exists bool) {
+	v := m.deliver_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliverTo returns the old "deliver_to" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldDeliverTo(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliverTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliverTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliverTo: %w", err)
+	}
+	return oldValue.DeliverTo, nil
+}
+
+// AppendDeliverTo adds s to the "deliver_to" field.
+func (m *CronJobMutation) AppendDeliverTo(s []string) {
+	m.appenddeliver_to = append(m.appenddeliver_to, s...)
+}
+
+// AppendedDeliverTo returns the list of values that were appended to the "deliver_to" field in this mutation.
+func (m *CronJobMutation) AppendedDeliverTo() ([]string, bool) {
+	if len(m.appenddeliver_to) == 0 {
+		return nil, false
+	}
+	return m.appenddeliver_to, true
+}
+
+// ClearDeliverTo clears the value of the "deliver_to" field.
+func (m *CronJobMutation) ClearDeliverTo() {
+	m.deliver_to = nil
+	m.appenddeliver_to = nil
+	m.clearedFields[cronjob.FieldDeliverTo] = struct{}{}
+}
+
+// DeliverToCleared returns if the "deliver_to" field was cleared in this mutation.
+func (m *CronJobMutation) DeliverToCleared() bool {
+	_, ok := m.clearedFields[cronjob.FieldDeliverTo]
+	return ok
+}
+
+// ResetDeliverTo resets all changes to the "deliver_to" field.
+func (m *CronJobMutation) ResetDeliverTo() {
+	m.deliver_to = nil
+	m.appenddeliver_to = nil
+	delete(m.clearedFields, cronjob.FieldDeliverTo)
+}
+
+// SetTimezone sets the "timezone" field.
+func (m *CronJobMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *CronJobMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimezone returns the old "timezone" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
+}
+
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *CronJobMutation) ResetTimezone() {
+	m.timezone = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *CronJobMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *CronJobMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *CronJobMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *CronJobMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *CronJobMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunAt returns the old "last_run_at" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *CronJobMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[cronjob.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *CronJobMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[cronjob.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *CronJobMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, cronjob.FieldLastRunAt)
+}
+
+// SetNextRunAt sets the "next_run_at" field.
+func (m *CronJobMutation) SetNextRunAt(t time.Time) {
+	m.next_run_at = &t
+}
+
+// NextRunAt returns the value of the "next_run_at" field in the mutation.
+func (m *CronJobMutation) NextRunAt() (r time.Time, exists bool) {
+	v := m.next_run_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRunAt returns the old "next_run_at" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldNextRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRunAt: %w", err)
+	}
+	return oldValue.NextRunAt, nil
+}
+
+// ClearNextRunAt clears the value of the "next_run_at" field.
+func (m *CronJobMutation) ClearNextRunAt() {
+	m.next_run_at = nil
+	m.clearedFields[cronjob.FieldNextRunAt] = struct{}{}
+}
+
+// NextRunAtCleared returns if the "next_run_at" field was cleared in this mutation.
+func (m *CronJobMutation) NextRunAtCleared() bool {
+	_, ok := m.clearedFields[cronjob.FieldNextRunAt]
+	return ok
+}
+
+// ResetNextRunAt resets all changes to the "next_run_at" field.
+func (m *CronJobMutation) ResetNextRunAt() {
+	m.next_run_at = nil
+	delete(m.clearedFields, cronjob.FieldNextRunAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CronJobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CronJobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CronJobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CronJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CronJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CronJob entity.
+// If the CronJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CronJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the CronJobMutation builder.
+func (m *CronJobMutation) Where(ps ...predicate.CronJob) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CronJobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CronJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CronJob, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CronJobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CronJobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CronJob).
+func (m *CronJobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CronJobMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, cronjob.FieldName)
+	}
+	if m.schedule_type != nil {
+		fields = append(fields, cronjob.FieldScheduleType)
+	}
+	if m.schedule != nil {
+		fields = append(fields, cronjob.FieldSchedule)
+	}
+	if m.prompt != nil {
+		fields = append(fields, cronjob.FieldPrompt)
+	}
+	if m.session_mode != nil {
+		fields = append(fields, cronjob.FieldSessionMode)
+	}
+	if m.deliver_to != nil {
+		fields = append(fields, cronjob.FieldDeliverTo)
+	}
+	if m.timezone != nil {
+		fields = append(fields, cronjob.FieldTimezone)
+	}
+	if m.enabled != nil {
+		fields = append(fields, cronjob.FieldEnabled)
+	}
+	if m.last_run_at != nil {
+		fields = append(fields, cronjob.FieldLastRunAt)
+	}
+	if m.next_run_at != nil {
+		fields = append(fields, cronjob.FieldNextRunAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, cronjob.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, cronjob.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CronJobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cronjob.FieldName:
+		return m.Name()
+	case cronjob.FieldScheduleType:
+		return m.ScheduleType()
+	case cronjob.FieldSchedule:
+		return m.Schedule()
+	case cronjob.FieldPrompt:
+		return m.Prompt()
+	case cronjob.FieldSessionMode:
+		return m.SessionMode()
+	case cronjob.FieldDeliverTo:
+		return m.DeliverTo()
+	case cronjob.FieldTimezone:
+		return m.Timezone()
+	case cronjob.FieldEnabled:
+		return m.Enabled()
+	case cronjob.FieldLastRunAt:
+		return m.LastRunAt()
+	case cronjob.FieldNextRunAt:
+		return m.NextRunAt()
+	case cronjob.FieldCreatedAt:
+		return m.CreatedAt()
+	case cronjob.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CronJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cronjob.FieldName:
+		return m.OldName(ctx)
+	case cronjob.FieldScheduleType:
+		return m.OldScheduleType(ctx)
+	case cronjob.FieldSchedule:
+		return m.OldSchedule(ctx)
+	case cronjob.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case cronjob.FieldSessionMode:
+		return m.OldSessionMode(ctx)
+	case cronjob.FieldDeliverTo:
+		return m.OldDeliverTo(ctx)
+	case cronjob.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case cronjob.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case cronjob.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case cronjob.FieldNextRunAt:
+		return m.OldNextRunAt(ctx)
+	case cronjob.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cronjob.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CronJob field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CronJobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cronjob.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case cronjob.FieldScheduleType:
+		v, ok := value.(cronjob.ScheduleType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleType(v)
+		return nil
+	case cronjob.FieldSchedule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchedule(v)
+		return nil
+	case cronjob.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case cronjob.FieldSessionMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionMode(v)
+		return nil
+	case cronjob.FieldDeliverTo:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliverTo(v)
+		return nil
+	case cronjob.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case cronjob.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case cronjob.FieldLastRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunAt(v)
+		return nil
+	case cronjob.FieldNextRunAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRunAt(v)
+		return nil
+	case cronjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cronjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CronJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CronJobMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CronJobMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CronJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown CronJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CronJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cronjob.FieldDeliverTo) {
+		fields = append(fields, cronjob.FieldDeliverTo)
+	}
+	if m.FieldCleared(cronjob.FieldLastRunAt) {
+		fields = append(fields, cronjob.FieldLastRunAt)
+	}
+	if m.FieldCleared(cronjob.FieldNextRunAt) {
+		fields = append(fields, cronjob.FieldNextRunAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CronJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CronJobMutation) ClearField(name string) error {
+	switch name {
+	case cronjob.FieldDeliverTo:
+		m.ClearDeliverTo()
+		return nil
+	case cronjob.FieldLastRunAt:
+		m.ClearLastRunAt()
+		return nil
+	case cronjob.FieldNextRunAt:
+		m.ClearNextRunAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CronJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CronJobMutation) ResetField(name string) error {
+	switch name {
+	case cronjob.FieldName:
+		m.ResetName()
+		return nil
+	case cronjob.FieldScheduleType:
+		m.ResetScheduleType()
+		return nil
+	case cronjob.FieldSchedule:
+		m.ResetSchedule()
+		return nil
+	case cronjob.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case cronjob.FieldSessionMode:
+		m.ResetSessionMode()
+		return nil
+	case cronjob.FieldDeliverTo:
+		m.ResetDeliverTo()
+		return nil
+	case cronjob.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case cronjob.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case cronjob.FieldLastRunAt:
+		m.ResetLastRunAt()
+		return nil
+	case cronjob.FieldNextRunAt:
+		m.ResetNextRunAt()
+		return nil
+	case cronjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cronjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CronJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CronJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CronJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CronJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CronJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CronJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CronJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CronJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CronJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CronJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CronJob edge %s", name)
+}
+
+// CronJobHistoryMutation represents an operation that mutates the CronJobHistory nodes in the graph.
+type CronJobHistoryMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	job_id         *uuid.UUID
+	job_name       *string
+	status         *cronjobhistory.Status
+	prompt         *string
+	result         *string
+	error_message  *string
+	tokens_used    *int
+	addtokens_used *int
+	started_at     *time.Time
+	completed_at   *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*CronJobHistory, error)
+	predicates     []predicate.CronJobHistory
+}
+
+var _ ent.Mutation = (*CronJobHistoryMutation)(nil)
+
+// cronjobhistoryOption allows management of the mutation configuration using functional options.
+type cronjobhistoryOption func(*CronJobHistoryMutation)
+
+// newCronJobHistoryMutation creates new mutation for the CronJobHistory entity.
+func newCronJobHistoryMutation(c config, op Op, opts ...cronjobhistoryOption) *CronJobHistoryMutation {
+	m := &CronJobHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCronJobHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCronJobHistoryID sets the ID field of the mutation.
+func withCronJobHistoryID(id uuid.UUID) cronjobhistoryOption {
+	return func(m *CronJobHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CronJobHistory
+		)
+		m.oldValue = func(ctx context.Context) (*CronJobHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CronJobHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCronJobHistory sets the old CronJobHistory of the mutation.
+func withCronJobHistory(node *CronJobHistory) cronjobhistoryOption {
+	return func(m *CronJobHistoryMutation) {
+		m.oldValue = func(context.Context) (*CronJobHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CronJobHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CronJobHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CronJobHistory entities.
+func (m *CronJobHistoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CronJobHistoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CronJobHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CronJobHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetJobID sets the "job_id" field.
+func (m *CronJobHistoryMutation) SetJobID(u uuid.UUID) {
+	m.job_id = &u
+}
+
+// JobID returns the value of the "job_id" field in the mutation.
+func (m *CronJobHistoryMutation) JobID() (r uuid.UUID, exists bool) {
+	v := m.job_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobID returns the old "job_id" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
+	}
+	return oldValue.JobID, nil
+}
+
+// ResetJobID resets all changes to the "job_id" field.
+func (m *CronJobHistoryMutation) ResetJobID() {
+	m.job_id = nil
+}
+
+// SetJobName sets the "job_name" field.
+func (m *CronJobHistoryMutation) SetJobName(s string) {
+	m.job_name = &s
+}
+
+// JobName returns the value of the "job_name" field in the mutation.
+func (m *CronJobHistoryMutation) JobName() (r string, exists bool) {
+	v := m.job_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJobName returns the old "job_name" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldJobName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJobName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
+	}
+	return oldValue.JobName, nil
+}
+
+// ResetJobName resets all changes to the "job_name" field.
+func (m *CronJobHistoryMutation) ResetJobName() {
+	m.job_name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *CronJobHistoryMutation) SetStatus(c cronjobhistory.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CronJobHistoryMutation) Status() (r cronjobhistory.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldStatus(ctx context.Context) (v cronjobhistory.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CronJobHistoryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPrompt sets the "prompt" field.
+func (m *CronJobHistoryMutation) SetPrompt(s string) {
+	m.prompt = &s
+}
+
+// Prompt returns the value of the "prompt" field in the mutation.
+func (m *CronJobHistoryMutation) Prompt() (r string, exists bool) {
+	v := m.prompt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrompt returns the old "prompt" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldPrompt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrompt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
+	}
+	return oldValue.Prompt, nil
+}
+
+// ResetPrompt resets all changes to the "prompt" field.
+func (m *CronJobHistoryMutation) ResetPrompt() {
+	m.prompt = nil
+}
+
+// SetResult sets the "result" field.
+func (m *CronJobHistoryMutation) SetResult(s string) {
+	m.result = &s
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *CronJobHistoryMutation) Result() (r string, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ClearResult clears the value of the "result" field.
+func (m *CronJobHistoryMutation) ClearResult() {
+	m.result = nil
+	m.clearedFields[cronjobhistory.FieldResult] = struct{}{}
+}
+
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *CronJobHistoryMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[cronjobhistory.FieldResult]
+	return ok
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *CronJobHistoryMutation) ResetResult() {
+	m.result = nil
+	delete(m.clearedFields, cronjobhistory.FieldResult)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *CronJobHistoryMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *CronJobHistoryMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *CronJobHistoryMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[cronjobhistory.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *CronJobHistoryMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[cronjobhistory.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *CronJobHistoryMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, cronjobhistory.FieldErrorMessage)
+}
+
+// SetTokensUsed sets the "tokens_used" field.
+func (m *CronJobHistoryMutation) SetTokensUsed(i int) {
+	m.tokens_used = &i
+	m.addtokens_used = nil
+}
+
+// TokensUsed returns the value of the "tokens_used" field in the mutation.
+func (m *CronJobHistoryMutation) TokensUsed() (r int, exists bool) {
+	v := m.tokens_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokensUsed returns the old "tokens_used" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldTokensUsed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokensUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokensUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokensUsed: %w", err)
+	}
+	return oldValue.TokensUsed, nil
+}
+
+// AddTokensUsed adds i to the "tokens_used" field.
+func (m *CronJobHistoryMutation) AddTokensUsed(i int) {
+	if m.addtokens_used != nil {
+		*m.addtokens_used += i
+	} else {
+		m.addtokens_used = &i
+	}
+}
+
+// AddedTokensUsed returns the value that was added to the "tokens_used" field in this mutation.
+func (m *CronJobHistoryMutation) AddedTokensUsed() (r int, exists bool) {
+	v := m.addtokens_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokensUsed resets all changes to the "tokens_used" field.
+func (m *CronJobHistoryMutation) ResetTokensUsed() {
+	m.tokens_used = nil
+	m.addtokens_used = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *CronJobHistoryMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *CronJobHistoryMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *CronJobHistoryMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *CronJobHistoryMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *CronJobHistoryMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the CronJobHistory entity.
+// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CronJobHistoryMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *CronJobHistoryMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[cronjobhistory.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *CronJobHistoryMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[cronjobhistory.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *CronJobHistoryMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, cronjobhistory.FieldCompletedAt)
+}
+
+// Where appends a list predicates to the CronJobHistoryMutation builder.
+func (m *CronJobHistoryMutation) Where(ps ...predicate.CronJobHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CronJobHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CronJobHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CronJobHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CronJobHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CronJobHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CronJobHistory).
+func (m *CronJobHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CronJobHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.job_id != nil {
+		fields = append(fields, cronjobhistory.FieldJobID)
+	}
+	if m.job_name != nil {
+		fields = append(fields, cronjobhistory.FieldJobName)
+	}
+	if m.status != nil {
+		fields = append(fields, cronjobhistory.FieldStatus)
+	}
+	if m.prompt != nil {
+		fields = append(fields, cronjobhistory.FieldPrompt)
+	}
+	if m.result != nil {
+		fields = append(fields, cronjobhistory.FieldResult)
+	}
+	if m.error_message != nil {
+		fields = append(fields, cronjobhistory.FieldErrorMessage)
+	}
+	if m.tokens_used != nil {
+		fields = append(fields, cronjobhistory.FieldTokensUsed)
+	}
+	if m.started_at != nil {
+		fields = append(fields, cronjobhistory.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, cronjobhistory.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CronJobHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cronjobhistory.FieldJobID:
+		return m.JobID()
+	case cronjobhistory.FieldJobName:
+		return m.JobName()
+	case cronjobhistory.FieldStatus:
+		return m.Status()
+	case cronjobhistory.FieldPrompt:
+		return m.Prompt()
+	case cronjobhistory.FieldResult:
+		return m.Result()
+	case cronjobhistory.FieldErrorMessage:
+		return m.ErrorMessage()
+	case cronjobhistory.FieldTokensUsed:
+		return m.TokensUsed()
+	case cronjobhistory.FieldStartedAt:
+		return m.StartedAt()
+	case cronjobhistory.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CronJobHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cronjobhistory.FieldJobID:
+		return m.OldJobID(ctx)
+	case cronjobhistory.FieldJobName:
+		return m.OldJobName(ctx)
+	case cronjobhistory.FieldStatus:
+		return m.OldStatus(ctx)
+	case cronjobhistory.FieldPrompt:
+		return m.OldPrompt(ctx)
+	case cronjobhistory.FieldResult:
+		return m.OldResult(ctx)
+	case cronjobhistory.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case cronjobhistory.FieldTokensUsed:
+		return m.OldTokensUsed(ctx)
+	case cronjobhistory.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case cronjobhistory.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CronJobHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CronJobHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cronjobhistory.FieldJobID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobID(v)
+		return nil
+	case cronjobhistory.FieldJobName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJobName(v)
+		return nil
+	case cronjobhistory.FieldStatus:
+		v, ok := value.(cronjobhistory.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case cronjobhistory.FieldPrompt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrompt(v)
+		return nil
+	case cronjobhistory.FieldResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case cronjobhistory.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case cronjobhistory.FieldTokensUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokensUsed(v)
+		return nil
+	case cronjobhistory.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case cronjobhistory.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CronJobHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addtokens_used != nil {
+		fields = append(fields, cronjobhistory.FieldTokensUsed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CronJobHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cronjobhistory.FieldTokensUsed:
+		return m.AddedTokensUsed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CronJobHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cronjobhistory.FieldTokensUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokensUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CronJobHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cronjobhistory.FieldResult) {
+		fields = append(fields, cronjobhistory.FieldResult)
+	}
+	if m.FieldCleared(cronjobhistory.FieldErrorMessage) {
+		fields = append(fields, cronjobhistory.FieldErrorMessage)
+	}
+	if m.FieldCleared(cronjobhistory.FieldCompletedAt) {
+		fields = append(fields, cronjobhistory.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CronJobHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CronJobHistoryMutation) ClearField(name string) error {
+	switch name {
+	case cronjobhistory.FieldResult:
+		m.ClearResult()
+		return nil
+	case cronjobhistory.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case cronjobhistory.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CronJobHistoryMutation) ResetField(name string) error {
+	switch name {
+	case cronjobhistory.FieldJobID:
+		m.ResetJobID()
+		return nil
+	case cronjobhistory.FieldJobName:
+		m.ResetJobName()
+		return nil
+	case cronjobhistory.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case cronjobhistory.FieldPrompt:
+		m.ResetPrompt()
+		return nil
+	case cronjobhistory.FieldResult:
+		m.ResetResult()
+		return nil
+	case cronjobhistory.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case cronjobhistory.FieldTokensUsed:
+		m.ResetTokensUsed()
+		return nil
+	case cronjobhistory.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case cronjobhistory.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CronJobHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CronJobHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CronJobHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CronJobHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CronJobHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CronJobHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CronJobHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CronJobHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown CronJobHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CronJobHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown CronJobHistory edge %s", name)
+}
+
+// ExternalRefMutation represents an operation that mutates the ExternalRef nodes in the graph.
+type ExternalRefMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	ref_type      *externalref.RefType
+	location      *string
+	summary       *string
+	metadata      *map[string]interface{}
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExternalRef, error)
+	predicates    []predicate.ExternalRef
+}
+
+var _ ent.Mutation = (*ExternalRefMutation)(nil)
+
+// externalrefOption allows management of the mutation configuration using functional options.
+type externalrefOption func(*ExternalRefMutation)
+
+// newExternalRefMutation creates new mutation for the ExternalRef entity.
+func newExternalRefMutation(c config, op Op, opts ...externalrefOption) *ExternalRefMutation {
+	m := &ExternalRefMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExternalRef,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExternalRefID sets the ID field of the mutation.
+func withExternalRefID(id uuid.UUID) externalrefOption {
+	return func(m *ExternalRefMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExternalRef
+		)
+		m.oldValue = func(ctx context.Context) (*ExternalRef, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExternalRef.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExternalRef sets the old ExternalRef of the mutation.
+func withExternalRef(node *ExternalRef) externalrefOption {
+	return func(m *ExternalRefMutation) {
+		m.oldValue = func(context.Context) (*ExternalRef, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExternalRefMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExternalRefMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExternalRef entities.
+func (m *ExternalRefMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExternalRefMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExternalRefMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExternalRef.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ExternalRefMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ExternalRefMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ExternalRefMutation) ResetName() {
+	m.name = nil
+}
+
+// SetRefType sets the "ref_type" field.
+func (m *ExternalRefMutation) SetRefType(et externalref.RefType) {
+	m.ref_type = &et
+}
+
+// RefType returns the value of the "ref_type" field in the mutation.
+func (m *ExternalRefMutation) RefType() (r externalref.RefType, exists bool) {
+	v := m.ref_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefType returns the old "ref_type" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldRefType(ctx context.Context) (v externalref.RefType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefType: %w", err)
+	}
+	return oldValue.RefType, nil
+}
+
+// ResetRefType resets all changes to the "ref_type" field.
+func (m *ExternalRefMutation) ResetRefType() {
+	m.ref_type = nil
+}
+
+// SetLocation sets the "location" field.
+func (m *ExternalRefMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *ExternalRefMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *ExternalRefMutation) ResetLocation() {
+	m.location = nil
+}
+
+// SetSummary sets the "summary" field.
+func (m *ExternalRefMutation) SetSummary(s string) {
+	m.summary = &s
+}
+
+// Summary returns the value of the "summary" field in the mutation.
+func (m *ExternalRefMutation) Summary() (r string, exists bool) {
+	v := m.summary
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSummary returns the old "summary" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldSummary(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSummary requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+	}
+	return oldValue.Summary, nil
+}
+
+// ClearSummary clears the value of the "summary" field.
+func (m *ExternalRefMutation) ClearSummary() {
+	m.summary = nil
+	m.clearedFields[externalref.FieldSummary] = struct{}{}
+}
+
+// SummaryCleared returns if the "summary" field was cleared in this mutation.
+func (m *ExternalRefMutation) SummaryCleared() bool {
+	_, ok := m.clearedFields[externalref.FieldSummary]
+	return ok
+}
+
+// ResetSummary resets all changes to the "summary" field.
+func (m *ExternalRefMutation) ResetSummary() {
+	m.summary = nil
+	delete(m.clearedFields, externalref.FieldSummary)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ExternalRefMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ExternalRefMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ExternalRefMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[externalref.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ExternalRefMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[externalref.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ExternalRefMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, externalref.FieldMetadata)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ExternalRefMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ExternalRefMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ExternalRefMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExternalRefMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExternalRefMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ExternalRef entity.
+// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalRefMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExternalRefMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ExternalRefMutation builder.
+func (m *ExternalRefMutation) Where(ps ...predicate.ExternalRef) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExternalRefMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExternalRefMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExternalRef, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExternalRefMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExternalRefMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExternalRef).
+func (m *ExternalRefMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExternalRefMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.name != nil {
-		fields = append(fields, cronjob.FieldName)
+		fields = append(fields, externalref.FieldName)
+	}
+	if m.ref_type != nil {
+		fields = append(fields, externalref.FieldRefType)
+	}
+	if m.location != nil {
+		fields = append(fields, externalref.FieldLocation)
+	}
+	if m.summary != nil {
+		fields = append(fields, externalref.FieldSummary)
+	}
+	if m.metadata != nil {
+		fields = append(fields, externalref.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, externalref.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, externalref.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExternalRefMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case externalref.FieldName:
+		return m.Name()
+	case externalref.FieldRefType:
+		return m.RefType()
+	case externalref.FieldLocation:
+		return m.Location()
+	case externalref.FieldSummary:
+		return m.Summary()
+	case externalref.FieldMetadata:
+		return m.Metadata()
+	case externalref.FieldCreatedAt:
+		return m.CreatedAt()
+	case externalref.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExternalRefMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case externalref.FieldName:
+		return m.OldName(ctx)
+	case externalref.FieldRefType:
+		return m.OldRefType(ctx)
+	case externalref.FieldLocation:
+		return m.OldLocation(ctx)
+	case externalref.FieldSummary:
+		return m.OldSummary(ctx)
+	case externalref.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case externalref.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case externalref.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExternalRef field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExternalRefMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case externalref.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case externalref.FieldRefType:
+		v, ok := value.(externalref.RefType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefType(v)
+		return nil
+	case externalref.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case externalref.FieldSummary:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSummary(v)
+		return nil
+	case externalref.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case externalref.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case externalref.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalRef field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExternalRefMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExternalRefMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExternalRefMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExternalRef numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExternalRefMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(externalref.FieldSummary) {
+		fields = append(fields, externalref.FieldSummary)
+	}
+	if m.FieldCleared(externalref.FieldMetadata) {
+		fields = append(fields, externalref.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExternalRefMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExternalRefMutation) ClearField(name string) error {
+	switch name {
+	case externalref.FieldSummary:
+		m.ClearSummary()
+		return nil
+	case externalref.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalRef nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExternalRefMutation) ResetField(name string) error {
+	switch name {
+	case externalref.FieldName:
+		m.ResetName()
+		return nil
+	case externalref.FieldRefType:
+		m.ResetRefType()
+		return nil
+	case externalref.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case externalref.FieldSummary:
+		m.ResetSummary()
+		return nil
+	case externalref.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case externalref.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case externalref.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalRef field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExternalRefMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExternalRefMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExternalRefMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExternalRefMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExternalRefMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExternalRefMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExternalRefMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExternalRef unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExternalRefMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExternalRef edge %s", name)
+}
+
+// FileSnapshotMutation represents an operation that mutates the FileSnapshot nodes in the graph.
+type FileSnapshotMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	session_key   *string
+	_path         *string
+	operation     *filesnapshot.Operation
+	kind          *filesnapshot.Kind
+	content       *[]byte
+	mode          *uint32
+	addmode       *int32
+	after_hash    *string
+	undone        *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FileSnapshot, error)
+	predicates    []predicate.FileSnapshot
+}
+
+var _ ent.Mutation = (*FileSnapshotMutation)(nil)
+
+// filesnapshotOption allows management of the mutation configuration using functional options.
+type filesnapshotOption func(*FileSnapshotMutation)
+
+// newFileSnapshotMutation creates new mutation for the FileSnapshot entity.
+func newFileSnapshotMutation(c config, op Op, opts ...filesnapshotOption) *FileSnapshotMutation {
+	m := &FileSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileSnapshot,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFileSnapshotID sets the ID field of the mutation.
+func withFileSnapshotID(id uuid.UUID) filesnapshotOption {
+	return func(m *FileSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FileSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*FileSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FileSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFileSnapshot sets the old FileSnapshot of the mutation.
+func withFileSnapshot(node *FileSnapshot) filesnapshotOption {
+	return func(m *FileSnapshotMutation) {
+		m.oldValue = func(context.Context) (*FileSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FileSnapshot entities.
+func (m *FileSnapshotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FileSnapshotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FileSnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FileSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionKey sets the "session_key" field.
+func (m *FileSnapshotMutation) SetSessionKey(s string) {
+	m.session_key = &s
+}
+
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *FileSnapshotMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionKey returns the old "session_key" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
+	}
+	return oldValue.SessionKey, nil
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (m *FileSnapshotMutation) ClearSessionKey() {
+	m.session_key = nil
+	m.clearedFields[filesnapshot.FieldSessionKey] = struct{}{}
+}
+
+// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
+func (m *FileSnapshotMutation) SessionKeyCleared() bool {
+	_, ok := m.clearedFields[filesnapshot.FieldSessionKey]
+	return ok
+}
+
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *FileSnapshotMutation) ResetSessionKey() {
+	m.session_key = nil
+	delete(m.clearedFields, filesnapshot.FieldSessionKey)
+}
+
+// SetPath sets the "path" field.
+func (m *FileSnapshotMutation) SetPath(s string) {
+	m._path = &s
+}
+
+// Path returns the value of the "path" field in the mutation.
+func (m *FileSnapshotMutation) Path() (r string, exists bool) {
+	v := m._path
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPath returns the old "path" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldPath(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPath requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
+	}
+	return oldValue.Path, nil
+}
+
+// ResetPath resets all changes to the "path" field.
+func (m *FileSnapshotMutation) ResetPath() {
+	m._path = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *FileSnapshotMutation) SetOperation(f filesnapshot.Operation) {
+	m.operation = &f
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *FileSnapshotMutation) Operation() (r filesnapshot.Operation, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldOperation(ctx context.Context) (v filesnapshot.Operation, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *FileSnapshotMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *FileSnapshotMutation) SetKind(f filesnapshot.Kind) {
+	m.kind = &f
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *FileSnapshotMutation) Kind() (r filesnapshot.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldKind(ctx context.Context) (v filesnapshot.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *FileSnapshotMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetContent sets the "content" field.
+func (m *FileSnapshotMutation) SetContent(b []byte) {
+	m.content = &b
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *FileSnapshotMutation) Content() (r []byte, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldContent(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ClearContent clears the value of the "content" field.
+func (m *FileSnapshotMutation) ClearContent() {
+	m.content = nil
+	m.clearedFields[filesnapshot.FieldContent] = struct{}{}
+}
+
+// ContentCleared returns if the "content" field was cleared in this mutation.
+func (m *FileSnapshotMutation) ContentCleared() bool {
+	_, ok := m.clearedFields[filesnapshot.FieldContent]
+	return ok
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *FileSnapshotMutation) ResetContent() {
+	m.content = nil
+	delete(m.clearedFields, filesnapshot.FieldContent)
+}
+
+// SetMode sets the "mode" field.
+func (m *FileSnapshotMutation) SetMode(u uint32) {
+	m.mode = &u
+	m.addmode = nil
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *FileSnapshotMutation) Mode() (r uint32, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldMode(ctx context.Context) (v uint32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// AddMode adds u to the "mode" field.
+func (m *FileSnapshotMutation) AddMode(u int32) {
+	if m.addmode != nil {
+		*m.addmode += u
+	} else {
+		m.addmode = &u
+	}
+}
+
+// AddedMode returns the value that was added to the "mode" field in this mutation.
+func (m *FileSnapshotMutation) AddedMode() (r int32, exists bool) {
+	v := m.addmode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *FileSnapshotMutation) ResetMode() {
+	m.mode = nil
+	m.addmode = nil
+}
+
+// SetAfterHash sets the "after_hash" field.
+func (m *FileSnapshotMutation) SetAfterHash(s string) {
+	m.after_hash = &s
+}
+
+// AfterHash returns the value of the "after_hash" field in the mutation.
+func (m *FileSnapshotMutation) AfterHash() (r string, exists bool) {
+	v := m.after_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfterHash returns the old "after_hash" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldAfterHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfterHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfterHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfterHash: %w", err)
+	}
+	return oldValue.AfterHash, nil
+}
+
+// ClearAfterHash clears the value of the "after_hash" field.
+func (m *FileSnapshotMutation) ClearAfterHash() {
+	m.after_hash = nil
+	m.clearedFields[filesnapshot.FieldAfterHash] = struct{}{}
+}
+
+// AfterHashCleared returns if the "after_hash" field was cleared in this mutation.
+func (m *FileSnapshotMutation) AfterHashCleared() bool {
+	_, ok := m.clearedFields[filesnapshot.FieldAfterHash]
+	return ok
+}
+
+// ResetAfterHash resets all changes to the "after_hash" field.
+func (m *FileSnapshotMutation) ResetAfterHash() {
+	m.after_hash = nil
+	delete(m.clearedFields, filesnapshot.FieldAfterHash)
+}
+
+// SetUndone sets the "undone" field.
+func (m *FileSnapshotMutation) SetUndone(b bool) {
+	m.undone = &b
+}
+
+// Undone returns the value of the "undone" field in the mutation.
+func (m *FileSnapshotMutation) Undone() (r bool, exists bool) {
+	v := m.undone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUndone returns the old "undone" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldUndone(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUndone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUndone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUndone: %w", err)
+	}
+	return oldValue.Undone, nil
+}
+
+// ResetUndone resets all changes to the "undone" field.
+func (m *FileSnapshotMutation) ResetUndone() {
+	m.undone = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FileSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FileSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FileSnapshot entity.
+// If the FileSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FileSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FileSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the FileSnapshotMutation builder.
+func (m *FileSnapshotMutation) Where(ps ...predicate.FileSnapshot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FileSnapshotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FileSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FileSnapshot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FileSnapshotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FileSnapshotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FileSnapshot).
+func (m *FileSnapshotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FileSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.session_key != nil {
+		fields = append(fields, filesnapshot.FieldSessionKey)
+	}
+	if m._path != nil {
+		fields = append(fields, filesnapshot.FieldPath)
+	}
+	if m.operation != nil {
+		fields = append(fields, filesnapshot.FieldOperation)
+	}
+	if m.kind != nil {
+		fields = append(fields, filesnapshot.FieldKind)
+	}
+	if m.content != nil {
+		fields = append(fields, filesnapshot.FieldContent)
+	}
+	if m.mode != nil {
+		fields = append(fields, filesnapshot.FieldMode)
+	}
+	if m.after_hash != nil {
+		fields = append(fields, filesnapshot.FieldAfterHash)
+	}
+	if m.undone != nil {
+		fields = append(fields, filesnapshot.FieldUndone)
+	}
+	if m.created_at != nil {
+		fields = append(fields, filesnapshot.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FileSnapshotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filesnapshot.FieldSessionKey:
+		return m.SessionKey()
+	case filesnapshot.FieldPath:
+		return m.Path()
+	case filesnapshot.FieldOperation:
+		return m.Operation()
+	case filesnapshot.FieldKind:
+		return m.Kind()
+	case filesnapshot.FieldContent:
+		return m.Content()
+	case filesnapshot.FieldMode:
+		return m.Mode()
+	case filesnapshot.FieldAfterHash:
+		return m.AfterHash()
+	case filesnapshot.FieldUndone:
+		return m.Undone()
+	case filesnapshot.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FileSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case filesnapshot.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case filesnapshot.FieldPath:
+		return m.OldPath(ctx)
+	case filesnapshot.FieldOperation:
+		return m.OldOperation(ctx)
+	case filesnapshot.FieldKind:
+		return m.OldKind(ctx)
+	case filesnapshot.FieldContent:
+		return m.OldContent(ctx)
+	case filesnapshot.FieldMode:
+		return m.OldMode(ctx)
+	case filesnapshot.FieldAfterHash:
+		return m.OldAfterHash(ctx)
+	case filesnapshot.FieldUndone:
+		return m.OldUndone(ctx)
+	case filesnapshot.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FileSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filesnapshot.FieldSessionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionKey(v)
+		return nil
+	case filesnapshot.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case filesnapshot.FieldOperation:
+		v, ok := value.(filesnapshot.Operation)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case filesnapshot.FieldKind:
+		v, ok := value.(filesnapshot.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case filesnapshot.FieldContent:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case filesnapshot.FieldMode:
+		v, ok := value.(uint32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case filesnapshot.FieldAfterHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfterHash(v)
+		return nil
+	case filesnapshot.FieldUndone:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUndone(v)
+		return nil
+	case filesnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileSnapshot field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FileSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addmode != nil {
+		fields = append(fields, filesnapshot.FieldMode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FileSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case filesnapshot.FieldMode:
+		return m.AddedMode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FileSnapshotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case filesnapshot.FieldMode:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMode(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileSnapshot numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FileSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filesnapshot.FieldSessionKey) {
+		fields = append(fields, filesnapshot.FieldSessionKey)
+	}
+	if m.FieldCleared(filesnapshot.FieldContent) {
+		fields = append(fields, filesnapshot.FieldContent)
+	}
+	if m.FieldCleared(filesnapshot.FieldAfterHash) {
+		fields = append(fields, filesnapshot.FieldAfterHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FileSnapshotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case filesnapshot.FieldSessionKey:
+		m.ClearSessionKey()
+		return nil
+	case filesnapshot.FieldContent:
+		m.ClearContent()
+		return nil
+	case filesnapshot.FieldAfterHash:
+		m.ClearAfterHash()
+		return nil
+	}
+	return fmt.Errorf("unknown FileSnapshot nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FileSnapshotMutation) ResetField(name string) error {
+	switch name {
+	case filesnapshot.FieldSessionKey:
+		m.ResetSessionKey()
+		return nil
+	case filesnapshot.FieldPath:
+		m.ResetPath()
+		return nil
+	case filesnapshot.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case filesnapshot.FieldKind:
+		m.ResetKind()
+		return nil
+	case filesnapshot.FieldContent:
+		m.ResetContent()
+		return nil
+	case filesnapshot.FieldMode:
+		m.ResetMode()
+		return nil
+	case filesnapshot.FieldAfterHash:
+		m.ResetAfterHash()
+		return nil
+	case filesnapshot.FieldUndone:
+		m.ResetUndone()
+		return nil
+	case filesnapshot.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileSnapshot field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FileSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FileSnapshotMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FileSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FileSnapshotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FileSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FileSnapshotMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FileSnapshotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FileSnapshot unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FileSnapshotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FileSnapshot edge %s", name)
+}
+
+// InquiryMutation represents an operation that mutates the Inquiry nodes in the graph.
+type InquiryMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	session_key           *string
+	topic                 *string
+	question              *string
+	context               *string
+	options               *[]string
+	appendoptions         []string
+	priority              *inquiry.Priority
+	status                *inquiry.Status
+	answer                *string
+	knowledge_key         *string
+	source_observation_id *string
+	created_at            *time.Time
+	resolved_at           *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*Inquiry, error)
+	predicates            []predicate.Inquiry
+}
+
+var _ ent.Mutation = (*InquiryMutation)(nil)
+
+// inquiryOption allows management of the mutation configuration using functional options.
+type inquiryOption func(*InquiryMutation)
+
+// newInquiryMutation creates new mutation for the Inquiry entity.
+func newInquiryMutation(c config, op Op, opts ...inquiryOption) *InquiryMutation {
+	m := &InquiryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeInquiry,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withInquiryID sets the ID field of the mutation.
+func withInquiryID(id uuid.UUID) inquiryOption {
+	return func(m *InquiryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Inquiry
+		)
+		m.oldValue = func(ctx context.Context) (*Inquiry, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Inquiry.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withInquiry sets the old Inquiry of the mutation.
+func withInquiry(node *Inquiry) inquiryOption {
+	return func(m *InquiryMutation) {
+		m.oldValue = func(context.Context) (*Inquiry, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m InquiryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m InquiryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Inquiry entities.
+func (m *InquiryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *InquiryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *InquiryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Inquiry.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSessionKey sets the "session_key" field.
+func (m *InquiryMutation) SetSessionKey(s string) {
+	m.session_key = &s
+}
+
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *InquiryMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionKey returns the old "session_key" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
+	}
+	return oldValue.SessionKey, nil
+}
+
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *InquiryMutation) ResetSessionKey() {
+	m.session_key = nil
+}
+
+// SetTopic sets the "topic" field.
+func (m *InquiryMutation) SetTopic(s string) {
+	m.topic = &s
+}
+
+// Topic returns the value of the "topic" field in the mutation.
+func (m *InquiryMutation) Topic() (r string, exists bool) {
+	v := m.topic
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopic returns the old "topic" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldTopic(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+	}
+	return oldValue.Topic, nil
+}
+
+// ResetTopic resets all changes to the "topic" field.
+func (m *InquiryMutation) ResetTopic() {
+	m.topic = nil
+}
+
+// SetQuestion sets the "question" field.
+func (m *InquiryMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *InquiryMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *InquiryMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetContext sets the "context" field.
+func (m *InquiryMutation) SetContext(s string) {
+	m.context = &s
+}
+
+// Context returns the value of the "context" field in the mutation.
+func (m *InquiryMutation) Context() (r string, exists bool) {
+	v := m.context
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContext returns the old "context" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldContext(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContext is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContext requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContext: %w", err)
+	}
+	return oldValue.Context, nil
+}
+
+// ClearContext clears the value of the "context" field.
+func (m *InquiryMutation) ClearContext() {
+	m.context = nil
+	m.clearedFields[inquiry.FieldContext] = struct{}{}
+}
+
+// ContextCleared returns if the "context" field was cleared in this mutation.
+func (m *InquiryMutation) ContextCleared() bool {
+	_, ok := m.clearedFields[inquiry.FieldContext]
+	return ok
+}
+
+// ResetContext resets all changes to the "context" field.
+func (m *InquiryMutation) ResetContext() {
+	m.context = nil
+	delete(m.clearedFields, inquiry.FieldContext)
+}
+
+// SetOptions sets the "options" field.
+func (m *InquiryMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *InquiryMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *InquiryMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *InquiryMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *InquiryMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[inquiry.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *InquiryMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[inquiry.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *InquiryMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, inquiry.FieldOptions)
+}
+
+// SetPriority sets the "priority" field.
+func (m *InquiryMutation) SetPriority(i inquiry.Priority) {
+	m.priority = &i
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *InquiryMutation) Priority() (r inquiry.Priority, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldPriority(ctx context.Context) (v inquiry.Priority, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *InquiryMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *InquiryMutation) SetStatus(i inquiry.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *InquiryMutation) Status() (r inquiry.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldStatus(ctx context.Context) (v inquiry.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *InquiryMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *InquiryMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *InquiryMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldAnswer(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ClearAnswer clears the value of the "answer" field.
+func (m *InquiryMutation) ClearAnswer() {
+	m.answer = nil
+	m.clearedFields[inquiry.FieldAnswer] = struct{}{}
+}
+
+// AnswerCleared returns if the "answer" field was cleared in this mutation.
+func (m *InquiryMutation) AnswerCleared() bool {
+	_, ok := m.clearedFields[inquiry.FieldAnswer]
+	return ok
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *InquiryMutation) ResetAnswer() {
+	m.answer = nil
+	delete(m.clearedFields, inquiry.FieldAnswer)
+}
+
+// SetKnowledgeKey sets the "knowledge_key" field.
+func (m *InquiryMutation) SetKnowledgeKey(s string) {
+	m.knowledge_key = &s
+}
+
+// KnowledgeKey returns the value of the "knowledge_key" field in the mutation.
+func (m *InquiryMutation) KnowledgeKey() (r string, exists bool) {
+	v := m.knowledge_key
+	if v == nil {
+		return
 	}
-	if m.schedule_type != nil {
-		fields = append(fields, cronjob.FieldScheduleType)
+	return *v, true
+}
+
+// OldKnowledgeKey returns the old "knowledge_key" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldKnowledgeKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKnowledgeKey is only allowed on UpdateOne operations")
 	}
-	if m.schedule != nil {
-		fields = append(fields, cronjob.FieldSchedule)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKnowledgeKey requires an ID field in the mutation")
 	}
-	if m.prompt != nil {
-		fields = append(fields, cronjob.FieldPrompt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKnowledgeKey: %w", err)
 	}
-	if m.session_mode != nil {
-		fields = append(fields, cronjob.FieldSessionMode)
+	return oldValue.KnowledgeKey, nil
+}
+
+// ClearKnowledgeKey clears the value of the "knowledge_key" field.
+func (m *InquiryMutation) ClearKnowledgeKey() {
+	m.knowledge_key = nil
+	m.clearedFields[inquiry.FieldKnowledgeKey] = struct{}{}
+}
+
+// KnowledgeKeyCleared returns if the "knowledge_key" field was cleared in this mutation.
+func (m *InquiryMutation) KnowledgeKeyCleared() bool {
+	_, ok := m.clearedFields[inquiry.FieldKnowledgeKey]
+	return ok
+}
+
+// ResetKnowledgeKey resets all changes to the "knowledge_key" field.
+func (m *InquiryMutation) ResetKnowledgeKey() {
+	m.knowledge_key = nil
+	delete(m.clearedFields, inquiry.FieldKnowledgeKey)
+}
+
+// SetSourceObservationID sets the "source_observation_id" field.
+func (m *InquiryMutation) SetSourceObservationID(s string) {
+	m.source_observation_id = &s
+}
+
+// SourceObservationID returns the value of the "source_observation_id" field in the mutation.
+func (m *InquiryMutation) SourceObservationID() (r string, exists bool) {
+	v := m.source_observation_id
+	if v == nil {
+		return
 	}
-	if m.deliver_to != nil {
-		fields = append(fields, cronjob.FieldDeliverTo)
+	return *v, true
+}
+
+// OldSourceObservationID returns the old "source_observation_id" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldSourceObservationID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceObservationID is only allowed on UpdateOne operations")
 	}
-	if m.timezone != nil {
-		fields = append(fields, cronjob.FieldTimezone)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceObservationID requires an ID field in the mutation")
 	}
-	if m.enabled != nil {
-		fields = append(fields, cronjob.FieldEnabled)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceObservationID: %w", err)
 	}
-	if m.last_run_at != nil {
-		fields = append(fields, cronjob.FieldLastRunAt)
+	return oldValue.SourceObservationID, nil
+}
+
+// ClearSourceObservationID clears the value of the "source_observation_id" field.
+func (m *InquiryMutation) ClearSourceObservationID() {
+	m.source_observation_id = nil
+	m.clearedFields[inquiry.FieldSourceObservationID] = struct{}{}
+}
+
+// SourceObservationIDCleared returns if the "source_observation_id" field was cleared in this mutation.
+func (m *InquiryMutation) SourceObservationIDCleared() bool {
+	_, ok := m.clearedFields[inquiry.FieldSourceObservationID]
+	return ok
+}
+
+// ResetSourceObservationID resets all changes to the "source_observation_id" field.
+func (m *InquiryMutation) ResetSourceObservationID() {
+	m.source_observation_id = nil
+	delete(m.clearedFields, inquiry.FieldSourceObservationID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *InquiryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *InquiryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	if m.next_run_at != nil {
-		fields = append(fields, cronjob.FieldNextRunAt)
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *InquiryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *InquiryMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *InquiryMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Inquiry entity.
+// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *InquiryMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *InquiryMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[inquiry.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *InquiryMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[inquiry.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *InquiryMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, inquiry.FieldResolvedAt)
+}
+
+// Where appends a list predicates to the InquiryMutation builder.
+func (m *InquiryMutation) Where(ps ...predicate.Inquiry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the InquiryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *InquiryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Inquiry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *InquiryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *InquiryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Inquiry).
+func (m *InquiryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *InquiryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.session_key != nil {
+		fields = append(fields, inquiry.FieldSessionKey)
+	}
+	if m.topic != nil {
+		fields = append(fields, inquiry.FieldTopic)
+	}
+	if m.question != nil {
+		fields = append(fields, inquiry.FieldQuestion)
+	}
+	if m.context != nil {
+		fields = append(fields, inquiry.FieldContext)
+	}
+	if m.options != nil {
+		fields = append(fields, inquiry.FieldOptions)
+	}
+	if m.priority != nil {
+		fields = append(fields, inquiry.FieldPriority)
+	}
+	if m.status != nil {
+		fields = append(fields, inquiry.FieldStatus)
+	}
+	if m.answer != nil {
+		fields = append(fields, inquiry.FieldAnswer)
+	}
+	if m.knowledge_key != nil {
+		fields = append(fields, inquiry.FieldKnowledgeKey)
+	}
+	if m.source_observation_id != nil {
+		fields = append(fields, inquiry.FieldSourceObservationID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, cronjob.FieldCreatedAt)
+		fields = append(fields, inquiry.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, cronjob.FieldUpdatedAt)
+	if m.resolved_at != nil {
+		fields = append(fields, inquiry.FieldResolvedAt)
 	}
 	return fields
 }
@@ -2056,32 +6241,32 @@ func (m *CronJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CronJobMutation) Field(name string) (ent.Value, bool) {
+func (m *InquiryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cronjob.FieldName:
-		return m.Name()
-	case cronjob.FieldScheduleType:
-		return m.ScheduleType()
-	case cronjob.FieldSchedule:
-		return m.Schedule()
-	case cronjob.FieldPrompt:
-		return m.Prompt()
-	case cronjob.FieldSessionMode:
-		return m.SessionMode()
-	case cronjob.FieldDeliverTo:
-		return m.DeliverTo()
-	case cronjob.FieldTimezone:
-		return m.Timezone()
-	case cronjob.FieldEnabled:
-		return m.Enabled()
-	case cronjob.FieldLastRunAt:
-		return m.LastRunAt()
-	case cronjob.FieldNextRunAt:
-		return m.NextRunAt()
-	case cronjob.FieldCreatedAt:
+	case inquiry.FieldSessionKey:
+		return m.SessionKey()
+	case inquiry.FieldTopic:
+		return m.Topic()
+	case inquiry.FieldQuestion:
+		return m.Question()
+	case inquiry.FieldContext:
+		return m.Context()
+	case inquiry.FieldOptions:
+		return m.Options()
+	case inquiry.FieldPriority:
+		return m.Priority()
+	case inquiry.FieldStatus:
+		return m.Status()
+	case inquiry.FieldAnswer:
+		return m.Answer()
+	case inquiry.FieldKnowledgeKey:
+		return m.KnowledgeKey()
+	case inquiry.FieldSourceObservationID:
+		return m.SourceObservationID()
+	case inquiry.FieldCreatedAt:
 		return m.CreatedAt()
-	case cronjob.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case inquiry.FieldResolvedAt:
+		return m.ResolvedAt()
 	}
 	return nil, false
 }
@@ -2089,316 +6274,332 @@ func (m *CronJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CronJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *InquiryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cronjob.FieldName:
-		return m.OldName(ctx)
-	case cronjob.FieldScheduleType:
-		return m.OldScheduleType(ctx)
-	case cronjob.FieldSchedule:
-		return m.OldSchedule(ctx)
-	case cronjob.FieldPrompt:
-		return m.OldPrompt(ctx)
-	case cronjob.FieldSessionMode:
-		return m.OldSessionMode(ctx)
-	case cronjob.FieldDeliverTo:
-		return m.OldDeliverTo(ctx)
-	case cronjob.FieldTimezone:
-		return m.OldTimezone(ctx)
-	case cronjob.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case cronjob.FieldLastRunAt:
-		return m.OldLastRunAt(ctx)
-	case cronjob.FieldNextRunAt:
-		return m.OldNextRunAt(ctx)
-	case cronjob.FieldCreatedAt:
+	case inquiry.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case inquiry.FieldTopic:
+		return m.OldTopic(ctx)
+	case inquiry.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case inquiry.FieldContext:
+		return m.OldContext(ctx)
+	case inquiry.FieldOptions:
+		return m.OldOptions(ctx)
+	case inquiry.FieldPriority:
+		return m.OldPriority(ctx)
+	case inquiry.FieldStatus:
+		return m.OldStatus(ctx)
+	case inquiry.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case inquiry.FieldKnowledgeKey:
+		return m.OldKnowledgeKey(ctx)
+	case inquiry.FieldSourceObservationID:
+		return m.OldSourceObservationID(ctx)
+	case inquiry.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case cronjob.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case inquiry.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CronJob field %s", name)
+	return nil, fmt.Errorf("unknown Inquiry field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CronJobMutation) SetField(name string, value ent.Value) error {
+func (m *InquiryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cronjob.FieldName:
+	case inquiry.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetSessionKey(v)
 		return nil
-	case cronjob.FieldScheduleType:
-		v, ok := value.(cronjob.ScheduleType)
+	case inquiry.FieldTopic:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScheduleType(v)
+		m.SetTopic(v)
 		return nil
-	case cronjob.FieldSchedule:
+	case inquiry.FieldQuestion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSchedule(v)
+		m.SetQuestion(v)
 		return nil
-	case cronjob.FieldPrompt:
+	case inquiry.FieldContext:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrompt(v)
+		m.SetContext(v)
 		return nil
-	case cronjob.FieldSessionMode:
-		v, ok := value.(string)
+	case inquiry.FieldOptions:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionMode(v)
+		m.SetOptions(v)
 		return nil
-	case cronjob.FieldDeliverTo:
-		v, ok := value.([]string)
+	case inquiry.FieldPriority:
+		v, ok := value.(inquiry.Priority)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeliverTo(v)
+		m.SetPriority(v)
 		return nil
-	case cronjob.FieldTimezone:
-		v, ok := value.(string)
+	case inquiry.FieldStatus:
+		v, ok := value.(inquiry.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimezone(v)
+		m.SetStatus(v)
 		return nil
-	case cronjob.FieldEnabled:
-		v, ok := value.(bool)
+	case inquiry.FieldAnswer:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetAnswer(v)
 		return nil
-	case cronjob.FieldLastRunAt:
-		v, ok := value.(time.Time)
+	case inquiry.FieldKnowledgeKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastRunAt(v)
+		m.SetKnowledgeKey(v)
 		return nil
-	case cronjob.FieldNextRunAt:
-		v, ok := value.(time.Time)
+	case inquiry.FieldSourceObservationID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNextRunAt(v)
+		m.SetSourceObservationID(v)
 		return nil
-	case cronjob.FieldCreatedAt:
+	case inquiry.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case cronjob.FieldUpdatedAt:
+	case inquiry.FieldResolvedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetResolvedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CronJob field %s", name)
+	return fmt.Errorf("unknown Inquiry field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CronJobMutation) AddedFields() []string {
+func (m *InquiryMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CronJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *InquiryMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CronJobMutation) AddField(name string, value ent.Value) error {
+func (m *InquiryMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown CronJob numeric field %s", name)
+	return fmt.Errorf("unknown Inquiry numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CronJobMutation) ClearedFields() []string {
+func (m *InquiryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(cronjob.FieldDeliverTo) {
-		fields = append(fields, cronjob.FieldDeliverTo)
+	if m.FieldCleared(inquiry.FieldContext) {
+		fields = append(fields, inquiry.FieldContext)
 	}
-	if m.FieldCleared(cronjob.FieldLastRunAt) {
-		fields = append(fields, cronjob.FieldLastRunAt)
+	if m.FieldCleared(inquiry.FieldOptions) {
+		fields = append(fields, inquiry.FieldOptions)
 	}
-	if m.FieldCleared(cronjob.FieldNextRunAt) {
-		fields = append(fields, cronjob.FieldNextRunAt)
+	if m.FieldCleared(inquiry.FieldAnswer) {
+		fields = append(fields, inquiry.FieldAnswer)
+	}
+	if m.FieldCleared(inquiry.FieldKnowledgeKey) {
+		fields = append(fields, inquiry.FieldKnowledgeKey)
+	}
+	if m.FieldCleared(inquiry.FieldSourceObservationID) {
+		fields = append(fields, inquiry.FieldSourceObservationID)
+	}
+	if m.FieldCleared(inquiry.FieldResolvedAt) {
+		fields = append(fields, inquiry.FieldResolvedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CronJobMutation) FieldCleared(name string) bool {
+func (m *InquiryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CronJobMutation) ClearField(name string) error {
+func (m *InquiryMutation) ClearField(name string) error {
 	switch name {
-	case cronjob.FieldDeliverTo:
-		m.ClearDeliverTo()
+	case inquiry.FieldContext:
+		m.ClearContext()
 		return nil
-	case cronjob.FieldLastRunAt:
-		m.ClearLastRunAt()
+	case inquiry.FieldOptions:
+		m.ClearOptions()
 		return nil
-	case cronjob.FieldNextRunAt:
-		m.ClearNextRunAt()
+	case inquiry.FieldAnswer:
+		m.ClearAnswer()
+		return nil
+	case inquiry.FieldKnowledgeKey:
+		m.ClearKnowledgeKey()
+		return nil
+	case inquiry.FieldSourceObservationID:
+		m.ClearSourceObservationID()
+		return nil
+	case inquiry.FieldResolvedAt:
+		m.ClearResolvedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CronJob nullable field %s", name)
+	return fmt.Errorf("unknown Inquiry nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CronJobMutation) ResetField(name string) error {
+func (m *InquiryMutation) ResetField(name string) error {
 	switch name {
-	case cronjob.FieldName:
-		m.ResetName()
+	case inquiry.FieldSessionKey:
+		m.ResetSessionKey()
 		return nil
-	case cronjob.FieldScheduleType:
-		m.ResetScheduleType()
+	case inquiry.FieldTopic:
+		m.ResetTopic()
 		return nil
-	case cronjob.FieldSchedule:
-		m.ResetSchedule()
+	case inquiry.FieldQuestion:
+		m.ResetQuestion()
 		return nil
-	case cronjob.FieldPrompt:
-		m.ResetPrompt()
+	case inquiry.FieldContext:
+		m.ResetContext()
 		return nil
-	case cronjob.FieldSessionMode:
-		m.ResetSessionMode()
+	case inquiry.FieldOptions:
+		m.ResetOptions()
 		return nil
-	case cronjob.FieldDeliverTo:
-		m.ResetDeliverTo()
+	case inquiry.FieldPriority:
+		m.ResetPriority()
 		return nil
-	case cronjob.FieldTimezone:
-		m.ResetTimezone()
+	case inquiry.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case cronjob.FieldEnabled:
-		m.ResetEnabled()
+	case inquiry.FieldAnswer:
+		m.ResetAnswer()
 		return nil
-	case cronjob.FieldLastRunAt:
-		m.ResetLastRunAt()
+	case inquiry.FieldKnowledgeKey:
+		m.ResetKnowledgeKey()
 		return nil
-	case cronjob.FieldNextRunAt:
-		m.ResetNextRunAt()
+	case inquiry.FieldSourceObservationID:
+		m.ResetSourceObservationID()
 		return nil
-	case cronjob.FieldCreatedAt:
+	case inquiry.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case cronjob.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case inquiry.FieldResolvedAt:
+		m.ResetResolvedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CronJob field %s", name)
+	return fmt.Errorf("unknown Inquiry field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CronJobMutation) AddedEdges() []string {
+func (m *InquiryMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CronJobMutation) AddedIDs(name string) []ent.Value {
+func (m *InquiryMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CronJobMutation) RemovedEdges() []string {
+func (m *InquiryMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CronJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *InquiryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CronJobMutation) ClearedEdges() []string {
+func (m *InquiryMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CronJobMutation) EdgeCleared(name string) bool {
+func (m *InquiryMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CronJobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CronJob unique edge %s", name)
+func (m *InquiryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Inquiry unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CronJobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CronJob edge %s", name)
+func (m *InquiryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Inquiry edge %s", name)
 }
 
-// CronJobHistoryMutation represents an operation that mutates the CronJobHistory nodes in the graph.
-type CronJobHistoryMutation struct {
+// KeyMutation represents an operation that mutates the Key nodes in the graph.
+type KeyMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *uuid.UUID
-	job_id         *uuid.UUID
-	job_name       *string
-	status         *cronjobhistory.Status
-	prompt         *string
-	result         *string
-	error_message  *string
-	tokens_used    *int
-	addtokens_used *int
-	started_at     *time.Time
-	completed_at   *time.Time
+	name           *string
+	remote_key_id  *string
+	_type          *key.Type
+	created_at     *time.Time
+	last_used_at   *time.Time
 	clearedFields  map[string]struct{}
+	secrets        map[uuid.UUID]struct{}
+	removedsecrets map[uuid.UUID]struct{}
+	clearedsecrets bool
 	done           bool
-	oldValue       func(context.Context) (*CronJobHistory, error)
-	predicates     []predicate.CronJobHistory
+	oldValue       func(context.Context) (*Key, error)
+	predicates     []predicate.Key
 }
 
-var _ ent.Mutation = (*CronJobHistoryMutation)(nil)
+var _ ent.Mutation = (*KeyMutation)(nil)
 
-// cronjobhistoryOption allows management of the mutation configuration using functional options.
-type cronjobhistoryOption func(*CronJobHistoryMutation)
+// keyOption allows management of the mutation configuration using functional options.
+type keyOption func(*KeyMutation)
 
-// newCronJobHistoryMutation creates new mutation for the CronJobHistory entity.
-func newCronJobHistoryMutation(c config, op Op, opts ...cronjobhistoryOption) *CronJobHistoryMutation {
-	m := &CronJobHistoryMutation{
+// newKeyMutation creates new mutation for the Key entity.
+func newKeyMutation(c config, op Op, opts ...keyOption) *KeyMutation {
+	m := &KeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCronJobHistory,
+		typ:           TypeKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2407,20 +6608,20 @@ func newCronJobHistoryMutation(c config, op Op, opts ...cronjobhistoryOption) *C
 	return m
 }
 
-// withCronJobHistoryID sets the ID field of the mutation.
-func withCronJobHistoryID(id uuid.UUID) cronjobhistoryOption {
-	return func(m *CronJobHistoryMutation) {
+// withKeyID sets the ID field of the mutation.
+func withKeyID(id uuid.UUID) keyOption {
+	return func(m *KeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CronJobHistory
+			value *Key
 		)
-		m.oldValue = func(ctx context.Context) (*CronJobHistory, error) {
+		m.oldValue = func(ctx context.Context) (*Key, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CronJobHistory.Get(ctx, id)
+					value, err = m.Client().Key.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2429,10 +6630,10 @@ func withCronJobHistoryID(id uuid.UUID) cronjobhistoryOption {
 	}
 }
 
-// withCronJobHistory sets the old CronJobHistory of the mutation.
-func withCronJobHistory(node *CronJobHistory) cronjobhistoryOption {
-	return func(m *CronJobHistoryMutation) {
-		m.oldValue = func(context.Context) (*CronJobHistory, error) {
+// withKey sets the old Key of the mutation.
+func withKey(node *Key) keyOption {
+	return func(m *KeyMutation) {
+		m.oldValue = func(context.Context) (*Key, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2441,7 +6642,7 @@ func withCronJobHistory(node *CronJobHistory) cronjobhistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CronJobHistoryMutation) Client() *Client {
+func (m KeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2449,7 +6650,7 @@ func (m CronJobHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CronJobHistoryMutation) Tx() (*Tx, error) {
+func (m KeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2459,14 +6660,14 @@ func (m CronJobHistoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CronJobHistory entities.
-func (m *CronJobHistoryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Key entities.
+func (m *KeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CronJobHistoryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *KeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2477,7 +6678,7 @@ func (m *CronJobHistoryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CronJobHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *KeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2486,404 +6687,268 @@ func (m *CronJobHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CronJobHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Key.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetJobID sets the "job_id" field.
-func (m *CronJobHistoryMutation) SetJobID(u uuid.UUID) {
-	m.job_id = &u
-}
-
-// JobID returns the value of the "job_id" field in the mutation.
-func (m *CronJobHistoryMutation) JobID() (r uuid.UUID, exists bool) {
-	v := m.job_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldJobID returns the old "job_id" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldJobID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobID: %w", err)
-	}
-	return oldValue.JobID, nil
-}
-
-// ResetJobID resets all changes to the "job_id" field.
-func (m *CronJobHistoryMutation) ResetJobID() {
-	m.job_id = nil
-}
-
-// SetJobName sets the "job_name" field.
-func (m *CronJobHistoryMutation) SetJobName(s string) {
-	m.job_name = &s
-}
-
-// JobName returns the value of the "job_name" field in the mutation.
-func (m *CronJobHistoryMutation) JobName() (r string, exists bool) {
-	v := m.job_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldJobName returns the old "job_name" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldJobName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJobName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJobName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJobName: %w", err)
-	}
-	return oldValue.JobName, nil
-}
-
-// ResetJobName resets all changes to the "job_name" field.
-func (m *CronJobHistoryMutation) ResetJobName() {
-	m.job_name = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *CronJobHistoryMutation) SetStatus(c cronjobhistory.Status) {
-	m.status = &c
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *CronJobHistoryMutation) Status() (r cronjobhistory.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldStatus(ctx context.Context) (v cronjobhistory.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *CronJobHistoryMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetPrompt sets the "prompt" field.
-func (m *CronJobHistoryMutation) SetPrompt(s string) {
-	m.prompt = &s
-}
-
-// Prompt returns the value of the "prompt" field in the mutation.
-func (m *CronJobHistoryMutation) Prompt() (r string, exists bool) {
-	v := m.prompt
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPrompt returns the old "prompt" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldPrompt(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrompt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrompt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrompt: %w", err)
-	}
-	return oldValue.Prompt, nil
-}
-
-// ResetPrompt resets all changes to the "prompt" field.
-func (m *CronJobHistoryMutation) ResetPrompt() {
-	m.prompt = nil
-}
-
-// SetResult sets the "result" field.
-func (m *CronJobHistoryMutation) SetResult(s string) {
-	m.result = &s
+// SetName sets the "name" field.
+func (m *KeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Result returns the value of the "result" field in the mutation.
-func (m *CronJobHistoryMutation) Result() (r string, exists bool) {
-	v := m.result
+// Name returns the value of the "name" field in the mutation.
+func (m *KeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResult returns the old "result" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Key entity.
+// If the Key object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldResult(ctx context.Context) (v string, err error) {
+func (m *KeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResult requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Result, nil
-}
-
-// ClearResult clears the value of the "result" field.
-func (m *CronJobHistoryMutation) ClearResult() {
-	m.result = nil
-	m.clearedFields[cronjobhistory.FieldResult] = struct{}{}
-}
-
-// ResultCleared returns if the "result" field was cleared in this mutation.
-func (m *CronJobHistoryMutation) ResultCleared() bool {
-	_, ok := m.clearedFields[cronjobhistory.FieldResult]
-	return ok
+	return oldValue.Name, nil
 }
 
-// ResetResult resets all changes to the "result" field.
-func (m *CronJobHistoryMutation) ResetResult() {
-	m.result = nil
-	delete(m.clearedFields, cronjobhistory.FieldResult)
+// ResetName resets all changes to the "name" field.
+func (m *KeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *CronJobHistoryMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetRemoteKeyID sets the "remote_key_id" field.
+func (m *KeyMutation) SetRemoteKeyID(s string) {
+	m.remote_key_id = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *CronJobHistoryMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// RemoteKeyID returns the value of the "remote_key_id" field in the mutation.
+func (m *KeyMutation) RemoteKeyID() (r string, exists bool) {
+	v := m.remote_key_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldRemoteKeyID returns the old "remote_key_id" field's value of the Key entity.
+// If the Key object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *KeyMutation) OldRemoteKeyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemoteKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldRemoteKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemoteKeyID: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *CronJobHistoryMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[cronjobhistory.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *CronJobHistoryMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[cronjobhistory.FieldErrorMessage]
-	return ok
+	return oldValue.RemoteKeyID, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *CronJobHistoryMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, cronjobhistory.FieldErrorMessage)
+// ResetRemoteKeyID resets all changes to the "remote_key_id" field.
+func (m *KeyMutation) ResetRemoteKeyID() {
+	m.remote_key_id = nil
 }
 
-// SetTokensUsed sets the "tokens_used" field.
-func (m *CronJobHistoryMutation) SetTokensUsed(i int) {
-	m.tokens_used = &i
-	m.addtokens_used = nil
+// SetType sets the "type" field.
+func (m *KeyMutation) SetType(k key.Type) {
+	m._type = &k
 }
 
-// TokensUsed returns the value of the "tokens_used" field in the mutation.
-func (m *CronJobHistoryMutation) TokensUsed() (r int, exists bool) {
-	v := m.tokens_used
+// GetType returns the value of the "type" field in the mutation.
+func (m *KeyMutation) GetType() (r key.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokensUsed returns the old "tokens_used" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Key entity.
+// If the Key object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldTokensUsed(ctx context.Context) (v int, err error) {
+func (m *KeyMutation) OldType(ctx context.Context) (v key.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokensUsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokensUsed requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokensUsed: %w", err)
-	}
-	return oldValue.TokensUsed, nil
-}
-
-// AddTokensUsed adds i to the "tokens_used" field.
-func (m *CronJobHistoryMutation) AddTokensUsed(i int) {
-	if m.addtokens_used != nil {
-		*m.addtokens_used += i
-	} else {
-		m.addtokens_used = &i
-	}
-}
-
-// AddedTokensUsed returns the value that was added to the "tokens_used" field in this mutation.
-func (m *CronJobHistoryMutation) AddedTokensUsed() (r int, exists bool) {
-	v := m.addtokens_used
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return *v, true
+	return oldValue.Type, nil
 }
 
-// ResetTokensUsed resets all changes to the "tokens_used" field.
-func (m *CronJobHistoryMutation) ResetTokensUsed() {
-	m.tokens_used = nil
-	m.addtokens_used = nil
+// ResetType resets all changes to the "type" field.
+func (m *KeyMutation) ResetType() {
+	m._type = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *CronJobHistoryMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *KeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *CronJobHistoryMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Key entity.
+// If the Key object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *CronJobHistoryMutation) ResetStartedAt() {
-	m.started_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *CronJobHistoryMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *KeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *CronJobHistoryMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *KeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletedAt returns the old "completed_at" field's value of the CronJobHistory entity.
-// If the CronJobHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the Key entity.
+// If the Key object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CronJobHistoryMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *KeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.CompletedAt, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *CronJobHistoryMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[cronjobhistory.FieldCompletedAt] = struct{}{}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *KeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[key.FieldLastUsedAt] = struct{}{}
 }
 
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *CronJobHistoryMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[cronjobhistory.FieldCompletedAt]
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *KeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[key.FieldLastUsedAt]
 	return ok
 }
 
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *CronJobHistoryMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, cronjobhistory.FieldCompletedAt)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *KeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, key.FieldLastUsedAt)
+}
+
+// AddSecretIDs adds the "secrets" edge to the Secret entity by ids.
+func (m *KeyMutation) AddSecretIDs(ids ...uuid.UUID) {
+	if m.secrets == nil {
+		m.secrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.secrets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSecrets clears the "secrets" edge to the Secret entity.
+func (m *KeyMutation) ClearSecrets() {
+	m.clearedsecrets = true
+}
+
+// SecretsCleared reports if the "secrets" edge to the Secret entity was cleared.
+func (m *KeyMutation) SecretsCleared() bool {
+	return m.clearedsecrets
+}
+
+// RemoveSecretIDs removes the "secrets" edge to the Secret entity by IDs.
+func (m *KeyMutation) RemoveSecretIDs(ids ...uuid.UUID) {
+	if m.removedsecrets == nil {
+		m.removedsecrets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.secrets, ids[i])
+		m.removedsecrets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSecrets returns the removed IDs of the "secrets" edge to the Secret entity.
+func (m *KeyMutation) RemovedSecretsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsecrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SecretsIDs returns the "secrets" edge IDs in the mutation.
+func (m *KeyMutation) SecretsIDs() (ids []uuid.UUID) {
+	for id := range m.secrets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSecrets resets all changes to the "secrets" edge.
+func (m *KeyMutation) ResetSecrets() {
+	m.secrets = nil
+	m.clearedsecrets = false
+	m.removedsecrets = nil
 }
 
-// Where appends a list predicates to the CronJobHistoryMutation builder.
-func (m *CronJobHistoryMutation) Where(ps ...predicate.CronJobHistory) {
+// Where appends a list predicates to the KeyMutation builder.
+func (m *KeyMutation) Where(ps ...predicate.Key) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CronJobHistoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the KeyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CronJobHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CronJobHistory, len(ps))
+func (m *KeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Key, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2891,51 +6956,39 @@ func (m *CronJobHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CronJobHistoryMutation) Op() Op {
+func (m *KeyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CronJobHistoryMutation) SetOp(op Op) {
+func (m *KeyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CronJobHistory).
-func (m *CronJobHistoryMutation) Type() string {
+// Type returns the node type of this mutation (Key).
+func (m *KeyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CronJobHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.job_id != nil {
-		fields = append(fields, cronjobhistory.FieldJobID)
-	}
-	if m.job_name != nil {
-		fields = append(fields, cronjobhistory.FieldJobName)
-	}
-	if m.status != nil {
-		fields = append(fields, cronjobhistory.FieldStatus)
-	}
-	if m.prompt != nil {
-		fields = append(fields, cronjobhistory.FieldPrompt)
-	}
-	if m.result != nil {
-		fields = append(fields, cronjobhistory.FieldResult)
+func (m *KeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, key.FieldName)
 	}
-	if m.error_message != nil {
-		fields = append(fields, cronjobhistory.FieldErrorMessage)
+	if m.remote_key_id != nil {
+		fields = append(fields, key.FieldRemoteKeyID)
 	}
-	if m.tokens_used != nil {
-		fields = append(fields, cronjobhistory.FieldTokensUsed)
+	if m._type != nil {
+		fields = append(fields, key.FieldType)
 	}
-	if m.started_at != nil {
-		fields = append(fields, cronjobhistory.FieldStartedAt)
+	if m.created_at != nil {
+		fields = append(fields, key.FieldCreatedAt)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, cronjobhistory.FieldCompletedAt)
+	if m.last_used_at != nil {
+		fields = append(fields, key.FieldLastUsedAt)
 	}
 	return fields
 }
@@ -2943,26 +6996,18 @@ func (m *CronJobHistoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CronJobHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *KeyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cronjobhistory.FieldJobID:
-		return m.JobID()
-	case cronjobhistory.FieldJobName:
-		return m.JobName()
-	case cronjobhistory.FieldStatus:
-		return m.Status()
-	case cronjobhistory.FieldPrompt:
-		return m.Prompt()
-	case cronjobhistory.FieldResult:
-		return m.Result()
-	case cronjobhistory.FieldErrorMessage:
-		return m.ErrorMessage()
-	case cronjobhistory.FieldTokensUsed:
-		return m.TokensUsed()
-	case cronjobhistory.FieldStartedAt:
-		return m.StartedAt()
-	case cronjobhistory.FieldCompletedAt:
-		return m.CompletedAt()
+	case key.FieldName:
+		return m.Name()
+	case key.FieldRemoteKeyID:
+		return m.RemoteKeyID()
+	case key.FieldType:
+		return m.GetType()
+	case key.FieldCreatedAt:
+		return m.CreatedAt()
+	case key.FieldLastUsedAt:
+		return m.LastUsedAt()
 	}
 	return nil, false
 }
@@ -2970,292 +7015,258 @@ func (m *CronJobHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CronJobHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *KeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cronjobhistory.FieldJobID:
-		return m.OldJobID(ctx)
-	case cronjobhistory.FieldJobName:
-		return m.OldJobName(ctx)
-	case cronjobhistory.FieldStatus:
-		return m.OldStatus(ctx)
-	case cronjobhistory.FieldPrompt:
-		return m.OldPrompt(ctx)
-	case cronjobhistory.FieldResult:
-		return m.OldResult(ctx)
-	case cronjobhistory.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case cronjobhistory.FieldTokensUsed:
-		return m.OldTokensUsed(ctx)
-	case cronjobhistory.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case cronjobhistory.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
+	case key.FieldName:
+		return m.OldName(ctx)
+	case key.FieldRemoteKeyID:
+		return m.OldRemoteKeyID(ctx)
+	case key.FieldType:
+		return m.OldType(ctx)
+	case key.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case key.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown CronJobHistory field %s", name)
+	return nil, fmt.Errorf("unknown Key field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CronJobHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *KeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cronjobhistory.FieldJobID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobID(v)
-		return nil
-	case cronjobhistory.FieldJobName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetJobName(v)
-		return nil
-	case cronjobhistory.FieldStatus:
-		v, ok := value.(cronjobhistory.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case cronjobhistory.FieldPrompt:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrompt(v)
-		return nil
-	case cronjobhistory.FieldResult:
+	case key.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResult(v)
+		m.SetName(v)
 		return nil
-	case cronjobhistory.FieldErrorMessage:
+	case key.FieldRemoteKeyID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetRemoteKeyID(v)
 		return nil
-	case cronjobhistory.FieldTokensUsed:
-		v, ok := value.(int)
+	case key.FieldType:
+		v, ok := value.(key.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokensUsed(v)
+		m.SetType(v)
 		return nil
-	case cronjobhistory.FieldStartedAt:
+	case key.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case cronjobhistory.FieldCompletedAt:
+	case key.FieldLastUsedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompletedAt(v)
+		m.SetLastUsedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CronJobHistory field %s", name)
+	return fmt.Errorf("unknown Key field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CronJobHistoryMutation) AddedFields() []string {
-	var fields []string
-	if m.addtokens_used != nil {
-		fields = append(fields, cronjobhistory.FieldTokensUsed)
-	}
-	return fields
+func (m *KeyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CronJobHistoryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case cronjobhistory.FieldTokensUsed:
-		return m.AddedTokensUsed()
-	}
+func (m *KeyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CronJobHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *KeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case cronjobhistory.FieldTokensUsed:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTokensUsed(v)
-		return nil
 	}
-	return fmt.Errorf("unknown CronJobHistory numeric field %s", name)
+	return fmt.Errorf("unknown Key numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CronJobHistoryMutation) ClearedFields() []string {
+func (m *KeyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(cronjobhistory.FieldResult) {
-		fields = append(fields, cronjobhistory.FieldResult)
-	}
-	if m.FieldCleared(cronjobhistory.FieldErrorMessage) {
-		fields = append(fields, cronjobhistory.FieldErrorMessage)
-	}
-	if m.FieldCleared(cronjobhistory.FieldCompletedAt) {
-		fields = append(fields, cronjobhistory.FieldCompletedAt)
+	if m.FieldCleared(key.FieldLastUsedAt) {
+		fields = append(fields, key.FieldLastUsedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CronJobHistoryMutation) FieldCleared(name string) bool {
+func (m *KeyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CronJobHistoryMutation) ClearField(name string) error {
+func (m *KeyMutation) ClearField(name string) error {
 	switch name {
-	case cronjobhistory.FieldResult:
-		m.ClearResult()
-		return nil
-	case cronjobhistory.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case cronjobhistory.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case key.FieldLastUsedAt:
+		m.ClearLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CronJobHistory nullable field %s", name)
+	return fmt.Errorf("unknown Key nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CronJobHistoryMutation) ResetField(name string) error {
+func (m *KeyMutation) ResetField(name string) error {
 	switch name {
-	case cronjobhistory.FieldJobID:
-		m.ResetJobID()
-		return nil
-	case cronjobhistory.FieldJobName:
-		m.ResetJobName()
-		return nil
-	case cronjobhistory.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case cronjobhistory.FieldPrompt:
-		m.ResetPrompt()
-		return nil
-	case cronjobhistory.FieldResult:
-		m.ResetResult()
+	case key.FieldName:
+		m.ResetName()
 		return nil
-	case cronjobhistory.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case key.FieldRemoteKeyID:
+		m.ResetRemoteKeyID()
 		return nil
-	case cronjobhistory.FieldTokensUsed:
-		m.ResetTokensUsed()
+	case key.FieldType:
+		m.ResetType()
 		return nil
-	case cronjobhistory.FieldStartedAt:
-		m.ResetStartedAt()
+	case key.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case cronjobhistory.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case key.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown CronJobHistory field %s", name)
+	return fmt.Errorf("unknown Key field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CronJobHistoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *KeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.secrets != nil {
+		edges = append(edges, key.EdgeSecrets)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CronJobHistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *KeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case key.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.secrets))
+		for id := range m.secrets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CronJobHistoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *KeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedsecrets != nil {
+		edges = append(edges, key.EdgeSecrets)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CronJobHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *KeyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case key.EdgeSecrets:
+		ids := make([]ent.Value, 0, len(m.removedsecrets))
+		for id := range m.removedsecrets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CronJobHistoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *KeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsecrets {
+		edges = append(edges, key.EdgeSecrets)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CronJobHistoryMutation) EdgeCleared(name string) bool {
+func (m *KeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case key.EdgeSecrets:
+		return m.clearedsecrets
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CronJobHistoryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown CronJobHistory unique edge %s", name)
+func (m *KeyMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Key unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CronJobHistoryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown CronJobHistory edge %s", name)
+func (m *KeyMutation) ResetEdge(name string) error {
+	switch name {
+	case key.EdgeSecrets:
+		m.ResetSecrets()
+		return nil
+	}
+	return fmt.Errorf("unknown Key edge %s", name)
 }
 
-// ExternalRefMutation represents an operation that mutates the ExternalRef nodes in the graph.
-type ExternalRefMutation struct {
+// KnowledgeMutation represents an operation that mutates the Knowledge nodes in the graph.
+type KnowledgeMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	name          *string
-	ref_type      *externalref.RefType
-	location      *string
-	summary       *string
-	metadata      *map[string]interface{}
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*ExternalRef, error)
-	predicates    []predicate.ExternalRef
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	key                *string
+	category           *knowledge.Category
+	content            *string
+	tags               *[]string
+	appendtags         []string
+	source             *string
+	use_count          *int
+	adduse_count       *int
+	relevance_score    *float64
+	addrelevance_score *float64
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*Knowledge, error)
+	predicates         []predicate.Knowledge
 }
 
-var _ ent.Mutation = (*ExternalRefMutation)(nil)
+var _ ent.Mutation = (*KnowledgeMutation)(nil)
 
-// externalrefOption allows management of the mutation configuration using functional options.
-type externalrefOption func(*ExternalRefMutation)
+// knowledgeOption allows management of the mutation configuration using functional options.
+type knowledgeOption func(*KnowledgeMutation)
 
-// newExternalRefMutation creates new mutation for the ExternalRef entity.
-func newExternalRefMutation(c config, op Op, opts ...externalrefOption) *ExternalRefMutation {
-	m := &ExternalRefMutation{
+// newKnowledgeMutation creates new mutation for the Knowledge entity.
+func newKnowledgeMutation(c config, op Op, opts ...knowledgeOption) *KnowledgeMutation {
+	m := &KnowledgeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExternalRef,
+		typ:           TypeKnowledge,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3264,20 +7275,20 @@ func newExternalRefMutation(c config, op Op, opts ...externalrefOption) *Externa
 	return m
 }
 
-// withExternalRefID sets the ID field of the mutation.
-func withExternalRefID(id uuid.UUID) externalrefOption {
-	return func(m *ExternalRefMutation) {
+// withKnowledgeID sets the ID field of the mutation.
+func withKnowledgeID(id uuid.UUID) knowledgeOption {
+	return func(m *KnowledgeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExternalRef
+			value *Knowledge
 		)
-		m.oldValue = func(ctx context.Context) (*ExternalRef, error) {
+		m.oldValue = func(ctx context.Context) (*Knowledge, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExternalRef.Get(ctx, id)
+					value, err = m.Client().Knowledge.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3286,10 +7297,10 @@ func withExternalRefID(id uuid.UUID) externalrefOption {
 	}
 }
 
-// withExternalRef sets the old ExternalRef of the mutation.
-func withExternalRef(node *ExternalRef) externalrefOption {
-	return func(m *ExternalRefMutation) {
-		m.oldValue = func(context.Context) (*ExternalRef, error) {
+// withKnowledge sets the old Knowledge of the mutation.
+func withKnowledge(node *Knowledge) knowledgeOption {
+	return func(m *KnowledgeMutation) {
+		m.oldValue = func(context.Context) (*Knowledge, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3298,7 +7309,7 @@ func withExternalRef(node *ExternalRef) externalrefOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExternalRefMutation) Client() *Client {
+func (m KnowledgeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3306,7 +7317,7 @@ func (m ExternalRefMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExternalRefMutation) Tx() (*Tx, error) {
+func (m KnowledgeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3316,14 +7327,14 @@ func (m ExternalRefMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ExternalRef entities.
-func (m *ExternalRefMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Knowledge entities.
+func (m *KnowledgeMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExternalRefMutation) ID() (id uuid.UUID, exists bool) {
+func (m *KnowledgeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3334,7 +7345,7 @@ func (m *ExternalRefMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExternalRefMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *KnowledgeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3343,225 +7354,353 @@ func (m *ExternalRefMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExternalRef.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Knowledge.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *ExternalRefMutation) SetName(s string) {
-	m.name = &s
+// SetKey sets the "key" field.
+func (m *KnowledgeMutation) SetKey(s string) {
+	m.key = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ExternalRefMutation) Name() (r string, exists bool) {
-	v := m.name
+// Key returns the value of the "key" field in the mutation.
+func (m *KnowledgeMutation) Key() (r string, exists bool) {
+	v := m.key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldKey returns the old "key" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *KnowledgeMutation) OldKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Key, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ExternalRefMutation) ResetName() {
-	m.name = nil
+// ResetKey resets all changes to the "key" field.
+func (m *KnowledgeMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *KnowledgeMutation) SetCategory(k knowledge.Category) {
+	m.category = &k
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *KnowledgeMutation) Category() (r knowledge.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeMutation) OldCategory(ctx context.Context) (v knowledge.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *KnowledgeMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetContent sets the "content" field.
+func (m *KnowledgeMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *KnowledgeMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *KnowledgeMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetRefType sets the "ref_type" field.
-func (m *ExternalRefMutation) SetRefType(et externalref.RefType) {
-	m.ref_type = &et
+// SetTags sets the "tags" field.
+func (m *KnowledgeMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// RefType returns the value of the "ref_type" field in the mutation.
-func (m *ExternalRefMutation) RefType() (r externalref.RefType, exists bool) {
-	v := m.ref_type
+// Tags returns the value of the "tags" field in the mutation.
+func (m *KnowledgeMutation) Tags() (r []string, exists bool) {
+	v := m.tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefType returns the old "ref_type" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldTags returns the old "tags" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldRefType(ctx context.Context) (v externalref.RefType, err error) {
+func (m *KnowledgeMutation) OldTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefType is only allowed on UpdateOne operations")
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefType requires an ID field in the mutation")
+		return v, errors.New("OldTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefType: %w", err)
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
 	}
-	return oldValue.RefType, nil
+	return oldValue.Tags, nil
 }
 
-// ResetRefType resets all changes to the "ref_type" field.
-func (m *ExternalRefMutation) ResetRefType() {
-	m.ref_type = nil
+// AppendTags adds s to the "tags" field.
+func (m *KnowledgeMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
 }
 
-// SetLocation sets the "location" field.
-func (m *ExternalRefMutation) SetLocation(s string) {
-	m.location = &s
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *KnowledgeMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *ExternalRefMutation) Location() (r string, exists bool) {
-	v := m.location
+// ClearTags clears the value of the "tags" field.
+func (m *KnowledgeMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[knowledge.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *KnowledgeMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[knowledge.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *KnowledgeMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, knowledge.FieldTags)
+}
+
+// SetSource sets the "source" field.
+func (m *KnowledgeMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *KnowledgeMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldLocation(ctx context.Context) (v string, err error) {
+func (m *KnowledgeMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Location, nil
+	return oldValue.Source, nil
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *ExternalRefMutation) ResetLocation() {
-	m.location = nil
+// ClearSource clears the value of the "source" field.
+func (m *KnowledgeMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[knowledge.FieldSource] = struct{}{}
 }
 
-// SetSummary sets the "summary" field.
-func (m *ExternalRefMutation) SetSummary(s string) {
-	m.summary = &s
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *KnowledgeMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[knowledge.FieldSource]
+	return ok
 }
 
-// Summary returns the value of the "summary" field in the mutation.
-func (m *ExternalRefMutation) Summary() (r string, exists bool) {
-	v := m.summary
+// ResetSource resets all changes to the "source" field.
+func (m *KnowledgeMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, knowledge.FieldSource)
+}
+
+// SetUseCount sets the "use_count" field.
+func (m *KnowledgeMutation) SetUseCount(i int) {
+	m.use_count = &i
+	m.adduse_count = nil
+}
+
+// UseCount returns the value of the "use_count" field in the mutation.
+func (m *KnowledgeMutation) UseCount() (r int, exists bool) {
+	v := m.use_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSummary returns the old "summary" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldUseCount returns the old "use_count" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldSummary(ctx context.Context) (v string, err error) {
+func (m *KnowledgeMutation) OldUseCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSummary is only allowed on UpdateOne operations")
+		return v, errors.New("OldUseCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSummary requires an ID field in the mutation")
+		return v, errors.New("OldUseCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSummary: %w", err)
+		return v, fmt.Errorf("querying old value for OldUseCount: %w", err)
 	}
-	return oldValue.Summary, nil
+	return oldValue.UseCount, nil
 }
 
-// ClearSummary clears the value of the "summary" field.
-func (m *ExternalRefMutation) ClearSummary() {
-	m.summary = nil
-	m.clearedFields[externalref.FieldSummary] = struct{}{}
+// AddUseCount adds i to the "use_count" field.
+func (m *KnowledgeMutation) AddUseCount(i int) {
+	if m.adduse_count != nil {
+		*m.adduse_count += i
+	} else {
+		m.adduse_count = &i
+	}
 }
 
-// SummaryCleared returns if the "summary" field was cleared in this mutation.
-func (m *ExternalRefMutation) SummaryCleared() bool {
-	_, ok := m.clearedFields[externalref.FieldSummary]
-	return ok
+// AddedUseCount returns the value that was added to the "use_count" field in this mutation.
+func (m *KnowledgeMutation) AddedUseCount() (r int, exists bool) {
+	v := m.adduse_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSummary resets all changes to the "summary" field.
-func (m *ExternalRefMutation) ResetSummary() {
-	m.summary = nil
-	delete(m.clearedFields, externalref.FieldSummary)
+// ResetUseCount resets all changes to the "use_count" field.
+func (m *KnowledgeMutation) ResetUseCount() {
+	m.use_count = nil
+	m.adduse_count = nil
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *ExternalRefMutation) SetMetadata(value map[string]interface{}) {
-	m.metadata = &value
+// SetRelevanceScore sets the "relevance_score" field.
+func (m *KnowledgeMutation) SetRelevanceScore(f float64) {
+	m.relevance_score = &f
+	m.addrelevance_score = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *ExternalRefMutation) Metadata() (r map[string]interface{}, exists bool) {
-	v := m.metadata
+// RelevanceScore returns the value of the "relevance_score" field in the mutation.
+func (m *KnowledgeMutation) RelevanceScore() (r float64, exists bool) {
+	v := m.relevance_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldRelevanceScore returns the old "relevance_score" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *KnowledgeMutation) OldRelevanceScore(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldRelevanceScore is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldRelevanceScore requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldRelevanceScore: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.RelevanceScore, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *ExternalRefMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[externalref.FieldMetadata] = struct{}{}
+// AddRelevanceScore adds f to the "relevance_score" field.
+func (m *KnowledgeMutation) AddRelevanceScore(f float64) {
+	if m.addrelevance_score != nil {
+		*m.addrelevance_score += f
+	} else {
+		m.addrelevance_score = &f
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *ExternalRefMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[externalref.FieldMetadata]
-	return ok
+// AddedRelevanceScore returns the value that was added to the "relevance_score" field in this mutation.
+func (m *KnowledgeMutation) AddedRelevanceScore() (r float64, exists bool) {
+	v := m.addrelevance_score
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *ExternalRefMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, externalref.FieldMetadata)
+// ResetRelevanceScore resets all changes to the "relevance_score" field.
+func (m *KnowledgeMutation) ResetRelevanceScore() {
+	m.relevance_score = nil
+	m.addrelevance_score = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ExternalRefMutation) SetCreatedAt(t time.Time) {
+func (m *KnowledgeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ExternalRefMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *KnowledgeMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3569,10 +7708,10 @@ func (m *ExternalRefMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3587,17 +7726,17 @@ func (m *ExternalRefMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ExternalRefMutation) ResetCreatedAt() {
+func (m *KnowledgeMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ExternalRefMutation) SetUpdatedAt(t time.Time) {
+func (m *KnowledgeMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ExternalRefMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *KnowledgeMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3605,10 +7744,10 @@ func (m *ExternalRefMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ExternalRef entity.
-// If the ExternalRef object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Knowledge entity.
+// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalRefMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3623,19 +7762,19 @@ func (m *ExternalRefMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ExternalRefMutation) ResetUpdatedAt() {
+func (m *KnowledgeMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// Where appends a list predicates to the ExternalRefMutation builder.
-func (m *ExternalRefMutation) Where(ps ...predicate.ExternalRef) {
+// Where appends a list predicates to the KnowledgeMutation builder.
+func (m *KnowledgeMutation) Where(ps ...predicate.Knowledge) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ExternalRefMutation builder. Using this method,
+// WhereP appends storage-level predicates to the KnowledgeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExternalRefMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExternalRef, len(ps))
+func (m *KnowledgeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Knowledge, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3643,45 +7782,51 @@ func (m *ExternalRefMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ExternalRefMutation) Op() Op {
+func (m *KnowledgeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ExternalRefMutation) SetOp(op Op) {
+func (m *KnowledgeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (ExternalRef).
-func (m *ExternalRefMutation) Type() string {
+// Type returns the node type of this mutation (Knowledge).
+func (m *KnowledgeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ExternalRefMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, externalref.FieldName)
+func (m *KnowledgeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.key != nil {
+		fields = append(fields, knowledge.FieldKey)
 	}
-	if m.ref_type != nil {
-		fields = append(fields, externalref.FieldRefType)
+	if m.category != nil {
+		fields = append(fields, knowledge.FieldCategory)
 	}
-	if m.location != nil {
-		fields = append(fields, externalref.FieldLocation)
+	if m.content != nil {
+		fields = append(fields, knowledge.FieldContent)
 	}
-	if m.summary != nil {
-		fields = append(fields, externalref.FieldSummary)
+	if m.tags != nil {
+		fields = append(fields, knowledge.FieldTags)
 	}
-	if m.metadata != nil {
-		fields = append(fields, externalref.FieldMetadata)
+	if m.source != nil {
+		fields = append(fields, knowledge.FieldSource)
+	}
+	if m.use_count != nil {
+		fields = append(fields, knowledge.FieldUseCount)
+	}
+	if m.relevance_score != nil {
+		fields = append(fields, knowledge.FieldRelevanceScore)
 	}
 	if m.created_at != nil {
-		fields = append(fields, externalref.FieldCreatedAt)
+		fields = append(fields, knowledge.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, externalref.FieldUpdatedAt)
+		fields = append(fields, knowledge.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3689,21 +7834,25 @@ func (m *ExternalRefMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExternalRefMutation) Field(name string) (ent.Value, bool) {
+func (m *KnowledgeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case externalref.FieldName:
-		return m.Name()
-	case externalref.FieldRefType:
-		return m.RefType()
-	case externalref.FieldLocation:
-		return m.Location()
-	case externalref.FieldSummary:
-		return m.Summary()
-	case externalref.FieldMetadata:
-		return m.Metadata()
-	case externalref.FieldCreatedAt:
+	case knowledge.FieldKey:
+		return m.Key()
+	case knowledge.FieldCategory:
+		return m.Category()
+	case knowledge.FieldContent:
+		return m.Content()
+	case knowledge.FieldTags:
+		return m.Tags()
+	case knowledge.FieldSource:
+		return m.Source()
+	case knowledge.FieldUseCount:
+		return m.UseCount()
+	case knowledge.FieldRelevanceScore:
+		return m.RelevanceScore()
+	case knowledge.FieldCreatedAt:
 		return m.CreatedAt()
-	case externalref.FieldUpdatedAt:
+	case knowledge.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3712,74 +7861,92 @@ func (m *ExternalRefMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExternalRefMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *KnowledgeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case externalref.FieldName:
-		return m.OldName(ctx)
-	case externalref.FieldRefType:
-		return m.OldRefType(ctx)
-	case externalref.FieldLocation:
-		return m.OldLocation(ctx)
-	case externalref.FieldSummary:
-		return m.OldSummary(ctx)
-	case externalref.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case externalref.FieldCreatedAt:
+	case knowledge.FieldKey:
+		return m.OldKey(ctx)
+	case knowledge.FieldCategory:
+		return m.OldCategory(ctx)
+	case knowledge.FieldContent:
+		return m.OldContent(ctx)
+	case knowledge.FieldTags:
+		return m.OldTags(ctx)
+	case knowledge.FieldSource:
+		return m.OldSource(ctx)
+	case knowledge.FieldUseCount:
+		return m.OldUseCount(ctx)
+	case knowledge.FieldRelevanceScore:
+		return m.OldRelevanceScore(ctx)
+	case knowledge.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case externalref.FieldUpdatedAt:
+	case knowledge.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ExternalRef field %s", name)
+	return nil, fmt.Errorf("unknown Knowledge field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExternalRefMutation) SetField(name string, value ent.Value) error {
+func (m *KnowledgeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case externalref.FieldName:
+	case knowledge.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetKey(v)
 		return nil
-	case externalref.FieldRefType:
-		v, ok := value.(externalref.RefType)
+	case knowledge.FieldCategory:
+		v, ok := value.(knowledge.Category)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefType(v)
+		m.SetCategory(v)
 		return nil
-	case externalref.FieldLocation:
+	case knowledge.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetContent(v)
 		return nil
-	case externalref.FieldSummary:
+	case knowledge.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case knowledge.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSummary(v)
+		m.SetSource(v)
 		return nil
-	case externalref.FieldMetadata:
-		v, ok := value.(map[string]interface{})
+	case knowledge.FieldUseCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetUseCount(v)
 		return nil
-	case externalref.FieldCreatedAt:
+	case knowledge.FieldRelevanceScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelevanceScore(v)
+		return nil
+	case knowledge.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case externalref.FieldUpdatedAt:
+	case knowledge.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3787,176 +7954,211 @@ func (m *ExternalRefMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalRef field %s", name)
+	return fmt.Errorf("unknown Knowledge field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExternalRefMutation) AddedFields() []string {
-	return nil
+func (m *KnowledgeMutation) AddedFields() []string {
+	var fields []string
+	if m.adduse_count != nil {
+		fields = append(fields, knowledge.FieldUseCount)
+	}
+	if m.addrelevance_score != nil {
+		fields = append(fields, knowledge.FieldRelevanceScore)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExternalRefMutation) AddedField(name string) (ent.Value, bool) {
+func (m *KnowledgeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case knowledge.FieldUseCount:
+		return m.AddedUseCount()
+	case knowledge.FieldRelevanceScore:
+		return m.AddedRelevanceScore()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExternalRefMutation) AddField(name string, value ent.Value) error {
+func (m *KnowledgeMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case knowledge.FieldUseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUseCount(v)
+		return nil
+	case knowledge.FieldRelevanceScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRelevanceScore(v)
+		return nil
 	}
-	return fmt.Errorf("unknown ExternalRef numeric field %s", name)
+	return fmt.Errorf("unknown Knowledge numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExternalRefMutation) ClearedFields() []string {
+func (m *KnowledgeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(externalref.FieldSummary) {
-		fields = append(fields, externalref.FieldSummary)
+	if m.FieldCleared(knowledge.FieldTags) {
+		fields = append(fields, knowledge.FieldTags)
 	}
-	if m.FieldCleared(externalref.FieldMetadata) {
-		fields = append(fields, externalref.FieldMetadata)
+	if m.FieldCleared(knowledge.FieldSource) {
+		fields = append(fields, knowledge.FieldSource)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExternalRefMutation) FieldCleared(name string) bool {
+func (m *KnowledgeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExternalRefMutation) ClearField(name string) error {
+func (m *KnowledgeMutation) ClearField(name string) error {
 	switch name {
-	case externalref.FieldSummary:
-		m.ClearSummary()
+	case knowledge.FieldTags:
+		m.ClearTags()
 		return nil
-	case externalref.FieldMetadata:
-		m.ClearMetadata()
+	case knowledge.FieldSource:
+		m.ClearSource()
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalRef nullable field %s", name)
+	return fmt.Errorf("unknown Knowledge nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExternalRefMutation) ResetField(name string) error {
+func (m *KnowledgeMutation) ResetField(name string) error {
 	switch name {
-	case externalref.FieldName:
-		m.ResetName()
+	case knowledge.FieldKey:
+		m.ResetKey()
 		return nil
-	case externalref.FieldRefType:
-		m.ResetRefType()
+	case knowledge.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case externalref.FieldLocation:
-		m.ResetLocation()
+	case knowledge.FieldContent:
+		m.ResetContent()
 		return nil
-	case externalref.FieldSummary:
-		m.ResetSummary()
+	case knowledge.FieldTags:
+		m.ResetTags()
 		return nil
-	case externalref.FieldMetadata:
-		m.ResetMetadata()
+	case knowledge.FieldSource:
+		m.ResetSource()
 		return nil
-	case externalref.FieldCreatedAt:
+	case knowledge.FieldUseCount:
+		m.ResetUseCount()
+		return nil
+	case knowledge.FieldRelevanceScore:
+		m.ResetRelevanceScore()
+		return nil
+	case knowledge.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case externalref.FieldUpdatedAt:
+	case knowledge.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalRef field %s", name)
+	return fmt.Errorf("unknown Knowledge field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExternalRefMutation) AddedEdges() []string {
+func (m *KnowledgeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExternalRefMutation) AddedIDs(name string) []ent.Value {
+func (m *KnowledgeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExternalRefMutation) RemovedEdges() []string {
+func (m *KnowledgeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExternalRefMutation) RemovedIDs(name string) []ent.Value {
+func (m *KnowledgeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExternalRefMutation) ClearedEdges() []string {
+func (m *KnowledgeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExternalRefMutation) EdgeCleared(name string) bool {
+func (m *KnowledgeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExternalRefMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown ExternalRef unique edge %s", name)
+func (m *KnowledgeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Knowledge unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExternalRefMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown ExternalRef edge %s", name)
+func (m *KnowledgeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Knowledge edge %s", name)
 }
 
-// InquiryMutation represents an operation that mutates the Inquiry nodes in the graph.
-type InquiryMutation struct {
+// KnowledgeRevisionMutation represents an operation that mutates the KnowledgeRevision nodes in the graph.
+type KnowledgeRevisionMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	session_key           *string
-	topic                 *string
-	question              *string
-	context               *string
-	priority              *inquiry.Priority
-	status                *inquiry.Status
-	answer                *string
-	knowledge_key         *string
-	source_observation_id *string
-	created_at            *time.Time
-	resolved_at           *time.Time
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*Inquiry, error)
-	predicates            []predicate.Inquiry
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	knowledge_key *string
+	version       *int
+	addversion    *int
+	action        *knowledgerevision.Action
+	category      *knowledgerevision.Category
+	content       *string
+	tags          *[]string
+	appendtags    []string
+	source        *string
+	author        *knowledgerevision.Author
+	session_key   *string
+	reason        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*KnowledgeRevision, error)
+	predicates    []predicate.KnowledgeRevision
 }
 
-var _ ent.Mutation = (*InquiryMutation)(nil)
+var _ ent.Mutation = (*KnowledgeRevisionMutation)(nil)
 
-// inquiryOption allows management of the mutation configuration using functional options.
-type inquiryOption func(*InquiryMutation)
+// knowledgerevisionOption allows management of the mutation configuration using functional options.
+type knowledgerevisionOption func(*KnowledgeRevisionMutation)
 
-// newInquiryMutation creates new mutation for the Inquiry entity.
-func newInquiryMutation(c config, op Op, opts ...inquiryOption) *InquiryMutation {
-	m := &InquiryMutation{
+// newKnowledgeRevisionMutation creates new mutation for the KnowledgeRevision entity.
+func newKnowledgeRevisionMutation(c config, op Op, opts ...knowledgerevisionOption) *KnowledgeRevisionMutation {
+	m := &KnowledgeRevisionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeInquiry,
+		typ:           TypeKnowledgeRevision,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3965,20 +8167,20 @@ func newInquiryMutation(c config, op Op, opts ...inquiryOption) *InquiryMutation
 	return m
 }
 
-// withInquiryID sets the ID field of the mutation.
-func withInquiryID(id uuid.UUID) inquiryOption {
-	return func(m *InquiryMutation) {
+// withKnowledgeRevisionID sets the ID field of the mutation.
+func withKnowledgeRevisionID(id uuid.UUID) knowledgerevisionOption {
+	return func(m *KnowledgeRevisionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Inquiry
+			value *KnowledgeRevision
 		)
-		m.oldValue = func(ctx context.Context) (*Inquiry, error) {
+		m.oldValue = func(ctx context.Context) (*KnowledgeRevision, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Inquiry.Get(ctx, id)
+					value, err = m.Client().KnowledgeRevision.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3987,10 +8189,10 @@ func withInquiryID(id uuid.UUID) inquiryOption {
 	}
 }
 
-// withInquiry sets the old Inquiry of the mutation.
-func withInquiry(node *Inquiry) inquiryOption {
-	return func(m *InquiryMutation) {
-		m.oldValue = func(context.Context) (*Inquiry, error) {
+// withKnowledgeRevision sets the old KnowledgeRevision of the mutation.
+func withKnowledgeRevision(node *KnowledgeRevision) knowledgerevisionOption {
+	return func(m *KnowledgeRevisionMutation) {
+		m.oldValue = func(context.Context) (*KnowledgeRevision, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3999,7 +8201,7 @@ func withInquiry(node *Inquiry) inquiryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m InquiryMutation) Client() *Client {
+func (m KnowledgeRevisionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4007,7 +8209,7 @@ func (m InquiryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m InquiryMutation) Tx() (*Tx, error) {
+func (m KnowledgeRevisionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4017,14 +8219,14 @@ func (m InquiryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Inquiry entities.
-func (m *InquiryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of KnowledgeRevision entities.
+func (m *KnowledgeRevisionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *InquiryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *KnowledgeRevisionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4035,7 +8237,7 @@ func (m *InquiryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *InquiryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *KnowledgeRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4044,395 +8246,467 @@ func (m *InquiryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Inquiry.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().KnowledgeRevision.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSessionKey sets the "session_key" field.
-func (m *InquiryMutation) SetSessionKey(s string) {
-	m.session_key = &s
+// SetKnowledgeKey sets the "knowledge_key" field.
+func (m *KnowledgeRevisionMutation) SetKnowledgeKey(s string) {
+	m.knowledge_key = &s
 }
 
-// SessionKey returns the value of the "session_key" field in the mutation.
-func (m *InquiryMutation) SessionKey() (r string, exists bool) {
-	v := m.session_key
+// KnowledgeKey returns the value of the "knowledge_key" field in the mutation.
+func (m *KnowledgeRevisionMutation) KnowledgeKey() (r string, exists bool) {
+	v := m.knowledge_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionKey returns the old "session_key" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldKnowledgeKey returns the old "knowledge_key" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+func (m *KnowledgeRevisionMutation) OldKnowledgeKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldKnowledgeKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionKey requires an ID field in the mutation")
+		return v, errors.New("OldKnowledgeKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldKnowledgeKey: %w", err)
 	}
-	return oldValue.SessionKey, nil
+	return oldValue.KnowledgeKey, nil
 }
 
-// ResetSessionKey resets all changes to the "session_key" field.
-func (m *InquiryMutation) ResetSessionKey() {
-	m.session_key = nil
+// ResetKnowledgeKey resets all changes to the "knowledge_key" field.
+func (m *KnowledgeRevisionMutation) ResetKnowledgeKey() {
+	m.knowledge_key = nil
 }
 
-// SetTopic sets the "topic" field.
-func (m *InquiryMutation) SetTopic(s string) {
-	m.topic = &s
+// SetVersion sets the "version" field.
+func (m *KnowledgeRevisionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Topic returns the value of the "topic" field in the mutation.
-func (m *InquiryMutation) Topic() (r string, exists bool) {
-	v := m.topic
+// Version returns the value of the "version" field in the mutation.
+func (m *KnowledgeRevisionMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTopic returns the old "topic" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldTopic(ctx context.Context) (v string, err error) {
+func (m *KnowledgeRevisionMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTopic is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTopic requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTopic: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Topic, nil
+	return oldValue.Version, nil
 }
 
-// ResetTopic resets all changes to the "topic" field.
-func (m *InquiryMutation) ResetTopic() {
-	m.topic = nil
+// AddVersion adds i to the "version" field.
+func (m *KnowledgeRevisionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetQuestion sets the "question" field.
-func (m *InquiryMutation) SetQuestion(s string) {
-	m.question = &s
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *KnowledgeRevisionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Question returns the value of the "question" field in the mutation.
-func (m *InquiryMutation) Question() (r string, exists bool) {
-	v := m.question
+// ResetVersion resets all changes to the "version" field.
+func (m *KnowledgeRevisionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetAction sets the "action" field.
+func (m *KnowledgeRevisionMutation) SetAction(k knowledgerevision.Action) {
+	m.action = &k
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *KnowledgeRevisionMutation) Action() (r knowledgerevision.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestion returns the old "question" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldQuestion(ctx context.Context) (v string, err error) {
+func (m *KnowledgeRevisionMutation) OldAction(ctx context.Context) (v knowledgerevision.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestion requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.Question, nil
+	return oldValue.Action, nil
 }
 
-// ResetQuestion resets all changes to the "question" field.
-func (m *InquiryMutation) ResetQuestion() {
-	m.question = nil
+// ResetAction resets all changes to the "action" field.
+func (m *KnowledgeRevisionMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetContext sets the "context" field.
-func (m *InquiryMutation) SetContext(s string) {
-	m.context = &s
+// SetCategory sets the "category" field.
+func (m *KnowledgeRevisionMutation) SetCategory(k knowledgerevision.Category) {
+	m.category = &k
 }
 
-// Context returns the value of the "context" field in the mutation.
-func (m *InquiryMutation) Context() (r string, exists bool) {
-	v := m.context
+// Category returns the value of the "category" field in the mutation.
+func (m *KnowledgeRevisionMutation) Category() (r knowledgerevision.Category, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContext returns the old "context" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldContext(ctx context.Context) (v *string, err error) {
+func (m *KnowledgeRevisionMutation) OldCategory(ctx context.Context) (v knowledgerevision.Category, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContext is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContext requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContext: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.Context, nil
+	return oldValue.Category, nil
 }
 
-// ClearContext clears the value of the "context" field.
-func (m *InquiryMutation) ClearContext() {
-	m.context = nil
-	m.clearedFields[inquiry.FieldContext] = struct{}{}
+// ResetCategory resets all changes to the "category" field.
+func (m *KnowledgeRevisionMutation) ResetCategory() {
+	m.category = nil
 }
 
-// ContextCleared returns if the "context" field was cleared in this mutation.
-func (m *InquiryMutation) ContextCleared() bool {
-	_, ok := m.clearedFields[inquiry.FieldContext]
-	return ok
+// SetContent sets the "content" field.
+func (m *KnowledgeRevisionMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// ResetContext resets all changes to the "context" field.
-func (m *InquiryMutation) ResetContext() {
-	m.context = nil
-	delete(m.clearedFields, inquiry.FieldContext)
+// Content returns the value of the "content" field in the mutation.
+func (m *KnowledgeRevisionMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetPriority sets the "priority" field.
-func (m *InquiryMutation) SetPriority(i inquiry.Priority) {
-	m.priority = &i
+// OldContent returns the old "content" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KnowledgeRevisionMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *InquiryMutation) Priority() (r inquiry.Priority, exists bool) {
-	v := m.priority
+// ResetContent resets all changes to the "content" field.
+func (m *KnowledgeRevisionMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetTags sets the "tags" field.
+func (m *KnowledgeRevisionMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *KnowledgeRevisionMutation) Tags() (r []string, exists bool) {
+	v := m.tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPriority returns the old "priority" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldTags returns the old "tags" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldPriority(ctx context.Context) (v inquiry.Priority, err error) {
+func (m *KnowledgeRevisionMutation) OldTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+		return v, errors.New("OldTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
 	}
-	return oldValue.Priority, nil
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *KnowledgeRevisionMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *KnowledgeRevisionMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *KnowledgeRevisionMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[knowledgerevision.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *KnowledgeRevisionMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[knowledgerevision.FieldTags]
+	return ok
 }
 
-// ResetPriority resets all changes to the "priority" field.
-func (m *InquiryMutation) ResetPriority() {
-	m.priority = nil
+// ResetTags resets all changes to the "tags" field.
+func (m *KnowledgeRevisionMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, knowledgerevision.FieldTags)
 }
 
-// SetStatus sets the "status" field.
-func (m *InquiryMutation) SetStatus(i inquiry.Status) {
-	m.status = &i
+// SetSource sets the "source" field.
+func (m *KnowledgeRevisionMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *InquiryMutation) Status() (r inquiry.Status, exists bool) {
-	v := m.status
+// Source returns the value of the "source" field in the mutation.
+func (m *KnowledgeRevisionMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldStatus(ctx context.Context) (v inquiry.Status, err error) {
+func (m *KnowledgeRevisionMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Source, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *InquiryMutation) ResetStatus() {
-	m.status = nil
+// ClearSource clears the value of the "source" field.
+func (m *KnowledgeRevisionMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[knowledgerevision.FieldSource] = struct{}{}
 }
 
-// SetAnswer sets the "answer" field.
-func (m *InquiryMutation) SetAnswer(s string) {
-	m.answer = &s
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *KnowledgeRevisionMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[knowledgerevision.FieldSource]
+	return ok
 }
 
-// Answer returns the value of the "answer" field in the mutation.
-func (m *InquiryMutation) Answer() (r string, exists bool) {
-	v := m.answer
+// ResetSource resets all changes to the "source" field.
+func (m *KnowledgeRevisionMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, knowledgerevision.FieldSource)
+}
+
+// SetAuthor sets the "author" field.
+func (m *KnowledgeRevisionMutation) SetAuthor(k knowledgerevision.Author) {
+	m.author = &k
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *KnowledgeRevisionMutation) Author() (r knowledgerevision.Author, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswer returns the old "answer" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthor returns the old "author" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldAnswer(ctx context.Context) (v *string, err error) {
+func (m *KnowledgeRevisionMutation) OldAuthor(ctx context.Context) (v knowledgerevision.Author, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswer requires an ID field in the mutation")
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
 	}
-	return oldValue.Answer, nil
-}
-
-// ClearAnswer clears the value of the "answer" field.
-func (m *InquiryMutation) ClearAnswer() {
-	m.answer = nil
-	m.clearedFields[inquiry.FieldAnswer] = struct{}{}
-}
-
-// AnswerCleared returns if the "answer" field was cleared in this mutation.
-func (m *InquiryMutation) AnswerCleared() bool {
-	_, ok := m.clearedFields[inquiry.FieldAnswer]
-	return ok
+	return oldValue.Author, nil
 }
 
-// ResetAnswer resets all changes to the "answer" field.
-func (m *InquiryMutation) ResetAnswer() {
-	m.answer = nil
-	delete(m.clearedFields, inquiry.FieldAnswer)
+// ResetAuthor resets all changes to the "author" field.
+func (m *KnowledgeRevisionMutation) ResetAuthor() {
+	m.author = nil
 }
 
-// SetKnowledgeKey sets the "knowledge_key" field.
-func (m *InquiryMutation) SetKnowledgeKey(s string) {
-	m.knowledge_key = &s
+// SetSessionKey sets the "session_key" field.
+func (m *KnowledgeRevisionMutation) SetSessionKey(s string) {
+	m.session_key = &s
 }
 
-// KnowledgeKey returns the value of the "knowledge_key" field in the mutation.
-func (m *InquiryMutation) KnowledgeKey() (r string, exists bool) {
-	v := m.knowledge_key
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *KnowledgeRevisionMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKnowledgeKey returns the old "knowledge_key" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldKnowledgeKey(ctx context.Context) (v *string, err error) {
+func (m *KnowledgeRevisionMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKnowledgeKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKnowledgeKey requires an ID field in the mutation")
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKnowledgeKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
 	}
-	return oldValue.KnowledgeKey, nil
+	return oldValue.SessionKey, nil
 }
 
-// ClearKnowledgeKey clears the value of the "knowledge_key" field.
-func (m *InquiryMutation) ClearKnowledgeKey() {
-	m.knowledge_key = nil
-	m.clearedFields[inquiry.FieldKnowledgeKey] = struct{}{}
+// ClearSessionKey clears the value of the "session_key" field.
+func (m *KnowledgeRevisionMutation) ClearSessionKey() {
+	m.session_key = nil
+	m.clearedFields[knowledgerevision.FieldSessionKey] = struct{}{}
 }
 
-// KnowledgeKeyCleared returns if the "knowledge_key" field was cleared in this mutation.
-func (m *InquiryMutation) KnowledgeKeyCleared() bool {
-	_, ok := m.clearedFields[inquiry.FieldKnowledgeKey]
+// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
+func (m *KnowledgeRevisionMutation) SessionKeyCleared() bool {
+	_, ok := m.clearedFields[knowledgerevision.FieldSessionKey]
 	return ok
 }
 
-// ResetKnowledgeKey resets all changes to the "knowledge_key" field.
-func (m *InquiryMutation) ResetKnowledgeKey() {
-	m.knowledge_key = nil
-	delete(m.clearedFields, inquiry.FieldKnowledgeKey)
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *KnowledgeRevisionMutation) ResetSessionKey() {
+	m.session_key = nil
+	delete(m.clearedFields, knowledgerevision.FieldSessionKey)
 }
 
-// SetSourceObservationID sets the "source_observation_id" field.
-func (m *InquiryMutation) SetSourceObservationID(s string) {
-	m.source_observation_id = &s
+// SetReason sets the "reason" field.
+func (m *KnowledgeRevisionMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// SourceObservationID returns the value of the "source_observation_id" field in the mutation.
-func (m *InquiryMutation) SourceObservationID() (r string, exists bool) {
-	v := m.source_observation_id
+// Reason returns the value of the "reason" field in the mutation.
+func (m *KnowledgeRevisionMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceObservationID returns the old "source_observation_id" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldSourceObservationID(ctx context.Context) (v *string, err error) {
+func (m *KnowledgeRevisionMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceObservationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceObservationID requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceObservationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.SourceObservationID, nil
+	return oldValue.Reason, nil
 }
 
-// ClearSourceObservationID clears the value of the "source_observation_id" field.
-func (m *InquiryMutation) ClearSourceObservationID() {
-	m.source_observation_id = nil
-	m.clearedFields[inquiry.FieldSourceObservationID] = struct{}{}
+// ClearReason clears the value of the "reason" field.
+func (m *KnowledgeRevisionMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[knowledgerevision.FieldReason] = struct{}{}
 }
 
-// SourceObservationIDCleared returns if the "source_observation_id" field was cleared in this mutation.
-func (m *InquiryMutation) SourceObservationIDCleared() bool {
-	_, ok := m.clearedFields[inquiry.FieldSourceObservationID]
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *KnowledgeRevisionMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[knowledgerevision.FieldReason]
 	return ok
 }
 
-// ResetSourceObservationID resets all changes to the "source_observation_id" field.
-func (m *InquiryMutation) ResetSourceObservationID() {
-	m.source_observation_id = nil
-	delete(m.clearedFields, inquiry.FieldSourceObservationID)
+// ResetReason resets all changes to the "reason" field.
+func (m *KnowledgeRevisionMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, knowledgerevision.FieldReason)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *InquiryMutation) SetCreatedAt(t time.Time) {
+func (m *KnowledgeRevisionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *InquiryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *KnowledgeRevisionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4440,10 +8714,10 @@ func (m *InquiryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the KnowledgeRevision entity.
+// If the KnowledgeRevision object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KnowledgeRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4458,68 +8732,19 @@ func (m *InquiryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *InquiryMutation) ResetCreatedAt() {
+func (m *KnowledgeRevisionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *InquiryMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
-}
-
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *InquiryMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldResolvedAt returns the old "resolved_at" field's value of the Inquiry entity.
-// If the Inquiry object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *InquiryMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
-	}
-	return oldValue.ResolvedAt, nil
-}
-
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *InquiryMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[inquiry.FieldResolvedAt] = struct{}{}
-}
-
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *InquiryMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[inquiry.FieldResolvedAt]
-	return ok
-}
-
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *InquiryMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, inquiry.FieldResolvedAt)
-}
-
-// Where appends a list predicates to the InquiryMutation builder.
-func (m *InquiryMutation) Where(ps ...predicate.Inquiry) {
+// Where appends a list predicates to the KnowledgeRevisionMutation builder.
+func (m *KnowledgeRevisionMutation) Where(ps ...predicate.KnowledgeRevision) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the InquiryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the KnowledgeRevisionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *InquiryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Inquiry, len(ps))
+func (m *KnowledgeRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KnowledgeRevision, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4527,88 +8752,88 @@ func (m *InquiryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *InquiryMutation) Op() Op {
+func (m *KnowledgeRevisionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *InquiryMutation) SetOp(op Op) {
+func (m *KnowledgeRevisionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Inquiry).
-func (m *InquiryMutation) Type() string {
+// Type returns the node type of this mutation (KnowledgeRevision).
+func (m *KnowledgeRevisionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *InquiryMutation) Fields() []string {
+func (m *KnowledgeRevisionMutation) Fields() []string {
 	fields := make([]string, 0, 11)
-	if m.session_key != nil {
-		fields = append(fields, inquiry.FieldSessionKey)
+	if m.knowledge_key != nil {
+		fields = append(fields, knowledgerevision.FieldKnowledgeKey)
 	}
-	if m.topic != nil {
-		fields = append(fields, inquiry.FieldTopic)
+	if m.version != nil {
+		fields = append(fields, knowledgerevision.FieldVersion)
 	}
-	if m.question != nil {
-		fields = append(fields, inquiry.FieldQuestion)
+	if m.action != nil {
+		fields = append(fields, knowledgerevision.FieldAction)
 	}
-	if m.context != nil {
-		fields = append(fields, inquiry.FieldContext)
+	if m.category != nil {
+		fields = append(fields, knowledgerevision.FieldCategory)
 	}
-	if m.priority != nil {
-		fields = append(fields, inquiry.FieldPriority)
+	if m.content != nil {
+		fields = append(fields, knowledgerevision.FieldContent)
 	}
-	if m.status != nil {
-		fields = append(fields, inquiry.FieldStatus)
+	if m.tags != nil {
+		fields = append(fields, knowledgerevision.FieldTags)
 	}
-	if m.answer != nil {
-		fields = append(fields, inquiry.FieldAnswer)
+	if m.source != nil {
+		fields = append(fields, knowledgerevision.FieldSource)
 	}
-	if m.knowledge_key != nil {
-		fields = append(fields, inquiry.FieldKnowledgeKey)
+	if m.author != nil {
+		fields = append(fields, knowledgerevision.FieldAuthor)
 	}
-	if m.source_observation_id != nil {
-		fields = append(fields, inquiry.FieldSourceObservationID)
+	if m.session_key != nil {
+		fields = append(fields, knowledgerevision.FieldSessionKey)
 	}
-	if m.created_at != nil {
-		fields = append(fields, inquiry.FieldCreatedAt)
+	if m.reason != nil {
+		fields = append(fields, knowledgerevision.FieldReason)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, inquiry.FieldResolvedAt)
+	if m.created_at != nil {
+		fields = append(fields, knowledgerevision.FieldCreatedAt)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *InquiryMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case inquiry.FieldSessionKey:
-		return m.SessionKey()
-	case inquiry.FieldTopic:
-		return m.Topic()
-	case inquiry.FieldQuestion:
-		return m.Question()
-	case inquiry.FieldContext:
-		return m.Context()
-	case inquiry.FieldPriority:
-		return m.Priority()
-	case inquiry.FieldStatus:
-		return m.Status()
-	case inquiry.FieldAnswer:
-		return m.Answer()
-	case inquiry.FieldKnowledgeKey:
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KnowledgeRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case knowledgerevision.FieldKnowledgeKey:
 		return m.KnowledgeKey()
-	case inquiry.FieldSourceObservationID:
-		return m.SourceObservationID()
-	case inquiry.FieldCreatedAt:
+	case knowledgerevision.FieldVersion:
+		return m.Version()
+	case knowledgerevision.FieldAction:
+		return m.Action()
+	case knowledgerevision.FieldCategory:
+		return m.Category()
+	case knowledgerevision.FieldContent:
+		return m.Content()
+	case knowledgerevision.FieldTags:
+		return m.Tags()
+	case knowledgerevision.FieldSource:
+		return m.Source()
+	case knowledgerevision.FieldAuthor:
+		return m.Author()
+	case knowledgerevision.FieldSessionKey:
+		return m.SessionKey()
+	case knowledgerevision.FieldReason:
+		return m.Reason()
+	case knowledgerevision.FieldCreatedAt:
 		return m.CreatedAt()
-	case inquiry.FieldResolvedAt:
-		return m.ResolvedAt()
 	}
 	return nil, false
 }
@@ -4616,663 +8841,1051 @@ func (m *InquiryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *InquiryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *KnowledgeRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case inquiry.FieldSessionKey:
-		return m.OldSessionKey(ctx)
-	case inquiry.FieldTopic:
-		return m.OldTopic(ctx)
-	case inquiry.FieldQuestion:
-		return m.OldQuestion(ctx)
-	case inquiry.FieldContext:
-		return m.OldContext(ctx)
-	case inquiry.FieldPriority:
-		return m.OldPriority(ctx)
-	case inquiry.FieldStatus:
-		return m.OldStatus(ctx)
-	case inquiry.FieldAnswer:
-		return m.OldAnswer(ctx)
-	case inquiry.FieldKnowledgeKey:
+	case knowledgerevision.FieldKnowledgeKey:
 		return m.OldKnowledgeKey(ctx)
-	case inquiry.FieldSourceObservationID:
-		return m.OldSourceObservationID(ctx)
-	case inquiry.FieldCreatedAt:
+	case knowledgerevision.FieldVersion:
+		return m.OldVersion(ctx)
+	case knowledgerevision.FieldAction:
+		return m.OldAction(ctx)
+	case knowledgerevision.FieldCategory:
+		return m.OldCategory(ctx)
+	case knowledgerevision.FieldContent:
+		return m.OldContent(ctx)
+	case knowledgerevision.FieldTags:
+		return m.OldTags(ctx)
+	case knowledgerevision.FieldSource:
+		return m.OldSource(ctx)
+	case knowledgerevision.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case knowledgerevision.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case knowledgerevision.FieldReason:
+		return m.OldReason(ctx)
+	case knowledgerevision.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case inquiry.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Inquiry field %s", name)
+	return nil, fmt.Errorf("unknown KnowledgeRevision field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *InquiryMutation) SetField(name string, value ent.Value) error {
+func (m *KnowledgeRevisionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case inquiry.FieldSessionKey:
+	case knowledgerevision.FieldKnowledgeKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionKey(v)
+		m.SetKnowledgeKey(v)
 		return nil
-	case inquiry.FieldTopic:
-		v, ok := value.(string)
+	case knowledgerevision.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTopic(v)
+		m.SetVersion(v)
 		return nil
-	case inquiry.FieldQuestion:
-		v, ok := value.(string)
+	case knowledgerevision.FieldAction:
+		v, ok := value.(knowledgerevision.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestion(v)
+		m.SetAction(v)
 		return nil
-	case inquiry.FieldContext:
-		v, ok := value.(string)
+	case knowledgerevision.FieldCategory:
+		v, ok := value.(knowledgerevision.Category)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContext(v)
+		m.SetCategory(v)
 		return nil
-	case inquiry.FieldPriority:
-		v, ok := value.(inquiry.Priority)
+	case knowledgerevision.FieldContent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriority(v)
+		m.SetContent(v)
 		return nil
-	case inquiry.FieldStatus:
-		v, ok := value.(inquiry.Status)
+	case knowledgerevision.FieldTags:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetTags(v)
 		return nil
-	case inquiry.FieldAnswer:
+	case knowledgerevision.FieldSource:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswer(v)
+		m.SetSource(v)
 		return nil
-	case inquiry.FieldKnowledgeKey:
+	case knowledgerevision.FieldAuthor:
+		v, ok := value.(knowledgerevision.Author)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case knowledgerevision.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKnowledgeKey(v)
+		m.SetSessionKey(v)
 		return nil
-	case inquiry.FieldSourceObservationID:
+	case knowledgerevision.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceObservationID(v)
+		m.SetReason(v)
 		return nil
-	case inquiry.FieldCreatedAt:
+	case knowledgerevision.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
-		return nil
-	case inquiry.FieldResolvedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KnowledgeRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, knowledgerevision.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KnowledgeRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case knowledgerevision.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KnowledgeRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case knowledgerevision.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KnowledgeRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(knowledgerevision.FieldTags) {
+		fields = append(fields, knowledgerevision.FieldTags)
+	}
+	if m.FieldCleared(knowledgerevision.FieldSource) {
+		fields = append(fields, knowledgerevision.FieldSource)
+	}
+	if m.FieldCleared(knowledgerevision.FieldSessionKey) {
+		fields = append(fields, knowledgerevision.FieldSessionKey)
+	}
+	if m.FieldCleared(knowledgerevision.FieldReason) {
+		fields = append(fields, knowledgerevision.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KnowledgeRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KnowledgeRevisionMutation) ClearField(name string) error {
+	switch name {
+	case knowledgerevision.FieldTags:
+		m.ClearTags()
+		return nil
+	case knowledgerevision.FieldSource:
+		m.ClearSource()
+		return nil
+	case knowledgerevision.FieldSessionKey:
+		m.ClearSessionKey()
+		return nil
+	case knowledgerevision.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KnowledgeRevisionMutation) ResetField(name string) error {
+	switch name {
+	case knowledgerevision.FieldKnowledgeKey:
+		m.ResetKnowledgeKey()
+		return nil
+	case knowledgerevision.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case knowledgerevision.FieldAction:
+		m.ResetAction()
+		return nil
+	case knowledgerevision.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case knowledgerevision.FieldContent:
+		m.ResetContent()
+		return nil
+	case knowledgerevision.FieldTags:
+		m.ResetTags()
+		return nil
+	case knowledgerevision.FieldSource:
+		m.ResetSource()
+		return nil
+	case knowledgerevision.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case knowledgerevision.FieldSessionKey:
+		m.ResetSessionKey()
+		return nil
+	case knowledgerevision.FieldReason:
+		m.ResetReason()
+		return nil
+	case knowledgerevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KnowledgeRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KnowledgeRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KnowledgeRevisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KnowledgeRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KnowledgeRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KnowledgeRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KnowledgeRevisionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KnowledgeRevisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KnowledgeRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KnowledgeRevisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KnowledgeRevision edge %s", name)
+}
+
+// LearningMutation represents an operation that mutates the Learning nodes in the graph.
+type LearningMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	trigger             *string
+	error_pattern       *string
+	diagnosis           *string
+	fix                 *string
+	category            *learning.Category
+	tags                *[]string
+	appendtags          []string
+	occurrence_count    *int
+	addoccurrence_count *int
+	success_count       *int
+	addsuccess_count    *int
+	confidence          *float64
+	addconfidence       *float64
+	failure_count       *int
+	addfailure_count    *int
+	review_status       *learning.ReviewStatus
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Learning, error)
+	predicates          []predicate.Learning
+}
+
+var _ ent.Mutation = (*LearningMutation)(nil)
+
+// learningOption allows management of the mutation configuration using functional options.
+type learningOption func(*LearningMutation)
+
+// newLearningMutation creates new mutation for the Learning entity.
+func newLearningMutation(c config, op Op, opts ...learningOption) *LearningMutation {
+	m := &LearningMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLearning,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLearningID sets the ID field of the mutation.
+func withLearningID(id uuid.UUID) learningOption {
+	return func(m *LearningMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Learning
+		)
+		m.oldValue = func(ctx context.Context) (*Learning, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Learning.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLearning sets the old Learning of the mutation.
+func withLearning(node *Learning) learningOption {
+	return func(m *LearningMutation) {
+		m.oldValue = func(context.Context) (*Learning, error) {
+			return node, nil
 		}
-		m.SetResolvedAt(v)
-		return nil
+		m.id = &node.ID
 	}
-	return fmt.Errorf("unknown Inquiry field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *InquiryMutation) AddedFields() []string {
-	return nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LearningMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *InquiryMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LearningMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *InquiryMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Learning entities.
+func (m *LearningMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LearningMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return fmt.Errorf("unknown Inquiry numeric field %s", name)
+	return *m.id, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *InquiryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(inquiry.FieldContext) {
-		fields = append(fields, inquiry.FieldContext)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LearningMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Learning.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.FieldCleared(inquiry.FieldAnswer) {
-		fields = append(fields, inquiry.FieldAnswer)
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *LearningMutation) SetTrigger(s string) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *LearningMutation) Trigger() (r string, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(inquiry.FieldKnowledgeKey) {
-		fields = append(fields, inquiry.FieldKnowledgeKey)
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldTrigger(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(inquiry.FieldSourceObservationID) {
-		fields = append(fields, inquiry.FieldSourceObservationID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
 	}
-	if m.FieldCleared(inquiry.FieldResolvedAt) {
-		fields = append(fields, inquiry.FieldResolvedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
 	}
-	return fields
+	return oldValue.Trigger, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *InquiryMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *LearningMutation) ResetTrigger() {
+	m.trigger = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *InquiryMutation) ClearField(name string) error {
-	switch name {
-	case inquiry.FieldContext:
-		m.ClearContext()
-		return nil
-	case inquiry.FieldAnswer:
-		m.ClearAnswer()
-		return nil
-	case inquiry.FieldKnowledgeKey:
-		m.ClearKnowledgeKey()
-		return nil
-	case inquiry.FieldSourceObservationID:
-		m.ClearSourceObservationID()
-		return nil
-	case inquiry.FieldResolvedAt:
-		m.ClearResolvedAt()
-		return nil
+// SetErrorPattern sets the "error_pattern" field.
+func (m *LearningMutation) SetErrorPattern(s string) {
+	m.error_pattern = &s
+}
+
+// ErrorPattern returns the value of the "error_pattern" field in the mutation.
+func (m *LearningMutation) ErrorPattern() (r string, exists bool) {
+	v := m.error_pattern
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Inquiry nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *InquiryMutation) ResetField(name string) error {
-	switch name {
-	case inquiry.FieldSessionKey:
-		m.ResetSessionKey()
-		return nil
-	case inquiry.FieldTopic:
-		m.ResetTopic()
-		return nil
-	case inquiry.FieldQuestion:
-		m.ResetQuestion()
-		return nil
-	case inquiry.FieldContext:
-		m.ResetContext()
-		return nil
-	case inquiry.FieldPriority:
-		m.ResetPriority()
-		return nil
-	case inquiry.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case inquiry.FieldAnswer:
-		m.ResetAnswer()
-		return nil
-	case inquiry.FieldKnowledgeKey:
-		m.ResetKnowledgeKey()
-		return nil
-	case inquiry.FieldSourceObservationID:
-		m.ResetSourceObservationID()
-		return nil
-	case inquiry.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case inquiry.FieldResolvedAt:
-		m.ResetResolvedAt()
-		return nil
+// OldErrorPattern returns the old "error_pattern" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldErrorPattern(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorPattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorPattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorPattern: %w", err)
+	}
+	return oldValue.ErrorPattern, nil
+}
+
+// ClearErrorPattern clears the value of the "error_pattern" field.
+func (m *LearningMutation) ClearErrorPattern() {
+	m.error_pattern = nil
+	m.clearedFields[learning.FieldErrorPattern] = struct{}{}
+}
+
+// ErrorPatternCleared returns if the "error_pattern" field was cleared in this mutation.
+func (m *LearningMutation) ErrorPatternCleared() bool {
+	_, ok := m.clearedFields[learning.FieldErrorPattern]
+	return ok
+}
+
+// ResetErrorPattern resets all changes to the "error_pattern" field.
+func (m *LearningMutation) ResetErrorPattern() {
+	m.error_pattern = nil
+	delete(m.clearedFields, learning.FieldErrorPattern)
+}
+
+// SetDiagnosis sets the "diagnosis" field.
+func (m *LearningMutation) SetDiagnosis(s string) {
+	m.diagnosis = &s
+}
+
+// Diagnosis returns the value of the "diagnosis" field in the mutation.
+func (m *LearningMutation) Diagnosis() (r string, exists bool) {
+	v := m.diagnosis
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Inquiry field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *InquiryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// OldDiagnosis returns the old "diagnosis" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldDiagnosis(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiagnosis is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiagnosis requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiagnosis: %w", err)
+	}
+	return oldValue.Diagnosis, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *InquiryMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// ClearDiagnosis clears the value of the "diagnosis" field.
+func (m *LearningMutation) ClearDiagnosis() {
+	m.diagnosis = nil
+	m.clearedFields[learning.FieldDiagnosis] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *InquiryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// DiagnosisCleared returns if the "diagnosis" field was cleared in this mutation.
+func (m *LearningMutation) DiagnosisCleared() bool {
+	_, ok := m.clearedFields[learning.FieldDiagnosis]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *InquiryMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetDiagnosis resets all changes to the "diagnosis" field.
+func (m *LearningMutation) ResetDiagnosis() {
+	m.diagnosis = nil
+	delete(m.clearedFields, learning.FieldDiagnosis)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *InquiryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// SetFix sets the "fix" field.
+func (m *LearningMutation) SetFix(s string) {
+	m.fix = &s
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *InquiryMutation) EdgeCleared(name string) bool {
-	return false
+// Fix returns the value of the "fix" field in the mutation.
+func (m *LearningMutation) Fix() (r string, exists bool) {
+	v := m.fix
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *InquiryMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Inquiry unique edge %s", name)
+// OldFix returns the old "fix" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldFix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFix: %w", err)
+	}
+	return oldValue.Fix, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *InquiryMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Inquiry edge %s", name)
+// ClearFix clears the value of the "fix" field.
+func (m *LearningMutation) ClearFix() {
+	m.fix = nil
+	m.clearedFields[learning.FieldFix] = struct{}{}
 }
 
-// KeyMutation represents an operation that mutates the Key nodes in the graph.
-type KeyMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	name           *string
-	remote_key_id  *string
-	_type          *key.Type
-	created_at     *time.Time
-	last_used_at   *time.Time
-	clearedFields  map[string]struct{}
-	secrets        map[uuid.UUID]struct{}
-	removedsecrets map[uuid.UUID]struct{}
-	clearedsecrets bool
-	done           bool
-	oldValue       func(context.Context) (*Key, error)
-	predicates     []predicate.Key
+// FixCleared returns if the "fix" field was cleared in this mutation.
+func (m *LearningMutation) FixCleared() bool {
+	_, ok := m.clearedFields[learning.FieldFix]
+	return ok
 }
 
-var _ ent.Mutation = (*KeyMutation)(nil)
+// ResetFix resets all changes to the "fix" field.
+func (m *LearningMutation) ResetFix() {
+	m.fix = nil
+	delete(m.clearedFields, learning.FieldFix)
+}
 
-// keyOption allows management of the mutation configuration using functional options.
-type keyOption func(*KeyMutation)
+// SetCategory sets the "category" field.
+func (m *LearningMutation) SetCategory(l learning.Category) {
+	m.category = &l
+}
 
-// newKeyMutation creates new mutation for the Key entity.
-func newKeyMutation(c config, op Op, opts ...keyOption) *KeyMutation {
-	m := &KeyMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeKey,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// Category returns the value of the "category" field in the mutation.
+func (m *LearningMutation) Category() (r learning.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withKeyID sets the ID field of the mutation.
-func withKeyID(id uuid.UUID) keyOption {
-	return func(m *KeyMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Key
-		)
-		m.oldValue = func(ctx context.Context) (*Key, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Key.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldCategory returns the old "category" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldCategory(ctx context.Context) (v learning.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
+	return oldValue.Category, nil
 }
 
-// withKey sets the old Key of the mutation.
-func withKey(node *Key) keyOption {
-	return func(m *KeyMutation) {
-		m.oldValue = func(context.Context) (*Key, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ResetCategory resets all changes to the "category" field.
+func (m *LearningMutation) ResetCategory() {
+	m.category = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KeyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// SetTags sets the "tags" field.
+func (m *LearningMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m KeyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// Tags returns the value of the "tags" field in the mutation.
+func (m *LearningMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Key entities.
-func (m *KeyMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// OldTags returns the old "tags" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *KeyMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// AppendTags adds s to the "tags" field.
+func (m *LearningMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *LearningMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
 	}
-	return *m.id, true
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *LearningMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[learning.FieldTags] = struct{}{}
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *KeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Key.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *LearningMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[learning.FieldTags]
+	return ok
 }
 
-// SetName sets the "name" field.
-func (m *KeyMutation) SetName(s string) {
-	m.name = &s
+// ResetTags resets all changes to the "tags" field.
+func (m *LearningMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, learning.FieldTags)
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *KeyMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetOccurrenceCount sets the "occurrence_count" field.
+func (m *LearningMutation) SetOccurrenceCount(i int) {
+	m.occurrence_count = &i
+	m.addoccurrence_count = nil
+}
+
+// OccurrenceCount returns the value of the "occurrence_count" field in the mutation.
+func (m *LearningMutation) OccurrenceCount() (r int, exists bool) {
+	v := m.occurrence_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Key entity.
-// If the Key object wasn't provided to the builder, the object is fetched from the database.
+// OldOccurrenceCount returns the old "occurrence_count" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *LearningMutation) OldOccurrenceCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccurrenceCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOccurrenceCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOccurrenceCount: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OccurrenceCount, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *KeyMutation) ResetName() {
-	m.name = nil
+// AddOccurrenceCount adds i to the "occurrence_count" field.
+func (m *LearningMutation) AddOccurrenceCount(i int) {
+	if m.addoccurrence_count != nil {
+		*m.addoccurrence_count += i
+	} else {
+		m.addoccurrence_count = &i
+	}
 }
 
-// SetRemoteKeyID sets the "remote_key_id" field.
-func (m *KeyMutation) SetRemoteKeyID(s string) {
-	m.remote_key_id = &s
+// AddedOccurrenceCount returns the value that was added to the "occurrence_count" field in this mutation.
+func (m *LearningMutation) AddedOccurrenceCount() (r int, exists bool) {
+	v := m.addoccurrence_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoteKeyID returns the value of the "remote_key_id" field in the mutation.
-func (m *KeyMutation) RemoteKeyID() (r string, exists bool) {
-	v := m.remote_key_id
+// ResetOccurrenceCount resets all changes to the "occurrence_count" field.
+func (m *LearningMutation) ResetOccurrenceCount() {
+	m.occurrence_count = nil
+	m.addoccurrence_count = nil
+}
+
+// SetSuccessCount sets the "success_count" field.
+func (m *LearningMutation) SetSuccessCount(i int) {
+	m.success_count = &i
+	m.addsuccess_count = nil
+}
+
+// SuccessCount returns the value of the "success_count" field in the mutation.
+func (m *LearningMutation) SuccessCount() (r int, exists bool) {
+	v := m.success_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRemoteKeyID returns the old "remote_key_id" field's value of the Key entity.
-// If the Key object wasn't provided to the builder, the object is fetched from the database.
+// OldSuccessCount returns the old "success_count" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyMutation) OldRemoteKeyID(ctx context.Context) (v string, err error) {
+func (m *LearningMutation) OldSuccessCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRemoteKeyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuccessCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRemoteKeyID requires an ID field in the mutation")
+		return v, errors.New("OldSuccessCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRemoteKeyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuccessCount: %w", err)
 	}
-	return oldValue.RemoteKeyID, nil
+	return oldValue.SuccessCount, nil
 }
 
-// ResetRemoteKeyID resets all changes to the "remote_key_id" field.
-func (m *KeyMutation) ResetRemoteKeyID() {
-	m.remote_key_id = nil
+// AddSuccessCount adds i to the "success_count" field.
+func (m *LearningMutation) AddSuccessCount(i int) {
+	if m.addsuccess_count != nil {
+		*m.addsuccess_count += i
+	} else {
+		m.addsuccess_count = &i
+	}
 }
 
-// SetType sets the "type" field.
-func (m *KeyMutation) SetType(k key.Type) {
-	m._type = &k
+// AddedSuccessCount returns the value that was added to the "success_count" field in this mutation.
+func (m *LearningMutation) AddedSuccessCount() (r int, exists bool) {
+	v := m.addsuccess_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *KeyMutation) GetType() (r key.Type, exists bool) {
-	v := m._type
+// ResetSuccessCount resets all changes to the "success_count" field.
+func (m *LearningMutation) ResetSuccessCount() {
+	m.success_count = nil
+	m.addsuccess_count = nil
+}
+
+// SetConfidence sets the "confidence" field.
+func (m *LearningMutation) SetConfidence(f float64) {
+	m.confidence = &f
+	m.addconfidence = nil
+}
+
+// Confidence returns the value of the "confidence" field in the mutation.
+func (m *LearningMutation) Confidence() (r float64, exists bool) {
+	v := m.confidence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Key entity.
-// If the Key object wasn't provided to the builder, the object is fetched from the database.
+// OldConfidence returns the old "confidence" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyMutation) OldType(ctx context.Context) (v key.Type, err error) {
+func (m *LearningMutation) OldConfidence(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldConfidence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldConfidence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldConfidence: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Confidence, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *KeyMutation) ResetType() {
-	m._type = nil
+// AddConfidence adds f to the "confidence" field.
+func (m *LearningMutation) AddConfidence(f float64) {
+	if m.addconfidence != nil {
+		*m.addconfidence += f
+	} else {
+		m.addconfidence = &f
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *KeyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedConfidence returns the value that was added to the "confidence" field in this mutation.
+func (m *LearningMutation) AddedConfidence() (r float64, exists bool) {
+	v := m.addconfidence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *KeyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetConfidence resets all changes to the "confidence" field.
+func (m *LearningMutation) ResetConfidence() {
+	m.confidence = nil
+	m.addconfidence = nil
+}
+
+// SetFailureCount sets the "failure_count" field.
+func (m *LearningMutation) SetFailureCount(i int) {
+	m.failure_count = &i
+	m.addfailure_count = nil
+}
+
+// FailureCount returns the value of the "failure_count" field in the mutation.
+func (m *LearningMutation) FailureCount() (r int, exists bool) {
+	v := m.failure_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Key entity.
-// If the Key object wasn't provided to the builder, the object is fetched from the database.
+// OldFailureCount returns the old "failure_count" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *LearningMutation) OldFailureCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailureCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFailureCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailureCount: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.FailureCount, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *KeyMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddFailureCount adds i to the "failure_count" field.
+func (m *LearningMutation) AddFailureCount(i int) {
+	if m.addfailure_count != nil {
+		*m.addfailure_count += i
+	} else {
+		m.addfailure_count = &i
+	}
 }
 
-// SetLastUsedAt sets the "last_used_at" field.
-func (m *KeyMutation) SetLastUsedAt(t time.Time) {
-	m.last_used_at = &t
+// AddedFailureCount returns the value that was added to the "failure_count" field in this mutation.
+func (m *LearningMutation) AddedFailureCount() (r int, exists bool) {
+	v := m.addfailure_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// LastUsedAt returns the value of the "last_used_at" field in the mutation.
-func (m *KeyMutation) LastUsedAt() (r time.Time, exists bool) {
-	v := m.last_used_at
+// ResetFailureCount resets all changes to the "failure_count" field.
+func (m *LearningMutation) ResetFailureCount() {
+	m.failure_count = nil
+	m.addfailure_count = nil
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (m *LearningMutation) SetReviewStatus(ls learning.ReviewStatus) {
+	m.review_status = &ls
+}
+
+// ReviewStatus returns the value of the "review_status" field in the mutation.
+func (m *LearningMutation) ReviewStatus() (r learning.ReviewStatus, exists bool) {
+	v := m.review_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUsedAt returns the old "last_used_at" field's value of the Key entity.
-// If the Key object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewStatus returns the old "review_status" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *LearningMutation) OldReviewStatus(ctx context.Context) (v learning.ReviewStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+		return v, errors.New("OldReviewStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewStatus: %w", err)
 	}
-	return oldValue.LastUsedAt, nil
+	return oldValue.ReviewStatus, nil
 }
 
-// ClearLastUsedAt clears the value of the "last_used_at" field.
-func (m *KeyMutation) ClearLastUsedAt() {
-	m.last_used_at = nil
-	m.clearedFields[key.FieldLastUsedAt] = struct{}{}
+// ResetReviewStatus resets all changes to the "review_status" field.
+func (m *LearningMutation) ResetReviewStatus() {
+	m.review_status = nil
 }
 
-// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
-func (m *KeyMutation) LastUsedAtCleared() bool {
-	_, ok := m.clearedFields[key.FieldLastUsedAt]
-	return ok
+// SetCreatedAt sets the "created_at" field.
+func (m *LearningMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ResetLastUsedAt resets all changes to the "last_used_at" field.
-func (m *KeyMutation) ResetLastUsedAt() {
-	m.last_used_at = nil
-	delete(m.clearedFields, key.FieldLastUsedAt)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LearningMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddSecretIDs adds the "secrets" edge to the Secret entity by ids.
-func (m *KeyMutation) AddSecretIDs(ids ...uuid.UUID) {
-	if m.secrets == nil {
-		m.secrets = make(map[uuid.UUID]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.secrets[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ClearSecrets clears the "secrets" edge to the Secret entity.
-func (m *KeyMutation) ClearSecrets() {
-	m.clearedsecrets = true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LearningMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SecretsCleared reports if the "secrets" edge to the Secret entity was cleared.
-func (m *KeyMutation) SecretsCleared() bool {
-	return m.clearedsecrets
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LearningMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// RemoveSecretIDs removes the "secrets" edge to the Secret entity by IDs.
-func (m *KeyMutation) RemoveSecretIDs(ids ...uuid.UUID) {
-	if m.removedsecrets == nil {
-		m.removedsecrets = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.secrets, ids[i])
-		m.removedsecrets[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LearningMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedSecrets returns the removed IDs of the "secrets" edge to the Secret entity.
-func (m *KeyMutation) RemovedSecretsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsecrets {
-		ids = append(ids, id)
+// OldUpdatedAt returns the old "updated_at" field's value of the Learning entity.
+// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearningMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// SecretsIDs returns the "secrets" edge IDs in the mutation.
-func (m *KeyMutation) SecretsIDs() (ids []uuid.UUID) {
-	for id := range m.secrets {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSecrets resets all changes to the "secrets" edge.
-func (m *KeyMutation) ResetSecrets() {
-	m.secrets = nil
-	m.clearedsecrets = false
-	m.removedsecrets = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LearningMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the KeyMutation builder.
-func (m *KeyMutation) Where(ps ...predicate.Key) {
+// Where appends a list predicates to the LearningMutation builder.
+func (m *LearningMutation) Where(ps ...predicate.Learning) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the KeyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the LearningMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *KeyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Key, len(ps))
+func (m *LearningMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Learning, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5280,39 +9893,63 @@ func (m *KeyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *KeyMutation) Op() Op {
+func (m *LearningMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *KeyMutation) SetOp(op Op) {
+func (m *LearningMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Key).
-func (m *KeyMutation) Type() string {
+// Type returns the node type of this mutation (Learning).
+func (m *LearningMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *KeyMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, key.FieldName)
+func (m *LearningMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.trigger != nil {
+		fields = append(fields, learning.FieldTrigger)
 	}
-	if m.remote_key_id != nil {
-		fields = append(fields, key.FieldRemoteKeyID)
+	if m.error_pattern != nil {
+		fields = append(fields, learning.FieldErrorPattern)
 	}
-	if m._type != nil {
-		fields = append(fields, key.FieldType)
+	if m.diagnosis != nil {
+		fields = append(fields, learning.FieldDiagnosis)
+	}
+	if m.fix != nil {
+		fields = append(fields, learning.FieldFix)
+	}
+	if m.category != nil {
+		fields = append(fields, learning.FieldCategory)
+	}
+	if m.tags != nil {
+		fields = append(fields, learning.FieldTags)
+	}
+	if m.occurrence_count != nil {
+		fields = append(fields, learning.FieldOccurrenceCount)
+	}
+	if m.success_count != nil {
+		fields = append(fields, learning.FieldSuccessCount)
+	}
+	if m.confidence != nil {
+		fields = append(fields, learning.FieldConfidence)
+	}
+	if m.failure_count != nil {
+		fields = append(fields, learning.FieldFailureCount)
+	}
+	if m.review_status != nil {
+		fields = append(fields, learning.FieldReviewStatus)
 	}
 	if m.created_at != nil {
-		fields = append(fields, key.FieldCreatedAt)
+		fields = append(fields, learning.FieldCreatedAt)
 	}
-	if m.last_used_at != nil {
-		fields = append(fields, key.FieldLastUsedAt)
+	if m.updated_at != nil {
+		fields = append(fields, learning.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5320,18 +9957,34 @@ func (m *KeyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *KeyMutation) Field(name string) (ent.Value, bool) {
+func (m *LearningMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case key.FieldName:
-		return m.Name()
-	case key.FieldRemoteKeyID:
-		return m.RemoteKeyID()
-	case key.FieldType:
-		return m.GetType()
-	case key.FieldCreatedAt:
+	case learning.FieldTrigger:
+		return m.Trigger()
+	case learning.FieldErrorPattern:
+		return m.ErrorPattern()
+	case learning.FieldDiagnosis:
+		return m.Diagnosis()
+	case learning.FieldFix:
+		return m.Fix()
+	case learning.FieldCategory:
+		return m.Category()
+	case learning.FieldTags:
+		return m.Tags()
+	case learning.FieldOccurrenceCount:
+		return m.OccurrenceCount()
+	case learning.FieldSuccessCount:
+		return m.SuccessCount()
+	case learning.FieldConfidence:
+		return m.Confidence()
+	case learning.FieldFailureCount:
+		return m.FailureCount()
+	case learning.FieldReviewStatus:
+		return m.ReviewStatus()
+	case learning.FieldCreatedAt:
 		return m.CreatedAt()
-	case key.FieldLastUsedAt:
-		return m.LastUsedAt()
+	case learning.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5339,258 +9992,383 @@ func (m *KeyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *KeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *LearningMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case key.FieldName:
-		return m.OldName(ctx)
-	case key.FieldRemoteKeyID:
-		return m.OldRemoteKeyID(ctx)
-	case key.FieldType:
-		return m.OldType(ctx)
-	case key.FieldCreatedAt:
+	case learning.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case learning.FieldErrorPattern:
+		return m.OldErrorPattern(ctx)
+	case learning.FieldDiagnosis:
+		return m.OldDiagnosis(ctx)
+	case learning.FieldFix:
+		return m.OldFix(ctx)
+	case learning.FieldCategory:
+		return m.OldCategory(ctx)
+	case learning.FieldTags:
+		return m.OldTags(ctx)
+	case learning.FieldOccurrenceCount:
+		return m.OldOccurrenceCount(ctx)
+	case learning.FieldSuccessCount:
+		return m.OldSuccessCount(ctx)
+	case learning.FieldConfidence:
+		return m.OldConfidence(ctx)
+	case learning.FieldFailureCount:
+		return m.OldFailureCount(ctx)
+	case learning.FieldReviewStatus:
+		return m.OldReviewStatus(ctx)
+	case learning.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case key.FieldLastUsedAt:
-		return m.OldLastUsedAt(ctx)
+	case learning.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Key field %s", name)
+	return nil, fmt.Errorf("unknown Learning field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeyMutation) SetField(name string, value ent.Value) error {
+func (m *LearningMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case key.FieldName:
+	case learning.FieldTrigger:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrigger(v)
+		return nil
+	case learning.FieldErrorPattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorPattern(v)
+		return nil
+	case learning.FieldDiagnosis:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDiagnosis(v)
+		return nil
+	case learning.FieldFix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFix(v)
+		return nil
+	case learning.FieldCategory:
+		v, ok := value.(learning.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case learning.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case learning.FieldOccurrenceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurrenceCount(v)
+		return nil
+	case learning.FieldSuccessCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuccessCount(v)
+		return nil
+	case learning.FieldConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfidence(v)
 		return nil
-	case key.FieldRemoteKeyID:
-		v, ok := value.(string)
+	case learning.FieldFailureCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRemoteKeyID(v)
+		m.SetFailureCount(v)
 		return nil
-	case key.FieldType:
-		v, ok := value.(key.Type)
+	case learning.FieldReviewStatus:
+		v, ok := value.(learning.ReviewStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetReviewStatus(v)
 		return nil
-	case key.FieldCreatedAt:
+	case learning.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case key.FieldLastUsedAt:
+	case learning.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastUsedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Key field %s", name)
+	return fmt.Errorf("unknown Learning field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *KeyMutation) AddedFields() []string {
-	return nil
+func (m *LearningMutation) AddedFields() []string {
+	var fields []string
+	if m.addoccurrence_count != nil {
+		fields = append(fields, learning.FieldOccurrenceCount)
+	}
+	if m.addsuccess_count != nil {
+		fields = append(fields, learning.FieldSuccessCount)
+	}
+	if m.addconfidence != nil {
+		fields = append(fields, learning.FieldConfidence)
+	}
+	if m.addfailure_count != nil {
+		fields = append(fields, learning.FieldFailureCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *KeyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *LearningMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case learning.FieldOccurrenceCount:
+		return m.AddedOccurrenceCount()
+	case learning.FieldSuccessCount:
+		return m.AddedSuccessCount()
+	case learning.FieldConfidence:
+		return m.AddedConfidence()
+	case learning.FieldFailureCount:
+		return m.AddedFailureCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KeyMutation) AddField(name string, value ent.Value) error {
+func (m *LearningMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case learning.FieldOccurrenceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOccurrenceCount(v)
+		return nil
+	case learning.FieldSuccessCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuccessCount(v)
+		return nil
+	case learning.FieldConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfidence(v)
+		return nil
+	case learning.FieldFailureCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailureCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Key numeric field %s", name)
+	return fmt.Errorf("unknown Learning numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *KeyMutation) ClearedFields() []string {
+func (m *LearningMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(key.FieldLastUsedAt) {
-		fields = append(fields, key.FieldLastUsedAt)
+	if m.FieldCleared(learning.FieldErrorPattern) {
+		fields = append(fields, learning.FieldErrorPattern)
+	}
+	if m.FieldCleared(learning.FieldDiagnosis) {
+		fields = append(fields, learning.FieldDiagnosis)
+	}
+	if m.FieldCleared(learning.FieldFix) {
+		fields = append(fields, learning.FieldFix)
+	}
+	if m.FieldCleared(learning.FieldTags) {
+		fields = append(fields, learning.FieldTags)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *KeyMutation) FieldCleared(name string) bool {
+func (m *LearningMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *KeyMutation) ClearField(name string) error {
+func (m *LearningMutation) ClearField(name string) error {
 	switch name {
-	case key.FieldLastUsedAt:
-		m.ClearLastUsedAt()
+	case learning.FieldErrorPattern:
+		m.ClearErrorPattern()
+		return nil
+	case learning.FieldDiagnosis:
+		m.ClearDiagnosis()
+		return nil
+	case learning.FieldFix:
+		m.ClearFix()
+		return nil
+	case learning.FieldTags:
+		m.ClearTags()
 		return nil
 	}
-	return fmt.Errorf("unknown Key nullable field %s", name)
+	return fmt.Errorf("unknown Learning nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *KeyMutation) ResetField(name string) error {
+func (m *LearningMutation) ResetField(name string) error {
 	switch name {
-	case key.FieldName:
-		m.ResetName()
+	case learning.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case key.FieldRemoteKeyID:
-		m.ResetRemoteKeyID()
+	case learning.FieldErrorPattern:
+		m.ResetErrorPattern()
 		return nil
-	case key.FieldType:
-		m.ResetType()
+	case learning.FieldDiagnosis:
+		m.ResetDiagnosis()
 		return nil
-	case key.FieldCreatedAt:
+	case learning.FieldFix:
+		m.ResetFix()
+		return nil
+	case learning.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case learning.FieldTags:
+		m.ResetTags()
+		return nil
+	case learning.FieldOccurrenceCount:
+		m.ResetOccurrenceCount()
+		return nil
+	case learning.FieldSuccessCount:
+		m.ResetSuccessCount()
+		return nil
+	case learning.FieldConfidence:
+		m.ResetConfidence()
+		return nil
+	case learning.FieldFailureCount:
+		m.ResetFailureCount()
+		return nil
+	case learning.FieldReviewStatus:
+		m.ResetReviewStatus()
+		return nil
+	case learning.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case key.FieldLastUsedAt:
-		m.ResetLastUsedAt()
+	case learning.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Key field %s", name)
+	return fmt.Errorf("unknown Learning field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KeyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.secrets != nil {
-		edges = append(edges, key.EdgeSecrets)
-	}
+func (m *LearningMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *KeyMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case key.EdgeSecrets:
-		ids := make([]ent.Value, 0, len(m.secrets))
-		for id := range m.secrets {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *LearningMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KeyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedsecrets != nil {
-		edges = append(edges, key.EdgeSecrets)
-	}
+func (m *LearningMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *KeyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case key.EdgeSecrets:
-		ids := make([]ent.Value, 0, len(m.removedsecrets))
-		for id := range m.removedsecrets {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *LearningMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KeyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsecrets {
-		edges = append(edges, key.EdgeSecrets)
-	}
+func (m *LearningMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *KeyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case key.EdgeSecrets:
-		return m.clearedsecrets
-	}
+func (m *LearningMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *KeyMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Key unique edge %s", name)
+func (m *LearningMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Learning unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *KeyMutation) ResetEdge(name string) error {
-	switch name {
-	case key.EdgeSecrets:
-		m.ResetSecrets()
-		return nil
-	}
-	return fmt.Errorf("unknown Key edge %s", name)
+func (m *LearningMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Learning edge %s", name)
 }
 
-// KnowledgeMutation represents an operation that mutates the Knowledge nodes in the graph.
-type KnowledgeMutation struct {
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	key                *string
-	category           *knowledge.Category
-	content            *string
-	tags               *[]string
-	appendtags         []string
-	source             *string
-	use_count          *int
-	adduse_count       *int
-	relevance_score    *float64
-	addrelevance_score *float64
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*Knowledge, error)
-	predicates         []predicate.Knowledge
+	op               Op
+	typ              string
+	id               *int
+	role             *string
+	content          *string
+	timestamp        *time.Time
+	tool_calls       *[]schema.ToolCall
+	appendtool_calls []schema.ToolCall
+	author           *string
+	clearedFields    map[string]struct{}
+	session          *int
+	clearedsession   bool
+	done             bool
+	oldValue         func(context.Context) (*Message, error)
+	predicates       []predicate.Message
 }
 
-var _ ent.Mutation = (*KnowledgeMutation)(nil)
+var _ ent.Mutation = (*MessageMutation)(nil)
 
-// knowledgeOption allows management of the mutation configuration using functional options.
-type knowledgeOption func(*KnowledgeMutation)
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
 
-// newKnowledgeMutation creates new mutation for the Knowledge entity.
-func newKnowledgeMutation(c config, op Op, opts ...knowledgeOption) *KnowledgeMutation {
-	m := &KnowledgeMutation{
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeKnowledge,
+		typ:           TypeMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5599,20 +10377,20 @@ func newKnowledgeMutation(c config, op Op, opts ...knowledgeOption) *KnowledgeMu
 	return m
 }
 
-// withKnowledgeID sets the ID field of the mutation.
-func withKnowledgeID(id uuid.UUID) knowledgeOption {
-	return func(m *KnowledgeMutation) {
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id int) messageOption {
+	return func(m *MessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Knowledge
+			value *Message
 		)
-		m.oldValue = func(ctx context.Context) (*Knowledge, error) {
+		m.oldValue = func(ctx context.Context) (*Message, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Knowledge.Get(ctx, id)
+					value, err = m.Client().Message.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5621,10 +10399,10 @@ func withKnowledgeID(id uuid.UUID) knowledgeOption {
 	}
 }
 
-// withKnowledge sets the old Knowledge of the mutation.
-func withKnowledge(node *Knowledge) knowledgeOption {
-	return func(m *KnowledgeMutation) {
-		m.oldValue = func(context.Context) (*Knowledge, error) {
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5633,7 +10411,7 @@ func withKnowledge(node *Knowledge) knowledgeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m KnowledgeMutation) Client() *Client {
+func (m MessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5641,7 +10419,7 @@ func (m KnowledgeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m KnowledgeMutation) Tx() (*Tx, error) {
+func (m MessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5650,15 +10428,9 @@ func (m KnowledgeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Knowledge entities.
-func (m *KnowledgeMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *KnowledgeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MessageMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5669,100 +10441,64 @@ func (m *KnowledgeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *KnowledgeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []uuid.UUID{id}, nil
+			return []int{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Knowledge.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *KnowledgeMutation) SetKey(s string) {
-	m.key = &s
-}
-
-// Key returns the value of the "key" field in the mutation.
-func (m *KnowledgeMutation) Key() (r string, exists bool) {
-	v := m.key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKey returns the old "key" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
-	}
-	return oldValue.Key, nil
-}
-
-// ResetKey resets all changes to the "key" field.
-func (m *KnowledgeMutation) ResetKey() {
-	m.key = nil
-}
-
-// SetCategory sets the "category" field.
-func (m *KnowledgeMutation) SetCategory(k knowledge.Category) {
-	m.category = &k
+// SetRole sets the "role" field.
+func (m *MessageMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *KnowledgeMutation) Category() (r knowledge.Category, exists bool) {
-	v := m.category
+// Role returns the value of the "role" field in the mutation.
+func (m *MessageMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldCategory(ctx context.Context) (v knowledge.Category, err error) {
+func (m *MessageMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.Role, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *KnowledgeMutation) ResetCategory() {
-	m.category = nil
+// ResetRole resets all changes to the "role" field.
+func (m *MessageMutation) ResetRole() {
+	m.role = nil
 }
 
 // SetContent sets the "content" field.
-func (m *KnowledgeMutation) SetContent(s string) {
+func (m *MessageMutation) SetContent(s string) {
 	m.content = &s
 }
 
 // Content returns the value of the "content" field in the mutation.
-func (m *KnowledgeMutation) Content() (r string, exists bool) {
+func (m *MessageMutation) Content() (r string, exists bool) {
 	v := m.content
 	if v == nil {
 		return
@@ -5770,10 +10506,10 @@ func (m *KnowledgeMutation) Content() (r string, exists bool) {
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
@@ -5788,317 +10524,208 @@ func (m *KnowledgeMutation) OldContent(ctx context.Context) (v string, err error
 }
 
 // ResetContent resets all changes to the "content" field.
-func (m *KnowledgeMutation) ResetContent() {
+func (m *MessageMutation) ResetContent() {
 	m.content = nil
 }
 
-// SetTags sets the "tags" field.
-func (m *KnowledgeMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
-}
-
-// Tags returns the value of the "tags" field in the mutation.
-func (m *KnowledgeMutation) Tags() (r []string, exists bool) {
-	v := m.tags
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTags returns the old "tags" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldTags(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
-	}
-	return oldValue.Tags, nil
-}
-
-// AppendTags adds s to the "tags" field.
-func (m *KnowledgeMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
-}
-
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *KnowledgeMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
-	}
-	return m.appendtags, true
-}
-
-// ClearTags clears the value of the "tags" field.
-func (m *KnowledgeMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[knowledge.FieldTags] = struct{}{}
-}
-
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *KnowledgeMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[knowledge.FieldTags]
-	return ok
-}
-
-// ResetTags resets all changes to the "tags" field.
-func (m *KnowledgeMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, knowledge.FieldTags)
-}
-
-// SetSource sets the "source" field.
-func (m *KnowledgeMutation) SetSource(s string) {
-	m.source = &s
-}
-
-// Source returns the value of the "source" field in the mutation.
-func (m *KnowledgeMutation) Source() (r string, exists bool) {
-	v := m.source
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSource returns the old "source" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldSource(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
-	}
-	return oldValue.Source, nil
-}
-
-// ClearSource clears the value of the "source" field.
-func (m *KnowledgeMutation) ClearSource() {
-	m.source = nil
-	m.clearedFields[knowledge.FieldSource] = struct{}{}
-}
-
-// SourceCleared returns if the "source" field was cleared in this mutation.
-func (m *KnowledgeMutation) SourceCleared() bool {
-	_, ok := m.clearedFields[knowledge.FieldSource]
-	return ok
-}
-
-// ResetSource resets all changes to the "source" field.
-func (m *KnowledgeMutation) ResetSource() {
-	m.source = nil
-	delete(m.clearedFields, knowledge.FieldSource)
-}
-
-// SetUseCount sets the "use_count" field.
-func (m *KnowledgeMutation) SetUseCount(i int) {
-	m.use_count = &i
-	m.adduse_count = nil
+// SetTimestamp sets the "timestamp" field.
+func (m *MessageMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
 }
 
-// UseCount returns the value of the "use_count" field in the mutation.
-func (m *KnowledgeMutation) UseCount() (r int, exists bool) {
-	v := m.use_count
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *MessageMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
-
-// OldUseCount returns the old "use_count" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+
+// OldTimestamp returns the old "timestamp" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldUseCount(ctx context.Context) (v int, err error) {
+func (m *MessageMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUseCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUseCount requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUseCount: %w", err)
-	}
-	return oldValue.UseCount, nil
-}
-
-// AddUseCount adds i to the "use_count" field.
-func (m *KnowledgeMutation) AddUseCount(i int) {
-	if m.adduse_count != nil {
-		*m.adduse_count += i
-	} else {
-		m.adduse_count = &i
-	}
-}
-
-// AddedUseCount returns the value that was added to the "use_count" field in this mutation.
-func (m *KnowledgeMutation) AddedUseCount() (r int, exists bool) {
-	v := m.adduse_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return *v, true
+	return oldValue.Timestamp, nil
 }
 
-// ResetUseCount resets all changes to the "use_count" field.
-func (m *KnowledgeMutation) ResetUseCount() {
-	m.use_count = nil
-	m.adduse_count = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *MessageMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// SetRelevanceScore sets the "relevance_score" field.
-func (m *KnowledgeMutation) SetRelevanceScore(f float64) {
-	m.relevance_score = &f
-	m.addrelevance_score = nil
+// SetToolCalls sets the "tool_calls" field.
+func (m *MessageMutation) SetToolCalls(sc []schema.ToolCall) {
+	m.tool_calls = &sc
+	m.appendtool_calls = nil
 }
 
-// RelevanceScore returns the value of the "relevance_score" field in the mutation.
-func (m *KnowledgeMutation) RelevanceScore() (r float64, exists bool) {
-	v := m.relevance_score
+// ToolCalls returns the value of the "tool_calls" field in the mutation.
+func (m *MessageMutation) ToolCalls() (r []schema.ToolCall, exists bool) {
+	v := m.tool_calls
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRelevanceScore returns the old "relevance_score" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldToolCalls returns the old "tool_calls" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldRelevanceScore(ctx context.Context) (v float64, err error) {
+func (m *MessageMutation) OldToolCalls(ctx context.Context) (v []schema.ToolCall, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRelevanceScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldToolCalls is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRelevanceScore requires an ID field in the mutation")
+		return v, errors.New("OldToolCalls requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRelevanceScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldToolCalls: %w", err)
 	}
-	return oldValue.RelevanceScore, nil
+	return oldValue.ToolCalls, nil
 }
 
-// AddRelevanceScore adds f to the "relevance_score" field.
-func (m *KnowledgeMutation) AddRelevanceScore(f float64) {
-	if m.addrelevance_score != nil {
-		*m.addrelevance_score += f
-	} else {
-		m.addrelevance_score = &f
-	}
+// AppendToolCalls adds sc to the "tool_calls" field.
+func (m *MessageMutation) AppendToolCalls(sc []schema.ToolCall) {
+	m.appendtool_calls = append(m.appendtool_calls, sc...)
 }
 
-// AddedRelevanceScore returns the value that was added to the "relevance_score" field in this mutation.
-func (m *KnowledgeMutation) AddedRelevanceScore() (r float64, exists bool) {
-	v := m.addrelevance_score
-	if v == nil {
-		return
+// AppendedToolCalls returns the list of values that were appended to the "tool_calls" field in this mutation.
+func (m *MessageMutation) AppendedToolCalls() ([]schema.ToolCall, bool) {
+	if len(m.appendtool_calls) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendtool_calls, true
 }
 
-// ResetRelevanceScore resets all changes to the "relevance_score" field.
-func (m *KnowledgeMutation) ResetRelevanceScore() {
-	m.relevance_score = nil
-	m.addrelevance_score = nil
+// ClearToolCalls clears the value of the "tool_calls" field.
+func (m *MessageMutation) ClearToolCalls() {
+	m.tool_calls = nil
+	m.appendtool_calls = nil
+	m.clearedFields[message.FieldToolCalls] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *KnowledgeMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ToolCallsCleared returns if the "tool_calls" field was cleared in this mutation.
+func (m *MessageMutation) ToolCallsCleared() bool {
+	_, ok := m.clearedFields[message.FieldToolCalls]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *KnowledgeMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetToolCalls resets all changes to the "tool_calls" field.
+func (m *MessageMutation) ResetToolCalls() {
+	m.tool_calls = nil
+	m.appendtool_calls = nil
+	delete(m.clearedFields, message.FieldToolCalls)
+}
+
+// SetAuthor sets the "author" field.
+func (m *MessageMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *MessageMutation) Author() (r string, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthor returns the old "author" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldAuthor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Author, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *KnowledgeMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearAuthor clears the value of the "author" field.
+func (m *MessageMutation) ClearAuthor() {
+	m.author = nil
+	m.clearedFields[message.FieldAuthor] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *KnowledgeMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AuthorCleared returns if the "author" field was cleared in this mutation.
+func (m *MessageMutation) AuthorCleared() bool {
+	_, ok := m.clearedFields[message.FieldAuthor]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *KnowledgeMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetAuthor resets all changes to the "author" field.
+func (m *MessageMutation) ResetAuthor() {
+	m.author = nil
+	delete(m.clearedFields, message.FieldAuthor)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Knowledge entity.
-// If the Knowledge object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *KnowledgeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// SetSessionID sets the "session" edge to the Session entity by id.
+func (m *MessageMutation) SetSessionID(id int) {
+	m.session = &id
+}
+
+// ClearSession clears the "session" edge to the Session entity.
+func (m *MessageMutation) ClearSession() {
+	m.clearedsession = true
+}
+
+// SessionCleared reports if the "session" edge to the Session entity was cleared.
+func (m *MessageMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionID returns the "session" edge ID in the mutation.
+func (m *MessageMutation) SessionID() (id int, exists bool) {
+	if m.session != nil {
+		return *m.session, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SessionIDs() (ids []int) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *KnowledgeMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetSession resets all changes to the "session" edge.
+func (m *MessageMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
 }
 
-// Where appends a list predicates to the KnowledgeMutation builder.
-func (m *KnowledgeMutation) Where(ps ...predicate.Knowledge) {
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the KnowledgeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *KnowledgeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Knowledge, len(ps))
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6106,51 +10733,39 @@ func (m *KnowledgeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *KnowledgeMutation) Op() Op {
+func (m *MessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *KnowledgeMutation) SetOp(op Op) {
+func (m *MessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Knowledge).
-func (m *KnowledgeMutation) Type() string {
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *KnowledgeMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.key != nil {
-		fields = append(fields, knowledge.FieldKey)
-	}
-	if m.category != nil {
-		fields = append(fields, knowledge.FieldCategory)
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.role != nil {
+		fields = append(fields, message.FieldRole)
 	}
 	if m.content != nil {
-		fields = append(fields, knowledge.FieldContent)
-	}
-	if m.tags != nil {
-		fields = append(fields, knowledge.FieldTags)
-	}
-	if m.source != nil {
-		fields = append(fields, knowledge.FieldSource)
-	}
-	if m.use_count != nil {
-		fields = append(fields, knowledge.FieldUseCount)
+		fields = append(fields, message.FieldContent)
 	}
-	if m.relevance_score != nil {
-		fields = append(fields, knowledge.FieldRelevanceScore)
+	if m.timestamp != nil {
+		fields = append(fields, message.FieldTimestamp)
 	}
-	if m.created_at != nil {
-		fields = append(fields, knowledge.FieldCreatedAt)
+	if m.tool_calls != nil {
+		fields = append(fields, message.FieldToolCalls)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, knowledge.FieldUpdatedAt)
+	if m.author != nil {
+		fields = append(fields, message.FieldAuthor)
 	}
 	return fields
 }
@@ -6158,26 +10773,18 @@ func (m *KnowledgeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *KnowledgeMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case knowledge.FieldKey:
-		return m.Key()
-	case knowledge.FieldCategory:
-		return m.Category()
-	case knowledge.FieldContent:
+	case message.FieldRole:
+		return m.Role()
+	case message.FieldContent:
 		return m.Content()
-	case knowledge.FieldTags:
-		return m.Tags()
-	case knowledge.FieldSource:
-		return m.Source()
-	case knowledge.FieldUseCount:
-		return m.UseCount()
-	case knowledge.FieldRelevanceScore:
-		return m.RelevanceScore()
-	case knowledge.FieldCreatedAt:
-		return m.CreatedAt()
-	case knowledge.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case message.FieldTimestamp:
+		return m.Timestamp()
+	case message.FieldToolCalls:
+		return m.ToolCalls()
+	case message.FieldAuthor:
+		return m.Author()
 	}
 	return nil, false
 }
@@ -6185,306 +10792,252 @@ func (m *KnowledgeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *KnowledgeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case knowledge.FieldKey:
-		return m.OldKey(ctx)
-	case knowledge.FieldCategory:
-		return m.OldCategory(ctx)
-	case knowledge.FieldContent:
+	case message.FieldRole:
+		return m.OldRole(ctx)
+	case message.FieldContent:
 		return m.OldContent(ctx)
-	case knowledge.FieldTags:
-		return m.OldTags(ctx)
-	case knowledge.FieldSource:
-		return m.OldSource(ctx)
-	case knowledge.FieldUseCount:
-		return m.OldUseCount(ctx)
-	case knowledge.FieldRelevanceScore:
-		return m.OldRelevanceScore(ctx)
-	case knowledge.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case knowledge.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case message.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case message.FieldToolCalls:
+		return m.OldToolCalls(ctx)
+	case message.FieldAuthor:
+		return m.OldAuthor(ctx)
 	}
-	return nil, fmt.Errorf("unknown Knowledge field %s", name)
+	return nil, fmt.Errorf("unknown Message field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KnowledgeMutation) SetField(name string, value ent.Value) error {
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case knowledge.FieldKey:
+	case message.FieldRole:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
-		return nil
-	case knowledge.FieldCategory:
-		v, ok := value.(knowledge.Category)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
+		m.SetRole(v)
 		return nil
-	case knowledge.FieldContent:
+	case message.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetContent(v)
 		return nil
-	case knowledge.FieldTags:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTags(v)
-		return nil
-	case knowledge.FieldSource:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSource(v)
-		return nil
-	case knowledge.FieldUseCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUseCount(v)
-		return nil
-	case knowledge.FieldRelevanceScore:
-		v, ok := value.(float64)
+	case message.FieldTimestamp:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRelevanceScore(v)
+		m.SetTimestamp(v)
 		return nil
-	case knowledge.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case message.FieldToolCalls:
+		v, ok := value.([]schema.ToolCall)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetToolCalls(v)
 		return nil
-	case knowledge.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case message.FieldAuthor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetAuthor(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Knowledge field %s", name)
+	return fmt.Errorf("unknown Message field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *KnowledgeMutation) AddedFields() []string {
-	var fields []string
-	if m.adduse_count != nil {
-		fields = append(fields, knowledge.FieldUseCount)
-	}
-	if m.addrelevance_score != nil {
-		fields = append(fields, knowledge.FieldRelevanceScore)
-	}
-	return fields
+func (m *MessageMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *KnowledgeMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case knowledge.FieldUseCount:
-		return m.AddedUseCount()
-	case knowledge.FieldRelevanceScore:
-		return m.AddedRelevanceScore()
-	}
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *KnowledgeMutation) AddField(name string, value ent.Value) error {
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case knowledge.FieldUseCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUseCount(v)
-		return nil
-	case knowledge.FieldRelevanceScore:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRelevanceScore(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Knowledge numeric field %s", name)
+	return fmt.Errorf("unknown Message numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *KnowledgeMutation) ClearedFields() []string {
+func (m *MessageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(knowledge.FieldTags) {
-		fields = append(fields, knowledge.FieldTags)
+	if m.FieldCleared(message.FieldToolCalls) {
+		fields = append(fields, message.FieldToolCalls)
 	}
-	if m.FieldCleared(knowledge.FieldSource) {
-		fields = append(fields, knowledge.FieldSource)
+	if m.FieldCleared(message.FieldAuthor) {
+		fields = append(fields, message.FieldAuthor)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *KnowledgeMutation) FieldCleared(name string) bool {
+func (m *MessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *KnowledgeMutation) ClearField(name string) error {
+func (m *MessageMutation) ClearField(name string) error {
 	switch name {
-	case knowledge.FieldTags:
-		m.ClearTags()
+	case message.FieldToolCalls:
+		m.ClearToolCalls()
 		return nil
-	case knowledge.FieldSource:
-		m.ClearSource()
+	case message.FieldAuthor:
+		m.ClearAuthor()
 		return nil
 	}
-	return fmt.Errorf("unknown Knowledge nullable field %s", name)
+	return fmt.Errorf("unknown Message nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *KnowledgeMutation) ResetField(name string) error {
+func (m *MessageMutation) ResetField(name string) error {
 	switch name {
-	case knowledge.FieldKey:
-		m.ResetKey()
-		return nil
-	case knowledge.FieldCategory:
-		m.ResetCategory()
+	case message.FieldRole:
+		m.ResetRole()
 		return nil
-	case knowledge.FieldContent:
+	case message.FieldContent:
 		m.ResetContent()
 		return nil
-	case knowledge.FieldTags:
-		m.ResetTags()
-		return nil
-	case knowledge.FieldSource:
-		m.ResetSource()
-		return nil
-	case knowledge.FieldUseCount:
-		m.ResetUseCount()
-		return nil
-	case knowledge.FieldRelevanceScore:
-		m.ResetRelevanceScore()
+	case message.FieldTimestamp:
+		m.ResetTimestamp()
 		return nil
-	case knowledge.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case message.FieldToolCalls:
+		m.ResetToolCalls()
 		return nil
-	case knowledge.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case message.FieldAuthor:
+		m.ResetAuthor()
 		return nil
 	}
-	return fmt.Errorf("unknown Knowledge field %s", name)
+	return fmt.Errorf("unknown Message field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *KnowledgeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.session != nil {
+		edges = append(edges, message.EdgeSession)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *KnowledgeMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *KnowledgeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *KnowledgeMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *KnowledgeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsession {
+		edges = append(edges, message.EdgeSession)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *KnowledgeMutation) EdgeCleared(name string) bool {
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeSession:
+		return m.clearedsession
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *KnowledgeMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Knowledge unique edge %s", name)
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeSession:
+		m.ClearSession()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *KnowledgeMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Knowledge edge %s", name)
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeSession:
+		m.ResetSession()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
 }
 
-// LearningMutation represents an operation that mutates the Learning nodes in the graph.
-type LearningMutation struct {
+// ObservationMutation represents an operation that mutates the Observation nodes in the graph.
+type ObservationMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	trigger             *string
-	error_pattern       *string
-	diagnosis           *string
-	fix                 *string
-	category            *learning.Category
-	tags                *[]string
-	appendtags          []string
-	occurrence_count    *int
-	addoccurrence_count *int
-	success_count       *int
-	addsuccess_count    *int
-	confidence          *float64
-	addconfidence       *float64
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*Learning, error)
-	predicates          []predicate.Learning
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	session_key           *string
+	content               *string
+	token_count           *int
+	addtoken_count        *int
+	source_start_index    *int
+	addsource_start_index *int
+	source_end_index      *int
+	addsource_end_index   *int
+	pinned                *bool
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*Observation, error)
+	predicates            []predicate.Observation
 }
 
-var _ ent.Mutation = (*LearningMutation)(nil)
+var _ ent.Mutation = (*ObservationMutation)(nil)
 
-// learningOption allows management of the mutation configuration using functional options.
-type learningOption func(*LearningMutation)
+// observationOption allows management of the mutation configuration using functional options.
+type observationOption func(*ObservationMutation)
 
-// newLearningMutation creates new mutation for the Learning entity.
-func newLearningMutation(c config, op Op, opts ...learningOption) *LearningMutation {
-	m := &LearningMutation{
+// newObservationMutation creates new mutation for the Observation entity.
+func newObservationMutation(c config, op Op, opts ...observationOption) *ObservationMutation {
+	m := &ObservationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLearning,
+		typ:           TypeObservation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6493,20 +11046,20 @@ func newLearningMutation(c config, op Op, opts ...learningOption) *LearningMutat
 	return m
 }
 
-// withLearningID sets the ID field of the mutation.
-func withLearningID(id uuid.UUID) learningOption {
-	return func(m *LearningMutation) {
+// withObservationID sets the ID field of the mutation.
+func withObservationID(id uuid.UUID) observationOption {
+	return func(m *ObservationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Learning
+			value *Observation
 		)
-		m.oldValue = func(ctx context.Context) (*Learning, error) {
+		m.oldValue = func(ctx context.Context) (*Observation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Learning.Get(ctx, id)
+					value, err = m.Client().Observation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6515,602 +11068,390 @@ func withLearningID(id uuid.UUID) learningOption {
 	}
 }
 
-// withLearning sets the old Learning of the mutation.
-func withLearning(node *Learning) learningOption {
-	return func(m *LearningMutation) {
-		m.oldValue = func(context.Context) (*Learning, error) {
+// withObservation sets the old Observation of the mutation.
+func withObservation(node *Observation) observationOption {
+	return func(m *ObservationMutation) {
+		m.oldValue = func(context.Context) (*Observation, error) {
 			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LearningMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m LearningMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Learning entities.
-func (m *LearningMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *LearningMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *LearningMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Learning.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetTrigger sets the "trigger" field.
-func (m *LearningMutation) SetTrigger(s string) {
-	m.trigger = &s
-}
-
-// Trigger returns the value of the "trigger" field in the mutation.
-func (m *LearningMutation) Trigger() (r string, exists bool) {
-	v := m.trigger
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldTrigger returns the old "trigger" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldTrigger(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrigger requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
-	}
-	return oldValue.Trigger, nil
-}
-
-// ResetTrigger resets all changes to the "trigger" field.
-func (m *LearningMutation) ResetTrigger() {
-	m.trigger = nil
-}
-
-// SetErrorPattern sets the "error_pattern" field.
-func (m *LearningMutation) SetErrorPattern(s string) {
-	m.error_pattern = &s
-}
-
-// ErrorPattern returns the value of the "error_pattern" field in the mutation.
-func (m *LearningMutation) ErrorPattern() (r string, exists bool) {
-	v := m.error_pattern
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldErrorPattern returns the old "error_pattern" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldErrorPattern(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorPattern is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorPattern requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorPattern: %w", err)
-	}
-	return oldValue.ErrorPattern, nil
-}
-
-// ClearErrorPattern clears the value of the "error_pattern" field.
-func (m *LearningMutation) ClearErrorPattern() {
-	m.error_pattern = nil
-	m.clearedFields[learning.FieldErrorPattern] = struct{}{}
-}
-
-// ErrorPatternCleared returns if the "error_pattern" field was cleared in this mutation.
-func (m *LearningMutation) ErrorPatternCleared() bool {
-	_, ok := m.clearedFields[learning.FieldErrorPattern]
-	return ok
-}
-
-// ResetErrorPattern resets all changes to the "error_pattern" field.
-func (m *LearningMutation) ResetErrorPattern() {
-	m.error_pattern = nil
-	delete(m.clearedFields, learning.FieldErrorPattern)
-}
-
-// SetDiagnosis sets the "diagnosis" field.
-func (m *LearningMutation) SetDiagnosis(s string) {
-	m.diagnosis = &s
-}
-
-// Diagnosis returns the value of the "diagnosis" field in the mutation.
-func (m *LearningMutation) Diagnosis() (r string, exists bool) {
-	v := m.diagnosis
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDiagnosis returns the old "diagnosis" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldDiagnosis(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiagnosis is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiagnosis requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiagnosis: %w", err)
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Diagnosis, nil
-}
-
-// ClearDiagnosis clears the value of the "diagnosis" field.
-func (m *LearningMutation) ClearDiagnosis() {
-	m.diagnosis = nil
-	m.clearedFields[learning.FieldDiagnosis] = struct{}{}
 }
 
-// DiagnosisCleared returns if the "diagnosis" field was cleared in this mutation.
-func (m *LearningMutation) DiagnosisCleared() bool {
-	_, ok := m.clearedFields[learning.FieldDiagnosis]
-	return ok
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ObservationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetDiagnosis resets all changes to the "diagnosis" field.
-func (m *LearningMutation) ResetDiagnosis() {
-	m.diagnosis = nil
-	delete(m.clearedFields, learning.FieldDiagnosis)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ObservationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetFix sets the "fix" field.
-func (m *LearningMutation) SetFix(s string) {
-	m.fix = &s
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Observation entities.
+func (m *ObservationMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// Fix returns the value of the "fix" field in the mutation.
-func (m *LearningMutation) Fix() (r string, exists bool) {
-	v := m.fix
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ObservationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldFix returns the old "fix" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldFix(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFix is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFix requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFix: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ObservationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Observation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Fix, nil
-}
-
-// ClearFix clears the value of the "fix" field.
-func (m *LearningMutation) ClearFix() {
-	m.fix = nil
-	m.clearedFields[learning.FieldFix] = struct{}{}
-}
-
-// FixCleared returns if the "fix" field was cleared in this mutation.
-func (m *LearningMutation) FixCleared() bool {
-	_, ok := m.clearedFields[learning.FieldFix]
-	return ok
-}
-
-// ResetFix resets all changes to the "fix" field.
-func (m *LearningMutation) ResetFix() {
-	m.fix = nil
-	delete(m.clearedFields, learning.FieldFix)
 }
 
-// SetCategory sets the "category" field.
-func (m *LearningMutation) SetCategory(l learning.Category) {
-	m.category = &l
+// SetSessionKey sets the "session_key" field.
+func (m *ObservationMutation) SetSessionKey(s string) {
+	m.session_key = &s
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *LearningMutation) Category() (r learning.Category, exists bool) {
-	v := m.category
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *ObservationMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldCategory(ctx context.Context) (v learning.Category, err error) {
+func (m *ObservationMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.SessionKey, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *LearningMutation) ResetCategory() {
-	m.category = nil
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *ObservationMutation) ResetSessionKey() {
+	m.session_key = nil
 }
 
-// SetTags sets the "tags" field.
-func (m *LearningMutation) SetTags(s []string) {
-	m.tags = &s
-	m.appendtags = nil
+// SetContent sets the "content" field.
+func (m *ObservationMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Tags returns the value of the "tags" field in the mutation.
-func (m *LearningMutation) Tags() (r []string, exists bool) {
-	v := m.tags
+// Content returns the value of the "content" field in the mutation.
+func (m *ObservationMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTags returns the old "tags" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldTags(ctx context.Context) (v []string, err error) {
+func (m *ObservationMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTags requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTags: %w", err)
-	}
-	return oldValue.Tags, nil
-}
-
-// AppendTags adds s to the "tags" field.
-func (m *LearningMutation) AppendTags(s []string) {
-	m.appendtags = append(m.appendtags, s...)
-}
-
-// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
-func (m *LearningMutation) AppendedTags() ([]string, bool) {
-	if len(m.appendtags) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return m.appendtags, true
-}
-
-// ClearTags clears the value of the "tags" field.
-func (m *LearningMutation) ClearTags() {
-	m.tags = nil
-	m.appendtags = nil
-	m.clearedFields[learning.FieldTags] = struct{}{}
-}
-
-// TagsCleared returns if the "tags" field was cleared in this mutation.
-func (m *LearningMutation) TagsCleared() bool {
-	_, ok := m.clearedFields[learning.FieldTags]
-	return ok
+	return oldValue.Content, nil
 }
 
-// ResetTags resets all changes to the "tags" field.
-func (m *LearningMutation) ResetTags() {
-	m.tags = nil
-	m.appendtags = nil
-	delete(m.clearedFields, learning.FieldTags)
+// ResetContent resets all changes to the "content" field.
+func (m *ObservationMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetOccurrenceCount sets the "occurrence_count" field.
-func (m *LearningMutation) SetOccurrenceCount(i int) {
-	m.occurrence_count = &i
-	m.addoccurrence_count = nil
+// SetTokenCount sets the "token_count" field.
+func (m *ObservationMutation) SetTokenCount(i int) {
+	m.token_count = &i
+	m.addtoken_count = nil
 }
 
-// OccurrenceCount returns the value of the "occurrence_count" field in the mutation.
-func (m *LearningMutation) OccurrenceCount() (r int, exists bool) {
-	v := m.occurrence_count
+// TokenCount returns the value of the "token_count" field in the mutation.
+func (m *ObservationMutation) TokenCount() (r int, exists bool) {
+	v := m.token_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOccurrenceCount returns the old "occurrence_count" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenCount returns the old "token_count" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldOccurrenceCount(ctx context.Context) (v int, err error) {
+func (m *ObservationMutation) OldTokenCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOccurrenceCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOccurrenceCount requires an ID field in the mutation")
+		return v, errors.New("OldTokenCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOccurrenceCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenCount: %w", err)
 	}
-	return oldValue.OccurrenceCount, nil
+	return oldValue.TokenCount, nil
 }
 
-// AddOccurrenceCount adds i to the "occurrence_count" field.
-func (m *LearningMutation) AddOccurrenceCount(i int) {
-	if m.addoccurrence_count != nil {
-		*m.addoccurrence_count += i
+// AddTokenCount adds i to the "token_count" field.
+func (m *ObservationMutation) AddTokenCount(i int) {
+	if m.addtoken_count != nil {
+		*m.addtoken_count += i
 	} else {
-		m.addoccurrence_count = &i
+		m.addtoken_count = &i
 	}
 }
 
-// AddedOccurrenceCount returns the value that was added to the "occurrence_count" field in this mutation.
-func (m *LearningMutation) AddedOccurrenceCount() (r int, exists bool) {
-	v := m.addoccurrence_count
+// AddedTokenCount returns the value that was added to the "token_count" field in this mutation.
+func (m *ObservationMutation) AddedTokenCount() (r int, exists bool) {
+	v := m.addtoken_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetOccurrenceCount resets all changes to the "occurrence_count" field.
-func (m *LearningMutation) ResetOccurrenceCount() {
-	m.occurrence_count = nil
-	m.addoccurrence_count = nil
+// ResetTokenCount resets all changes to the "token_count" field.
+func (m *ObservationMutation) ResetTokenCount() {
+	m.token_count = nil
+	m.addtoken_count = nil
 }
 
-// SetSuccessCount sets the "success_count" field.
-func (m *LearningMutation) SetSuccessCount(i int) {
-	m.success_count = &i
-	m.addsuccess_count = nil
+// SetSourceStartIndex sets the "source_start_index" field.
+func (m *ObservationMutation) SetSourceStartIndex(i int) {
+	m.source_start_index = &i
+	m.addsource_start_index = nil
 }
 
-// SuccessCount returns the value of the "success_count" field in the mutation.
-func (m *LearningMutation) SuccessCount() (r int, exists bool) {
-	v := m.success_count
+// SourceStartIndex returns the value of the "source_start_index" field in the mutation.
+func (m *ObservationMutation) SourceStartIndex() (r int, exists bool) {
+	v := m.source_start_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccessCount returns the old "success_count" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceStartIndex returns the old "source_start_index" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldSuccessCount(ctx context.Context) (v int, err error) {
+func (m *ObservationMutation) OldSourceStartIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccessCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceStartIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccessCount requires an ID field in the mutation")
+		return v, errors.New("OldSourceStartIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccessCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceStartIndex: %w", err)
 	}
-	return oldValue.SuccessCount, nil
+	return oldValue.SourceStartIndex, nil
 }
 
-// AddSuccessCount adds i to the "success_count" field.
-func (m *LearningMutation) AddSuccessCount(i int) {
-	if m.addsuccess_count != nil {
-		*m.addsuccess_count += i
+// AddSourceStartIndex adds i to the "source_start_index" field.
+func (m *ObservationMutation) AddSourceStartIndex(i int) {
+	if m.addsource_start_index != nil {
+		*m.addsource_start_index += i
 	} else {
-		m.addsuccess_count = &i
+		m.addsource_start_index = &i
 	}
 }
 
-// AddedSuccessCount returns the value that was added to the "success_count" field in this mutation.
-func (m *LearningMutation) AddedSuccessCount() (r int, exists bool) {
-	v := m.addsuccess_count
+// AddedSourceStartIndex returns the value that was added to the "source_start_index" field in this mutation.
+func (m *ObservationMutation) AddedSourceStartIndex() (r int, exists bool) {
+	v := m.addsource_start_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSuccessCount resets all changes to the "success_count" field.
-func (m *LearningMutation) ResetSuccessCount() {
-	m.success_count = nil
-	m.addsuccess_count = nil
+// ResetSourceStartIndex resets all changes to the "source_start_index" field.
+func (m *ObservationMutation) ResetSourceStartIndex() {
+	m.source_start_index = nil
+	m.addsource_start_index = nil
 }
 
-// SetConfidence sets the "confidence" field.
-func (m *LearningMutation) SetConfidence(f float64) {
-	m.confidence = &f
-	m.addconfidence = nil
+// SetSourceEndIndex sets the "source_end_index" field.
+func (m *ObservationMutation) SetSourceEndIndex(i int) {
+	m.source_end_index = &i
+	m.addsource_end_index = nil
 }
 
-// Confidence returns the value of the "confidence" field in the mutation.
-func (m *LearningMutation) Confidence() (r float64, exists bool) {
-	v := m.confidence
+// SourceEndIndex returns the value of the "source_end_index" field in the mutation.
+func (m *ObservationMutation) SourceEndIndex() (r int, exists bool) {
+	v := m.source_end_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfidence returns the old "confidence" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldSourceEndIndex returns the old "source_end_index" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldConfidence(ctx context.Context) (v float64, err error) {
+func (m *ObservationMutation) OldSourceEndIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfidence is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourceEndIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfidence requires an ID field in the mutation")
+		return v, errors.New("OldSourceEndIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfidence: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourceEndIndex: %w", err)
 	}
-	return oldValue.Confidence, nil
+	return oldValue.SourceEndIndex, nil
 }
 
-// AddConfidence adds f to the "confidence" field.
-func (m *LearningMutation) AddConfidence(f float64) {
-	if m.addconfidence != nil {
-		*m.addconfidence += f
+// AddSourceEndIndex adds i to the "source_end_index" field.
+func (m *ObservationMutation) AddSourceEndIndex(i int) {
+	if m.addsource_end_index != nil {
+		*m.addsource_end_index += i
 	} else {
-		m.addconfidence = &f
+		m.addsource_end_index = &i
 	}
 }
 
-// AddedConfidence returns the value that was added to the "confidence" field in this mutation.
-func (m *LearningMutation) AddedConfidence() (r float64, exists bool) {
-	v := m.addconfidence
+// AddedSourceEndIndex returns the value that was added to the "source_end_index" field in this mutation.
+func (m *ObservationMutation) AddedSourceEndIndex() (r int, exists bool) {
+	v := m.addsource_end_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetConfidence resets all changes to the "confidence" field.
-func (m *LearningMutation) ResetConfidence() {
-	m.confidence = nil
-	m.addconfidence = nil
+// ResetSourceEndIndex resets all changes to the "source_end_index" field.
+func (m *ObservationMutation) ResetSourceEndIndex() {
+	m.source_end_index = nil
+	m.addsource_end_index = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LearningMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPinned sets the "pinned" field.
+func (m *ObservationMutation) SetPinned(b bool) {
+	m.pinned = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LearningMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Pinned returns the value of the "pinned" field in the mutation.
+func (m *ObservationMutation) Pinned() (r bool, exists bool) {
+	v := m.pinned
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldPinned returns the old "pinned" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ObservationMutation) OldPinned(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPinned is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPinned requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPinned: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Pinned, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LearningMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPinned resets all changes to the "pinned" field.
+func (m *ObservationMutation) ResetPinned() {
+	m.pinned = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *LearningMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *ObservationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LearningMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ObservationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Learning entity.
-// If the Learning object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Observation entity.
+// If the Observation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LearningMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ObservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LearningMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ObservationMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the LearningMutation builder.
-func (m *LearningMutation) Where(ps ...predicate.Learning) {
+// Where appends a list predicates to the ObservationMutation builder.
+func (m *ObservationMutation) Where(ps ...predicate.Observation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LearningMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ObservationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LearningMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Learning, len(ps))
+func (m *ObservationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Observation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7118,57 +11459,45 @@ func (m *LearningMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LearningMutation) Op() Op {
+func (m *ObservationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LearningMutation) SetOp(op Op) {
+func (m *ObservationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Learning).
-func (m *LearningMutation) Type() string {
+// Type returns the node type of this mutation (Observation).
+func (m *ObservationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LearningMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.trigger != nil {
-		fields = append(fields, learning.FieldTrigger)
-	}
-	if m.error_pattern != nil {
-		fields = append(fields, learning.FieldErrorPattern)
-	}
-	if m.diagnosis != nil {
-		fields = append(fields, learning.FieldDiagnosis)
-	}
-	if m.fix != nil {
-		fields = append(fields, learning.FieldFix)
+func (m *ObservationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.session_key != nil {
+		fields = append(fields, observation.FieldSessionKey)
 	}
-	if m.category != nil {
-		fields = append(fields, learning.FieldCategory)
+	if m.content != nil {
+		fields = append(fields, observation.FieldContent)
 	}
-	if m.tags != nil {
-		fields = append(fields, learning.FieldTags)
+	if m.token_count != nil {
+		fields = append(fields, observation.FieldTokenCount)
 	}
-	if m.occurrence_count != nil {
-		fields = append(fields, learning.FieldOccurrenceCount)
+	if m.source_start_index != nil {
+		fields = append(fields, observation.FieldSourceStartIndex)
 	}
-	if m.success_count != nil {
-		fields = append(fields, learning.FieldSuccessCount)
+	if m.source_end_index != nil {
+		fields = append(fields, observation.FieldSourceEndIndex)
 	}
-	if m.confidence != nil {
-		fields = append(fields, learning.FieldConfidence)
+	if m.pinned != nil {
+		fields = append(fields, observation.FieldPinned)
 	}
 	if m.created_at != nil {
-		fields = append(fields, learning.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, learning.FieldUpdatedAt)
+		fields = append(fields, observation.FieldCreatedAt)
 	}
 	return fields
 }
@@ -7176,30 +11505,22 @@ func (m *LearningMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LearningMutation) Field(name string) (ent.Value, bool) {
+func (m *ObservationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case learning.FieldTrigger:
-		return m.Trigger()
-	case learning.FieldErrorPattern:
-		return m.ErrorPattern()
-	case learning.FieldDiagnosis:
-		return m.Diagnosis()
-	case learning.FieldFix:
-		return m.Fix()
-	case learning.FieldCategory:
-		return m.Category()
-	case learning.FieldTags:
-		return m.Tags()
-	case learning.FieldOccurrenceCount:
-		return m.OccurrenceCount()
-	case learning.FieldSuccessCount:
-		return m.SuccessCount()
-	case learning.FieldConfidence:
-		return m.Confidence()
-	case learning.FieldCreatedAt:
+	case observation.FieldSessionKey:
+		return m.SessionKey()
+	case observation.FieldContent:
+		return m.Content()
+	case observation.FieldTokenCount:
+		return m.TokenCount()
+	case observation.FieldSourceStartIndex:
+		return m.SourceStartIndex()
+	case observation.FieldSourceEndIndex:
+		return m.SourceEndIndex()
+	case observation.FieldPinned:
+		return m.Pinned()
+	case observation.FieldCreatedAt:
 		return m.CreatedAt()
-	case learning.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7207,704 +11528,933 @@ func (m *LearningMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LearningMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ObservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case learning.FieldTrigger:
-		return m.OldTrigger(ctx)
-	case learning.FieldErrorPattern:
-		return m.OldErrorPattern(ctx)
-	case learning.FieldDiagnosis:
-		return m.OldDiagnosis(ctx)
-	case learning.FieldFix:
-		return m.OldFix(ctx)
-	case learning.FieldCategory:
-		return m.OldCategory(ctx)
-	case learning.FieldTags:
-		return m.OldTags(ctx)
-	case learning.FieldOccurrenceCount:
-		return m.OldOccurrenceCount(ctx)
-	case learning.FieldSuccessCount:
-		return m.OldSuccessCount(ctx)
-	case learning.FieldConfidence:
-		return m.OldConfidence(ctx)
-	case learning.FieldCreatedAt:
+	case observation.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case observation.FieldContent:
+		return m.OldContent(ctx)
+	case observation.FieldTokenCount:
+		return m.OldTokenCount(ctx)
+	case observation.FieldSourceStartIndex:
+		return m.OldSourceStartIndex(ctx)
+	case observation.FieldSourceEndIndex:
+		return m.OldSourceEndIndex(ctx)
+	case observation.FieldPinned:
+		return m.OldPinned(ctx)
+	case observation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case learning.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Learning field %s", name)
+	return nil, fmt.Errorf("unknown Observation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LearningMutation) SetField(name string, value ent.Value) error {
+func (m *ObservationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case learning.FieldTrigger:
+	case observation.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrigger(v)
+		m.SetSessionKey(v)
 		return nil
-	case learning.FieldErrorPattern:
+	case observation.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorPattern(v)
+		m.SetContent(v)
 		return nil
-	case learning.FieldDiagnosis:
-		v, ok := value.(string)
+	case observation.FieldTokenCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiagnosis(v)
+		m.SetTokenCount(v)
 		return nil
-	case learning.FieldFix:
-		v, ok := value.(string)
+	case observation.FieldSourceStartIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFix(v)
+		m.SetSourceStartIndex(v)
 		return nil
-	case learning.FieldCategory:
-		v, ok := value.(learning.Category)
+	case observation.FieldSourceEndIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetSourceEndIndex(v)
 		return nil
-	case learning.FieldTags:
-		v, ok := value.([]string)
+	case observation.FieldPinned:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTags(v)
+		m.SetPinned(v)
 		return nil
-	case learning.FieldOccurrenceCount:
-		v, ok := value.(int)
+	case observation.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOccurrenceCount(v)
+		m.SetCreatedAt(v)
 		return nil
-	case learning.FieldSuccessCount:
+	}
+	return fmt.Errorf("unknown Observation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ObservationMutation) AddedFields() []string {
+	var fields []string
+	if m.addtoken_count != nil {
+		fields = append(fields, observation.FieldTokenCount)
+	}
+	if m.addsource_start_index != nil {
+		fields = append(fields, observation.FieldSourceStartIndex)
+	}
+	if m.addsource_end_index != nil {
+		fields = append(fields, observation.FieldSourceEndIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ObservationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case observation.FieldTokenCount:
+		return m.AddedTokenCount()
+	case observation.FieldSourceStartIndex:
+		return m.AddedSourceStartIndex()
+	case observation.FieldSourceEndIndex:
+		return m.AddedSourceEndIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ObservationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case observation.FieldTokenCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuccessCount(v)
+		m.AddTokenCount(v)
 		return nil
-	case learning.FieldConfidence:
-		v, ok := value.(float64)
+	case observation.FieldSourceStartIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfidence(v)
+		m.AddSourceStartIndex(v)
 		return nil
-	case learning.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case observation.FieldSourceEndIndex:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.AddSourceEndIndex(v)
 		return nil
-	case learning.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown Observation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ObservationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ObservationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ObservationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Observation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ObservationMutation) ResetField(name string) error {
+	switch name {
+	case observation.FieldSessionKey:
+		m.ResetSessionKey()
+		return nil
+	case observation.FieldContent:
+		m.ResetContent()
+		return nil
+	case observation.FieldTokenCount:
+		m.ResetTokenCount()
+		return nil
+	case observation.FieldSourceStartIndex:
+		m.ResetSourceStartIndex()
+		return nil
+	case observation.FieldSourceEndIndex:
+		m.ResetSourceEndIndex()
+		return nil
+	case observation.FieldPinned:
+		m.ResetPinned()
+		return nil
+	case observation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Observation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ObservationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ObservationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ObservationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ObservationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ObservationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ObservationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ObservationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Observation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ObservationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Observation edge %s", name)
+}
+
+// PaymentTxMutation represents an operation that mutates the PaymentTx nodes in the graph.
+type PaymentTxMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	tx_hash        *string
+	from_address   *string
+	to_address     *string
+	amount         *string
+	chain_id       *int64
+	addchain_id    *int64
+	status         *paymenttx.Status
+	session_key    *string
+	purpose        *string
+	x402_url       *string
+	payment_method *paymenttx.PaymentMethod
+	error_message  *string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*PaymentTx, error)
+	predicates     []predicate.PaymentTx
+}
+
+var _ ent.Mutation = (*PaymentTxMutation)(nil)
+
+// paymenttxOption allows management of the mutation configuration using functional options.
+type paymenttxOption func(*PaymentTxMutation)
+
+// newPaymentTxMutation creates new mutation for the PaymentTx entity.
+func newPaymentTxMutation(c config, op Op, opts ...paymenttxOption) *PaymentTxMutation {
+	m := &PaymentTxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaymentTx,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentTxID sets the ID field of the mutation.
+func withPaymentTxID(id uuid.UUID) paymenttxOption {
+	return func(m *PaymentTxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaymentTx
+		)
+		m.oldValue = func(ctx context.Context) (*PaymentTx, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaymentTx.Get(ctx, id)
+				}
+			})
+			return value, err
 		}
-		m.SetUpdatedAt(v)
-		return nil
+		m.id = &id
 	}
-	return fmt.Errorf("unknown Learning field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *LearningMutation) AddedFields() []string {
-	var fields []string
-	if m.addoccurrence_count != nil {
-		fields = append(fields, learning.FieldOccurrenceCount)
-	}
-	if m.addsuccess_count != nil {
-		fields = append(fields, learning.FieldSuccessCount)
+// withPaymentTx sets the old PaymentTx of the mutation.
+func withPaymentTx(node *PaymentTx) paymenttxOption {
+	return func(m *PaymentTxMutation) {
+		m.oldValue = func(context.Context) (*PaymentTx, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	if m.addconfidence != nil {
-		fields = append(fields, learning.FieldConfidence)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentTxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentTxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return fields
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *LearningMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case learning.FieldOccurrenceCount:
-		return m.AddedOccurrenceCount()
-	case learning.FieldSuccessCount:
-		return m.AddedSuccessCount()
-	case learning.FieldConfidence:
-		return m.AddedConfidence()
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PaymentTx entities.
+func (m *PaymentTxMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentTxMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return nil, false
+	return *m.id, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *LearningMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case learning.FieldOccurrenceCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOccurrenceCount(v)
-		return nil
-	case learning.FieldSuccessCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSuccessCount(v)
-		return nil
-	case learning.FieldConfidence:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaymentTxMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
 		}
-		m.AddConfidence(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaymentTx.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown Learning numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *LearningMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(learning.FieldErrorPattern) {
-		fields = append(fields, learning.FieldErrorPattern)
+// SetTxHash sets the "tx_hash" field.
+func (m *PaymentTxMutation) SetTxHash(s string) {
+	m.tx_hash = &s
+}
+
+// TxHash returns the value of the "tx_hash" field in the mutation.
+func (m *PaymentTxMutation) TxHash() (r string, exists bool) {
+	v := m.tx_hash
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(learning.FieldDiagnosis) {
-		fields = append(fields, learning.FieldDiagnosis)
+	return *v, true
+}
+
+// OldTxHash returns the old "tx_hash" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldTxHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(learning.FieldFix) {
-		fields = append(fields, learning.FieldFix)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTxHash requires an ID field in the mutation")
 	}
-	if m.FieldCleared(learning.FieldTags) {
-		fields = append(fields, learning.FieldTags)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
 	}
-	return fields
+	return oldValue.TxHash, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *LearningMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearTxHash clears the value of the "tx_hash" field.
+func (m *PaymentTxMutation) ClearTxHash() {
+	m.tx_hash = nil
+	m.clearedFields[paymenttx.FieldTxHash] = struct{}{}
+}
+
+// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
+func (m *PaymentTxMutation) TxHashCleared() bool {
+	_, ok := m.clearedFields[paymenttx.FieldTxHash]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *LearningMutation) ClearField(name string) error {
-	switch name {
-	case learning.FieldErrorPattern:
-		m.ClearErrorPattern()
-		return nil
-	case learning.FieldDiagnosis:
-		m.ClearDiagnosis()
-		return nil
-	case learning.FieldFix:
-		m.ClearFix()
-		return nil
-	case learning.FieldTags:
-		m.ClearTags()
-		return nil
+// ResetTxHash resets all changes to the "tx_hash" field.
+func (m *PaymentTxMutation) ResetTxHash() {
+	m.tx_hash = nil
+	delete(m.clearedFields, paymenttx.FieldTxHash)
+}
+
+// SetFromAddress sets the "from_address" field.
+func (m *PaymentTxMutation) SetFromAddress(s string) {
+	m.from_address = &s
+}
+
+// FromAddress returns the value of the "from_address" field in the mutation.
+func (m *PaymentTxMutation) FromAddress() (r string, exists bool) {
+	v := m.from_address
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Learning nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *LearningMutation) ResetField(name string) error {
-	switch name {
-	case learning.FieldTrigger:
-		m.ResetTrigger()
-		return nil
-	case learning.FieldErrorPattern:
-		m.ResetErrorPattern()
-		return nil
-	case learning.FieldDiagnosis:
-		m.ResetDiagnosis()
-		return nil
-	case learning.FieldFix:
-		m.ResetFix()
-		return nil
-	case learning.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case learning.FieldTags:
-		m.ResetTags()
-		return nil
-	case learning.FieldOccurrenceCount:
-		m.ResetOccurrenceCount()
-		return nil
-	case learning.FieldSuccessCount:
-		m.ResetSuccessCount()
-		return nil
-	case learning.FieldConfidence:
-		m.ResetConfidence()
-		return nil
-	case learning.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case learning.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldFromAddress returns the old "from_address" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldFromAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromAddress is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Learning field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromAddress: %w", err)
+	}
+	return oldValue.FromAddress, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LearningMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetFromAddress resets all changes to the "from_address" field.
+func (m *PaymentTxMutation) ResetFromAddress() {
+	m.from_address = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *LearningMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SetToAddress sets the "to_address" field.
+func (m *PaymentTxMutation) SetToAddress(s string) {
+	m.to_address = &s
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LearningMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ToAddress returns the value of the "to_address" field in the mutation.
+func (m *PaymentTxMutation) ToAddress() (r string, exists bool) {
+	v := m.to_address
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *LearningMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldToAddress returns the old "to_address" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldToAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToAddress is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToAddress: %w", err)
+	}
+	return oldValue.ToAddress, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LearningMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetToAddress resets all changes to the "to_address" field.
+func (m *PaymentTxMutation) ResetToAddress() {
+	m.to_address = nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *LearningMutation) EdgeCleared(name string) bool {
-	return false
+// SetAmount sets the "amount" field.
+func (m *PaymentTxMutation) SetAmount(s string) {
+	m.amount = &s
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *LearningMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Learning unique edge %s", name)
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PaymentTxMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *LearningMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Learning edge %s", name)
+// OldAmount returns the old "amount" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	role             *string
-	content          *string
-	timestamp        *time.Time
-	tool_calls       *[]schema.ToolCall
-	appendtool_calls []schema.ToolCall
-	author           *string
-	clearedFields    map[string]struct{}
-	session          *int
-	clearedsession   bool
-	done             bool
-	oldValue         func(context.Context) (*Message, error)
-	predicates       []predicate.Message
+// ResetAmount resets all changes to the "amount" field.
+func (m *PaymentTxMutation) ResetAmount() {
+	m.amount = nil
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+// SetChainID sets the "chain_id" field.
+func (m *PaymentTxMutation) SetChainID(i int64) {
+	m.chain_id = &i
+	m.addchain_id = nil
+}
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// ChainID returns the value of the "chain_id" field in the mutation.
+func (m *PaymentTxMutation) ChainID() (r int64, exists bool) {
+	v := m.chain_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMessage,
-		clearedFields: make(map[string]struct{}),
+// OldChainID returns the old "chain_id" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldChainID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChainID is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChainID requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChainID: %w", err)
+	}
+	return oldValue.ChainID, nil
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id int) messageOption {
-	return func(m *MessageMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Message
-		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Message.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddChainID adds i to the "chain_id" field.
+func (m *PaymentTxMutation) AddChainID(i int64) {
+	if m.addchain_id != nil {
+		*m.addchain_id += i
+	} else {
+		m.addchain_id = &i
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedChainID returns the value that was added to the "chain_id" field in this mutation.
+func (m *PaymentTxMutation) AddedChainID() (r int64, exists bool) {
+	v := m.addchain_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetChainID resets all changes to the "chain_id" field.
+func (m *PaymentTxMutation) ResetChainID() {
+	m.chain_id = nil
+	m.addchain_id = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetStatus sets the "status" field.
+func (m *PaymentTxMutation) SetStatus(pa paymenttx.Status) {
+	m.status = &pa
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// Status returns the value of the "status" field in the mutation.
+func (m *PaymentTxMutation) Status() (r paymenttx.Status, exists bool) {
+	v := m.status
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldStatus returns the old "status" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldStatus(ctx context.Context) (v paymenttx.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// SetRole sets the "role" field.
-func (m *MessageMutation) SetRole(s string) {
-	m.role = &s
+// ResetStatus resets all changes to the "status" field.
+func (m *PaymentTxMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetSessionKey sets the "session_key" field.
+func (m *PaymentTxMutation) SetSessionKey(s string) {
+	m.session_key = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *MessageMutation) Role() (r string, exists bool) {
-	v := m.role
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *PaymentTxMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *PaymentTxMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.SessionKey, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *MessageMutation) ResetRole() {
-	m.role = nil
+// ClearSessionKey clears the value of the "session_key" field.
+func (m *PaymentTxMutation) ClearSessionKey() {
+	m.session_key = nil
+	m.clearedFields[paymenttx.FieldSessionKey] = struct{}{}
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
+func (m *PaymentTxMutation) SessionKeyCleared() bool {
+	_, ok := m.clearedFields[paymenttx.FieldSessionKey]
+	return ok
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *PaymentTxMutation) ResetSessionKey() {
+	m.session_key = nil
+	delete(m.clearedFields, paymenttx.FieldSessionKey)
+}
+
+// SetPurpose sets the "purpose" field.
+func (m *PaymentTxMutation) SetPurpose(s string) {
+	m.purpose = &s
+}
+
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *PaymentTxMutation) Purpose() (r string, exists bool) {
+	v := m.purpose
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldPurpose returns the old "purpose" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *PaymentTxMutation) OldPurpose(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Purpose, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// ClearPurpose clears the value of the "purpose" field.
+func (m *PaymentTxMutation) ClearPurpose() {
+	m.purpose = nil
+	m.clearedFields[paymenttx.FieldPurpose] = struct{}{}
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *MessageMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// PurposeCleared returns if the "purpose" field was cleared in this mutation.
+func (m *PaymentTxMutation) PurposeCleared() bool {
+	_, ok := m.clearedFields[paymenttx.FieldPurpose]
+	return ok
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *MessageMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *PaymentTxMutation) ResetPurpose() {
+	m.purpose = nil
+	delete(m.clearedFields, paymenttx.FieldPurpose)
+}
+
+// SetX402URL sets the "x402_url" field.
+func (m *PaymentTxMutation) SetX402URL(s string) {
+	m.x402_url = &s
+}
+
+// X402URL returns the value of the "x402_url" field in the mutation.
+func (m *PaymentTxMutation) X402URL() (r string, exists bool) {
+	v := m.x402_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldX402URL returns the old "x402_url" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *PaymentTxMutation) OldX402URL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldX402URL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldX402URL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldX402URL: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.X402URL, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *MessageMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ClearX402URL clears the value of the "x402_url" field.
+func (m *PaymentTxMutation) ClearX402URL() {
+	m.x402_url = nil
+	m.clearedFields[paymenttx.FieldX402URL] = struct{}{}
 }
 
-// SetToolCalls sets the "tool_calls" field.
-func (m *MessageMutation) SetToolCalls(sc []schema.ToolCall) {
-	m.tool_calls = &sc
-	m.appendtool_calls = nil
+// X402URLCleared returns if the "x402_url" field was cleared in this mutation.
+func (m *PaymentTxMutation) X402URLCleared() bool {
+	_, ok := m.clearedFields[paymenttx.FieldX402URL]
+	return ok
 }
 
-// ToolCalls returns the value of the "tool_calls" field in the mutation.
-func (m *MessageMutation) ToolCalls() (r []schema.ToolCall, exists bool) {
-	v := m.tool_calls
+// ResetX402URL resets all changes to the "x402_url" field.
+func (m *PaymentTxMutation) ResetX402URL() {
+	m.x402_url = nil
+	delete(m.clearedFields, paymenttx.FieldX402URL)
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (m *PaymentTxMutation) SetPaymentMethod(pm paymenttx.PaymentMethod) {
+	m.payment_method = &pm
+}
+
+// PaymentMethod returns the value of the "payment_method" field in the mutation.
+func (m *PaymentTxMutation) PaymentMethod() (r paymenttx.PaymentMethod, exists bool) {
+	v := m.payment_method
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToolCalls returns the old "tool_calls" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldPaymentMethod returns the old "payment_method" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldToolCalls(ctx context.Context) (v []schema.ToolCall, err error) {
+func (m *PaymentTxMutation) OldPaymentMethod(ctx context.Context) (v paymenttx.PaymentMethod, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToolCalls is only allowed on UpdateOne operations")
+		return v, errors.New("OldPaymentMethod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToolCalls requires an ID field in the mutation")
+		return v, errors.New("OldPaymentMethod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToolCalls: %w", err)
-	}
-	return oldValue.ToolCalls, nil
-}
-
-// AppendToolCalls adds sc to the "tool_calls" field.
-func (m *MessageMutation) AppendToolCalls(sc []schema.ToolCall) {
-	m.appendtool_calls = append(m.appendtool_calls, sc...)
-}
-
-// AppendedToolCalls returns the list of values that were appended to the "tool_calls" field in this mutation.
-func (m *MessageMutation) AppendedToolCalls() ([]schema.ToolCall, bool) {
-	if len(m.appendtool_calls) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldPaymentMethod: %w", err)
 	}
-	return m.appendtool_calls, true
-}
-
-// ClearToolCalls clears the value of the "tool_calls" field.
-func (m *MessageMutation) ClearToolCalls() {
-	m.tool_calls = nil
-	m.appendtool_calls = nil
-	m.clearedFields[message.FieldToolCalls] = struct{}{}
-}
-
-// ToolCallsCleared returns if the "tool_calls" field was cleared in this mutation.
-func (m *MessageMutation) ToolCallsCleared() bool {
-	_, ok := m.clearedFields[message.FieldToolCalls]
-	return ok
+	return oldValue.PaymentMethod, nil
 }
 
-// ResetToolCalls resets all changes to the "tool_calls" field.
-func (m *MessageMutation) ResetToolCalls() {
-	m.tool_calls = nil
-	m.appendtool_calls = nil
-	delete(m.clearedFields, message.FieldToolCalls)
+// ResetPaymentMethod resets all changes to the "payment_method" field.
+func (m *PaymentTxMutation) ResetPaymentMethod() {
+	m.payment_method = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *MessageMutation) SetAuthor(s string) {
-	m.author = &s
+// SetErrorMessage sets the "error_message" field.
+func (m *PaymentTxMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *MessageMutation) Author() (r string, exists bool) {
-	v := m.author
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *PaymentTxMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *PaymentTxMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.Author, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearAuthor clears the value of the "author" field.
-func (m *MessageMutation) ClearAuthor() {
-	m.author = nil
-	m.clearedFields[message.FieldAuthor] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *PaymentTxMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[paymenttx.FieldErrorMessage] = struct{}{}
 }
 
-// AuthorCleared returns if the "author" field was cleared in this mutation.
-func (m *MessageMutation) AuthorCleared() bool {
-	_, ok := m.clearedFields[message.FieldAuthor]
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *PaymentTxMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[paymenttx.FieldErrorMessage]
 	return ok
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *MessageMutation) ResetAuthor() {
-	m.author = nil
-	delete(m.clearedFields, message.FieldAuthor)
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *PaymentTxMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, paymenttx.FieldErrorMessage)
 }
 
-// SetSessionID sets the "session" edge to the Session entity by id.
-func (m *MessageMutation) SetSessionID(id int) {
-	m.session = &id
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentTxMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentTxMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSession clears the "session" edge to the Session entity.
-func (m *MessageMutation) ClearSession() {
-	m.clearedsession = true
+// OldCreatedAt returns the old "created_at" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// SessionCleared reports if the "session" edge to the Session entity was cleared.
-func (m *MessageMutation) SessionCleared() bool {
-	return m.clearedsession
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentTxMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SessionID returns the "session" edge ID in the mutation.
-func (m *MessageMutation) SessionID() (id int, exists bool) {
-	if m.session != nil {
-		return *m.session, true
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaymentTxMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaymentTxMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SessionIDs returns the "session" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SessionID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) SessionIDs() (ids []int) {
-	if id := m.session; id != nil {
-		ids = append(ids, *id)
+// OldUpdatedAt returns the old "updated_at" field's value of the PaymentTx entity.
+// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentTxMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetSession resets all changes to the "session" edge.
-func (m *MessageMutation) ResetSession() {
-	m.session = nil
-	m.clearedsession = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaymentTxMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the PaymentTxMutation builder.
+func (m *PaymentTxMutation) Where(ps ...predicate.PaymentTx) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PaymentTxMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *PaymentTxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaymentTx, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7912,39 +12462,63 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *PaymentTxMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *PaymentTxMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (PaymentTx).
+func (m *PaymentTxMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.role != nil {
-		fields = append(fields, message.FieldRole)
+func (m *PaymentTxMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.tx_hash != nil {
+		fields = append(fields, paymenttx.FieldTxHash)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.from_address != nil {
+		fields = append(fields, paymenttx.FieldFromAddress)
 	}
-	if m.timestamp != nil {
-		fields = append(fields, message.FieldTimestamp)
+	if m.to_address != nil {
+		fields = append(fields, paymenttx.FieldToAddress)
 	}
-	if m.tool_calls != nil {
-		fields = append(fields, message.FieldToolCalls)
+	if m.amount != nil {
+		fields = append(fields, paymenttx.FieldAmount)
 	}
-	if m.author != nil {
-		fields = append(fields, message.FieldAuthor)
+	if m.chain_id != nil {
+		fields = append(fields, paymenttx.FieldChainID)
+	}
+	if m.status != nil {
+		fields = append(fields, paymenttx.FieldStatus)
+	}
+	if m.session_key != nil {
+		fields = append(fields, paymenttx.FieldSessionKey)
+	}
+	if m.purpose != nil {
+		fields = append(fields, paymenttx.FieldPurpose)
+	}
+	if m.x402_url != nil {
+		fields = append(fields, paymenttx.FieldX402URL)
+	}
+	if m.payment_method != nil {
+		fields = append(fields, paymenttx.FieldPaymentMethod)
+	}
+	if m.error_message != nil {
+		fields = append(fields, paymenttx.FieldErrorMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, paymenttx.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, paymenttx.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7952,18 +12526,34 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *PaymentTxMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldRole:
-		return m.Role()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldTimestamp:
-		return m.Timestamp()
-	case message.FieldToolCalls:
-		return m.ToolCalls()
-	case message.FieldAuthor:
-		return m.Author()
+	case paymenttx.FieldTxHash:
+		return m.TxHash()
+	case paymenttx.FieldFromAddress:
+		return m.FromAddress()
+	case paymenttx.FieldToAddress:
+		return m.ToAddress()
+	case paymenttx.FieldAmount:
+		return m.Amount()
+	case paymenttx.FieldChainID:
+		return m.ChainID()
+	case paymenttx.FieldStatus:
+		return m.Status()
+	case paymenttx.FieldSessionKey:
+		return m.SessionKey()
+	case paymenttx.FieldPurpose:
+		return m.Purpose()
+	case paymenttx.FieldX402URL:
+		return m.X402URL()
+	case paymenttx.FieldPaymentMethod:
+		return m.PaymentMethod()
+	case paymenttx.FieldErrorMessage:
+		return m.ErrorMessage()
+	case paymenttx.FieldCreatedAt:
+		return m.CreatedAt()
+	case paymenttx.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7971,251 +12561,358 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PaymentTxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldRole:
-		return m.OldRole(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case message.FieldToolCalls:
-		return m.OldToolCalls(ctx)
-	case message.FieldAuthor:
-		return m.OldAuthor(ctx)
+	case paymenttx.FieldTxHash:
+		return m.OldTxHash(ctx)
+	case paymenttx.FieldFromAddress:
+		return m.OldFromAddress(ctx)
+	case paymenttx.FieldToAddress:
+		return m.OldToAddress(ctx)
+	case paymenttx.FieldAmount:
+		return m.OldAmount(ctx)
+	case paymenttx.FieldChainID:
+		return m.OldChainID(ctx)
+	case paymenttx.FieldStatus:
+		return m.OldStatus(ctx)
+	case paymenttx.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case paymenttx.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case paymenttx.FieldX402URL:
+		return m.OldX402URL(ctx)
+	case paymenttx.FieldPaymentMethod:
+		return m.OldPaymentMethod(ctx)
+	case paymenttx.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case paymenttx.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case paymenttx.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown PaymentTx field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *PaymentTxMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldRole:
+	case paymenttx.FieldTxHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTxHash(v)
+		return nil
+	case paymenttx.FieldFromAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromAddress(v)
+		return nil
+	case paymenttx.FieldToAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToAddress(v)
+		return nil
+	case paymenttx.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case paymenttx.FieldChainID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChainID(v)
+		return nil
+	case paymenttx.FieldStatus:
+		v, ok := value.(paymenttx.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case paymenttx.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetSessionKey(v)
 		return nil
-	case message.FieldContent:
+	case paymenttx.FieldPurpose:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetPurpose(v)
 		return nil
-	case message.FieldTimestamp:
-		v, ok := value.(time.Time)
+	case paymenttx.FieldX402URL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimestamp(v)
+		m.SetX402URL(v)
 		return nil
-	case message.FieldToolCalls:
-		v, ok := value.([]schema.ToolCall)
+	case paymenttx.FieldPaymentMethod:
+		v, ok := value.(paymenttx.PaymentMethod)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToolCalls(v)
+		m.SetPaymentMethod(v)
 		return nil
-	case message.FieldAuthor:
+	case paymenttx.FieldErrorMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthor(v)
+		m.SetErrorMessage(v)
+		return nil
+	case paymenttx.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case paymenttx.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown PaymentTx field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *PaymentTxMutation) AddedFields() []string {
+	var fields []string
+	if m.addchain_id != nil {
+		fields = append(fields, paymenttx.FieldChainID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PaymentTxMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case paymenttx.FieldChainID:
+		return m.AddedChainID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *PaymentTxMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case paymenttx.FieldChainID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChainID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown PaymentTx numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *PaymentTxMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldToolCalls) {
-		fields = append(fields, message.FieldToolCalls)
+	if m.FieldCleared(paymenttx.FieldTxHash) {
+		fields = append(fields, paymenttx.FieldTxHash)
 	}
-	if m.FieldCleared(message.FieldAuthor) {
-		fields = append(fields, message.FieldAuthor)
+	if m.FieldCleared(paymenttx.FieldSessionKey) {
+		fields = append(fields, paymenttx.FieldSessionKey)
+	}
+	if m.FieldCleared(paymenttx.FieldPurpose) {
+		fields = append(fields, paymenttx.FieldPurpose)
+	}
+	if m.FieldCleared(paymenttx.FieldX402URL) {
+		fields = append(fields, paymenttx.FieldX402URL)
+	}
+	if m.FieldCleared(paymenttx.FieldErrorMessage) {
+		fields = append(fields, paymenttx.FieldErrorMessage)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *PaymentTxMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *PaymentTxMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldToolCalls:
-		m.ClearToolCalls()
+	case paymenttx.FieldTxHash:
+		m.ClearTxHash()
 		return nil
-	case message.FieldAuthor:
-		m.ClearAuthor()
+	case paymenttx.FieldSessionKey:
+		m.ClearSessionKey()
+		return nil
+	case paymenttx.FieldPurpose:
+		m.ClearPurpose()
+		return nil
+	case paymenttx.FieldX402URL:
+		m.ClearX402URL()
+		return nil
+	case paymenttx.FieldErrorMessage:
+		m.ClearErrorMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown PaymentTx nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *PaymentTxMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldRole:
-		m.ResetRole()
+	case paymenttx.FieldTxHash:
+		m.ResetTxHash()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case paymenttx.FieldFromAddress:
+		m.ResetFromAddress()
 		return nil
-	case message.FieldTimestamp:
-		m.ResetTimestamp()
+	case paymenttx.FieldToAddress:
+		m.ResetToAddress()
 		return nil
-	case message.FieldToolCalls:
-		m.ResetToolCalls()
+	case paymenttx.FieldAmount:
+		m.ResetAmount()
 		return nil
-	case message.FieldAuthor:
-		m.ResetAuthor()
+	case paymenttx.FieldChainID:
+		m.ResetChainID()
+		return nil
+	case paymenttx.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case paymenttx.FieldSessionKey:
+		m.ResetSessionKey()
+		return nil
+	case paymenttx.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case paymenttx.FieldX402URL:
+		m.ResetX402URL()
+		return nil
+	case paymenttx.FieldPaymentMethod:
+		m.ResetPaymentMethod()
+		return nil
+	case paymenttx.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case paymenttx.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case paymenttx.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown PaymentTx field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.session != nil {
-		edges = append(edges, message.EdgeSession)
-	}
+func (m *PaymentTxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeSession:
-		if id := m.session; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *PaymentTxMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PaymentTxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *PaymentTxMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedsession {
-		edges = append(edges, message.EdgeSession)
-	}
+func (m *PaymentTxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case message.EdgeSession:
-		return m.clearedsession
-	}
+func (m *PaymentTxMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	switch name {
-	case message.EdgeSession:
-		m.ClearSession()
-		return nil
-	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *PaymentTxMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PaymentTx unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	switch name {
-	case message.EdgeSession:
-		m.ResetSession()
-		return nil
-	}
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *PaymentTxMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PaymentTx edge %s", name)
 }
 
-// ObservationMutation represents an operation that mutates the Observation nodes in the graph.
-type ObservationMutation struct {
+// PeerReputationMutation represents an operation that mutates the PeerReputation nodes in the graph.
+type PeerReputationMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	session_key           *string
-	content               *string
-	token_count           *int
-	addtoken_count        *int
-	source_start_index    *int
-	addsource_start_index *int
-	source_end_index      *int
-	addsource_end_index   *int
-	created_at            *time.Time
-	clearedFields         map[string]struct{}
-	done                  bool
-	oldValue              func(context.Context) (*Observation, error)
-	predicates            []predicate.Observation
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	peer_did                *string
+	successful_exchanges    *int
+	addsuccessful_exchanges *int
+	failed_exchanges        *int
+	addfailed_exchanges     *int
+	timeout_count           *int
+	addtimeout_count        *int
+	trust_score             *float64
+	addtrust_score          *float64
+	first_seen              *time.Time
+	last_interaction        *time.Time
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	done                    bool
+	oldValue                func(context.Context) (*PeerReputation, error)
+	predicates              []predicate.PeerReputation
 }
 
-var _ ent.Mutation = (*ObservationMutation)(nil)
+var _ ent.Mutation = (*PeerReputationMutation)(nil)
 
-// observationOption allows management of the mutation configuration using functional options.
-type observationOption func(*ObservationMutation)
+// peerreputationOption allows management of the mutation configuration using functional options.
+type peerreputationOption func(*PeerReputationMutation)
 
-// newObservationMutation creates new mutation for the Observation entity.
-func newObservationMutation(c config, op Op, opts ...observationOption) *ObservationMutation {
-	m := &ObservationMutation{
+// newPeerReputationMutation creates new mutation for the PeerReputation entity.
+func newPeerReputationMutation(c config, op Op, opts ...peerreputationOption) *PeerReputationMutation {
+	m := &PeerReputationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeObservation,
+		typ:           TypePeerReputation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8224,20 +12921,20 @@ func newObservationMutation(c config, op Op, opts ...observationOption) *Observa
 	return m
 }
 
-// withObservationID sets the ID field of the mutation.
-func withObservationID(id uuid.UUID) observationOption {
-	return func(m *ObservationMutation) {
+// withPeerReputationID sets the ID field of the mutation.
+func withPeerReputationID(id uuid.UUID) peerreputationOption {
+	return func(m *PeerReputationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Observation
+			value *PeerReputation
 		)
-		m.oldValue = func(ctx context.Context) (*Observation, error) {
+		m.oldValue = func(ctx context.Context) (*PeerReputation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Observation.Get(ctx, id)
+					value, err = m.Client().PeerReputation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8246,10 +12943,10 @@ func withObservationID(id uuid.UUID) observationOption {
 	}
 }
 
-// withObservation sets the old Observation of the mutation.
-func withObservation(node *Observation) observationOption {
-	return func(m *ObservationMutation) {
-		m.oldValue = func(context.Context) (*Observation, error) {
+// withPeerReputation sets the old PeerReputation of the mutation.
+func withPeerReputation(node *PeerReputation) peerreputationOption {
+	return func(m *PeerReputationMutation) {
+		m.oldValue = func(context.Context) (*PeerReputation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8258,7 +12955,7 @@ func withObservation(node *Observation) observationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ObservationMutation) Client() *Client {
+func (m PeerReputationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8266,7 +12963,7 @@ func (m ObservationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ObservationMutation) Tx() (*Tx, error) {
+func (m PeerReputationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8276,14 +12973,14 @@ func (m ObservationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Observation entities.
-func (m *ObservationMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of PeerReputation entities.
+func (m *PeerReputationMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ObservationMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PeerReputationMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8294,7 +12991,7 @@ func (m *ObservationMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ObservationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PeerReputationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8303,259 +13000,351 @@ func (m *ObservationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Observation.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PeerReputation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSessionKey sets the "session_key" field.
-func (m *ObservationMutation) SetSessionKey(s string) {
-	m.session_key = &s
+// SetPeerDid sets the "peer_did" field.
+func (m *PeerReputationMutation) SetPeerDid(s string) {
+	m.peer_did = &s
 }
 
-// SessionKey returns the value of the "session_key" field in the mutation.
-func (m *ObservationMutation) SessionKey() (r string, exists bool) {
-	v := m.session_key
+// PeerDid returns the value of the "peer_did" field in the mutation.
+func (m *PeerReputationMutation) PeerDid() (r string, exists bool) {
+	v := m.peer_did
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionKey returns the old "session_key" field's value of the Observation entity.
-// If the Observation object wasn't provided to the builder, the object is fetched from the database.
+// OldPeerDid returns the old "peer_did" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObservationMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+func (m *PeerReputationMutation) OldPeerDid(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeerDid is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionKey requires an ID field in the mutation")
+		return v, errors.New("OldPeerDid requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeerDid: %w", err)
 	}
-	return oldValue.SessionKey, nil
+	return oldValue.PeerDid, nil
 }
 
-// ResetSessionKey resets all changes to the "session_key" field.
-func (m *ObservationMutation) ResetSessionKey() {
-	m.session_key = nil
+// ResetPeerDid resets all changes to the "peer_did" field.
+func (m *PeerReputationMutation) ResetPeerDid() {
+	m.peer_did = nil
 }
 
-// SetContent sets the "content" field.
-func (m *ObservationMutation) SetContent(s string) {
-	m.content = &s
+// SetSuccessfulExchanges sets the "successful_exchanges" field.
+func (m *PeerReputationMutation) SetSuccessfulExchanges(i int) {
+	m.successful_exchanges = &i
+	m.addsuccessful_exchanges = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ObservationMutation) Content() (r string, exists bool) {
-	v := m.content
+// SuccessfulExchanges returns the value of the "successful_exchanges" field in the mutation.
+func (m *PeerReputationMutation) SuccessfulExchanges() (r int, exists bool) {
+	v := m.successful_exchanges
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Observation entity.
-// If the Observation object wasn't provided to the builder, the object is fetched from the database.
+// OldSuccessfulExchanges returns the old "successful_exchanges" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObservationMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *PeerReputationMutation) OldSuccessfulExchanges(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuccessfulExchanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldSuccessfulExchanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuccessfulExchanges: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.SuccessfulExchanges, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ObservationMutation) ResetContent() {
-	m.content = nil
+// AddSuccessfulExchanges adds i to the "successful_exchanges" field.
+func (m *PeerReputationMutation) AddSuccessfulExchanges(i int) {
+	if m.addsuccessful_exchanges != nil {
+		*m.addsuccessful_exchanges += i
+	} else {
+		m.addsuccessful_exchanges = &i
+	}
 }
 
-// SetTokenCount sets the "token_count" field.
-func (m *ObservationMutation) SetTokenCount(i int) {
-	m.token_count = &i
-	m.addtoken_count = nil
+// AddedSuccessfulExchanges returns the value that was added to the "successful_exchanges" field in this mutation.
+func (m *PeerReputationMutation) AddedSuccessfulExchanges() (r int, exists bool) {
+	v := m.addsuccessful_exchanges
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TokenCount returns the value of the "token_count" field in the mutation.
-func (m *ObservationMutation) TokenCount() (r int, exists bool) {
-	v := m.token_count
+// ResetSuccessfulExchanges resets all changes to the "successful_exchanges" field.
+func (m *PeerReputationMutation) ResetSuccessfulExchanges() {
+	m.successful_exchanges = nil
+	m.addsuccessful_exchanges = nil
+}
+
+// SetFailedExchanges sets the "failed_exchanges" field.
+func (m *PeerReputationMutation) SetFailedExchanges(i int) {
+	m.failed_exchanges = &i
+	m.addfailed_exchanges = nil
+}
+
+// FailedExchanges returns the value of the "failed_exchanges" field in the mutation.
+func (m *PeerReputationMutation) FailedExchanges() (r int, exists bool) {
+	v := m.failed_exchanges
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenCount returns the old "token_count" field's value of the Observation entity.
-// If the Observation object wasn't provided to the builder, the object is fetched from the database.
+// OldFailedExchanges returns the old "failed_exchanges" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObservationMutation) OldTokenCount(ctx context.Context) (v int, err error) {
+func (m *PeerReputationMutation) OldFailedExchanges(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldFailedExchanges is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenCount requires an ID field in the mutation")
+		return v, errors.New("OldFailedExchanges requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldFailedExchanges: %w", err)
 	}
-	return oldValue.TokenCount, nil
+	return oldValue.FailedExchanges, nil
 }
 
-// AddTokenCount adds i to the "token_count" field.
-func (m *ObservationMutation) AddTokenCount(i int) {
-	if m.addtoken_count != nil {
-		*m.addtoken_count += i
+// AddFailedExchanges adds i to the "failed_exchanges" field.
+func (m *PeerReputationMutation) AddFailedExchanges(i int) {
+	if m.addfailed_exchanges != nil {
+		*m.addfailed_exchanges += i
 	} else {
-		m.addtoken_count = &i
+		m.addfailed_exchanges = &i
 	}
 }
 
-// AddedTokenCount returns the value that was added to the "token_count" field in this mutation.
-func (m *ObservationMutation) AddedTokenCount() (r int, exists bool) {
-	v := m.addtoken_count
+// AddedFailedExchanges returns the value that was added to the "failed_exchanges" field in this mutation.
+func (m *PeerReputationMutation) AddedFailedExchanges() (r int, exists bool) {
+	v := m.addfailed_exchanges
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTokenCount resets all changes to the "token_count" field.
-func (m *ObservationMutation) ResetTokenCount() {
-	m.token_count = nil
-	m.addtoken_count = nil
+// ResetFailedExchanges resets all changes to the "failed_exchanges" field.
+func (m *PeerReputationMutation) ResetFailedExchanges() {
+	m.failed_exchanges = nil
+	m.addfailed_exchanges = nil
 }
 
-// SetSourceStartIndex sets the "source_start_index" field.
-func (m *ObservationMutation) SetSourceStartIndex(i int) {
-	m.source_start_index = &i
-	m.addsource_start_index = nil
+// SetTimeoutCount sets the "timeout_count" field.
+func (m *PeerReputationMutation) SetTimeoutCount(i int) {
+	m.timeout_count = &i
+	m.addtimeout_count = nil
 }
 
-// SourceStartIndex returns the value of the "source_start_index" field in the mutation.
-func (m *ObservationMutation) SourceStartIndex() (r int, exists bool) {
-	v := m.source_start_index
+// TimeoutCount returns the value of the "timeout_count" field in the mutation.
+func (m *PeerReputationMutation) TimeoutCount() (r int, exists bool) {
+	v := m.timeout_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceStartIndex returns the old "source_start_index" field's value of the Observation entity.
-// If the Observation object wasn't provided to the builder, the object is fetched from the database.
+// OldTimeoutCount returns the old "timeout_count" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObservationMutation) OldSourceStartIndex(ctx context.Context) (v int, err error) {
+func (m *PeerReputationMutation) OldTimeoutCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceStartIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimeoutCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceStartIndex requires an ID field in the mutation")
+		return v, errors.New("OldTimeoutCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceStartIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimeoutCount: %w", err)
+	}
+	return oldValue.TimeoutCount, nil
+}
+
+// AddTimeoutCount adds i to the "timeout_count" field.
+func (m *PeerReputationMutation) AddTimeoutCount(i int) {
+	if m.addtimeout_count != nil {
+		*m.addtimeout_count += i
+	} else {
+		m.addtimeout_count = &i
+	}
+}
+
+// AddedTimeoutCount returns the value that was added to the "timeout_count" field in this mutation.
+func (m *PeerReputationMutation) AddedTimeoutCount() (r int, exists bool) {
+	v := m.addtimeout_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeoutCount resets all changes to the "timeout_count" field.
+func (m *PeerReputationMutation) ResetTimeoutCount() {
+	m.timeout_count = nil
+	m.addtimeout_count = nil
+}
+
+// SetTrustScore sets the "trust_score" field.
+func (m *PeerReputationMutation) SetTrustScore(f float64) {
+	m.trust_score = &f
+	m.addtrust_score = nil
+}
+
+// TrustScore returns the value of the "trust_score" field in the mutation.
+func (m *PeerReputationMutation) TrustScore() (r float64, exists bool) {
+	v := m.trust_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrustScore returns the old "trust_score" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PeerReputationMutation) OldTrustScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrustScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrustScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrustScore: %w", err)
 	}
-	return oldValue.SourceStartIndex, nil
+	return oldValue.TrustScore, nil
 }
 
-// AddSourceStartIndex adds i to the "source_start_index" field.
-func (m *ObservationMutation) AddSourceStartIndex(i int) {
-	if m.addsource_start_index != nil {
-		*m.addsource_start_index += i
+// AddTrustScore adds f to the "trust_score" field.
+func (m *PeerReputationMutation) AddTrustScore(f float64) {
+	if m.addtrust_score != nil {
+		*m.addtrust_score += f
 	} else {
-		m.addsource_start_index = &i
+		m.addtrust_score = &f
 	}
 }
 
-// AddedSourceStartIndex returns the value that was added to the "source_start_index" field in this mutation.
-func (m *ObservationMutation) AddedSourceStartIndex() (r int, exists bool) {
-	v := m.addsource_start_index
+// AddedTrustScore returns the value that was added to the "trust_score" field in this mutation.
+func (m *PeerReputationMutation) AddedTrustScore() (r float64, exists bool) {
+	v := m.addtrust_score
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSourceStartIndex resets all changes to the "source_start_index" field.
-func (m *ObservationMutation) ResetSourceStartIndex() {
-	m.source_start_index = nil
-	m.addsource_start_index = nil
+// ResetTrustScore resets all changes to the "trust_score" field.
+func (m *PeerReputationMutation) ResetTrustScore() {
+	m.trust_score = nil
+	m.addtrust_score = nil
 }
 
-// SetSourceEndIndex sets the "source_end_index" field.
-func (m *ObservationMutation) SetSourceEndIndex(i int) {
-	m.source_end_index = &i
-	m.addsource_end_index = nil
+// SetFirstSeen sets the "first_seen" field.
+func (m *PeerReputationMutation) SetFirstSeen(t time.Time) {
+	m.first_seen = &t
 }
 
-// SourceEndIndex returns the value of the "source_end_index" field in the mutation.
-func (m *ObservationMutation) SourceEndIndex() (r int, exists bool) {
-	v := m.source_end_index
+// FirstSeen returns the value of the "first_seen" field in the mutation.
+func (m *PeerReputationMutation) FirstSeen() (r time.Time, exists bool) {
+	v := m.first_seen
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSourceEndIndex returns the old "source_end_index" field's value of the Observation entity.
-// If the Observation object wasn't provided to the builder, the object is fetched from the database.
+// OldFirstSeen returns the old "first_seen" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObservationMutation) OldSourceEndIndex(ctx context.Context) (v int, err error) {
+func (m *PeerReputationMutation) OldFirstSeen(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceEndIndex is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirstSeen is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceEndIndex requires an ID field in the mutation")
+		return v, errors.New("OldFirstSeen requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceEndIndex: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirstSeen: %w", err)
 	}
-	return oldValue.SourceEndIndex, nil
+	return oldValue.FirstSeen, nil
 }
 
-// AddSourceEndIndex adds i to the "source_end_index" field.
-func (m *ObservationMutation) AddSourceEndIndex(i int) {
-	if m.addsource_end_index != nil {
-		*m.addsource_end_index += i
-	} else {
-		m.addsource_end_index = &i
-	}
+// ResetFirstSeen resets all changes to the "first_seen" field.
+func (m *PeerReputationMutation) ResetFirstSeen() {
+	m.first_seen = nil
 }
 
-// AddedSourceEndIndex returns the value that was added to the "source_end_index" field in this mutation.
-func (m *ObservationMutation) AddedSourceEndIndex() (r int, exists bool) {
-	v := m.addsource_end_index
+// SetLastInteraction sets the "last_interaction" field.
+func (m *PeerReputationMutation) SetLastInteraction(t time.Time) {
+	m.last_interaction = &t
+}
+
+// LastInteraction returns the value of the "last_interaction" field in the mutation.
+func (m *PeerReputationMutation) LastInteraction() (r time.Time, exists bool) {
+	v := m.last_interaction
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSourceEndIndex resets all changes to the "source_end_index" field.
-func (m *ObservationMutation) ResetSourceEndIndex() {
-	m.source_end_index = nil
-	m.addsource_end_index = nil
+// OldLastInteraction returns the old "last_interaction" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PeerReputationMutation) OldLastInteraction(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastInteraction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastInteraction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastInteraction: %w", err)
+	}
+	return oldValue.LastInteraction, nil
+}
+
+// ResetLastInteraction resets all changes to the "last_interaction" field.
+func (m *PeerReputationMutation) ResetLastInteraction() {
+	m.last_interaction = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ObservationMutation) SetCreatedAt(t time.Time) {
+func (m *PeerReputationMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ObservationMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PeerReputationMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8563,10 +13352,10 @@ func (m *ObservationMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Observation entity.
-// If the Observation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ObservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PeerReputationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8581,19 +13370,55 @@ func (m *ObservationMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ObservationMutation) ResetCreatedAt() {
+func (m *PeerReputationMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the ObservationMutation builder.
-func (m *ObservationMutation) Where(ps ...predicate.Observation) {
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PeerReputationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PeerReputationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PeerReputation entity.
+// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PeerReputationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PeerReputationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the PeerReputationMutation builder.
+func (m *PeerReputationMutation) Where(ps ...predicate.PeerReputation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ObservationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PeerReputationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ObservationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Observation, len(ps))
+func (m *PeerReputationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PeerReputation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8601,42 +13426,51 @@ func (m *ObservationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ObservationMutation) Op() Op {
+func (m *PeerReputationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ObservationMutation) SetOp(op Op) {
+func (m *PeerReputationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Observation).
-func (m *ObservationMutation) Type() string {
+// Type returns the node type of this mutation (PeerReputation).
+func (m *PeerReputationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ObservationMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.session_key != nil {
-		fields = append(fields, observation.FieldSessionKey)
+func (m *PeerReputationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.peer_did != nil {
+		fields = append(fields, peerreputation.FieldPeerDid)
 	}
-	if m.content != nil {
-		fields = append(fields, observation.FieldContent)
+	if m.successful_exchanges != nil {
+		fields = append(fields, peerreputation.FieldSuccessfulExchanges)
 	}
-	if m.token_count != nil {
-		fields = append(fields, observation.FieldTokenCount)
+	if m.failed_exchanges != nil {
+		fields = append(fields, peerreputation.FieldFailedExchanges)
 	}
-	if m.source_start_index != nil {
-		fields = append(fields, observation.FieldSourceStartIndex)
+	if m.timeout_count != nil {
+		fields = append(fields, peerreputation.FieldTimeoutCount)
 	}
-	if m.source_end_index != nil {
-		fields = append(fields, observation.FieldSourceEndIndex)
+	if m.trust_score != nil {
+		fields = append(fields, peerreputation.FieldTrustScore)
+	}
+	if m.first_seen != nil {
+		fields = append(fields, peerreputation.FieldFirstSeen)
+	}
+	if m.last_interaction != nil {
+		fields = append(fields, peerreputation.FieldLastInteraction)
 	}
 	if m.created_at != nil {
-		fields = append(fields, observation.FieldCreatedAt)
+		fields = append(fields, peerreputation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, peerreputation.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8644,20 +13478,26 @@ func (m *ObservationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ObservationMutation) Field(name string) (ent.Value, bool) {
+func (m *PeerReputationMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case observation.FieldSessionKey:
-		return m.SessionKey()
-	case observation.FieldContent:
-		return m.Content()
-	case observation.FieldTokenCount:
-		return m.TokenCount()
-	case observation.FieldSourceStartIndex:
-		return m.SourceStartIndex()
-	case observation.FieldSourceEndIndex:
-		return m.SourceEndIndex()
-	case observation.FieldCreatedAt:
+	case peerreputation.FieldPeerDid:
+		return m.PeerDid()
+	case peerreputation.FieldSuccessfulExchanges:
+		return m.SuccessfulExchanges()
+	case peerreputation.FieldFailedExchanges:
+		return m.FailedExchanges()
+	case peerreputation.FieldTimeoutCount:
+		return m.TimeoutCount()
+	case peerreputation.FieldTrustScore:
+		return m.TrustScore()
+	case peerreputation.FieldFirstSeen:
+		return m.FirstSeen()
+	case peerreputation.FieldLastInteraction:
+		return m.LastInteraction()
+	case peerreputation.FieldCreatedAt:
 		return m.CreatedAt()
+	case peerreputation.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -8665,615 +13505,407 @@ func (m *ObservationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ObservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PeerReputationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case observation.FieldSessionKey:
-		return m.OldSessionKey(ctx)
-	case observation.FieldContent:
-		return m.OldContent(ctx)
-	case observation.FieldTokenCount:
-		return m.OldTokenCount(ctx)
-	case observation.FieldSourceStartIndex:
-		return m.OldSourceStartIndex(ctx)
-	case observation.FieldSourceEndIndex:
-		return m.OldSourceEndIndex(ctx)
-	case observation.FieldCreatedAt:
+	case peerreputation.FieldPeerDid:
+		return m.OldPeerDid(ctx)
+	case peerreputation.FieldSuccessfulExchanges:
+		return m.OldSuccessfulExchanges(ctx)
+	case peerreputation.FieldFailedExchanges:
+		return m.OldFailedExchanges(ctx)
+	case peerreputation.FieldTimeoutCount:
+		return m.OldTimeoutCount(ctx)
+	case peerreputation.FieldTrustScore:
+		return m.OldTrustScore(ctx)
+	case peerreputation.FieldFirstSeen:
+		return m.OldFirstSeen(ctx)
+	case peerreputation.FieldLastInteraction:
+		return m.OldLastInteraction(ctx)
+	case peerreputation.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case peerreputation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Observation field %s", name)
+	return nil, fmt.Errorf("unknown PeerReputation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ObservationMutation) SetField(name string, value ent.Value) error {
+func (m *PeerReputationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case observation.FieldSessionKey:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionKey(v)
-		return nil
-	case observation.FieldContent:
+	case peerreputation.FieldPeerDid:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetPeerDid(v)
 		return nil
-	case observation.FieldTokenCount:
+	case peerreputation.FieldSuccessfulExchanges:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenCount(v)
+		m.SetSuccessfulExchanges(v)
 		return nil
-	case observation.FieldSourceStartIndex:
+	case peerreputation.FieldFailedExchanges:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceStartIndex(v)
+		m.SetFailedExchanges(v)
 		return nil
-	case observation.FieldSourceEndIndex:
+	case peerreputation.FieldTimeoutCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceEndIndex(v)
-		return nil
-	case observation.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+		m.SetTimeoutCount(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Observation field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ObservationMutation) AddedFields() []string {
-	var fields []string
-	if m.addtoken_count != nil {
-		fields = append(fields, observation.FieldTokenCount)
-	}
-	if m.addsource_start_index != nil {
-		fields = append(fields, observation.FieldSourceStartIndex)
-	}
-	if m.addsource_end_index != nil {
-		fields = append(fields, observation.FieldSourceEndIndex)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ObservationMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case observation.FieldTokenCount:
-		return m.AddedTokenCount()
-	case observation.FieldSourceStartIndex:
-		return m.AddedSourceStartIndex()
-	case observation.FieldSourceEndIndex:
-		return m.AddedSourceEndIndex()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ObservationMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case observation.FieldTokenCount:
-		v, ok := value.(int)
+	case peerreputation.FieldTrustScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTokenCount(v)
+		m.SetTrustScore(v)
 		return nil
-	case observation.FieldSourceStartIndex:
-		v, ok := value.(int)
+	case peerreputation.FieldFirstSeen:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSourceStartIndex(v)
+		m.SetFirstSeen(v)
 		return nil
-	case observation.FieldSourceEndIndex:
-		v, ok := value.(int)
+	case peerreputation.FieldLastInteraction:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSourceEndIndex(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Observation numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ObservationMutation) ClearedFields() []string {
-	return nil
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ObservationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ObservationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Observation nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ObservationMutation) ResetField(name string) error {
-	switch name {
-	case observation.FieldSessionKey:
-		m.ResetSessionKey()
-		return nil
-	case observation.FieldContent:
-		m.ResetContent()
-		return nil
-	case observation.FieldTokenCount:
-		m.ResetTokenCount()
-		return nil
-	case observation.FieldSourceStartIndex:
-		m.ResetSourceStartIndex()
-		return nil
-	case observation.FieldSourceEndIndex:
-		m.ResetSourceEndIndex()
-		return nil
-	case observation.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Observation field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ObservationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ObservationMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ObservationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ObservationMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ObservationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ObservationMutation) EdgeCleared(name string) bool {
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ObservationMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Observation unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ObservationMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Observation edge %s", name)
-}
-
-// PaymentTxMutation represents an operation that mutates the PaymentTx nodes in the graph.
-type PaymentTxMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	tx_hash        *string
-	from_address   *string
-	to_address     *string
-	amount         *string
-	chain_id       *int64
-	addchain_id    *int64
-	status         *paymenttx.Status
-	session_key    *string
-	purpose        *string
-	x402_url       *string
-	payment_method *paymenttx.PaymentMethod
-	error_message  *string
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*PaymentTx, error)
-	predicates     []predicate.PaymentTx
-}
-
-var _ ent.Mutation = (*PaymentTxMutation)(nil)
-
-// paymenttxOption allows management of the mutation configuration using functional options.
-type paymenttxOption func(*PaymentTxMutation)
-
-// newPaymentTxMutation creates new mutation for the PaymentTx entity.
-func newPaymentTxMutation(c config, op Op, opts ...paymenttxOption) *PaymentTxMutation {
-	m := &PaymentTxMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePaymentTx,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
-}
-
-// withPaymentTxID sets the ID field of the mutation.
-func withPaymentTxID(id uuid.UUID) paymenttxOption {
-	return func(m *PaymentTxMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PaymentTx
-		)
-		m.oldValue = func(ctx context.Context) (*PaymentTx, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PaymentTx.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withPaymentTx sets the old PaymentTx of the mutation.
-func withPaymentTx(node *PaymentTx) paymenttxOption {
-	return func(m *PaymentTxMutation) {
-		m.oldValue = func(context.Context) (*PaymentTx, error) {
-			return node, nil
+		m.SetLastInteraction(v)
+		return nil
+	case peerreputation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.id = &node.ID
+		m.SetCreatedAt(v)
+		return nil
+	case peerreputation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown PeerReputation field %s", name)
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PaymentTxMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PaymentTxMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PeerReputationMutation) AddedFields() []string {
+	var fields []string
+	if m.addsuccessful_exchanges != nil {
+		fields = append(fields, peerreputation.FieldSuccessfulExchanges)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PaymentTx entities.
-func (m *PaymentTxMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PaymentTxMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+	if m.addfailed_exchanges != nil {
+		fields = append(fields, peerreputation.FieldFailedExchanges)
 	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PaymentTxMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PaymentTx.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	if m.addtimeout_count != nil {
+		fields = append(fields, peerreputation.FieldTimeoutCount)
 	}
+	if m.addtrust_score != nil {
+		fields = append(fields, peerreputation.FieldTrustScore)
+	}
+	return fields
 }
 
-// SetTxHash sets the "tx_hash" field.
-func (m *PaymentTxMutation) SetTxHash(s string) {
-	m.tx_hash = &s
-}
-
-// TxHash returns the value of the "tx_hash" field in the mutation.
-func (m *PaymentTxMutation) TxHash() (r string, exists bool) {
-	v := m.tx_hash
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PeerReputationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case peerreputation.FieldSuccessfulExchanges:
+		return m.AddedSuccessfulExchanges()
+	case peerreputation.FieldFailedExchanges:
+		return m.AddedFailedExchanges()
+	case peerreputation.FieldTimeoutCount:
+		return m.AddedTimeoutCount()
+	case peerreputation.FieldTrustScore:
+		return m.AddedTrustScore()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldTxHash returns the old "tx_hash" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldTxHash(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTxHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTxHash requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTxHash: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PeerReputationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case peerreputation.FieldSuccessfulExchanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuccessfulExchanges(v)
+		return nil
+	case peerreputation.FieldFailedExchanges:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedExchanges(v)
+		return nil
+	case peerreputation.FieldTimeoutCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeoutCount(v)
+		return nil
+	case peerreputation.FieldTrustScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrustScore(v)
+		return nil
 	}
-	return oldValue.TxHash, nil
+	return fmt.Errorf("unknown PeerReputation numeric field %s", name)
 }
 
-// ClearTxHash clears the value of the "tx_hash" field.
-func (m *PaymentTxMutation) ClearTxHash() {
-	m.tx_hash = nil
-	m.clearedFields[paymenttx.FieldTxHash] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PeerReputationMutation) ClearedFields() []string {
+	return nil
 }
 
-// TxHashCleared returns if the "tx_hash" field was cleared in this mutation.
-func (m *PaymentTxMutation) TxHashCleared() bool {
-	_, ok := m.clearedFields[paymenttx.FieldTxHash]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PeerReputationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetTxHash resets all changes to the "tx_hash" field.
-func (m *PaymentTxMutation) ResetTxHash() {
-	m.tx_hash = nil
-	delete(m.clearedFields, paymenttx.FieldTxHash)
-}
-
-// SetFromAddress sets the "from_address" field.
-func (m *PaymentTxMutation) SetFromAddress(s string) {
-	m.from_address = &s
-}
-
-// FromAddress returns the value of the "from_address" field in the mutation.
-func (m *PaymentTxMutation) FromAddress() (r string, exists bool) {
-	v := m.from_address
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PeerReputationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PeerReputation nullable field %s", name)
 }
 
-// OldFromAddress returns the old "from_address" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldFromAddress(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFromAddress is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFromAddress requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFromAddress: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PeerReputationMutation) ResetField(name string) error {
+	switch name {
+	case peerreputation.FieldPeerDid:
+		m.ResetPeerDid()
+		return nil
+	case peerreputation.FieldSuccessfulExchanges:
+		m.ResetSuccessfulExchanges()
+		return nil
+	case peerreputation.FieldFailedExchanges:
+		m.ResetFailedExchanges()
+		return nil
+	case peerreputation.FieldTimeoutCount:
+		m.ResetTimeoutCount()
+		return nil
+	case peerreputation.FieldTrustScore:
+		m.ResetTrustScore()
+		return nil
+	case peerreputation.FieldFirstSeen:
+		m.ResetFirstSeen()
+		return nil
+	case peerreputation.FieldLastInteraction:
+		m.ResetLastInteraction()
+		return nil
+	case peerreputation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case peerreputation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.FromAddress, nil
-}
-
-// ResetFromAddress resets all changes to the "from_address" field.
-func (m *PaymentTxMutation) ResetFromAddress() {
-	m.from_address = nil
+	return fmt.Errorf("unknown PeerReputation field %s", name)
 }
 
-// SetToAddress sets the "to_address" field.
-func (m *PaymentTxMutation) SetToAddress(s string) {
-	m.to_address = &s
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PeerReputationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ToAddress returns the value of the "to_address" field in the mutation.
-func (m *PaymentTxMutation) ToAddress() (r string, exists bool) {
-	v := m.to_address
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PeerReputationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldToAddress returns the old "to_address" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldToAddress(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToAddress is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToAddress requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToAddress: %w", err)
-	}
-	return oldValue.ToAddress, nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PeerReputationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetToAddress resets all changes to the "to_address" field.
-func (m *PaymentTxMutation) ResetToAddress() {
-	m.to_address = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PeerReputationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetAmount sets the "amount" field.
-func (m *PaymentTxMutation) SetAmount(s string) {
-	m.amount = &s
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PeerReputationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// Amount returns the value of the "amount" field in the mutation.
-func (m *PaymentTxMutation) Amount() (r string, exists bool) {
-	v := m.amount
-	if v == nil {
-		return
-	}
-	return *v, true
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PeerReputationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// OldAmount returns the old "amount" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldAmount(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
-	}
-	return oldValue.Amount, nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PeerReputationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PeerReputation unique edge %s", name)
 }
 
-// ResetAmount resets all changes to the "amount" field.
-func (m *PaymentTxMutation) ResetAmount() {
-	m.amount = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PeerReputationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PeerReputation edge %s", name)
 }
 
-// SetChainID sets the "chain_id" field.
-func (m *PaymentTxMutation) SetChainID(i int64) {
-	m.chain_id = &i
-	m.addchain_id = nil
+// ReflectionMutation represents an operation that mutates the Reflection nodes in the graph.
+type ReflectionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	session_key    *string
+	content        *string
+	token_count    *int
+	addtoken_count *int
+	generation     *int
+	addgeneration  *int
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Reflection, error)
+	predicates     []predicate.Reflection
 }
 
-// ChainID returns the value of the "chain_id" field in the mutation.
-func (m *PaymentTxMutation) ChainID() (r int64, exists bool) {
-	v := m.chain_id
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*ReflectionMutation)(nil)
 
-// OldChainID returns the old "chain_id" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldChainID(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChainID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChainID requires an ID field in the mutation")
+// reflectionOption allows management of the mutation configuration using functional options.
+type reflectionOption func(*ReflectionMutation)
+
+// newReflectionMutation creates new mutation for the Reflection entity.
+func newReflectionMutation(c config, op Op, opts ...reflectionOption) *ReflectionMutation {
+	m := &ReflectionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReflection,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChainID: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.ChainID, nil
+	return m
 }
 
-// AddChainID adds i to the "chain_id" field.
-func (m *PaymentTxMutation) AddChainID(i int64) {
-	if m.addchain_id != nil {
-		*m.addchain_id += i
-	} else {
-		m.addchain_id = &i
+// withReflectionID sets the ID field of the mutation.
+func withReflectionID(id uuid.UUID) reflectionOption {
+	return func(m *ReflectionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Reflection
+		)
+		m.oldValue = func(ctx context.Context) (*Reflection, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Reflection.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedChainID returns the value that was added to the "chain_id" field in this mutation.
-func (m *PaymentTxMutation) AddedChainID() (r int64, exists bool) {
-	v := m.addchain_id
-	if v == nil {
-		return
+// withReflection sets the old Reflection of the mutation.
+func withReflection(node *Reflection) reflectionOption {
+	return func(m *ReflectionMutation) {
+		m.oldValue = func(context.Context) (*Reflection, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetChainID resets all changes to the "chain_id" field.
-func (m *PaymentTxMutation) ResetChainID() {
-	m.chain_id = nil
-	m.addchain_id = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReflectionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetStatus sets the "status" field.
-func (m *PaymentTxMutation) SetStatus(pa paymenttx.Status) {
-	m.status = &pa
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReflectionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PaymentTxMutation) Status() (r paymenttx.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Reflection entities.
+func (m *ReflectionMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// OldStatus returns the old "status" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldStatus(ctx context.Context) (v paymenttx.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReflectionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Status, nil
+	return *m.id, true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PaymentTxMutation) ResetStatus() {
-	m.status = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReflectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Reflection.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
 // SetSessionKey sets the "session_key" field.
-func (m *PaymentTxMutation) SetSessionKey(s string) {
+func (m *ReflectionMutation) SetSessionKey(s string) {
 	m.session_key = &s
 }
 
 // SessionKey returns the value of the "session_key" field in the mutation.
-func (m *PaymentTxMutation) SessionKey() (r string, exists bool) {
+func (m *ReflectionMutation) SessionKey() (r string, exists bool) {
 	v := m.session_key
 	if v == nil {
 		return
@@ -9281,10 +13913,10 @@ func (m *PaymentTxMutation) SessionKey() (r string, exists bool) {
 	return *v, true
 }
 
-// OldSessionKey returns the old "session_key" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the Reflection entity.
+// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+func (m *ReflectionMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
@@ -9298,214 +13930,166 @@ func (m *PaymentTxMutation) OldSessionKey(ctx context.Context) (v string, err er
 	return oldValue.SessionKey, nil
 }
 
-// ClearSessionKey clears the value of the "session_key" field.
-func (m *PaymentTxMutation) ClearSessionKey() {
-	m.session_key = nil
-	m.clearedFields[paymenttx.FieldSessionKey] = struct{}{}
-}
-
-// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
-func (m *PaymentTxMutation) SessionKeyCleared() bool {
-	_, ok := m.clearedFields[paymenttx.FieldSessionKey]
-	return ok
-}
-
 // ResetSessionKey resets all changes to the "session_key" field.
-func (m *PaymentTxMutation) ResetSessionKey() {
+func (m *ReflectionMutation) ResetSessionKey() {
 	m.session_key = nil
-	delete(m.clearedFields, paymenttx.FieldSessionKey)
 }
 
-// SetPurpose sets the "purpose" field.
-func (m *PaymentTxMutation) SetPurpose(s string) {
-	m.purpose = &s
+// SetContent sets the "content" field.
+func (m *ReflectionMutation) SetContent(s string) {
+	m.content = &s
 }
 
-// Purpose returns the value of the "purpose" field in the mutation.
-func (m *PaymentTxMutation) Purpose() (r string, exists bool) {
-	v := m.purpose
+// Content returns the value of the "content" field in the mutation.
+func (m *ReflectionMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPurpose returns the old "purpose" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Reflection entity.
+// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldPurpose(ctx context.Context) (v string, err error) {
+func (m *ReflectionMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPurpose requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.Purpose, nil
-}
-
-// ClearPurpose clears the value of the "purpose" field.
-func (m *PaymentTxMutation) ClearPurpose() {
-	m.purpose = nil
-	m.clearedFields[paymenttx.FieldPurpose] = struct{}{}
-}
-
-// PurposeCleared returns if the "purpose" field was cleared in this mutation.
-func (m *PaymentTxMutation) PurposeCleared() bool {
-	_, ok := m.clearedFields[paymenttx.FieldPurpose]
-	return ok
+	return oldValue.Content, nil
 }
 
-// ResetPurpose resets all changes to the "purpose" field.
-func (m *PaymentTxMutation) ResetPurpose() {
-	m.purpose = nil
-	delete(m.clearedFields, paymenttx.FieldPurpose)
+// ResetContent resets all changes to the "content" field.
+func (m *ReflectionMutation) ResetContent() {
+	m.content = nil
 }
 
-// SetX402URL sets the "x402_url" field.
-func (m *PaymentTxMutation) SetX402URL(s string) {
-	m.x402_url = &s
+// SetTokenCount sets the "token_count" field.
+func (m *ReflectionMutation) SetTokenCount(i int) {
+	m.token_count = &i
+	m.addtoken_count = nil
 }
 
-// X402URL returns the value of the "x402_url" field in the mutation.
-func (m *PaymentTxMutation) X402URL() (r string, exists bool) {
-	v := m.x402_url
+// TokenCount returns the value of the "token_count" field in the mutation.
+func (m *ReflectionMutation) TokenCount() (r int, exists bool) {
+	v := m.token_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldX402URL returns the old "x402_url" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenCount returns the old "token_count" field's value of the Reflection entity.
+// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldX402URL(ctx context.Context) (v string, err error) {
+func (m *ReflectionMutation) OldTokenCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldX402URL is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldX402URL requires an ID field in the mutation")
+		return v, errors.New("OldTokenCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldX402URL: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenCount: %w", err)
 	}
-	return oldValue.X402URL, nil
-}
-
-// ClearX402URL clears the value of the "x402_url" field.
-func (m *PaymentTxMutation) ClearX402URL() {
-	m.x402_url = nil
-	m.clearedFields[paymenttx.FieldX402URL] = struct{}{}
-}
-
-// X402URLCleared returns if the "x402_url" field was cleared in this mutation.
-func (m *PaymentTxMutation) X402URLCleared() bool {
-	_, ok := m.clearedFields[paymenttx.FieldX402URL]
-	return ok
-}
-
-// ResetX402URL resets all changes to the "x402_url" field.
-func (m *PaymentTxMutation) ResetX402URL() {
-	m.x402_url = nil
-	delete(m.clearedFields, paymenttx.FieldX402URL)
+	return oldValue.TokenCount, nil
 }
 
-// SetPaymentMethod sets the "payment_method" field.
-func (m *PaymentTxMutation) SetPaymentMethod(pm paymenttx.PaymentMethod) {
-	m.payment_method = &pm
+// AddTokenCount adds i to the "token_count" field.
+func (m *ReflectionMutation) AddTokenCount(i int) {
+	if m.addtoken_count != nil {
+		*m.addtoken_count += i
+	} else {
+		m.addtoken_count = &i
+	}
 }
 
-// PaymentMethod returns the value of the "payment_method" field in the mutation.
-func (m *PaymentTxMutation) PaymentMethod() (r paymenttx.PaymentMethod, exists bool) {
-	v := m.payment_method
+// AddedTokenCount returns the value that was added to the "token_count" field in this mutation.
+func (m *ReflectionMutation) AddedTokenCount() (r int, exists bool) {
+	v := m.addtoken_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPaymentMethod returns the old "payment_method" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldPaymentMethod(ctx context.Context) (v paymenttx.PaymentMethod, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentMethod is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentMethod requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentMethod: %w", err)
-	}
-	return oldValue.PaymentMethod, nil
-}
-
-// ResetPaymentMethod resets all changes to the "payment_method" field.
-func (m *PaymentTxMutation) ResetPaymentMethod() {
-	m.payment_method = nil
+// ResetTokenCount resets all changes to the "token_count" field.
+func (m *ReflectionMutation) ResetTokenCount() {
+	m.token_count = nil
+	m.addtoken_count = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *PaymentTxMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetGeneration sets the "generation" field.
+func (m *ReflectionMutation) SetGeneration(i int) {
+	m.generation = &i
+	m.addgeneration = nil
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *PaymentTxMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// Generation returns the value of the "generation" field in the mutation.
+func (m *ReflectionMutation) Generation() (r int, exists bool) {
+	v := m.generation
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// OldGeneration returns the old "generation" field's value of the Reflection entity.
+// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *ReflectionMutation) OldGeneration(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldGeneration is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldGeneration requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldGeneration: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
+	return oldValue.Generation, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *PaymentTxMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[paymenttx.FieldErrorMessage] = struct{}{}
+// AddGeneration adds i to the "generation" field.
+func (m *ReflectionMutation) AddGeneration(i int) {
+	if m.addgeneration != nil {
+		*m.addgeneration += i
+	} else {
+		m.addgeneration = &i
+	}
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *PaymentTxMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[paymenttx.FieldErrorMessage]
-	return ok
+// AddedGeneration returns the value that was added to the "generation" field in this mutation.
+func (m *ReflectionMutation) AddedGeneration() (r int, exists bool) {
+	v := m.addgeneration
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *PaymentTxMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, paymenttx.FieldErrorMessage)
+// ResetGeneration resets all changes to the "generation" field.
+func (m *ReflectionMutation) ResetGeneration() {
+	m.generation = nil
+	m.addgeneration = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PaymentTxMutation) SetCreatedAt(t time.Time) {
+func (m *ReflectionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PaymentTxMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ReflectionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9513,10 +14097,10 @@ func (m *PaymentTxMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Reflection entity.
+// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReflectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9531,55 +14115,19 @@ func (m *PaymentTxMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PaymentTxMutation) ResetCreatedAt() {
+func (m *ReflectionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PaymentTxMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PaymentTxMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the PaymentTx entity.
-// If the PaymentTx object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentTxMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PaymentTxMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// Where appends a list predicates to the PaymentTxMutation builder.
-func (m *PaymentTxMutation) Where(ps ...predicate.PaymentTx) {
+// Where appends a list predicates to the ReflectionMutation builder.
+func (m *ReflectionMutation) Where(ps ...predicate.Reflection) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PaymentTxMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReflectionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PaymentTxMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PaymentTx, len(ps))
+func (m *ReflectionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reflection, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9587,63 +14135,39 @@ func (m *PaymentTxMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PaymentTxMutation) Op() Op {
+func (m *ReflectionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PaymentTxMutation) SetOp(op Op) {
+func (m *ReflectionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PaymentTx).
-func (m *PaymentTxMutation) Type() string {
+// Type returns the node type of this mutation (Reflection).
+func (m *ReflectionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PaymentTxMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.tx_hash != nil {
-		fields = append(fields, paymenttx.FieldTxHash)
-	}
-	if m.from_address != nil {
-		fields = append(fields, paymenttx.FieldFromAddress)
-	}
-	if m.to_address != nil {
-		fields = append(fields, paymenttx.FieldToAddress)
-	}
-	if m.amount != nil {
-		fields = append(fields, paymenttx.FieldAmount)
-	}
-	if m.chain_id != nil {
-		fields = append(fields, paymenttx.FieldChainID)
-	}
-	if m.status != nil {
-		fields = append(fields, paymenttx.FieldStatus)
-	}
+func (m *ReflectionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.session_key != nil {
-		fields = append(fields, paymenttx.FieldSessionKey)
-	}
-	if m.purpose != nil {
-		fields = append(fields, paymenttx.FieldPurpose)
+		fields = append(fields, reflection.FieldSessionKey)
 	}
-	if m.x402_url != nil {
-		fields = append(fields, paymenttx.FieldX402URL)
+	if m.content != nil {
+		fields = append(fields, reflection.FieldContent)
 	}
-	if m.payment_method != nil {
-		fields = append(fields, paymenttx.FieldPaymentMethod)
+	if m.token_count != nil {
+		fields = append(fields, reflection.FieldTokenCount)
 	}
-	if m.error_message != nil {
-		fields = append(fields, paymenttx.FieldErrorMessage)
+	if m.generation != nil {
+		fields = append(fields, reflection.FieldGeneration)
 	}
 	if m.created_at != nil {
-		fields = append(fields, paymenttx.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, paymenttx.FieldUpdatedAt)
+		fields = append(fields, reflection.FieldCreatedAt)
 	}
 	return fields
 }
@@ -9651,34 +14175,18 @@ func (m *PaymentTxMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PaymentTxMutation) Field(name string) (ent.Value, bool) {
+func (m *ReflectionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case paymenttx.FieldTxHash:
-		return m.TxHash()
-	case paymenttx.FieldFromAddress:
-		return m.FromAddress()
-	case paymenttx.FieldToAddress:
-		return m.ToAddress()
-	case paymenttx.FieldAmount:
-		return m.Amount()
-	case paymenttx.FieldChainID:
-		return m.ChainID()
-	case paymenttx.FieldStatus:
-		return m.Status()
-	case paymenttx.FieldSessionKey:
+	case reflection.FieldSessionKey:
 		return m.SessionKey()
-	case paymenttx.FieldPurpose:
-		return m.Purpose()
-	case paymenttx.FieldX402URL:
-		return m.X402URL()
-	case paymenttx.FieldPaymentMethod:
-		return m.PaymentMethod()
-	case paymenttx.FieldErrorMessage:
-		return m.ErrorMessage()
-	case paymenttx.FieldCreatedAt:
+	case reflection.FieldContent:
+		return m.Content()
+	case reflection.FieldTokenCount:
+		return m.TokenCount()
+	case reflection.FieldGeneration:
+		return m.Generation()
+	case reflection.FieldCreatedAt:
 		return m.CreatedAt()
-	case paymenttx.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -9686,144 +14194,75 @@ func (m *PaymentTxMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PaymentTxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReflectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case paymenttx.FieldTxHash:
-		return m.OldTxHash(ctx)
-	case paymenttx.FieldFromAddress:
-		return m.OldFromAddress(ctx)
-	case paymenttx.FieldToAddress:
-		return m.OldToAddress(ctx)
-	case paymenttx.FieldAmount:
-		return m.OldAmount(ctx)
-	case paymenttx.FieldChainID:
-		return m.OldChainID(ctx)
-	case paymenttx.FieldStatus:
-		return m.OldStatus(ctx)
-	case paymenttx.FieldSessionKey:
+	case reflection.FieldSessionKey:
 		return m.OldSessionKey(ctx)
-	case paymenttx.FieldPurpose:
-		return m.OldPurpose(ctx)
-	case paymenttx.FieldX402URL:
-		return m.OldX402URL(ctx)
-	case paymenttx.FieldPaymentMethod:
-		return m.OldPaymentMethod(ctx)
-	case paymenttx.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case paymenttx.FieldCreatedAt:
+	case reflection.FieldContent:
+		return m.OldContent(ctx)
+	case reflection.FieldTokenCount:
+		return m.OldTokenCount(ctx)
+	case reflection.FieldGeneration:
+		return m.OldGeneration(ctx)
+	case reflection.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case paymenttx.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PaymentTx field %s", name)
+	return nil, fmt.Errorf("unknown Reflection field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentTxMutation) SetField(name string, value ent.Value) error {
+func (m *ReflectionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case paymenttx.FieldTxHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTxHash(v)
-		return nil
-	case paymenttx.FieldFromAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFromAddress(v)
-		return nil
-	case paymenttx.FieldToAddress:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetToAddress(v)
-		return nil
-	case paymenttx.FieldAmount:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmount(v)
-		return nil
-	case paymenttx.FieldChainID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChainID(v)
-		return nil
-	case paymenttx.FieldStatus:
-		v, ok := value.(paymenttx.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case paymenttx.FieldSessionKey:
+	case reflection.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSessionKey(v)
 		return nil
-	case paymenttx.FieldPurpose:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPurpose(v)
-		return nil
-	case paymenttx.FieldX402URL:
+	case reflection.FieldContent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetX402URL(v)
+		m.SetContent(v)
 		return nil
-	case paymenttx.FieldPaymentMethod:
-		v, ok := value.(paymenttx.PaymentMethod)
+	case reflection.FieldTokenCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPaymentMethod(v)
+		m.SetTokenCount(v)
 		return nil
-	case paymenttx.FieldErrorMessage:
-		v, ok := value.(string)
+	case reflection.FieldGeneration:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetGeneration(v)
 		return nil
-	case paymenttx.FieldCreatedAt:
+	case reflection.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case paymenttx.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown PaymentTx field %s", name)
+	return fmt.Errorf("unknown Reflection field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PaymentTxMutation) AddedFields() []string {
+func (m *ReflectionMutation) AddedFields() []string {
 	var fields []string
-	if m.addchain_id != nil {
-		fields = append(fields, paymenttx.FieldChainID)
+	if m.addtoken_count != nil {
+		fields = append(fields, reflection.FieldTokenCount)
+	}
+	if m.addgeneration != nil {
+		fields = append(fields, reflection.FieldGeneration)
 	}
 	return fields
 }
@@ -9831,10 +14270,12 @@ func (m *PaymentTxMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PaymentTxMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReflectionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case paymenttx.FieldChainID:
-		return m.AddedChainID()
+	case reflection.FieldTokenCount:
+		return m.AddedTokenCount()
+	case reflection.FieldGeneration:
+		return m.AddedGeneration()
 	}
 	return nil, false
 }
@@ -9842,202 +14283,155 @@ func (m *PaymentTxMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentTxMutation) AddField(name string, value ent.Value) error {
+func (m *ReflectionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case paymenttx.FieldChainID:
-		v, ok := value.(int64)
+	case reflection.FieldTokenCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddChainID(v)
+		m.AddTokenCount(v)
+		return nil
+	case reflection.FieldGeneration:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGeneration(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PaymentTx numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PaymentTxMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(paymenttx.FieldTxHash) {
-		fields = append(fields, paymenttx.FieldTxHash)
-	}
-	if m.FieldCleared(paymenttx.FieldSessionKey) {
-		fields = append(fields, paymenttx.FieldSessionKey)
-	}
-	if m.FieldCleared(paymenttx.FieldPurpose) {
-		fields = append(fields, paymenttx.FieldPurpose)
-	}
-	if m.FieldCleared(paymenttx.FieldX402URL) {
-		fields = append(fields, paymenttx.FieldX402URL)
-	}
-	if m.FieldCleared(paymenttx.FieldErrorMessage) {
-		fields = append(fields, paymenttx.FieldErrorMessage)
-	}
-	return fields
+	return fmt.Errorf("unknown Reflection numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReflectionMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PaymentTxMutation) FieldCleared(name string) bool {
+func (m *ReflectionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PaymentTxMutation) ClearField(name string) error {
-	switch name {
-	case paymenttx.FieldTxHash:
-		m.ClearTxHash()
-		return nil
-	case paymenttx.FieldSessionKey:
-		m.ClearSessionKey()
-		return nil
-	case paymenttx.FieldPurpose:
-		m.ClearPurpose()
-		return nil
-	case paymenttx.FieldX402URL:
-		m.ClearX402URL()
-		return nil
-	case paymenttx.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	}
-	return fmt.Errorf("unknown PaymentTx nullable field %s", name)
+func (m *ReflectionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Reflection nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PaymentTxMutation) ResetField(name string) error {
+func (m *ReflectionMutation) ResetField(name string) error {
 	switch name {
-	case paymenttx.FieldTxHash:
-		m.ResetTxHash()
-		return nil
-	case paymenttx.FieldFromAddress:
-		m.ResetFromAddress()
-		return nil
-	case paymenttx.FieldToAddress:
-		m.ResetToAddress()
-		return nil
-	case paymenttx.FieldAmount:
-		m.ResetAmount()
-		return nil
-	case paymenttx.FieldChainID:
-		m.ResetChainID()
-		return nil
-	case paymenttx.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case paymenttx.FieldSessionKey:
+	case reflection.FieldSessionKey:
 		m.ResetSessionKey()
 		return nil
-	case paymenttx.FieldPurpose:
-		m.ResetPurpose()
-		return nil
-	case paymenttx.FieldX402URL:
-		m.ResetX402URL()
+	case reflection.FieldContent:
+		m.ResetContent()
 		return nil
-	case paymenttx.FieldPaymentMethod:
-		m.ResetPaymentMethod()
+	case reflection.FieldTokenCount:
+		m.ResetTokenCount()
 		return nil
-	case paymenttx.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case reflection.FieldGeneration:
+		m.ResetGeneration()
 		return nil
-	case paymenttx.FieldCreatedAt:
+	case reflection.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case paymenttx.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown PaymentTx field %s", name)
+	return fmt.Errorf("unknown Reflection field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PaymentTxMutation) AddedEdges() []string {
+func (m *ReflectionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PaymentTxMutation) AddedIDs(name string) []ent.Value {
+func (m *ReflectionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PaymentTxMutation) RemovedEdges() []string {
+func (m *ReflectionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PaymentTxMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReflectionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PaymentTxMutation) ClearedEdges() []string {
+func (m *ReflectionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PaymentTxMutation) EdgeCleared(name string) bool {
+func (m *ReflectionMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PaymentTxMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PaymentTx unique edge %s", name)
+func (m *ReflectionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Reflection unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PaymentTxMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PaymentTx edge %s", name)
+func (m *ReflectionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Reflection edge %s", name)
 }
 
-// PeerReputationMutation represents an operation that mutates the PeerReputation nodes in the graph.
-type PeerReputationMutation struct {
+// SecretMutation represents an operation that mutates the Secret nodes in the graph.
+type SecretMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	peer_did                *string
-	successful_exchanges    *int
-	addsuccessful_exchanges *int
-	failed_exchanges        *int
-	addfailed_exchanges     *int
-	timeout_count           *int
-	addtimeout_count        *int
-	trust_score             *float64
-	addtrust_score          *float64
-	first_seen              *time.Time
-	last_interaction        *time.Time
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	done                    bool
-	oldValue                func(context.Context) (*PeerReputation, error)
-	predicates              []predicate.PeerReputation
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	name                 *string
+	encrypted_value      *[]byte
+	created_at           *time.Time
+	updated_at           *time.Time
+	access_count         *int
+	addaccess_count      *int
+	version              *int
+	addversion           *int
+	owner                *string
+	expires_at           *time.Time
+	rotation_interval    *time.Duration
+	addrotation_interval *time.Duration
+	rotated_at           *time.Time
+	expiry_notified_at   *time.Time
+	clearedFields        map[string]struct{}
+	key                  *uuid.UUID
+	clearedkey           bool
+	done                 bool
+	oldValue             func(context.Context) (*Secret, error)
+	predicates           []predicate.Secret
 }
 
-var _ ent.Mutation = (*PeerReputationMutation)(nil)
+var _ ent.Mutation = (*SecretMutation)(nil)
 
-// peerreputationOption allows management of the mutation configuration using functional options.
-type peerreputationOption func(*PeerReputationMutation)
+// secretOption allows management of the mutation configuration using functional options.
+type secretOption func(*SecretMutation)
 
-// newPeerReputationMutation creates new mutation for the PeerReputation entity.
-func newPeerReputationMutation(c config, op Op, opts ...peerreputationOption) *PeerReputationMutation {
-	m := &PeerReputationMutation{
+// newSecretMutation creates new mutation for the Secret entity.
+func newSecretMutation(c config, op Op, opts ...secretOption) *SecretMutation {
+	m := &SecretMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePeerReputation,
+		typ:           TypeSecret,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10046,20 +14440,20 @@ func newPeerReputationMutation(c config, op Op, opts ...peerreputationOption) *P
 	return m
 }
 
-// withPeerReputationID sets the ID field of the mutation.
-func withPeerReputationID(id uuid.UUID) peerreputationOption {
-	return func(m *PeerReputationMutation) {
+// withSecretID sets the ID field of the mutation.
+func withSecretID(id uuid.UUID) secretOption {
+	return func(m *SecretMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PeerReputation
+			value *Secret
 		)
-		m.oldValue = func(ctx context.Context) (*PeerReputation, error) {
+		m.oldValue = func(ctx context.Context) (*Secret, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PeerReputation.Get(ctx, id)
+					value, err = m.Client().Secret.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10068,10 +14462,10 @@ func withPeerReputationID(id uuid.UUID) peerreputationOption {
 	}
 }
 
-// withPeerReputation sets the old PeerReputation of the mutation.
-func withPeerReputation(node *PeerReputation) peerreputationOption {
-	return func(m *PeerReputationMutation) {
-		m.oldValue = func(context.Context) (*PeerReputation, error) {
+// withSecret sets the old Secret of the mutation.
+func withSecret(node *Secret) secretOption {
+	return func(m *SecretMutation) {
+		m.oldValue = func(context.Context) (*Secret, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10080,470 +14474,627 @@ func withPeerReputation(node *PeerReputation) peerreputationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PeerReputationMutation) Client() *Client {
+func (m SecretMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PeerReputationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SecretMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Secret entities.
+func (m *SecretMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SecretMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SecretMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Secret.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SecretMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SecretMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SecretMutation) ResetName() {
+	m.name = nil
+}
+
+// SetEncryptedValue sets the "encrypted_value" field.
+func (m *SecretMutation) SetEncryptedValue(b []byte) {
+	m.encrypted_value = &b
+}
+
+// EncryptedValue returns the value of the "encrypted_value" field in the mutation.
+func (m *SecretMutation) EncryptedValue() (r []byte, exists bool) {
+	v := m.encrypted_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEncryptedValue returns the old "encrypted_value" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldEncryptedValue(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEncryptedValue is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEncryptedValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEncryptedValue: %w", err)
+	}
+	return oldValue.EncryptedValue, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PeerReputation entities.
-func (m *PeerReputationMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetEncryptedValue resets all changes to the "encrypted_value" field.
+func (m *SecretMutation) ResetEncryptedValue() {
+	m.encrypted_value = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PeerReputationMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetCreatedAt sets the "created_at" field.
+func (m *SecretMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SecretMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PeerReputationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PeerReputation.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldCreatedAt returns the old "created_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// SetPeerDid sets the "peer_did" field.
-func (m *PeerReputationMutation) SetPeerDid(s string) {
-	m.peer_did = &s
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SecretMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// PeerDid returns the value of the "peer_did" field in the mutation.
-func (m *PeerReputationMutation) PeerDid() (r string, exists bool) {
-	v := m.peer_did
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SecretMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SecretMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPeerDid returns the old "peer_did" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldPeerDid(ctx context.Context) (v string, err error) {
+func (m *SecretMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPeerDid is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPeerDid requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPeerDid: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.PeerDid, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetPeerDid resets all changes to the "peer_did" field.
-func (m *PeerReputationMutation) ResetPeerDid() {
-	m.peer_did = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SecretMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetSuccessfulExchanges sets the "successful_exchanges" field.
-func (m *PeerReputationMutation) SetSuccessfulExchanges(i int) {
-	m.successful_exchanges = &i
-	m.addsuccessful_exchanges = nil
+// SetAccessCount sets the "access_count" field.
+func (m *SecretMutation) SetAccessCount(i int) {
+	m.access_count = &i
+	m.addaccess_count = nil
 }
 
-// SuccessfulExchanges returns the value of the "successful_exchanges" field in the mutation.
-func (m *PeerReputationMutation) SuccessfulExchanges() (r int, exists bool) {
-	v := m.successful_exchanges
+// AccessCount returns the value of the "access_count" field in the mutation.
+func (m *SecretMutation) AccessCount() (r int, exists bool) {
+	v := m.access_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSuccessfulExchanges returns the old "successful_exchanges" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessCount returns the old "access_count" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldSuccessfulExchanges(ctx context.Context) (v int, err error) {
+func (m *SecretMutation) OldAccessCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSuccessfulExchanges is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSuccessfulExchanges requires an ID field in the mutation")
+		return v, errors.New("OldAccessCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSuccessfulExchanges: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessCount: %w", err)
 	}
-	return oldValue.SuccessfulExchanges, nil
+	return oldValue.AccessCount, nil
 }
 
-// AddSuccessfulExchanges adds i to the "successful_exchanges" field.
-func (m *PeerReputationMutation) AddSuccessfulExchanges(i int) {
-	if m.addsuccessful_exchanges != nil {
-		*m.addsuccessful_exchanges += i
+// AddAccessCount adds i to the "access_count" field.
+func (m *SecretMutation) AddAccessCount(i int) {
+	if m.addaccess_count != nil {
+		*m.addaccess_count += i
 	} else {
-		m.addsuccessful_exchanges = &i
+		m.addaccess_count = &i
 	}
 }
 
-// AddedSuccessfulExchanges returns the value that was added to the "successful_exchanges" field in this mutation.
-func (m *PeerReputationMutation) AddedSuccessfulExchanges() (r int, exists bool) {
-	v := m.addsuccessful_exchanges
+// AddedAccessCount returns the value that was added to the "access_count" field in this mutation.
+func (m *SecretMutation) AddedAccessCount() (r int, exists bool) {
+	v := m.addaccess_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSuccessfulExchanges resets all changes to the "successful_exchanges" field.
-func (m *PeerReputationMutation) ResetSuccessfulExchanges() {
-	m.successful_exchanges = nil
-	m.addsuccessful_exchanges = nil
+// ResetAccessCount resets all changes to the "access_count" field.
+func (m *SecretMutation) ResetAccessCount() {
+	m.access_count = nil
+	m.addaccess_count = nil
 }
 
-// SetFailedExchanges sets the "failed_exchanges" field.
-func (m *PeerReputationMutation) SetFailedExchanges(i int) {
-	m.failed_exchanges = &i
-	m.addfailed_exchanges = nil
+// SetVersion sets the "version" field.
+func (m *SecretMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// FailedExchanges returns the value of the "failed_exchanges" field in the mutation.
-func (m *PeerReputationMutation) FailedExchanges() (r int, exists bool) {
-	v := m.failed_exchanges
+// Version returns the value of the "version" field in the mutation.
+func (m *SecretMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFailedExchanges returns the old "failed_exchanges" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldFailedExchanges(ctx context.Context) (v int, err error) {
+func (m *SecretMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFailedExchanges is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFailedExchanges requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFailedExchanges: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.FailedExchanges, nil
+	return oldValue.Version, nil
 }
 
-// AddFailedExchanges adds i to the "failed_exchanges" field.
-func (m *PeerReputationMutation) AddFailedExchanges(i int) {
-	if m.addfailed_exchanges != nil {
-		*m.addfailed_exchanges += i
+// AddVersion adds i to the "version" field.
+func (m *SecretMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
 	} else {
-		m.addfailed_exchanges = &i
+		m.addversion = &i
 	}
 }
 
-// AddedFailedExchanges returns the value that was added to the "failed_exchanges" field in this mutation.
-func (m *PeerReputationMutation) AddedFailedExchanges() (r int, exists bool) {
-	v := m.addfailed_exchanges
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *SecretMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetFailedExchanges resets all changes to the "failed_exchanges" field.
-func (m *PeerReputationMutation) ResetFailedExchanges() {
-	m.failed_exchanges = nil
-	m.addfailed_exchanges = nil
+// ResetVersion resets all changes to the "version" field.
+func (m *SecretMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// SetTimeoutCount sets the "timeout_count" field.
-func (m *PeerReputationMutation) SetTimeoutCount(i int) {
-	m.timeout_count = &i
-	m.addtimeout_count = nil
+// SetOwner sets the "owner" field.
+func (m *SecretMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// TimeoutCount returns the value of the "timeout_count" field in the mutation.
-func (m *PeerReputationMutation) TimeoutCount() (r int, exists bool) {
-	v := m.timeout_count
+// Owner returns the value of the "owner" field in the mutation.
+func (m *SecretMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimeoutCount returns the old "timeout_count" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldTimeoutCount(ctx context.Context) (v int, err error) {
+func (m *SecretMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeoutCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeoutCount requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeoutCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.TimeoutCount, nil
+	return oldValue.Owner, nil
 }
 
-// AddTimeoutCount adds i to the "timeout_count" field.
-func (m *PeerReputationMutation) AddTimeoutCount(i int) {
-	if m.addtimeout_count != nil {
-		*m.addtimeout_count += i
-	} else {
-		m.addtimeout_count = &i
-	}
+// ClearOwner clears the value of the "owner" field.
+func (m *SecretMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[secret.FieldOwner] = struct{}{}
 }
 
-// AddedTimeoutCount returns the value that was added to the "timeout_count" field in this mutation.
-func (m *PeerReputationMutation) AddedTimeoutCount() (r int, exists bool) {
-	v := m.addtimeout_count
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *SecretMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[secret.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *SecretMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, secret.FieldOwner)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SecretMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SecretMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTimeoutCount resets all changes to the "timeout_count" field.
-func (m *PeerReputationMutation) ResetTimeoutCount() {
-	m.timeout_count = nil
-	m.addtimeout_count = nil
+// OldExpiresAt returns the old "expires_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SecretMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[secret.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SecretMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[secret.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SecretMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, secret.FieldExpiresAt)
 }
 
-// SetTrustScore sets the "trust_score" field.
-func (m *PeerReputationMutation) SetTrustScore(f float64) {
-	m.trust_score = &f
-	m.addtrust_score = nil
+// SetRotationInterval sets the "rotation_interval" field.
+func (m *SecretMutation) SetRotationInterval(t time.Duration) {
+	m.rotation_interval = &t
+	m.addrotation_interval = nil
 }
 
-// TrustScore returns the value of the "trust_score" field in the mutation.
-func (m *PeerReputationMutation) TrustScore() (r float64, exists bool) {
-	v := m.trust_score
+// RotationInterval returns the value of the "rotation_interval" field in the mutation.
+func (m *SecretMutation) RotationInterval() (r time.Duration, exists bool) {
+	v := m.rotation_interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrustScore returns the old "trust_score" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldRotationInterval returns the old "rotation_interval" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldTrustScore(ctx context.Context) (v float64, err error) {
+func (m *SecretMutation) OldRotationInterval(ctx context.Context) (v time.Duration, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrustScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldRotationInterval is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrustScore requires an ID field in the mutation")
+		return v, errors.New("OldRotationInterval requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrustScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldRotationInterval: %w", err)
 	}
-	return oldValue.TrustScore, nil
+	return oldValue.RotationInterval, nil
 }
 
-// AddTrustScore adds f to the "trust_score" field.
-func (m *PeerReputationMutation) AddTrustScore(f float64) {
-	if m.addtrust_score != nil {
-		*m.addtrust_score += f
+// AddRotationInterval adds t to the "rotation_interval" field.
+func (m *SecretMutation) AddRotationInterval(t time.Duration) {
+	if m.addrotation_interval != nil {
+		*m.addrotation_interval += t
 	} else {
-		m.addtrust_score = &f
+		m.addrotation_interval = &t
 	}
 }
 
-// AddedTrustScore returns the value that was added to the "trust_score" field in this mutation.
-func (m *PeerReputationMutation) AddedTrustScore() (r float64, exists bool) {
-	v := m.addtrust_score
+// AddedRotationInterval returns the value that was added to the "rotation_interval" field in this mutation.
+func (m *SecretMutation) AddedRotationInterval() (r time.Duration, exists bool) {
+	v := m.addrotation_interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTrustScore resets all changes to the "trust_score" field.
-func (m *PeerReputationMutation) ResetTrustScore() {
-	m.trust_score = nil
-	m.addtrust_score = nil
+// ClearRotationInterval clears the value of the "rotation_interval" field.
+func (m *SecretMutation) ClearRotationInterval() {
+	m.rotation_interval = nil
+	m.addrotation_interval = nil
+	m.clearedFields[secret.FieldRotationInterval] = struct{}{}
 }
 
-// SetFirstSeen sets the "first_seen" field.
-func (m *PeerReputationMutation) SetFirstSeen(t time.Time) {
-	m.first_seen = &t
+// RotationIntervalCleared returns if the "rotation_interval" field was cleared in this mutation.
+func (m *SecretMutation) RotationIntervalCleared() bool {
+	_, ok := m.clearedFields[secret.FieldRotationInterval]
+	return ok
 }
 
-// FirstSeen returns the value of the "first_seen" field in the mutation.
-func (m *PeerReputationMutation) FirstSeen() (r time.Time, exists bool) {
-	v := m.first_seen
+// ResetRotationInterval resets all changes to the "rotation_interval" field.
+func (m *SecretMutation) ResetRotationInterval() {
+	m.rotation_interval = nil
+	m.addrotation_interval = nil
+	delete(m.clearedFields, secret.FieldRotationInterval)
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (m *SecretMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
+}
+
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *SecretMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFirstSeen returns the old "first_seen" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldRotatedAt returns the old "rotated_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldFirstSeen(ctx context.Context) (v time.Time, err error) {
+func (m *SecretMutation) OldRotatedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstSeen is only allowed on UpdateOne operations")
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstSeen requires an ID field in the mutation")
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstSeen: %w", err)
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
 	}
-	return oldValue.FirstSeen, nil
+	return oldValue.RotatedAt, nil
 }
 
-// ResetFirstSeen resets all changes to the "first_seen" field.
-func (m *PeerReputationMutation) ResetFirstSeen() {
-	m.first_seen = nil
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (m *SecretMutation) ClearRotatedAt() {
+	m.rotated_at = nil
+	m.clearedFields[secret.FieldRotatedAt] = struct{}{}
 }
 
-// SetLastInteraction sets the "last_interaction" field.
-func (m *PeerReputationMutation) SetLastInteraction(t time.Time) {
-	m.last_interaction = &t
+// RotatedAtCleared returns if the "rotated_at" field was cleared in this mutation.
+func (m *SecretMutation) RotatedAtCleared() bool {
+	_, ok := m.clearedFields[secret.FieldRotatedAt]
+	return ok
 }
 
-// LastInteraction returns the value of the "last_interaction" field in the mutation.
-func (m *PeerReputationMutation) LastInteraction() (r time.Time, exists bool) {
-	v := m.last_interaction
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *SecretMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+	delete(m.clearedFields, secret.FieldRotatedAt)
+}
+
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (m *SecretMutation) SetExpiryNotifiedAt(t time.Time) {
+	m.expiry_notified_at = &t
+}
+
+// ExpiryNotifiedAt returns the value of the "expiry_notified_at" field in the mutation.
+func (m *SecretMutation) ExpiryNotifiedAt() (r time.Time, exists bool) {
+	v := m.expiry_notified_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastInteraction returns the old "last_interaction" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiryNotifiedAt returns the old "expiry_notified_at" field's value of the Secret entity.
+// If the Secret object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldLastInteraction(ctx context.Context) (v time.Time, err error) {
+func (m *SecretMutation) OldExpiryNotifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastInteraction is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiryNotifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastInteraction requires an ID field in the mutation")
+		return v, errors.New("OldExpiryNotifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastInteraction: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiryNotifiedAt: %w", err)
 	}
-	return oldValue.LastInteraction, nil
+	return oldValue.ExpiryNotifiedAt, nil
 }
 
-// ResetLastInteraction resets all changes to the "last_interaction" field.
-func (m *PeerReputationMutation) ResetLastInteraction() {
-	m.last_interaction = nil
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (m *SecretMutation) ClearExpiryNotifiedAt() {
+	m.expiry_notified_at = nil
+	m.clearedFields[secret.FieldExpiryNotifiedAt] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PeerReputationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ExpiryNotifiedAtCleared returns if the "expiry_notified_at" field was cleared in this mutation.
+func (m *SecretMutation) ExpiryNotifiedAtCleared() bool {
+	_, ok := m.clearedFields[secret.FieldExpiryNotifiedAt]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PeerReputationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetExpiryNotifiedAt resets all changes to the "expiry_notified_at" field.
+func (m *SecretMutation) ResetExpiryNotifiedAt() {
+	m.expiry_notified_at = nil
+	delete(m.clearedFields, secret.FieldExpiryNotifiedAt)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// SetKeyID sets the "key" edge to the Key entity by id.
+func (m *SecretMutation) SetKeyID(id uuid.UUID) {
+	m.key = &id
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PeerReputationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearKey clears the "key" edge to the Key entity.
+func (m *SecretMutation) ClearKey() {
+	m.clearedkey = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PeerReputationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// KeyCleared reports if the "key" edge to the Key entity was cleared.
+func (m *SecretMutation) KeyCleared() bool {
+	return m.clearedkey
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PeerReputationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// KeyID returns the "key" edge ID in the mutation.
+func (m *SecretMutation) KeyID() (id uuid.UUID, exists bool) {
+	if m.key != nil {
+		return *m.key, true
 	}
-	return *v, true
+	return
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PeerReputation entity.
-// If the PeerReputation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PeerReputationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// KeyIDs returns the "key" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// KeyID instead. It exists only for internal usage by the builders.
+func (m *SecretMutation) KeyIDs() (ids []uuid.UUID) {
+	if id := m.key; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PeerReputationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetKey resets all changes to the "key" edge.
+func (m *SecretMutation) ResetKey() {
+	m.key = nil
+	m.clearedkey = false
 }
 
-// Where appends a list predicates to the PeerReputationMutation builder.
-func (m *PeerReputationMutation) Where(ps ...predicate.PeerReputation) {
+// Where appends a list predicates to the SecretMutation builder.
+func (m *SecretMutation) Where(ps ...predicate.Secret) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PeerReputationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SecretMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PeerReputationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PeerReputation, len(ps))
+func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Secret, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10551,51 +15102,57 @@ func (m *PeerReputationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PeerReputationMutation) Op() Op {
+func (m *SecretMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PeerReputationMutation) SetOp(op Op) {
+func (m *SecretMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PeerReputation).
-func (m *PeerReputationMutation) Type() string {
+// Type returns the node type of this mutation (Secret).
+func (m *SecretMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PeerReputationMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.peer_did != nil {
-		fields = append(fields, peerreputation.FieldPeerDid)
+func (m *SecretMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, secret.FieldName)
 	}
-	if m.successful_exchanges != nil {
-		fields = append(fields, peerreputation.FieldSuccessfulExchanges)
+	if m.encrypted_value != nil {
+		fields = append(fields, secret.FieldEncryptedValue)
 	}
-	if m.failed_exchanges != nil {
-		fields = append(fields, peerreputation.FieldFailedExchanges)
+	if m.created_at != nil {
+		fields = append(fields, secret.FieldCreatedAt)
 	}
-	if m.timeout_count != nil {
-		fields = append(fields, peerreputation.FieldTimeoutCount)
+	if m.updated_at != nil {
+		fields = append(fields, secret.FieldUpdatedAt)
 	}
-	if m.trust_score != nil {
-		fields = append(fields, peerreputation.FieldTrustScore)
+	if m.access_count != nil {
+		fields = append(fields, secret.FieldAccessCount)
 	}
-	if m.first_seen != nil {
-		fields = append(fields, peerreputation.FieldFirstSeen)
+	if m.version != nil {
+		fields = append(fields, secret.FieldVersion)
 	}
-	if m.last_interaction != nil {
-		fields = append(fields, peerreputation.FieldLastInteraction)
+	if m.owner != nil {
+		fields = append(fields, secret.FieldOwner)
 	}
-	if m.created_at != nil {
-		fields = append(fields, peerreputation.FieldCreatedAt)
+	if m.expires_at != nil {
+		fields = append(fields, secret.FieldExpiresAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, peerreputation.FieldUpdatedAt)
+	if m.rotation_interval != nil {
+		fields = append(fields, secret.FieldRotationInterval)
+	}
+	if m.rotated_at != nil {
+		fields = append(fields, secret.FieldRotatedAt)
+	}
+	if m.expiry_notified_at != nil {
+		fields = append(fields, secret.FieldExpiryNotifiedAt)
 	}
 	return fields
 }
@@ -10603,144 +15160,163 @@ func (m *PeerReputationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PeerReputationMutation) Field(name string) (ent.Value, bool) {
+func (m *SecretMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case peerreputation.FieldPeerDid:
-		return m.PeerDid()
-	case peerreputation.FieldSuccessfulExchanges:
-		return m.SuccessfulExchanges()
-	case peerreputation.FieldFailedExchanges:
-		return m.FailedExchanges()
-	case peerreputation.FieldTimeoutCount:
-		return m.TimeoutCount()
-	case peerreputation.FieldTrustScore:
-		return m.TrustScore()
-	case peerreputation.FieldFirstSeen:
-		return m.FirstSeen()
-	case peerreputation.FieldLastInteraction:
-		return m.LastInteraction()
-	case peerreputation.FieldCreatedAt:
+	case secret.FieldName:
+		return m.Name()
+	case secret.FieldEncryptedValue:
+		return m.EncryptedValue()
+	case secret.FieldCreatedAt:
 		return m.CreatedAt()
-	case peerreputation.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PeerReputationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case peerreputation.FieldPeerDid:
-		return m.OldPeerDid(ctx)
-	case peerreputation.FieldSuccessfulExchanges:
-		return m.OldSuccessfulExchanges(ctx)
-	case peerreputation.FieldFailedExchanges:
-		return m.OldFailedExchanges(ctx)
-	case peerreputation.FieldTimeoutCount:
-		return m.OldTimeoutCount(ctx)
-	case peerreputation.FieldTrustScore:
-		return m.OldTrustScore(ctx)
-	case peerreputation.FieldFirstSeen:
-		return m.OldFirstSeen(ctx)
-	case peerreputation.FieldLastInteraction:
-		return m.OldLastInteraction(ctx)
-	case peerreputation.FieldCreatedAt:
+	case secret.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case secret.FieldAccessCount:
+		return m.AccessCount()
+	case secret.FieldVersion:
+		return m.Version()
+	case secret.FieldOwner:
+		return m.Owner()
+	case secret.FieldExpiresAt:
+		return m.ExpiresAt()
+	case secret.FieldRotationInterval:
+		return m.RotationInterval()
+	case secret.FieldRotatedAt:
+		return m.RotatedAt()
+	case secret.FieldExpiryNotifiedAt:
+		return m.ExpiryNotifiedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case secret.FieldName:
+		return m.OldName(ctx)
+	case secret.FieldEncryptedValue:
+		return m.OldEncryptedValue(ctx)
+	case secret.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case peerreputation.FieldUpdatedAt:
+	case secret.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case secret.FieldAccessCount:
+		return m.OldAccessCount(ctx)
+	case secret.FieldVersion:
+		return m.OldVersion(ctx)
+	case secret.FieldOwner:
+		return m.OldOwner(ctx)
+	case secret.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case secret.FieldRotationInterval:
+		return m.OldRotationInterval(ctx)
+	case secret.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
+	case secret.FieldExpiryNotifiedAt:
+		return m.OldExpiryNotifiedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PeerReputation field %s", name)
+	return nil, fmt.Errorf("unknown Secret field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PeerReputationMutation) SetField(name string, value ent.Value) error {
+func (m *SecretMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case peerreputation.FieldPeerDid:
+	case secret.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPeerDid(v)
+		m.SetName(v)
 		return nil
-	case peerreputation.FieldSuccessfulExchanges:
-		v, ok := value.(int)
+	case secret.FieldEncryptedValue:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSuccessfulExchanges(v)
+		m.SetEncryptedValue(v)
 		return nil
-	case peerreputation.FieldFailedExchanges:
+	case secret.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case secret.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case secret.FieldAccessCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFailedExchanges(v)
+		m.SetAccessCount(v)
 		return nil
-	case peerreputation.FieldTimeoutCount:
+	case secret.FieldVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimeoutCount(v)
+		m.SetVersion(v)
 		return nil
-	case peerreputation.FieldTrustScore:
-		v, ok := value.(float64)
+	case secret.FieldOwner:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrustScore(v)
+		m.SetOwner(v)
 		return nil
-	case peerreputation.FieldFirstSeen:
+	case secret.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFirstSeen(v)
+		m.SetExpiresAt(v)
 		return nil
-	case peerreputation.FieldLastInteraction:
-		v, ok := value.(time.Time)
+	case secret.FieldRotationInterval:
+		v, ok := value.(time.Duration)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastInteraction(v)
+		m.SetRotationInterval(v)
 		return nil
-	case peerreputation.FieldCreatedAt:
+	case secret.FieldRotatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRotatedAt(v)
 		return nil
-	case peerreputation.FieldUpdatedAt:
+	case secret.FieldExpiryNotifiedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetExpiryNotifiedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PeerReputation field %s", name)
+	return fmt.Errorf("unknown Secret field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PeerReputationMutation) AddedFields() []string {
+func (m *SecretMutation) AddedFields() []string {
 	var fields []string
-	if m.addsuccessful_exchanges != nil {
-		fields = append(fields, peerreputation.FieldSuccessfulExchanges)
-	}
-	if m.addfailed_exchanges != nil {
-		fields = append(fields, peerreputation.FieldFailedExchanges)
+	if m.addaccess_count != nil {
+		fields = append(fields, secret.FieldAccessCount)
 	}
-	if m.addtimeout_count != nil {
-		fields = append(fields, peerreputation.FieldTimeoutCount)
+	if m.addversion != nil {
+		fields = append(fields, secret.FieldVersion)
 	}
-	if m.addtrust_score != nil {
-		fields = append(fields, peerreputation.FieldTrustScore)
+	if m.addrotation_interval != nil {
+		fields = append(fields, secret.FieldRotationInterval)
 	}
 	return fields
 }
@@ -10748,16 +15324,14 @@ func (m *PeerReputationMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PeerReputationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SecretMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case peerreputation.FieldSuccessfulExchanges:
-		return m.AddedSuccessfulExchanges()
-	case peerreputation.FieldFailedExchanges:
-		return m.AddedFailedExchanges()
-	case peerreputation.FieldTimeoutCount:
-		return m.AddedTimeoutCount()
-	case peerreputation.FieldTrustScore:
-		return m.AddedTrustScore()
+	case secret.FieldAccessCount:
+		return m.AddedAccessCount()
+	case secret.FieldVersion:
+		return m.AddedVersion()
+	case secret.FieldRotationInterval:
+		return m.AddedRotationInterval()
 	}
 	return nil, false
 }
@@ -10765,172 +15339,230 @@ func (m *PeerReputationMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PeerReputationMutation) AddField(name string, value ent.Value) error {
+func (m *SecretMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case peerreputation.FieldSuccessfulExchanges:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSuccessfulExchanges(v)
-		return nil
-	case peerreputation.FieldFailedExchanges:
+	case secret.FieldAccessCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddFailedExchanges(v)
+		m.AddAccessCount(v)
 		return nil
-	case peerreputation.FieldTimeoutCount:
+	case secret.FieldVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTimeoutCount(v)
+		m.AddVersion(v)
 		return nil
-	case peerreputation.FieldTrustScore:
-		v, ok := value.(float64)
+	case secret.FieldRotationInterval:
+		v, ok := value.(time.Duration)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTrustScore(v)
+		m.AddRotationInterval(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PeerReputation numeric field %s", name)
+	return fmt.Errorf("unknown Secret numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PeerReputationMutation) ClearedFields() []string {
-	return nil
+func (m *SecretMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(secret.FieldOwner) {
+		fields = append(fields, secret.FieldOwner)
+	}
+	if m.FieldCleared(secret.FieldExpiresAt) {
+		fields = append(fields, secret.FieldExpiresAt)
+	}
+	if m.FieldCleared(secret.FieldRotationInterval) {
+		fields = append(fields, secret.FieldRotationInterval)
+	}
+	if m.FieldCleared(secret.FieldRotatedAt) {
+		fields = append(fields, secret.FieldRotatedAt)
+	}
+	if m.FieldCleared(secret.FieldExpiryNotifiedAt) {
+		fields = append(fields, secret.FieldExpiryNotifiedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PeerReputationMutation) FieldCleared(name string) bool {
+func (m *SecretMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PeerReputationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PeerReputation nullable field %s", name)
+func (m *SecretMutation) ClearField(name string) error {
+	switch name {
+	case secret.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case secret.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case secret.FieldRotationInterval:
+		m.ClearRotationInterval()
+		return nil
+	case secret.FieldRotatedAt:
+		m.ClearRotatedAt()
+		return nil
+	case secret.FieldExpiryNotifiedAt:
+		m.ClearExpiryNotifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PeerReputationMutation) ResetField(name string) error {
+func (m *SecretMutation) ResetField(name string) error {
 	switch name {
-	case peerreputation.FieldPeerDid:
-		m.ResetPeerDid()
+	case secret.FieldName:
+		m.ResetName()
 		return nil
-	case peerreputation.FieldSuccessfulExchanges:
-		m.ResetSuccessfulExchanges()
+	case secret.FieldEncryptedValue:
+		m.ResetEncryptedValue()
 		return nil
-	case peerreputation.FieldFailedExchanges:
-		m.ResetFailedExchanges()
+	case secret.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case peerreputation.FieldTimeoutCount:
-		m.ResetTimeoutCount()
+	case secret.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case peerreputation.FieldTrustScore:
-		m.ResetTrustScore()
+	case secret.FieldAccessCount:
+		m.ResetAccessCount()
 		return nil
-	case peerreputation.FieldFirstSeen:
-		m.ResetFirstSeen()
+	case secret.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case peerreputation.FieldLastInteraction:
-		m.ResetLastInteraction()
+	case secret.FieldOwner:
+		m.ResetOwner()
 		return nil
-	case peerreputation.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case secret.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case peerreputation.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case secret.FieldRotationInterval:
+		m.ResetRotationInterval()
+		return nil
+	case secret.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
+	case secret.FieldExpiryNotifiedAt:
+		m.ResetExpiryNotifiedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PeerReputation field %s", name)
+	return fmt.Errorf("unknown Secret field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PeerReputationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SecretMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.key != nil {
+		edges = append(edges, secret.EdgeKey)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PeerReputationMutation) AddedIDs(name string) []ent.Value {
+func (m *SecretMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case secret.EdgeKey:
+		if id := m.key; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PeerReputationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SecretMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PeerReputationMutation) RemovedIDs(name string) []ent.Value {
+func (m *SecretMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PeerReputationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SecretMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedkey {
+		edges = append(edges, secret.EdgeKey)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PeerReputationMutation) EdgeCleared(name string) bool {
+func (m *SecretMutation) EdgeCleared(name string) bool {
+	switch name {
+	case secret.EdgeKey:
+		return m.clearedkey
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PeerReputationMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown PeerReputation unique edge %s", name)
+func (m *SecretMutation) ClearEdge(name string) error {
+	switch name {
+	case secret.EdgeKey:
+		m.ClearKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PeerReputationMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown PeerReputation edge %s", name)
+func (m *SecretMutation) ResetEdge(name string) error {
+	switch name {
+	case secret.EdgeKey:
+		m.ResetKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Secret edge %s", name)
 }
 
-// ReflectionMutation represents an operation that mutates the Reflection nodes in the graph.
-type ReflectionMutation struct {
+// SecretVersionMutation represents an operation that mutates the SecretVersion nodes in the graph.
+type SecretVersionMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	session_key    *string
-	content        *string
-	token_count    *int
-	addtoken_count *int
-	generation     *int
-	addgeneration  *int
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*Reflection, error)
-	predicates     []predicate.Reflection
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	secret_name     *string
+	version         *int
+	addversion      *int
+	encrypted_value *[]byte
+	key_id          *uuid.UUID
+	created_at      *time.Time
+	retired_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*SecretVersion, error)
+	predicates      []predicate.SecretVersion
 }
 
-var _ ent.Mutation = (*ReflectionMutation)(nil)
+var _ ent.Mutation = (*SecretVersionMutation)(nil)
 
-// reflectionOption allows management of the mutation configuration using functional options.
-type reflectionOption func(*ReflectionMutation)
+// secretversionOption allows management of the mutation configuration using functional options.
+type secretversionOption func(*SecretVersionMutation)
 
-// newReflectionMutation creates new mutation for the Reflection entity.
-func newReflectionMutation(c config, op Op, opts ...reflectionOption) *ReflectionMutation {
-	m := &ReflectionMutation{
+// newSecretVersionMutation creates new mutation for the SecretVersion entity.
+func newSecretVersionMutation(c config, op Op, opts ...secretversionOption) *SecretVersionMutation {
+	m := &SecretVersionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeReflection,
+		typ:           TypeSecretVersion,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -10939,20 +15571,20 @@ func newReflectionMutation(c config, op Op, opts ...reflectionOption) *Reflectio
 	return m
 }
 
-// withReflectionID sets the ID field of the mutation.
-func withReflectionID(id uuid.UUID) reflectionOption {
-	return func(m *ReflectionMutation) {
+// withSecretVersionID sets the ID field of the mutation.
+func withSecretVersionID(id uuid.UUID) secretversionOption {
+	return func(m *SecretVersionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Reflection
+			value *SecretVersion
 		)
-		m.oldValue = func(ctx context.Context) (*Reflection, error) {
+		m.oldValue = func(ctx context.Context) (*SecretVersion, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Reflection.Get(ctx, id)
+					value, err = m.Client().SecretVersion.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -10961,10 +15593,10 @@ func withReflectionID(id uuid.UUID) reflectionOption {
 	}
 }
 
-// withReflection sets the old Reflection of the mutation.
-func withReflection(node *Reflection) reflectionOption {
-	return func(m *ReflectionMutation) {
-		m.oldValue = func(context.Context) (*Reflection, error) {
+// withSecretVersion sets the old SecretVersion of the mutation.
+func withSecretVersion(node *SecretVersion) secretversionOption {
+	return func(m *SecretVersionMutation) {
+		m.oldValue = func(context.Context) (*SecretVersion, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -10973,7 +15605,7 @@ func withReflection(node *Reflection) reflectionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReflectionMutation) Client() *Client {
+func (m SecretVersionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -10981,7 +15613,7 @@ func (m ReflectionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ReflectionMutation) Tx() (*Tx, error) {
+func (m SecretVersionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -10991,14 +15623,14 @@ func (m ReflectionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Reflection entities.
-func (m *ReflectionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of SecretVersion entities.
+func (m *SecretVersionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ReflectionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *SecretVersionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11009,7 +15641,7 @@ func (m *ReflectionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ReflectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *SecretVersionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11018,203 +15650,183 @@ func (m *ReflectionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Reflection.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().SecretVersion.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSessionKey sets the "session_key" field.
-func (m *ReflectionMutation) SetSessionKey(s string) {
-	m.session_key = &s
+// SetSecretName sets the "secret_name" field.
+func (m *SecretVersionMutation) SetSecretName(s string) {
+	m.secret_name = &s
 }
 
-// SessionKey returns the value of the "session_key" field in the mutation.
-func (m *ReflectionMutation) SessionKey() (r string, exists bool) {
-	v := m.session_key
+// SecretName returns the value of the "secret_name" field in the mutation.
+func (m *SecretVersionMutation) SecretName() (r string, exists bool) {
+	v := m.secret_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionKey returns the old "session_key" field's value of the Reflection entity.
-// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretName returns the old "secret_name" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReflectionMutation) OldSessionKey(ctx context.Context) (v string, err error) {
+func (m *SecretVersionMutation) OldSecretName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionKey requires an ID field in the mutation")
+		return v, errors.New("OldSecretName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretName: %w", err)
 	}
-	return oldValue.SessionKey, nil
+	return oldValue.SecretName, nil
 }
 
-// ResetSessionKey resets all changes to the "session_key" field.
-func (m *ReflectionMutation) ResetSessionKey() {
-	m.session_key = nil
+// ResetSecretName resets all changes to the "secret_name" field.
+func (m *SecretVersionMutation) ResetSecretName() {
+	m.secret_name = nil
 }
 
-// SetContent sets the "content" field.
-func (m *ReflectionMutation) SetContent(s string) {
-	m.content = &s
+// SetVersion sets the "version" field.
+func (m *SecretVersionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ReflectionMutation) Content() (r string, exists bool) {
-	v := m.content
+// Version returns the value of the "version" field in the mutation.
+func (m *SecretVersionMutation) Version() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Reflection entity.
-// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReflectionMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *SecretVersionMutation) OldVersion(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Version, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ReflectionMutation) ResetContent() {
-	m.content = nil
+// AddVersion adds i to the "version" field.
+func (m *SecretVersionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetTokenCount sets the "token_count" field.
-func (m *ReflectionMutation) SetTokenCount(i int) {
-	m.token_count = &i
-	m.addtoken_count = nil
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *SecretVersionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TokenCount returns the value of the "token_count" field in the mutation.
-func (m *ReflectionMutation) TokenCount() (r int, exists bool) {
-	v := m.token_count
+// ResetVersion resets all changes to the "version" field.
+func (m *SecretVersionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetEncryptedValue sets the "encrypted_value" field.
+func (m *SecretVersionMutation) SetEncryptedValue(b []byte) {
+	m.encrypted_value = &b
+}
+
+// EncryptedValue returns the value of the "encrypted_value" field in the mutation.
+func (m *SecretVersionMutation) EncryptedValue() (r []byte, exists bool) {
+	v := m.encrypted_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenCount returns the old "token_count" field's value of the Reflection entity.
-// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
+// OldEncryptedValue returns the old "encrypted_value" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReflectionMutation) OldTokenCount(ctx context.Context) (v int, err error) {
+func (m *SecretVersionMutation) OldEncryptedValue(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldEncryptedValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenCount requires an ID field in the mutation")
+		return v, errors.New("OldEncryptedValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenCount: %w", err)
-	}
-	return oldValue.TokenCount, nil
-}
-
-// AddTokenCount adds i to the "token_count" field.
-func (m *ReflectionMutation) AddTokenCount(i int) {
-	if m.addtoken_count != nil {
-		*m.addtoken_count += i
-	} else {
-		m.addtoken_count = &i
-	}
-}
-
-// AddedTokenCount returns the value that was added to the "token_count" field in this mutation.
-func (m *ReflectionMutation) AddedTokenCount() (r int, exists bool) {
-	v := m.addtoken_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldEncryptedValue: %w", err)
 	}
-	return *v, true
+	return oldValue.EncryptedValue, nil
 }
 
-// ResetTokenCount resets all changes to the "token_count" field.
-func (m *ReflectionMutation) ResetTokenCount() {
-	m.token_count = nil
-	m.addtoken_count = nil
+// ResetEncryptedValue resets all changes to the "encrypted_value" field.
+func (m *SecretVersionMutation) ResetEncryptedValue() {
+	m.encrypted_value = nil
 }
 
-// SetGeneration sets the "generation" field.
-func (m *ReflectionMutation) SetGeneration(i int) {
-	m.generation = &i
-	m.addgeneration = nil
+// SetKeyID sets the "key_id" field.
+func (m *SecretVersionMutation) SetKeyID(u uuid.UUID) {
+	m.key_id = &u
 }
 
-// Generation returns the value of the "generation" field in the mutation.
-func (m *ReflectionMutation) Generation() (r int, exists bool) {
-	v := m.generation
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *SecretVersionMutation) KeyID() (r uuid.UUID, exists bool) {
+	v := m.key_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGeneration returns the old "generation" field's value of the Reflection entity.
-// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
+// OldKeyID returns the old "key_id" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReflectionMutation) OldGeneration(ctx context.Context) (v int, err error) {
+func (m *SecretVersionMutation) OldKeyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGeneration is only allowed on UpdateOne operations")
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGeneration requires an ID field in the mutation")
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGeneration: %w", err)
-	}
-	return oldValue.Generation, nil
-}
-
-// AddGeneration adds i to the "generation" field.
-func (m *ReflectionMutation) AddGeneration(i int) {
-	if m.addgeneration != nil {
-		*m.addgeneration += i
-	} else {
-		m.addgeneration = &i
-	}
-}
-
-// AddedGeneration returns the value that was added to the "generation" field in this mutation.
-func (m *ReflectionMutation) AddedGeneration() (r int, exists bool) {
-	v := m.addgeneration
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
 	}
-	return *v, true
+	return oldValue.KeyID, nil
 }
 
-// ResetGeneration resets all changes to the "generation" field.
-func (m *ReflectionMutation) ResetGeneration() {
-	m.generation = nil
-	m.addgeneration = nil
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *SecretVersionMutation) ResetKeyID() {
+	m.key_id = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ReflectionMutation) SetCreatedAt(t time.Time) {
+func (m *SecretVersionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReflectionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SecretVersionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -11222,10 +15834,10 @@ func (m *ReflectionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Reflection entity.
-// If the Reflection object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReflectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SecretVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -11240,19 +15852,55 @@ func (m *ReflectionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReflectionMutation) ResetCreatedAt() {
+func (m *SecretVersionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the ReflectionMutation builder.
-func (m *ReflectionMutation) Where(ps ...predicate.Reflection) {
+// SetRetiredAt sets the "retired_at" field.
+func (m *SecretVersionMutation) SetRetiredAt(t time.Time) {
+	m.retired_at = &t
+}
+
+// RetiredAt returns the value of the "retired_at" field in the mutation.
+func (m *SecretVersionMutation) RetiredAt() (r time.Time, exists bool) {
+	v := m.retired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetiredAt returns the old "retired_at" field's value of the SecretVersion entity.
+// If the SecretVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SecretVersionMutation) OldRetiredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredAt: %w", err)
+	}
+	return oldValue.RetiredAt, nil
+}
+
+// ResetRetiredAt resets all changes to the "retired_at" field.
+func (m *SecretVersionMutation) ResetRetiredAt() {
+	m.retired_at = nil
+}
+
+// Where appends a list predicates to the SecretVersionMutation builder.
+func (m *SecretVersionMutation) Where(ps ...predicate.SecretVersion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReflectionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SecretVersionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReflectionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Reflection, len(ps))
+func (m *SecretVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SecretVersion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11260,39 +15908,42 @@ func (m *ReflectionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReflectionMutation) Op() Op {
+func (m *SecretVersionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReflectionMutation) SetOp(op Op) {
+func (m *SecretVersionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Reflection).
-func (m *ReflectionMutation) Type() string {
+// Type returns the node type of this mutation (SecretVersion).
+func (m *SecretVersionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReflectionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.session_key != nil {
-		fields = append(fields, reflection.FieldSessionKey)
+func (m *SecretVersionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.secret_name != nil {
+		fields = append(fields, secretversion.FieldSecretName)
 	}
-	if m.content != nil {
-		fields = append(fields, reflection.FieldContent)
+	if m.version != nil {
+		fields = append(fields, secretversion.FieldVersion)
 	}
-	if m.token_count != nil {
-		fields = append(fields, reflection.FieldTokenCount)
+	if m.encrypted_value != nil {
+		fields = append(fields, secretversion.FieldEncryptedValue)
 	}
-	if m.generation != nil {
-		fields = append(fields, reflection.FieldGeneration)
+	if m.key_id != nil {
+		fields = append(fields, secretversion.FieldKeyID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, reflection.FieldCreatedAt)
+		fields = append(fields, secretversion.FieldCreatedAt)
+	}
+	if m.retired_at != nil {
+		fields = append(fields, secretversion.FieldRetiredAt)
 	}
 	return fields
 }
@@ -11300,18 +15951,20 @@ func (m *ReflectionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReflectionMutation) Field(name string) (ent.Value, bool) {
+func (m *SecretVersionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case reflection.FieldSessionKey:
-		return m.SessionKey()
-	case reflection.FieldContent:
-		return m.Content()
-	case reflection.FieldTokenCount:
-		return m.TokenCount()
-	case reflection.FieldGeneration:
-		return m.Generation()
-	case reflection.FieldCreatedAt:
+	case secretversion.FieldSecretName:
+		return m.SecretName()
+	case secretversion.FieldVersion:
+		return m.Version()
+	case secretversion.FieldEncryptedValue:
+		return m.EncryptedValue()
+	case secretversion.FieldKeyID:
+		return m.KeyID()
+	case secretversion.FieldCreatedAt:
 		return m.CreatedAt()
+	case secretversion.FieldRetiredAt:
+		return m.RetiredAt()
 	}
 	return nil, false
 }
@@ -11319,75 +15972,81 @@ func (m *ReflectionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReflectionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SecretVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case reflection.FieldSessionKey:
-		return m.OldSessionKey(ctx)
-	case reflection.FieldContent:
-		return m.OldContent(ctx)
-	case reflection.FieldTokenCount:
-		return m.OldTokenCount(ctx)
-	case reflection.FieldGeneration:
-		return m.OldGeneration(ctx)
-	case reflection.FieldCreatedAt:
+	case secretversion.FieldSecretName:
+		return m.OldSecretName(ctx)
+	case secretversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case secretversion.FieldEncryptedValue:
+		return m.OldEncryptedValue(ctx)
+	case secretversion.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case secretversion.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case secretversion.FieldRetiredAt:
+		return m.OldRetiredAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Reflection field %s", name)
+	return nil, fmt.Errorf("unknown SecretVersion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReflectionMutation) SetField(name string, value ent.Value) error {
+func (m *SecretVersionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case reflection.FieldSessionKey:
+	case secretversion.FieldSecretName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionKey(v)
+		m.SetSecretName(v)
 		return nil
-	case reflection.FieldContent:
-		v, ok := value.(string)
+	case secretversion.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetVersion(v)
 		return nil
-	case reflection.FieldTokenCount:
-		v, ok := value.(int)
+	case secretversion.FieldEncryptedValue:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenCount(v)
+		m.SetEncryptedValue(v)
 		return nil
-	case reflection.FieldGeneration:
-		v, ok := value.(int)
+	case secretversion.FieldKeyID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGeneration(v)
+		m.SetKeyID(v)
 		return nil
-	case reflection.FieldCreatedAt:
+	case secretversion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case secretversion.FieldRetiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetiredAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Reflection field %s", name)
+	return fmt.Errorf("unknown SecretVersion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReflectionMutation) AddedFields() []string {
+func (m *SecretVersionMutation) AddedFields() []string {
 	var fields []string
-	if m.addtoken_count != nil {
-		fields = append(fields, reflection.FieldTokenCount)
-	}
-	if m.addgeneration != nil {
-		fields = append(fields, reflection.FieldGeneration)
+	if m.addversion != nil {
+		fields = append(fields, secretversion.FieldVersion)
 	}
 	return fields
 }
@@ -11395,12 +16054,10 @@ func (m *ReflectionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReflectionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SecretVersionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case reflection.FieldTokenCount:
-		return m.AddedTokenCount()
-	case reflection.FieldGeneration:
-		return m.AddedGeneration()
+	case secretversion.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -11408,319 +16065,521 @@ func (m *ReflectionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReflectionMutation) AddField(name string, value ent.Value) error {
+func (m *SecretVersionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case reflection.FieldTokenCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTokenCount(v)
-		return nil
-	case reflection.FieldGeneration:
+	case secretversion.FieldVersion:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddGeneration(v)
+		m.AddVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Reflection numeric field %s", name)
+	return fmt.Errorf("unknown SecretVersion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReflectionMutation) ClearedFields() []string {
+func (m *SecretVersionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReflectionMutation) FieldCleared(name string) bool {
+func (m *SecretVersionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReflectionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Reflection nullable field %s", name)
+func (m *SecretVersionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SecretVersion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReflectionMutation) ResetField(name string) error {
+func (m *SecretVersionMutation) ResetField(name string) error {
 	switch name {
-	case reflection.FieldSessionKey:
-		m.ResetSessionKey()
+	case secretversion.FieldSecretName:
+		m.ResetSecretName()
 		return nil
-	case reflection.FieldContent:
-		m.ResetContent()
+	case secretversion.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case reflection.FieldTokenCount:
-		m.ResetTokenCount()
+	case secretversion.FieldEncryptedValue:
+		m.ResetEncryptedValue()
 		return nil
-	case reflection.FieldGeneration:
-		m.ResetGeneration()
+	case secretversion.FieldKeyID:
+		m.ResetKeyID()
 		return nil
-	case reflection.FieldCreatedAt:
+	case secretversion.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case secretversion.FieldRetiredAt:
+		m.ResetRetiredAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Reflection field %s", name)
+	return fmt.Errorf("unknown SecretVersion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReflectionMutation) AddedEdges() []string {
+func (m *SecretVersionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReflectionMutation) AddedIDs(name string) []ent.Value {
+func (m *SecretVersionMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReflectionMutation) RemovedEdges() []string {
+func (m *SecretVersionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReflectionMutation) RemovedIDs(name string) []ent.Value {
+func (m *SecretVersionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReflectionMutation) ClearedEdges() []string {
+func (m *SecretVersionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ReflectionMutation) EdgeCleared(name string) bool {
-	return false
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SecretVersionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SecretVersionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SecretVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SecretVersionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SecretVersion edge %s", name)
+}
+
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	key             *string
+	agent_id        *string
+	channel_type    *string
+	channel_id      *string
+	model           *string
+	metadata        *map[string]string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	messages        map[int]struct{}
+	removedmessages map[int]struct{}
+	clearedmessages bool
+	done            bool
+	oldValue        func(context.Context) (*Session, error)
+	predicates      []predicate.Session
+}
+
+var _ ent.Mutation = (*SessionMutation)(nil)
+
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
+
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id int) sessionOption {
+	return func(m *SessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Session
+		)
+		m.oldValue = func(ctx context.Context) (*Session, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Session.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SessionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *SessionMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *SessionMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *SessionMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *SessionMutation) SetAgentID(s string) {
+	m.agent_id = &s
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *SessionMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldAgentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *SessionMutation) ClearAgentID() {
+	m.agent_id = nil
+	m.clearedFields[session.FieldAgentID] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ReflectionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Reflection unique edge %s", name)
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *SessionMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[session.FieldAgentID]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ReflectionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Reflection edge %s", name)
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *SessionMutation) ResetAgentID() {
+	m.agent_id = nil
+	delete(m.clearedFields, session.FieldAgentID)
 }
 
-// SecretMutation represents an operation that mutates the Secret nodes in the graph.
-type SecretMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	name            *string
-	encrypted_value *[]byte
-	created_at      *time.Time
-	updated_at      *time.Time
-	access_count    *int
-	addaccess_count *int
-	clearedFields   map[string]struct{}
-	key             *uuid.UUID
-	clearedkey      bool
-	done            bool
-	oldValue        func(context.Context) (*Secret, error)
-	predicates      []predicate.Secret
+// SetChannelType sets the "channel_type" field.
+func (m *SessionMutation) SetChannelType(s string) {
+	m.channel_type = &s
 }
 
-var _ ent.Mutation = (*SecretMutation)(nil)
-
-// secretOption allows management of the mutation configuration using functional options.
-type secretOption func(*SecretMutation)
-
-// newSecretMutation creates new mutation for the Secret entity.
-func newSecretMutation(c config, op Op, opts ...secretOption) *SecretMutation {
-	m := &SecretMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeSecret,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// ChannelType returns the value of the "channel_type" field in the mutation.
+func (m *SessionMutation) ChannelType() (r string, exists bool) {
+	v := m.channel_type
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withSecretID sets the ID field of the mutation.
-func withSecretID(id uuid.UUID) secretOption {
-	return func(m *SecretMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Secret
-		)
-		m.oldValue = func(ctx context.Context) (*Secret, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Secret.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldChannelType returns the old "channel_type" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldChannelType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelType: %w", err)
 	}
+	return oldValue.ChannelType, nil
 }
 
-// withSecret sets the old Secret of the mutation.
-func withSecret(node *Secret) secretOption {
-	return func(m *SecretMutation) {
-		m.oldValue = func(context.Context) (*Secret, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearChannelType clears the value of the "channel_type" field.
+func (m *SessionMutation) ClearChannelType() {
+	m.channel_type = nil
+	m.clearedFields[session.FieldChannelType] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SecretMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ChannelTypeCleared returns if the "channel_type" field was cleared in this mutation.
+func (m *SessionMutation) ChannelTypeCleared() bool {
+	_, ok := m.clearedFields[session.FieldChannelType]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m SecretMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetChannelType resets all changes to the "channel_type" field.
+func (m *SessionMutation) ResetChannelType() {
+	m.channel_type = nil
+	delete(m.clearedFields, session.FieldChannelType)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Secret entities.
-func (m *SecretMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetChannelID sets the "channel_id" field.
+func (m *SessionMutation) SetChannelID(s string) {
+	m.channel_id = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SecretMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *SessionMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SecretMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Secret.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldChannelID returns the old "channel_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldChannelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
 }
 
-// SetName sets the "name" field.
-func (m *SecretMutation) SetName(s string) {
-	m.name = &s
+// ClearChannelID clears the value of the "channel_id" field.
+func (m *SessionMutation) ClearChannelID() {
+	m.channel_id = nil
+	m.clearedFields[session.FieldChannelID] = struct{}{}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *SecretMutation) Name() (r string, exists bool) {
-	v := m.name
+// ChannelIDCleared returns if the "channel_id" field was cleared in this mutation.
+func (m *SessionMutation) ChannelIDCleared() bool {
+	_, ok := m.clearedFields[session.FieldChannelID]
+	return ok
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *SessionMutation) ResetChannelID() {
+	m.channel_id = nil
+	delete(m.clearedFields, session.FieldChannelID)
+}
+
+// SetModel sets the "model" field.
+func (m *SessionMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *SessionMutation) Model() (r string, exists bool) {
+	v := m.model
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldModel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Model, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *SecretMutation) ResetName() {
-	m.name = nil
+// ClearModel clears the value of the "model" field.
+func (m *SessionMutation) ClearModel() {
+	m.model = nil
+	m.clearedFields[session.FieldModel] = struct{}{}
 }
 
-// SetEncryptedValue sets the "encrypted_value" field.
-func (m *SecretMutation) SetEncryptedValue(b []byte) {
-	m.encrypted_value = &b
+// ModelCleared returns if the "model" field was cleared in this mutation.
+func (m *SessionMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[session.FieldModel]
+	return ok
 }
 
-// EncryptedValue returns the value of the "encrypted_value" field in the mutation.
-func (m *SecretMutation) EncryptedValue() (r []byte, exists bool) {
-	v := m.encrypted_value
+// ResetModel resets all changes to the "model" field.
+func (m *SessionMutation) ResetModel() {
+	m.model = nil
+	delete(m.clearedFields, session.FieldModel)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SessionMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SessionMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEncryptedValue returns the old "encrypted_value" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldMetadata returns the old "metadata" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldEncryptedValue(ctx context.Context) (v []byte, err error) {
+func (m *SessionMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEncryptedValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEncryptedValue requires an ID field in the mutation")
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEncryptedValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
 	}
-	return oldValue.EncryptedValue, nil
+	return oldValue.Metadata, nil
 }
 
-// ResetEncryptedValue resets all changes to the "encrypted_value" field.
-func (m *SecretMutation) ResetEncryptedValue() {
-	m.encrypted_value = nil
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SessionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[session.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SessionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[session.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SessionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, session.FieldMetadata)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *SecretMutation) SetCreatedAt(t time.Time) {
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SecretMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -11728,10 +16587,10 @@ func (m *SecretMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -11746,17 +16605,17 @@ func (m *SecretMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SecretMutation) ResetCreatedAt() {
+func (m *SessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *SecretMutation) SetUpdatedAt(t time.Time) {
+func (m *SessionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SecretMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -11764,10 +16623,10 @@ func (m *SecretMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -11782,114 +16641,73 @@ func (m *SecretMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SecretMutation) ResetUpdatedAt() {
+func (m *SessionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetAccessCount sets the "access_count" field.
-func (m *SecretMutation) SetAccessCount(i int) {
-	m.access_count = &i
-	m.addaccess_count = nil
-}
-
-// AccessCount returns the value of the "access_count" field in the mutation.
-func (m *SecretMutation) AccessCount() (r int, exists bool) {
-	v := m.access_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAccessCount returns the old "access_count" field's value of the Secret entity.
-// If the Secret object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SecretMutation) OldAccessCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessCount: %w", err)
-	}
-	return oldValue.AccessCount, nil
-}
-
-// AddAccessCount adds i to the "access_count" field.
-func (m *SecretMutation) AddAccessCount(i int) {
-	if m.addaccess_count != nil {
-		*m.addaccess_count += i
-	} else {
-		m.addaccess_count = &i
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *SessionMutation) AddMessageIDs(ids ...int) {
+	if m.messages == nil {
+		m.messages = make(map[int]struct{})
 	}
-}
-
-// AddedAccessCount returns the value that was added to the "access_count" field in this mutation.
-func (m *SecretMutation) AddedAccessCount() (r int, exists bool) {
-	v := m.addaccess_count
-	if v == nil {
-		return
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetAccessCount resets all changes to the "access_count" field.
-func (m *SecretMutation) ResetAccessCount() {
-	m.access_count = nil
-	m.addaccess_count = nil
-}
-
-// SetKeyID sets the "key" edge to the Key entity by id.
-func (m *SecretMutation) SetKeyID(id uuid.UUID) {
-	m.key = &id
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *SessionMutation) ClearMessages() {
+	m.clearedmessages = true
 }
 
-// ClearKey clears the "key" edge to the Key entity.
-func (m *SecretMutation) ClearKey() {
-	m.clearedkey = true
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *SessionMutation) MessagesCleared() bool {
+	return m.clearedmessages
 }
 
-// KeyCleared reports if the "key" edge to the Key entity was cleared.
-func (m *SecretMutation) KeyCleared() bool {
-	return m.clearedkey
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *SessionMutation) RemoveMessageIDs(ids ...int) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
 }
 
-// KeyID returns the "key" edge ID in the mutation.
-func (m *SecretMutation) KeyID() (id uuid.UUID, exists bool) {
-	if m.key != nil {
-		return *m.key, true
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *SessionMutation) RemovedMessagesIDs() (ids []int) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// KeyIDs returns the "key" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// KeyID instead. It exists only for internal usage by the builders.
-func (m *SecretMutation) KeyIDs() (ids []uuid.UUID) {
-	if id := m.key; id != nil {
-		ids = append(ids, *id)
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *SessionMutation) MessagesIDs() (ids []int) {
+	for id := range m.messages {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetKey resets all changes to the "key" edge.
-func (m *SecretMutation) ResetKey() {
-	m.key = nil
-	m.clearedkey = false
+// ResetMessages resets all changes to the "messages" edge.
+func (m *SessionMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
 }
 
-// Where appends a list predicates to the SecretMutation builder.
-func (m *SecretMutation) Where(ps ...predicate.Secret) {
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SecretMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Secret, len(ps))
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11897,39 +16715,48 @@ func (m *SecretMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SecretMutation) Op() Op {
+func (m *SessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SecretMutation) SetOp(op Op) {
+func (m *SessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Secret).
-func (m *SecretMutation) Type() string {
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SecretMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, secret.FieldName)
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.key != nil {
+		fields = append(fields, session.FieldKey)
 	}
-	if m.encrypted_value != nil {
-		fields = append(fields, secret.FieldEncryptedValue)
+	if m.agent_id != nil {
+		fields = append(fields, session.FieldAgentID)
+	}
+	if m.channel_type != nil {
+		fields = append(fields, session.FieldChannelType)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, session.FieldChannelID)
+	}
+	if m.model != nil {
+		fields = append(fields, session.FieldModel)
+	}
+	if m.metadata != nil {
+		fields = append(fields, session.FieldMetadata)
 	}
 	if m.created_at != nil {
-		fields = append(fields, secret.FieldCreatedAt)
+		fields = append(fields, session.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, secret.FieldUpdatedAt)
-	}
-	if m.access_count != nil {
-		fields = append(fields, secret.FieldAccessCount)
+		fields = append(fields, session.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -11937,18 +16764,24 @@ func (m *SecretMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SecretMutation) Field(name string) (ent.Value, bool) {
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case secret.FieldName:
-		return m.Name()
-	case secret.FieldEncryptedValue:
-		return m.EncryptedValue()
-	case secret.FieldCreatedAt:
+	case session.FieldKey:
+		return m.Key()
+	case session.FieldAgentID:
+		return m.AgentID()
+	case session.FieldChannelType:
+		return m.ChannelType()
+	case session.FieldChannelID:
+		return m.ChannelID()
+	case session.FieldModel:
+		return m.Model()
+	case session.FieldMetadata:
+		return m.Metadata()
+	case session.FieldCreatedAt:
 		return m.CreatedAt()
-	case secret.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case secret.FieldAccessCount:
-		return m.AccessCount()
 	}
 	return nil, false
 }
@@ -11956,253 +16789,317 @@ func (m *SecretMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SecretMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case secret.FieldName:
-		return m.OldName(ctx)
-	case secret.FieldEncryptedValue:
-		return m.OldEncryptedValue(ctx)
-	case secret.FieldCreatedAt:
+	case session.FieldKey:
+		return m.OldKey(ctx)
+	case session.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case session.FieldChannelType:
+		return m.OldChannelType(ctx)
+	case session.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case session.FieldModel:
+		return m.OldModel(ctx)
+	case session.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case session.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case secret.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case secret.FieldAccessCount:
-		return m.OldAccessCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown Secret field %s", name)
+	return nil, fmt.Errorf("unknown Session field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SecretMutation) SetField(name string, value ent.Value) error {
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case secret.FieldName:
+	case session.FieldKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetKey(v)
 		return nil
-	case secret.FieldEncryptedValue:
-		v, ok := value.([]byte)
+	case session.FieldAgentID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEncryptedValue(v)
+		m.SetAgentID(v)
 		return nil
-	case secret.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case session.FieldChannelType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetChannelType(v)
 		return nil
-	case secret.FieldUpdatedAt:
+	case session.FieldChannelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case session.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case session.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case session.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case secret.FieldAccessCount:
-		v, ok := value.(int)
+	case session.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessCount(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Secret field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SecretMutation) AddedFields() []string {
-	var fields []string
-	if m.addaccess_count != nil {
-		fields = append(fields, secret.FieldAccessCount)
-	}
-	return fields
+func (m *SessionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SecretMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case secret.FieldAccessCount:
-		return m.AddedAccessCount()
-	}
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SecretMutation) AddField(name string, value ent.Value) error {
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case secret.FieldAccessCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAccessCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Secret numeric field %s", name)
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SecretMutation) ClearedFields() []string {
-	return nil
+func (m *SessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(session.FieldAgentID) {
+		fields = append(fields, session.FieldAgentID)
+	}
+	if m.FieldCleared(session.FieldChannelType) {
+		fields = append(fields, session.FieldChannelType)
+	}
+	if m.FieldCleared(session.FieldChannelID) {
+		fields = append(fields, session.FieldChannelID)
+	}
+	if m.FieldCleared(session.FieldModel) {
+		fields = append(fields, session.FieldModel)
+	}
+	if m.FieldCleared(session.FieldMetadata) {
+		fields = append(fields, session.FieldMetadata)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SecretMutation) FieldCleared(name string) bool {
+func (m *SessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SecretMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Secret nullable field %s", name)
+func (m *SessionMutation) ClearField(name string) error {
+	switch name {
+	case session.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case session.FieldChannelType:
+		m.ClearChannelType()
+		return nil
+	case session.FieldChannelID:
+		m.ClearChannelID()
+		return nil
+	case session.FieldModel:
+		m.ClearModel()
+		return nil
+	case session.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SecretMutation) ResetField(name string) error {
+func (m *SessionMutation) ResetField(name string) error {
 	switch name {
-	case secret.FieldName:
-		m.ResetName()
+	case session.FieldKey:
+		m.ResetKey()
 		return nil
-	case secret.FieldEncryptedValue:
-		m.ResetEncryptedValue()
+	case session.FieldAgentID:
+		m.ResetAgentID()
 		return nil
-	case secret.FieldCreatedAt:
+	case session.FieldChannelType:
+		m.ResetChannelType()
+		return nil
+	case session.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case session.FieldModel:
+		m.ResetModel()
+		return nil
+	case session.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case session.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case secret.FieldUpdatedAt:
+	case session.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case secret.FieldAccessCount:
-		m.ResetAccessCount()
-		return nil
 	}
-	return fmt.Errorf("unknown Secret field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SecretMutation) AddedEdges() []string {
+func (m *SessionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.key != nil {
-		edges = append(edges, secret.EdgeKey)
+	if m.messages != nil {
+		edges = append(edges, session.EdgeMessages)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SecretMutation) AddedIDs(name string) []ent.Value {
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case secret.EdgeKey:
-		if id := m.key; id != nil {
-			return []ent.Value{*id}
+	case session.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SecretMutation) RemovedEdges() []string {
+func (m *SessionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedmessages != nil {
+		edges = append(edges, session.EdgeMessages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SecretMutation) RemovedIDs(name string) []ent.Value {
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SecretMutation) ClearedEdges() []string {
+func (m *SessionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedkey {
-		edges = append(edges, secret.EdgeKey)
+	if m.clearedmessages {
+		edges = append(edges, session.EdgeMessages)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SecretMutation) EdgeCleared(name string) bool {
+func (m *SessionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case secret.EdgeKey:
-		return m.clearedkey
+	case session.EdgeMessages:
+		return m.clearedmessages
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SecretMutation) ClearEdge(name string) error {
+func (m *SessionMutation) ClearEdge(name string) error {
 	switch name {
-	case secret.EdgeKey:
-		m.ClearKey()
-		return nil
 	}
-	return fmt.Errorf("unknown Secret unique edge %s", name)
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SecretMutation) ResetEdge(name string) error {
+func (m *SessionMutation) ResetEdge(name string) error {
 	switch name {
-	case secret.EdgeKey:
-		m.ResetKey()
+	case session.EdgeMessages:
+		m.ResetMessages()
 		return nil
 	}
-	return fmt.Errorf("unknown Secret edge %s", name)
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-// SessionMutation represents an operation that mutates the Session nodes in the graph.
-type SessionMutation struct {
+// UsageRecordMutation represents an operation that mutates the UsageRecord nodes in the graph.
+type UsageRecordMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	key             *string
-	agent_id        *string
-	channel_type    *string
-	channel_id      *string
-	model           *string
-	metadata        *map[string]string
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	messages        map[int]struct{}
-	removedmessages map[int]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Session, error)
-	predicates      []predicate.Session
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	channel          *string
+	user_id          *string
+	session_key      *string
+	model            *string
+	input_tokens     *int
+	addinput_tokens  *int
+	output_tokens    *int
+	addoutput_tokens *int
+	cost             *float64
+	addcost          *float64
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*UsageRecord, error)
+	predicates       []predicate.UsageRecord
 }
 
-var _ ent.Mutation = (*SessionMutation)(nil)
+var _ ent.Mutation = (*UsageRecordMutation)(nil)
 
-// sessionOption allows management of the mutation configuration using functional options.
-type sessionOption func(*SessionMutation)
+// usagerecordOption allows management of the mutation configuration using functional options.
+type usagerecordOption func(*UsageRecordMutation)
 
-// newSessionMutation creates new mutation for the Session entity.
-func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
-	m := &SessionMutation{
+// newUsageRecordMutation creates new mutation for the UsageRecord entity.
+func newUsageRecordMutation(c config, op Op, opts ...usagerecordOption) *UsageRecordMutation {
+	m := &UsageRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSession,
+		typ:           TypeUsageRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12211,20 +17108,20 @@ func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation
 	return m
 }
 
-// withSessionID sets the ID field of the mutation.
-func withSessionID(id int) sessionOption {
-	return func(m *SessionMutation) {
+// withUsageRecordID sets the ID field of the mutation.
+func withUsageRecordID(id uuid.UUID) usagerecordOption {
+	return func(m *UsageRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Session
+			value *UsageRecord
 		)
-		m.oldValue = func(ctx context.Context) (*Session, error) {
+		m.oldValue = func(ctx context.Context) (*UsageRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Session.Get(ctx, id)
+					value, err = m.Client().UsageRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12233,10 +17130,10 @@ func withSessionID(id int) sessionOption {
 	}
 }
 
-// withSession sets the old Session of the mutation.
-func withSession(node *Session) sessionOption {
-	return func(m *SessionMutation) {
-		m.oldValue = func(context.Context) (*Session, error) {
+// withUsageRecord sets the old UsageRecord of the mutation.
+func withUsageRecord(node *UsageRecord) usagerecordOption {
+	return func(m *UsageRecordMutation) {
+		m.oldValue = func(context.Context) (*UsageRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12245,7 +17142,7 @@ func withSession(node *Session) sessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SessionMutation) Client() *Client {
+func (m UsageRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12253,7 +17150,7 @@ func (m SessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SessionMutation) Tx() (*Tx, error) {
+func (m UsageRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -12262,9 +17159,15 @@ func (m SessionMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UsageRecord entities.
+func (m *UsageRecordMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SessionMutation) ID() (id int, exists bool) {
+func (m *UsageRecordMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12275,211 +17178,149 @@ func (m *SessionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SessionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UsageRecordMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UsageRecord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetKey sets the "key" field.
-func (m *SessionMutation) SetKey(s string) {
-	m.key = &s
-}
-
-// Key returns the value of the "key" field in the mutation.
-func (m *SessionMutation) Key() (r string, exists bool) {
-	v := m.key
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKey returns the old "key" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldKey(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
-	}
-	return oldValue.Key, nil
-}
-
-// ResetKey resets all changes to the "key" field.
-func (m *SessionMutation) ResetKey() {
-	m.key = nil
-}
-
-// SetAgentID sets the "agent_id" field.
-func (m *SessionMutation) SetAgentID(s string) {
-	m.agent_id = &s
+// SetChannel sets the "channel" field.
+func (m *UsageRecordMutation) SetChannel(s string) {
+	m.channel = &s
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *SessionMutation) AgentID() (r string, exists bool) {
-	v := m.agent_id
+// Channel returns the value of the "channel" field in the mutation.
+func (m *UsageRecordMutation) Channel() (r string, exists bool) {
+	v := m.channel
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldChannel returns the old "channel" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldAgentID(ctx context.Context) (v string, err error) {
+func (m *UsageRecordMutation) OldChannel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
+		return v, errors.New("OldChannel requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
 	}
-	return oldValue.AgentID, nil
-}
-
-// ClearAgentID clears the value of the "agent_id" field.
-func (m *SessionMutation) ClearAgentID() {
-	m.agent_id = nil
-	m.clearedFields[session.FieldAgentID] = struct{}{}
-}
-
-// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *SessionMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[session.FieldAgentID]
-	return ok
+	return oldValue.Channel, nil
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *SessionMutation) ResetAgentID() {
-	m.agent_id = nil
-	delete(m.clearedFields, session.FieldAgentID)
+// ResetChannel resets all changes to the "channel" field.
+func (m *UsageRecordMutation) ResetChannel() {
+	m.channel = nil
 }
 
-// SetChannelType sets the "channel_type" field.
-func (m *SessionMutation) SetChannelType(s string) {
-	m.channel_type = &s
+// SetUserID sets the "user_id" field.
+func (m *UsageRecordMutation) SetUserID(s string) {
+	m.user_id = &s
 }
 
-// ChannelType returns the value of the "channel_type" field in the mutation.
-func (m *SessionMutation) ChannelType() (r string, exists bool) {
-	v := m.channel_type
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UsageRecordMutation) UserID() (r string, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChannelType returns the old "channel_type" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldChannelType(ctx context.Context) (v string, err error) {
+func (m *UsageRecordMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChannelType is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChannelType requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChannelType: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.ChannelType, nil
-}
-
-// ClearChannelType clears the value of the "channel_type" field.
-func (m *SessionMutation) ClearChannelType() {
-	m.channel_type = nil
-	m.clearedFields[session.FieldChannelType] = struct{}{}
-}
-
-// ChannelTypeCleared returns if the "channel_type" field was cleared in this mutation.
-func (m *SessionMutation) ChannelTypeCleared() bool {
-	_, ok := m.clearedFields[session.FieldChannelType]
-	return ok
+	return oldValue.UserID, nil
 }
 
-// ResetChannelType resets all changes to the "channel_type" field.
-func (m *SessionMutation) ResetChannelType() {
-	m.channel_type = nil
-	delete(m.clearedFields, session.FieldChannelType)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UsageRecordMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetChannelID sets the "channel_id" field.
-func (m *SessionMutation) SetChannelID(s string) {
-	m.channel_id = &s
+// SetSessionKey sets the "session_key" field.
+func (m *UsageRecordMutation) SetSessionKey(s string) {
+	m.session_key = &s
 }
 
-// ChannelID returns the value of the "channel_id" field in the mutation.
-func (m *SessionMutation) ChannelID() (r string, exists bool) {
-	v := m.channel_id
+// SessionKey returns the value of the "session_key" field in the mutation.
+func (m *UsageRecordMutation) SessionKey() (r string, exists bool) {
+	v := m.session_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChannelID returns the old "channel_id" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionKey returns the old "session_key" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldChannelID(ctx context.Context) (v string, err error) {
+func (m *UsageRecordMutation) OldSessionKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChannelID requires an ID field in the mutation")
+		return v, errors.New("OldSessionKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionKey: %w", err)
 	}
-	return oldValue.ChannelID, nil
+	return oldValue.SessionKey, nil
 }
 
-// ClearChannelID clears the value of the "channel_id" field.
-func (m *SessionMutation) ClearChannelID() {
-	m.channel_id = nil
-	m.clearedFields[session.FieldChannelID] = struct{}{}
+// ClearSessionKey clears the value of the "session_key" field.
+func (m *UsageRecordMutation) ClearSessionKey() {
+	m.session_key = nil
+	m.clearedFields[usagerecord.FieldSessionKey] = struct{}{}
 }
 
-// ChannelIDCleared returns if the "channel_id" field was cleared in this mutation.
-func (m *SessionMutation) ChannelIDCleared() bool {
-	_, ok := m.clearedFields[session.FieldChannelID]
+// SessionKeyCleared returns if the "session_key" field was cleared in this mutation.
+func (m *UsageRecordMutation) SessionKeyCleared() bool {
+	_, ok := m.clearedFields[usagerecord.FieldSessionKey]
 	return ok
 }
 
-// ResetChannelID resets all changes to the "channel_id" field.
-func (m *SessionMutation) ResetChannelID() {
-	m.channel_id = nil
-	delete(m.clearedFields, session.FieldChannelID)
+// ResetSessionKey resets all changes to the "session_key" field.
+func (m *UsageRecordMutation) ResetSessionKey() {
+	m.session_key = nil
+	delete(m.clearedFields, usagerecord.FieldSessionKey)
 }
 
 // SetModel sets the "model" field.
-func (m *SessionMutation) SetModel(s string) {
+func (m *UsageRecordMutation) SetModel(s string) {
 	m.model = &s
 }
 
 // Model returns the value of the "model" field in the mutation.
-func (m *SessionMutation) Model() (r string, exists bool) {
+func (m *UsageRecordMutation) Model() (r string, exists bool) {
 	v := m.model
 	if v == nil {
 		return
@@ -12487,10 +17328,10 @@ func (m *SessionMutation) Model() (r string, exists bool) {
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldModel returns the old "model" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *UsageRecordMutation) OldModel(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldModel is only allowed on UpdateOne operations")
 	}
@@ -12505,207 +17346,236 @@ func (m *SessionMutation) OldModel(ctx context.Context) (v string, err error) {
 }
 
 // ClearModel clears the value of the "model" field.
-func (m *SessionMutation) ClearModel() {
+func (m *UsageRecordMutation) ClearModel() {
 	m.model = nil
-	m.clearedFields[session.FieldModel] = struct{}{}
+	m.clearedFields[usagerecord.FieldModel] = struct{}{}
 }
 
 // ModelCleared returns if the "model" field was cleared in this mutation.
-func (m *SessionMutation) ModelCleared() bool {
-	_, ok := m.clearedFields[session.FieldModel]
+func (m *UsageRecordMutation) ModelCleared() bool {
+	_, ok := m.clearedFields[usagerecord.FieldModel]
 	return ok
 }
 
 // ResetModel resets all changes to the "model" field.
-func (m *SessionMutation) ResetModel() {
+func (m *UsageRecordMutation) ResetModel() {
 	m.model = nil
-	delete(m.clearedFields, session.FieldModel)
+	delete(m.clearedFields, usagerecord.FieldModel)
 }
 
-// SetMetadata sets the "metadata" field.
-func (m *SessionMutation) SetMetadata(value map[string]string) {
-	m.metadata = &value
+// SetInputTokens sets the "input_tokens" field.
+func (m *UsageRecordMutation) SetInputTokens(i int) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
 }
 
-// Metadata returns the value of the "metadata" field in the mutation.
-func (m *SessionMutation) Metadata() (r map[string]string, exists bool) {
-	v := m.metadata
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *UsageRecordMutation) InputTokens() (r int, exists bool) {
+	v := m.input_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMetadata returns the old "metadata" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldInputTokens returns the old "input_tokens" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+func (m *UsageRecordMutation) OldInputTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMetadata requires an ID field in the mutation")
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
 	}
-	return oldValue.Metadata, nil
+	return oldValue.InputTokens, nil
 }
 
-// ClearMetadata clears the value of the "metadata" field.
-func (m *SessionMutation) ClearMetadata() {
-	m.metadata = nil
-	m.clearedFields[session.FieldMetadata] = struct{}{}
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *UsageRecordMutation) AddInputTokens(i int) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
 }
 
-// MetadataCleared returns if the "metadata" field was cleared in this mutation.
-func (m *SessionMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[session.FieldMetadata]
-	return ok
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *UsageRecordMutation) AddedInputTokens() (r int, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetMetadata resets all changes to the "metadata" field.
-func (m *SessionMutation) ResetMetadata() {
-	m.metadata = nil
-	delete(m.clearedFields, session.FieldMetadata)
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *UsageRecordMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SessionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetOutputTokens sets the "output_tokens" field.
+func (m *UsageRecordMutation) SetOutputTokens(i int) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *UsageRecordMutation) OutputTokens() (r int, exists bool) {
+	v := m.output_tokens
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldOutputTokens returns the old "output_tokens" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldOutputTokens(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.OutputTokens, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SessionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *UsageRecordMutation) AddOutputTokens(i int) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *SessionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *UsageRecordMutation) AddedOutputTokens() (r int, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *SessionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *UsageRecordMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+}
+
+// SetCost sets the "cost" field.
+func (m *UsageRecordMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *UsageRecordMutation) Cost() (r float64, exists bool) {
+	v := m.cost
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Session entity.
-// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// OldCost returns the old "cost" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UsageRecordMutation) OldCost(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *SessionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Cost, nil
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *SessionMutation) AddMessageIDs(ids ...int) {
-	if m.messages == nil {
-		m.messages = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+// AddCost adds f to the "cost" field.
+func (m *UsageRecordMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
 	}
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *SessionMutation) ClearMessages() {
-	m.clearedmessages = true
-}
-
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *SessionMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *UsageRecordMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *SessionMutation) RemoveMessageIDs(ids ...int) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
+// ResetCost resets all changes to the "cost" field.
+func (m *UsageRecordMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *SessionMutation) RemovedMessagesIDs() (ids []int) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// SetCreatedAt sets the "created_at" field.
+func (m *UsageRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UsageRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *SessionMutation) MessagesIDs() (ids []int) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the UsageRecord entity.
+// If the UsageRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UsageRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *SessionMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UsageRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the SessionMutation builder.
-func (m *SessionMutation) Where(ps ...predicate.Session) {
+// Where appends a list predicates to the UsageRecordMutation builder.
+func (m *UsageRecordMutation) Where(ps ...predicate.UsageRecord) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UsageRecordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Session, len(ps))
+func (m *UsageRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UsageRecord, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -12713,48 +17583,48 @@ func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SessionMutation) Op() Op {
+func (m *UsageRecordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SessionMutation) SetOp(op Op) {
+func (m *UsageRecordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Session).
-func (m *SessionMutation) Type() string {
+// Type returns the node type of this mutation (UsageRecord).
+func (m *UsageRecordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SessionMutation) Fields() []string {
+func (m *UsageRecordMutation) Fields() []string {
 	fields := make([]string, 0, 8)
-	if m.key != nil {
-		fields = append(fields, session.FieldKey)
-	}
-	if m.agent_id != nil {
-		fields = append(fields, session.FieldAgentID)
+	if m.channel != nil {
+		fields = append(fields, usagerecord.FieldChannel)
 	}
-	if m.channel_type != nil {
-		fields = append(fields, session.FieldChannelType)
+	if m.user_id != nil {
+		fields = append(fields, usagerecord.FieldUserID)
 	}
-	if m.channel_id != nil {
-		fields = append(fields, session.FieldChannelID)
+	if m.session_key != nil {
+		fields = append(fields, usagerecord.FieldSessionKey)
 	}
 	if m.model != nil {
-		fields = append(fields, session.FieldModel)
+		fields = append(fields, usagerecord.FieldModel)
 	}
-	if m.metadata != nil {
-		fields = append(fields, session.FieldMetadata)
+	if m.input_tokens != nil {
+		fields = append(fields, usagerecord.FieldInputTokens)
 	}
-	if m.created_at != nil {
-		fields = append(fields, session.FieldCreatedAt)
+	if m.output_tokens != nil {
+		fields = append(fields, usagerecord.FieldOutputTokens)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, session.FieldUpdatedAt)
+	if m.cost != nil {
+		fields = append(fields, usagerecord.FieldCost)
+	}
+	if m.created_at != nil {
+		fields = append(fields, usagerecord.FieldCreatedAt)
 	}
 	return fields
 }
@@ -12762,24 +17632,24 @@ func (m *SessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SessionMutation) Field(name string) (ent.Value, bool) {
+func (m *UsageRecordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case session.FieldKey:
-		return m.Key()
-	case session.FieldAgentID:
-		return m.AgentID()
-	case session.FieldChannelType:
-		return m.ChannelType()
-	case session.FieldChannelID:
-		return m.ChannelID()
-	case session.FieldModel:
+	case usagerecord.FieldChannel:
+		return m.Channel()
+	case usagerecord.FieldUserID:
+		return m.UserID()
+	case usagerecord.FieldSessionKey:
+		return m.SessionKey()
+	case usagerecord.FieldModel:
 		return m.Model()
-	case session.FieldMetadata:
-		return m.Metadata()
-	case session.FieldCreatedAt:
+	case usagerecord.FieldInputTokens:
+		return m.InputTokens()
+	case usagerecord.FieldOutputTokens:
+		return m.OutputTokens()
+	case usagerecord.FieldCost:
+		return m.Cost()
+	case usagerecord.FieldCreatedAt:
 		return m.CreatedAt()
-	case session.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -12787,281 +17657,266 @@ func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UsageRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case session.FieldKey:
-		return m.OldKey(ctx)
-	case session.FieldAgentID:
-		return m.OldAgentID(ctx)
-	case session.FieldChannelType:
-		return m.OldChannelType(ctx)
-	case session.FieldChannelID:
-		return m.OldChannelID(ctx)
-	case session.FieldModel:
+	case usagerecord.FieldChannel:
+		return m.OldChannel(ctx)
+	case usagerecord.FieldUserID:
+		return m.OldUserID(ctx)
+	case usagerecord.FieldSessionKey:
+		return m.OldSessionKey(ctx)
+	case usagerecord.FieldModel:
 		return m.OldModel(ctx)
-	case session.FieldMetadata:
-		return m.OldMetadata(ctx)
-	case session.FieldCreatedAt:
+	case usagerecord.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case usagerecord.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case usagerecord.FieldCost:
+		return m.OldCost(ctx)
+	case usagerecord.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case session.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Session field %s", name)
+	return nil, fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) SetField(name string, value ent.Value) error {
+func (m *UsageRecordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case session.FieldKey:
+	case usagerecord.FieldChannel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetChannel(v)
 		return nil
-	case session.FieldAgentID:
+	case usagerecord.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgentID(v)
+		m.SetUserID(v)
 		return nil
-	case session.FieldChannelType:
+	case usagerecord.FieldSessionKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChannelType(v)
+		m.SetSessionKey(v)
 		return nil
-	case session.FieldChannelID:
+	case usagerecord.FieldModel:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetChannelID(v)
+		m.SetModel(v)
 		return nil
-	case session.FieldModel:
-		v, ok := value.(string)
+	case usagerecord.FieldInputTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetInputTokens(v)
 		return nil
-	case session.FieldMetadata:
-		v, ok := value.(map[string]string)
+	case usagerecord.FieldOutputTokens:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMetadata(v)
+		m.SetOutputTokens(v)
 		return nil
-	case session.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case usagerecord.FieldCost:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetCost(v)
 		return nil
-	case session.FieldUpdatedAt:
+	case usagerecord.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SessionMutation) AddedFields() []string {
-	return nil
+func (m *UsageRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addinput_tokens != nil {
+		fields = append(fields, usagerecord.FieldInputTokens)
+	}
+	if m.addoutput_tokens != nil {
+		fields = append(fields, usagerecord.FieldOutputTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, usagerecord.FieldCost)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UsageRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case usagerecord.FieldInputTokens:
+		return m.AddedInputTokens()
+	case usagerecord.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case usagerecord.FieldCost:
+		return m.AddedCost()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SessionMutation) AddField(name string, value ent.Value) error {
+func (m *UsageRecordMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case usagerecord.FieldInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputTokens(v)
+		return nil
+	case usagerecord.FieldOutputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputTokens(v)
+		return nil
+	case usagerecord.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Session numeric field %s", name)
+	return fmt.Errorf("unknown UsageRecord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SessionMutation) ClearedFields() []string {
+func (m *UsageRecordMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(session.FieldAgentID) {
-		fields = append(fields, session.FieldAgentID)
-	}
-	if m.FieldCleared(session.FieldChannelType) {
-		fields = append(fields, session.FieldChannelType)
-	}
-	if m.FieldCleared(session.FieldChannelID) {
-		fields = append(fields, session.FieldChannelID)
-	}
-	if m.FieldCleared(session.FieldModel) {
-		fields = append(fields, session.FieldModel)
+	if m.FieldCleared(usagerecord.FieldSessionKey) {
+		fields = append(fields, usagerecord.FieldSessionKey)
 	}
-	if m.FieldCleared(session.FieldMetadata) {
-		fields = append(fields, session.FieldMetadata)
+	if m.FieldCleared(usagerecord.FieldModel) {
+		fields = append(fields, usagerecord.FieldModel)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SessionMutation) FieldCleared(name string) bool {
+func (m *UsageRecordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SessionMutation) ClearField(name string) error {
+func (m *UsageRecordMutation) ClearField(name string) error {
 	switch name {
-	case session.FieldAgentID:
-		m.ClearAgentID()
-		return nil
-	case session.FieldChannelType:
-		m.ClearChannelType()
-		return nil
-	case session.FieldChannelID:
-		m.ClearChannelID()
+	case usagerecord.FieldSessionKey:
+		m.ClearSessionKey()
 		return nil
-	case session.FieldModel:
+	case usagerecord.FieldModel:
 		m.ClearModel()
 		return nil
-	case session.FieldMetadata:
-		m.ClearMetadata()
-		return nil
 	}
-	return fmt.Errorf("unknown Session nullable field %s", name)
+	return fmt.Errorf("unknown UsageRecord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SessionMutation) ResetField(name string) error {
+func (m *UsageRecordMutation) ResetField(name string) error {
 	switch name {
-	case session.FieldKey:
-		m.ResetKey()
-		return nil
-	case session.FieldAgentID:
-		m.ResetAgentID()
+	case usagerecord.FieldChannel:
+		m.ResetChannel()
 		return nil
-	case session.FieldChannelType:
-		m.ResetChannelType()
+	case usagerecord.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case session.FieldChannelID:
-		m.ResetChannelID()
+	case usagerecord.FieldSessionKey:
+		m.ResetSessionKey()
 		return nil
-	case session.FieldModel:
+	case usagerecord.FieldModel:
 		m.ResetModel()
 		return nil
-	case session.FieldMetadata:
-		m.ResetMetadata()
+	case usagerecord.FieldInputTokens:
+		m.ResetInputTokens()
 		return nil
-	case session.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case usagerecord.FieldOutputTokens:
+		m.ResetOutputTokens()
 		return nil
-	case session.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case usagerecord.FieldCost:
+		m.ResetCost()
+		return nil
+	case usagerecord.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Session field %s", name)
+	return fmt.Errorf("unknown UsageRecord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.messages != nil {
-		edges = append(edges, session.EdgeMessages)
-	}
+func (m *UsageRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SessionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case session.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UsageRecordMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmessages != nil {
-		edges = append(edges, session.EdgeMessages)
-	}
+func (m *UsageRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case session.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UsageRecordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmessages {
-		edges = append(edges, session.EdgeMessages)
-	}
+func (m *UsageRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SessionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case session.EdgeMessages:
-		return m.clearedmessages
-	}
+func (m *UsageRecordMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SessionMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Session unique edge %s", name)
+func (m *UsageRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UsageRecord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SessionMutation) ResetEdge(name string) error {
-	switch name {
-	case session.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	}
-	return fmt.Errorf("unknown Session edge %s", name)
+func (m *UsageRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UsageRecord edge %s", name)
 }
 
 // WorkflowRunMutation represents an operation that mutates the WorkflowRun nodes in the graph.
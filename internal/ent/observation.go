@@ -28,6 +28,8 @@ type Observation struct {
 	SourceStartIndex int `json:"source_start_index,omitempty"`
 	// SourceEndIndex holds the value of the "source_end_index" field.
 	SourceEndIndex int `json:"source_end_index,omitempty"`
+	// Pinned observations are always injected and never condensed
+	Pinned bool `json:"pinned,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt    time.Time `json:"created_at,omitempty"`
 	selectValues sql.SelectValues
@@ -38,6 +40,8 @@ func (*Observation) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case observation.FieldPinned:
+			values[i] = new(sql.NullBool)
 		case observation.FieldTokenCount, observation.FieldSourceStartIndex, observation.FieldSourceEndIndex:
 			values[i] = new(sql.NullInt64)
 		case observation.FieldSessionKey, observation.FieldContent:
@@ -97,6 +101,12 @@ func (_m *Observation) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.SourceEndIndex = int(value.Int64)
 			}
+		case observation.FieldPinned:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pinned", values[i])
+			} else if value.Valid {
+				_m.Pinned = value.Bool
+			}
 		case observation.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -154,6 +164,9 @@ func (_m *Observation) String() string {
 	builder.WriteString("source_end_index=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SourceEndIndex))
 	builder.WriteString(", ")
+	builder.WriteString("pinned=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pinned))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
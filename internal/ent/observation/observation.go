@@ -24,6 +24,8 @@ const (
 	FieldSourceStartIndex = "source_start_index"
 	// FieldSourceEndIndex holds the string denoting the source_end_index field in the database.
 	FieldSourceEndIndex = "source_end_index"
+	// FieldPinned holds the string denoting the pinned field in the database.
+	FieldPinned = "pinned"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// Table holds the table name of the observation in the database.
@@ -38,6 +40,7 @@ var Columns = []string{
 	FieldTokenCount,
 	FieldSourceStartIndex,
 	FieldSourceEndIndex,
+	FieldPinned,
 	FieldCreatedAt,
 }
 
@@ -62,6 +65,8 @@ var (
 	DefaultSourceStartIndex int
 	// DefaultSourceEndIndex holds the default value on creation for the "source_end_index" field.
 	DefaultSourceEndIndex int
+	// DefaultPinned holds the default value on creation for the "pinned" field.
+	DefaultPinned bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -101,6 +106,11 @@ func BySourceEndIndex(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSourceEndIndex, opts...).ToFunc()
 }
 
+// ByPinned orders the results by the pinned field.
+func ByPinned(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPinned, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -80,6 +80,11 @@ func SourceEndIndex(v int) predicate.Observation {
 	return predicate.Observation(sql.FieldEQ(FieldSourceEndIndex, v))
 }
 
+// Pinned applies equality check predicate on the "pinned" field. It's identical to PinnedEQ.
+func Pinned(v bool) predicate.Observation {
+	return predicate.Observation(sql.FieldEQ(FieldPinned, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Observation {
 	return predicate.Observation(sql.FieldEQ(FieldCreatedAt, v))
@@ -335,6 +340,16 @@ func SourceEndIndexLTE(v int) predicate.Observation {
 	return predicate.Observation(sql.FieldLTE(FieldSourceEndIndex, v))
 }
 
+// PinnedEQ applies the EQ predicate on the "pinned" field.
+func PinnedEQ(v bool) predicate.Observation {
+	return predicate.Observation(sql.FieldEQ(FieldPinned, v))
+}
+
+// PinnedNEQ applies the NEQ predicate on the "pinned" field.
+func PinnedNEQ(v bool) predicate.Observation {
+	return predicate.Observation(sql.FieldNEQ(FieldPinned, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Observation {
 	return predicate.Observation(sql.FieldEQ(FieldCreatedAt, v))
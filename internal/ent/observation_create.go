@@ -75,6 +75,20 @@ func (_c *ObservationCreate) SetNillableSourceEndIndex(v *int) *ObservationCreat
 	return _c
 }
 
+// SetPinned sets the "pinned" field.
+func (_c *ObservationCreate) SetPinned(v bool) *ObservationCreate {
+	_c.mutation.SetPinned(v)
+	return _c
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_c *ObservationCreate) SetNillablePinned(v *bool) *ObservationCreate {
+	if v != nil {
+		_c.SetPinned(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ObservationCreate) SetCreatedAt(v time.Time) *ObservationCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -150,6 +164,10 @@ func (_c *ObservationCreate) defaults() {
 		v := observation.DefaultSourceEndIndex
 		_c.mutation.SetSourceEndIndex(v)
 	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		v := observation.DefaultPinned
+		_c.mutation.SetPinned(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := observation.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -187,6 +205,9 @@ func (_c *ObservationCreate) check() error {
 	if _, ok := _c.mutation.SourceEndIndex(); !ok {
 		return &ValidationError{Name: "source_end_index", err: errors.New(`ent: missing required field "Observation.source_end_index"`)}
 	}
+	if _, ok := _c.mutation.Pinned(); !ok {
+		return &ValidationError{Name: "pinned", err: errors.New(`ent: missing required field "Observation.pinned"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Observation.created_at"`)}
 	}
@@ -245,6 +266,10 @@ func (_c *ObservationCreate) createSpec() (*Observation, *sqlgraph.CreateSpec) {
 		_spec.SetField(observation.FieldSourceEndIndex, field.TypeInt, value)
 		_node.SourceEndIndex = value
 	}
+	if value, ok := _c.mutation.Pinned(); ok {
+		_spec.SetField(observation.FieldPinned, field.TypeBool, value)
+		_node.Pinned = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(observation.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
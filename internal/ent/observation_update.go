@@ -118,6 +118,20 @@ func (_u *ObservationUpdate) AddSourceEndIndex(v int) *ObservationUpdate {
 	return _u
 }
 
+// SetPinned sets the "pinned" field.
+func (_u *ObservationUpdate) SetPinned(v bool) *ObservationUpdate {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *ObservationUpdate) SetNillablePinned(v *bool) *ObservationUpdate {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
 // Mutation returns the ObservationMutation object of the builder.
 func (_u *ObservationUpdate) Mutation() *ObservationMutation {
 	return _u.mutation
@@ -201,6 +215,9 @@ func (_u *ObservationUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedSourceEndIndex(); ok {
 		_spec.AddField(observation.FieldSourceEndIndex, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(observation.FieldPinned, field.TypeBool, value)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{observation.Label}
@@ -312,6 +329,20 @@ func (_u *ObservationUpdateOne) AddSourceEndIndex(v int) *ObservationUpdateOne {
 	return _u
 }
 
+// SetPinned sets the "pinned" field.
+func (_u *ObservationUpdateOne) SetPinned(v bool) *ObservationUpdateOne {
+	_u.mutation.SetPinned(v)
+	return _u
+}
+
+// SetNillablePinned sets the "pinned" field if the given value is not nil.
+func (_u *ObservationUpdateOne) SetNillablePinned(v *bool) *ObservationUpdateOne {
+	if v != nil {
+		_u.SetPinned(*v)
+	}
+	return _u
+}
+
 // Mutation returns the ObservationMutation object of the builder.
 func (_u *ObservationUpdateOne) Mutation() *ObservationMutation {
 	return _u.mutation
@@ -425,6 +456,9 @@ func (_u *ObservationUpdateOne) sqlSave(ctx context.Context) (_node *Observation
 	if value, ok := _u.mutation.AddedSourceEndIndex(); ok {
 		_spec.AddField(observation.FieldSourceEndIndex, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Pinned(); ok {
+		_spec.SetField(observation.FieldPinned, field.TypeBool, value)
+	}
 	_node = &Observation{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -6,6 +6,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApprovalGrant is the predicate function for approvalgrant builders.
+type ApprovalGrant func(*sql.Selector)
+
 // AuditLog is the predicate function for auditlog builders.
 type AuditLog func(*sql.Selector)
 
@@ -21,6 +24,9 @@ type CronJobHistory func(*sql.Selector)
 // ExternalRef is the predicate function for externalref builders.
 type ExternalRef func(*sql.Selector)
 
+// FileSnapshot is the predicate function for filesnapshot builders.
+type FileSnapshot func(*sql.Selector)
+
 // Inquiry is the predicate function for inquiry builders.
 type Inquiry func(*sql.Selector)
 
@@ -30,6 +36,9 @@ type Key func(*sql.Selector)
 // Knowledge is the predicate function for knowledge builders.
 type Knowledge func(*sql.Selector)
 
+// KnowledgeRevision is the predicate function for knowledgerevision builders.
+type KnowledgeRevision func(*sql.Selector)
+
 // Learning is the predicate function for learning builders.
 type Learning func(*sql.Selector)
 
@@ -51,9 +60,15 @@ type Reflection func(*sql.Selector)
 // Secret is the predicate function for secret builders.
 type Secret func(*sql.Selector)
 
+// SecretVersion is the predicate function for secretversion builders.
+type SecretVersion func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
+// UsageRecord is the predicate function for usagerecord builders.
+type UsageRecord func(*sql.Selector)
+
 // WorkflowRun is the predicate function for workflowrun builders.
 type WorkflowRun func(*sql.Selector)
 
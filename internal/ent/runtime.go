@@ -6,14 +6,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/approvalgrant"
 	"github.com/langoai/lango/internal/ent/auditlog"
 	"github.com/langoai/lango/internal/ent/configprofile"
 	"github.com/langoai/lango/internal/ent/cronjob"
 	"github.com/langoai/lango/internal/ent/cronjobhistory"
 	"github.com/langoai/lango/internal/ent/externalref"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
 	"github.com/langoai/lango/internal/ent/inquiry"
 	"github.com/langoai/lango/internal/ent/key"
 	"github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
 	"github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/ent/message"
 	"github.com/langoai/lango/internal/ent/observation"
@@ -22,7 +25,9 @@ import (
 	"github.com/langoai/lango/internal/ent/reflection"
 	"github.com/langoai/lango/internal/ent/schema"
 	"github.com/langoai/lango/internal/ent/secret"
+	"github.com/langoai/lango/internal/ent/secretversion"
 	"github.com/langoai/lango/internal/ent/session"
+	"github.com/langoai/lango/internal/ent/usagerecord"
 	"github.com/langoai/lango/internal/ent/workflowrun"
 	"github.com/langoai/lango/internal/ent/workflowsteprun"
 )
@@ -31,6 +36,24 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	approvalgrantFields := schema.ApprovalGrant{}.Fields()
+	_ = approvalgrantFields
+	// approvalgrantDescToolName is the schema descriptor for tool_name field.
+	approvalgrantDescToolName := approvalgrantFields[1].Descriptor()
+	// approvalgrant.ToolNameValidator is a validator for the "tool_name" field. It is called by the builders before save.
+	approvalgrant.ToolNameValidator = approvalgrantDescToolName.Validators[0].(func(string) error)
+	// approvalgrantDescChannel is the schema descriptor for channel field.
+	approvalgrantDescChannel := approvalgrantFields[4].Descriptor()
+	// approvalgrant.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	approvalgrant.ChannelValidator = approvalgrantDescChannel.Validators[0].(func(string) error)
+	// approvalgrantDescCreatedAt is the schema descriptor for created_at field.
+	approvalgrantDescCreatedAt := approvalgrantFields[6].Descriptor()
+	// approvalgrant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	approvalgrant.DefaultCreatedAt = approvalgrantDescCreatedAt.Default.(func() time.Time)
+	// approvalgrantDescID is the schema descriptor for id field.
+	approvalgrantDescID := approvalgrantFields[0].Descriptor()
+	// approvalgrant.DefaultID holds the default value on creation for the id field.
+	approvalgrant.DefaultID = approvalgrantDescID.Default.(func() uuid.UUID)
 	auditlogFields := schema.AuditLog{}.Fields()
 	_ = auditlogFields
 	// auditlogDescActor is the schema descriptor for actor field.
@@ -155,6 +178,28 @@ func init() {
 	externalrefDescID := externalrefFields[0].Descriptor()
 	// externalref.DefaultID holds the default value on creation for the id field.
 	externalref.DefaultID = externalrefDescID.Default.(func() uuid.UUID)
+	filesnapshotFields := schema.FileSnapshot{}.Fields()
+	_ = filesnapshotFields
+	// filesnapshotDescPath is the schema descriptor for path field.
+	filesnapshotDescPath := filesnapshotFields[2].Descriptor()
+	// filesnapshot.PathValidator is a validator for the "path" field. It is called by the builders before save.
+	filesnapshot.PathValidator = filesnapshotDescPath.Validators[0].(func(string) error)
+	// filesnapshotDescMode is the schema descriptor for mode field.
+	filesnapshotDescMode := filesnapshotFields[6].Descriptor()
+	// filesnapshot.DefaultMode holds the default value on creation for the mode field.
+	filesnapshot.DefaultMode = filesnapshotDescMode.Default.(uint32)
+	// filesnapshotDescUndone is the schema descriptor for undone field.
+	filesnapshotDescUndone := filesnapshotFields[8].Descriptor()
+	// filesnapshot.DefaultUndone holds the default value on creation for the undone field.
+	filesnapshot.DefaultUndone = filesnapshotDescUndone.Default.(bool)
+	// filesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	filesnapshotDescCreatedAt := filesnapshotFields[9].Descriptor()
+	// filesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	filesnapshot.DefaultCreatedAt = filesnapshotDescCreatedAt.Default.(func() time.Time)
+	// filesnapshotDescID is the schema descriptor for id field.
+	filesnapshotDescID := filesnapshotFields[0].Descriptor()
+	// filesnapshot.DefaultID holds the default value on creation for the id field.
+	filesnapshot.DefaultID = filesnapshotDescID.Default.(func() uuid.UUID)
 	inquiryFields := schema.Inquiry{}.Fields()
 	_ = inquiryFields
 	// inquiryDescSessionKey is the schema descriptor for session_key field.
@@ -170,7 +215,7 @@ func init() {
 	// inquiry.QuestionValidator is a validator for the "question" field. It is called by the builders before save.
 	inquiry.QuestionValidator = inquiryDescQuestion.Validators[0].(func(string) error)
 	// inquiryDescCreatedAt is the schema descriptor for created_at field.
-	inquiryDescCreatedAt := inquiryFields[10].Descriptor()
+	inquiryDescCreatedAt := inquiryFields[11].Descriptor()
 	// inquiry.DefaultCreatedAt holds the default value on creation for the created_at field.
 	inquiry.DefaultCreatedAt = inquiryDescCreatedAt.Default.(func() time.Time)
 	// inquiryDescID is the schema descriptor for id field.
@@ -227,6 +272,28 @@ func init() {
 	knowledgeDescID := knowledgeFields[0].Descriptor()
 	// knowledge.DefaultID holds the default value on creation for the id field.
 	knowledge.DefaultID = knowledgeDescID.Default.(func() uuid.UUID)
+	knowledgerevisionFields := schema.KnowledgeRevision{}.Fields()
+	_ = knowledgerevisionFields
+	// knowledgerevisionDescKnowledgeKey is the schema descriptor for knowledge_key field.
+	knowledgerevisionDescKnowledgeKey := knowledgerevisionFields[1].Descriptor()
+	// knowledgerevision.KnowledgeKeyValidator is a validator for the "knowledge_key" field. It is called by the builders before save.
+	knowledgerevision.KnowledgeKeyValidator = knowledgerevisionDescKnowledgeKey.Validators[0].(func(string) error)
+	// knowledgerevisionDescVersion is the schema descriptor for version field.
+	knowledgerevisionDescVersion := knowledgerevisionFields[2].Descriptor()
+	// knowledgerevision.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	knowledgerevision.VersionValidator = knowledgerevisionDescVersion.Validators[0].(func(int) error)
+	// knowledgerevisionDescContent is the schema descriptor for content field.
+	knowledgerevisionDescContent := knowledgerevisionFields[5].Descriptor()
+	// knowledgerevision.ContentValidator is a validator for the "content" field. It is called by the builders before save.
+	knowledgerevision.ContentValidator = knowledgerevisionDescContent.Validators[0].(func(string) error)
+	// knowledgerevisionDescCreatedAt is the schema descriptor for created_at field.
+	knowledgerevisionDescCreatedAt := knowledgerevisionFields[11].Descriptor()
+	// knowledgerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	knowledgerevision.DefaultCreatedAt = knowledgerevisionDescCreatedAt.Default.(func() time.Time)
+	// knowledgerevisionDescID is the schema descriptor for id field.
+	knowledgerevisionDescID := knowledgerevisionFields[0].Descriptor()
+	// knowledgerevision.DefaultID holds the default value on creation for the id field.
+	knowledgerevision.DefaultID = knowledgerevisionDescID.Default.(func() uuid.UUID)
 	learningFields := schema.Learning{}.Fields()
 	_ = learningFields
 	// learningDescTrigger is the schema descriptor for trigger field.
@@ -245,12 +312,16 @@ func init() {
 	learningDescConfidence := learningFields[9].Descriptor()
 	// learning.DefaultConfidence holds the default value on creation for the confidence field.
 	learning.DefaultConfidence = learningDescConfidence.Default.(float64)
+	// learningDescFailureCount is the schema descriptor for failure_count field.
+	learningDescFailureCount := learningFields[10].Descriptor()
+	// learning.DefaultFailureCount holds the default value on creation for the failure_count field.
+	learning.DefaultFailureCount = learningDescFailureCount.Default.(int)
 	// learningDescCreatedAt is the schema descriptor for created_at field.
-	learningDescCreatedAt := learningFields[10].Descriptor()
+	learningDescCreatedAt := learningFields[12].Descriptor()
 	// learning.DefaultCreatedAt holds the default value on creation for the created_at field.
 	learning.DefaultCreatedAt = learningDescCreatedAt.Default.(func() time.Time)
 	// learningDescUpdatedAt is the schema descriptor for updated_at field.
-	learningDescUpdatedAt := learningFields[11].Descriptor()
+	learningDescUpdatedAt := learningFields[13].Descriptor()
 	// learning.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	learning.DefaultUpdatedAt = learningDescUpdatedAt.Default.(func() time.Time)
 	// learning.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -295,8 +366,12 @@ func init() {
 	observationDescSourceEndIndex := observationFields[5].Descriptor()
 	// observation.DefaultSourceEndIndex holds the default value on creation for the source_end_index field.
 	observation.DefaultSourceEndIndex = observationDescSourceEndIndex.Default.(int)
+	// observationDescPinned is the schema descriptor for pinned field.
+	observationDescPinned := observationFields[6].Descriptor()
+	// observation.DefaultPinned holds the default value on creation for the pinned field.
+	observation.DefaultPinned = observationDescPinned.Default.(bool)
 	// observationDescCreatedAt is the schema descriptor for created_at field.
-	observationDescCreatedAt := observationFields[6].Descriptor()
+	observationDescCreatedAt := observationFields[7].Descriptor()
 	// observation.DefaultCreatedAt holds the default value on creation for the created_at field.
 	observation.DefaultCreatedAt = observationDescCreatedAt.Default.(func() time.Time)
 	// observationDescID is the schema descriptor for id field.
@@ -423,10 +498,34 @@ func init() {
 	secretDescAccessCount := secretFields[5].Descriptor()
 	// secret.DefaultAccessCount holds the default value on creation for the access_count field.
 	secret.DefaultAccessCount = secretDescAccessCount.Default.(int)
+	// secretDescVersion is the schema descriptor for version field.
+	secretDescVersion := secretFields[6].Descriptor()
+	// secret.DefaultVersion holds the default value on creation for the version field.
+	secret.DefaultVersion = secretDescVersion.Default.(int)
+	// secret.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	secret.VersionValidator = secretDescVersion.Validators[0].(func(int) error)
 	// secretDescID is the schema descriptor for id field.
 	secretDescID := secretFields[0].Descriptor()
 	// secret.DefaultID holds the default value on creation for the id field.
 	secret.DefaultID = secretDescID.Default.(func() uuid.UUID)
+	secretversionFields := schema.SecretVersion{}.Fields()
+	_ = secretversionFields
+	// secretversionDescSecretName is the schema descriptor for secret_name field.
+	secretversionDescSecretName := secretversionFields[1].Descriptor()
+	// secretversion.SecretNameValidator is a validator for the "secret_name" field. It is called by the builders before save.
+	secretversion.SecretNameValidator = secretversionDescSecretName.Validators[0].(func(string) error)
+	// secretversionDescVersion is the schema descriptor for version field.
+	secretversionDescVersion := secretversionFields[2].Descriptor()
+	// secretversion.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	secretversion.VersionValidator = secretversionDescVersion.Validators[0].(func(int) error)
+	// secretversionDescRetiredAt is the schema descriptor for retired_at field.
+	secretversionDescRetiredAt := secretversionFields[6].Descriptor()
+	// secretversion.DefaultRetiredAt holds the default value on creation for the retired_at field.
+	secretversion.DefaultRetiredAt = secretversionDescRetiredAt.Default.(func() time.Time)
+	// secretversionDescID is the schema descriptor for id field.
+	secretversionDescID := secretversionFields[0].Descriptor()
+	// secretversion.DefaultID holds the default value on creation for the id field.
+	secretversion.DefaultID = secretversionDescID.Default.(func() uuid.UUID)
 	sessionFields := schema.Session{}.Fields()
 	_ = sessionFields
 	// sessionDescKey is the schema descriptor for key field.
@@ -443,6 +542,36 @@ func init() {
 	session.DefaultUpdatedAt = sessionDescUpdatedAt.Default.(func() time.Time)
 	// session.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	session.UpdateDefaultUpdatedAt = sessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	usagerecordFields := schema.UsageRecord{}.Fields()
+	_ = usagerecordFields
+	// usagerecordDescChannel is the schema descriptor for channel field.
+	usagerecordDescChannel := usagerecordFields[1].Descriptor()
+	// usagerecord.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	usagerecord.ChannelValidator = usagerecordDescChannel.Validators[0].(func(string) error)
+	// usagerecordDescUserID is the schema descriptor for user_id field.
+	usagerecordDescUserID := usagerecordFields[2].Descriptor()
+	// usagerecord.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	usagerecord.UserIDValidator = usagerecordDescUserID.Validators[0].(func(string) error)
+	// usagerecordDescInputTokens is the schema descriptor for input_tokens field.
+	usagerecordDescInputTokens := usagerecordFields[5].Descriptor()
+	// usagerecord.DefaultInputTokens holds the default value on creation for the input_tokens field.
+	usagerecord.DefaultInputTokens = usagerecordDescInputTokens.Default.(int)
+	// usagerecordDescOutputTokens is the schema descriptor for output_tokens field.
+	usagerecordDescOutputTokens := usagerecordFields[6].Descriptor()
+	// usagerecord.DefaultOutputTokens holds the default value on creation for the output_tokens field.
+	usagerecord.DefaultOutputTokens = usagerecordDescOutputTokens.Default.(int)
+	// usagerecordDescCost is the schema descriptor for cost field.
+	usagerecordDescCost := usagerecordFields[7].Descriptor()
+	// usagerecord.DefaultCost holds the default value on creation for the cost field.
+	usagerecord.DefaultCost = usagerecordDescCost.Default.(float64)
+	// usagerecordDescCreatedAt is the schema descriptor for created_at field.
+	usagerecordDescCreatedAt := usagerecordFields[8].Descriptor()
+	// usagerecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usagerecord.DefaultCreatedAt = usagerecordDescCreatedAt.Default.(func() time.Time)
+	// usagerecordDescID is the schema descriptor for id field.
+	usagerecordDescID := usagerecordFields[0].Descriptor()
+	// usagerecord.DefaultID holds the default value on creation for the id field.
+	usagerecord.DefaultID = usagerecordDescID.Default.(func() uuid.UUID)
 	workflowrunFields := schema.WorkflowRun{}.Fields()
 	_ = workflowrunFields
 	// workflowrunDescWorkflowName is the schema descriptor for workflow_name field.
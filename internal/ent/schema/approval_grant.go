@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ApprovalGrant holds the schema definition for an "always allow" grant of
+// a tool, so that grants survive restarts and can be listed and revoked.
+type ApprovalGrant struct {
+	ent.Schema
+}
+
+// Fields of the ApprovalGrant.
+func (ApprovalGrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("tool_name").
+			NotEmpty(),
+		field.Enum("scope").
+			Values("session", "user", "global").
+			Default("session"),
+		field.String("subject").
+			Optional().
+			Comment("Session key for session grants, channel:user for user grants, empty for global grants"),
+		field.String("channel").
+			NotEmpty().
+			Comment("Channel the grant was created from (telegram, discord, gateway, ...)"),
+		field.String("session_key").
+			Optional().
+			Comment("Session the grant was created from"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("Nil for grants that never expire"),
+	}
+}
+
+// Edges of the ApprovalGrant.
+func (ApprovalGrant) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ApprovalGrant.
+func (ApprovalGrant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tool_name", "scope", "subject"),
+		index.Fields("session_key"),
+	}
+}
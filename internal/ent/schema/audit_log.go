@@ -35,6 +35,8 @@ func (AuditLog) Fields() []ent.Field {
 				"knowledge_search",
 				"approval_request",
 				"approval_response",
+				"approval_revoke",
+				"secret_access",
 			),
 		field.String("actor").
 			NotEmpty(),
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FileSnapshot holds the schema definition for an undo journal entry: the
+// state of a path before a filesystem tool changed it.
+type FileSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the FileSnapshot.
+func (FileSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("session_key").
+			Optional().
+			Comment("Session whose tool call changed the path"),
+		field.String("path").
+			NotEmpty().
+			Comment("Absolute path that was changed"),
+		field.Enum("operation").
+			Values("write", "edit", "delete"),
+		field.Enum("kind").
+			Values("none", "file", "dir", "link").
+			Comment("What existed at the path before the change; dir content is a tar archive, link content the target"),
+		field.Bytes("content").
+			Optional(),
+		field.Uint32("mode").
+			Default(0),
+		field.String("after_hash").
+			Optional().
+			Comment("SHA-256 of the file content after the change, empty for deletions"),
+		field.Bool("undone").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the FileSnapshot.
+func (FileSnapshot) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the FileSnapshot.
+func (FileSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("session_key", "created_at"),
+		index.Fields("path"),
+	}
+}
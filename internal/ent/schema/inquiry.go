@@ -30,6 +30,8 @@ func (Inquiry) Fields() []ent.Field {
 		field.Text("context").
 			Optional().
 			Nillable(),
+		field.JSON("options", []string{}).
+			Optional(),
 		field.Enum("priority").
 			Values("low", "medium", "high").
 			Default("medium"),
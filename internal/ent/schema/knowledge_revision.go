@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// KnowledgeRevision holds the schema definition for the KnowledgeRevision entity.
+// KnowledgeRevision is an append-only snapshot of a knowledge entry taken on
+// every change, so overwritten or deleted knowledge can be inspected and restored.
+type KnowledgeRevision struct {
+	ent.Schema
+}
+
+// Fields of the KnowledgeRevision.
+func (KnowledgeRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("knowledge_key").
+			NotEmpty().
+			Immutable(),
+		field.Int("version").
+			Positive().
+			Immutable(),
+		field.Enum("action").
+			Values("create", "update", "delete", "rollback").
+			Immutable(),
+		field.Enum("category").
+			Values("rule", "definition", "preference", "fact", "pattern", "correction").
+			Immutable(),
+		field.Text("content").
+			NotEmpty().
+			Immutable(),
+		field.JSON("tags", []string{}).
+			Optional().
+			Immutable(),
+		field.String("source").
+			Optional().
+			Immutable(),
+		field.Enum("author").
+			Values("agent", "user", "librarian").
+			Default("agent").
+			Immutable(),
+		field.String("session_key").
+			Optional().
+			Immutable(),
+		field.Text("reason").
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the KnowledgeRevision.
+func (KnowledgeRevision) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the KnowledgeRevision.
+func (KnowledgeRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("knowledge_key", "version").Unique(),
+	}
+}
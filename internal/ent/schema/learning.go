@@ -39,6 +39,13 @@ func (Learning) Fields() []ent.Field {
 			Default(0),
 		field.Float("confidence").
 			Default(0.5),
+		field.Int("failure_count").
+			Default(0).
+			Comment("Times a suggested fix was followed by another failure"),
+		field.Enum("review_status").
+			Values("pending", "approved", "rejected").
+			Default("approved").
+			Comment("Human review state; only approved fixes are auto-applied"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -58,5 +65,6 @@ func (Learning) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("category"),
 		index.Fields("confidence"),
+		index.Fields("review_status"),
 	}
 }
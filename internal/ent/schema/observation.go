@@ -31,6 +31,9 @@ func (Observation) Fields() []ent.Field {
 			Default(0),
 		field.Int("source_end_index").
 			Default(0),
+		field.Bool("pinned").
+			Default(false).
+			Comment("Pinned observations are always injected and never condensed"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -47,5 +50,6 @@ func (Observation) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("session_key"),
 		index.Fields("created_at"),
+		index.Fields("pinned"),
 	}
 }
@@ -37,6 +37,29 @@ func (Secret) Fields() []ent.Field {
 		field.Int("access_count").
 			Default(0).
 			Comment("Number of times this secret has been accessed"),
+		field.Int("version").
+			Positive().
+			Default(1).
+			Comment("Version of the current value; earlier values are kept as SecretVersion"),
+		field.String("owner").
+			Optional().
+			Comment("Person or team responsible for the secret"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("When the secret expires; nil means never"),
+		field.Int64("rotation_interval").
+			GoType(time.Duration(0)).
+			Optional().
+			Comment("Rotation interval; a rotation sets expires_at this far ahead"),
+		field.Time("rotated_at").
+			Optional().
+			Nillable().
+			Comment("When the value was last rotated"),
+		field.Time("expiry_notified_at").
+			Optional().
+			Nillable().
+			Comment("When the owner was last warned about the expiry; cleared on rotation"),
 	}
 }
 
@@ -56,5 +79,6 @@ func (Secret) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("name"),
 		index.Fields("created_at"),
+		index.Fields("expires_at"),
 	}
 }
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SecretVersion holds the schema definition for the SecretVersion entity.
+// SecretVersion keeps an earlier encrypted value of a secret after it was
+// rotated or overwritten, so the secret can be rolled back.
+type SecretVersion struct {
+	ent.Schema
+}
+
+// Fields of the SecretVersion.
+func (SecretVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("secret_name").
+			NotEmpty().
+			Immutable(),
+		field.Int("version").
+			Positive().
+			Immutable(),
+		field.Bytes("encrypted_value").
+			Comment("Encrypted secret data of this version; re-encrypted on passphrase migration"),
+		field.UUID("key_id", uuid.UUID{}).
+			Immutable().
+			Comment("Encryption key the value was encrypted with"),
+		field.Time("created_at").
+			Immutable().
+			Comment("When this version became the current value"),
+		field.Time("retired_at").
+			Default(time.Now).
+			Immutable().
+			Comment("When this version was replaced"),
+	}
+}
+
+// Edges of the SecretVersion.
+func (SecretVersion) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SecretVersion.
+func (SecretVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("secret_name", "version").Unique(),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// UsageRecord holds the schema definition for the usage of one agent
+// request, used to enforce and report per-user quotas.
+type UsageRecord struct {
+	ent.Schema
+}
+
+// Fields of the UsageRecord.
+func (UsageRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("channel").
+			NotEmpty().
+			Comment("Channel the request came from (telegram, discord, gateway, ...)"),
+		field.String("user_id").
+			NotEmpty().
+			Comment("Platform user ID of the requester"),
+		field.String("session_key").
+			Optional().
+			Comment("Session the request ran in"),
+		field.String("model").
+			Optional().
+			Comment("Model of the last model call"),
+		field.Int("input_tokens").
+			Default(0),
+		field.Int("output_tokens").
+			Default(0),
+		field.Float("cost").
+			Default(0).
+			Comment("Estimated cost in USD"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the UsageRecord.
+func (UsageRecord) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the UsageRecord.
+func (UsageRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("channel", "user_id", "created_at"),
+		index.Fields("channel", "created_at"),
+		index.Fields("created_at"),
+	}
+}
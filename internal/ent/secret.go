@@ -29,6 +29,18 @@ type Secret struct {
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Number of times this secret has been accessed
 	AccessCount int `json:"access_count,omitempty"`
+	// Version of the current value; earlier values are kept as SecretVersion
+	Version int `json:"version,omitempty"`
+	// Person or team responsible for the secret
+	Owner string `json:"owner,omitempty"`
+	// When the secret expires; nil means never
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Rotation interval; a rotation sets expires_at this far ahead
+	RotationInterval time.Duration `json:"rotation_interval,omitempty"`
+	// When the value was last rotated
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	// When the owner was last warned about the expiry; cleared on rotation
+	ExpiryNotifiedAt *time.Time `json:"expiry_notified_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SecretQuery when eager-loading is set.
 	Edges        SecretEdges `json:"edges"`
@@ -63,11 +75,11 @@ func (*Secret) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case secret.FieldEncryptedValue:
 			values[i] = new([]byte)
-		case secret.FieldAccessCount:
+		case secret.FieldAccessCount, secret.FieldVersion, secret.FieldRotationInterval:
 			values[i] = new(sql.NullInt64)
-		case secret.FieldName:
+		case secret.FieldName, secret.FieldOwner:
 			values[i] = new(sql.NullString)
-		case secret.FieldCreatedAt, secret.FieldUpdatedAt:
+		case secret.FieldCreatedAt, secret.FieldUpdatedAt, secret.FieldExpiresAt, secret.FieldRotatedAt, secret.FieldExpiryNotifiedAt:
 			values[i] = new(sql.NullTime)
 		case secret.FieldID:
 			values[i] = new(uuid.UUID)
@@ -124,6 +136,45 @@ func (_m *Secret) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AccessCount = int(value.Int64)
 			}
+		case secret.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case secret.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.String
+			}
+		case secret.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = new(time.Time)
+				*_m.ExpiresAt = value.Time
+			}
+		case secret.FieldRotationInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rotation_interval", values[i])
+			} else if value.Valid {
+				_m.RotationInterval = time.Duration(value.Int64)
+			}
+		case secret.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				_m.RotatedAt = new(time.Time)
+				*_m.RotatedAt = value.Time
+			}
+		case secret.FieldExpiryNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_notified_at", values[i])
+			} else if value.Valid {
+				_m.ExpiryNotifiedAt = new(time.Time)
+				*_m.ExpiryNotifiedAt = value.Time
+			}
 		case secret.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field key_secrets", values[i])
@@ -186,6 +237,30 @@ func (_m *Secret) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("access_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AccessCount))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(_m.Owner)
+	builder.WriteString(", ")
+	if v := _m.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("rotation_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RotationInterval))
+	builder.WriteString(", ")
+	if v := _m.RotatedAt; v != nil {
+		builder.WriteString("rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ExpiryNotifiedAt; v != nil {
+		builder.WriteString("expiry_notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,6 +25,18 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// FieldAccessCount holds the string denoting the access_count field in the database.
 	FieldAccessCount = "access_count"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldRotationInterval holds the string denoting the rotation_interval field in the database.
+	FieldRotationInterval = "rotation_interval"
+	// FieldRotatedAt holds the string denoting the rotated_at field in the database.
+	FieldRotatedAt = "rotated_at"
+	// FieldExpiryNotifiedAt holds the string denoting the expiry_notified_at field in the database.
+	FieldExpiryNotifiedAt = "expiry_notified_at"
 	// EdgeKey holds the string denoting the key edge name in mutations.
 	EdgeKey = "key"
 	// Table holds the table name of the secret in the database.
@@ -46,6 +58,12 @@ var Columns = []string{
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldAccessCount,
+	FieldVersion,
+	FieldOwner,
+	FieldExpiresAt,
+	FieldRotationInterval,
+	FieldRotatedAt,
+	FieldExpiryNotifiedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "secrets"
@@ -80,6 +98,10 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultAccessCount holds the default value on creation for the "access_count" field.
 	DefaultAccessCount int
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -112,6 +134,36 @@ func ByAccessCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccessCount, opts...).ToFunc()
 }
 
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByRotationInterval orders the results by the rotation_interval field.
+func ByRotationInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotationInterval, opts...).ToFunc()
+}
+
+// ByRotatedAt orders the results by the rotated_at field.
+func ByRotatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotatedAt, opts...).ToFunc()
+}
+
+// ByExpiryNotifiedAt orders the results by the expiry_notified_at field.
+func ByExpiryNotifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryNotifiedAt, opts...).ToFunc()
+}
+
 // ByKeyField orders the results by key field.
 func ByKeyField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
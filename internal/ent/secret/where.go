@@ -81,6 +81,37 @@ func AccessCount(v int) predicate.Secret {
 	return predicate.Secret(sql.FieldEQ(FieldAccessCount, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldVersion, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldOwner, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RotationInterval applies equality check predicate on the "rotation_interval" field. It's identical to RotationIntervalEQ.
+func RotationInterval(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldEQ(FieldRotationInterval, vc))
+}
+
+// RotatedAt applies equality check predicate on the "rotated_at" field. It's identical to RotatedAtEQ.
+func RotatedAt(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// ExpiryNotifiedAt applies equality check predicate on the "expiry_notified_at" field. It's identical to ExpiryNotifiedAtEQ.
+func ExpiryNotifiedAt(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldExpiryNotifiedAt, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Secret {
 	return predicate.Secret(sql.FieldEQ(FieldName, v))
@@ -306,6 +337,335 @@ func AccessCountLTE(v int) predicate.Secret {
 	return predicate.Secret(sql.FieldLTE(FieldAccessCount, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldVersion, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Secret {
+	return predicate.Secret(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// RotationIntervalEQ applies the EQ predicate on the "rotation_interval" field.
+func RotationIntervalEQ(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldEQ(FieldRotationInterval, vc))
+}
+
+// RotationIntervalNEQ applies the NEQ predicate on the "rotation_interval" field.
+func RotationIntervalNEQ(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldNEQ(FieldRotationInterval, vc))
+}
+
+// RotationIntervalIn applies the In predicate on the "rotation_interval" field.
+func RotationIntervalIn(vs ...time.Duration) predicate.Secret {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Secret(sql.FieldIn(FieldRotationInterval, v...))
+}
+
+// RotationIntervalNotIn applies the NotIn predicate on the "rotation_interval" field.
+func RotationIntervalNotIn(vs ...time.Duration) predicate.Secret {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = int64(vs[i])
+	}
+	return predicate.Secret(sql.FieldNotIn(FieldRotationInterval, v...))
+}
+
+// RotationIntervalGT applies the GT predicate on the "rotation_interval" field.
+func RotationIntervalGT(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldGT(FieldRotationInterval, vc))
+}
+
+// RotationIntervalGTE applies the GTE predicate on the "rotation_interval" field.
+func RotationIntervalGTE(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldGTE(FieldRotationInterval, vc))
+}
+
+// RotationIntervalLT applies the LT predicate on the "rotation_interval" field.
+func RotationIntervalLT(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldLT(FieldRotationInterval, vc))
+}
+
+// RotationIntervalLTE applies the LTE predicate on the "rotation_interval" field.
+func RotationIntervalLTE(v time.Duration) predicate.Secret {
+	vc := int64(v)
+	return predicate.Secret(sql.FieldLTE(FieldRotationInterval, vc))
+}
+
+// RotationIntervalIsNil applies the IsNil predicate on the "rotation_interval" field.
+func RotationIntervalIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldRotationInterval))
+}
+
+// RotationIntervalNotNil applies the NotNil predicate on the "rotation_interval" field.
+func RotationIntervalNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldRotationInterval))
+}
+
+// RotatedAtEQ applies the EQ predicate on the "rotated_at" field.
+func RotatedAtEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtNEQ applies the NEQ predicate on the "rotated_at" field.
+func RotatedAtNEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtIn applies the In predicate on the "rotated_at" field.
+func RotatedAtIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtNotIn applies the NotIn predicate on the "rotated_at" field.
+func RotatedAtNotIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtGT applies the GT predicate on the "rotated_at" field.
+func RotatedAtGT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldRotatedAt, v))
+}
+
+// RotatedAtGTE applies the GTE predicate on the "rotated_at" field.
+func RotatedAtGTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldRotatedAt, v))
+}
+
+// RotatedAtLT applies the LT predicate on the "rotated_at" field.
+func RotatedAtLT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldRotatedAt, v))
+}
+
+// RotatedAtLTE applies the LTE predicate on the "rotated_at" field.
+func RotatedAtLTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldRotatedAt, v))
+}
+
+// RotatedAtIsNil applies the IsNil predicate on the "rotated_at" field.
+func RotatedAtIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldRotatedAt))
+}
+
+// RotatedAtNotNil applies the NotNil predicate on the "rotated_at" field.
+func RotatedAtNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldRotatedAt))
+}
+
+// ExpiryNotifiedAtEQ applies the EQ predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldEQ(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtNEQ applies the NEQ predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtNEQ(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNEQ(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtIn applies the In predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldIn(FieldExpiryNotifiedAt, vs...))
+}
+
+// ExpiryNotifiedAtNotIn applies the NotIn predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtNotIn(vs ...time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldNotIn(FieldExpiryNotifiedAt, vs...))
+}
+
+// ExpiryNotifiedAtGT applies the GT predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtGT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGT(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtGTE applies the GTE predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtGTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldGTE(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtLT applies the LT predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtLT(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLT(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtLTE applies the LTE predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtLTE(v time.Time) predicate.Secret {
+	return predicate.Secret(sql.FieldLTE(FieldExpiryNotifiedAt, v))
+}
+
+// ExpiryNotifiedAtIsNil applies the IsNil predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtIsNil() predicate.Secret {
+	return predicate.Secret(sql.FieldIsNull(FieldExpiryNotifiedAt))
+}
+
+// ExpiryNotifiedAtNotNil applies the NotNil predicate on the "expiry_notified_at" field.
+func ExpiryNotifiedAtNotNil() predicate.Secret {
+	return predicate.Secret(sql.FieldNotNull(FieldExpiryNotifiedAt))
+}
+
 // HasKey applies the HasEdge predicate on the "key" edge.
 func HasKey() predicate.Secret {
 	return predicate.Secret(func(s *sql.Selector) {
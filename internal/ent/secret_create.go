@@ -76,6 +76,90 @@ func (_c *SecretCreate) SetNillableAccessCount(v *int) *SecretCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *SecretCreate) SetVersion(v int) *SecretCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableVersion(v *int) *SecretCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *SecretCreate) SetOwner(v string) *SecretCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableOwner(v *string) *SecretCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *SecretCreate) SetExpiresAt(v time.Time) *SecretCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableExpiresAt(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRotationInterval sets the "rotation_interval" field.
+func (_c *SecretCreate) SetRotationInterval(v time.Duration) *SecretCreate {
+	_c.mutation.SetRotationInterval(v)
+	return _c
+}
+
+// SetNillableRotationInterval sets the "rotation_interval" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableRotationInterval(v *time.Duration) *SecretCreate {
+	if v != nil {
+		_c.SetRotationInterval(*v)
+	}
+	return _c
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_c *SecretCreate) SetRotatedAt(v time.Time) *SecretCreate {
+	_c.mutation.SetRotatedAt(v)
+	return _c
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableRotatedAt(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetRotatedAt(*v)
+	}
+	return _c
+}
+
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (_c *SecretCreate) SetExpiryNotifiedAt(v time.Time) *SecretCreate {
+	_c.mutation.SetExpiryNotifiedAt(v)
+	return _c
+}
+
+// SetNillableExpiryNotifiedAt sets the "expiry_notified_at" field if the given value is not nil.
+func (_c *SecretCreate) SetNillableExpiryNotifiedAt(v *time.Time) *SecretCreate {
+	if v != nil {
+		_c.SetExpiryNotifiedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SecretCreate) SetID(v uuid.UUID) *SecretCreate {
 	_c.mutation.SetID(v)
@@ -148,6 +232,10 @@ func (_c *SecretCreate) defaults() {
 		v := secret.DefaultAccessCount
 		_c.mutation.SetAccessCount(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := secret.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := secret.DefaultID()
 		_c.mutation.SetID(v)
@@ -176,6 +264,14 @@ func (_c *SecretCreate) check() error {
 	if _, ok := _c.mutation.AccessCount(); !ok {
 		return &ValidationError{Name: "access_count", err: errors.New(`ent: missing required field "Secret.access_count"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Secret.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := secret.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Secret.version": %w`, err)}
+		}
+	}
 	if len(_c.mutation.KeyIDs()) == 0 {
 		return &ValidationError{Name: "key", err: errors.New(`ent: missing required edge "Secret.key"`)}
 	}
@@ -234,6 +330,30 @@ func (_c *SecretCreate) createSpec() (*Secret, *sqlgraph.CreateSpec) {
 		_spec.SetField(secret.FieldAccessCount, field.TypeInt, value)
 		_node.AccessCount = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(secret.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(secret.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(secret.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.RotationInterval(); ok {
+		_spec.SetField(secret.FieldRotationInterval, field.TypeInt64, value)
+		_node.RotationInterval = value
+	}
+	if value, ok := _c.mutation.RotatedAt(); ok {
+		_spec.SetField(secret.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
+	if value, ok := _c.mutation.ExpiryNotifiedAt(); ok {
+		_spec.SetField(secret.FieldExpiryNotifiedAt, field.TypeTime, value)
+		_node.ExpiryNotifiedAt = &value
+	}
 	if nodes := _c.mutation.KeyIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -77,6 +77,134 @@ func (_u *SecretUpdate) AddAccessCount(v int) *SecretUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *SecretUpdate) SetVersion(v int) *SecretUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableVersion(v *int) *SecretUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *SecretUpdate) AddVersion(v int) *SecretUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *SecretUpdate) SetOwner(v string) *SecretUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableOwner(v *string) *SecretUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *SecretUpdate) ClearOwner() *SecretUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SecretUpdate) SetExpiresAt(v time.Time) *SecretUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableExpiresAt(v *time.Time) *SecretUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *SecretUpdate) ClearExpiresAt() *SecretUpdate {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRotationInterval sets the "rotation_interval" field.
+func (_u *SecretUpdate) SetRotationInterval(v time.Duration) *SecretUpdate {
+	_u.mutation.ResetRotationInterval()
+	_u.mutation.SetRotationInterval(v)
+	return _u
+}
+
+// SetNillableRotationInterval sets the "rotation_interval" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableRotationInterval(v *time.Duration) *SecretUpdate {
+	if v != nil {
+		_u.SetRotationInterval(*v)
+	}
+	return _u
+}
+
+// AddRotationInterval adds value to the "rotation_interval" field.
+func (_u *SecretUpdate) AddRotationInterval(v time.Duration) *SecretUpdate {
+	_u.mutation.AddRotationInterval(v)
+	return _u
+}
+
+// ClearRotationInterval clears the value of the "rotation_interval" field.
+func (_u *SecretUpdate) ClearRotationInterval() *SecretUpdate {
+	_u.mutation.ClearRotationInterval()
+	return _u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *SecretUpdate) SetRotatedAt(v time.Time) *SecretUpdate {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableRotatedAt(v *time.Time) *SecretUpdate {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *SecretUpdate) ClearRotatedAt() *SecretUpdate {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (_u *SecretUpdate) SetExpiryNotifiedAt(v time.Time) *SecretUpdate {
+	_u.mutation.SetExpiryNotifiedAt(v)
+	return _u
+}
+
+// SetNillableExpiryNotifiedAt sets the "expiry_notified_at" field if the given value is not nil.
+func (_u *SecretUpdate) SetNillableExpiryNotifiedAt(v *time.Time) *SecretUpdate {
+	if v != nil {
+		_u.SetExpiryNotifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (_u *SecretUpdate) ClearExpiryNotifiedAt() *SecretUpdate {
+	_u.mutation.ClearExpiryNotifiedAt()
+	return _u
+}
+
 // SetKeyID sets the "key" edge to the Key entity by ID.
 func (_u *SecretUpdate) SetKeyID(id uuid.UUID) *SecretUpdate {
 	_u.mutation.SetKeyID(id)
@@ -142,6 +270,11 @@ func (_u *SecretUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Secret.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := secret.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Secret.version": %w`, err)}
+		}
+	}
 	if _u.mutation.KeyCleared() && len(_u.mutation.KeyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Secret.key"`)
 	}
@@ -175,6 +308,45 @@ func (_u *SecretUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedAccessCount(); ok {
 		_spec.AddField(secret.FieldAccessCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(secret.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(secret.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(secret.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(secret.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(secret.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(secret.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RotationInterval(); ok {
+		_spec.SetField(secret.FieldRotationInterval, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRotationInterval(); ok {
+		_spec.AddField(secret.FieldRotationInterval, field.TypeInt64, value)
+	}
+	if _u.mutation.RotationIntervalCleared() {
+		_spec.ClearField(secret.FieldRotationInterval, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(secret.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(secret.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiryNotifiedAt(); ok {
+		_spec.SetField(secret.FieldExpiryNotifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryNotifiedAtCleared() {
+		_spec.ClearField(secret.FieldExpiryNotifiedAt, field.TypeTime)
+	}
 	if _u.mutation.KeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -271,6 +443,134 @@ func (_u *SecretUpdateOne) AddAccessCount(v int) *SecretUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *SecretUpdateOne) SetVersion(v int) *SecretUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableVersion(v *int) *SecretUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *SecretUpdateOne) AddVersion(v int) *SecretUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *SecretUpdateOne) SetOwner(v string) *SecretUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableOwner(v *string) *SecretUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *SecretUpdateOne) ClearOwner() *SecretUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SecretUpdateOne) SetExpiresAt(v time.Time) *SecretUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableExpiresAt(v *time.Time) *SecretUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (_u *SecretUpdateOne) ClearExpiresAt() *SecretUpdateOne {
+	_u.mutation.ClearExpiresAt()
+	return _u
+}
+
+// SetRotationInterval sets the "rotation_interval" field.
+func (_u *SecretUpdateOne) SetRotationInterval(v time.Duration) *SecretUpdateOne {
+	_u.mutation.ResetRotationInterval()
+	_u.mutation.SetRotationInterval(v)
+	return _u
+}
+
+// SetNillableRotationInterval sets the "rotation_interval" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableRotationInterval(v *time.Duration) *SecretUpdateOne {
+	if v != nil {
+		_u.SetRotationInterval(*v)
+	}
+	return _u
+}
+
+// AddRotationInterval adds value to the "rotation_interval" field.
+func (_u *SecretUpdateOne) AddRotationInterval(v time.Duration) *SecretUpdateOne {
+	_u.mutation.AddRotationInterval(v)
+	return _u
+}
+
+// ClearRotationInterval clears the value of the "rotation_interval" field.
+func (_u *SecretUpdateOne) ClearRotationInterval() *SecretUpdateOne {
+	_u.mutation.ClearRotationInterval()
+	return _u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (_u *SecretUpdateOne) SetRotatedAt(v time.Time) *SecretUpdateOne {
+	_u.mutation.SetRotatedAt(v)
+	return _u
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableRotatedAt(v *time.Time) *SecretUpdateOne {
+	if v != nil {
+		_u.SetRotatedAt(*v)
+	}
+	return _u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (_u *SecretUpdateOne) ClearRotatedAt() *SecretUpdateOne {
+	_u.mutation.ClearRotatedAt()
+	return _u
+}
+
+// SetExpiryNotifiedAt sets the "expiry_notified_at" field.
+func (_u *SecretUpdateOne) SetExpiryNotifiedAt(v time.Time) *SecretUpdateOne {
+	_u.mutation.SetExpiryNotifiedAt(v)
+	return _u
+}
+
+// SetNillableExpiryNotifiedAt sets the "expiry_notified_at" field if the given value is not nil.
+func (_u *SecretUpdateOne) SetNillableExpiryNotifiedAt(v *time.Time) *SecretUpdateOne {
+	if v != nil {
+		_u.SetExpiryNotifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearExpiryNotifiedAt clears the value of the "expiry_notified_at" field.
+func (_u *SecretUpdateOne) ClearExpiryNotifiedAt() *SecretUpdateOne {
+	_u.mutation.ClearExpiryNotifiedAt()
+	return _u
+}
+
 // SetKeyID sets the "key" edge to the Key entity by ID.
 func (_u *SecretUpdateOne) SetKeyID(id uuid.UUID) *SecretUpdateOne {
 	_u.mutation.SetKeyID(id)
@@ -349,6 +649,11 @@ func (_u *SecretUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Secret.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := secret.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Secret.version": %w`, err)}
+		}
+	}
 	if _u.mutation.KeyCleared() && len(_u.mutation.KeyIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Secret.key"`)
 	}
@@ -399,6 +704,45 @@ func (_u *SecretUpdateOne) sqlSave(ctx context.Context) (_node *Secret, err erro
 	if value, ok := _u.mutation.AddedAccessCount(); ok {
 		_spec.AddField(secret.FieldAccessCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(secret.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(secret.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(secret.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(secret.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(secret.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(secret.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RotationInterval(); ok {
+		_spec.SetField(secret.FieldRotationInterval, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedRotationInterval(); ok {
+		_spec.AddField(secret.FieldRotationInterval, field.TypeInt64, value)
+	}
+	if _u.mutation.RotationIntervalCleared() {
+		_spec.ClearField(secret.FieldRotationInterval, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RotatedAt(); ok {
+		_spec.SetField(secret.FieldRotatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RotatedAtCleared() {
+		_spec.ClearField(secret.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ExpiryNotifiedAt(); ok {
+		_spec.SetField(secret.FieldExpiryNotifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ExpiryNotifiedAtCleared() {
+		_spec.ClearField(secret.FieldExpiryNotifiedAt, field.TypeTime)
+	}
 	if _u.mutation.KeyCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
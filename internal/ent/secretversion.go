@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/secretversion"
+)
+
+// SecretVersion is the model entity for the SecretVersion schema.
+type SecretVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// SecretName holds the value of the "secret_name" field.
+	SecretName string `json:"secret_name,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Encrypted secret data of this version; re-encrypted on passphrase migration
+	EncryptedValue []byte `json:"encrypted_value,omitempty"`
+	// Encryption key the value was encrypted with
+	KeyID uuid.UUID `json:"key_id,omitempty"`
+	// When this version became the current value
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// When this version was replaced
+	RetiredAt    time.Time `json:"retired_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecretVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case secretversion.FieldEncryptedValue:
+			values[i] = new([]byte)
+		case secretversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case secretversion.FieldSecretName:
+			values[i] = new(sql.NullString)
+		case secretversion.FieldCreatedAt, secretversion.FieldRetiredAt:
+			values[i] = new(sql.NullTime)
+		case secretversion.FieldID, secretversion.FieldKeyID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecretVersion fields.
+func (_m *SecretVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case secretversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case secretversion.FieldSecretName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret_name", values[i])
+			} else if value.Valid {
+				_m.SecretName = value.String
+			}
+		case secretversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case secretversion.FieldEncryptedValue:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field encrypted_value", values[i])
+			} else if value != nil {
+				_m.EncryptedValue = *value
+			}
+		case secretversion.FieldKeyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value != nil {
+				_m.KeyID = *value
+			}
+		case secretversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case secretversion.FieldRetiredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retired_at", values[i])
+			} else if value.Valid {
+				_m.RetiredAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecretVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *SecretVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SecretVersion.
+// Note that you need to call SecretVersion.Unwrap() before calling this method if this SecretVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SecretVersion) Update() *SecretVersionUpdateOne {
+	return NewSecretVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SecretVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SecretVersion) Unwrap() *SecretVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SecretVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SecretVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecretVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("secret_name=")
+	builder.WriteString(_m.SecretName)
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("encrypted_value=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EncryptedValue))
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.KeyID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("retired_at=")
+	builder.WriteString(_m.RetiredAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecretVersions is a parsable slice of SecretVersion.
+type SecretVersions []*SecretVersion
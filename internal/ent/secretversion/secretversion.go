@@ -0,0 +1,96 @@
+// Code generated by ent, DO NOT EDIT.
+
+package secretversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the secretversion type in the database.
+	Label = "secret_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSecretName holds the string denoting the secret_name field in the database.
+	FieldSecretName = "secret_name"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldEncryptedValue holds the string denoting the encrypted_value field in the database.
+	FieldEncryptedValue = "encrypted_value"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldRetiredAt holds the string denoting the retired_at field in the database.
+	FieldRetiredAt = "retired_at"
+	// Table holds the table name of the secretversion in the database.
+	Table = "secret_versions"
+)
+
+// Columns holds all SQL columns for secretversion fields.
+var Columns = []string{
+	FieldID,
+	FieldSecretName,
+	FieldVersion,
+	FieldEncryptedValue,
+	FieldKeyID,
+	FieldCreatedAt,
+	FieldRetiredAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SecretNameValidator is a validator for the "secret_name" field. It is called by the builders before save.
+	SecretNameValidator func(string) error
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
+	// DefaultRetiredAt holds the default value on creation for the "retired_at" field.
+	DefaultRetiredAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SecretVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySecretName orders the results by the secret_name field.
+func BySecretName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecretName, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByRetiredAt orders the results by the retired_at field.
+func ByRetiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetiredAt, opts...).ToFunc()
+}
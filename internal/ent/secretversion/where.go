@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package secretversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldID, id))
+}
+
+// SecretName applies equality check predicate on the "secret_name" field. It's identical to SecretNameEQ.
+func SecretName(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldSecretName, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// EncryptedValue applies equality check predicate on the "encrypted_value" field. It's identical to EncryptedValueEQ.
+func EncryptedValue(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldEncryptedValue, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldKeyID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// RetiredAt applies equality check predicate on the "retired_at" field. It's identical to RetiredAtEQ.
+func RetiredAt(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// SecretNameEQ applies the EQ predicate on the "secret_name" field.
+func SecretNameEQ(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldSecretName, v))
+}
+
+// SecretNameNEQ applies the NEQ predicate on the "secret_name" field.
+func SecretNameNEQ(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldSecretName, v))
+}
+
+// SecretNameIn applies the In predicate on the "secret_name" field.
+func SecretNameIn(vs ...string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldSecretName, vs...))
+}
+
+// SecretNameNotIn applies the NotIn predicate on the "secret_name" field.
+func SecretNameNotIn(vs ...string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldSecretName, vs...))
+}
+
+// SecretNameGT applies the GT predicate on the "secret_name" field.
+func SecretNameGT(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldSecretName, v))
+}
+
+// SecretNameGTE applies the GTE predicate on the "secret_name" field.
+func SecretNameGTE(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldSecretName, v))
+}
+
+// SecretNameLT applies the LT predicate on the "secret_name" field.
+func SecretNameLT(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldSecretName, v))
+}
+
+// SecretNameLTE applies the LTE predicate on the "secret_name" field.
+func SecretNameLTE(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldSecretName, v))
+}
+
+// SecretNameContains applies the Contains predicate on the "secret_name" field.
+func SecretNameContains(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldContains(FieldSecretName, v))
+}
+
+// SecretNameHasPrefix applies the HasPrefix predicate on the "secret_name" field.
+func SecretNameHasPrefix(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldHasPrefix(FieldSecretName, v))
+}
+
+// SecretNameHasSuffix applies the HasSuffix predicate on the "secret_name" field.
+func SecretNameHasSuffix(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldHasSuffix(FieldSecretName, v))
+}
+
+// SecretNameEqualFold applies the EqualFold predicate on the "secret_name" field.
+func SecretNameEqualFold(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEqualFold(FieldSecretName, v))
+}
+
+// SecretNameContainsFold applies the ContainsFold predicate on the "secret_name" field.
+func SecretNameContainsFold(v string) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldContainsFold(FieldSecretName, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// EncryptedValueEQ applies the EQ predicate on the "encrypted_value" field.
+func EncryptedValueEQ(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldEncryptedValue, v))
+}
+
+// EncryptedValueNEQ applies the NEQ predicate on the "encrypted_value" field.
+func EncryptedValueNEQ(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldEncryptedValue, v))
+}
+
+// EncryptedValueIn applies the In predicate on the "encrypted_value" field.
+func EncryptedValueIn(vs ...[]byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldEncryptedValue, vs...))
+}
+
+// EncryptedValueNotIn applies the NotIn predicate on the "encrypted_value" field.
+func EncryptedValueNotIn(vs ...[]byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldEncryptedValue, vs...))
+}
+
+// EncryptedValueGT applies the GT predicate on the "encrypted_value" field.
+func EncryptedValueGT(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldEncryptedValue, v))
+}
+
+// EncryptedValueGTE applies the GTE predicate on the "encrypted_value" field.
+func EncryptedValueGTE(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldEncryptedValue, v))
+}
+
+// EncryptedValueLT applies the LT predicate on the "encrypted_value" field.
+func EncryptedValueLT(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldEncryptedValue, v))
+}
+
+// EncryptedValueLTE applies the LTE predicate on the "encrypted_value" field.
+func EncryptedValueLTE(v []byte) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldEncryptedValue, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v uuid.UUID) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldKeyID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// RetiredAtEQ applies the EQ predicate on the "retired_at" field.
+func RetiredAtEQ(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtNEQ applies the NEQ predicate on the "retired_at" field.
+func RetiredAtNEQ(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNEQ(FieldRetiredAt, v))
+}
+
+// RetiredAtIn applies the In predicate on the "retired_at" field.
+func RetiredAtIn(vs ...time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtNotIn applies the NotIn predicate on the "retired_at" field.
+func RetiredAtNotIn(vs ...time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldNotIn(FieldRetiredAt, vs...))
+}
+
+// RetiredAtGT applies the GT predicate on the "retired_at" field.
+func RetiredAtGT(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGT(FieldRetiredAt, v))
+}
+
+// RetiredAtGTE applies the GTE predicate on the "retired_at" field.
+func RetiredAtGTE(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldGTE(FieldRetiredAt, v))
+}
+
+// RetiredAtLT applies the LT predicate on the "retired_at" field.
+func RetiredAtLT(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLT(FieldRetiredAt, v))
+}
+
+// RetiredAtLTE applies the LTE predicate on the "retired_at" field.
+func RetiredAtLTE(v time.Time) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.FieldLTE(FieldRetiredAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SecretVersion) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SecretVersion) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SecretVersion) predicate.SecretVersion {
+	return predicate.SecretVersion(sql.NotPredicates(p))
+}
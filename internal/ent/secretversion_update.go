@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/predicate"
+	"github.com/langoai/lango/internal/ent/secretversion"
+)
+
+// SecretVersionUpdate is the builder for updating SecretVersion entities.
+type SecretVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SecretVersionMutation
+}
+
+// Where appends a list predicates to the SecretVersionUpdate builder.
+func (_u *SecretVersionUpdate) Where(ps ...predicate.SecretVersion) *SecretVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEncryptedValue sets the "encrypted_value" field.
+func (_u *SecretVersionUpdate) SetEncryptedValue(v []byte) *SecretVersionUpdate {
+	_u.mutation.SetEncryptedValue(v)
+	return _u
+}
+
+// Mutation returns the SecretVersionMutation object of the builder.
+func (_u *SecretVersionUpdate) Mutation() *SecretVersionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SecretVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SecretVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SecretVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SecretVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SecretVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(secretversion.Table, secretversion.Columns, sqlgraph.NewFieldSpec(secretversion.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EncryptedValue(); ok {
+		_spec.SetField(secretversion.FieldEncryptedValue, field.TypeBytes, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{secretversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SecretVersionUpdateOne is the builder for updating a single SecretVersion entity.
+type SecretVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SecretVersionMutation
+}
+
+// SetEncryptedValue sets the "encrypted_value" field.
+func (_u *SecretVersionUpdateOne) SetEncryptedValue(v []byte) *SecretVersionUpdateOne {
+	_u.mutation.SetEncryptedValue(v)
+	return _u
+}
+
+// Mutation returns the SecretVersionMutation object of the builder.
+func (_u *SecretVersionUpdateOne) Mutation() *SecretVersionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SecretVersionUpdate builder.
+func (_u *SecretVersionUpdateOne) Where(ps ...predicate.SecretVersion) *SecretVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SecretVersionUpdateOne) Select(field string, fields ...string) *SecretVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SecretVersion entity.
+func (_u *SecretVersionUpdateOne) Save(ctx context.Context) (*SecretVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SecretVersionUpdateOne) SaveX(ctx context.Context) *SecretVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SecretVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SecretVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *SecretVersionUpdateOne) sqlSave(ctx context.Context) (_node *SecretVersion, err error) {
+	_spec := sqlgraph.NewUpdateSpec(secretversion.Table, secretversion.Columns, sqlgraph.NewFieldSpec(secretversion.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SecretVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, secretversion.FieldID)
+		for _, f := range fields {
+			if !secretversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != secretversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EncryptedValue(); ok {
+		_spec.SetField(secretversion.FieldEncryptedValue, field.TypeBytes, value)
+	}
+	_node = &SecretVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{secretversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApprovalGrant is the client for interacting with the ApprovalGrant builders.
+	ApprovalGrant *ApprovalGrantClient
 	// AuditLog is the client for interacting with the AuditLog builders.
 	AuditLog *AuditLogClient
 	// ConfigProfile is the client for interacting with the ConfigProfile builders.
@@ -22,12 +24,16 @@ type Tx struct {
 	CronJobHistory *CronJobHistoryClient
 	// ExternalRef is the client for interacting with the ExternalRef builders.
 	ExternalRef *ExternalRefClient
+	// FileSnapshot is the client for interacting with the FileSnapshot builders.
+	FileSnapshot *FileSnapshotClient
 	// Inquiry is the client for interacting with the Inquiry builders.
 	Inquiry *InquiryClient
 	// Key is the client for interacting with the Key builders.
 	Key *KeyClient
 	// Knowledge is the client for interacting with the Knowledge builders.
 	Knowledge *KnowledgeClient
+	// KnowledgeRevision is the client for interacting with the KnowledgeRevision builders.
+	KnowledgeRevision *KnowledgeRevisionClient
 	// Learning is the client for interacting with the Learning builders.
 	Learning *LearningClient
 	// Message is the client for interacting with the Message builders.
@@ -42,8 +48,12 @@ type Tx struct {
 	Reflection *ReflectionClient
 	// Secret is the client for interacting with the Secret builders.
 	Secret *SecretClient
+	// SecretVersion is the client for interacting with the SecretVersion builders.
+	SecretVersion *SecretVersionClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
+	// UsageRecord is the client for interacting with the UsageRecord builders.
+	UsageRecord *UsageRecordClient
 	// WorkflowRun is the client for interacting with the WorkflowRun builders.
 	WorkflowRun *WorkflowRunClient
 	// WorkflowStepRun is the client for interacting with the WorkflowStepRun builders.
@@ -179,14 +189,17 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApprovalGrant = NewApprovalGrantClient(tx.config)
 	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.ConfigProfile = NewConfigProfileClient(tx.config)
 	tx.CronJob = NewCronJobClient(tx.config)
 	tx.CronJobHistory = NewCronJobHistoryClient(tx.config)
 	tx.ExternalRef = NewExternalRefClient(tx.config)
+	tx.FileSnapshot = NewFileSnapshotClient(tx.config)
 	tx.Inquiry = NewInquiryClient(tx.config)
 	tx.Key = NewKeyClient(tx.config)
 	tx.Knowledge = NewKnowledgeClient(tx.config)
+	tx.KnowledgeRevision = NewKnowledgeRevisionClient(tx.config)
 	tx.Learning = NewLearningClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.Observation = NewObservationClient(tx.config)
@@ -194,7 +207,9 @@ func (tx *Tx) init() {
 	tx.PeerReputation = NewPeerReputationClient(tx.config)
 	tx.Reflection = NewReflectionClient(tx.config)
 	tx.Secret = NewSecretClient(tx.config)
+	tx.SecretVersion = NewSecretVersionClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
+	tx.UsageRecord = NewUsageRecordClient(tx.config)
 	tx.WorkflowRun = NewWorkflowRunClient(tx.config)
 	tx.WorkflowStepRun = NewWorkflowStepRunClient(tx.config)
 }
@@ -206,7 +221,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
+// applies a query, for example: ApprovalGrant.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
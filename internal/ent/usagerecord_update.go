@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/langoai/lango/internal/ent/predicate"
+	"github.com/langoai/lango/internal/ent/usagerecord"
+)
+
+// UsageRecordUpdate is the builder for updating UsageRecord entities.
+type UsageRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (_u *UsageRecordUpdate) Where(ps ...predicate.UsageRecord) *UsageRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *UsageRecordUpdate) SetChannel(v string) *UsageRecordUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableChannel(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UsageRecordUpdate) SetUserID(v string) *UsageRecordUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableUserID(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *UsageRecordUpdate) SetSessionKey(v string) *UsageRecordUpdate {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableSessionKey(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (_u *UsageRecordUpdate) ClearSessionKey() *UsageRecordUpdate {
+	_u.mutation.ClearSessionKey()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *UsageRecordUpdate) SetModel(v string) *UsageRecordUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableModel(v *string) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *UsageRecordUpdate) ClearModel() *UsageRecordUpdate {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (_u *UsageRecordUpdate) SetInputTokens(v int) *UsageRecordUpdate {
+	_u.mutation.ResetInputTokens()
+	_u.mutation.SetInputTokens(v)
+	return _u
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableInputTokens(v *int) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetInputTokens(*v)
+	}
+	return _u
+}
+
+// AddInputTokens adds value to the "input_tokens" field.
+func (_u *UsageRecordUpdate) AddInputTokens(v int) *UsageRecordUpdate {
+	_u.mutation.AddInputTokens(v)
+	return _u
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (_u *UsageRecordUpdate) SetOutputTokens(v int) *UsageRecordUpdate {
+	_u.mutation.ResetOutputTokens()
+	_u.mutation.SetOutputTokens(v)
+	return _u
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableOutputTokens(v *int) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetOutputTokens(*v)
+	}
+	return _u
+}
+
+// AddOutputTokens adds value to the "output_tokens" field.
+func (_u *UsageRecordUpdate) AddOutputTokens(v int) *UsageRecordUpdate {
+	_u.mutation.AddOutputTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *UsageRecordUpdate) SetCost(v float64) *UsageRecordUpdate {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *UsageRecordUpdate) SetNillableCost(v *float64) *UsageRecordUpdate {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *UsageRecordUpdate) AddCost(v float64) *UsageRecordUpdate {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_u *UsageRecordUpdate) Mutation() *UsageRecordMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UsageRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UsageRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRecordUpdate) check() error {
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := usagerecord.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := usagerecord.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(usagerecord.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usagerecord.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(usagerecord.FieldSessionKey, field.TypeString, value)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(usagerecord.FieldSessionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(usagerecord.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(usagerecord.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.InputTokens(); ok {
+		_spec.SetField(usagerecord.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTokens(); ok {
+		_spec.AddField(usagerecord.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTokens(); ok {
+		_spec.SetField(usagerecord.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(usagerecord.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(usagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(usagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UsageRecordUpdateOne is the builder for updating a single UsageRecord entity.
+type UsageRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UsageRecordMutation
+}
+
+// SetChannel sets the "channel" field.
+func (_u *UsageRecordUpdateOne) SetChannel(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableChannel(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UsageRecordUpdateOne) SetUserID(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableUserID(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSessionKey sets the "session_key" field.
+func (_u *UsageRecordUpdateOne) SetSessionKey(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetSessionKey(v)
+	return _u
+}
+
+// SetNillableSessionKey sets the "session_key" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableSessionKey(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetSessionKey(*v)
+	}
+	return _u
+}
+
+// ClearSessionKey clears the value of the "session_key" field.
+func (_u *UsageRecordUpdateOne) ClearSessionKey() *UsageRecordUpdateOne {
+	_u.mutation.ClearSessionKey()
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *UsageRecordUpdateOne) SetModel(v string) *UsageRecordUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableModel(v *string) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// ClearModel clears the value of the "model" field.
+func (_u *UsageRecordUpdateOne) ClearModel() *UsageRecordUpdateOne {
+	_u.mutation.ClearModel()
+	return _u
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (_u *UsageRecordUpdateOne) SetInputTokens(v int) *UsageRecordUpdateOne {
+	_u.mutation.ResetInputTokens()
+	_u.mutation.SetInputTokens(v)
+	return _u
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableInputTokens(v *int) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetInputTokens(*v)
+	}
+	return _u
+}
+
+// AddInputTokens adds value to the "input_tokens" field.
+func (_u *UsageRecordUpdateOne) AddInputTokens(v int) *UsageRecordUpdateOne {
+	_u.mutation.AddInputTokens(v)
+	return _u
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (_u *UsageRecordUpdateOne) SetOutputTokens(v int) *UsageRecordUpdateOne {
+	_u.mutation.ResetOutputTokens()
+	_u.mutation.SetOutputTokens(v)
+	return _u
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableOutputTokens(v *int) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetOutputTokens(*v)
+	}
+	return _u
+}
+
+// AddOutputTokens adds value to the "output_tokens" field.
+func (_u *UsageRecordUpdateOne) AddOutputTokens(v int) *UsageRecordUpdateOne {
+	_u.mutation.AddOutputTokens(v)
+	return _u
+}
+
+// SetCost sets the "cost" field.
+func (_u *UsageRecordUpdateOne) SetCost(v float64) *UsageRecordUpdateOne {
+	_u.mutation.ResetCost()
+	_u.mutation.SetCost(v)
+	return _u
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (_u *UsageRecordUpdateOne) SetNillableCost(v *float64) *UsageRecordUpdateOne {
+	if v != nil {
+		_u.SetCost(*v)
+	}
+	return _u
+}
+
+// AddCost adds value to the "cost" field.
+func (_u *UsageRecordUpdateOne) AddCost(v float64) *UsageRecordUpdateOne {
+	_u.mutation.AddCost(v)
+	return _u
+}
+
+// Mutation returns the UsageRecordMutation object of the builder.
+func (_u *UsageRecordUpdateOne) Mutation() *UsageRecordMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UsageRecordUpdate builder.
+func (_u *UsageRecordUpdateOne) Where(ps ...predicate.UsageRecord) *UsageRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UsageRecordUpdateOne) Select(field string, fields ...string) *UsageRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UsageRecord entity.
+func (_u *UsageRecordUpdateOne) Save(ctx context.Context) (*UsageRecord, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UsageRecordUpdateOne) SaveX(ctx context.Context) *UsageRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UsageRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UsageRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UsageRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := usagerecord.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := usagerecord.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UsageRecord.user_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UsageRecordUpdateOne) sqlSave(ctx context.Context) (_node *UsageRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(usagerecord.Table, usagerecord.Columns, sqlgraph.NewFieldSpec(usagerecord.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UsageRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usagerecord.FieldID)
+		for _, f := range fields {
+			if !usagerecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usagerecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(usagerecord.FieldChannel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usagerecord.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SessionKey(); ok {
+		_spec.SetField(usagerecord.FieldSessionKey, field.TypeString, value)
+	}
+	if _u.mutation.SessionKeyCleared() {
+		_spec.ClearField(usagerecord.FieldSessionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(usagerecord.FieldModel, field.TypeString, value)
+	}
+	if _u.mutation.ModelCleared() {
+		_spec.ClearField(usagerecord.FieldModel, field.TypeString)
+	}
+	if value, ok := _u.mutation.InputTokens(); ok {
+		_spec.SetField(usagerecord.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTokens(); ok {
+		_spec.AddField(usagerecord.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTokens(); ok {
+		_spec.SetField(usagerecord.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(usagerecord.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Cost(); ok {
+		_spec.SetField(usagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCost(); ok {
+		_spec.AddField(usagerecord.FieldCost, field.TypeFloat64, value)
+	}
+	_node = &UsageRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usagerecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
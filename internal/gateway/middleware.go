@@ -2,8 +2,11 @@ package gateway
 
 import (
 	"context"
+	"crypto/subtle"
 	"net/http"
 	"strings"
+
+	"github.com/langoai/lango/internal/session"
 )
 
 // contextKey is an unexported type for context keys in this package.
@@ -12,6 +15,8 @@ type contextKey int
 const (
 	// sessionContextKey stores the authenticated session key in request context.
 	sessionContextKey contextKey = iota
+	// principalContextKey stores the authenticated user ("provider:sub").
+	principalContextKey
 )
 
 // SessionFromContext extracts the authenticated session key from the request context.
@@ -21,6 +26,14 @@ func SessionFromContext(ctx context.Context) string {
 	return v
 }
 
+// PrincipalFromContext returns the authenticated user of the request as
+// "provider:sub". Unlike the session key it stays the same across logins.
+// Returns empty string if no session is present.
+func PrincipalFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(principalContextKey).(string)
+	return v
+}
+
 // requireAuth returns chi middleware that validates the lango_session cookie
 // against the AuthManager's session store. If auth is nil (no OIDC configured),
 // all requests pass through unchanged (development/local mode).
@@ -46,11 +59,74 @@ func requireAuth(auth *AuthManager) func(http.Handler) http.Handler {
 			}
 
 			ctx := context.WithValue(r.Context(), sessionContextKey, sess.Key)
+			ctx = context.WithValue(ctx, principalContextKey, sessionPrincipal(sess))
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// sessionPrincipal returns the user an auth session belongs to, falling
+// back to the session key for sessions without OIDC claims.
+func sessionPrincipal(sess *session.Session) string {
+	if sub := sess.Metadata["sub"]; sub != "" {
+		return sess.Metadata["provider"] + ":" + sub
+	}
+	return sess.Key
+}
+
+// requireAPIAuth returns chi middleware for the REST API. A request whose
+// bearer token matches one of tokens passes; any other request needs an OIDC
+// session cookie. Without tokens and OIDC every request is refused.
+func requireAPIAuth(auth *AuthManager, tokens []string) func(http.Handler) http.Handler {
+	sessionAuth := requireAuth(auth)
+	return func(next http.Handler) http.Handler {
+		withSession := sessionAuth(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if bearer, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && len(tokens) > 0 {
+				for _, tok := range tokens {
+					if tok != "" && subtle.ConstantTimeCompare([]byte(bearer), []byte(tok)) == 1 {
+						next.ServeHTTP(w, r)
+						return
+					}
+				}
+				http.Error(w, `{"error":"invalid API token"}`, http.StatusUnauthorized)
+				return
+			}
+
+			if auth == nil {
+				http.Error(w, `{"error":"authentication required"}`, http.StatusUnauthorized)
+				return
+			}
+			withSession.ServeHTTP(w, r)
+		})
+	}
+}
+
+// requireSameOrigin returns chi middleware that refuses browser requests
+// from other origins. Browsers send cross-site form and fetch requests
+// without a preflight as long as they look simple, so the Origin header is
+// the only signal that a page on another site issued the request. Requests
+// without an Origin header (curl, scripts) pass.
+func requireSameOrigin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin != "" && !strings.EqualFold(strings.TrimRight(origin, "/"), requestOrigin(r)) {
+			http.Error(w, `{"error":"cross-origin request refused"}`, http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// requestOrigin returns the origin (scheme and host) the request was sent to.
+func requestOrigin(r *http.Request) string {
+	scheme := "http"
+	if isSecure(r) {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
 // makeOriginChecker builds a CheckOrigin function for gorilla/websocket.Upgrader.
 // - Empty list: returns nil (gorilla default behavior = same-origin check).
 // - Single "*" entry: allows all origins.
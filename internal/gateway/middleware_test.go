@@ -111,6 +111,7 @@ func TestRequireAuth_ValidSessionSetsContext(t *testing.T) {
 		Key:       "sess_valid-key",
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
+		Metadata:  map[string]string{"sub": "user-1", "provider": "google"},
 	})
 
 	auth := &AuthManager{
@@ -118,9 +119,10 @@ func TestRequireAuth_ValidSessionSetsContext(t *testing.T) {
 		store:     store,
 	}
 
-	var capturedSessionKey string
+	var capturedSessionKey, capturedPrincipal string
 	handler := requireAuth(auth)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		capturedSessionKey = SessionFromContext(r.Context())
+		capturedPrincipal = PrincipalFromContext(r.Context())
 		w.WriteHeader(http.StatusOK)
 	}))
 
@@ -136,6 +138,87 @@ func TestRequireAuth_ValidSessionSetsContext(t *testing.T) {
 	if capturedSessionKey != "sess_valid-key" {
 		t.Errorf("expected session key 'sess_valid-key', got %q", capturedSessionKey)
 	}
+	if capturedPrincipal != "google:user-1" {
+		t.Errorf("expected principal 'google:user-1', got %q", capturedPrincipal)
+	}
+}
+
+func TestRequireAPIAuth(t *testing.T) {
+	store := newMockStore()
+	store.Create(&session.Session{Key: "sess_valid-key", CreatedAt: time.Now(), UpdatedAt: time.Now()})
+	auth := &AuthManager{providers: make(map[string]*OIDCProvider), store: store}
+
+	tests := []struct {
+		give     string
+		auth     *AuthManager
+		tokens   []string
+		bearer   string
+		cookie   string
+		wantCode int
+	}{
+		{give: "closed without tokens or auth", wantCode: http.StatusUnauthorized},
+		{give: "bearer without tokens", bearer: "t1", wantCode: http.StatusUnauthorized},
+		{give: "valid token", tokens: []string{"t1", "t2"}, bearer: "t2", wantCode: http.StatusOK},
+		{give: "invalid token", tokens: []string{"t1"}, bearer: "nope", wantCode: http.StatusUnauthorized},
+		{give: "tokens close open mode", tokens: []string{"t1"}, wantCode: http.StatusUnauthorized},
+		{give: "session cookie with tokens", auth: auth, tokens: []string{"t1"}, cookie: "sess_valid-key", wantCode: http.StatusOK},
+		{give: "auth without credentials", auth: auth, wantCode: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			handler := requireAPIAuth(tt.auth, tt.tokens)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/api/cron", nil)
+			if tt.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.bearer)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "lango_session", Value: tt.cookie})
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("expected %d, got %d", tt.wantCode, rec.Code)
+			}
+		})
+	}
+}
+
+func TestRequireSameOrigin(t *testing.T) {
+	tests := []struct {
+		give     string
+		origin   string
+		wantCode int
+	}{
+		{give: "no origin", wantCode: http.StatusOK},
+		{give: "same origin", origin: "http://localhost:18789", wantCode: http.StatusOK},
+		{give: "other site", origin: "https://evil.example", wantCode: http.StatusForbidden},
+		{give: "other port", origin: "http://localhost:3000", wantCode: http.StatusForbidden},
+		{give: "opaque origin", origin: "null", wantCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			handler := requireSameOrigin(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			req := httptest.NewRequest(http.MethodPost, "http://localhost:18789/api/bg", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("expected %d, got %d", tt.wantCode, rec.Code)
+			}
+		})
+	}
 }
 
 func TestSessionFromContext_Empty(t *testing.T) {
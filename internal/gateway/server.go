@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -27,6 +28,13 @@ func logger() *zap.SugaredLogger { return logging.Gateway() }
 // TurnCallback is called after each agent turn completes (for buffer triggers, etc).
 type TurnCallback func(sessionKey string)
 
+// RequestGuard is called before each agent request. principal identifies
+// who sends the request independent of the session key the client picks:
+// the authenticated user, or the remote host without auth. It returns the
+// context to run the agent with, or an error shown to the client to refuse
+// the request (e.g. when over quota).
+type RequestGuard func(ctx context.Context, principal, sessionKey string) (context.Context, error)
+
 // Server represents the gateway server
 type Server struct {
 	config             Config
@@ -44,6 +52,7 @@ type Server struct {
 	pendingApprovals   map[string]chan approval.ApprovalResponse
 	pendingApprovalsMu sync.Mutex
 	turnCallbacks      []TurnCallback
+	requestGuard       RequestGuard
 }
 
 // Config holds gateway server configuration
@@ -53,6 +62,7 @@ type Config struct {
 	HTTPEnabled      bool
 	WebSocketEnabled bool
 	AllowedOrigins   []string
+	APITokens        []string // bearer tokens accepted by routes mounted with MountAPI
 	ApprovalTimeout  time.Duration
 	RequestTimeout   time.Duration
 }
@@ -65,6 +75,7 @@ type Client struct {
 	Server     *Server
 	Send       chan []byte
 	SessionKey string
+	Principal  string // authenticated user, or "addr:<host>" without auth
 	closed     bool
 	closeMu    sync.Mutex
 }
@@ -169,11 +180,6 @@ func (s *Server) handleChatMessage(client *Client, params json.RawMessage) (inte
 		return nil, ErrAgentNotReady
 	}
 
-	// Notify UI that agent is thinking
-	s.BroadcastToSession(sessionKey, "agent.thinking", map[string]string{
-		"sessionKey": sessionKey,
-	})
-
 	timeout := s.config.RequestTimeout
 	if timeout <= 0 {
 		timeout = 5 * time.Minute
@@ -181,6 +187,19 @@ func (s *Server) handleChatMessage(client *Client, params json.RawMessage) (inte
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
+	if s.requestGuard != nil {
+		guarded, err := s.requestGuard(ctx, client.Principal, sessionKey)
+		if err != nil {
+			return nil, err
+		}
+		ctx = guarded
+	}
+
+	// Notify UI that agent is thinking
+	s.BroadcastToSession(sessionKey, "agent.thinking", map[string]string{
+		"sessionKey": sessionKey,
+	})
+
 	ctx = session.WithSessionKey(ctx, sessionKey)
 	response, err := s.agent.RunStreaming(ctx, sessionKey, req.Message, func(chunk string) {
 		s.BroadcastToSession(sessionKey, "agent.chunk", map[string]string{
@@ -432,6 +451,23 @@ func (s *Server) Router() chi.Router {
 	return s.router
 }
 
+// MountAPI registers REST routes that require authentication: an OIDC
+// session cookie or one of the configured API tokens. Without either the
+// routes are not mounted, and MountAPI reports false. Cross-origin browser
+// requests are refused.
+func (s *Server) MountAPI(fn func(r chi.Router)) bool {
+	if s.auth == nil && len(s.config.APITokens) == 0 {
+		logger().Warnw("REST API disabled: configure server.apiTokens or OIDC authentication to enable it")
+		return false
+	}
+	s.router.Group(func(r chi.Router) {
+		r.Use(requireSameOrigin)
+		r.Use(requireAPIAuth(s.auth, s.config.APITokens))
+		fn(r)
+	})
+	return true
+}
+
 // SetAgent sets the agent on the server (used for deferred wiring).
 func (s *Server) SetAgent(agent *adk.Agent) {
 	s.agent = agent
@@ -442,6 +478,11 @@ func (s *Server) OnTurnComplete(cb TurnCallback) {
 	s.turnCallbacks = append(s.turnCallbacks, cb)
 }
 
+// SetRequestGuard sets the guard called before each agent request.
+func (s *Server) SetRequestGuard(g RequestGuard) {
+	s.requestGuard = g
+}
+
 // RegisterHandler registers an RPC method handler
 func (s *Server) RegisterHandler(method string, handler RPCHandler) {
 	s.handlersMu.Lock()
@@ -526,6 +567,14 @@ func (s *Server) handleWebSocketConnection(w http.ResponseWriter, r *http.Reques
 
 	// Bind authenticated session to client (empty if no auth)
 	sessionKey := SessionFromContext(r.Context())
+	principal := PrincipalFromContext(r.Context())
+	if principal == "" {
+		host, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			host = r.RemoteAddr
+		}
+		principal = "addr:" + host
+	}
 
 	client := &Client{
 		ID:         clientID,
@@ -534,6 +583,7 @@ func (s *Server) handleWebSocketConnection(w http.ResponseWriter, r *http.Reques
 		Server:     s,
 		Send:       make(chan []byte, 256),
 		SessionKey: sessionKey,
+		Principal:  principal,
 	}
 
 	s.clientsMu.Lock()
@@ -4,12 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	bolt "go.etcd.io/bbolt"
+	berrors "go.etcd.io/bbolt/errors"
 )
 
 // Bucket names for the three index orderings.
@@ -106,6 +110,136 @@ func (s *BoltStore) RemoveTriple(_ context.Context, t Triple) error {
 	})
 }
 
+// RemoveNode removes all triples where node is the subject or the object,
+// across all three indexes, in a single transaction.
+func (s *BoltStore) RemoveNode(_ context.Context, node string) (int, error) {
+	prefix := append([]byte(node), sep)
+	removed := 0
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		outgoing, err := scanPrefix(tx.Bucket(bucketSPO), prefix, tripleFromSPOKey)
+		if err != nil {
+			return err
+		}
+		incoming, err := scanPrefix(tx.Bucket(bucketOSP), prefix, tripleFromOSPKey)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range append(outgoing, incoming...) {
+			spoKey := makeKey(t.Subject, t.Predicate, t.Object)
+			if tx.Bucket(bucketSPO).Get(spoKey) == nil {
+				continue // self-loop already removed
+			}
+			if err := tx.Bucket(bucketSPO).Delete(spoKey); err != nil {
+				return fmt.Errorf("delete spo: %w", err)
+			}
+			if err := tx.Bucket(bucketPOS).Delete(makeKey(t.Predicate, t.Object, t.Subject)); err != nil {
+				return fmt.Errorf("delete pos: %w", err)
+			}
+			if err := tx.Bucket(bucketOSP).Delete(makeKey(t.Object, t.Subject, t.Predicate)); err != nil {
+				return fmt.Errorf("delete osp: %w", err)
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// RemoveBySource scans all triples and removes those whose "source"
+// metadata equals sourceID.
+func (s *BoltStore) RemoveBySource(_ context.Context, sourceID string) (int, error) {
+	removed := 0
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		var matches []Triple
+		c := tx.Bucket(bucketSPO).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			t, err := tripleFromSPOKey(k, v)
+			if err != nil {
+				return err
+			}
+			if t.Metadata["source"] == sourceID {
+				matches = append(matches, t)
+			}
+		}
+
+		for _, t := range matches {
+			if err := tx.Bucket(bucketSPO).Delete(makeKey(t.Subject, t.Predicate, t.Object)); err != nil {
+				return fmt.Errorf("delete spo: %w", err)
+			}
+			if err := tx.Bucket(bucketPOS).Delete(makeKey(t.Predicate, t.Object, t.Subject)); err != nil {
+				return fmt.Errorf("delete pos: %w", err)
+			}
+			if err := tx.Bucket(bucketOSP).Delete(makeKey(t.Object, t.Subject, t.Predicate)); err != nil {
+				return fmt.Errorf("delete osp: %w", err)
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// RewriteTriples passes every triple to rewrite and replaces the triples it
+// reports as changed. With dryRun, nothing is written. It returns the number
+// of changed triples.
+func (s *BoltStore) RewriteTriples(_ context.Context, rewrite func(Triple) (Triple, bool), dryRun bool) (int, error) {
+	changed := 0
+
+	scan := func(tx *bolt.Tx) ([][2]Triple, error) {
+		var pairs [][2]Triple
+		c := tx.Bucket(bucketSPO).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			t, err := tripleFromSPOKey(k, v)
+			if err != nil {
+				return nil, err
+			}
+			if nt, ok := rewrite(t); ok {
+				pairs = append(pairs, [2]Triple{t, nt})
+			}
+		}
+		return pairs, nil
+	}
+
+	if dryRun {
+		err := s.db.View(func(tx *bolt.Tx) error {
+			pairs, err := scan(tx)
+			changed = len(pairs)
+			return err
+		})
+		return changed, err
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		pairs, err := scan(tx)
+		if err != nil {
+			return err
+		}
+		for _, pair := range pairs {
+			old := pair[0]
+			if err := tx.Bucket(bucketSPO).Delete(makeKey(old.Subject, old.Predicate, old.Object)); err != nil {
+				return fmt.Errorf("delete spo: %w", err)
+			}
+			if err := tx.Bucket(bucketPOS).Delete(makeKey(old.Predicate, old.Object, old.Subject)); err != nil {
+				return fmt.Errorf("delete pos: %w", err)
+			}
+			if err := tx.Bucket(bucketOSP).Delete(makeKey(old.Object, old.Subject, old.Predicate)); err != nil {
+				return fmt.Errorf("delete osp: %w", err)
+			}
+		}
+		for _, pair := range pairs {
+			if err := putTriple(tx, pair[1]); err != nil {
+				return err
+			}
+		}
+		changed = len(pairs)
+		return nil
+	})
+	return changed, err
+}
+
 // QueryBySubject returns all triples whose subject matches.
 func (s *BoltStore) QueryBySubject(_ context.Context, subject string) ([]Triple, error) {
 	prefix := append([]byte(subject), sep)
@@ -270,6 +404,42 @@ func (s *BoltStore) Close() error {
 	return s.db.Close()
 }
 
+// Snapshot writes a consistent copy of the BoltDB database at path to w. The
+// database is opened read-only; if another process holds it open for writing
+// (e.g. a running server), Snapshot gives up after timeout.
+func Snapshot(path string, w io.Writer, timeout time.Duration) error {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{ReadOnly: true, Timeout: timeout})
+	if err != nil {
+		if errors.Is(err, berrors.ErrTimeout) {
+			return fmt.Errorf("open bolt db %s: locked by another process (is the server running?)", path)
+		}
+		return fmt.Errorf("open bolt db: %w", err)
+	}
+	defer db.Close()
+
+	return db.View(func(tx *bolt.Tx) error {
+		_, err := tx.WriteTo(w)
+		return err
+	})
+}
+
+// CheckUnlocked returns an error when another process holds the BoltDB
+// database at path open for writing (e.g. a running server), after waiting
+// up to timeout. A missing database is not locked.
+func CheckUnlocked(path string, timeout time.Duration) error {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	db, err := bolt.Open(path, 0o600, &bolt.Options{ReadOnly: true, Timeout: timeout})
+	if err != nil {
+		if errors.Is(err, berrors.ErrTimeout) {
+			return fmt.Errorf("open bolt db %s: locked by another process (is the server running?)", path)
+		}
+		return fmt.Errorf("open bolt db: %w", err)
+	}
+	return db.Close()
+}
+
 // --- helpers ---
 
 // makeKey joins components with the null-byte separator.
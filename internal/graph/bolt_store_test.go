@@ -4,7 +4,9 @@ import (
 	"context"
 	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -176,6 +178,97 @@ func TestBoltStore_RemoveTriple_Nonexistent(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestBoltStore_RemoveNode(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	require.NoError(t, store.AddTriples(ctx, []Triple{
+		{Subject: "observation:1", Predicate: InSession, Object: "session:s"},
+		{Subject: "observation:2", Predicate: Follows, Object: "observation:1"},
+		{Subject: "observation:1", Predicate: Follows, Object: "observation:1"},
+		{Subject: "observation:2", Predicate: InSession, Object: "session:s"},
+	}))
+
+	removed, err := store.RemoveNode(ctx, "observation:1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, removed)
+
+	bySub, err := store.QueryBySubject(ctx, "observation:1")
+	require.NoError(t, err)
+	assert.Empty(t, bySub)
+
+	byObj, err := store.QueryByObject(ctx, "observation:1")
+	require.NoError(t, err)
+	assert.Empty(t, byObj)
+
+	count, err := store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "unrelated triple must survive")
+}
+
+func TestBoltStore_RemoveBySource(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	require.NoError(t, store.AddTriples(ctx, []Triple{
+		{Subject: "user", Predicate: RelatedTo, Object: "Busan", Metadata: map[string]string{"source": "obs-1"}},
+		{Subject: "user", Predicate: RelatedTo, Object: "Go", Metadata: map[string]string{"source": "obs-2"}},
+		{Subject: "A", Predicate: RelatedTo, Object: "B"},
+	}))
+
+	removed, err := store.RemoveBySource(ctx, "obs-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	got, err := store.QueryByObject(ctx, "Busan")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	count, err := store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestBoltStore_RewriteTriples(t *testing.T) {
+	ctx := context.Background()
+	store := newTestStore(t)
+
+	require.NoError(t, store.AddTriples(ctx, []Triple{
+		{Subject: "user", Predicate: RelatedTo, Object: "sk-secret", Metadata: map[string]string{"note": "sk-secret"}},
+		{Subject: "A", Predicate: RelatedTo, Object: "B"},
+	}))
+
+	scrub := func(s string) string { return strings.ReplaceAll(s, "sk-secret", "{{secret:key}}") }
+	rewrite := func(t Triple) (Triple, bool) {
+		nt := ScrubTriple(t, scrub)
+		return nt, nt.Object != t.Object
+	}
+
+	changed, err := store.RewriteTriples(ctx, rewrite, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	got, err := store.QueryByObject(ctx, "sk-secret")
+	require.NoError(t, err)
+	assert.Len(t, got, 1, "dry run must not write")
+
+	changed, err = store.RewriteTriples(ctx, rewrite, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, changed)
+
+	got, err = store.QueryByObject(ctx, "sk-secret")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	got, err = store.QueryBySubject(ctx, "user")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "{{secret:key}}", got[0].Object)
+	assert.Equal(t, "{{secret:key}}", got[0].Metadata["note"])
+
+	count, err := store.Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
 func TestBoltStore_Traverse(t *testing.T) {
 	ctx := context.Background()
 	store := newTestStore(t)
@@ -391,3 +484,15 @@ func TestBoltStore_QueryEmptyStore(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, got)
 }
+
+func TestCheckUnlocked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "graph.db")
+	assert.NoError(t, CheckUnlocked(path, 50*time.Millisecond), "missing database")
+
+	store, err := NewBoltStore(path)
+	require.NoError(t, err)
+	assert.ErrorContains(t, CheckUnlocked(path, 50*time.Millisecond), "locked by another process")
+
+	require.NoError(t, store.Close())
+	assert.NoError(t, CheckUnlocked(path, 50*time.Millisecond))
+}
@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/langoai/lango/internal/asyncbuf"
+	"github.com/langoai/lango/internal/types"
 )
 
 // GraphRequest represents a request to add triples to the graph.
@@ -25,6 +26,7 @@ type GraphBuffer struct {
 	store  Store
 	inner  *asyncbuf.BatchBuffer[GraphRequest]
 	logger *zap.SugaredLogger
+	scrub  types.ScrubFunc
 }
 
 // NewGraphBuffer creates a new asynchronous graph update buffer.
@@ -41,6 +43,13 @@ func NewGraphBuffer(store Store, logger *zap.SugaredLogger) *GraphBuffer {
 	return b
 }
 
+// SetScrubber sets the optional hook that removes secrets from triple
+// subjects, objects and metadata before they are stored. It must be called
+// before Start.
+func (b *GraphBuffer) SetScrubber(fn types.ScrubFunc) {
+	b.scrub = fn
+}
+
 // Start launches the background goroutine. The WaitGroup is incremented
 // so callers can wait for graceful shutdown.
 func (b *GraphBuffer) Start(wg *sync.WaitGroup) {
@@ -71,9 +80,29 @@ func (b *GraphBuffer) processBatchRequests(batch []GraphRequest) {
 	if len(triples) == 0 {
 		return
 	}
+	if b.scrub != nil {
+		for i := range triples {
+			triples[i] = ScrubTriple(triples[i], b.scrub)
+		}
+	}
 	b.processBatch(triples)
 }
 
+// ScrubTriple returns the triple with scrub applied to its subject, object
+// and metadata values. The predicate is left as is.
+func ScrubTriple(t Triple, scrub types.ScrubFunc) Triple {
+	t.Subject = scrub(t.Subject)
+	t.Object = scrub(t.Object)
+	if len(t.Metadata) > 0 {
+		meta := make(map[string]string, len(t.Metadata))
+		for k, v := range t.Metadata {
+			meta[k] = scrub(v)
+		}
+		t.Metadata = meta
+	}
+	return t
+}
+
 func (b *GraphBuffer) processBatch(batch []Triple) {
 	ctx := context.Background()
 
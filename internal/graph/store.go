@@ -53,6 +53,15 @@ type Store interface {
 	// RemoveTriple removes a triple from the graph.
 	RemoveTriple(ctx context.Context, t Triple) error
 
+	// RemoveNode removes every triple in which node appears as subject or object.
+	// It returns the number of triples removed.
+	RemoveNode(ctx context.Context, node string) (int, error)
+
+	// RemoveBySource removes every triple whose "source" metadata equals
+	// sourceID (provenance set by the entity extractor).
+	// It returns the number of triples removed.
+	RemoveBySource(ctx context.Context, sourceID string) (int, error)
+
 	// QueryBySubject returns all triples with the given subject.
 	QueryBySubject(ctx context.Context, subject string) ([]Triple, error)
 
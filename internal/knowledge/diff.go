@@ -0,0 +1,75 @@
+package knowledge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffRevisions returns a line diff from one revision's content to another's.
+func DiffRevisions(from, to *Revision) string {
+	return DiffContent(
+		fmt.Sprintf("%s v%d (%s)", from.Key, from.Version, from.Category), from.Content,
+		fmt.Sprintf("%s v%d (%s)", to.Key, to.Version, to.Category), to.Content,
+	)
+}
+
+// DiffContent returns a line diff between two labelled texts. Unchanged lines
+// are prefixed with "  ", removed lines with "- " and added lines with "+ ".
+func DiffContent(fromLabel, from, toLabel, to string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", fromLabel, toLabel)
+	for _, l := range diffLines(splitLines(from), splitLines(to)) {
+		b.WriteString(l)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes a minimal line diff using the longest common subsequence.
+func diffLines(a, b []string) []string {
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	out := make([]string, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, "  "+a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, "- "+a[i])
+			i++
+		default:
+			out = append(out, "+ "+b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		out = append(out, "+ "+b[j])
+	}
+	return out
+}
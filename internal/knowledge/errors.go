@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrKnowledgeNotFound = errors.New("knowledge not found")
 	ErrLearningNotFound  = errors.New("learning not found")
+	ErrRevisionNotFound  = errors.New("knowledge revision not found")
 )
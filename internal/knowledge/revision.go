@@ -0,0 +1,308 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/langoai/lango/internal/ent"
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
+)
+
+// Revision authors.
+const (
+	AuthorAgent     = knowledgerevision.AuthorAgent
+	AuthorUser      = knowledgerevision.AuthorUser
+	AuthorLibrarian = knowledgerevision.AuthorLibrarian
+)
+
+// librarianSource is the knowledge source used by the proactive librarian.
+const librarianSource = "proactive_librarian"
+
+// RevisionInfo describes who made a knowledge change and why.
+type RevisionInfo struct {
+	Author knowledgerevision.Author
+	Reason string
+}
+
+// revisionInfoCtxKey is the context key type for revision info.
+type revisionInfoCtxKey struct{}
+
+// WithRevisionInfo attaches revision attribution to the context. Knowledge
+// saved with the returned context records the given author and reason.
+func WithRevisionInfo(ctx context.Context, info RevisionInfo) context.Context {
+	return context.WithValue(ctx, revisionInfoCtxKey{}, info)
+}
+
+// revisionInfoFromContext returns the revision info attached to ctx. Without
+// one, the author is inferred from the entry source.
+func revisionInfoFromContext(ctx context.Context, source string) RevisionInfo {
+	info, _ := ctx.Value(revisionInfoCtxKey{}).(RevisionInfo)
+	if info.Author == "" {
+		info.Author = authorFromSource(source)
+	}
+	return info
+}
+
+func authorFromSource(source string) knowledgerevision.Author {
+	if source == librarianSource {
+		return AuthorLibrarian
+	}
+	return AuthorAgent
+}
+
+// Revision is an immutable snapshot of a knowledge entry after a change.
+type Revision struct {
+	Version    int                      `json:"version"`
+	Key        string                   `json:"key"`
+	Action     knowledgerevision.Action `json:"action"`
+	Category   entknowledge.Category    `json:"category"`
+	Content    string                   `json:"content"`
+	Tags       []string                 `json:"tags,omitempty"`
+	Source     string                   `json:"source,omitempty"`
+	Author     knowledgerevision.Author `json:"author"`
+	SessionKey string                   `json:"session_key,omitempty"`
+	Reason     string                   `json:"reason,omitempty"`
+	CreatedAt  time.Time                `json:"created_at"`
+}
+
+// ListKnowledgeRevisions returns all revisions of a knowledge key, oldest first.
+func (s *Store) ListKnowledgeRevisions(ctx context.Context, key string) ([]Revision, error) {
+	revs, err := s.client.KnowledgeRevision.Query().
+		Where(knowledgerevision.KnowledgeKey(key)).
+		Order(knowledgerevision.ByVersion()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list knowledge revisions: %w", err)
+	}
+
+	result := make([]Revision, 0, len(revs))
+	for _, r := range revs {
+		result = append(result, toRevision(r))
+	}
+	return result, nil
+}
+
+// GetKnowledgeRevision returns a single revision of a knowledge key.
+func (s *Store) GetKnowledgeRevision(ctx context.Context, key string, version int) (*Revision, error) {
+	r, err := s.client.KnowledgeRevision.Query().
+		Where(
+			knowledgerevision.KnowledgeKey(key),
+			knowledgerevision.Version(version),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("revision %d of %q: %w", version, key, ErrRevisionNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get knowledge revision: %w", err)
+	}
+	rev := toRevision(r)
+	return &rev, nil
+}
+
+// RollbackKnowledge restores a knowledge key to the content of an earlier
+// revision, recording the restore as a new revision. Rolling back a deleted
+// key recreates it. Embeddings and graph nodes are rebuilt from the restored
+// content. It returns the new revision.
+func (s *Store) RollbackKnowledge(ctx context.Context, key string, version int) (*Revision, error) {
+	target, err := s.GetKnowledgeRevision(ctx, key, version)
+	if err != nil {
+		return nil, err
+	}
+	if target.Action == knowledgerevision.ActionDelete {
+		return nil, fmt.Errorf("revision %d of %q is a deletion; roll back to an earlier version", version, key)
+	}
+
+	info := revisionInfoFromContext(ctx, "")
+	if info.Reason == "" {
+		info.Reason = fmt.Sprintf("rollback to v%d", version)
+	}
+	entry := KnowledgeEntry{
+		Key:      target.Key,
+		Category: target.Category,
+		Content:  target.Content,
+		Tags:     target.Tags,
+		Source:   target.Source,
+	}
+
+	var rev *ent.KnowledgeRevision
+	err = s.withTx(ctx, func(tx *ent.Tx) error {
+		existing, err := tx.Knowledge.Query().
+			Where(entknowledge.Key(key)).
+			Only(ctx)
+		switch {
+		case ent.IsNotFound(err):
+			if _, err := createKnowledge(ctx, tx, entry); err != nil {
+				return err
+			}
+		case err != nil:
+			return fmt.Errorf("query knowledge: %w", err)
+		default:
+			// Tags and source are restored exactly, including clearing them.
+			updater := existing.Update().
+				SetCategory(entry.Category).
+				SetContent(entry.Content).
+				SetTags(entry.Tags).
+				SetSource(entry.Source)
+			if _, err := updater.Save(ctx); err != nil {
+				return fmt.Errorf("update knowledge: %w", err)
+			}
+		}
+		rev, err = recordRevision(ctx, tx, knowledgerevision.ActionRollback, entry, info, "")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeDerived(key)
+	meta := map[string]string{"category": string(entry.Category)}
+	if s.onEmbed != nil {
+		s.onEmbed(key, "knowledge", entry.Content, meta)
+	}
+	if s.onGraph != nil {
+		s.onGraph(key, "knowledge", entry.Content, meta)
+	}
+
+	result := toRevision(rev)
+	return &result, nil
+}
+
+// removeDerived fires the remove callbacks for a knowledge key.
+func (s *Store) removeDerived(key string) {
+	if s.onEmbedRemove != nil {
+		s.onEmbedRemove(key, "knowledge")
+	}
+	if s.onGraphRemove != nil {
+		s.onGraphRemove(key, "knowledge")
+	}
+}
+
+// withTx runs fn inside a transaction, committing on success.
+func (s *Store) withTx(ctx context.Context, fn func(tx *ent.Tx) error) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("start transaction: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// recordRevision appends the next revision of entry.Key.
+func recordRevision(
+	ctx context.Context,
+	tx *ent.Tx,
+	action knowledgerevision.Action,
+	entry KnowledgeEntry,
+	info RevisionInfo,
+	sessionKey string,
+) (*ent.KnowledgeRevision, error) {
+	latest, err := tx.KnowledgeRevision.Query().
+		Where(knowledgerevision.KnowledgeKey(entry.Key)).
+		Order(knowledgerevision.ByVersion(sql.OrderDesc())).
+		First(ctx)
+	version := 1
+	switch {
+	case ent.IsNotFound(err):
+	case err != nil:
+		return nil, fmt.Errorf("query latest revision: %w", err)
+	default:
+		version = latest.Version + 1
+	}
+
+	builder := tx.KnowledgeRevision.Create().
+		SetKnowledgeKey(entry.Key).
+		SetVersion(version).
+		SetAction(action).
+		SetCategory(knowledgerevision.Category(entry.Category)).
+		SetContent(entry.Content).
+		SetAuthor(info.Author)
+	if len(entry.Tags) > 0 {
+		builder.SetTags(entry.Tags)
+	}
+	if entry.Source != "" {
+		builder.SetSource(entry.Source)
+	}
+	if sessionKey != "" {
+		builder.SetSessionKey(sessionKey)
+	}
+	if info.Reason != "" {
+		builder.SetReason(info.Reason)
+	}
+
+	rev, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("record knowledge revision: %w", err)
+	}
+	return rev, nil
+}
+
+// hasRevisions reports whether any revision exists for key.
+func hasRevisions(ctx context.Context, tx *ent.Tx, key string) (bool, error) {
+	ok, err := tx.KnowledgeRevision.Query().
+		Where(knowledgerevision.KnowledgeKey(key)).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("query knowledge revisions: %w", err)
+	}
+	return ok, nil
+}
+
+// recordBaseline snapshots an entry that predates revision tracking as
+// version 1, so its original content survives the first tracked change.
+func recordBaseline(ctx context.Context, tx *ent.Tx, k *ent.Knowledge) error {
+	ok, err := hasRevisions(ctx, tx, k.Key)
+	if err != nil || ok {
+		return err
+	}
+	_, err = recordRevision(ctx, tx, knowledgerevision.ActionCreate, entryFromEnt(k), RevisionInfo{
+		Author: authorFromSource(k.Source),
+		Reason: "baseline",
+	}, "")
+	return err
+}
+
+// sameKnowledge reports whether saving entry over k would change nothing.
+func sameKnowledge(k *ent.Knowledge, entry KnowledgeEntry) bool {
+	return k.Category == entry.Category &&
+		k.Content == entry.Content &&
+		(len(entry.Tags) == 0 || slices.Equal(k.Tags, entry.Tags)) &&
+		(entry.Source == "" || k.Source == entry.Source)
+}
+
+func entryFromEnt(k *ent.Knowledge) KnowledgeEntry {
+	return KnowledgeEntry{
+		Key:      k.Key,
+		Category: k.Category,
+		Content:  k.Content,
+		Tags:     k.Tags,
+		Source:   k.Source,
+	}
+}
+
+func toRevision(r *ent.KnowledgeRevision) Revision {
+	return Revision{
+		Version:    r.Version,
+		Key:        r.KnowledgeKey,
+		Action:     r.Action,
+		Category:   entknowledge.Category(r.Category),
+		Content:    r.Content,
+		Tags:       r.Tags,
+		Source:     r.Source,
+		Author:     r.Author,
+		SessionKey: r.SessionKey,
+		Reason:     r.Reason,
+		CreatedAt:  r.CreatedAt,
+	}
+}
@@ -0,0 +1,170 @@
+package knowledge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
+)
+
+func TestSaveKnowledge_RecordsRevisions(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	entry := KnowledgeEntry{Key: "go_version", Category: entknowledge.CategoryFact, Content: "Go 1.24"}
+	require.NoError(t, store.SaveKnowledge(ctx, "telegram:1:2", entry))
+
+	// Saving identical content does not add a revision.
+	require.NoError(t, store.SaveKnowledge(ctx, "telegram:1:2", entry))
+
+	entry.Content = "Go 1.25"
+	userCtx := WithRevisionInfo(ctx, RevisionInfo{Author: AuthorUser, Reason: "upgraded"})
+	require.NoError(t, store.SaveKnowledge(userCtx, "", entry))
+
+	revs, err := store.ListKnowledgeRevisions(ctx, "go_version")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+
+	assert.Equal(t, 1, revs[0].Version)
+	assert.Equal(t, knowledgerevision.ActionCreate, revs[0].Action)
+	assert.Equal(t, AuthorAgent, revs[0].Author)
+	assert.Equal(t, "telegram:1:2", revs[0].SessionKey)
+	assert.Equal(t, "Go 1.24", revs[0].Content)
+
+	assert.Equal(t, 2, revs[1].Version)
+	assert.Equal(t, knowledgerevision.ActionUpdate, revs[1].Action)
+	assert.Equal(t, AuthorUser, revs[1].Author)
+	assert.Equal(t, "upgraded", revs[1].Reason)
+	assert.Equal(t, "Go 1.25", revs[1].Content)
+}
+
+func TestSaveKnowledge_LibrarianAuthor(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveKnowledge(ctx, "", KnowledgeEntry{
+		Key:      "editor",
+		Category: entknowledge.CategoryPreference,
+		Content:  "vim",
+		Source:   "proactive_librarian",
+	}))
+
+	rev, err := store.GetKnowledgeRevision(ctx, "editor", 1)
+	require.NoError(t, err)
+	assert.Equal(t, AuthorLibrarian, rev.Author)
+
+	_, err = store.GetKnowledgeRevision(ctx, "editor", 2)
+	assert.ErrorIs(t, err, ErrRevisionNotFound)
+}
+
+func TestSaveKnowledge_BaselineForUntrackedEntry(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	// Simulate an entry written before revision tracking existed.
+	_, err := store.client.Knowledge.Create().
+		SetKey("legacy").
+		SetCategory(entknowledge.CategoryRule).
+		SetContent("old rule").
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, store.SaveKnowledge(ctx, "", KnowledgeEntry{
+		Key: "legacy", Category: entknowledge.CategoryRule, Content: "new rule",
+	}))
+
+	revs, err := store.ListKnowledgeRevisions(ctx, "legacy")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, "old rule", revs[0].Content)
+	assert.Equal(t, "baseline", revs[0].Reason)
+	assert.Equal(t, "new rule", revs[1].Content)
+}
+
+func TestRollbackKnowledge(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	var embedded, graphed, removed []string
+	store.SetEmbedCallback(func(id, _, content string, _ map[string]string) {
+		embedded = append(embedded, content)
+	})
+	store.SetGraphCallback(func(id, _, content string, _ map[string]string) {
+		graphed = append(graphed, content)
+	})
+	store.SetEmbedRemoveCallback(func(id, collection string) {
+		removed = append(removed, "embed:"+collection+":"+id)
+	})
+	store.SetGraphRemoveCallback(func(id, collection string) {
+		removed = append(removed, "graph:"+collection+":"+id)
+	})
+
+	require.NoError(t, store.SaveKnowledge(ctx, "", KnowledgeEntry{
+		Key: "db", Category: entknowledge.CategoryFact, Content: "Postgres", Tags: []string{"infra"},
+	}))
+	require.NoError(t, store.SaveKnowledge(ctx, "", KnowledgeEntry{
+		Key: "db", Category: entknowledge.CategoryFact, Content: "MySQL",
+	}))
+	embedded, graphed = nil, nil
+
+	rev, err := store.RollbackKnowledge(ctx, "db", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rev.Version)
+	assert.Equal(t, knowledgerevision.ActionRollback, rev.Action)
+	assert.Equal(t, "rollback to v1", rev.Reason)
+
+	got, err := store.GetKnowledge(ctx, "db")
+	require.NoError(t, err)
+	assert.Equal(t, "Postgres", got.Content)
+	assert.Equal(t, []string{"infra"}, got.Tags)
+
+	assert.Equal(t, []string{"embed:knowledge:db", "graph:knowledge:db"}, removed)
+	assert.Equal(t, []string{"Postgres"}, embedded)
+	assert.Equal(t, []string{"Postgres"}, graphed)
+}
+
+func TestRollbackKnowledge_RestoresDeletedKey(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveKnowledge(ctx, "", KnowledgeEntry{
+		Key: "deploy", Category: entknowledge.CategoryRule, Content: "Deploy on Fridays",
+	}))
+	require.NoError(t, store.DeleteKnowledge(ctx, "deploy"))
+
+	_, err := store.GetKnowledge(ctx, "deploy")
+	require.ErrorIs(t, err, ErrKnowledgeNotFound)
+
+	revs, err := store.ListKnowledgeRevisions(ctx, "deploy")
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, knowledgerevision.ActionDelete, revs[1].Action)
+
+	_, err = store.RollbackKnowledge(ctx, "deploy", 2)
+	assert.Error(t, err)
+
+	_, err = store.RollbackKnowledge(ctx, "deploy", 1)
+	require.NoError(t, err)
+
+	got, err := store.GetKnowledge(ctx, "deploy")
+	require.NoError(t, err)
+	assert.Equal(t, "Deploy on Fridays", got.Content)
+}
+
+func TestDiffRevisions(t *testing.T) {
+	from := &Revision{Key: "k", Version: 1, Category: entknowledge.CategoryFact, Content: "a\nb\nc"}
+	to := &Revision{Key: "k", Version: 2, Category: entknowledge.CategoryRule, Content: "a\nB\nc\nd"}
+
+	want := "--- k v1 (fact)\n" +
+		"+++ k v2 (rule)\n" +
+		"  a\n" +
+		"- b\n" +
+		"+ B\n" +
+		"  c\n" +
+		"+ d\n"
+	assert.Equal(t, want, DiffRevisions(from, to))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/langoai/lango/internal/ent/auditlog"
 	"github.com/langoai/lango/internal/ent/externalref"
 	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/ent/knowledgerevision"
 	entlearning "github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/ent/predicate"
 	"github.com/langoai/lango/internal/types"
@@ -28,6 +29,11 @@ type Store struct {
 	onEmbed types.EmbedCallback
 	// Optional graph relationship hook (nil = disabled).
 	onGraph types.ContentCallback
+	// Optional derived-data removal hooks (nil = disabled).
+	onEmbedRemove types.RemoveCallback
+	onGraphRemove types.RemoveCallback
+	// Optional secret scrubbing hook (nil = disabled).
+	scrub types.ScrubFunc
 }
 
 // NewStore creates a new knowledge store.
@@ -48,65 +54,110 @@ func (s *Store) SetGraphCallback(cb types.ContentCallback) {
 	s.onGraph = cb
 }
 
+// SetEmbedRemoveCallback sets the optional hook that drops a knowledge
+// entry's embedding.
+func (s *Store) SetEmbedRemoveCallback(cb types.RemoveCallback) {
+	s.onEmbedRemove = cb
+}
+
+// SetGraphRemoveCallback sets the optional hook that drops a knowledge
+// entry's graph nodes.
+func (s *Store) SetGraphRemoveCallback(cb types.RemoveCallback) {
+	s.onGraphRemove = cb
+}
+
+// SetScrubber sets the optional hook that removes secrets from knowledge
+// content before it is saved.
+func (s *Store) SetScrubber(fn types.ScrubFunc) {
+	s.scrub = fn
+}
+
 // SaveKnowledge creates or updates a knowledge entry by key.
 func (s *Store) SaveKnowledge(ctx context.Context, sessionKey string, entry KnowledgeEntry) error {
-	existing, err := s.client.Knowledge.Query().
-		Where(entknowledge.Key(entry.Key)).
-		Only(ctx)
+	if s.scrub != nil {
+		entry.Content = s.scrub(entry.Content)
+	}
+	info := revisionInfoFromContext(ctx, entry.Source)
 
-	if ent.IsNotFound(err) {
-		builder := s.client.Knowledge.Create().
-			SetKey(entry.Key).
+	var created bool
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		existing, err := tx.Knowledge.Query().
+			Where(entknowledge.Key(entry.Key)).
+			Only(ctx)
+
+		if ent.IsNotFound(err) {
+			if _, err := createKnowledge(ctx, tx, entry); err != nil {
+				return err
+			}
+			created = true
+			_, err = recordRevision(ctx, tx, knowledgerevision.ActionCreate, entry, info, sessionKey)
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("query knowledge: %w", err)
+		}
+
+		unchanged := sameKnowledge(existing, entry)
+		if !unchanged {
+			if err := recordBaseline(ctx, tx, existing); err != nil {
+				return err
+			}
+		}
+
+		updater := existing.Update().
 			SetCategory(entry.Category).
 			SetContent(entry.Content)
 
 		if len(entry.Tags) > 0 {
-			builder.SetTags(entry.Tags)
+			updater.SetTags(entry.Tags)
 		}
 		if entry.Source != "" {
-			builder.SetSource(entry.Source)
+			updater.SetSource(entry.Source)
 		}
 
-		_, err = builder.Save(ctx)
+		updated, err := updater.Save(ctx)
 		if err != nil {
-			return fmt.Errorf("create knowledge: %w", err)
-		}
-
-		meta := map[string]string{"category": string(entry.Category)}
-		if s.onEmbed != nil {
-			s.onEmbed(entry.Key, "knowledge", entry.Content, meta)
+			return fmt.Errorf("update knowledge: %w", err)
 		}
-		if s.onGraph != nil {
-			s.onGraph(entry.Key, "knowledge", entry.Content, meta)
+		if unchanged {
+			return nil
 		}
-		return nil
-	}
+		_, err = recordRevision(ctx, tx, knowledgerevision.ActionUpdate, entryFromEnt(updated), info, sessionKey)
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("query knowledge: %w", err)
+		return err
 	}
 
-	updater := existing.Update().
+	meta := map[string]string{"category": string(entry.Category)}
+	if s.onEmbed != nil {
+		s.onEmbed(entry.Key, "knowledge", entry.Content, meta)
+	}
+	if created && s.onGraph != nil {
+		s.onGraph(entry.Key, "knowledge", entry.Content, meta)
+	}
+	return nil
+}
+
+// createKnowledge inserts a new knowledge row.
+func createKnowledge(ctx context.Context, tx *ent.Tx, entry KnowledgeEntry) (*ent.Knowledge, error) {
+	builder := tx.Knowledge.Create().
+		SetKey(entry.Key).
 		SetCategory(entry.Category).
 		SetContent(entry.Content)
 
 	if len(entry.Tags) > 0 {
-		updater.SetTags(entry.Tags)
+		builder.SetTags(entry.Tags)
 	}
 	if entry.Source != "" {
-		updater.SetSource(entry.Source)
+		builder.SetSource(entry.Source)
 	}
 
-	_, err = updater.Save(ctx)
+	k, err := builder.Save(ctx)
 	if err != nil {
-		return fmt.Errorf("update knowledge: %w", err)
+		return nil, fmt.Errorf("create knowledge: %w", err)
 	}
-
-	if s.onEmbed != nil {
-		s.onEmbed(entry.Key, "knowledge", entry.Content, map[string]string{
-			"category": string(entry.Category),
-		})
-	}
-	return nil
+	return k, nil
 }
 
 // GetKnowledge retrieves a knowledge entry by key.
@@ -208,24 +259,111 @@ func (s *Store) IncrementKnowledgeUseCount(ctx context.Context, key string) erro
 
 // DeleteKnowledge deletes a knowledge entry by key.
 func (s *Store) DeleteKnowledge(ctx context.Context, key string) error {
-	n, err := s.client.Knowledge.Delete().
-		Where(entknowledge.Key(key)).
-		Exec(ctx)
+	err := s.withTx(ctx, func(tx *ent.Tx) error {
+		existing, err := tx.Knowledge.Query().
+			Where(entknowledge.Key(key)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("delete knowledge %q: %w", key, ErrKnowledgeNotFound)
+		}
+		if err != nil {
+			return fmt.Errorf("query knowledge: %w", err)
+		}
+
+		// The final content is kept as a delete revision so the key can be
+		// rolled back later.
+		if err := recordBaseline(ctx, tx, existing); err != nil {
+			return err
+		}
+		info := revisionInfoFromContext(ctx, existing.Source)
+		if _, err := recordRevision(ctx, tx, knowledgerevision.ActionDelete, entryFromEnt(existing), info, ""); err != nil {
+			return err
+		}
 
+		if err := tx.Knowledge.DeleteOne(existing).Exec(ctx); err != nil {
+			return fmt.Errorf("delete knowledge: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("delete knowledge: %w", err)
-	}
-	if n == 0 {
-		return fmt.Errorf("delete knowledge %q: %w", key, ErrKnowledgeNotFound)
+		return err
 	}
+
+	s.removeDerived(key)
 	return nil
 }
 
+// ListKnowledge returns knowledge entries with optional category filtering
+// and pagination, ordered by key. It also returns the total matching count.
+func (s *Store) ListKnowledge(ctx context.Context, category string, limit, offset int) ([]*ent.Knowledge, int, error) {
+	q := s.client.Knowledge.Query()
+	if category != "" {
+		q = q.Where(entknowledge.CategoryEQ(entknowledge.Category(category)))
+	}
+
+	total, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count knowledge: %w", err)
+	}
+
+	if limit <= 0 {
+		limit = 50
+	}
+	entries, err := q.
+		Order(entknowledge.ByKey()).
+		Limit(limit).
+		Offset(offset).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list knowledge: %w", err)
+	}
+	return entries, total, nil
+}
+
+// KnowledgeStats holds aggregate statistics about knowledge entries.
+type KnowledgeStats struct {
+	TotalCount    int                           `json:"total_count"`
+	ByCategory    map[entknowledge.Category]int `json:"by_category"`
+	TotalUseCount int                           `json:"total_use_count"`
+	OldestEntry   time.Time                     `json:"oldest_entry,omitempty"`
+	NewestEntry   time.Time                     `json:"newest_entry,omitempty"`
+}
+
+// GetKnowledgeStats returns aggregate statistics about stored knowledge entries.
+func (s *Store) GetKnowledgeStats(ctx context.Context) (*KnowledgeStats, error) {
+	entries, err := s.client.Knowledge.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query knowledge: %w", err)
+	}
+
+	stats := &KnowledgeStats{
+		ByCategory: make(map[entknowledge.Category]int),
+	}
+	stats.TotalCount = len(entries)
+	for _, e := range entries {
+		stats.ByCategory[e.Category]++
+		stats.TotalUseCount += e.UseCount
+		if stats.OldestEntry.IsZero() || e.CreatedAt.Before(stats.OldestEntry) {
+			stats.OldestEntry = e.CreatedAt
+		}
+		if e.CreatedAt.After(stats.NewestEntry) {
+			stats.NewestEntry = e.CreatedAt
+		}
+	}
+	return stats, nil
+}
+
 // SaveLearning creates a new learning entry.
 func (s *Store) SaveLearning(ctx context.Context, sessionKey string, entry LearningEntry) error {
+	status := entry.ReviewStatus
+	if status == "" {
+		status = entlearning.ReviewStatusPending
+	}
+
 	builder := s.client.Learning.Create().
 		SetTrigger(entry.Trigger).
-		SetCategory(entry.Category)
+		SetCategory(entry.Category).
+		SetReviewStatus(status)
 
 	if entry.ErrorPattern != "" {
 		builder.SetErrorPattern(entry.ErrorPattern)
@@ -268,15 +406,30 @@ func (s *Store) GetLearning(ctx context.Context, id uuid.UUID) (*LearningEntry,
 		return nil, fmt.Errorf("get learning: %w", err)
 	}
 	return &LearningEntry{
+		ID:           l.ID,
 		Trigger:      l.Trigger,
 		ErrorPattern: l.ErrorPattern,
 		Diagnosis:    l.Diagnosis,
 		Fix:          l.Fix,
 		Category:     l.Category,
 		Tags:         l.Tags,
+		ReviewStatus: l.ReviewStatus,
 	}, nil
 }
 
+// GetLearningEntity retrieves the full learning entity, including counters
+// and review state, by its UUID.
+func (s *Store) GetLearningEntity(ctx context.Context, id uuid.UUID) (*ent.Learning, error) {
+	l, err := s.client.Learning.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("get learning %q: %w", id, ErrLearningNotFound)
+		}
+		return nil, fmt.Errorf("get learning: %w", err)
+	}
+	return l, nil
+}
+
 // SearchLearnings searches learnings by error pattern or trigger substring match.
 // The query is split into individual keywords and matched with per-keyword OR
 // predicates to avoid SQLite LIKE pattern complexity limits.
@@ -285,7 +438,10 @@ func (s *Store) SearchLearnings(ctx context.Context, errorPattern string, catego
 		limit = 10
 	}
 
-	var predicates []predicate.Learning
+	// Rejected learnings are kept for audit but never surfaced.
+	predicates := []predicate.Learning{
+		entlearning.ReviewStatusNEQ(entlearning.ReviewStatusRejected),
+	}
 	if errorPattern != "" {
 		if kwPreds := learningKeywordPredicates(errorPattern); len(kwPreds) > 0 {
 			predicates = append(predicates, entlearning.Or(kwPreds...))
@@ -308,12 +464,14 @@ func (s *Store) SearchLearnings(ctx context.Context, errorPattern string, catego
 	result := make([]LearningEntry, 0, len(entries))
 	for _, l := range entries {
 		result = append(result, LearningEntry{
+			ID:           l.ID,
 			Trigger:      l.Trigger,
 			ErrorPattern: l.ErrorPattern,
 			Diagnosis:    l.Diagnosis,
 			Fix:          l.Fix,
 			Category:     l.Category,
 			Tags:         l.Tags,
+			ReviewStatus: l.ReviewStatus,
 		})
 	}
 	return result, nil
@@ -344,7 +502,9 @@ func (s *Store) SearchLearningEntities(ctx context.Context, errorPattern string,
 		limit = 5
 	}
 
-	var predicates []predicate.Learning
+	predicates := []predicate.Learning{
+		entlearning.ReviewStatusNEQ(entlearning.ReviewStatusRejected),
+	}
 	if errorPattern != "" {
 		if kwPreds := learningKeywordPredicates(errorPattern); len(kwPreds) > 0 {
 			predicates = append(predicates, entlearning.Or(kwPreds...))
@@ -397,6 +557,106 @@ func (s *Store) BoostLearningConfidence(ctx context.Context, id uuid.UUID, succe
 	return nil
 }
 
+// PenalizeLearningConfidence records that a suggested fix was followed by
+// another failure. It increments the failure and occurrence counts and
+// lowers confidence by penalty, clamped to [0.1, 1.0].
+func (s *Store) PenalizeLearningConfidence(ctx context.Context, id uuid.UUID, penalty float64) error {
+	l, err := s.client.Learning.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("penalize learning %q: %w", id, ErrLearningNotFound)
+		}
+		return fmt.Errorf("get learning: %w", err)
+	}
+
+	newConfidence := l.Confidence - penalty
+	if newConfidence < 0.1 {
+		newConfidence = 0.1
+	}
+	if newConfidence > 1.0 {
+		newConfidence = 1.0
+	}
+
+	_, err = l.Update().
+		SetFailureCount(l.FailureCount + 1).
+		SetOccurrenceCount(l.OccurrenceCount + 1).
+		SetConfidence(newConfidence).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("penalize learning confidence: %w", err)
+	}
+	return nil
+}
+
+// ListLearningsByReviewStatus returns learnings in the given review state,
+// oldest first.
+func (s *Store) ListLearningsByReviewStatus(ctx context.Context, status entlearning.ReviewStatus, limit int) ([]*ent.Learning, error) {
+	query := s.client.Learning.Query().
+		Where(entlearning.ReviewStatusEQ(status)).
+		Order(entlearning.ByCreatedAt())
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	entries, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list learnings by review status: %w", err)
+	}
+	return entries, nil
+}
+
+// SetLearningReviewStatus marks a learning as approved, rejected or pending.
+func (s *Store) SetLearningReviewStatus(ctx context.Context, id uuid.UUID, status entlearning.ReviewStatus) error {
+	err := s.client.Learning.UpdateOneID(id).
+		SetReviewStatus(status).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("review learning %q: %w", id, ErrLearningNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("set learning review status: %w", err)
+	}
+	return nil
+}
+
+// UpdateLearning replaces the editable fields of a learning and re-embeds it.
+// A confidence outside (0, 1] leaves the stored confidence unchanged.
+func (s *Store) UpdateLearning(ctx context.Context, id uuid.UUID, entry LearningEntry, confidence float64) error {
+	builder := s.client.Learning.UpdateOneID(id).
+		SetTrigger(entry.Trigger).
+		SetCategory(entry.Category).
+		SetErrorPattern(entry.ErrorPattern).
+		SetDiagnosis(entry.Diagnosis).
+		SetFix(entry.Fix)
+	if len(entry.Tags) > 0 {
+		builder.SetTags(entry.Tags)
+	}
+	if entry.ReviewStatus != "" {
+		builder.SetReviewStatus(entry.ReviewStatus)
+	}
+	if confidence > 0 && confidence <= 1.0 {
+		builder.SetConfidence(confidence)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("update learning %q: %w", id, ErrLearningNotFound)
+		}
+		return fmt.Errorf("update learning: %w", err)
+	}
+
+	if s.onEmbed != nil {
+		content := entry.Trigger
+		if entry.Fix != "" {
+			content += "\n" + entry.Fix
+		}
+		s.onEmbed(id.String(), "learning", content, map[string]string{
+			"category": string(entry.Category),
+		})
+	}
+	return nil
+}
+
 // SaveAuditLog creates a new audit log entry.
 func (s *Store) SaveAuditLog(ctx context.Context, entry AuditEntry) error {
 	builder := s.client.AuditLog.Create().
@@ -520,6 +780,8 @@ type LearningStats struct {
 	NewestEntry      time.Time                    `json:"newest_entry,omitempty"`
 	TotalOccurrences int                          `json:"total_occurrences"`
 	TotalSuccesses   int                          `json:"total_successes"`
+	TotalFailures    int                          `json:"total_failures"`
+	PendingReview    int                          `json:"pending_review"`
 }
 
 // GetLearningStats returns aggregate statistics about stored learning entries.
@@ -543,6 +805,10 @@ func (s *Store) GetLearningStats(ctx context.Context) (*LearningStats, error) {
 		totalConf += e.Confidence
 		stats.TotalOccurrences += e.OccurrenceCount
 		stats.TotalSuccesses += e.SuccessCount
+		stats.TotalFailures += e.FailureCount
+		if e.ReviewStatus == entlearning.ReviewStatusPending {
+			stats.PendingReview++
+		}
 		if stats.OldestEntry.IsZero() || e.CreatedAt.Before(stats.OldestEntry) {
 			stats.OldestEntry = e.CreatedAt
 		}
@@ -603,8 +869,36 @@ func (s *Store) DeleteLearning(ctx context.Context, id uuid.UUID) error {
 // DeleteLearningsWhere deletes learning entries matching the given criteria
 // and returns the number of deleted entries.
 func (s *Store) DeleteLearningsWhere(ctx context.Context, category string, maxConfidence float64, olderThan time.Time) (int, error) {
-	q := s.client.Learning.Delete()
+	preds := learningCleanupPredicates(category, maxConfidence, olderThan)
+	if len(preds) == 0 {
+		return 0, fmt.Errorf("at least one filter criterion is required for bulk delete")
+	}
+
+	n, err := s.client.Learning.Delete().Where(preds...).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete learnings: %w", err)
+	}
+	return n, nil
+}
 
+// CountLearningsWhere returns the number of learning entries that
+// DeleteLearningsWhere would delete for the same criteria.
+func (s *Store) CountLearningsWhere(ctx context.Context, category string, maxConfidence float64, olderThan time.Time) (int, error) {
+	preds := learningCleanupPredicates(category, maxConfidence, olderThan)
+	if len(preds) == 0 {
+		return 0, fmt.Errorf("at least one filter criterion is required for bulk delete")
+	}
+
+	n, err := s.client.Learning.Query().Where(preds...).Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count learnings: %w", err)
+	}
+	return n, nil
+}
+
+// learningCleanupPredicates builds the bulk-delete filter shared by
+// DeleteLearningsWhere and CountLearningsWhere.
+func learningCleanupPredicates(category string, maxConfidence float64, olderThan time.Time) []predicate.Learning {
 	var preds []predicate.Learning
 	if category != "" {
 		preds = append(preds, entlearning.CategoryEQ(entlearning.Category(category)))
@@ -615,13 +909,70 @@ func (s *Store) DeleteLearningsWhere(ctx context.Context, category string, maxCo
 	if !olderThan.IsZero() {
 		preds = append(preds, entlearning.CreatedAtLTE(olderThan))
 	}
-	if len(preds) == 0 {
-		return 0, fmt.Errorf("at least one filter criterion is required for bulk delete")
-	}
+	return preds
+}
 
-	n, err := q.Where(preds...).Exec(ctx)
+// ImportLearning restores a learning exported from another store, keeping
+// its ID, counters, confidence and review state. An existing learning with
+// the same ID is overwritten. It reports whether a new row was created.
+func (s *Store) ImportLearning(ctx context.Context, l *ent.Learning) (bool, error) {
+	exists, err := s.client.Learning.Query().Where(entlearning.ID(l.ID)).Exist(ctx)
 	if err != nil {
-		return 0, fmt.Errorf("delete learnings: %w", err)
+		return false, fmt.Errorf("query learning: %w", err)
+	}
+
+	status := l.ReviewStatus
+	if status == "" {
+		status = entlearning.ReviewStatusPending
+	}
+
+	if exists {
+		err = s.client.Learning.UpdateOneID(l.ID).
+			SetTrigger(l.Trigger).
+			SetErrorPattern(l.ErrorPattern).
+			SetDiagnosis(l.Diagnosis).
+			SetFix(l.Fix).
+			SetCategory(l.Category).
+			SetTags(l.Tags).
+			SetOccurrenceCount(l.OccurrenceCount).
+			SetSuccessCount(l.SuccessCount).
+			SetFailureCount(l.FailureCount).
+			SetConfidence(l.Confidence).
+			SetReviewStatus(status).
+			Exec(ctx)
+		if err != nil {
+			return false, fmt.Errorf("update learning: %w", err)
+		}
+	} else {
+		builder := s.client.Learning.Create().
+			SetID(l.ID).
+			SetTrigger(l.Trigger).
+			SetErrorPattern(l.ErrorPattern).
+			SetDiagnosis(l.Diagnosis).
+			SetFix(l.Fix).
+			SetCategory(l.Category).
+			SetTags(l.Tags).
+			SetOccurrenceCount(l.OccurrenceCount).
+			SetSuccessCount(l.SuccessCount).
+			SetFailureCount(l.FailureCount).
+			SetConfidence(l.Confidence).
+			SetReviewStatus(status)
+		if !l.CreatedAt.IsZero() {
+			builder.SetCreatedAt(l.CreatedAt)
+		}
+		if err := builder.Exec(ctx); err != nil {
+			return false, fmt.Errorf("create learning: %w", err)
+		}
 	}
-	return n, nil
+
+	if s.onEmbed != nil {
+		content := l.Trigger
+		if l.Fix != "" {
+			content += "\n" + l.Fix
+		}
+		s.onEmbed(l.ID.String(), "learning", content, map[string]string{
+			"category": string(l.Category),
+		})
+	}
+	return !exists, nil
 }
@@ -2,14 +2,18 @@ package knowledge
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/langoai/lango/internal/ent"
 	"github.com/langoai/lango/internal/ent/enttest"
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
 	entlearning "github.com/langoai/lango/internal/ent/learning"
 	_ "github.com/mattn/go-sqlite3"
 )
@@ -103,6 +107,26 @@ func TestSaveAndGetKnowledge(t *testing.T) {
 	})
 }
 
+func TestSaveKnowledge_Scrubber(t *testing.T) {
+	store := newTestStore(t)
+	store.SetScrubber(func(text string) string {
+		return strings.ReplaceAll(text, "hunter2", "{{secret:pw}}")
+	})
+	ctx := context.Background()
+
+	entry := KnowledgeEntry{Key: "db-login", Category: "fact", Content: "The db password is hunter2"}
+	if err := store.SaveKnowledge(ctx, "session-1", entry); err != nil {
+		t.Fatalf("SaveKnowledge: %v", err)
+	}
+	got, err := store.GetKnowledge(ctx, "db-login")
+	if err != nil {
+		t.Fatalf("GetKnowledge: %v", err)
+	}
+	if want := "The db password is {{secret:pw}}"; got.Content != want {
+		t.Errorf("want content %q, got %q", want, got.Content)
+	}
+}
+
 func TestGetKnowledge_NotFound(t *testing.T) {
 	store := newTestStore(t)
 	ctx := context.Background()
@@ -718,3 +742,312 @@ func TestListLearnings(t *testing.T) {
 		t.Errorf("entries: want 2 (limit), got %d", len(entries))
 	}
 }
+
+func TestCountLearningsWhere(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		entry := LearningEntry{
+			Trigger:  fmt.Sprintf("count-%d", i),
+			Category: entlearning.CategoryTimeout,
+		}
+		if err := store.SaveLearning(ctx, "sess-count", entry); err != nil {
+			t.Fatalf("SaveLearning %d: %v", i, err)
+		}
+	}
+	entries, _, err := store.ListLearnings(ctx, "timeout", 0, time.Time{}, 0, 0)
+	if err != nil {
+		t.Fatalf("ListLearnings: %v", err)
+	}
+	if _, err := entries[0].Update().SetConfidence(0.9).Save(ctx); err != nil {
+		t.Fatalf("update confidence: %v", err)
+	}
+
+	n, err := store.CountLearningsWhere(ctx, "timeout", 0.5, time.Time{})
+	if err != nil {
+		t.Fatalf("CountLearningsWhere: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("want 3 matching, got %d", n)
+	}
+
+	deleted, err := store.DeleteLearningsWhere(ctx, "timeout", 0.5, time.Time{})
+	if err != nil {
+		t.Fatalf("DeleteLearningsWhere: %v", err)
+	}
+	if deleted != n {
+		t.Errorf("count %d and delete %d disagree", n, deleted)
+	}
+
+	if _, err := store.CountLearningsWhere(ctx, "", 0, time.Time{}); err == nil {
+		t.Error("want error without filter criteria")
+	}
+}
+
+func TestImportLearning(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	id := uuid.New()
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	l := &ent.Learning{
+		ID:              id,
+		Trigger:         "tool:exec",
+		ErrorPattern:    "exit status 1",
+		Fix:             "check the command",
+		Category:        entlearning.CategoryToolError,
+		OccurrenceCount: 7,
+		SuccessCount:    4,
+		FailureCount:    2,
+		Confidence:      0.75,
+		ReviewStatus:    entlearning.ReviewStatusApproved,
+		CreatedAt:       created,
+	}
+
+	isNew, err := store.ImportLearning(ctx, l)
+	if err != nil {
+		t.Fatalf("ImportLearning: %v", err)
+	}
+	if !isNew {
+		t.Error("want first import to create")
+	}
+
+	got, err := store.GetLearningEntity(ctx, id)
+	if err != nil {
+		t.Fatalf("GetLearningEntity: %v", err)
+	}
+	if got.OccurrenceCount != 7 || got.SuccessCount != 4 || got.FailureCount != 2 {
+		t.Errorf("counters not restored: %d/%d/%d", got.OccurrenceCount, got.SuccessCount, got.FailureCount)
+	}
+	if got.Confidence != 0.75 || got.ReviewStatus != entlearning.ReviewStatusApproved {
+		t.Errorf("want confidence 0.75 approved, got %f %s", got.Confidence, got.ReviewStatus)
+	}
+	if !got.CreatedAt.Equal(created) {
+		t.Errorf("want created_at %v, got %v", created, got.CreatedAt)
+	}
+
+	l.Fix = "check the exit code"
+	isNew, err = store.ImportLearning(ctx, l)
+	if err != nil {
+		t.Fatalf("ImportLearning again: %v", err)
+	}
+	if isNew {
+		t.Error("want second import to update")
+	}
+	got, _ = store.GetLearningEntity(ctx, id)
+	if got.Fix != "check the exit code" {
+		t.Errorf("want updated fix, got %q", got.Fix)
+	}
+}
+
+func TestListKnowledge(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	entries := []KnowledgeEntry{
+		{Key: "b-rule", Category: entknowledge.CategoryRule, Content: "rule b"},
+		{Key: "a-rule", Category: entknowledge.CategoryRule, Content: "rule a"},
+		{Key: "c-fact", Category: entknowledge.CategoryFact, Content: "fact c"},
+	}
+	for _, e := range entries {
+		if err := store.SaveKnowledge(ctx, "sess", e); err != nil {
+			t.Fatalf("SaveKnowledge(%q): %v", e.Key, err)
+		}
+	}
+
+	got, total, err := store.ListKnowledge(ctx, "rule", 0, 0)
+	if err != nil {
+		t.Fatalf("ListKnowledge: %v", err)
+	}
+	if total != 2 || len(got) != 2 {
+		t.Fatalf("want 2 rules, got total=%d len=%d", total, len(got))
+	}
+	if got[0].Key != "a-rule" {
+		t.Errorf("want ordering by key, got %q first", got[0].Key)
+	}
+
+	got, total, err = store.ListKnowledge(ctx, "", 1, 2)
+	if err != nil {
+		t.Fatalf("ListKnowledge: %v", err)
+	}
+	if total != 3 || len(got) != 1 || got[0].Key != "c-fact" {
+		t.Errorf("want page with c-fact of 3, got total=%d len=%d", total, len(got))
+	}
+
+	stats, err := store.GetKnowledgeStats(ctx)
+	if err != nil {
+		t.Fatalf("GetKnowledgeStats: %v", err)
+	}
+	if stats.TotalCount != 3 || stats.ByCategory[entknowledge.CategoryRule] != 2 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestLearningReviewStatus(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	if err := store.SaveLearning(ctx, "session-1", LearningEntry{
+		Trigger:      "auto-extracted",
+		ErrorPattern: "review-error",
+		Category:     entlearning.CategoryToolError,
+	}); err != nil {
+		t.Fatalf("SaveLearning: %v", err)
+	}
+	if err := store.SaveLearning(ctx, "session-1", LearningEntry{
+		Trigger:      "user-correction",
+		ErrorPattern: "review-error",
+		Category:     entlearning.CategoryUserCorrection,
+		ReviewStatus: entlearning.ReviewStatusApproved,
+	}); err != nil {
+		t.Fatalf("SaveLearning: %v", err)
+	}
+
+	pending, err := store.ListLearningsByReviewStatus(ctx, entlearning.ReviewStatusPending, 0)
+	if err != nil {
+		t.Fatalf("ListLearningsByReviewStatus: %v", err)
+	}
+	if len(pending) != 1 || pending[0].Trigger != "auto-extracted" {
+		t.Fatalf("want 1 pending learning 'auto-extracted', got %d", len(pending))
+	}
+
+	stats, err := store.GetLearningStats(ctx)
+	if err != nil {
+		t.Fatalf("GetLearningStats: %v", err)
+	}
+	if stats.PendingReview != 1 {
+		t.Errorf("want pending_review 1, got %d", stats.PendingReview)
+	}
+
+	t.Run("rejected learnings are hidden from search", func(t *testing.T) {
+		if err := store.SetLearningReviewStatus(ctx, pending[0].ID, entlearning.ReviewStatusRejected); err != nil {
+			t.Fatalf("SetLearningReviewStatus: %v", err)
+		}
+
+		results, err := store.SearchLearnings(ctx, "review-error", "", 0)
+		if err != nil {
+			t.Fatalf("SearchLearnings: %v", err)
+		}
+		if len(results) != 1 || results[0].Trigger != "user-correction" {
+			t.Errorf("want only 'user-correction', got %d results", len(results))
+		}
+
+		entities, err := store.SearchLearningEntities(ctx, "review-error", 0)
+		if err != nil {
+			t.Fatalf("SearchLearningEntities: %v", err)
+		}
+		if len(entities) != 1 {
+			t.Errorf("want 1 entity, got %d", len(entities))
+		}
+	})
+
+	t.Run("unknown id", func(t *testing.T) {
+		err := store.SetLearningReviewStatus(ctx, uuid.New(), entlearning.ReviewStatusApproved)
+		if !errors.Is(err, ErrLearningNotFound) {
+			t.Errorf("want ErrLearningNotFound, got %v", err)
+		}
+	})
+}
+
+func TestUpdateLearning(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	var embedded []string
+	store.SetEmbedCallback(func(id, collection, content string, _ map[string]string) {
+		embedded = append(embedded, content)
+	})
+
+	if err := store.SaveLearning(ctx, "session-1", LearningEntry{
+		Trigger:      "update-trigger",
+		ErrorPattern: "update-error",
+		Fix:          "old fix",
+		Category:     entlearning.CategoryGeneral,
+	}); err != nil {
+		t.Fatalf("SaveLearning: %v", err)
+	}
+	entities, err := store.SearchLearningEntities(ctx, "update-error", 1)
+	if err != nil || len(entities) == 0 {
+		t.Fatalf("SearchLearningEntities: %v (n=%d)", err, len(entities))
+	}
+	id := entities[0].ID
+
+	err = store.UpdateLearning(ctx, id, LearningEntry{
+		Trigger:      "update-trigger",
+		ErrorPattern: "update-error",
+		Diagnosis:    "clarified",
+		Fix:          "new fix",
+		Category:     entlearning.CategoryToolError,
+		ReviewStatus: entlearning.ReviewStatusApproved,
+	}, 0.8)
+	if err != nil {
+		t.Fatalf("UpdateLearning: %v", err)
+	}
+
+	got, err := store.client.Learning.Get(ctx, id)
+	if err != nil {
+		t.Fatalf("Get learning: %v", err)
+	}
+	if got.Fix != "new fix" || got.Diagnosis != "clarified" {
+		t.Errorf("want updated fix and diagnosis, got %q / %q", got.Fix, got.Diagnosis)
+	}
+	if got.Category != entlearning.CategoryToolError {
+		t.Errorf("want category tool_error, got %q", got.Category)
+	}
+	if got.ReviewStatus != entlearning.ReviewStatusApproved {
+		t.Errorf("want review_status approved, got %q", got.ReviewStatus)
+	}
+	if got.Confidence != 0.8 {
+		t.Errorf("want confidence 0.8, got %f", got.Confidence)
+	}
+	if len(embedded) != 2 || embedded[1] != "update-trigger\nnew fix" {
+		t.Errorf("want re-embed with new fix, got %v", embedded)
+	}
+}
+
+func TestPenalizeLearningConfidence(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	if err := store.SaveLearning(ctx, "session-1", LearningEntry{
+		Trigger:      "penalize-trigger",
+		ErrorPattern: "penalize-error",
+		Category:     entlearning.CategoryGeneral,
+	}); err != nil {
+		t.Fatalf("SaveLearning: %v", err)
+	}
+	entities, err := store.SearchLearningEntities(ctx, "penalize-error", 1)
+	if err != nil || len(entities) == 0 {
+		t.Fatalf("SearchLearningEntities: %v (n=%d)", err, len(entities))
+	}
+	initial := entities[0]
+
+	if err := store.PenalizeLearningConfidence(ctx, initial.ID, 0.2); err != nil {
+		t.Fatalf("PenalizeLearningConfidence: %v", err)
+	}
+	got, err := store.client.Learning.Get(ctx, initial.ID)
+	if err != nil {
+		t.Fatalf("Get learning: %v", err)
+	}
+	if got.FailureCount != 1 {
+		t.Errorf("want failure_count 1, got %d", got.FailureCount)
+	}
+	if got.OccurrenceCount != initial.OccurrenceCount+1 {
+		t.Errorf("want occurrence_count %d, got %d", initial.OccurrenceCount+1, got.OccurrenceCount)
+	}
+	if want := initial.Confidence - 0.2; got.Confidence < want-1e-9 || got.Confidence > want+1e-9 {
+		t.Errorf("want confidence %f, got %f", want, got.Confidence)
+	}
+
+	// Repeated failures never drop confidence below the floor.
+	for i := 0; i < 10; i++ {
+		if err := store.PenalizeLearningConfidence(ctx, initial.ID, 0.2); err != nil {
+			t.Fatalf("PenalizeLearningConfidence: %v", err)
+		}
+	}
+	got, _ = store.client.Learning.Get(ctx, initial.ID)
+	if got.Confidence != 0.1 {
+		t.Errorf("want confidence floor 0.1, got %f", got.Confidence)
+	}
+}
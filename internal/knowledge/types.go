@@ -3,6 +3,8 @@ package knowledge
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
 	entlearning "github.com/langoai/lango/internal/ent/learning"
 )
@@ -76,12 +78,16 @@ type KnowledgeEntry struct {
 
 // LearningEntry is the domain type for learning CRUD operations.
 type LearningEntry struct {
+	// ID is set on reads and ignored by SaveLearning.
+	ID           uuid.UUID
 	Trigger      string
 	ErrorPattern string
 	Diagnosis    string
 	Fix          string
 	Category     entlearning.Category
 	Tags         []string
+	// ReviewStatus is the human review state. Empty means pending on save.
+	ReviewStatus entlearning.ReviewStatus
 }
 
 // AuditEntry is the domain type for audit log writes.
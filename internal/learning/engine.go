@@ -3,11 +3,14 @@ package learning
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	entlearning "github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/knowledge"
+	"github.com/langoai/lango/internal/session"
 )
 
 // ToolResultObserver observes tool execution results for learning.
@@ -23,11 +26,20 @@ var _ ToolResultObserver = (*Engine)(nil)
 type Engine struct {
 	store  *knowledge.Store
 	logger *zap.SugaredLogger
+
+	// suggested tracks the learning whose fix was last suggested per session,
+	// so a following failure can be attributed to it.
+	suggestedMu sync.Mutex
+	suggested   map[string]uuid.UUID
 }
 
 // NewEngine creates a new learning engine.
 func NewEngine(store *knowledge.Store, logger *zap.SugaredLogger) *Engine {
-	return &Engine{store: store, logger: logger}
+	return &Engine{
+		store:     store,
+		logger:    logger,
+		suggested: make(map[string]uuid.UUID),
+	}
 }
 
 // OnToolResult observes a tool execution result and records learnings.
@@ -57,6 +69,8 @@ func (e *Engine) OnToolResult(ctx context.Context, sessionKey, toolName string,
 		e.logger.Warnw("save audit log:", "error", auditErr)
 	}
 
+	e.settleSuggestedFix(ctx, sessionKey, err == nil)
+
 	if err != nil {
 		e.handleError(ctx, sessionKey, toolName, err)
 		return
@@ -69,7 +83,12 @@ func (e *Engine) OnToolResult(ctx context.Context, sessionKey, toolName string,
 // Set higher than the previous 0.5 to reduce false positives from low-quality learnings.
 const autoApplyConfidenceThreshold = 0.7
 
+// fixFailurePenalty is subtracted from a learning's confidence when its
+// suggested fix is followed by another failure in the same session.
+const fixFailurePenalty = 0.2
+
 // GetFixForError returns a known fix for a given tool error if one exists with sufficient confidence.
+// Only learnings approved in review are applied.
 func (e *Engine) GetFixForError(ctx context.Context, toolName string, err error) (string, bool) {
 	pattern := extractErrorPattern(err)
 
@@ -80,20 +99,59 @@ func (e *Engine) GetFixForError(ctx context.Context, toolName string, err error)
 	}
 
 	for _, entity := range entities {
+		if entity.ReviewStatus != entlearning.ReviewStatusApproved {
+			continue
+		}
 		if entity.Confidence > autoApplyConfidenceThreshold && entity.Fix != "" {
+			if key := session.SessionKeyFromContext(ctx); key != "" {
+				e.suggestedMu.Lock()
+				e.suggested[key] = entity.ID
+				e.suggestedMu.Unlock()
+			}
 			return entity.Fix, true
 		}
 	}
 	return "", false
 }
 
+// RecordFixOutcome reports whether the fix last suggested by GetFixForError
+// for the session in ctx resolved the error. A failure lowers the learning's
+// confidence; either way the suggestion is cleared.
+func (e *Engine) RecordFixOutcome(ctx context.Context, success bool) {
+	e.settleSuggestedFix(ctx, session.SessionKeyFromContext(ctx), success)
+}
+
+// settleSuggestedFix clears the pending suggestion for a session and
+// penalizes its learning when the outcome was a failure.
+func (e *Engine) settleSuggestedFix(ctx context.Context, sessionKey string, success bool) {
+	if sessionKey == "" {
+		return
+	}
+
+	e.suggestedMu.Lock()
+	id, ok := e.suggested[sessionKey]
+	delete(e.suggested, sessionKey)
+	e.suggestedMu.Unlock()
+
+	if !ok || success {
+		return
+	}
+	if err := e.store.PenalizeLearningConfidence(ctx, id, fixFailurePenalty); err != nil {
+		e.logger.Warnw("penalize learning confidence:", "error", err)
+		return
+	}
+	e.logger.Infow("suggested fix followed by failure", "session", sessionKey, "learning", id)
+}
+
 // RecordUserCorrection saves a user-provided correction as a high-confidence learning.
+// User corrections skip the review queue.
 func (e *Engine) RecordUserCorrection(ctx context.Context, sessionKey, trigger, diagnosis, fix string) error {
 	return e.store.SaveLearning(ctx, sessionKey, knowledge.LearningEntry{
-		Trigger:   trigger,
-		Diagnosis: diagnosis,
-		Fix:       fix,
-		Category:  entlearning.CategoryUserCorrection,
+		Trigger:      trigger,
+		Diagnosis:    diagnosis,
+		Fix:          fix,
+		Category:     entlearning.CategoryUserCorrection,
+		ReviewStatus: entlearning.ReviewStatusApproved,
 	})
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/langoai/lango/internal/ent/enttest"
 	entlearning "github.com/langoai/lango/internal/ent/learning"
 	"github.com/langoai/lango/internal/knowledge"
+	"github.com/langoai/lango/internal/session"
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -126,6 +127,7 @@ func TestEngine_GetFixForError(t *testing.T) {
 			Diagnosis:    "missing declaration",
 			Fix:          "declare the variable before use",
 			Category:     entlearning.CategoryToolError,
+			ReviewStatus: entlearning.ReviewStatusApproved,
 		})
 		if err != nil {
 			t.Fatalf("SaveLearning: %v", err)
@@ -200,6 +202,108 @@ func TestEngine_GetFixForError(t *testing.T) {
 	})
 }
 
+func TestEngine_GetFixForError_RequiresApproval(t *testing.T) {
+	engine, store := newTestEngine(t)
+	ctx := context.Background()
+
+	errMsg := "pending review pattern"
+	if err := store.SaveLearning(ctx, "sess-1", knowledge.LearningEntry{
+		Trigger:      "tool:build",
+		ErrorPattern: errMsg,
+		Fix:          "unreviewed fix",
+		Category:     entlearning.CategoryToolError,
+	}); err != nil {
+		t.Fatalf("SaveLearning: %v", err)
+	}
+	entities, err := store.SearchLearningEntities(ctx, errMsg, 5)
+	if err != nil || len(entities) == 0 {
+		t.Fatalf("SearchLearningEntities: %v (n=%d)", err, len(entities))
+	}
+	if _, err := entities[0].Update().SetConfidence(0.9).Save(ctx); err != nil {
+		t.Fatalf("update confidence: %v", err)
+	}
+
+	if fix, ok := engine.GetFixForError(ctx, "build", errors.New(errMsg)); ok {
+		t.Errorf("GetFixForError applied a pending learning, fix = %q", fix)
+	}
+
+	if err := store.SetLearningReviewStatus(ctx, entities[0].ID, entlearning.ReviewStatusApproved); err != nil {
+		t.Fatalf("SetLearningReviewStatus: %v", err)
+	}
+	if _, ok := engine.GetFixForError(ctx, "build", errors.New(errMsg)); !ok {
+		t.Error("GetFixForError returned false for approved learning, want true")
+	}
+}
+
+func TestEngine_FixFailurePenalizesLearning(t *testing.T) {
+	engine, store := newTestEngine(t)
+	ctx := session.WithSessionKey(context.Background(), "sess-1")
+
+	errMsg := "flaky network pattern"
+	if err := store.SaveLearning(ctx, "sess-1", knowledge.LearningEntry{
+		Trigger:      "tool:http_call",
+		ErrorPattern: errMsg,
+		Fix:          "retry with backoff",
+		Category:     entlearning.CategoryToolError,
+		ReviewStatus: entlearning.ReviewStatusApproved,
+	}); err != nil {
+		t.Fatalf("SaveLearning: %v", err)
+	}
+	entities, err := store.SearchLearningEntities(ctx, errMsg, 5)
+	if err != nil || len(entities) == 0 {
+		t.Fatalf("SearchLearningEntities: %v (n=%d)", err, len(entities))
+	}
+	if _, err := entities[0].Update().SetConfidence(0.8).Save(ctx); err != nil {
+		t.Fatalf("update confidence: %v", err)
+	}
+
+	t.Run("next tool failure penalizes", func(t *testing.T) {
+		if _, ok := engine.GetFixForError(ctx, "http_call", errors.New(errMsg)); !ok {
+			t.Fatal("GetFixForError returned false, want true")
+		}
+		engine.OnToolResult(ctx, "sess-1", "http_call", nil, nil, errors.New("still failing"))
+
+		stats, err := store.GetLearningStats(ctx)
+		if err != nil {
+			t.Fatalf("GetLearningStats: %v", err)
+		}
+		if stats.TotalFailures != 1 {
+			t.Errorf("want total_failures 1, got %d", stats.TotalFailures)
+		}
+	})
+
+	t.Run("outcome is settled only once", func(t *testing.T) {
+		engine.RecordFixOutcome(ctx, false)
+
+		stats, err := store.GetLearningStats(ctx)
+		if err != nil {
+			t.Fatalf("GetLearningStats: %v", err)
+		}
+		if stats.TotalFailures != 1 {
+			t.Errorf("want total_failures 1, got %d", stats.TotalFailures)
+		}
+	})
+
+	t.Run("retry failure penalizes", func(t *testing.T) {
+		// Restore confidence above the auto-apply threshold.
+		if _, err := entities[0].Update().SetConfidence(0.8).Save(ctx); err != nil {
+			t.Fatalf("update confidence: %v", err)
+		}
+		if _, ok := engine.GetFixForError(ctx, "http_call", errors.New(errMsg)); !ok {
+			t.Fatal("GetFixForError returned false, want true")
+		}
+		engine.RecordFixOutcome(ctx, false)
+
+		stats, err := store.GetLearningStats(ctx)
+		if err != nil {
+			t.Fatalf("GetLearningStats: %v", err)
+		}
+		if stats.TotalFailures != 2 {
+			t.Errorf("want total_failures 2, got %d", stats.TotalFailures)
+		}
+	})
+}
+
 func TestEngine_RecordUserCorrection(t *testing.T) {
 	engine, store := newTestEngine(t)
 	ctx := context.Background()
@@ -29,6 +29,10 @@ func (s *fakeGraphStore) AddTriples(_ context.Context, ts []graph.Triple) error
 }
 
 func (s *fakeGraphStore) RemoveTriple(context.Context, graph.Triple) error { return nil }
+func (s *fakeGraphStore) RemoveNode(context.Context, string) (int, error)  { return 0, nil }
+func (s *fakeGraphStore) RemoveBySource(context.Context, string) (int, error) {
+	return 0, nil
+}
 func (s *fakeGraphStore) QueryBySubject(context.Context, string) ([]graph.Triple, error) {
 	return nil, nil
 }
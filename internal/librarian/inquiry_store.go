@@ -2,6 +2,7 @@ package librarian
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -12,6 +13,9 @@ import (
 	"github.com/langoai/lango/internal/ent/inquiry"
 )
 
+// ErrInquiryNotFound is returned when no inquiry matches an ID.
+var ErrInquiryNotFound = errors.New("inquiry not found")
+
 // InquiryStore provides CRUD operations for knowledge inquiries.
 type InquiryStore struct {
 	client *ent.Client
@@ -38,6 +42,9 @@ func (s *InquiryStore) SaveInquiry(ctx context.Context, inq Inquiry) error {
 	if inq.Context != "" {
 		builder.SetContext(inq.Context)
 	}
+	if len(inq.Options) > 0 {
+		builder.SetOptions(inq.Options)
+	}
 	if inq.SourceObservationID != "" {
 		builder.SetSourceObservationID(inq.SourceObservationID)
 	}
@@ -51,6 +58,19 @@ func (s *InquiryStore) SaveInquiry(ctx context.Context, inq Inquiry) error {
 	return nil
 }
 
+// GetInquiry returns a single inquiry by ID.
+func (s *InquiryStore) GetInquiry(ctx context.Context, id uuid.UUID) (*Inquiry, error) {
+	e, err := s.client.Inquiry.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("inquiry %s: %w", id, ErrInquiryNotFound)
+		}
+		return nil, fmt.Errorf("get inquiry: %w", err)
+	}
+	inq := entToInquiry(e)
+	return &inq, nil
+}
+
 // ListPendingInquiries returns pending inquiries for a session, ordered by priority and creation time.
 func (s *InquiryStore) ListPendingInquiries(ctx context.Context, sessionKey string, limit int) ([]Inquiry, error) {
 	entries, err := s.client.Inquiry.Query().
@@ -121,6 +141,7 @@ func entToInquiry(e *ent.Inquiry) Inquiry {
 		SessionKey: e.SessionKey,
 		Topic:      e.Topic,
 		Question:   e.Question,
+		Options:    e.Options,
 		Priority:   string(e.Priority),
 		Status:     string(e.Status),
 		CreatedAt:  e.CreatedAt,
@@ -0,0 +1,177 @@
+package librarian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	entknowledge "github.com/langoai/lango/internal/ent/knowledge"
+	"github.com/langoai/lango/internal/knowledge"
+)
+
+// ErrInquiryNotPending is returned when a quick reply targets an inquiry
+// that was already resolved or dismissed.
+var ErrInquiryNotPending = errors.New("inquiry is not pending")
+
+// InquiryNotifier pushes a newly created inquiry to the user's channel as an
+// interactive message.
+type InquiryNotifier interface {
+	NotifyInquiry(ctx context.Context, inq Inquiry) error
+	CanHandle(sessionKey string) bool
+}
+
+// InquiryResolver handles quick replies sent back from channel buttons.
+type InquiryResolver interface {
+	// GetInquiry returns the inquiry with id, so channels can check who may
+	// answer it.
+	GetInquiry(ctx context.Context, id uuid.UUID) (*Inquiry, error)
+	// AnswerInquiry resolves the inquiry with the option at index and saves
+	// the answer as knowledge. It returns the resolved inquiry.
+	AnswerInquiry(ctx context.Context, id uuid.UUID, option int) (*Inquiry, error)
+	// DismissInquiry marks the inquiry as dismissed.
+	DismissInquiry(ctx context.Context, id uuid.UUID) error
+}
+
+// CompositeNotifier routes inquiries to the first notifier whose CanHandle
+// returns true. Sessions without a matching notifier are silently skipped
+// and the inquiry keeps surfacing passively in the prompt.
+type CompositeNotifier struct {
+	mu        sync.RWMutex
+	notifiers []InquiryNotifier
+}
+
+var _ InquiryNotifier = (*CompositeNotifier)(nil)
+
+// NewCompositeNotifier creates a new CompositeNotifier.
+func NewCompositeNotifier() *CompositeNotifier {
+	return &CompositeNotifier{}
+}
+
+// Register appends a notifier to the routing chain.
+func (c *CompositeNotifier) Register(n InquiryNotifier) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.notifiers = append(c.notifiers, n)
+}
+
+// NotifyInquiry delivers the inquiry through the first matching notifier.
+func (c *CompositeNotifier) NotifyInquiry(ctx context.Context, inq Inquiry) error {
+	if n := c.route(inq.SessionKey); n != nil {
+		return n.NotifyInquiry(ctx, inq)
+	}
+	return nil
+}
+
+// CanHandle returns true if any registered notifier handles the session key.
+func (c *CompositeNotifier) CanHandle(sessionKey string) bool {
+	return c.route(sessionKey) != nil
+}
+
+func (c *CompositeNotifier) route(sessionKey string) InquiryNotifier {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, n := range c.notifiers {
+		if n.CanHandle(sessionKey) {
+			return n
+		}
+	}
+	return nil
+}
+
+// QuickReplyResolver resolves inquiries answered through channel buttons
+// and stores the chosen answer as knowledge.
+type QuickReplyResolver struct {
+	inquiryStore   *InquiryStore
+	knowledgeStore *knowledge.Store
+	logger         *zap.SugaredLogger
+}
+
+var _ InquiryResolver = (*QuickReplyResolver)(nil)
+
+// NewQuickReplyResolver creates a new quick-reply resolver.
+func NewQuickReplyResolver(
+	inquiryStore *InquiryStore,
+	knowledgeStore *knowledge.Store,
+	logger *zap.SugaredLogger,
+) *QuickReplyResolver {
+	return &QuickReplyResolver{
+		inquiryStore:   inquiryStore,
+		knowledgeStore: knowledgeStore,
+		logger:         logger,
+	}
+}
+
+// AnswerInquiry resolves the inquiry with the selected option. When the
+// answer cannot be saved as knowledge the inquiry stays pending, so it can
+// be answered again.
+func (r *QuickReplyResolver) AnswerInquiry(ctx context.Context, id uuid.UUID, option int) (*Inquiry, error) {
+	inq, err := r.inquiryStore.GetInquiry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if inq.Status != "pending" {
+		return nil, fmt.Errorf("inquiry %s: %w", id, ErrInquiryNotPending)
+	}
+	if option < 0 || option >= len(inq.Options) {
+		return nil, fmt.Errorf("inquiry %s: option %d out of range", id, option)
+	}
+	answer := inq.Options[option]
+
+	entry := knowledge.KnowledgeEntry{
+		Key:      inquiryKnowledgeKey(inq.Topic),
+		Category: entknowledge.CategoryFact,
+		Content:  fmt.Sprintf("%s\nAnswer: %s", inq.Question, answer),
+		Source:   "proactive_librarian",
+	}
+	saveCtx := knowledge.WithRevisionInfo(ctx, knowledge.RevisionInfo{
+		Author: knowledge.AuthorLibrarian,
+		Reason: "quick reply to inquiry: " + inq.Question,
+	})
+	if err := r.knowledgeStore.SaveKnowledge(saveCtx, inq.SessionKey, entry); err != nil {
+		return nil, fmt.Errorf("inquiry %s: save knowledge: %w", id, err)
+	}
+	r.logger.Infow("knowledge saved from quick reply", "key", entry.Key, "inquiryID", id)
+
+	if err := r.inquiryStore.ResolveInquiry(ctx, id, answer, entry.Key); err != nil {
+		return nil, err
+	}
+	inq.Status = "resolved"
+	inq.Answer = answer
+	inq.KnowledgeKey = entry.Key
+	return inq, nil
+}
+
+// GetInquiry returns the inquiry with id.
+func (r *QuickReplyResolver) GetInquiry(ctx context.Context, id uuid.UUID) (*Inquiry, error) {
+	return r.inquiryStore.GetInquiry(ctx, id)
+}
+
+// DismissInquiry marks the inquiry as dismissed.
+func (r *QuickReplyResolver) DismissInquiry(ctx context.Context, id uuid.UUID) error {
+	return r.inquiryStore.DismissInquiry(ctx, id)
+}
+
+// inquiryKnowledgeKey derives a snake_case knowledge key from an inquiry topic.
+func inquiryKnowledgeKey(topic string) string {
+	var b strings.Builder
+	b.WriteString("inquiry")
+	sep := true
+	for _, r := range strings.ToLower(topic) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if sep {
+				b.WriteByte('_')
+				sep = false
+			}
+			b.WriteRune(r)
+			continue
+		}
+		sep = true
+	}
+	return b.String()
+}
@@ -0,0 +1,164 @@
+package librarian
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/enttest"
+	"github.com/langoai/lango/internal/knowledge"
+)
+
+type fakeNotifier struct {
+	prefix   string
+	notified []Inquiry
+}
+
+func (f *fakeNotifier) NotifyInquiry(_ context.Context, inq Inquiry) error {
+	f.notified = append(f.notified, inq)
+	return nil
+}
+
+func (f *fakeNotifier) CanHandle(sessionKey string) bool {
+	return strings.HasPrefix(sessionKey, f.prefix)
+}
+
+func newTestResolver(t *testing.T) (*QuickReplyResolver, *InquiryStore, *knowledge.Store) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	logger := zap.NewNop().Sugar()
+	is := NewInquiryStore(client, logger)
+	ks := knowledge.NewStore(client, logger)
+	return NewQuickReplyResolver(is, ks, logger), is, ks
+}
+
+func TestCompositeNotifier_Routes(t *testing.T) {
+	tg := &fakeNotifier{prefix: "telegram:"}
+	sl := &fakeNotifier{prefix: "slack:"}
+	c := NewCompositeNotifier()
+	c.Register(tg)
+	c.Register(sl)
+
+	assert.True(t, c.CanHandle("slack:C1:U1"))
+	assert.False(t, c.CanHandle("discord:C1:U1"))
+
+	require.NoError(t, c.NotifyInquiry(context.Background(), Inquiry{SessionKey: "slack:C1:U1"}))
+	require.NoError(t, c.NotifyInquiry(context.Background(), Inquiry{SessionKey: "discord:C1:U1"}))
+	assert.Empty(t, tg.notified)
+	assert.Len(t, sl.notified, 1)
+}
+
+func TestQuickReplyResolver_AnswerInquiry(t *testing.T) {
+	resolver, is, ks := newTestResolver(t)
+	ctx := context.Background()
+
+	id := uuid.New()
+	require.NoError(t, is.SaveInquiry(ctx, Inquiry{
+		ID:         id,
+		SessionKey: "telegram:1:2",
+		Topic:      "Deploy Target",
+		Question:   "Where do you deploy?",
+		Options:    []string{"AWS", "GCP"},
+		Priority:   "medium",
+	}))
+
+	inq, err := resolver.AnswerInquiry(ctx, id, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "GCP", inq.Answer)
+	assert.Equal(t, "inquiry_deploy_target", inq.KnowledgeKey)
+
+	stored, err := is.GetInquiry(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "resolved", stored.Status)
+	assert.Equal(t, "GCP", stored.Answer)
+
+	entry, err := ks.GetKnowledge(ctx, "inquiry_deploy_target")
+	require.NoError(t, err)
+	assert.Contains(t, entry.Content, "Answer: GCP")
+
+	_, err = resolver.AnswerInquiry(ctx, id, 0)
+	assert.ErrorIs(t, err, ErrInquiryNotPending)
+}
+
+func TestQuickReplyResolver_SaveFailureKeepsPending(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	logger := zap.NewNop().Sugar()
+	is := NewInquiryStore(client, logger)
+	resolver := NewQuickReplyResolver(is, knowledge.NewStore(client, logger), logger)
+	ctx := context.Background()
+
+	id := uuid.New()
+	require.NoError(t, is.SaveInquiry(ctx, Inquiry{
+		ID:         id,
+		SessionKey: "telegram:1:2",
+		Topic:      "Deploy Target",
+		Question:   "Where do you deploy?",
+		Options:    []string{"AWS", "GCP"},
+		Priority:   "medium",
+	}))
+
+	client.Knowledge.Use(func(ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(context.Context, ent.Mutation) (ent.Value, error) {
+			return nil, errors.New("disk full")
+		})
+	})
+
+	_, err := resolver.AnswerInquiry(ctx, id, 1)
+	require.ErrorContains(t, err, "disk full")
+
+	stored, err := is.GetInquiry(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "pending", stored.Status)
+	assert.Empty(t, stored.Answer)
+}
+
+func TestQuickReplyResolver_Errors(t *testing.T) {
+	resolver, is, _ := newTestResolver(t)
+	ctx := context.Background()
+
+	_, err := resolver.AnswerInquiry(ctx, uuid.New(), 0)
+	assert.ErrorIs(t, err, ErrInquiryNotFound)
+
+	id := uuid.New()
+	require.NoError(t, is.SaveInquiry(ctx, Inquiry{
+		ID:         id,
+		SessionKey: "slack:C1:U1",
+		Topic:      "editor",
+		Question:   "Which editor?",
+		Options:    []string{"vim"},
+		Priority:   "low",
+	}))
+	_, err = resolver.AnswerInquiry(ctx, id, 3)
+	assert.Error(t, err)
+
+	require.NoError(t, resolver.DismissInquiry(ctx, id))
+	stored, err := is.GetInquiry(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "dismissed", stored.Status)
+}
+
+func TestInquiryKnowledgeKey(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "Deploy Target", want: "inquiry_deploy_target"},
+		{give: "  CI/CD -- pipeline! ", want: "inquiry_ci_cd_pipeline"},
+		{give: "", want: "inquiry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			assert.Equal(t, tt.want, inquiryKnowledgeKey(tt.give))
+		})
+	}
+}
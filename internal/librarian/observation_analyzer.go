@@ -44,7 +44,8 @@ Output JSON:
       "topic": "topic of the gap",
       "question": "natural question to ask the user",
       "context": "why this question matters",
-      "priority": "high|medium|low"
+      "priority": "high|medium|low",
+      "options": ["up to 3 short candidate answers, if the question has obvious choices"]
     }
   ]
 }
@@ -52,6 +53,7 @@ Output JSON:
 Rules:
 - Only extract genuinely useful knowledge, not conversational filler
 - Questions should be natural and conversational, not survey-like
+- Only offer options when a short answer fully resolves the question; omit them otherwise
 - Skip knowledge that is too session-specific or ephemeral
 - Prefer specific, actionable knowledge over vague observations`
 
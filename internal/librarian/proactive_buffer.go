@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/langoai/lango/internal/asyncbuf"
@@ -34,6 +35,7 @@ type ProactiveBuffer struct {
 	maxPending           int
 	autoSaveConfidence   types.Confidence
 	graphCallback        GraphCallback
+	notifier             InquiryNotifier
 
 	mu          sync.Mutex
 	turnCounter map[string]int // session_key -> turns since last inquiry
@@ -99,6 +101,12 @@ func (b *ProactiveBuffer) SetGraphCallback(cb GraphCallback) {
 	b.graphCallback = cb
 }
 
+// SetNotifier sets the optional notifier that pushes new inquiries to the
+// user's channel instead of waiting for them to surface in the prompt.
+func (b *ProactiveBuffer) SetNotifier(n InquiryNotifier) {
+	b.notifier = n
+}
+
 // Start launches the background processing goroutine.
 func (b *ProactiveBuffer) Start(wg *sync.WaitGroup) {
 	b.inner.Start(wg)
@@ -201,10 +209,12 @@ func (b *ProactiveBuffer) process(sessionKey string) {
 		}
 
 		inq := Inquiry{
+			ID:         uuid.New(),
 			SessionKey: sessionKey,
 			Topic:      gap.Topic,
 			Question:   gap.Question,
 			Context:    gap.Context,
+			Options:    gap.Options,
 			Priority:   gap.Priority,
 		}
 		if err := b.inquiryStore.SaveInquiry(ctx, inq); err != nil {
@@ -214,6 +224,12 @@ func (b *ProactiveBuffer) process(sessionKey string) {
 
 		pendingCount++
 		b.logger.Infow("inquiry created", "topic", gap.Topic, "priority", gap.Priority)
+
+		if b.notifier != nil && b.notifier.CanHandle(sessionKey) {
+			if err := b.notifier.NotifyInquiry(ctx, inq); err != nil {
+				b.logger.Warnw("notify inquiry", "id", inq.ID, "sessionKey", sessionKey, "error", err)
+			}
+		}
 	}
 
 	// Reset cooldown counter after creating inquiries.
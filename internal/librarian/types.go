@@ -29,6 +29,7 @@ type KnowledgeGap struct {
 	Context     string   `json:"context,omitempty"`
 	Priority    string   `json:"priority"` // low, medium, high
 	RelatedKeys []string `json:"relatedKeys,omitempty"`
+	Options     []string `json:"options,omitempty"` // short candidate answers for quick replies
 }
 
 // AnalysisOutput is the combined result from observation analysis.
@@ -44,8 +45,9 @@ type Inquiry struct {
 	Topic               string
 	Question            string
 	Context             string
-	Priority            string // low, medium, high
-	Status              string // pending, resolved, dismissed
+	Options             []string // quick-reply candidate answers
+	Priority            string   // low, medium, high
+	Status              string   // pending, resolved, dismissed
 	Answer              string
 	KnowledgeKey        string
 	SourceObservationID string
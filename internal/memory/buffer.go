@@ -144,6 +144,9 @@ func (b *Buffer) process(sessionKey string) {
 
 	var totalObsTokens int
 	for _, obs := range observations {
+		if obs.Pinned {
+			continue
+		}
 		totalObsTokens += obs.TokenCount
 	}
 
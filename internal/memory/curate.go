@@ -0,0 +1,240 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/observation"
+	"github.com/langoai/lango/internal/ent/reflection"
+)
+
+// Collection names used for memory entries in the vector store and graph.
+const (
+	CollectionObservation = "observation"
+	CollectionReflection  = "reflection"
+)
+
+// ErrEntryNotFound is returned when no observation or reflection matches an ID.
+var ErrEntryNotFound = errors.New("memory entry not found")
+
+// Entry is a user-facing view of a single memory entry, either an
+// observation or a reflection.
+type Entry struct {
+	ID         uuid.UUID `json:"id"`
+	Kind       string    `json:"kind"`
+	SessionKey string    `json:"session_key"`
+	Content    string    `json:"content"`
+	Pinned     bool      `json:"pinned"`
+}
+
+// GetEntry looks up an observation or reflection by ID.
+func (s *Store) GetEntry(ctx context.Context, id uuid.UUID) (*Entry, error) {
+	obs, err := s.client.Observation.Get(ctx, id)
+	if err == nil {
+		return &Entry{
+			ID:         obs.ID,
+			Kind:       CollectionObservation,
+			SessionKey: obs.SessionKey,
+			Content:    obs.Content,
+			Pinned:     obs.Pinned,
+		}, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("get observation: %w", err)
+	}
+
+	ref, err := s.client.Reflection.Get(ctx, id)
+	if err == nil {
+		return &Entry{
+			ID:         ref.ID,
+			Kind:       CollectionReflection,
+			SessionKey: ref.SessionKey,
+			Content:    ref.Content,
+		}, nil
+	}
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%s: %w", id, ErrEntryNotFound)
+	}
+	return nil, fmt.Errorf("get reflection: %w", err)
+}
+
+// PinFact stores content as a new pinned observation. Pinned observations
+// are injected into every session and are never condensed by the reflector.
+func (s *Store) PinFact(ctx context.Context, sessionKey, content string) (*Observation, error) {
+	obs := Observation{
+		ID:         uuid.New(),
+		SessionKey: sessionKey,
+		Content:    content,
+		TokenCount: EstimateTokens(content),
+		Pinned:     true,
+	}
+	if err := s.SaveObservation(ctx, obs); err != nil {
+		return nil, err
+	}
+	return &obs, nil
+}
+
+// SetPinned pins or unpins an existing observation.
+func (s *Store) SetPinned(ctx context.Context, id uuid.UUID, pinned bool) error {
+	err := s.client.Observation.UpdateOneID(id).
+		SetPinned(pinned).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("observation %s: %w", id, ErrEntryNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("set pinned: %w", err)
+	}
+	return nil
+}
+
+// ListPinnedObservations returns all pinned observations across sessions,
+// ordered by created_at ascending.
+func (s *Store) ListPinnedObservations(ctx context.Context) ([]Observation, error) {
+	entries, err := s.client.Observation.Query().
+		Where(observation.Pinned(true)).
+		Order(observation.ByCreatedAt()).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list pinned observations: %w", err)
+	}
+
+	result := make([]Observation, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, Observation{
+			ID:               e.ID,
+			SessionKey:       e.SessionKey,
+			Content:          e.Content,
+			TokenCount:       e.TokenCount,
+			SourceStartIndex: e.SourceStartIndex,
+			SourceEndIndex:   e.SourceEndIndex,
+			Pinned:           e.Pinned,
+			CreatedAt:        e.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+// Forget permanently deletes an observation or reflection and cascades the
+// deletion to its embeddings and graph nodes. It returns the deleted entry.
+func (s *Store) Forget(ctx context.Context, id uuid.UUID) (*Entry, error) {
+	entry, err := s.GetEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch entry.Kind {
+	case CollectionObservation:
+		if err := s.DeleteObservations(ctx, []uuid.UUID{id}); err != nil {
+			return nil, err
+		}
+		// Avoid linking the next observation to a node that no longer exists.
+		s.lastObsMu.Lock()
+		if s.lastObsIDs[entry.SessionKey] == id.String() {
+			delete(s.lastObsIDs, entry.SessionKey)
+		}
+		s.lastObsMu.Unlock()
+	case CollectionReflection:
+		if err := s.DeleteReflections(ctx, []uuid.UUID{id}); err != nil {
+			return nil, err
+		}
+	}
+
+	s.removeDerived(id.String(), entry.Kind)
+	return entry, nil
+}
+
+// ForgetSession deletes the observations and reflections of a session and
+// cascades the deletion to their embeddings and graph nodes, like Forget.
+// Pinned observations are kept unless includePinned is set. It returns the
+// number of deleted entries.
+func (s *Store) ForgetSession(ctx context.Context, sessionKey string, includePinned bool) (int, error) {
+	obsQuery := s.client.Observation.Query().Where(observation.SessionKey(sessionKey))
+	if !includePinned {
+		obsQuery = obsQuery.Where(observation.Pinned(false))
+	}
+	obsIDs, err := obsQuery.IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list observations: %w", err)
+	}
+	refIDs, err := s.client.Reflection.Query().Where(reflection.SessionKey(sessionKey)).IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list reflections: %w", err)
+	}
+
+	if err := s.DeleteObservations(ctx, obsIDs); err != nil {
+		return 0, err
+	}
+	if err := s.DeleteReflections(ctx, refIDs); err != nil {
+		return 0, err
+	}
+	s.lastObsMu.Lock()
+	delete(s.lastObsIDs, sessionKey)
+	s.lastObsMu.Unlock()
+
+	for _, id := range obsIDs {
+		s.removeDerived(id.String(), CollectionObservation)
+	}
+	for _, id := range refIDs {
+		s.removeDerived(id.String(), CollectionReflection)
+	}
+	return len(obsIDs) + len(refIDs), nil
+}
+
+// Correct replaces the content of an observation or reflection. Derived
+// embeddings and graph triples are rebuilt from the corrected content.
+func (s *Store) Correct(ctx context.Context, id uuid.UUID, content string) (*Entry, error) {
+	entry, err := s.GetEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content = s.scrubContent(content)
+	tokens := EstimateTokens(content)
+	switch entry.Kind {
+	case CollectionObservation:
+		err = s.client.Observation.UpdateOneID(id).
+			SetContent(content).
+			SetTokenCount(tokens).
+			Exec(ctx)
+	case CollectionReflection:
+		err = s.client.Reflection.UpdateOneID(id).
+			SetContent(content).
+			SetTokenCount(tokens).
+			Exec(ctx)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("correct %s: %w", entry.Kind, err)
+	}
+	entry.Content = content
+
+	idStr := id.String()
+	s.removeDerived(idStr, entry.Kind)
+
+	meta := map[string]string{"session_key": entry.SessionKey}
+	if s.onEmbed != nil {
+		s.onEmbed(idStr, entry.Kind, content, meta)
+	}
+	if s.onGraph != nil {
+		s.onGraph(idStr, entry.Kind, content, meta)
+	}
+	if s.graphHooks != nil && entry.Kind == CollectionObservation {
+		s.graphHooks.OnObservation(Observation{ID: id, SessionKey: entry.SessionKey}, "")
+	}
+
+	return entry, nil
+}
+
+// removeDerived fires the remove callbacks for an entry.
+func (s *Store) removeDerived(id, collection string) {
+	if s.onEmbedRemove != nil {
+		s.onEmbedRemove(id, collection)
+	}
+	if s.onGraphRemove != nil {
+		s.onGraphRemove(id, collection)
+	}
+}
@@ -0,0 +1,207 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPinFact(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveObservation(ctx, Observation{
+		SessionKey: "session-a",
+		Content:    "User asked about weather",
+	}))
+	pinned, err := store.PinFact(ctx, "session-b", "User prefers Go")
+	require.NoError(t, err)
+	assert.True(t, pinned.Pinned)
+
+	got, err := store.ListPinnedObservations(ctx)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "User prefers Go", got[0].Content)
+	assert.Equal(t, "session-b", got[0].SessionKey)
+}
+
+func TestSetPinned(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	id := uuid.New()
+	require.NoError(t, store.SaveObservation(ctx, Observation{
+		ID:         id,
+		SessionKey: "session-1",
+		Content:    "User lives in Seoul",
+	}))
+
+	require.NoError(t, store.SetPinned(ctx, id, true))
+	obs, err := store.GetObservation(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, obs.Pinned)
+
+	require.NoError(t, store.SetPinned(ctx, id, false))
+	obs, err = store.GetObservation(ctx, id)
+	require.NoError(t, err)
+	assert.False(t, obs.Pinned)
+
+	err = store.SetPinned(ctx, uuid.New(), true)
+	assert.ErrorIs(t, err, ErrEntryNotFound)
+}
+
+func TestForget(t *testing.T) {
+	t.Run("observation cascades to vectors and graph", func(t *testing.T) {
+		store := newTestStore(t)
+		ctx := context.Background()
+
+		var embedRemoved, graphRemoved []string
+		store.SetEmbedRemoveCallback(func(id, collection string) {
+			embedRemoved = append(embedRemoved, collection+":"+id)
+		})
+		store.SetGraphRemoveCallback(func(id, collection string) {
+			graphRemoved = append(graphRemoved, collection+":"+id)
+		})
+
+		id := uuid.New()
+		require.NoError(t, store.SaveObservation(ctx, Observation{
+			ID:         id,
+			SessionKey: "session-1",
+			Content:    "User lives in Busan",
+		}))
+
+		entry, err := store.Forget(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, CollectionObservation, entry.Kind)
+
+		_, err = store.GetObservation(ctx, id)
+		assert.Error(t, err)
+		assert.Equal(t, []string{"observation:" + id.String()}, embedRemoved)
+		assert.Equal(t, []string{"observation:" + id.String()}, graphRemoved)
+	})
+
+	t.Run("reflection", func(t *testing.T) {
+		store := newTestStore(t)
+		ctx := context.Background()
+
+		id := uuid.New()
+		require.NoError(t, store.SaveReflection(ctx, Reflection{
+			ID:         id,
+			SessionKey: "session-1",
+			Content:    "User is relocating",
+			Generation: 1,
+		}))
+
+		entry, err := store.Forget(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, CollectionReflection, entry.Kind)
+
+		refs, err := store.ListReflections(ctx, "session-1")
+		require.NoError(t, err)
+		assert.Empty(t, refs)
+	})
+
+	t.Run("unknown id", func(t *testing.T) {
+		store := newTestStore(t)
+		_, err := store.Forget(context.Background(), uuid.New())
+		assert.ErrorIs(t, err, ErrEntryNotFound)
+	})
+}
+
+func TestForgetSession(t *testing.T) {
+	tests := []struct {
+		give          bool // includePinned
+		wantDeleted   int
+		wantRemaining int
+	}{
+		{give: false, wantDeleted: 2, wantRemaining: 1},
+		{give: true, wantDeleted: 3, wantRemaining: 0},
+	}
+	for _, tt := range tests {
+		store := newTestStore(t)
+		ctx := context.Background()
+
+		var removed []string
+		store.SetEmbedRemoveCallback(func(id, collection string) {
+			removed = append(removed, collection)
+		})
+
+		require.NoError(t, store.SaveObservation(ctx, Observation{SessionKey: "session-1", Content: "User asked about weather"}))
+		require.NoError(t, store.SaveReflection(ctx, Reflection{SessionKey: "session-1", Content: "User plans a trip", Generation: 1}))
+		_, err := store.PinFact(ctx, "session-1", "User prefers Go")
+		require.NoError(t, err)
+		require.NoError(t, store.SaveObservation(ctx, Observation{SessionKey: "session-2", Content: "Other session"}))
+
+		n, err := store.ForgetSession(ctx, "session-1", tt.give)
+		require.NoError(t, err)
+		assert.Equal(t, tt.wantDeleted, n)
+		assert.Len(t, removed, tt.wantDeleted)
+
+		obs, err := store.ListObservations(ctx, "session-1")
+		require.NoError(t, err)
+		assert.Len(t, obs, tt.wantRemaining)
+		refs, err := store.ListReflections(ctx, "session-1")
+		require.NoError(t, err)
+		assert.Empty(t, refs)
+		other, err := store.ListObservations(ctx, "session-2")
+		require.NoError(t, err)
+		assert.Len(t, other, 1)
+	}
+}
+
+func TestCorrect(t *testing.T) {
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	var reembedded []string
+	var removed int
+	store.SetEmbedCallback(func(id, collection, content string, _ map[string]string) {
+		reembedded = append(reembedded, content)
+	})
+	store.SetEmbedRemoveCallback(func(string, string) { removed++ })
+
+	id := uuid.New()
+	require.NoError(t, store.SaveObservation(ctx, Observation{
+		ID:         id,
+		SessionKey: "session-1",
+		Content:    "User lives in Busan",
+	}))
+
+	entry, err := store.Correct(ctx, id, "User lives in Seoul")
+	require.NoError(t, err)
+	assert.Equal(t, "User lives in Seoul", entry.Content)
+
+	obs, err := store.GetObservation(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, "User lives in Seoul", obs.Content)
+	assert.Equal(t, EstimateTokens("User lives in Seoul"), obs.TokenCount)
+
+	assert.Equal(t, 1, removed)
+	assert.Equal(t, []string{"User lives in Busan", "User lives in Seoul"}, reembedded)
+}
+
+func TestReflect_KeepsPinnedObservations(t *testing.T) {
+	gen := &mockGenerator{response: "condensed"}
+	reflector, store := newTestReflector(t, gen)
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveObservation(ctx, Observation{
+		SessionKey: "session-1",
+		Content:    "transient note",
+		TokenCount: 5,
+	}))
+	pinned, err := store.PinFact(ctx, "session-1", "User prefers Go")
+	require.NoError(t, err)
+
+	ref, err := reflector.Reflect(ctx, "session-1")
+	require.NoError(t, err)
+	require.NotNil(t, ref)
+
+	remaining, err := store.ListObservations(ctx, "session-1")
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, pinned.ID, remaining[0].ID)
+}
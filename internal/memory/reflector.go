@@ -35,11 +35,19 @@ func NewReflector(generator TextGenerator, store *Store, logger *zap.SugaredLogg
 // Reflect condenses all observations for a session into a single reflection.
 // Returns nil if there are no observations to condense.
 func (r *Reflector) Reflect(ctx context.Context, sessionKey string) (*Reflection, error) {
-	observations, err := r.store.ListObservations(ctx, sessionKey)
+	all, err := r.store.ListObservations(ctx, sessionKey)
 	if err != nil {
 		return nil, fmt.Errorf("list observations: %w", err)
 	}
 
+	// Pinned observations are user-curated and must survive condensation.
+	observations := make([]Observation, 0, len(all))
+	for _, obs := range all {
+		if !obs.Pinned {
+			observations = append(observations, obs)
+		}
+	}
+
 	if len(observations) == 0 {
 		return nil, nil
 	}
@@ -22,6 +22,12 @@ type Store struct {
 	onEmbed    types.EmbedCallback
 	onGraph    types.ContentCallback
 	graphHooks *GraphHooks
+	scrub      types.ScrubFunc
+
+	// onEmbedRemove and onGraphRemove cascade explicit deletions (forget)
+	// and corrections to the vector store and the knowledge graph.
+	onEmbedRemove types.RemoveCallback
+	onGraphRemove types.RemoveCallback
 
 	// lastObsMu protects lastObsIDs for concurrent SaveObservation calls.
 	lastObsMu  sync.Mutex
@@ -47,6 +53,32 @@ func (s *Store) SetGraphCallback(cb types.ContentCallback) {
 	s.onGraph = cb
 }
 
+// SetEmbedRemoveCallback sets the optional hook that deletes embeddings
+// of forgotten or corrected entries.
+func (s *Store) SetEmbedRemoveCallback(cb types.RemoveCallback) {
+	s.onEmbedRemove = cb
+}
+
+// SetGraphRemoveCallback sets the optional hook that deletes graph nodes
+// and derived triples of forgotten or corrected entries.
+func (s *Store) SetGraphRemoveCallback(cb types.RemoveCallback) {
+	s.onGraphRemove = cb
+}
+
+// SetScrubber sets the optional hook that removes secrets from content
+// before it is saved.
+func (s *Store) SetScrubber(fn types.ScrubFunc) {
+	s.scrub = fn
+}
+
+// scrubContent applies the scrub hook, if any.
+func (s *Store) scrubContent(content string) string {
+	if s.scrub == nil {
+		return content
+	}
+	return s.scrub(content)
+}
+
 // SetGraphHooks sets the graph hooks for temporal/session triple generation.
 func (s *Store) SetGraphHooks(hooks *GraphHooks) {
 	s.graphHooks = hooks
@@ -54,12 +86,14 @@ func (s *Store) SetGraphHooks(hooks *GraphHooks) {
 
 // SaveObservation persists an observation to the database.
 func (s *Store) SaveObservation(ctx context.Context, obs Observation) error {
+	obs.Content = s.scrubContent(obs.Content)
 	builder := s.client.Observation.Create().
 		SetSessionKey(obs.SessionKey).
 		SetContent(obs.Content).
 		SetTokenCount(obs.TokenCount).
 		SetSourceStartIndex(obs.SourceStartIndex).
-		SetSourceEndIndex(obs.SourceEndIndex)
+		SetSourceEndIndex(obs.SourceEndIndex).
+		SetPinned(obs.Pinned)
 
 	if obs.ID != uuid.Nil {
 		builder.SetID(obs.ID)
@@ -114,6 +148,7 @@ func (s *Store) ListObservations(ctx context.Context, sessionKey string) ([]Obse
 			TokenCount:       e.TokenCount,
 			SourceStartIndex: e.SourceStartIndex,
 			SourceEndIndex:   e.SourceEndIndex,
+			Pinned:           e.Pinned,
 			CreatedAt:        e.CreatedAt,
 		})
 	}
@@ -133,6 +168,7 @@ func (s *Store) GetObservation(ctx context.Context, id uuid.UUID) (*Observation,
 		TokenCount:       e.TokenCount,
 		SourceStartIndex: e.SourceStartIndex,
 		SourceEndIndex:   e.SourceEndIndex,
+		Pinned:           e.Pinned,
 		CreatedAt:        e.CreatedAt,
 	}, nil
 }
@@ -163,6 +199,7 @@ func (s *Store) DeleteObservationsBySession(ctx context.Context, sessionKey stri
 
 // SaveReflection persists a reflection to the database.
 func (s *Store) SaveReflection(ctx context.Context, ref Reflection) error {
+	ref.Content = s.scrubContent(ref.Content)
 	builder := s.client.Reflection.Create().
 		SetSessionKey(ref.SessionKey).
 		SetContent(ref.Content).
@@ -319,6 +356,7 @@ func (s *Store) ListRecentObservations(ctx context.Context, sessionKey string, l
 			TokenCount:       e.TokenCount,
 			SourceStartIndex: e.SourceStartIndex,
 			SourceEndIndex:   e.SourceEndIndex,
+			Pinned:           e.Pinned,
 			CreatedAt:        e.CreatedAt,
 		}
 	}
@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -78,6 +79,31 @@ func TestSaveAndListObservations(t *testing.T) {
 	})
 }
 
+func TestStore_Scrubber(t *testing.T) {
+	store := newTestStore(t)
+	store.SetScrubber(func(text string) string {
+		return strings.ReplaceAll(text, "hunter2", "{{secret:pw}}")
+	})
+	ctx := context.Background()
+
+	require.NoError(t, store.SaveObservation(ctx, Observation{SessionKey: "s1", Content: "password is hunter2"}))
+	require.NoError(t, store.SaveReflection(ctx, Reflection{SessionKey: "s1", Content: "uses hunter2"}))
+
+	observations, err := store.ListObservations(ctx, "s1")
+	require.NoError(t, err)
+	require.Len(t, observations, 1)
+	assert.Equal(t, "password is {{secret:pw}}", observations[0].Content)
+
+	corrected, err := store.Correct(ctx, observations[0].ID, "password is now hunter2")
+	require.NoError(t, err)
+	assert.Equal(t, "password is now {{secret:pw}}", corrected.Content)
+
+	reflections, err := store.ListReflections(ctx, "s1")
+	require.NoError(t, err)
+	require.Len(t, reflections, 1)
+	assert.Equal(t, "uses {{secret:pw}}", reflections[0].Content)
+}
+
 func TestDeleteObservations(t *testing.T) {
 	store := newTestStore(t)
 	ctx := context.Background()
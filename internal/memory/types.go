@@ -14,6 +14,7 @@ type Observation struct {
 	TokenCount       int
 	SourceStartIndex int
 	SourceEndIndex   int
+	Pinned           bool
 	CreatedAt        time.Time
 }
 
@@ -0,0 +1,350 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// pattern is a compiled glob or regular expression.
+type pattern struct {
+	src  string
+	kind string // "glob" or "regex"
+	re   *regexp.Regexp
+	path bool // the glob is a filesystem path; values are cleaned before matching
+}
+
+// compileGlob compiles a shell-style glob: "*" matches any run of
+// characters (including "/"), "?" one character and "[...]" a class. With
+// paths, a pattern starting with "/" or "~/" is a filesystem path glob.
+func compileGlob(src string, paths bool) (*pattern, error) {
+	p := &pattern{src: src, kind: "glob"}
+	glob := src
+	if paths && (strings.HasPrefix(glob, "/") || strings.HasPrefix(glob, "~/")) {
+		p.path = true
+		glob = expandHome(glob)
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed '[' in glob")
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			b.WriteString("[" + class + "]")
+			i += end + 1
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob: %w", err)
+	}
+	p.re = re
+	return p, nil
+}
+
+func compileRegex(src string) (*pattern, error) {
+	re, err := regexp.Compile(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex: %w", err)
+	}
+	return &pattern{src: src, kind: "regex", re: re}, nil
+}
+
+func (p *pattern) match(value string) bool {
+	if p.path && (strings.HasPrefix(value, "/") || strings.HasPrefix(value, "~")) {
+		// Clean the path so "~/work/../.ssh" does not match "~/work/*".
+		value = filepath.Clean(expandHome(value))
+	}
+	return p.re.MatchString(value)
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
+
+// paramMatcher matches the values a JSON path selects from tool parameters.
+type paramMatcher struct {
+	path    string
+	segs    []pathSeg
+	pattern *pattern // nil: the parameter only has to be present
+	all     bool     // every selected value must match, not just one
+}
+
+// pathSeg is one step of a JSON path: a key, an index or "[*]".
+type pathSeg struct {
+	key   string
+	index int // -1: any element
+	isKey bool
+}
+
+// compileParam compiles a parameter matcher. With all set, a path that
+// selects several values matches only when every value matches, so an allow
+// rule cannot be satisfied by one harmless element among others.
+func compileParam(pc config.PolicyParam, all bool) (*paramMatcher, error) {
+	if pc.Glob != "" && pc.Regex != "" {
+		return nil, fmt.Errorf("set glob or regex, not both")
+	}
+	segs, err := parsePath(pc.Path)
+	if err != nil {
+		return nil, fmt.Errorf("path %q: %w", pc.Path, err)
+	}
+	m := &paramMatcher{path: pc.Path, segs: segs, all: all}
+	switch {
+	case pc.Glob != "":
+		m.pattern, err = compileGlob(pc.Glob, true)
+	case pc.Regex != "":
+		m.pattern, err = compileRegex(pc.Regex)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parsePath parses a JSON path such as "$.edits[*].path" or "command".
+func parsePath(path string) ([]pathSeg, error) {
+	p := strings.TrimPrefix(strings.TrimPrefix(path, "$"), ".")
+	if p == "" {
+		return nil, fmt.Errorf("empty path")
+	}
+
+	var segs []pathSeg
+	for _, part := range strings.Split(p, ".") {
+		key := part
+		if i := strings.IndexByte(part, '['); i >= 0 {
+			key, part = part[:i], part[i:]
+		} else {
+			part = ""
+		}
+		if key == "" && part == "" {
+			return nil, fmt.Errorf("empty segment")
+		}
+		if key != "" {
+			segs = append(segs, pathSeg{key: key, isKey: true})
+		}
+		for part != "" {
+			end := strings.IndexByte(part, ']')
+			if !strings.HasPrefix(part, "[") || end < 0 {
+				return nil, fmt.Errorf("invalid index in %q", part)
+			}
+			idx := part[1:end]
+			part = part[end+1:]
+			if idx == "*" {
+				segs = append(segs, pathSeg{index: -1})
+				continue
+			}
+			n, err := strconv.Atoi(idx)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid index %q", idx)
+			}
+			segs = append(segs, pathSeg{index: n})
+		}
+	}
+	return segs, nil
+}
+
+// resolve returns the values the path selects. Arrays at the end of the
+// path are expanded so that any element can match.
+func resolve(v interface{}, segs []pathSeg) []interface{} {
+	if len(segs) == 0 {
+		if list, ok := asList(v); ok {
+			return list
+		}
+		return []interface{}{v}
+	}
+	seg := segs[0]
+	if seg.isKey {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		child, ok := m[seg.key]
+		if !ok {
+			return nil
+		}
+		return resolve(child, segs[1:])
+	}
+
+	list, ok := asList(v)
+	if !ok {
+		return nil
+	}
+	if seg.index >= 0 {
+		if seg.index >= len(list) {
+			return nil
+		}
+		return resolve(list[seg.index], segs[1:])
+	}
+	var out []interface{}
+	for _, el := range list {
+		out = append(out, resolve(el, segs[1:])...)
+	}
+	return out
+}
+
+func asList(v interface{}) ([]interface{}, bool) {
+	switch l := v.(type) {
+	case []interface{}:
+		return l, true
+	case []string:
+		out := make([]interface{}, len(l))
+		for i, s := range l {
+			out[i] = s
+		}
+		return out, true
+	}
+	return nil, false
+}
+
+// stringify renders a parameter value for matching.
+func stringify(v interface{}) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool, int, int64:
+		return fmt.Sprint(x)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func (m *paramMatcher) match(params map[string]interface{}) (bool, string) {
+	values := resolve(map[string]interface{}(params), m.segs)
+	var present []string
+	for _, v := range values {
+		if v != nil {
+			present = append(present, stringify(v))
+		}
+	}
+	if len(present) == 0 {
+		return false, fmt.Sprintf("param %s is missing", m.path)
+	}
+	if m.pattern == nil {
+		return true, ""
+	}
+	for _, s := range present {
+		ok := m.pattern.match(s)
+		if ok && !m.all {
+			return true, ""
+		}
+		if !ok && m.all {
+			return false, fmt.Sprintf("param %s=%q does not match %s %q", m.path, s, m.pattern.kind, m.pattern.src)
+		}
+	}
+	if m.all {
+		return true, ""
+	}
+	return false, fmt.Sprintf("param %s=%q does not match %s %q", m.path, present[0], m.pattern.kind, m.pattern.src)
+}
+
+// timeWindow restricts a rule to days of the week and a range of hours.
+type timeWindow struct {
+	src        config.PolicyTimeWindow
+	days       map[time.Weekday]bool // nil: every day
+	start, end int                   // minutes since midnight; start == end: all day
+	loc        *time.Location
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+func compileWindow(tw config.PolicyTimeWindow) (*timeWindow, error) {
+	if len(tw.Days) == 0 && tw.Hours == "" {
+		return nil, nil
+	}
+	w := &timeWindow{src: tw, loc: time.Local}
+	if tw.Timezone != "" {
+		loc, err := time.LoadLocation(tw.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("timezone %q: %w", tw.Timezone, err)
+		}
+		w.loc = loc
+	}
+	if len(tw.Days) > 0 {
+		w.days = make(map[time.Weekday]bool, len(tw.Days))
+		for _, d := range tw.Days {
+			day, ok := weekdays[strings.ToLower(d)]
+			if !ok {
+				return nil, fmt.Errorf("day %q must be one of mon, tue, wed, thu, fri, sat, sun", d)
+			}
+			w.days[day] = true
+		}
+	}
+	if tw.Hours != "" {
+		from, to, ok := strings.Cut(tw.Hours, "-")
+		var err error
+		if ok {
+			if w.start, err = parseClock(from); err == nil {
+				w.end, err = parseClock(to)
+			}
+		}
+		if !ok || err != nil {
+			return nil, fmt.Errorf("hours %q must look like 09:00-18:00", tw.Hours)
+		}
+	}
+	return w, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (w *timeWindow) match(t time.Time) (bool, string) {
+	t = t.In(w.loc)
+	if w.days != nil && !w.days[t.Weekday()] {
+		return false, fmt.Sprintf("%s is not in days [%s]", strings.ToLower(t.Weekday().String()[:3]), strings.Join(w.src.Days, ", "))
+	}
+	if w.start == w.end {
+		return true, ""
+	}
+	m := t.Hour()*60 + t.Minute()
+	in := m >= w.start && m < w.end
+	if w.start > w.end { // wraps midnight
+		in = m >= w.start || m < w.end
+	}
+	if !in {
+		return false, fmt.Sprintf("%s is outside hours %s", t.Format("15:04"), w.src.Hours)
+	}
+	return true, ""
+}
@@ -0,0 +1,239 @@
+// Package policy evaluates declarative tool approval rules. Rules match the
+// tool name, tool parameters, the session and channel of the request, and
+// time windows, and decide whether a tool runs, asks for approval or is
+// refused.
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// Request is a tool invocation to evaluate.
+type Request struct {
+	Tool       string
+	Params     map[string]interface{}
+	SessionKey string
+	Time       time.Time // zero means now
+}
+
+// Decision is the outcome of the rule that matched a request.
+type Decision struct {
+	Action config.PolicyAction
+	Rule   string // name of the deciding rule
+}
+
+// Trace explains how one rule was evaluated against a request.
+type Trace struct {
+	Rule    string              `json:"rule"`
+	Action  config.PolicyAction `json:"action"`
+	Matched bool                `json:"matched"`
+	Reason  string              `json:"reason"` // the first condition that did not match, or why it matched
+}
+
+// Engine evaluates compiled rules in order.
+type Engine struct {
+	rules []*rule
+}
+
+// New compiles rules. It returns an error naming the rule with an invalid
+// pattern, time window or action.
+func New(rules []config.PolicyRule) (*Engine, error) {
+	e := &Engine{rules: make([]*rule, 0, len(rules))}
+	for i, rc := range rules {
+		name := rc.Name
+		if name == "" {
+			name = fmt.Sprintf("rule %d", i+1)
+		}
+		r, err := compileRule(name, rc)
+		if err != nil {
+			return nil, fmt.Errorf("policy %q: %w", name, err)
+		}
+		e.rules = append(e.rules, r)
+	}
+	return e, nil
+}
+
+// Empty reports whether the engine has no rules.
+func (e *Engine) Empty() bool {
+	return e == nil || len(e.rules) == 0
+}
+
+// Covers reports whether any rule can match invocations of the tool.
+func (e *Engine) Covers(tool string) bool {
+	if e == nil {
+		return false
+	}
+	for _, r := range e.rules {
+		if r.matchTool(tool) {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate returns the decision of the first rule matching req. It returns
+// false when no rule matches.
+func (e *Engine) Evaluate(req Request) (Decision, bool) {
+	if e == nil {
+		return Decision{}, false
+	}
+	req = normalize(req)
+	for _, r := range e.rules {
+		if ok, _ := r.match(req); ok {
+			return Decision{Action: r.action, Rule: r.name}, true
+		}
+	}
+	return Decision{}, false
+}
+
+// Explain evaluates every rule against req, up to and including the first
+// one that matches.
+func (e *Engine) Explain(req Request) []Trace {
+	if e == nil {
+		return nil
+	}
+	req = normalize(req)
+	traces := make([]Trace, 0, len(e.rules))
+	for _, r := range e.rules {
+		ok, reason := r.match(req)
+		traces = append(traces, Trace{Rule: r.name, Action: r.action, Matched: ok, Reason: reason})
+		if ok {
+			break
+		}
+	}
+	return traces
+}
+
+// ChannelOf returns the channel of a session key: the prefix before the
+// first colon ("telegram", "cron", "p2p", ...). Keys without a prefix
+// belong to gateway and local sessions and return "gateway".
+func ChannelOf(sessionKey string) string {
+	if i := strings.Index(sessionKey, ":"); i > 0 {
+		return sessionKey[:i]
+	}
+	return "gateway"
+}
+
+func normalize(req Request) Request {
+	if req.Time.IsZero() {
+		req.Time = time.Now()
+	}
+	return req
+}
+
+// rule is a compiled config.PolicyRule.
+type rule struct {
+	name     string
+	action   config.PolicyAction
+	tools    []*pattern
+	params   []*paramMatcher
+	sessions []*pattern
+	channels []string
+	window   *timeWindow
+}
+
+func compileRule(name string, rc config.PolicyRule) (*rule, error) {
+	if !rc.Action.Valid() {
+		return nil, fmt.Errorf("action %q must be allow, ask, or deny", rc.Action)
+	}
+	r := &rule{name: name, action: rc.Action, channels: rc.Channels}
+
+	for _, t := range rc.Tools {
+		p, err := compileGlob(t, false)
+		if err != nil {
+			return nil, fmt.Errorf("tool %q: %w", t, err)
+		}
+		r.tools = append(r.tools, p)
+	}
+	for _, s := range rc.Sessions {
+		p, err := compileGlob(s, false)
+		if err != nil {
+			return nil, fmt.Errorf("session %q: %w", s, err)
+		}
+		r.sessions = append(r.sessions, p)
+	}
+	for i, pc := range rc.Params {
+		m, err := compileParam(pc, rc.Action == config.PolicyActionAllow)
+		if err != nil {
+			return nil, fmt.Errorf("params[%d]: %w", i, err)
+		}
+		r.params = append(r.params, m)
+	}
+	w, err := compileWindow(rc.Time)
+	if err != nil {
+		return nil, fmt.Errorf("time: %w", err)
+	}
+	r.window = w
+	return r, nil
+}
+
+func (r *rule) matchTool(tool string) bool {
+	if len(r.tools) == 0 {
+		return true
+	}
+	for _, p := range r.tools {
+		if p.match(tool) {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether the rule matches req, with the reason.
+func (r *rule) match(req Request) (bool, string) {
+	if !r.matchTool(req.Tool) {
+		return false, fmt.Sprintf("tool %q is not in %s", req.Tool, patternList(r.tools))
+	}
+
+	if len(r.sessions) > 0 {
+		matched := false
+		for _, p := range r.sessions {
+			if p.match(req.SessionKey) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, fmt.Sprintf("session %q is not in %s", req.SessionKey, patternList(r.sessions))
+		}
+	}
+
+	if len(r.channels) > 0 {
+		channel := ChannelOf(req.SessionKey)
+		matched := false
+		for _, c := range r.channels {
+			if strings.EqualFold(c, channel) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, fmt.Sprintf("channel %q is not in [%s]", channel, strings.Join(r.channels, ", "))
+		}
+	}
+
+	if r.window != nil {
+		if ok, reason := r.window.match(req.Time); !ok {
+			return false, reason
+		}
+	}
+
+	for _, m := range r.params {
+		if ok, reason := m.match(req.Params); !ok {
+			return false, reason
+		}
+	}
+	return true, "all conditions match"
+}
+
+func patternList(ps []*pattern) string {
+	srcs := make([]string, 0, len(ps))
+	for _, p := range ps {
+		srcs = append(srcs, p.src)
+	}
+	return "[" + strings.Join(srcs, ", ") + "]"
+}
@@ -0,0 +1,217 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+func mustEngine(t *testing.T, rules ...config.PolicyRule) *Engine {
+	t.Helper()
+	e, err := New(rules)
+	if err != nil {
+		t.Fatalf("new engine: %v", err)
+	}
+	return e
+}
+
+func TestEngine_ExecCommands(t *testing.T) {
+	e := mustEngine(t,
+		config.PolicyRule{
+			Name:   "ask-rm",
+			Tools:  []string{"exec", "exec_bg"},
+			Params: []config.PolicyParam{{Path: "command", Regex: `\brm\b`}},
+			Action: config.PolicyActionAsk,
+		},
+		config.PolicyRule{
+			Name:   "safe-commands",
+			Tools:  []string{"exec"},
+			Params: []config.PolicyParam{{Path: "command", Regex: `^(git status|go test \./\.\.\.)$`}},
+			Action: config.PolicyActionAllow,
+		},
+	)
+
+	tests := []struct {
+		give        string
+		wantMatched bool
+		wantRule    string
+	}{
+		{give: "git status", wantMatched: true, wantRule: "safe-commands"},
+		{give: "go test ./...", wantMatched: true, wantRule: "safe-commands"},
+		{give: "git status && rm -rf /", wantMatched: true, wantRule: "ask-rm"},
+		{give: "rm build.log", wantMatched: true, wantRule: "ask-rm"},
+		{give: "git push"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			d, ok := e.Evaluate(Request{Tool: "exec", Params: map[string]interface{}{"command": tt.give}})
+			if ok != tt.wantMatched {
+				t.Fatalf("expected matched=%v, got %v (%+v)", tt.wantMatched, ok, d)
+			}
+			if d.Rule != tt.wantRule {
+				t.Errorf("expected rule %q, got %q", tt.wantRule, d.Rule)
+			}
+		})
+	}
+}
+
+func TestEngine_PathGlob(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+	e := mustEngine(t, config.PolicyRule{
+		Tools:  []string{"fs_*"},
+		Params: []config.PolicyParam{{Path: "$.path", Glob: "~/work/*"}},
+		Action: config.PolicyActionAllow,
+	})
+
+	tests := []struct {
+		give string
+		want bool
+	}{
+		{give: "~/work/notes.md", want: true},
+		{give: filepath.Join(home, "work", "src", "main.go"), want: true},
+		{give: "~/work/../.ssh/id_ed25519", want: false},
+		{give: "/etc/passwd", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			_, ok := e.Evaluate(Request{Tool: "fs_write", Params: map[string]interface{}{"path": tt.give}})
+			if ok != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, ok)
+			}
+		})
+	}
+}
+
+func TestEngine_ArrayParams(t *testing.T) {
+	params := map[string]interface{}{
+		"edits": []interface{}{
+			map[string]interface{}{"path": "/srv/app/a.go"},
+			map[string]interface{}{"path": "/etc/hosts"},
+		},
+	}
+	path := config.PolicyParam{Path: "$.edits[*].path", Glob: "/srv/app/*"}
+
+	// An allow rule needs every selected value to match.
+	allow := mustEngine(t, config.PolicyRule{Params: []config.PolicyParam{path}, Action: config.PolicyActionAllow})
+	if _, ok := allow.Evaluate(Request{Tool: "fs_apply_patch", Params: params}); ok {
+		t.Error("expected allow rule not to match a mixed list")
+	}
+
+	// Ask and deny rules match on any value.
+	deny := mustEngine(t, config.PolicyRule{
+		Params: []config.PolicyParam{{Path: "$.edits[*].path", Glob: "/etc/*"}},
+		Action: config.PolicyActionDeny,
+	})
+	if d, ok := deny.Evaluate(Request{Tool: "fs_apply_patch", Params: params}); !ok || d.Action != config.PolicyActionDeny {
+		t.Errorf("expected deny, got %+v, %v", d, ok)
+	}
+
+	first := mustEngine(t, config.PolicyRule{
+		Params: []config.PolicyParam{{Path: "edits[1].path", Glob: "/etc/hosts"}},
+		Action: config.PolicyActionAsk,
+	})
+	if _, ok := first.Evaluate(Request{Params: params}); !ok {
+		t.Error("expected indexed path to match")
+	}
+}
+
+func TestEngine_SessionChannelAndTime(t *testing.T) {
+	e := mustEngine(t, config.PolicyRule{
+		Name:     "office-hours",
+		Tools:    []string{"exec"},
+		Channels: []string{"telegram"},
+		Time:     config.PolicyTimeWindow{Days: []string{"mon", "tue", "wed", "thu", "fri"}, Hours: "09:00-18:00", Timezone: "UTC"},
+		Action:   config.PolicyActionAllow,
+	})
+	monday := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		give       string
+		giveTime   time.Time
+		want       bool
+		wantReason string
+	}{
+		{give: "telegram:1:2", giveTime: monday, want: true},
+		{give: "discord:1:2", giveTime: monday, wantReason: `channel "discord"`},
+		{give: "telegram:1:2", giveTime: monday.Add(9 * time.Hour), wantReason: "outside hours"},
+		{give: "telegram:1:2", giveTime: monday.AddDate(0, 0, -1), wantReason: "sun is not in days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give+" "+tt.giveTime.String(), func(t *testing.T) {
+			req := Request{Tool: "exec", SessionKey: tt.give, Time: tt.giveTime}
+			if _, ok := e.Evaluate(req); ok != tt.want {
+				t.Fatalf("expected %v, got %v", tt.want, ok)
+			}
+			traces := e.Explain(req)
+			if len(traces) != 1 {
+				t.Fatalf("expected 1 trace, got %d", len(traces))
+			}
+			if tt.wantReason != "" && !strings.Contains(traces[0].Reason, tt.wantReason) {
+				t.Errorf("expected reason containing %q, got %q", tt.wantReason, traces[0].Reason)
+			}
+		})
+	}
+}
+
+func TestWindow_WrapsMidnight(t *testing.T) {
+	w, err := compileWindow(config.PolicyTimeWindow{Hours: "22:00-06:00", Timezone: "UTC"})
+	if err != nil {
+		t.Fatalf("compile: %v", err)
+	}
+	for hour, want := range map[int]bool{23: true, 3: true, 6: false, 12: false} {
+		if ok, _ := w.match(time.Date(2026, 3, 2, hour, 0, 0, 0, time.UTC)); ok != want {
+			t.Errorf("hour %d: expected %v, got %v", hour, want, ok)
+		}
+	}
+}
+
+func TestNew_InvalidRules(t *testing.T) {
+	tests := []struct {
+		give config.PolicyRule
+		want string
+	}{
+		{give: config.PolicyRule{Action: "maybe"}, want: "action"},
+		{give: config.PolicyRule{Action: "ask", Params: []config.PolicyParam{{Path: "command", Regex: "("}}}, want: "invalid regex"},
+		{give: config.PolicyRule{Action: "ask", Params: []config.PolicyParam{{Path: "a..b"}}}, want: "empty segment"},
+		{give: config.PolicyRule{Action: "ask", Params: []config.PolicyParam{{Path: "a", Glob: "x", Regex: "y"}}}, want: "not both"},
+		{give: config.PolicyRule{Action: "ask", Time: config.PolicyTimeWindow{Hours: "9-5"}}, want: "hours"},
+		{give: config.PolicyRule{Action: "ask", Time: config.PolicyTimeWindow{Days: []string{"someday"}}}, want: "day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			_, err := New([]config.PolicyRule{tt.give})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestChannelOf(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "telegram:123:456", want: "telegram"},
+		{give: "cron:digest", want: "cron"},
+		{give: "default", want: "gateway"},
+		{give: "", want: "gateway"},
+	}
+
+	for _, tt := range tests {
+		if got := ChannelOf(tt.give); got != tt.want {
+			t.Errorf("ChannelOf(%q) = %q, want %q", tt.give, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package anthropic
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"iter"
 
@@ -41,10 +42,13 @@ func (p *AnthropicProvider) Generate(ctx context.Context, params provider.Genera
 	stream := p.client.Messages.NewStreaming(ctx, msgParams)
 
 	return func(yield func(provider.StreamEvent, error) bool) {
+		var usage provider.Usage
 		for stream.Next() {
 			evt := stream.Current()
 
 			switch evt.Type {
+			case "message_start":
+				usage.InputTokens = int(evt.Message.Usage.InputTokens)
 			case "content_block_delta":
 				switch evt.Delta.Type {
 				case "text_delta":
@@ -77,7 +81,8 @@ func (p *AnthropicProvider) Generate(ctx context.Context, params provider.Genera
 					}
 				}
 			case "message_delta":
-				// Handle stop reason if needed
+				// Output tokens are cumulative across message_delta events.
+				usage.OutputTokens = int(evt.Usage.OutputTokens)
 			}
 		}
 
@@ -86,7 +91,7 @@ func (p *AnthropicProvider) Generate(ctx context.Context, params provider.Genera
 			return
 		}
 
-		yield(provider.StreamEvent{Type: provider.StreamEventDone}, nil)
+		yield(provider.StreamEvent{Type: provider.StreamEventDone, Usage: &usage}, nil)
 	}, nil
 }
 
@@ -117,7 +122,7 @@ func (p *AnthropicProvider) convertParams(params provider.GenerateParams) (anthr
 	for _, m := range params.Messages {
 		switch m.Role {
 		case "user":
-			msgs = append(msgs, anthropic.NewUserMessage(anthropic.NewTextBlock(m.Content)))
+			msgs = append(msgs, anthropic.NewUserMessage(userBlocks(m)...))
 		case "assistant":
 			msgs = append(msgs, anthropic.NewAssistantMessage(anthropic.NewTextBlock(m.Content)))
 		case "system":
@@ -180,3 +185,37 @@ func (p *AnthropicProvider) convertParams(params provider.GenerateParams) (anthr
 
 	return req, nil
 }
+
+// imageTypes lists the image formats accepted as image blocks.
+var imageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// userBlocks converts a user message and its attachments into content
+// blocks. Images and PDFs are sent natively; other attachments fall back to
+// text.
+func userBlocks(m provider.Message) []anthropic.ContentBlockParamUnion {
+	if len(m.Parts) == 0 {
+		return []anthropic.ContentBlockParamUnion{anthropic.NewTextBlock(m.Content)}
+	}
+
+	var blocks []anthropic.ContentBlockParamUnion
+	for _, p := range m.Parts {
+		data := base64.StdEncoding.EncodeToString(p.Data)
+		switch {
+		case p.Type == provider.ContentPartImage && imageTypes[p.MimeType]:
+			blocks = append(blocks, anthropic.NewImageBlockBase64(p.MimeType, data))
+		case p.MimeType == "application/pdf":
+			blocks = append(blocks, anthropic.NewDocumentBlock(anthropic.Base64PDFSourceParam{Data: data}))
+		default:
+			blocks = append(blocks, anthropic.NewTextBlock(p.TextFallback()))
+		}
+	}
+	if m.Content != "" {
+		blocks = append(blocks, anthropic.NewTextBlock(m.Content))
+	}
+	return blocks
+}
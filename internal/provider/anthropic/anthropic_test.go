@@ -3,7 +3,10 @@ package anthropic
 import (
 	"context"
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/langoai/lango/internal/provider"
 )
 
 func TestNewProvider(t *testing.T) {
@@ -34,3 +37,30 @@ func TestAnthropicProvider_ListModels(t *testing.T) {
 		}
 	}
 }
+
+func TestUserBlocks(t *testing.T) {
+	blocks := userBlocks(provider.Message{
+		Role:    "user",
+		Content: "summarize",
+		Parts: []provider.ContentPart{
+			{Type: provider.ContentPartImage, MimeType: "image/png", Data: []byte("png")},
+			{Type: provider.ContentPartFile, MimeType: "application/pdf", Data: []byte("pdf")},
+			{Type: provider.ContentPartImage, MimeType: "image/tiff", Name: "scan.tiff", Data: []byte("tiff")},
+		},
+	})
+	if len(blocks) != 4 {
+		t.Fatalf("expected 4 blocks, got %d", len(blocks))
+	}
+	if blocks[0].OfImage == nil || blocks[0].OfImage.Source.OfBase64.Data != "cG5n" {
+		t.Errorf("expected base64 image block, got %+v", blocks[0])
+	}
+	if blocks[1].OfDocument == nil || blocks[1].OfDocument.Source.OfBase64 == nil {
+		t.Errorf("expected PDF document block, got %+v", blocks[1])
+	}
+	if blocks[2].OfText == nil || !strings.Contains(blocks[2].OfText.Text, "scan.tiff") {
+		t.Errorf("expected text fallback for unsupported image, got %+v", blocks[2])
+	}
+	if blocks[3].OfText == nil || blocks[3].OfText.Text != "summarize" {
+		t.Errorf("expected trailing text block, got %+v", blocks[3])
+	}
+}
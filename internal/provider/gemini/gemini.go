@@ -86,6 +86,9 @@ func (p *GeminiProvider) Generate(ctx context.Context, params provider.GenerateP
 		if m.Content != "" {
 			parts = append(parts, &genai.Part{Text: m.Content})
 		}
+		for _, cp := range m.Parts {
+			parts = append(parts, convertPart(cp))
+		}
 
 		// If assistant message has tool calls, add them as parts
 		if role == "model" && len(m.ToolCalls) > 0 {
@@ -158,12 +161,21 @@ func (p *GeminiProvider) Generate(ctx context.Context, params provider.GenerateP
 	streamIter := p.client.Models.GenerateContentStream(ctx, model, contents, conf)
 
 	return func(yield func(provider.StreamEvent, error) bool) {
+		var usage *provider.Usage
 		for resp, err := range streamIter {
 			if err != nil {
 				yield(provider.StreamEvent{Type: provider.StreamEventError, Error: err}, err)
 				return
 			}
 
+			// Each chunk reports the running totals; the last one wins.
+			if md := resp.UsageMetadata; md != nil {
+				usage = &provider.Usage{
+					InputTokens:  int(md.PromptTokenCount),
+					OutputTokens: int(md.CandidatesTokenCount),
+				}
+			}
+
 			// Handle response parts
 			for _, cand := range resp.Candidates {
 				if cand.Content != nil {
@@ -193,7 +205,7 @@ func (p *GeminiProvider) Generate(ctx context.Context, params provider.GenerateP
 				}
 			}
 		}
-		yield(provider.StreamEvent{Type: provider.StreamEventDone}, nil)
+		yield(provider.StreamEvent{Type: provider.StreamEventDone, Usage: usage}, nil)
 	}, nil
 }
 
@@ -227,3 +239,30 @@ func convertSchema(schemaMap map[string]interface{}) (*genai.Schema, error) {
 	}
 	return &s, nil
 }
+
+// inlineTypes lists the attachment MIME types Gemini accepts as inline data.
+var inlineTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/webp":      true,
+	"image/heic":      true,
+	"image/heif":      true,
+	"audio/wav":       true,
+	"audio/mp3":       true,
+	"audio/mpeg":      true,
+	"audio/aiff":      true,
+	"audio/aac":       true,
+	"audio/ogg":       true,
+	"audio/flac":      true,
+	"application/pdf": true,
+}
+
+// convertPart sends supported attachments as inline data; other attachments
+// fall back to text, since the API rejects unknown MIME types.
+func convertPart(cp provider.ContentPart) *genai.Part {
+	mimeType, _, _ := strings.Cut(cp.MimeType, ";")
+	if inlineTypes[strings.ToLower(strings.TrimSpace(mimeType))] {
+		return &genai.Part{InlineData: &genai.Blob{MIMEType: cp.MimeType, Data: cp.Data}}
+	}
+	return &genai.Part{Text: cp.TextFallback()}
+}
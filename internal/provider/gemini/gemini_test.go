@@ -0,0 +1,36 @@
+package gemini
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/langoai/lango/internal/provider"
+)
+
+func TestConvertPart(t *testing.T) {
+	tests := []struct {
+		give       provider.ContentPart
+		wantInline bool
+	}{
+		{give: provider.ContentPart{Type: provider.ContentPartImage, MimeType: "image/png", Data: []byte("png")}, wantInline: true},
+		{give: provider.ContentPart{Type: provider.ContentPartAudio, MimeType: "audio/ogg; codecs=opus", Data: []byte("ogg")}, wantInline: true},
+		{give: provider.ContentPart{Type: provider.ContentPartFile, MimeType: "application/pdf", Data: []byte("pdf")}, wantInline: true},
+		{give: provider.ContentPart{Type: provider.ContentPartImage, MimeType: "image/tiff", Name: "scan.tiff", Data: []byte("tiff")}},
+		{give: provider.ContentPart{Type: provider.ContentPartFile, MimeType: "application/zip", Name: "src.zip", Data: []byte("zip")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give.MimeType, func(t *testing.T) {
+			got := convertPart(tt.give)
+			if tt.wantInline {
+				if got.InlineData == nil || string(got.InlineData.Data) != string(tt.give.Data) {
+					t.Errorf("expected inline data, got %+v", got)
+				}
+				return
+			}
+			if got.InlineData != nil || !strings.Contains(got.Text, tt.give.Name) {
+				t.Errorf("expected text fallback naming %q, got %+v", tt.give.Name, got)
+			}
+		})
+	}
+}
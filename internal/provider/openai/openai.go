@@ -2,6 +2,7 @@ package openai
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
@@ -15,8 +16,9 @@ import (
 
 // OpenAIProvider implements the Provider interface for OpenAI-compatible APIs.
 type OpenAIProvider struct {
-	client *openai.Client
-	id     string
+	client       *openai.Client
+	id           string
+	includeUsage bool
 }
 
 // NewProvider creates a new OpenAIProvider.
@@ -31,6 +33,12 @@ func NewProvider(id, apiKey, baseURL string) *OpenAIProvider {
 	}
 }
 
+// SetIncludeUsage asks for token usage in the final stream chunk. It is off
+// by default, since some OpenAI-compatible servers reject stream_options.
+func (p *OpenAIProvider) SetIncludeUsage(include bool) {
+	p.includeUsage = include
+}
+
 // ID returns the provider ID.
 func (p *OpenAIProvider) ID() string {
 	return p.id
@@ -54,10 +62,11 @@ func (p *OpenAIProvider) Generate(ctx context.Context, params provider.GenerateP
 	return func(yield func(provider.StreamEvent, error) bool) {
 		defer stream.Close()
 
+		var usage *provider.Usage
 		for {
 			response, err := stream.Recv()
 			if errors.Is(err, io.EOF) {
-				yield(provider.StreamEvent{Type: provider.StreamEventDone}, nil)
+				yield(provider.StreamEvent{Type: provider.StreamEventDone, Usage: usage}, nil)
 				return
 			}
 			if err != nil {
@@ -65,6 +74,14 @@ func (p *OpenAIProvider) Generate(ctx context.Context, params provider.GenerateP
 				return
 			}
 
+			// With IncludeUsage the last chunk carries the usage and no choices.
+			if response.Usage != nil {
+				usage = &provider.Usage{
+					InputTokens:  response.Usage.PromptTokens,
+					OutputTokens: response.Usage.CompletionTokens,
+				}
+			}
+
 			if len(response.Choices) == 0 {
 				continue
 			}
@@ -124,6 +141,10 @@ func (p *OpenAIProvider) convertParams(params provider.GenerateParams) (openai.C
 			Role:    m.Role,
 			Content: m.Content,
 		}
+		if len(m.Parts) > 0 {
+			msg.Content = ""
+			msg.MultiContent = convertParts(m.Content, m.Parts)
+		}
 		if len(m.ToolCalls) > 0 {
 			tcs := make([]openai.ToolCall, len(m.ToolCalls))
 			for j, tc := range m.ToolCalls {
@@ -151,6 +172,9 @@ func (p *OpenAIProvider) convertParams(params provider.GenerateParams) (openai.C
 		Temperature: float32(params.Temperature),
 		Stream:      true,
 	}
+	if p.includeUsage {
+		req.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+	}
 
 	if len(params.Tools) > 0 {
 		tools := make([]openai.Tool, len(params.Tools))
@@ -173,3 +197,26 @@ func (p *OpenAIProvider) convertParams(params provider.GenerateParams) (openai.C
 
 	return req, nil
 }
+
+// convertParts builds multi-part content from message text and attachments.
+// Images are sent inline as data URLs; other attachments fall back to text.
+func convertParts(text string, parts []provider.ContentPart) []openai.ChatMessagePart {
+	var out []openai.ChatMessagePart
+	if text != "" {
+		out = append(out, openai.ChatMessagePart{Type: openai.ChatMessagePartTypeText, Text: text})
+	}
+	for _, p := range parts {
+		if p.Type == provider.ContentPartImage {
+			out = append(out, openai.ChatMessagePart{
+				Type: openai.ChatMessagePartTypeImageURL,
+				ImageURL: &openai.ChatMessageImageURL{
+					URL:    "data:" + p.MimeType + ";base64," + base64.StdEncoding.EncodeToString(p.Data),
+					Detail: openai.ImageURLDetailAuto,
+				},
+			})
+			continue
+		}
+		out = append(out, openai.ChatMessagePart{Type: openai.ChatMessagePartTypeText, Text: p.TextFallback()})
+	}
+	return out
+}
@@ -2,7 +2,10 @@ package openai
 
 import (
 	"context"
+	"strings"
 	"testing"
+
+	"github.com/langoai/lango/internal/provider"
 )
 
 func TestNewProvider(t *testing.T) {
@@ -21,3 +24,55 @@ func TestOpenAIProvider_ListModels(t *testing.T) {
 		t.Error("expected error when connecting to unavailable server")
 	}
 }
+
+func TestConvertParams_Parts(t *testing.T) {
+	p := NewProvider("openai", "test-key", "")
+	req, err := p.convertParams(provider.GenerateParams{Messages: []provider.Message{{
+		Role:    "user",
+		Content: "what is this?",
+		Parts: []provider.ContentPart{
+			{Type: provider.ContentPartImage, MimeType: "image/png", Data: []byte("png")},
+			{Type: provider.ContentPartAudio, MimeType: "audio/ogg", Name: "voice.ogg", Data: []byte("OggS")},
+		},
+	}}})
+	if err != nil {
+		t.Fatalf("convertParams: %v", err)
+	}
+
+	msg := req.Messages[0]
+	if msg.Content != "" {
+		t.Errorf("expected content to move into parts, got %q", msg.Content)
+	}
+	if len(msg.MultiContent) != 3 {
+		t.Fatalf("expected 3 parts, got %d", len(msg.MultiContent))
+	}
+	if msg.MultiContent[0].Text != "what is this?" {
+		t.Errorf("unexpected text part %+v", msg.MultiContent[0])
+	}
+	if img := msg.MultiContent[1].ImageURL; img == nil || img.URL != "data:image/png;base64,cG5n" {
+		t.Errorf("unexpected image part %+v", msg.MultiContent[1])
+	}
+	if !strings.Contains(msg.MultiContent[2].Text, "voice.ogg") {
+		t.Errorf("expected audio text fallback, got %+v", msg.MultiContent[2])
+	}
+}
+
+func TestConvertParams_IncludeUsage(t *testing.T) {
+	p := NewProvider("ollama", "", "http://localhost:11434/v1")
+	req, err := p.convertParams(provider.GenerateParams{Model: "llama3"})
+	if err != nil {
+		t.Fatalf("convertParams: %v", err)
+	}
+	if req.StreamOptions != nil {
+		t.Errorf("stream options sent by default: %+v", req.StreamOptions)
+	}
+
+	p.SetIncludeUsage(true)
+	req, err = p.convertParams(provider.GenerateParams{Model: "llama3"})
+	if err != nil {
+		t.Fatalf("convertParams: %v", err)
+	}
+	if req.StreamOptions == nil || !req.StreamOptions.IncludeUsage {
+		t.Errorf("want include_usage, got %+v", req.StreamOptions)
+	}
+}
@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"iter"
+	"strings"
+	"unicode/utf8"
 )
 
 // StreamEventType defines the type of event in a generation stream.
@@ -35,6 +38,18 @@ type StreamEvent struct {
 	Text     string
 	ToolCall *ToolCall
 	Error    error
+	Usage    *Usage // token usage of the call; set on the done event when known
+}
+
+// Usage is the token usage reported by a provider for one call.
+type Usage struct {
+	InputTokens  int
+	OutputTokens int
+}
+
+// Total returns the sum of input and output tokens.
+func (u Usage) Total() int {
+	return u.InputTokens + u.OutputTokens
 }
 
 // ToolCall represents a request for tool execution.
@@ -44,10 +59,82 @@ type ToolCall struct {
 	Arguments string // JSON string
 }
 
-// Message represents a chat message.
+// ContentPartType classifies binary message content.
+type ContentPartType string
+
+const (
+	ContentPartImage ContentPartType = "image"
+	ContentPartAudio ContentPartType = "audio"
+	ContentPartFile  ContentPartType = "file"
+)
+
+// Valid reports whether t is a known content part type.
+func (t ContentPartType) Valid() bool {
+	switch t {
+	case ContentPartImage, ContentPartAudio, ContentPartFile:
+		return true
+	}
+	return false
+}
+
+// Values returns all known content part types.
+func (t ContentPartType) Values() []ContentPartType {
+	return []ContentPartType{ContentPartImage, ContentPartAudio, ContentPartFile}
+}
+
+// PartTypeForMIME returns the content part type for a MIME type.
+func PartTypeForMIME(mimeType string) ContentPartType {
+	switch {
+	case strings.HasPrefix(mimeType, "image/"):
+		return ContentPartImage
+	case strings.HasPrefix(mimeType, "audio/"):
+		return ContentPartAudio
+	default:
+		return ContentPartFile
+	}
+}
+
+// ContentPart is an image, audio clip or file sent along with a message.
+type ContentPart struct {
+	Type     ContentPartType
+	MimeType string
+	Name     string
+	Data     []byte
+}
+
+// IsText reports whether the part holds plain text, such as a Markdown,
+// CSV or JSON document, that can be inlined into the prompt.
+func (p ContentPart) IsText() bool {
+	if strings.HasPrefix(p.MimeType, "text/") {
+		return true
+	}
+	switch p.MimeType {
+	case "application/json", "application/xml", "application/yaml", "application/x-yaml", "application/toml":
+		return true
+	}
+	return false
+}
+
+// TextFallback renders the part as text for models that cannot take it
+// natively. Text documents are inlined; anything else becomes a short note
+// so the model knows something was attached.
+func (p ContentPart) TextFallback() string {
+	name := p.Name
+	if name == "" {
+		name = string(p.Type)
+	}
+	if p.IsText() && utf8.Valid(p.Data) {
+		return fmt.Sprintf("[Attached file %s]\n```\n%s\n```", name, p.Data)
+	}
+	return fmt.Sprintf("[Attached %s %s (%s) cannot be read by this model]", p.Type, name, p.MimeType)
+}
+
+// Message represents a chat message. Parts holds binary content such as
+// images that accompanies the text in Content.
 type Message struct {
 	Role      string
 	Content   string
+	Parts     []ContentPart
 	ToolCalls []ToolCall
 	Metadata  map[string]interface{}
 }
@@ -0,0 +1,49 @@
+package provider
+
+import "testing"
+
+func TestPartTypeForMIME(t *testing.T) {
+	tests := []struct {
+		give string
+		want ContentPartType
+	}{
+		{give: "image/png", want: ContentPartImage},
+		{give: "audio/ogg", want: ContentPartAudio},
+		{give: "application/pdf", want: ContentPartFile},
+		{give: "video/mp4", want: ContentPartFile},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			if got := PartTypeForMIME(tt.give); got != tt.want {
+				t.Errorf("PartTypeForMIME(%q) = %q, want %q", tt.give, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContentPart_TextFallback(t *testing.T) {
+	tests := []struct {
+		give ContentPart
+		want string
+	}{
+		{
+			give: ContentPart{Type: ContentPartFile, MimeType: "text/csv", Name: "a.csv", Data: []byte("a,b")},
+			want: "[Attached file a.csv]\n```\na,b\n```",
+		},
+		{
+			give: ContentPart{Type: ContentPartAudio, MimeType: "audio/ogg", Data: []byte("OggS")},
+			want: "[Attached audio audio (audio/ogg) cannot be read by this model]",
+		},
+		{
+			give: ContentPart{Type: ContentPartFile, MimeType: "text/plain", Name: "bin.txt", Data: []byte{0xff, 0xfe}},
+			want: "[Attached file bin.txt (text/plain) cannot be read by this model]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give.MimeType, func(t *testing.T) {
+			if got := tt.give.TextFallback(); got != tt.want {
+				t.Errorf("TextFallback() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
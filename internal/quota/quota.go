@@ -0,0 +1,243 @@
+// Package quota enforces per-user and per-channel usage limits on agent
+// requests and records the token usage and cost of each request.
+package quota
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/provider"
+)
+
+// Limits caps the usage of a quota subject. Zero means unlimited.
+type Limits struct {
+	RequestsPerMinute int
+	TokensPerDay      int
+	CostPerDay        float64 // USD
+}
+
+// Subject identifies who a request is charged to.
+type Subject struct {
+	Channel string // e.g. "telegram", "gateway"
+	UserID  string // platform user ID
+}
+
+// Identity returns the user identity of the subject, "channel:user".
+func (s Subject) Identity() string {
+	return s.Channel + ":" + s.UserID
+}
+
+// Price is the price of a model in USD per million tokens.
+type Price struct {
+	Input  float64
+	Output float64
+}
+
+// Cost returns the cost of usage at the price.
+func (p Price) Cost(u provider.Usage) float64 {
+	return (float64(u.InputTokens)*p.Input + float64(u.OutputTokens)*p.Output) / 1e6
+}
+
+// Usage sums the usage of a set of requests.
+type Usage struct {
+	Requests     int
+	InputTokens  int
+	OutputTokens int
+	Cost         float64
+}
+
+// Tokens returns the sum of input and output tokens.
+func (u Usage) Tokens() int {
+	return u.InputTokens + u.OutputTokens
+}
+
+// ExceededError is returned by Manager.Admit when a request would exceed a
+// limit. Message is meant to be shown to the user.
+type ExceededError struct {
+	Subject string    // user identity or channel that hit the limit
+	Channel bool      // the limit is the channel's rather than the user's
+	Limit   string    // the limit reached, e.g. "20 requests per minute"
+	ResetAt time.Time // when usage falls below the limit again
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded for %s: %s", e.Subject, e.Limit)
+}
+
+// Message returns a friendly over-quota reply for the user.
+func (e *ExceededError) Message(now time.Time) string {
+	who := "You have"
+	if e.Channel {
+		who = "This channel has"
+	}
+	wait := e.ResetAt.Sub(now).Round(time.Minute)
+	if wait < time.Minute {
+		return fmt.Sprintf("%s reached the limit of %s. Please try again in a minute.", who, e.Limit)
+	}
+	return fmt.Sprintf("%s reached the limit of %s. It resets at %s UTC, in %s.",
+		who, e.Limit, e.ResetAt.UTC().Format("15:04"), strings.TrimSuffix(wait.String(), "0s"))
+}
+
+// Config holds the limits and model prices of a Manager.
+type Config struct {
+	User      Limits            // per user identity
+	Channel   Limits            // per channel, all users combined
+	Overrides map[string]Limits // by user identity or channel name
+	Pricing   map[string]Price  // by model
+}
+
+// ConfigFrom converts the quota section of the configuration.
+func ConfigFrom(cfg config.QuotaConfig) Config {
+	limits := func(l config.QuotaLimits) Limits {
+		return Limits{
+			RequestsPerMinute: l.RequestsPerMinute,
+			TokensPerDay:      l.TokensPerDay,
+			CostPerDay:        l.CostPerDay,
+		}
+	}
+	c := Config{
+		User:      limits(cfg.User),
+		Channel:   limits(cfg.Channel),
+		Overrides: make(map[string]Limits, len(cfg.Overrides)),
+		Pricing:   make(map[string]Price, len(cfg.Pricing)),
+	}
+	for _, o := range cfg.Overrides {
+		c.Overrides[o.Subject] = limits(o.Limits)
+	}
+	for _, p := range cfg.Pricing {
+		c.Pricing[p.Model] = Price{Input: p.Input, Output: p.Output}
+	}
+	return c
+}
+
+// Manager admits agent requests within the limits and records their usage.
+// Token and cost limits are checked before a request runs, so the request
+// that crosses a limit is allowed to finish.
+type Manager struct {
+	store Store
+	cfg   Config
+	now   func() time.Time
+
+	// admitMu makes the limit checks and the request record of Admit one
+	// step, so concurrent requests cannot all pass a check and overshoot.
+	admitMu sync.Mutex
+}
+
+// NewManager creates a Manager that keeps usage in store.
+func NewManager(store Store, cfg Config) *Manager {
+	return &Manager{store: store, cfg: cfg, now: time.Now}
+}
+
+// Request is an admitted agent request whose usage is being recorded.
+type Request struct {
+	id      uuid.UUID
+	manager *Manager
+}
+
+// Admit checks the limits of the subject and its channel and starts
+// recording a request. It returns an *ExceededError if a limit is reached.
+func (m *Manager) Admit(ctx context.Context, subject Subject, sessionKey string) (*Request, error) {
+	m.admitMu.Lock()
+	defer m.admitMu.Unlock()
+
+	now := m.now().UTC()
+
+	user := m.cfg.User
+	if l, ok := m.cfg.Overrides[subject.Identity()]; ok {
+		user = l
+	}
+	if err := m.check(ctx, Filter{Channel: subject.Channel, UserID: subject.UserID}, user, subject.Identity(), false, now); err != nil {
+		return nil, err
+	}
+
+	channel := m.cfg.Channel
+	if l, ok := m.cfg.Overrides[subject.Channel]; ok {
+		channel = l
+	}
+	if err := m.check(ctx, Filter{Channel: subject.Channel}, channel, subject.Channel, true, now); err != nil {
+		return nil, err
+	}
+
+	id, err := m.store.Create(ctx, Record{
+		Channel:    subject.Channel,
+		UserID:     subject.UserID,
+		SessionKey: sessionKey,
+		CreatedAt:  now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record request: %w", err)
+	}
+	return &Request{id: id, manager: m}, nil
+}
+
+// Today returns the usage of the subject since midnight UTC.
+func (m *Manager) Today(ctx context.Context, subject Subject) (Usage, error) {
+	return m.store.Sum(ctx, Filter{
+		Channel: subject.Channel,
+		UserID:  subject.UserID,
+		Since:   m.now().UTC().Truncate(24 * time.Hour),
+	})
+}
+
+// check returns an *ExceededError if the usage matching f reached limits.
+func (m *Manager) check(ctx context.Context, f Filter, limits Limits, subject string, channel bool, now time.Time) error {
+	exceeded := func(limit string, resetAt time.Time) error {
+		return &ExceededError{Subject: subject, Channel: channel, Limit: limit, ResetAt: resetAt}
+	}
+
+	if limits.RequestsPerMinute > 0 {
+		f.Since = now.Add(-time.Minute)
+		u, err := m.store.Sum(ctx, f)
+		if err != nil {
+			return fmt.Errorf("sum usage: %w", err)
+		}
+		if u.Requests >= limits.RequestsPerMinute {
+			limit := fmt.Sprintf("%d requests per minute", limits.RequestsPerMinute)
+			if limits.RequestsPerMinute == 1 {
+				limit = "1 request per minute"
+			}
+			return exceeded(limit, now.Add(time.Minute))
+		}
+	}
+
+	if limits.TokensPerDay > 0 || limits.CostPerDay > 0 {
+		day := now.Truncate(24 * time.Hour)
+		f.Since = day
+		u, err := m.store.Sum(ctx, f)
+		if err != nil {
+			return fmt.Errorf("sum usage: %w", err)
+		}
+		reset := day.Add(24 * time.Hour)
+		if limits.TokensPerDay > 0 && u.Tokens() >= limits.TokensPerDay {
+			return exceeded(fmt.Sprintf("%d tokens per day", limits.TokensPerDay), reset)
+		}
+		if limits.CostPerDay > 0 && u.Cost >= limits.CostPerDay {
+			return exceeded(fmt.Sprintf("$%.2f per day", limits.CostPerDay), reset)
+		}
+	}
+	return nil
+}
+
+// Record adds the usage of one model call to the request. A request that
+// calls tools makes several model calls.
+func (r *Request) Record(ctx context.Context, model string, usage provider.Usage) error {
+	cost := r.manager.cfg.Pricing[model].Cost(usage)
+	if err := r.manager.store.AddUsage(ctx, r.id, model, usage, cost); err != nil {
+		return fmt.Errorf("record usage: %w", err)
+	}
+	return nil
+}
+
+// IsExceeded reports whether err is an *ExceededError and returns it.
+func IsExceeded(err error) (*ExceededError, bool) {
+	var e *ExceededError
+	ok := errors.As(err, &e)
+	return e, ok
+}
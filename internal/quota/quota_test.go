@@ -0,0 +1,194 @@
+package quota
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/langoai/lango/internal/ent/enttest"
+	"github.com/langoai/lango/internal/provider"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestManager(t *testing.T, cfg Config) (*Manager, *EntStore) {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	store := NewEntStore(client)
+	return NewManager(store, cfg), store
+}
+
+func TestManager_RequestsPerMinute(t *testing.T) {
+	m, _ := newTestManager(t, Config{User: Limits{RequestsPerMinute: 2}})
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+
+	alice := Subject{Channel: "telegram", UserID: "1"}
+	for i := 0; i < 2; i++ {
+		if _, err := m.Admit(ctx, alice, "telegram:1:1"); err != nil {
+			t.Fatalf("admit %d: %v", i, err)
+		}
+	}
+
+	_, err := m.Admit(ctx, alice, "telegram:1:1")
+	e, ok := IsExceeded(err)
+	if !ok {
+		t.Fatalf("expected ExceededError, got %v", err)
+	}
+	if e.Channel || e.Subject != "telegram:1" {
+		t.Errorf("expected user limit of telegram:1, got %+v", e)
+	}
+	if msg := e.Message(now); !strings.Contains(msg, "2 requests per minute") {
+		t.Errorf("unexpected message %q", msg)
+	}
+
+	// Other users have their own limit.
+	if _, err := m.Admit(ctx, Subject{Channel: "telegram", UserID: "2"}, "telegram:2:2"); err != nil {
+		t.Errorf("admit other user: %v", err)
+	}
+
+	// The window slides.
+	now = now.Add(time.Minute + time.Second)
+	if _, err := m.Admit(ctx, alice, "telegram:1:1"); err != nil {
+		t.Errorf("admit after a minute: %v", err)
+	}
+}
+
+func TestManager_AdmitConcurrent(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "quota.db")+"?_fk=1&_busy_timeout=5000")
+	t.Cleanup(func() { client.Close() })
+	m := NewManager(NewEntStore(client), Config{User: Limits{RequestsPerMinute: 3}})
+
+	var admitted atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := m.Admit(context.Background(), Subject{Channel: "gateway", UserID: "u"}, "s"); err == nil {
+				admitted.Add(1)
+			} else if _, ok := IsExceeded(err); !ok {
+				t.Errorf("admit: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := admitted.Load(); got != 3 {
+		t.Errorf("admitted %d concurrent requests, want 3", got)
+	}
+}
+
+func TestManager_TokensAndCostPerDay(t *testing.T) {
+	tests := []struct {
+		give      Limits
+		wantLimit string
+	}{
+		{give: Limits{TokensPerDay: 1000}, wantLimit: "1000 tokens per day"},
+		{give: Limits{CostPerDay: 0.01}, wantLimit: "$0.01 per day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantLimit, func(t *testing.T) {
+			m, _ := newTestManager(t, Config{
+				Channel: tt.give,
+				Pricing: map[string]Price{"gpt-4o": {Input: 2.5, Output: 10}},
+			})
+			ctx := context.Background()
+			now := time.Date(2026, 3, 1, 22, 30, 0, 0, time.UTC)
+			m.now = func() time.Time { return now }
+
+			req, err := m.Admit(ctx, Subject{Channel: "discord", UserID: "1"}, "")
+			if err != nil {
+				t.Fatalf("admit: %v", err)
+			}
+			// 1000 tokens costing $0.0025 + $0.0080.
+			if err := req.Record(ctx, "gpt-4o", provider.Usage{InputTokens: 1000, OutputTokens: 800}); err != nil {
+				t.Fatalf("record: %v", err)
+			}
+
+			// The limit is per channel, so another user is refused too.
+			_, err = m.Admit(ctx, Subject{Channel: "discord", UserID: "2"}, "")
+			e, ok := IsExceeded(err)
+			if !ok {
+				t.Fatalf("expected ExceededError, got %v", err)
+			}
+			if !e.Channel || e.Limit != tt.wantLimit {
+				t.Errorf("expected channel limit %q, got %+v", tt.wantLimit, e)
+			}
+			if want := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC); !e.ResetAt.Equal(want) {
+				t.Errorf("expected reset at %v, got %v", want, e.ResetAt)
+			}
+			if msg := e.Message(now); !strings.Contains(msg, "in 1h30m") {
+				t.Errorf("unexpected message %q", msg)
+			}
+
+			// Counters start over the next day.
+			now = now.Add(2 * time.Hour)
+			if _, err := m.Admit(ctx, Subject{Channel: "discord", UserID: "2"}, ""); err != nil {
+				t.Errorf("admit next day: %v", err)
+			}
+		})
+	}
+}
+
+func TestManager_Overrides(t *testing.T) {
+	m, _ := newTestManager(t, Config{
+		User:      Limits{RequestsPerMinute: 1},
+		Overrides: map[string]Limits{"slack:ADMIN": {}},
+	})
+	ctx := context.Background()
+
+	admin := Subject{Channel: "slack", UserID: "ADMIN"}
+	for i := 0; i < 3; i++ {
+		if _, err := m.Admit(ctx, admin, ""); err != nil {
+			t.Fatalf("admit %d: %v", i, err)
+		}
+	}
+}
+
+func TestEntStore_Report(t *testing.T) {
+	_, store := newTestManager(t, Config{})
+	ctx := context.Background()
+	day := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	records := []Record{
+		{Channel: "telegram", UserID: "1", InputTokens: 100, OutputTokens: 10, Cost: 0.5, CreatedAt: day.Add(time.Hour)},
+		{Channel: "telegram", UserID: "1", InputTokens: 200, OutputTokens: 20, Cost: 0.5, CreatedAt: day.Add(2 * time.Hour)},
+		{Channel: "gateway", UserID: "default", InputTokens: 50, OutputTokens: 5, Cost: 2, CreatedAt: day.Add(3 * time.Hour)},
+		{Channel: "telegram", UserID: "1", InputTokens: 999, CreatedAt: day.Add(-time.Hour)},
+	}
+	for _, rec := range records {
+		if _, err := store.Create(ctx, rec); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	rows, err := store.Report(ctx, Filter{Since: day})
+	if err != nil {
+		t.Fatalf("report: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d: %+v", len(rows), rows)
+	}
+	if rows[0].Channel != "gateway" || rows[0].Cost != 2 {
+		t.Errorf("expected gateway first, got %+v", rows[0])
+	}
+	want := Usage{Requests: 2, InputTokens: 300, OutputTokens: 30, Cost: 1}
+	if rows[1].Usage != want {
+		t.Errorf("expected %+v, got %+v", want, rows[1].Usage)
+	}
+
+	total, err := store.Sum(ctx, Filter{Channel: "telegram"})
+	if err != nil {
+		t.Fatalf("sum: %v", err)
+	}
+	if total.Requests != 3 || total.InputTokens != 1299 {
+		t.Errorf("unexpected total %+v", total)
+	}
+}
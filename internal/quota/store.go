@@ -0,0 +1,175 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/predicate"
+	"github.com/langoai/lango/internal/ent/usagerecord"
+	"github.com/langoai/lango/internal/provider"
+)
+
+// Record is the usage of one agent request.
+type Record struct {
+	Channel      string
+	UserID       string
+	SessionKey   string
+	Model        string
+	InputTokens  int
+	OutputTokens int
+	Cost         float64
+	CreatedAt    time.Time
+}
+
+// Filter selects usage records. Empty fields match everything.
+type Filter struct {
+	Channel string
+	UserID  string
+	Since   time.Time
+}
+
+// ReportRow is the usage of one user identity.
+type ReportRow struct {
+	Channel string
+	UserID  string
+	Usage
+}
+
+// Store defines the persistence interface for usage records.
+type Store interface {
+	Create(ctx context.Context, rec Record) (uuid.UUID, error)
+	AddUsage(ctx context.Context, id uuid.UUID, model string, usage provider.Usage, cost float64) error
+	Sum(ctx context.Context, f Filter) (Usage, error)
+	Report(ctx context.Context, f Filter) ([]ReportRow, error)
+}
+
+// EntStore implements Store using the Ent ORM client.
+type EntStore struct {
+	client *ent.Client
+}
+
+// NewEntStore creates a new EntStore backed by the given Ent client.
+func NewEntStore(client *ent.Client) *EntStore {
+	return &EntStore{client: client}
+}
+
+// Create persists a new usage record and returns its ID.
+func (s *EntStore) Create(ctx context.Context, rec Record) (uuid.UUID, error) {
+	builder := s.client.UsageRecord.Create().
+		SetChannel(rec.Channel).
+		SetUserID(rec.UserID).
+		SetSessionKey(rec.SessionKey).
+		SetModel(rec.Model).
+		SetInputTokens(rec.InputTokens).
+		SetOutputTokens(rec.OutputTokens).
+		SetCost(rec.Cost)
+
+	// Times are kept in UTC: SQLite compares them as strings.
+	createdAt := rec.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	builder.SetCreatedAt(createdAt.UTC())
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("create usage record: %w", err)
+	}
+	return created.ID, nil
+}
+
+// AddUsage adds the usage of a model call to a record.
+func (s *EntStore) AddUsage(ctx context.Context, id uuid.UUID, model string, usage provider.Usage, cost float64) error {
+	err := s.client.UsageRecord.UpdateOneID(id).
+		SetModel(model).
+		AddInputTokens(usage.InputTokens).
+		AddOutputTokens(usage.OutputTokens).
+		AddCost(cost).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update usage record %s: %w", id, err)
+	}
+	return nil
+}
+
+// Sum returns the total usage of the records matching f.
+func (s *EntStore) Sum(ctx context.Context, f Filter) (Usage, error) {
+	rows, err := s.Report(ctx, f)
+	if err != nil {
+		return Usage{}, err
+	}
+	var total Usage
+	for _, row := range rows {
+		total.Requests += row.Requests
+		total.InputTokens += row.InputTokens
+		total.OutputTokens += row.OutputTokens
+		total.Cost += row.Cost
+	}
+	return total, nil
+}
+
+// Report returns the usage of the records matching f per user identity,
+// highest cost first.
+func (s *EntStore) Report(ctx context.Context, f Filter) ([]ReportRow, error) {
+	var preds []predicate.UsageRecord
+	if f.Channel != "" {
+		preds = append(preds, usagerecord.Channel(f.Channel))
+	}
+	if f.UserID != "" {
+		preds = append(preds, usagerecord.UserID(f.UserID))
+	}
+	if !f.Since.IsZero() {
+		preds = append(preds, usagerecord.CreatedAtGTE(f.Since.UTC()))
+	}
+
+	var groups []struct {
+		Channel      string  `json:"channel"`
+		UserID       string  `json:"user_id"`
+		Requests     int     `json:"requests"`
+		InputTokens  int     `json:"input_tokens"`
+		OutputTokens int     `json:"output_tokens"`
+		Cost         float64 `json:"cost"`
+	}
+	err := s.client.UsageRecord.Query().
+		Where(preds...).
+		GroupBy(usagerecord.FieldChannel, usagerecord.FieldUserID).
+		Aggregate(
+			ent.As(ent.Count(), "requests"),
+			ent.As(ent.Sum(usagerecord.FieldInputTokens), "input_tokens"),
+			ent.As(ent.Sum(usagerecord.FieldOutputTokens), "output_tokens"),
+			ent.As(ent.Sum(usagerecord.FieldCost), "cost"),
+		).
+		Scan(ctx, &groups)
+	if err != nil {
+		return nil, fmt.Errorf("query usage: %w", err)
+	}
+
+	rows := make([]ReportRow, 0, len(groups))
+	for _, g := range groups {
+		rows = append(rows, ReportRow{
+			Channel: g.Channel,
+			UserID:  g.UserID,
+			Usage: Usage{
+				Requests:     g.Requests,
+				InputTokens:  g.InputTokens,
+				OutputTokens: g.OutputTokens,
+				Cost:         g.Cost,
+			},
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Cost != rows[j].Cost {
+			return rows[i].Cost > rows[j].Cost
+		}
+		if rows[i].Tokens() != rows[j].Tokens() {
+			return rows[i].Tokens() > rows[j].Tokens()
+		}
+		return rows[i].Channel+":"+rows[i].UserID < rows[j].Channel+":"+rows[j].UserID
+	})
+	return rows, nil
+}
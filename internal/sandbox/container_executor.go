@@ -20,7 +20,8 @@ type ContainerExecutor struct {
 }
 
 // NewContainerExecutor creates a ContainerExecutor by probing runtimes in order.
-// Priority: docker (if requested or auto) > gvisor (if requested or auto) > native.
+// Priority: docker (if requested or auto) > gvisor (if requested or auto) >
+// linux (if requested or auto) > native.
 func NewContainerExecutor(cfg Config, containerCfg config.ContainerSandboxConfig) (*ContainerExecutor, error) {
 	ctx := context.Background()
 	runtimeName := containerCfg.Runtime
@@ -62,6 +63,18 @@ func NewContainerExecutor(cfg Config, containerCfg config.ContainerSandboxConfig
 		}
 	}
 
+	// Try the Linux namespace sandbox.
+	if runtimeName == "linux" || runtimeName == "auto" {
+		lr := NewLinuxRuntime(cfg, Policy{})
+		if lr.IsAvailable(ctx) {
+			exec.runtime = lr
+			return exec, nil
+		}
+		if runtimeName == "linux" {
+			return nil, fmt.Errorf("linux runtime requested but unavailable: %w", ProbeLinux())
+		}
+	}
+
 	// Fallback to native (subprocess).
 	exec.runtime = NewNativeRuntime(cfg)
 	return exec, nil
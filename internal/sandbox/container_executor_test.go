@@ -49,8 +49,8 @@ func TestContainerExecutor_FallbackToNative(t *testing.T) {
 
 	exec, err := NewContainerExecutor(cfg, containerCfg)
 	require.NoError(t, err)
-	// On CI/local without Docker, should fall back to native.
-	assert.Contains(t, []string{"docker", "native"}, exec.RuntimeName())
+	// On CI/local without Docker, should fall back to the Linux sandbox or native.
+	assert.Contains(t, []string{"docker", "linux", "native"}, exec.RuntimeName())
 }
 
 func TestContainerExecutor_RuntimeName(t *testing.T) {
@@ -0,0 +1,352 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// initFailureCode is the exit code of an init process that could not set
+// up the sandbox.
+const initFailureCode = 125
+
+// RunInit is the entry point of the sandbox init process. It runs as PID 1
+// of the new namespaces: it makes the filesystem read-only except for the
+// writable paths, drops its capabilities, applies landlock rules and the
+// seccomp filter, then runs the command and exits with its status.
+func RunInit() {
+	// Landlock, seccomp, no_new_privs and capabilities are per thread; the
+	// command is started from this thread and inherits them.
+	runtime.LockOSThread()
+
+	var spec initSpec
+	if err := json.Unmarshal([]byte(os.Getenv(specEnv)), &spec); err != nil {
+		initFail(fmt.Errorf("decode spec: %w", err))
+	}
+	_ = os.Unsetenv(specEnv)
+
+	privateTmp, err := setupMounts(spec.WritablePaths, spec.Path)
+	if err != nil {
+		initFail(err)
+	}
+	if err := os.Chdir(spec.Dir); err != nil {
+		initFail(fmt.Errorf("change to working directory: %w", err))
+	}
+	if err := confine(spec.WritablePaths, privateTmp); err != nil {
+		initFail(err)
+	}
+	if spec.Probe {
+		os.Exit(0)
+	}
+
+	cmd := &exec.Cmd{
+		Path:   spec.Path,
+		Args:   spec.Args,
+		Env:    os.Environ(),
+		Stdin:  os.Stdin,
+		Stdout: os.Stdout,
+		Stderr: os.Stderr,
+	}
+	if err := cmd.Start(); err != nil {
+		initFail(fmt.Errorf("start %s: %w", spec.Path, err))
+	}
+
+	// Signals from outside the namespace reach PID 1 only through handlers.
+	sigs := make(chan os.Signal, 8)
+	signal.Notify(sigs, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP,
+		syscall.SIGQUIT, syscall.SIGUSR1, syscall.SIGUSR2)
+	go func() {
+		for sig := range sigs {
+			_ = cmd.Process.Signal(sig)
+		}
+	}()
+
+	_ = cmd.Wait()
+	if ws, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		os.Exit(128 + int(ws.Signal()))
+	}
+	os.Exit(cmd.ProcessState.ExitCode())
+}
+
+func initFail(err error) {
+	fmt.Fprintf(os.Stderr, "lango sandbox: %v\n", err)
+	os.Exit(initFailureCode)
+}
+
+// setupMounts remounts every mount read-only except the writable paths,
+// mounts a fresh /proc for the new PID namespace and a private tmpfs on
+// /tmp unless a writable path lies in /tmp. An executable in /tmp stays
+// visible, read-only. It reports whether the private /tmp was mounted.
+func setupMounts(writable []string, executable string) (bool, error) {
+	if err := unix.Mount("", "/", "", unix.MS_REC|unix.MS_PRIVATE, ""); err != nil {
+		return false, fmt.Errorf("make mounts private: %w", err)
+	}
+	for _, p := range writable {
+		if err := unix.Mount(p, p, "", unix.MS_BIND|unix.MS_REC, ""); err != nil {
+			return false, fmt.Errorf("bind writable path %s: %w", p, err)
+		}
+	}
+
+	mounts, err := readMountInfo()
+	if err != nil {
+		return false, err
+	}
+	for _, m := range mounts {
+		if under(m.mountPoint, "/proc") || underAny(m.mountPoint, writable) {
+			continue
+		}
+		if err := remountReadOnly(m.mountPoint); err != nil {
+			// Kernel pseudo filesystems may refuse a remount; they are
+			// guarded by permissions and the seccomp filter instead.
+			if m.mountPoint != "/" && pseudoFS(m.fsType) {
+				continue
+			}
+			return false, fmt.Errorf("remount %s read-only: %w", m.mountPoint, err)
+		}
+	}
+
+	// A fresh /proc shows only the sandbox processes; where the host
+	// forbids it the inherited /proc stays.
+	_ = unix.Mount("proc", "/proc", "proc", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, "")
+
+	if _, err := os.Stat("/dev/shm"); err == nil {
+		_ = unix.Mount("tmpfs", "/dev/shm", "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=1777")
+	}
+
+	if _, err := os.Stat("/tmp"); err != nil {
+		return false, nil
+	}
+	for _, p := range writable {
+		if under(p, "/tmp") {
+			return false, nil
+		}
+	}
+
+	var exeFD int = -1
+	if executable != "" && under(executable, "/tmp") {
+		if exeFD, err = unix.Open(executable, unix.O_PATH|unix.O_CLOEXEC, 0); err != nil {
+			return false, fmt.Errorf("open %s: %w", executable, err)
+		}
+		defer unix.Close(exeFD)
+	}
+	if err := unix.Mount("tmpfs", "/tmp", "tmpfs", unix.MS_NOSUID|unix.MS_NODEV, "mode=1777"); err != nil {
+		return false, fmt.Errorf("mount private /tmp: %w", err)
+	}
+	if exeFD >= 0 {
+		if err := bindFile(exeFD, executable); err != nil {
+			return false, fmt.Errorf("keep %s visible: %w", executable, err)
+		}
+	}
+	return true, nil
+}
+
+// bindFile bind-mounts the file open as fd read-only onto path, creating
+// path first.
+func bindFile(fd int, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o755)
+	if err != nil {
+		return err
+	}
+	f.Close()
+	if err := unix.Mount(fmt.Sprintf("/proc/self/fd/%d", fd), path, "", unix.MS_BIND, ""); err != nil {
+		return err
+	}
+	return remountReadOnly(path)
+}
+
+// remountReadOnly makes the mount at path read-only, keeping the flags a
+// user namespace may not clear.
+func remountReadOnly(path string) error {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return err
+	}
+	flags := uintptr(unix.MS_BIND | unix.MS_REMOUNT | unix.MS_RDONLY)
+	for stFlag, msFlag := range map[int64]uintptr{
+		unix.ST_NOSUID:     unix.MS_NOSUID,
+		unix.ST_NODEV:      unix.MS_NODEV,
+		unix.ST_NOEXEC:     unix.MS_NOEXEC,
+		unix.ST_NOATIME:    unix.MS_NOATIME,
+		unix.ST_NODIRATIME: unix.MS_NODIRATIME,
+		unix.ST_RELATIME:   unix.MS_RELATIME,
+	} {
+		if int64(st.Flags)&stFlag != 0 {
+			flags |= msFlag
+		}
+	}
+	return unix.Mount("", path, "", flags, "")
+}
+
+func pseudoFS(fsType string) bool {
+	switch fsType {
+	case "proc", "sysfs", "cgroup", "cgroup2", "devpts", "mqueue", "debugfs",
+		"tracefs", "securityfs", "pstore", "bpf", "configfs", "fusectl",
+		"hugetlbfs", "binfmt_misc", "efivarfs", "autofs", "nsfs":
+		return true
+	}
+	return false
+}
+
+// under reports whether path is dir or inside it.
+func under(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, strings.TrimSuffix(dir, "/")+"/")
+}
+
+func underAny(path string, dirs []string) bool {
+	for _, d := range dirs {
+		if under(path, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// confine drops capabilities, sets no_new_privs and applies landlock and
+// seccomp to the current thread.
+func confine(writable []string, privateTmp bool) error {
+	// Emptying the bounding set matters when the user is root, which
+	// regains every bounding capability on exec. Without CAP_SETPCAP (the
+	// usual, non-root case) it cannot be changed, and clearing the ambient
+	// set already leaves the command without capabilities. CAP_SETPCAP
+	// itself goes last.
+	for c := 0; c <= unix.CAP_LAST_CAP; c++ {
+		if c != unix.CAP_SETPCAP {
+			_ = unix.Prctl(unix.PR_CAPBSET_DROP, uintptr(c), 0, 0, 0)
+		}
+	}
+	_ = unix.Prctl(unix.PR_CAPBSET_DROP, unix.CAP_SETPCAP, 0, 0, 0)
+	if err := unix.Prctl(unix.PR_CAP_AMBIENT, unix.PR_CAP_AMBIENT_CLEAR_ALL, 0, 0, 0); err != nil {
+		return fmt.Errorf("clear ambient capabilities: %w", err)
+	}
+	// Root also regains its inheritable capabilities on exec.
+	hdr := unix.CapUserHeader{Version: unix.LINUX_CAPABILITY_VERSION_3}
+	var data [2]unix.CapUserData
+	if err := unix.Capget(&hdr, &data[0]); err != nil {
+		return fmt.Errorf("read capabilities: %w", err)
+	}
+	data[0].Inheritable, data[1].Inheritable = 0, 0
+	if err := unix.Capset(&hdr, &data[0]); err != nil {
+		return fmt.Errorf("clear inheritable capabilities: %w", err)
+	}
+	if err := unix.Prctl(unix.PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0); err != nil {
+		return fmt.Errorf("set no_new_privs: %w", err)
+	}
+
+	rw := append([]string{}, writable...)
+	if privateTmp {
+		rw = append(rw, "/tmp")
+	}
+	if err := applyLandlock(rw); err != nil {
+		return fmt.Errorf("apply landlock rules: %w", err)
+	}
+	if err := applySeccomp(); err != nil {
+		return fmt.Errorf("install seccomp filter: %w", err)
+	}
+	return nil
+}
+
+const (
+	landlockRead = unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_READ_FILE |
+		unix.LANDLOCK_ACCESS_FS_READ_DIR
+	// landlockV1 is every filesystem access right of landlock ABI 1.
+	landlockV1 = landlockRead | unix.LANDLOCK_ACCESS_FS_WRITE_FILE |
+		unix.LANDLOCK_ACCESS_FS_REMOVE_DIR | unix.LANDLOCK_ACCESS_FS_REMOVE_FILE |
+		unix.LANDLOCK_ACCESS_FS_MAKE_CHAR | unix.LANDLOCK_ACCESS_FS_MAKE_DIR |
+		unix.LANDLOCK_ACCESS_FS_MAKE_REG | unix.LANDLOCK_ACCESS_FS_MAKE_SOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_FIFO | unix.LANDLOCK_ACCESS_FS_MAKE_BLOCK |
+		unix.LANDLOCK_ACCESS_FS_MAKE_SYM
+)
+
+// applyLandlock restricts the filesystem to reading and executing, plus
+// full access to the writable paths and device reads and writes (for
+// /dev/null and terminals). Kernels without landlock are skipped: the
+// read-only mounts still apply.
+func applyLandlock(writable []string) error {
+	abi, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET, 0, 0, unix.LANDLOCK_CREATE_RULESET_VERSION)
+	if errno != 0 {
+		if errno == unix.ENOSYS || errno == unix.EOPNOTSUPP {
+			return nil
+		}
+		return errno
+	}
+
+	handled := uint64(landlockV1)
+	full := uint64(landlockV1)
+	if abi >= 2 {
+		handled |= unix.LANDLOCK_ACCESS_FS_REFER
+		full |= unix.LANDLOCK_ACCESS_FS_REFER
+	}
+	if abi >= 3 {
+		handled |= unix.LANDLOCK_ACCESS_FS_TRUNCATE
+		full |= unix.LANDLOCK_ACCESS_FS_TRUNCATE
+	}
+
+	attr := unix.LandlockRulesetAttr{Access_fs: handled}
+	fd, _, errno := unix.Syscall(unix.SYS_LANDLOCK_CREATE_RULESET,
+		uintptr(unsafe.Pointer(&attr)), unsafe.Sizeof(attr), 0)
+	if errno != 0 {
+		return errno
+	}
+	defer unix.Close(int(fd))
+
+	rules := []struct {
+		path   string
+		access uint64
+	}{
+		{"/", landlockRead},
+		{"/dev", landlockRead | unix.LANDLOCK_ACCESS_FS_WRITE_FILE},
+	}
+	for _, p := range writable {
+		rules = append(rules, struct {
+			path   string
+			access uint64
+		}{p, full})
+	}
+	for _, r := range rules {
+		if err := addLandlockRule(int(fd), r.path, r.access); err != nil {
+			return fmt.Errorf("rule for %s: %w", r.path, err)
+		}
+	}
+
+	if _, _, errno := unix.Syscall(unix.SYS_LANDLOCK_RESTRICT_SELF, fd, 0, 0); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+func addLandlockRule(rulesetFD int, path string, access uint64) error {
+	fd, err := unix.Open(path, unix.O_PATH|unix.O_CLOEXEC, 0)
+	if err != nil {
+		if errors.Is(err, unix.ENOENT) {
+			return nil
+		}
+		return err
+	}
+	defer unix.Close(fd)
+
+	// Directory rights on a file are rejected; keep the file rights.
+	if st, err := os.Stat(path); err == nil && !st.IsDir() {
+		access &= unix.LANDLOCK_ACCESS_FS_EXECUTE | unix.LANDLOCK_ACCESS_FS_READ_FILE |
+			unix.LANDLOCK_ACCESS_FS_WRITE_FILE | unix.LANDLOCK_ACCESS_FS_TRUNCATE
+	}
+	attr := unix.LandlockPathBeneathAttr{Allowed_access: access, Parent_fd: int32(fd)}
+	_, _, errno := unix.Syscall6(unix.SYS_LANDLOCK_ADD_RULE, uintptr(rulesetFD),
+		unix.LANDLOCK_RULE_PATH_BENEATH, uintptr(unsafe.Pointer(&attr)), 0, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
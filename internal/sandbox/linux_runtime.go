@@ -0,0 +1,154 @@
+package sandbox
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// initFlag is the CLI flag that triggers sandbox init mode.
+const initFlag = "--sandbox-init"
+
+// specEnv carries the JSON-encoded init spec to the sandbox init process.
+const specEnv = "LANGO_SANDBOX_SPEC"
+
+// Policy defines the confinement of a command run by the Linux sandbox.
+// The root filesystem is always read-only and the working directory of
+// the command is always writable.
+type Policy struct {
+	// WritablePaths are additional host paths writable inside the sandbox.
+	WritablePaths []string
+
+	// Network keeps access to the host network. Without it the sandbox
+	// gets an empty network namespace with only a loopback device.
+	Network bool
+
+	// MemoryLimitMB is the cgroup v2 memory limit (0 = unlimited).
+	MemoryLimitMB int64
+
+	// CPUQuotaUS is the cgroup v2 CPU quota per 100ms period (0 = unlimited).
+	CPUQuotaUS int64
+
+	// PidsLimit is the cgroup v2 limit on processes (0 = unlimited).
+	PidsLimit int64
+
+	// AllowMissingLimits runs commands without the limits when the cgroup
+	// cannot be created. Otherwise isolation fails.
+	AllowMissingLimits bool
+}
+
+// NewPolicy converts the sandbox configuration to a Policy.
+func NewPolicy(cfg config.SandboxConfig) Policy {
+	return Policy{
+		WritablePaths:      cfg.WritablePaths,
+		Network:            cfg.Network,
+		MemoryLimitMB:      cfg.MemoryLimitMB,
+		CPUQuotaUS:         cfg.CPUQuotaUS,
+		PidsLimit:          cfg.PidsLimit,
+		AllowMissingLimits: cfg.AllowMissingLimits,
+	}
+}
+
+// CommandIsolator confines host commands before they are started.
+type CommandIsolator interface {
+	// Isolate rewrites cmd to run confined. The caller must call release
+	// once cmd has exited.
+	Isolate(cmd *exec.Cmd) (release func(), err error)
+}
+
+// LinuxRuntime confines processes with Linux namespaces, a read-only
+// root filesystem, landlock path rules, a seccomp filter and cgroup v2
+// limits. It needs neither Docker nor root, only unprivileged user
+// namespaces.
+type LinuxRuntime struct {
+	cfg    Config
+	policy Policy
+}
+
+var (
+	_ ContainerRuntime = (*LinuxRuntime)(nil)
+	_ CommandIsolator  = (*LinuxRuntime)(nil)
+)
+
+// NewLinuxRuntime creates a LinuxRuntime confining commands to policy.
+func NewLinuxRuntime(cfg Config, policy Policy) *LinuxRuntime {
+	return &LinuxRuntime{cfg: cfg, policy: policy}
+}
+
+// Isolate rewrites cmd to start through the sandbox init process.
+func (r *LinuxRuntime) Isolate(cmd *exec.Cmd) (func(), error) {
+	return isolate(cmd, r.policy)
+}
+
+// Run executes the tool in a sandbox worker subprocess confined by the
+// runtime policy, with the network, memory and CPU settings of cfg.
+func (r *LinuxRuntime) Run(ctx context.Context, cfg ContainerConfig) (*ExecutionResult, error) {
+	policy := r.policy
+	policy.Network = cfg.NetworkMode != "" && cfg.NetworkMode != "none"
+	if cfg.MemoryLimitMB > 0 {
+		policy.MemoryLimitMB = cfg.MemoryLimitMB
+	}
+	if cfg.CPUQuotaUS > 0 {
+		policy.CPUQuotaUS = cfg.CPUQuotaUS
+	}
+
+	sbxCfg := r.cfg
+	if cfg.Timeout > 0 {
+		sbxCfg.TimeoutPerTool = cfg.Timeout
+	}
+	executor := &SubprocessExecutor{cfg: sbxCfg, isolator: NewLinuxRuntime(sbxCfg, policy)}
+
+	output, err := executor.Execute(ctx, cfg.ToolName, cfg.Params)
+	if err != nil {
+		return &ExecutionResult{Error: err.Error()}, err
+	}
+	return &ExecutionResult{Output: output}, nil
+}
+
+// Cleanup is a no-op — sandboxed processes are cleaned up on exit.
+func (r *LinuxRuntime) Cleanup(_ context.Context, _ string) error {
+	return nil
+}
+
+var (
+	probeOnce sync.Once
+	probeErr  error
+)
+
+// IsAvailable reports whether the sandbox can be set up on this host. The
+// first call starts a probe sandbox; the result is cached.
+func (r *LinuxRuntime) IsAvailable(_ context.Context) bool {
+	return ProbeLinux() == nil
+}
+
+// Name returns the runtime name.
+func (r *LinuxRuntime) Name() string {
+	return "linux"
+}
+
+// ProbeLinux starts a sandbox that exits right after its setup and returns
+// why the sandbox is unavailable, or nil. The result is cached.
+func ProbeLinux() error {
+	probeOnce.Do(func() {
+		probeErr = probe()
+	})
+	return probeErr
+}
+
+// IsInitMode returns true if the process was launched as a sandbox init
+// process.
+func IsInitMode() bool {
+	return len(os.Args) > 1 && os.Args[1] == initFlag
+}
+
+// initSpec is what the sandbox init process sets up and runs.
+type initSpec struct {
+	Path          string   `json:"path"`
+	Args          []string `json:"args"`
+	Dir           string   `json:"dir"`
+	WritablePaths []string `json:"writablePaths"`
+	Probe         bool     `json:"probe,omitempty"`
+}
@@ -0,0 +1,312 @@
+package sandbox
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/langoai/lango/internal/logging"
+)
+
+var logger = logging.SubsystemSugar("sandbox")
+
+// isolate rewrites cmd to start the sandbox init process in new user,
+// mount, PID, IPC, UTS and (unless policy.Network) network namespaces.
+// The init process sets up the filesystem and runs the original command.
+func isolate(cmd *exec.Cmd, policy Policy) (func(), error) {
+	if cmd.Err != nil {
+		return nil, cmd.Err
+	}
+	if cmd.Process != nil {
+		return nil, fmt.Errorf("isolate: command already started")
+	}
+
+	self, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("resolve executable path: %w", err)
+	}
+
+	dir := cmd.Dir
+	if dir == "" {
+		if dir, err = os.Getwd(); err != nil {
+			return nil, fmt.Errorf("resolve working directory: %w", err)
+		}
+	}
+	writable := make([]string, 0, len(policy.WritablePaths)+1)
+	for _, p := range append([]string{dir}, policy.WritablePaths...) {
+		resolved, err := resolvePath(p)
+		if err != nil {
+			return nil, fmt.Errorf("sandbox writable path %q: %w", p, err)
+		}
+		writable = append(writable, resolved)
+	}
+
+	spec, err := json.Marshal(initSpec{
+		Path:          cmd.Path,
+		Args:          cmd.Args,
+		Dir:           writable[0],
+		WritablePaths: writable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal sandbox spec: %w", err)
+	}
+
+	env := cmd.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	cmd.Path = self
+	cmd.Args = []string{self, initFlag}
+	cmd.Env = append(withoutEnv(env, specEnv), specEnv+"="+string(spec))
+	cmd.SysProcAttr = namespaceAttr(cmd.SysProcAttr, policy.Network)
+
+	release := func() {}
+	if cg, err := newCgroup(policy); err != nil {
+		if !policy.AllowMissingLimits {
+			return nil, fmt.Errorf("sandbox resource limits: %w (set sandbox.allowMissingLimits to run without them)", err)
+		}
+		if cgroupWarned.CompareAndSwap(false, true) {
+			logger.Warnw("sandbox cgroup limits unavailable, running without them", "error", err)
+		} else {
+			logger.Debugw("sandbox cgroup limits unavailable", "error", err)
+		}
+	} else if cg != nil {
+		cmd.SysProcAttr.UseCgroupFD = true
+		cmd.SysProcAttr.CgroupFD = cg.fd
+		release = cg.release
+	}
+	return release, nil
+}
+
+// namespaceAttr adds the sandbox namespaces to attr, keeping fields such as
+// Setsid and Setctty set by the caller.
+func namespaceAttr(attr *syscall.SysProcAttr, network bool) *syscall.SysProcAttr {
+	if attr == nil {
+		attr = &syscall.SysProcAttr{}
+	}
+	attr.Cloneflags |= syscall.CLONE_NEWUSER | syscall.CLONE_NEWNS | syscall.CLONE_NEWPID |
+		syscall.CLONE_NEWIPC | syscall.CLONE_NEWUTS
+	if !network {
+		attr.Cloneflags |= syscall.CLONE_NEWNET
+	}
+	// Map only the current user, so files keep their owner and the
+	// sandbox cannot act as any other user.
+	uid, gid := os.Getuid(), os.Getgid()
+	attr.UidMappings = []syscall.SysProcIDMap{{ContainerID: uid, HostID: uid, Size: 1}}
+	attr.GidMappings = []syscall.SysProcIDMap{{ContainerID: gid, HostID: gid, Size: 1}}
+	attr.GidMappingsEnableSetgroups = false
+	// The init process needs CAP_SYS_ADMIN in the new user namespace to
+	// set up mounts; it drops every capability before running the command.
+	attr.AmbientCaps = append(attr.AmbientCaps, unix.CAP_SYS_ADMIN)
+	return attr
+}
+
+// probe starts a sandbox init process that exits after its setup.
+func probe() error {
+	self, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("resolve executable path: %w", err)
+	}
+	dir, err := os.MkdirTemp("", "lango-sandbox-probe-")
+	if err != nil {
+		return fmt.Errorf("create probe directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	spec, err := json.Marshal(initSpec{Dir: dir, WritablePaths: []string{dir}, Probe: true})
+	if err != nil {
+		return fmt.Errorf("marshal sandbox spec: %w", err)
+	}
+	cmd := exec.Command(self, initFlag)
+	cmd.Env = append(cleanEnv(), specEnv+"="+string(spec))
+	cmd.SysProcAttr = namespaceAttr(nil, false)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", ErrRuntimeUnavailable, msg)
+		}
+		return fmt.Errorf("%w: %v", ErrRuntimeUnavailable, err)
+	}
+	return nil
+}
+
+// resolvePath returns the absolute, symlink-free form of an existing path.
+func resolvePath(p string) (string, error) {
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return "", err
+	}
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return "", err
+	}
+	return resolved, nil
+}
+
+func withoutEnv(env []string, key string) []string {
+	out := make([]string, 0, len(env))
+	for _, e := range env {
+		if !strings.HasPrefix(e, key+"=") {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// cgroup is a cgroup v2 leaf created for one sandboxed command.
+type cgroup struct {
+	path string
+	fd   int
+}
+
+var (
+	cgroupSeq    atomic.Int64
+	cgroupWarned atomic.Bool // the missing-cgroup warning is logged once
+)
+
+// newCgroup creates a cgroup below the cgroup of the current process with
+// the limits of policy. It returns nil when policy sets no limits.
+// Creating it needs a delegated cgroup v2 subtree.
+func newCgroup(policy Policy) (*cgroup, error) {
+	if policy.MemoryLimitMB <= 0 && policy.CPUQuotaUS <= 0 && policy.PidsLimit <= 0 {
+		return nil, nil
+	}
+
+	parent, err := currentCgroupDir()
+	if err != nil {
+		return nil, err
+	}
+	path := filepath.Join(parent, fmt.Sprintf("lango-sandbox-%d-%d", os.Getpid(), cgroupSeq.Add(1)))
+	if err := os.Mkdir(path, 0o755); err != nil {
+		return nil, fmt.Errorf("create cgroup: %w", err)
+	}
+
+	limits := make(map[string]string)
+	if policy.MemoryLimitMB > 0 {
+		limits["memory.max"] = strconv.FormatInt(policy.MemoryLimitMB*1024*1024, 10)
+	}
+	if policy.CPUQuotaUS > 0 {
+		limits["cpu.max"] = fmt.Sprintf("%d 100000", policy.CPUQuotaUS)
+	}
+	if policy.PidsLimit > 0 {
+		limits["pids.max"] = strconv.FormatInt(policy.PidsLimit, 10)
+	}
+	for file, value := range limits {
+		if err := os.WriteFile(filepath.Join(path, file), []byte(value), 0); err != nil {
+			_ = os.Remove(path)
+			return nil, fmt.Errorf("set cgroup limit %s: %w", file, err)
+		}
+	}
+
+	fd, err := unix.Open(path, unix.O_DIRECTORY|unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf("open cgroup: %w", err)
+	}
+	return &cgroup{path: path, fd: fd}, nil
+}
+
+// release closes the cgroup and removes it; it must run after the
+// sandboxed processes have exited.
+func (c *cgroup) release() {
+	_ = unix.Close(c.fd)
+	if err := os.Remove(c.path); err != nil {
+		logger.Debugw("remove sandbox cgroup", "path", c.path, "error", err)
+	}
+}
+
+// currentCgroupDir returns the cgroup v2 directory of the current process.
+func currentCgroupDir() (string, error) {
+	data, err := os.ReadFile("/proc/self/cgroup")
+	if err != nil {
+		return "", fmt.Errorf("read cgroup membership: %w", err)
+	}
+	var rel string
+	for _, line := range strings.Split(string(data), "\n") {
+		if p, ok := strings.CutPrefix(line, "0::"); ok {
+			rel = p
+			break
+		}
+	}
+	if rel == "" {
+		return "", fmt.Errorf("process is not in a cgroup v2 hierarchy")
+	}
+
+	mounts, err := readMountInfo()
+	if err != nil {
+		return "", err
+	}
+	for _, m := range mounts {
+		if m.fsType == "cgroup2" {
+			return filepath.Join(m.mountPoint, rel), nil
+		}
+	}
+	return "", fmt.Errorf("cgroup v2 is not mounted")
+}
+
+// mountInfo is an entry of /proc/self/mountinfo.
+type mountInfo struct {
+	mountPoint string
+	fsType     string
+}
+
+func readMountInfo() ([]mountInfo, error) {
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, fmt.Errorf("read mount table: %w", err)
+	}
+	defer f.Close()
+
+	var mounts []mountInfo
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// ID PARENT MAJ:MIN ROOT MOUNTPOINT OPTIONS [OPTIONAL...] - FSTYPE SOURCE SUPEROPTIONS
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 7 {
+			continue
+		}
+		m := mountInfo{mountPoint: unescapeMountPath(fields[4])}
+		for i := 6; i < len(fields)-1; i++ {
+			if fields[i] == "-" {
+				m.fsType = fields[i+1]
+				break
+			}
+		}
+		mounts = append(mounts, m)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read mount table: %w", err)
+	}
+	return mounts, nil
+}
+
+// unescapeMountPath decodes the octal escapes (\040 for space) of
+// mountinfo paths.
+func unescapeMountPath(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if n, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,179 @@
+package sandbox
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+)
+
+// TestMain lets the test binary act as the sandbox init and worker
+// processes, which re-execute the current executable.
+func TestMain(m *testing.M) {
+	if IsInitMode() {
+		RunInit()
+	}
+	if IsWorkerMode() {
+		RunWorker(ToolRegistry{
+			"ppid": func(_ context.Context, _ map[string]interface{}) (interface{}, error) {
+				return map[string]interface{}{"ppid": float64(os.Getppid())}, nil
+			},
+		})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func requireLinuxSandbox(t *testing.T) {
+	t.Helper()
+	if err := ProbeLinux(); err != nil {
+		t.Skipf("linux sandbox unavailable: %v", err)
+	}
+}
+
+// runSandboxed runs script with sh in a sandbox rooted at dir.
+func runSandboxed(t *testing.T, policy Policy, dir, script string) (string, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", script)
+	cmd.Dir = dir
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	release, err := NewLinuxRuntime(Config{}, policy).Isolate(cmd)
+	require.NoError(t, err)
+	defer release()
+
+	err = cmd.Run()
+	return strings.TrimSpace(out.String()), err
+}
+
+func TestLinuxRuntime_Name(t *testing.T) {
+	assert.Equal(t, "linux", NewLinuxRuntime(Config{}, Policy{}).Name())
+}
+
+func TestLinuxRuntime_Filesystem(t *testing.T) {
+	requireLinuxSandbox(t)
+	workDir := t.TempDir()
+	outside := t.TempDir()
+
+	out, err := runSandboxed(t, Policy{}, workDir, "echo ok > inside.txt && cat inside.txt")
+	require.NoError(t, err, out)
+	assert.Equal(t, "ok", out)
+
+	out, err = runSandboxed(t, Policy{}, workDir, "echo no > "+filepath.Join(outside, "outside.txt"))
+	assert.Error(t, err, out)
+	assert.NoFileExists(t, filepath.Join(outside, "outside.txt"))
+
+	out, err = runSandboxed(t, Policy{WritablePaths: []string{outside}}, workDir, "echo yes > "+filepath.Join(outside, "extra.txt"))
+	require.NoError(t, err, out)
+	assert.FileExists(t, filepath.Join(outside, "extra.txt"))
+}
+
+func TestLinuxRuntime_Namespaces(t *testing.T) {
+	requireLinuxSandbox(t)
+	dir := t.TempDir()
+
+	// The command is a child of the sandbox init process, PID 1.
+	out, err := runSandboxed(t, Policy{}, dir, "echo $PPID")
+	require.NoError(t, err, out)
+	assert.Equal(t, "1", out)
+
+	// Without network access only the loopback device exists.
+	out, err = runSandboxed(t, Policy{}, dir, "grep -c : /proc/self/net/dev")
+	require.NoError(t, err, out)
+	assert.Equal(t, "1", out)
+}
+
+func TestLinuxRuntime_Seccomp(t *testing.T) {
+	requireLinuxSandbox(t)
+	if auditArch == 0 {
+		t.Skip("no seccomp filter for this architecture")
+	}
+
+	out, err := runSandboxed(t, Policy{}, t.TempDir(), "grep -E '^(Seccomp|NoNewPrivs|CapEff):' /proc/self/status")
+	require.NoError(t, err, out)
+	assert.Contains(t, out, "Seccomp:\t2")
+	assert.Contains(t, out, "NoNewPrivs:\t1")
+	assert.Contains(t, out, "CapEff:\t0000000000000000")
+}
+
+func TestLinuxRuntime_ExitStatus(t *testing.T) {
+	requireLinuxSandbox(t)
+
+	_, err := runSandboxed(t, Policy{}, t.TempDir(), "exit 3")
+	var exitErr *exec.ExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 3, exitErr.ExitCode())
+}
+
+func TestLinuxRuntime_Run(t *testing.T) {
+	requireLinuxSandbox(t)
+
+	rt := NewLinuxRuntime(Config{TimeoutPerTool: 30 * time.Second}, Policy{})
+	result, err := rt.Run(context.Background(), ContainerConfig{ToolName: "ppid", NetworkMode: "none"})
+	require.NoError(t, err)
+	// The worker runs as a child of the sandbox init process.
+	assert.Equal(t, float64(1), result.Output["ppid"])
+}
+
+func TestLinuxRuntime_MissingLimits(t *testing.T) {
+	policy := Policy{PidsLimit: 64}
+	if cg, err := newCgroup(policy); err == nil {
+		cg.release()
+		t.Skip("delegated cgroup available")
+	}
+
+	_, err := NewLinuxRuntime(Config{}, policy).Isolate(exec.Command("true"))
+	assert.ErrorContains(t, err, "sandbox.allowMissingLimits")
+
+	policy.AllowMissingLimits = true
+	release, err := NewLinuxRuntime(Config{}, policy).Isolate(exec.Command("true"))
+	require.NoError(t, err)
+	release()
+}
+
+func TestSeccompFilter(t *testing.T) {
+	if auditArch == 0 {
+		t.Skip("no seccomp filter for this architecture")
+	}
+	filter := seccompFilter()
+	require.Less(t, len(filter), 256)
+	for i, insn := range filter {
+		if insn.Code&0x07 != unix.BPF_JMP {
+			continue
+		}
+		assert.Less(t, i+1+int(insn.Jt), len(filter), "jump target of instruction %d", i)
+		assert.Less(t, i+1+int(insn.Jf), len(filter), "jump target of instruction %d", i)
+	}
+	last := filter[len(filter)-1]
+	assert.Equal(t, uint32(unix.SECCOMP_RET_ERRNO|uint32(unix.ENOSYS)), last.K)
+}
+
+func TestUnescapeMountPath(t *testing.T) {
+	tests := []struct {
+		give string
+		want string
+	}{
+		{give: "/home/user", want: "/home/user"},
+		{give: `/mnt/my\040disk`, want: "/mnt/my disk"},
+		{give: `/a\134b`, want: `/a\b`},
+		{give: `/trailing\04`, want: `/trailing\04`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			assert.Equal(t, tt.want, unescapeMountPath(tt.give))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+func isolate(_ *exec.Cmd, _ Policy) (func(), error) {
+	return nil, fmt.Errorf("linux sandbox on %s: %w", runtime.GOOS, ErrRuntimeUnavailable)
+}
+
+func probe() error {
+	return fmt.Errorf("linux sandbox on %s: %w", runtime.GOOS, ErrRuntimeUnavailable)
+}
+
+// RunInit exits with an error: the sandbox init process exists only on Linux.
+func RunInit() {
+	fmt.Fprintf(os.Stderr, "lango sandbox: unsupported on %s\n", runtime.GOOS)
+	os.Exit(125)
+}
@@ -0,0 +1,131 @@
+package sandbox
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// deniedSyscalls fail with EPERM inside the sandbox: they change mounts or
+// namespaces, load kernel code, or inspect other processes.
+var deniedSyscalls = append([]uintptr{
+	unix.SYS_PTRACE,
+	unix.SYS_PROCESS_VM_READV,
+	unix.SYS_PROCESS_VM_WRITEV,
+	unix.SYS_MOUNT,
+	unix.SYS_UMOUNT2,
+	unix.SYS_PIVOT_ROOT,
+	unix.SYS_CHROOT,
+	unix.SYS_FSOPEN,
+	unix.SYS_FSCONFIG,
+	unix.SYS_FSMOUNT,
+	unix.SYS_FSPICK,
+	unix.SYS_MOVE_MOUNT,
+	unix.SYS_OPEN_TREE,
+	unix.SYS_MOUNT_SETATTR,
+	unix.SYS_SETNS,
+	unix.SYS_UNSHARE,
+	unix.SYS_KEXEC_LOAD,
+	unix.SYS_KEXEC_FILE_LOAD,
+	unix.SYS_INIT_MODULE,
+	unix.SYS_FINIT_MODULE,
+	unix.SYS_DELETE_MODULE,
+	unix.SYS_BPF,
+	unix.SYS_PERF_EVENT_OPEN,
+	unix.SYS_USERFAULTFD,
+	unix.SYS_KEYCTL,
+	unix.SYS_ADD_KEY,
+	unix.SYS_REQUEST_KEY,
+	unix.SYS_OPEN_BY_HANDLE_AT,
+	unix.SYS_NAME_TO_HANDLE_AT,
+	unix.SYS_SWAPON,
+	unix.SYS_SWAPOFF,
+	unix.SYS_REBOOT,
+	unix.SYS_ACCT,
+	unix.SYS_QUOTACTL,
+	unix.SYS_LOOKUP_DCOOKIE,
+}, archDeniedSyscalls...)
+
+// namespaceCloneFlags are the clone flags that create namespaces.
+const namespaceCloneFlags = unix.CLONE_NEWNS | unix.CLONE_NEWUTS | unix.CLONE_NEWIPC |
+	unix.CLONE_NEWUSER | unix.CLONE_NEWPID | unix.CLONE_NEWNET | unix.CLONE_NEWCGROUP |
+	unix.CLONE_NEWTIME
+
+// seccomp_data offsets.
+const (
+	seccompNr   = 0
+	seccompArch = 4
+	seccompArg0 = 16 // low 32 bits on little-endian architectures
+)
+
+// applySeccomp installs the deny-list filter on the current thread. It is
+// a no-op on architectures without a filter.
+func applySeccomp() error {
+	if auditArch == 0 {
+		return nil
+	}
+	filter := seccompFilter()
+	prog := unix.SockFprog{Len: uint16(len(filter)), Filter: &filter[0]}
+	_, _, errno := unix.Syscall(unix.SYS_SECCOMP, unix.SECCOMP_SET_MODE_FILTER, 0, uintptr(unsafe.Pointer(&prog)))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// seccompFilter builds the BPF program:
+//
+//	foreign architecture            -> EPERM
+//	x32 ABI (amd64)                 -> EPERM
+//	clone3                          -> ENOSYS (flags are not inspectable; libc falls back to clone)
+//	clone with namespace flags      -> EPERM
+//	denied syscall                  -> EPERM
+//	anything else                   -> allow
+func seccompFilter() []unix.SockFilter {
+	const (
+		ld  = unix.BPF_LD | unix.BPF_W | unix.BPF_ABS
+		jeq = unix.BPF_JMP | unix.BPF_JEQ | unix.BPF_K
+		jge = unix.BPF_JMP | unix.BPF_JGE | unix.BPF_K
+		jst = unix.BPF_JMP | unix.BPF_JSET | unix.BPF_K
+		ret = unix.BPF_RET | unix.BPF_K
+	)
+
+	var prog []unix.SockFilter
+	var fixups []func() // resolve jumps to the labels below
+	var labelDeny, labelNoSys, labelClone int
+
+	// jumpTrue appends a conditional jump to *label when the test holds.
+	jumpTrue := func(code uint16, k uint32, label *int) {
+		at := len(prog)
+		prog = append(prog, unix.SockFilter{Code: code, K: k})
+		fixups = append(fixups, func() { prog[at].Jt = uint8(*label - at - 1) })
+	}
+
+	prog = append(prog, unix.SockFilter{Code: ld, K: seccompArch})
+	prog = append(prog, unix.SockFilter{Code: jeq, K: auditArch, Jt: 1})
+	prog = append(prog, unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)})
+	prog = append(prog, unix.SockFilter{Code: ld, K: seccompNr})
+	if x32SyscallBit != 0 {
+		jumpTrue(jge, x32SyscallBit, &labelDeny)
+	}
+	jumpTrue(jeq, unix.SYS_CLONE3, &labelNoSys)
+	jumpTrue(jeq, unix.SYS_CLONE, &labelClone)
+	for _, nr := range deniedSyscalls {
+		jumpTrue(jeq, uint32(nr), &labelDeny)
+	}
+
+	prog = append(prog, unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ALLOW})
+	labelClone = len(prog)
+	prog = append(prog, unix.SockFilter{Code: ld, K: seccompArg0})
+	jumpTrue(jst, namespaceCloneFlags, &labelDeny)
+	prog = append(prog, unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ALLOW})
+	labelDeny = len(prog)
+	prog = append(prog, unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ERRNO | uint32(unix.EPERM)})
+	labelNoSys = len(prog)
+	prog = append(prog, unix.SockFilter{Code: ret, K: unix.SECCOMP_RET_ERRNO | uint32(unix.ENOSYS)})
+
+	for _, fix := range fixups {
+		fix()
+	}
+	return prog
+}
@@ -0,0 +1,15 @@
+package sandbox
+
+import "golang.org/x/sys/unix"
+
+const (
+	auditArch     = unix.AUDIT_ARCH_X86_64
+	x32SyscallBit = 0x40000000
+)
+
+var archDeniedSyscalls = []uintptr{
+	unix.SYS_IOPL,
+	unix.SYS_IOPERM,
+	unix.SYS_USELIB,
+	unix.SYS_CREATE_MODULE,
+}
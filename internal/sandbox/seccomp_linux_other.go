@@ -0,0 +1,12 @@
+//go:build linux && !amd64 && !arm64
+
+package sandbox
+
+// No seccomp filter is built for this architecture; the namespace,
+// mount and landlock confinement still applies.
+const (
+	auditArch     = 0
+	x32SyscallBit = 0
+)
+
+var archDeniedSyscalls []uintptr
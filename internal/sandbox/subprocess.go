@@ -13,7 +13,8 @@ import (
 // The child process inherits only PATH and HOME environment variables,
 // preventing access to in-memory secrets of the parent process.
 type SubprocessExecutor struct {
-	cfg Config
+	cfg      Config
+	isolator CommandIsolator // confines the child process, if set
 }
 
 // NewSubprocessExecutor creates a subprocess executor with the given config.
@@ -58,6 +59,14 @@ func (e *SubprocessExecutor) Execute(ctx context.Context, toolName string, param
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	if e.isolator != nil {
+		release, err := e.isolator.Isolate(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("isolate tool %q: %w", toolName, err)
+		}
+		defer release()
+	}
+
 	// Run the subprocess.
 	if err := cmd.Run(); err != nil {
 		// Check for timeout.
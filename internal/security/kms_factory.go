@@ -14,21 +14,23 @@ const (
 	KMSProviderGCP    KMSProviderName = "gcp-kms"
 	KMSProviderAzure  KMSProviderName = "azure-kv"
 	KMSProviderPKCS11 KMSProviderName = "pkcs11"
+	KMSProviderVault  KMSProviderName = "vault"
 )
 
 // Valid reports whether n is a recognised KMS provider name.
 func (n KMSProviderName) Valid() bool {
 	switch n {
-	case KMSProviderAWS, KMSProviderGCP, KMSProviderAzure, KMSProviderPKCS11:
+	case KMSProviderAWS, KMSProviderGCP, KMSProviderAzure, KMSProviderPKCS11, KMSProviderVault:
 		return true
 	}
 	return false
 }
 
 // NewKMSProvider creates a CryptoProvider for the named KMS backend.
-// Supported providers: "aws-kms", "gcp-kms", "azure-kv", "pkcs11".
-// Build tags control which providers are compiled in; uncompiled providers
-// return a descriptive error.
+// Supported providers: "aws-kms", "gcp-kms", "azure-kv", "pkcs11", "vault".
+// Build tags control which cloud and HSM providers are compiled in;
+// uncompiled providers return a descriptive error. "vault" is always
+// available.
 func NewKMSProvider(providerName KMSProviderName, kmsConfig config.KMSConfig) (CryptoProvider, error) {
 	switch providerName {
 	case KMSProviderAWS:
@@ -39,7 +41,9 @@ func NewKMSProvider(providerName KMSProviderName, kmsConfig config.KMSConfig) (C
 		return newAzureKVProvider(kmsConfig)
 	case KMSProviderPKCS11:
 		return newPKCS11Provider(kmsConfig)
+	case KMSProviderVault:
+		return newVaultProvider(kmsConfig)
 	default:
-		return nil, fmt.Errorf("unknown KMS provider: %q (supported: aws-kms, gcp-kms, azure-kv, pkcs11)", providerName)
+		return nil, fmt.Errorf("unknown KMS provider: %q (supported: aws-kms, gcp-kms, azure-kv, pkcs11, vault)", providerName)
 	}
 }
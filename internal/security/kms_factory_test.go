@@ -16,6 +16,7 @@ func TestKMSProviderName_Valid(t *testing.T) {
 		{KMSProviderGCP, true},
 		{KMSProviderAzure, true},
 		{KMSProviderPKCS11, true},
+		{KMSProviderVault, true},
 		{"unknown", false},
 		{"", false},
 		{"local", false},
@@ -33,6 +34,7 @@ func TestKMSProviderName_Constants(t *testing.T) {
 	assert.Equal(t, KMSProviderName("gcp-kms"), KMSProviderGCP)
 	assert.Equal(t, KMSProviderName("azure-kv"), KMSProviderAzure)
 	assert.Equal(t, KMSProviderName("pkcs11"), KMSProviderPKCS11)
+	assert.Equal(t, KMSProviderName("vault"), KMSProviderVault)
 }
 
 func TestNewKMSProvider_UnknownProvider(t *testing.T) {
@@ -1,6 +1,7 @@
 package security
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"sync"
@@ -19,8 +20,20 @@ var refLogger = logging.SubsystemSugar("secret-ref")
 type RefStore struct {
 	mu      sync.RWMutex
 	secrets map[string][]byte // token -> plaintext
+	record  AccessRecorder
 }
 
+// RefAccess describes a reference token resolved to its value.
+type RefAccess struct {
+	Kind     string // "secret" or "decrypt"
+	Name     string // secret name or decrypt ID
+	Accessor string // tool that resolved the token
+}
+
+// AccessRecorder is called for every reference token resolved by
+// ResolveAllFor.
+type AccessRecorder func(ctx context.Context, access RefAccess)
+
 // NewRefStore creates a new RefStore.
 func NewRefStore() *RefStore {
 	return &RefStore{
@@ -77,6 +90,43 @@ func (r *RefStore) Resolve(token string) ([]byte, bool) {
 	return result, true
 }
 
+// SetAccessRecorder sets the function that records resolved reference
+// tokens.
+func (r *RefStore) SetAccessRecorder(fn AccessRecorder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.record = fn
+}
+
+// ResolveAllFor is ResolveAll on behalf of accessor: every distinct token
+// resolved is passed to the access recorder.
+func (r *RefStore) ResolveAllFor(ctx context.Context, accessor, input string) string {
+	r.mu.RLock()
+	record := r.record
+	var accesses []RefAccess
+	seen := make(map[string]bool)
+	out := tokenPattern.ReplaceAllStringFunc(input, func(token string) string {
+		val, ok := r.secrets[token]
+		if !ok {
+			return token
+		}
+		if !seen[token] {
+			seen[token] = true
+			m := tokenPattern.FindStringSubmatch(token)
+			accesses = append(accesses, RefAccess{Kind: m[1], Name: m[2], Accessor: accessor})
+		}
+		return string(val)
+	})
+	r.mu.RUnlock()
+
+	if record != nil {
+		for _, a := range accesses {
+			record(ctx, a)
+		}
+	}
+	return out
+}
+
 // ResolveAll replaces all {{secret:...}} and {{decrypt:...}} tokens in
 // the input string with their actual plaintext values.
 func (r *RefStore) ResolveAll(input string) string {
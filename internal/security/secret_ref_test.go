@@ -2,6 +2,7 @@ package security
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"sync"
 	"testing"
@@ -184,6 +185,35 @@ func TestRefStore_ResolveAll(t *testing.T) {
 	}
 }
 
+func TestRefStore_ResolveAllFor(t *testing.T) {
+	rs := NewRefStore()
+	rs.Store("user", []byte("admin"))
+	rs.StoreDecrypted("data-1", []byte("payload"))
+
+	var got []RefAccess
+	rs.SetAccessRecorder(func(_ context.Context, a RefAccess) {
+		got = append(got, a)
+	})
+
+	out := rs.ResolveAllFor(context.Background(), "exec", "{{secret:user}} {{secret:user}} {{decrypt:data-1}} {{secret:missing}}")
+	if want := "admin admin payload {{secret:missing}}"; out != want {
+		t.Errorf("ResolveAllFor() = %q, want %q", out, want)
+	}
+
+	want := []RefAccess{
+		{Kind: "secret", Name: "user", Accessor: "exec"},
+		{Kind: "decrypt", Name: "data-1", Accessor: "exec"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("recorded %d accesses, want %d: %v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("access[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
 func TestRefStore_Values(t *testing.T) {
 	rs := NewRefStore()
 	rs.Store("a", []byte("val-a"))
@@ -1,57 +1,180 @@
 package security
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/langoai/lango/internal/config"
 	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/auditlog"
 	"github.com/langoai/lango/internal/ent/secret"
+	"github.com/langoai/lango/internal/ent/secretversion"
 	"github.com/langoai/lango/internal/logging"
 )
 
 var secretsLogger = logging.SubsystemSugar("secrets-store")
 
+// DefaultKeepVersions is the number of earlier values kept per secret.
+const DefaultKeepVersions = 5
+
 // SecretInfo represents secret metadata (without the actual value).
 type SecretInfo struct {
-	ID          uuid.UUID
-	Name        string
-	KeyID       uuid.UUID
-	KeyName     string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	AccessCount int
+	ID               uuid.UUID
+	Name             string
+	KeyID            uuid.UUID
+	KeyName          string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	AccessCount      int
+	Version          int
+	Owner            string        `json:",omitempty"`
+	ExpiresAt        *time.Time    `json:",omitempty"`
+	RotationInterval time.Duration `json:",omitempty"`
+	RotatedAt        *time.Time    `json:",omitempty"`
+}
+
+// Expired reports whether the secret has expired at now.
+func (i *SecretInfo) Expired(now time.Time) bool {
+	return i.ExpiresAt != nil && !now.Before(*i.ExpiresAt)
+}
+
+// SecretMeta holds the optional expiry metadata of a secret.
+type SecretMeta struct {
+	// Owner is the person or team responsible for the secret.
+	Owner string
+	// ExpiresAt is when the secret expires; nil means never.
+	ExpiresAt *time.Time
+	// RotationInterval, when set, moves ExpiresAt this far ahead on every
+	// rotation.
+	RotationInterval time.Duration
+}
+
+// SecretVersionInfo describes an earlier value of a secret.
+type SecretVersionInfo struct {
+	Version   int
+	KeyID     uuid.UUID
+	CreatedAt time.Time
+	RetiredAt time.Time
+}
+
+// SecretAccess records that a secret reference was resolved to its value.
+type SecretAccess struct {
+	Time       time.Time
+	Name       string
+	Kind       string // "secret" or "decrypt"
+	Accessor   string // tool that resolved the reference
+	SessionKey string `json:",omitempty"`
+}
+
+// SecretBackend stores secret values outside the secrets table. The table
+// then keeps the reference returned by Put in place of the encrypted value.
+type SecretBackend interface {
+	// Put stores value as the newest version of name and returns a
+	// reference to that version.
+	Put(ctx context.Context, name string, value []byte) ([]byte, error)
+	// Get returns the value ref refers to. ok is false if ref was not
+	// written by this backend.
+	Get(ctx context.Context, name string, ref []byte) (value []byte, ok bool, err error)
+	// Delete removes all versions of name.
+	Delete(ctx context.Context, name string) error
+}
+
+// NewSecretBackend returns the backend selected by security.secrets.backend,
+// or nil when values are kept in the secrets table.
+func NewSecretBackend(cfg config.SecurityConfig) (SecretBackend, error) {
+	switch cfg.Secrets.Backend {
+	case "", "local":
+		return nil, nil
+	case "vault":
+		kv, err := NewVaultKV(cfg.KMS)
+		if err != nil {
+			return nil, err
+		}
+		return kv, nil
+	default:
+		return nil, fmt.Errorf("unknown secrets backend: %q (supported: local, vault)", cfg.Secrets.Backend)
+	}
 }
 
 // SecretsStore manages encrypted secrets.
 type SecretsStore struct {
-	client   *ent.Client
-	registry *KeyRegistry
-	crypto   CryptoProvider
+	client       *ent.Client
+	registry     *KeyRegistry
+	crypto       CryptoProvider
+	backend      SecretBackend
+	keepVersions int
 }
 
 // NewSecretsStore creates a new SecretsStore.
 func NewSecretsStore(client *ent.Client, registry *KeyRegistry, crypto CryptoProvider) *SecretsStore {
 	return &SecretsStore{
-		client:   client,
-		registry: registry,
-		crypto:   crypto,
+		client:       client,
+		registry:     registry,
+		crypto:       crypto,
+		keepVersions: DefaultKeepVersions,
 	}
 }
 
-// Store encrypts and stores a secret value.
+// SetKeepVersions sets how many earlier values are kept per secret for
+// rollback. Zero keeps none.
+func (s *SecretsStore) SetKeepVersions(n int) {
+	if n < 0 {
+		n = 0
+	}
+	s.keepVersions = n
+}
+
+// SetBackend stores new secret values in backend instead of encrypting them
+// into the secrets table. Values stored before stay readable.
+func (s *SecretsStore) SetBackend(backend SecretBackend) {
+	s.backend = backend
+}
+
+// Store encrypts and stores a secret value. The value it replaces is kept as
+// an earlier version.
 func (s *SecretsStore) Store(ctx context.Context, name string, value []byte) error {
+	return s.put(ctx, name, value, false)
+}
+
+// StoreIfChanged stores a secret value unless the secret already holds it,
+// so storing the same value again adds no version.
+func (s *SecretsStore) StoreIfChanged(ctx context.Context, name string, value []byte) error {
+	existing, err := s.client.Secret.Query().Where(secret.NameEQ(name)).WithKey().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("get secret: %w", err)
+	}
+	if err == nil && existing.Edges.Key != nil {
+		current, err := s.open(ctx, name, existing.Edges.Key.RemoteKeyID, existing.EncryptedValue)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(current, value) {
+			return nil
+		}
+	}
+	return s.put(ctx, name, value, false)
+}
+
+// Rotate replaces the value of an existing secret, keeping the previous
+// value as an earlier version. With a rotation interval, the expiry moves
+// that far ahead; a pending expiry warning is reset.
+func (s *SecretsStore) Rotate(ctx context.Context, name string, value []byte) error {
+	return s.put(ctx, name, value, true)
+}
+
+func (s *SecretsStore) put(ctx context.Context, name string, value []byte, rotate bool) error {
 	// Get default key for encryption
 	keyInfo, err := s.registry.GetDefaultKey(ctx)
 	if err != nil {
 		return fmt.Errorf("no encryption key available: %w", err)
 	}
 
-	// Encrypt the value
-	encrypted, err := s.crypto.Encrypt(ctx, keyInfo.RemoteKeyID, value)
+	encrypted, err := s.seal(ctx, name, keyInfo.RemoteKeyID, value)
 	if err != nil {
-		return fmt.Errorf("encrypt secret: %w", err)
+		return err
 	}
 
 	// Get the key entity
@@ -61,18 +184,15 @@ func (s *SecretsStore) Store(ctx context.Context, name string, value []byte) err
 	}
 
 	// Check if secret exists
-	existing, err := s.client.Secret.Query().Where(secret.NameEQ(name)).Only(ctx)
+	existing, err := s.client.Secret.Query().Where(secret.NameEQ(name)).WithKey().Only(ctx)
 	if err == nil {
-		// Update existing secret
-		_, err = existing.Update().
-			SetEncryptedValue(encrypted).
-			SetKey(keyEntity).
-			SetAccessCount(0). // Reset access count on update
-			Save(ctx)
-		if err != nil {
-			return fmt.Errorf("update secret: %w", err)
-		}
-		return nil
+		return s.replace(ctx, existing, encrypted, keyEntity, rotate)
+	}
+	if !ent.IsNotFound(err) {
+		return fmt.Errorf("get secret: %w", err)
+	}
+	if rotate {
+		return fmt.Errorf("secret not found: %s", name)
 	}
 
 	// Create new secret
@@ -88,6 +208,266 @@ func (s *SecretsStore) Store(ctx context.Context, name string, value []byte) err
 	return nil
 }
 
+// replace archives the current value of sec and replaces it with encrypted.
+func (s *SecretsStore) replace(ctx context.Context, sec *ent.Secret, encrypted []byte, key *ent.Key, rotate bool) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := s.archive(ctx, tx, sec); err != nil {
+		return err
+	}
+
+	update := tx.Secret.UpdateOne(sec).
+		SetEncryptedValue(encrypted).
+		SetKey(key).
+		SetVersion(sec.Version + 1).
+		SetAccessCount(0) // Reset access count on update
+	if rotate {
+		now := time.Now()
+		update.SetRotatedAt(now).ClearExpiryNotifiedAt()
+		if sec.RotationInterval > 0 {
+			update.SetExpiresAt(now.Add(sec.RotationInterval))
+		}
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return fmt.Errorf("update secret: %w", err)
+	}
+	return tx.Commit()
+}
+
+// archive keeps the current value of sec as an earlier version and prunes
+// versions beyond keepVersions.
+func (s *SecretsStore) archive(ctx context.Context, tx *ent.Tx, sec *ent.Secret) error {
+	if s.keepVersions == 0 {
+		return nil
+	}
+	if sec.Edges.Key == nil {
+		return fmt.Errorf("secret has no associated key")
+	}
+
+	// The current value became current when the previous one was retired.
+	since := sec.CreatedAt
+	if latest, err := tx.SecretVersion.Query().
+		Where(secretversion.SecretName(sec.Name)).
+		Order(ent.Desc(secretversion.FieldVersion)).
+		First(ctx); err == nil {
+		since = latest.RetiredAt
+	}
+
+	_, err := tx.SecretVersion.Create().
+		SetSecretName(sec.Name).
+		SetVersion(sec.Version).
+		SetEncryptedValue(sec.EncryptedValue).
+		SetKeyID(sec.Edges.Key.ID).
+		SetCreatedAt(since).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("keep secret version: %w", err)
+	}
+
+	if _, err := tx.SecretVersion.Delete().
+		Where(
+			secretversion.SecretName(sec.Name),
+			secretversion.VersionLTE(sec.Version-s.keepVersions),
+		).Exec(ctx); err != nil {
+		return fmt.Errorf("prune secret versions: %w", err)
+	}
+	return nil
+}
+
+// Versions returns the earlier values kept for a secret, newest first.
+func (s *SecretsStore) Versions(ctx context.Context, name string) ([]SecretVersionInfo, error) {
+	rows, err := s.client.SecretVersion.Query().
+		Where(secretversion.SecretName(name)).
+		Order(ent.Desc(secretversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list secret versions: %w", err)
+	}
+	result := make([]SecretVersionInfo, len(rows))
+	for i, v := range rows {
+		result[i] = SecretVersionInfo{Version: v.Version, KeyID: v.KeyID, CreatedAt: v.CreatedAt, RetiredAt: v.RetiredAt}
+	}
+	return result, nil
+}
+
+// Rollback restores an earlier value of a secret. Like any other change, the
+// restored value becomes a new version and the replaced value is kept.
+func (s *SecretsStore) Rollback(ctx context.Context, name string, version int) error {
+	sec, err := s.client.Secret.Query().Where(secret.NameEQ(name)).WithKey().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("secret not found: %s", name)
+		}
+		return fmt.Errorf("get secret: %w", err)
+	}
+	old, err := s.client.SecretVersion.Query().
+		Where(secretversion.SecretName(name), secretversion.Version(version)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("version %d of secret %s not found", version, name)
+		}
+		return fmt.Errorf("get secret version: %w", err)
+	}
+	key, err := s.client.Key.Get(ctx, old.KeyID)
+	if err != nil {
+		return fmt.Errorf("get key of version %d: %w", version, err)
+	}
+	if s.backend == nil {
+		return s.replace(ctx, sec, old.EncryptedValue, key, true)
+	}
+
+	// Write the old value again so it is also the newest version in the
+	// backend.
+	value, err := s.open(ctx, name, key.RemoteKeyID, old.EncryptedValue)
+	if err != nil {
+		return err
+	}
+	stored, err := s.seal(ctx, name, key.RemoteKeyID, value)
+	if err != nil {
+		return err
+	}
+	return s.replace(ctx, sec, stored, key, true)
+}
+
+// seal returns what the secrets table keeps for value: a backend reference
+// or the value encrypted with remoteKeyID.
+func (s *SecretsStore) seal(ctx context.Context, name, remoteKeyID string, value []byte) ([]byte, error) {
+	if s.backend != nil {
+		ref, err := s.backend.Put(ctx, name, value)
+		if err != nil {
+			return nil, fmt.Errorf("store secret in backend: %w", err)
+		}
+		return ref, nil
+	}
+	encrypted, err := s.crypto.Encrypt(ctx, remoteKeyID, value)
+	if err != nil {
+		return nil, fmt.Errorf("encrypt secret: %w", err)
+	}
+	return encrypted, nil
+}
+
+// open reverses seal.
+func (s *SecretsStore) open(ctx context.Context, name, remoteKeyID string, stored []byte) ([]byte, error) {
+	if s.backend != nil {
+		value, ok, err := s.backend.Get(ctx, name, stored)
+		if err != nil {
+			return nil, fmt.Errorf("read secret from backend: %w", err)
+		}
+		if ok {
+			return value, nil
+		}
+	}
+	decrypted, err := s.crypto.Decrypt(ctx, remoteKeyID, stored)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt secret: %w", err)
+	}
+	return decrypted, nil
+}
+
+// SetMeta sets the expiry metadata of an existing secret. A changed expiry
+// resets a pending expiry warning.
+func (s *SecretsStore) SetMeta(ctx context.Context, name string, meta SecretMeta) error {
+	update := s.client.Secret.Update().
+		Where(secret.NameEQ(name)).
+		SetOwner(meta.Owner).
+		SetRotationInterval(meta.RotationInterval).
+		ClearExpiryNotifiedAt()
+	if meta.ExpiresAt != nil {
+		update.SetExpiresAt(*meta.ExpiresAt)
+	} else {
+		update.ClearExpiresAt()
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update secret metadata: %w", err)
+	}
+	if n == 0 {
+		return fmt.Errorf("secret not found: %s", name)
+	}
+	return nil
+}
+
+// Expiring returns the secrets that expire before deadline and were not
+// warned about yet.
+func (s *SecretsStore) Expiring(ctx context.Context, deadline time.Time) ([]*SecretInfo, error) {
+	secrets, err := s.client.Secret.Query().
+		Where(secret.ExpiresAtLTE(deadline), secret.ExpiryNotifiedAtIsNil()).
+		WithKey().
+		Order(ent.Asc(secret.FieldExpiresAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list expiring secrets: %w", err)
+	}
+	result := make([]*SecretInfo, len(secrets))
+	for i, sec := range secrets {
+		result[i] = toSecretInfo(sec)
+	}
+	return result, nil
+}
+
+// MarkExpiryNotified records that the expiry warning for a secret was sent.
+func (s *SecretsStore) MarkExpiryNotified(ctx context.Context, name string) error {
+	if _, err := s.client.Secret.Update().
+		Where(secret.NameEQ(name)).
+		SetExpiryNotifiedAt(time.Now()).
+		Save(ctx); err != nil {
+		return fmt.Errorf("mark expiry notified: %w", err)
+	}
+	return nil
+}
+
+// RecordAccess writes a resolved secret reference to the audit log.
+func (s *SecretsStore) RecordAccess(ctx context.Context, a SecretAccess) error {
+	builder := s.client.AuditLog.Create().
+		SetAction(auditlog.ActionSecretAccess).
+		SetActor(a.Accessor).
+		SetTarget(a.Name).
+		SetDetails(map[string]interface{}{"kind": a.Kind})
+	if a.SessionKey != "" {
+		builder.SetSessionKey(a.SessionKey)
+	}
+	if _, err := builder.Save(ctx); err != nil {
+		return fmt.Errorf("create audit log: %w", err)
+	}
+	return nil
+}
+
+// AccessLog returns the recorded accesses to a secret, newest first. An
+// empty name returns the accesses to all secrets; limit <= 0 returns all.
+func (s *SecretsStore) AccessLog(ctx context.Context, name string, limit int) ([]SecretAccess, error) {
+	query := s.client.AuditLog.Query().
+		Where(auditlog.ActionEQ(auditlog.ActionSecretAccess)).
+		Order(ent.Desc(auditlog.FieldTimestamp))
+	if name != "" {
+		query = query.Where(auditlog.Target(name))
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	rows, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query secret access log: %w", err)
+	}
+
+	result := make([]SecretAccess, len(rows))
+	for i, row := range rows {
+		kind, _ := row.Details["kind"].(string)
+		result[i] = SecretAccess{
+			Time:       row.Timestamp,
+			Name:       row.Target,
+			Kind:       kind,
+			Accessor:   row.Actor,
+			SessionKey: row.SessionKey,
+		}
+	}
+	return result, nil
+}
+
 // Get retrieves and decrypts a secret value.
 func (s *SecretsStore) Get(ctx context.Context, name string) ([]byte, error) {
 	// Query secret with key edge
@@ -108,10 +488,9 @@ func (s *SecretsStore) Get(ctx context.Context, name string) ([]byte, error) {
 		return nil, fmt.Errorf("secret has no associated key")
 	}
 
-	// Decrypt the value
-	decrypted, err := s.crypto.Decrypt(ctx, keyEntity.RemoteKeyID, sec.EncryptedValue)
+	decrypted, err := s.open(ctx, name, keyEntity.RemoteKeyID, sec.EncryptedValue)
 	if err != nil {
-		return nil, fmt.Errorf("decrypt secret: %w", err)
+		return nil, err
 	}
 
 	// Increment access count
@@ -136,24 +515,25 @@ func (s *SecretsStore) List(ctx context.Context) ([]*SecretInfo, error) {
 
 	result := make([]*SecretInfo, len(secrets))
 	for i, sec := range secrets {
-		info := &SecretInfo{
-			ID:          sec.ID,
-			Name:        sec.Name,
-			CreatedAt:   sec.CreatedAt,
-			UpdatedAt:   sec.UpdatedAt,
-			AccessCount: sec.AccessCount,
-		}
-		if sec.Edges.Key != nil {
-			info.KeyID = sec.Edges.Key.ID
-			info.KeyName = sec.Edges.Key.Name
-		}
-		result[i] = info
+		result[i] = toSecretInfo(sec)
 	}
 
 	return result, nil
 }
 
-// Delete removes a secret by name.
+// Info returns the metadata of a secret.
+func (s *SecretsStore) Info(ctx context.Context, name string) (*SecretInfo, error) {
+	sec, err := s.client.Secret.Query().Where(secret.NameEQ(name)).WithKey().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("secret not found: %s", name)
+		}
+		return nil, fmt.Errorf("get secret: %w", err)
+	}
+	return toSecretInfo(sec), nil
+}
+
+// Delete removes a secret by name, with its earlier versions.
 func (s *SecretsStore) Delete(ctx context.Context, name string) error {
 	deleted, err := s.client.Secret.Delete().Where(secret.NameEQ(name)).Exec(ctx)
 	if err != nil {
@@ -162,5 +542,33 @@ func (s *SecretsStore) Delete(ctx context.Context, name string) error {
 	if deleted == 0 {
 		return fmt.Errorf("secret not found: %s", name)
 	}
+	if _, err := s.client.SecretVersion.Delete().Where(secretversion.SecretName(name)).Exec(ctx); err != nil {
+		return fmt.Errorf("delete secret versions: %w", err)
+	}
+	if s.backend != nil {
+		if err := s.backend.Delete(ctx, name); err != nil {
+			return fmt.Errorf("delete secret from backend: %w", err)
+		}
+	}
 	return nil
 }
+
+func toSecretInfo(sec *ent.Secret) *SecretInfo {
+	info := &SecretInfo{
+		ID:               sec.ID,
+		Name:             sec.Name,
+		CreatedAt:        sec.CreatedAt,
+		UpdatedAt:        sec.UpdatedAt,
+		AccessCount:      sec.AccessCount,
+		Version:          sec.Version,
+		Owner:            sec.Owner,
+		ExpiresAt:        sec.ExpiresAt,
+		RotationInterval: sec.RotationInterval,
+		RotatedAt:        sec.RotatedAt,
+	}
+	if sec.Edges.Key != nil {
+		info.KeyID = sec.Edges.Key.ID
+		info.KeyName = sec.Edges.Key.Name
+	}
+	return info
+}
@@ -0,0 +1,247 @@
+package security
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/langoai/lango/internal/ent/enttest"
+)
+
+func newTestSecretsStore(t *testing.T) *SecretsStore {
+	t.Helper()
+	// A file database, so transactions see the same data on any connection.
+	client := enttest.Open(t, "sqlite3", "file:"+filepath.Join(t.TempDir(), "secrets.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	crypto := NewLocalCryptoProvider()
+	if err := crypto.Initialize("test-passphrase-12345"); err != nil {
+		t.Fatalf("initialize crypto: %v", err)
+	}
+	registry := NewKeyRegistry(client)
+	if _, err := registry.RegisterKey(context.Background(), "default", "local", KeyTypeEncryption); err != nil {
+		t.Fatalf("register key: %v", err)
+	}
+	return NewSecretsStore(client, registry, crypto)
+}
+
+func mustGet(t *testing.T, s *SecretsStore, name string) string {
+	t.Helper()
+	val, err := s.Get(context.Background(), name)
+	if err != nil {
+		t.Fatalf("get %s: %v", name, err)
+	}
+	return string(val)
+}
+
+func TestSecretsStore_RotateAndRollback(t *testing.T) {
+	s := newTestSecretsStore(t)
+	ctx := context.Background()
+
+	if err := s.Rotate(ctx, "api-key", []byte("v1")); err == nil {
+		t.Error("expected error rotating a missing secret")
+	}
+	if err := s.Store(ctx, "api-key", []byte("v1")); err != nil {
+		t.Fatalf("store: %v", err)
+	}
+	if err := s.Rotate(ctx, "api-key", []byte("v2")); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	if err := s.Rotate(ctx, "api-key", []byte("v3")); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	info, err := s.Info(ctx, "api-key")
+	if err != nil {
+		t.Fatalf("info: %v", err)
+	}
+	if info.Version != 3 {
+		t.Errorf("Version = %d, want 3", info.Version)
+	}
+	if info.RotatedAt == nil {
+		t.Error("RotatedAt not set")
+	}
+	if got := mustGet(t, s, "api-key"); got != "v3" {
+		t.Errorf("value = %q, want %q", got, "v3")
+	}
+
+	versions, err := s.Versions(ctx, "api-key")
+	if err != nil {
+		t.Fatalf("versions: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 2 || versions[1].Version != 1 {
+		t.Fatalf("versions = %+v, want 2 and 1", versions)
+	}
+
+	if err := s.Rollback(ctx, "api-key", 1); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+	if got := mustGet(t, s, "api-key"); got != "v1" {
+		t.Errorf("value after rollback = %q, want %q", got, "v1")
+	}
+	info, _ = s.Info(ctx, "api-key")
+	if info.Version != 4 {
+		t.Errorf("Version after rollback = %d, want 4", info.Version)
+	}
+
+	if err := s.Rollback(ctx, "api-key", 9); err == nil {
+		t.Error("expected error rolling back to a missing version")
+	}
+}
+
+func TestSecretsStore_KeepVersions(t *testing.T) {
+	s := newTestSecretsStore(t)
+	s.SetKeepVersions(2)
+	ctx := context.Background()
+
+	for _, v := range []string{"v1", "v2", "v3", "v4"} {
+		if err := s.Store(ctx, "token", []byte(v)); err != nil {
+			t.Fatalf("store %s: %v", v, err)
+		}
+	}
+
+	versions, err := s.Versions(ctx, "token")
+	if err != nil {
+		t.Fatalf("versions: %v", err)
+	}
+	if len(versions) != 2 || versions[0].Version != 3 || versions[1].Version != 2 {
+		t.Errorf("versions = %+v, want 3 and 2", versions)
+	}
+
+	if err := s.Delete(ctx, "token"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if versions, _ := s.Versions(ctx, "token"); len(versions) != 0 {
+		t.Errorf("versions after delete = %d, want 0", len(versions))
+	}
+}
+
+func TestSecretsStore_StoreIfChanged(t *testing.T) {
+	s := newTestSecretsStore(t)
+	ctx := context.Background()
+
+	for _, v := range []string{"v1", "v1", "v2", "v2"} {
+		if err := s.StoreIfChanged(ctx, "token", []byte(v)); err != nil {
+			t.Fatalf("store %s: %v", v, err)
+		}
+	}
+
+	info, err := s.Info(ctx, "token")
+	if err != nil {
+		t.Fatalf("info: %v", err)
+	}
+	if info.Version != 2 {
+		t.Errorf("Version = %d, want 2", info.Version)
+	}
+	versions, err := s.Versions(ctx, "token")
+	if err != nil {
+		t.Fatalf("versions: %v", err)
+	}
+	if len(versions) != 1 || versions[0].Version != 1 {
+		t.Errorf("versions = %+v, want 1", versions)
+	}
+	if got := mustGet(t, s, "token"); got != "v2" {
+		t.Errorf("value = %q, want %q", got, "v2")
+	}
+}
+
+func TestSecretsStore_Expiry(t *testing.T) {
+	s := newTestSecretsStore(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	for _, name := range []string{"soon", "later", "never"} {
+		if err := s.Store(ctx, name, []byte("value")); err != nil {
+			t.Fatalf("store %s: %v", name, err)
+		}
+	}
+	soon := now.Add(24 * time.Hour)
+	later := now.Add(90 * 24 * time.Hour)
+	if err := s.SetMeta(ctx, "soon", SecretMeta{Owner: "ops", ExpiresAt: &soon, RotationInterval: 30 * 24 * time.Hour}); err != nil {
+		t.Fatalf("set meta: %v", err)
+	}
+	if err := s.SetMeta(ctx, "later", SecretMeta{ExpiresAt: &later}); err != nil {
+		t.Fatalf("set meta: %v", err)
+	}
+	if err := s.SetMeta(ctx, "missing", SecretMeta{}); err == nil {
+		t.Error("expected error setting metadata of a missing secret")
+	}
+
+	expiring, err := s.Expiring(ctx, now.Add(7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("expiring: %v", err)
+	}
+	if len(expiring) != 1 || expiring[0].Name != "soon" || expiring[0].Owner != "ops" {
+		t.Fatalf("expiring = %+v, want only soon", expiring)
+	}
+
+	if err := s.MarkExpiryNotified(ctx, "soon"); err != nil {
+		t.Fatalf("mark notified: %v", err)
+	}
+	if expiring, _ := s.Expiring(ctx, now.Add(7*24*time.Hour)); len(expiring) != 0 {
+		t.Errorf("expiring after notify = %d, want 0", len(expiring))
+	}
+
+	// Rotation moves the expiry one interval ahead and re-arms the warning.
+	if err := s.Rotate(ctx, "soon", []byte("new")); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+	info, _ := s.Info(ctx, "soon")
+	if info.ExpiresAt == nil || info.ExpiresAt.Before(now.Add(29*24*time.Hour)) {
+		t.Errorf("ExpiresAt after rotate = %v, want about 30 days ahead", info.ExpiresAt)
+	}
+	if expiring, _ := s.Expiring(ctx, now.Add(31*24*time.Hour)); len(expiring) != 1 {
+		t.Errorf("expiring after rotate = %d, want 1", len(expiring))
+	}
+}
+
+func TestSecretsStore_AccessLog(t *testing.T) {
+	s := newTestSecretsStore(t)
+	ctx := context.Background()
+
+	accesses := []SecretAccess{
+		{Name: "api-key", Kind: "secret", Accessor: "exec", SessionKey: "telegram:1"},
+		{Name: "db-pass", Kind: "secret", Accessor: "exec_bg"},
+		{Name: "api-key", Kind: "secret", Accessor: "exec"},
+	}
+	for _, a := range accesses {
+		if err := s.RecordAccess(ctx, a); err != nil {
+			t.Fatalf("record access: %v", err)
+		}
+	}
+
+	all, err := s.AccessLog(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("access log: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("all accesses = %d, want 3", len(all))
+	}
+
+	got, err := s.AccessLog(ctx, "api-key", 0)
+	if err != nil {
+		t.Fatalf("access log: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("api-key accesses = %d, want 2", len(got))
+	}
+	var withSession int
+	for _, a := range got {
+		if a.Accessor != "exec" || a.Kind != "secret" {
+			t.Errorf("access = %+v, want exec/secret", a)
+		}
+		if a.SessionKey == "telegram:1" {
+			withSession++
+		}
+	}
+	if withSession != 1 {
+		t.Errorf("accesses with session = %d, want 1", withSession)
+	}
+
+	if limited, _ := s.AccessLog(ctx, "", 1); len(limited) != 1 {
+		t.Errorf("limited accesses = %d, want 1", len(limited))
+	}
+}
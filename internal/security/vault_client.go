@@ -0,0 +1,273 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// vaultClient is a minimal client for the HashiCorp Vault / OpenBao HTTP API.
+// It authenticates with a token or AppRole and retries transient errors.
+type vaultClient struct {
+	addr       string
+	namespace  string
+	http       *http.Client
+	maxRetries int
+	timeout    time.Duration
+
+	authMethod   string
+	roleID       string
+	secretID     string
+	appRoleMount string
+
+	mu    sync.Mutex
+	token string
+}
+
+// vaultStatusError is an error response of the Vault API.
+type vaultStatusError struct {
+	StatusCode int
+	Errors     []string
+}
+
+func (e *vaultStatusError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("vault: HTTP %d", e.StatusCode)
+	}
+	return fmt.Sprintf("vault: HTTP %d: %s", e.StatusCode, strings.Join(e.Errors, "; "))
+}
+
+// newVaultClient creates a client from the KMS config. Address, token,
+// namespace and AppRole secret ID are taken from the config and fall back to
+// the VAULT_ADDR, VAULT_TOKEN, VAULT_NAMESPACE and LANGO_VAULT_SECRET_ID
+// environment variables.
+func newVaultClient(kmsConfig config.KMSConfig) (*vaultClient, error) {
+	vc := kmsConfig.Vault
+	addr := firstNonEmpty(vc.Address, os.Getenv("VAULT_ADDR"))
+	if addr == "" {
+		return nil, fmt.Errorf("new vault client: address is required (security.kms.vault.address or VAULT_ADDR)")
+	}
+	if _, err := url.Parse(addr); err != nil {
+		return nil, fmt.Errorf("new vault client: invalid address %q: %w", addr, err)
+	}
+
+	c := &vaultClient{
+		addr:         strings.TrimRight(addr, "/"),
+		namespace:    firstNonEmpty(vc.Namespace, os.Getenv("VAULT_NAMESPACE")),
+		http:         &http.Client{},
+		maxRetries:   kmsConfig.MaxRetries,
+		timeout:      kmsConfig.TimeoutPerOperation,
+		authMethod:   firstNonEmpty(vc.AuthMethod, "token"),
+		appRoleMount: firstNonEmpty(vc.AppRoleMount, "approle"),
+	}
+	if c.maxRetries <= 0 {
+		c.maxRetries = 3
+	}
+	if c.timeout <= 0 {
+		c.timeout = 5 * time.Second
+	}
+
+	switch c.authMethod {
+	case "token":
+		c.token = firstNonEmpty(vc.Token, os.Getenv("VAULT_TOKEN"))
+		if c.token == "" {
+			return nil, fmt.Errorf("new vault client: token is required (security.kms.vault.token or VAULT_TOKEN)")
+		}
+	case "approle":
+		c.roleID = vc.RoleID
+		c.secretID = firstNonEmpty(vc.SecretID, os.Getenv("LANGO_VAULT_SECRET_ID"))
+		if c.roleID == "" {
+			return nil, fmt.Errorf("new vault client: roleId is required for approle auth")
+		}
+	default:
+		return nil, fmt.Errorf("new vault client: unknown auth method %q (supported: token, approle)", c.authMethod)
+	}
+	return c, nil
+}
+
+// do sends a request to the API path below /v1/ and decodes the JSON
+// response into out. Errors are classified as KMSError for op on keyID and
+// transient ones are retried; with AppRole auth an expired token is renewed
+// once.
+func (c *vaultClient) do(ctx context.Context, op, keyID, method, path string, query url.Values, body, out interface{}) error {
+	return withRetry(ctx, c.maxRetries, func() error {
+		token, err := c.currentToken(ctx)
+		if err != nil {
+			return c.classifyError(op, keyID, err)
+		}
+		err = c.send(ctx, method, path, query, token, body, out)
+		var se *vaultStatusError
+		if c.authMethod == "approle" && errors.As(err, &se) && se.StatusCode == http.StatusForbidden {
+			if token, err = c.login(ctx); err != nil {
+				return c.classifyError(op, keyID, err)
+			}
+			err = c.send(ctx, method, path, query, token, body, out)
+		}
+		if err != nil {
+			return c.classifyError(op, keyID, err)
+		}
+		return nil
+	})
+}
+
+// currentToken returns the token, logging in with AppRole if there is none.
+func (c *vaultClient) currentToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	token := c.token
+	c.mu.Unlock()
+	if token != "" {
+		return token, nil
+	}
+	return c.login(ctx)
+}
+
+// login authenticates with AppRole and keeps the new token.
+func (c *vaultClient) login(ctx context.Context) (string, error) {
+	var resp struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	body := map[string]string{"role_id": c.roleID}
+	if c.secretID != "" {
+		body["secret_id"] = c.secretID
+	}
+	if err := c.send(ctx, http.MethodPost, vaultPath("auth", c.appRoleMount, "login"), nil, "", body, &resp); err != nil {
+		return "", fmt.Errorf("approle login: %w", err)
+	}
+	if resp.Auth.ClientToken == "" {
+		return "", fmt.Errorf("approle login: no token in response")
+	}
+
+	c.mu.Lock()
+	c.token = resp.Auth.ClientToken
+	c.mu.Unlock()
+	return resp.Auth.ClientToken, nil
+}
+
+func (c *vaultClient) send(ctx context.Context, method, path string, query url.Values, token string, body, out interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	u := c.addr + "/v1/" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if c.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.namespace)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		se := &vaultStatusError{StatusCode: resp.StatusCode}
+		var payload struct {
+			Errors []string `json:"errors"`
+		}
+		if json.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&payload) == nil {
+			se.Errors = payload.Errors
+		}
+		return se
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+// classifyError maps Vault errors to sentinel errors wrapped in KMSError.
+func (c *vaultClient) classifyError(op, keyID string, err error) error {
+	var kmsErr *KMSError
+	if errors.As(err, &kmsErr) {
+		return err
+	}
+	kmsErr = &KMSError{
+		Provider: "vault",
+		Op:       op,
+		KeyID:    keyID,
+	}
+
+	var se *vaultStatusError
+	var urlErr *url.Error
+	switch {
+	case errors.As(err, &se):
+		switch {
+		case se.StatusCode == http.StatusUnauthorized || se.StatusCode == http.StatusForbidden:
+			kmsErr.Err = fmt.Errorf("%w: %s", ErrKMSAccessDenied, err)
+		case se.StatusCode == http.StatusNotFound:
+			kmsErr.Err = fmt.Errorf("%w: %s", ErrKeyNotFound, err)
+		case se.StatusCode == http.StatusTooManyRequests:
+			kmsErr.Err = fmt.Errorf("%w: %s", ErrKMSThrottled, err)
+		case se.StatusCode >= 500:
+			// 503 also covers a sealed or standby server.
+			kmsErr.Err = fmt.Errorf("%w: %s", ErrKMSUnavailable, err)
+		default:
+			kmsErr.Err = err
+		}
+	case errors.As(err, &urlErr) && !errors.Is(err, context.Canceled):
+		// Network errors and timeouts.
+		kmsErr.Err = fmt.Errorf("%w: %s", ErrKMSUnavailable, err)
+	default:
+		kmsErr.Err = err
+	}
+	return kmsErr
+}
+
+// vaultPath joins API path segments, escaping each part of every segment.
+func vaultPath(segments ...string) string {
+	var parts []string
+	for _, seg := range segments {
+		for _, p := range strings.Split(strings.Trim(seg, "/"), "/") {
+			if p != "" {
+				parts = append(parts, url.PathEscape(p))
+			}
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,118 @@
+package security
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// vaultKVRefPrefix marks the secrets table values that refer to a KV version.
+const vaultKVRefPrefix = "vault-kv:v"
+
+// VaultKV is a SecretBackend that stores secret values in the KV v2 secrets
+// engine of HashiCorp Vault or OpenBao, below <kvMount>/<kvPrefix>/<name>.
+// Text values are stored in the "value" field, binary values base64-encoded
+// in "value_base64".
+type VaultKV struct {
+	client *vaultClient
+	mount  string
+	prefix string
+}
+
+var _ SecretBackend = (*VaultKV)(nil)
+
+// NewVaultKV creates a KV v2 secret backend from the KMS config.
+func NewVaultKV(kmsConfig config.KMSConfig) (*VaultKV, error) {
+	client, err := newVaultClient(kmsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("new Vault KV backend: %w", err)
+	}
+	kv := &VaultKV{
+		client: client,
+		mount:  firstNonEmpty(kmsConfig.Vault.KVMount, "secret"),
+		prefix: kmsConfig.Vault.KVPrefix,
+	}
+	vaultLogger.Infow("Vault KV secret backend initialized",
+		"address", client.addr,
+		"mount", kv.mount,
+		"prefix", kv.prefix,
+	)
+	return kv, nil
+}
+
+// Put writes value as a new KV version of name and returns a reference to
+// that version.
+func (kv *VaultKV) Put(ctx context.Context, name string, value []byte) ([]byte, error) {
+	data := map[string]string{"value": string(value)}
+	if !utf8.Valid(value) {
+		data = map[string]string{"value_base64": base64.StdEncoding.EncodeToString(value)}
+	}
+
+	var resp struct {
+		Data struct {
+			Version int `json:"version"`
+		} `json:"data"`
+	}
+	body := map[string]interface{}{"data": data}
+	if err := kv.client.do(ctx, "kv-put", name, http.MethodPost, kv.path("data", name), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Version <= 0 {
+		return nil, &KMSError{Provider: "vault", Op: "kv-put", KeyID: name, Err: fmt.Errorf("no version in response")}
+	}
+	return []byte(vaultKVRefPrefix + strconv.Itoa(resp.Data.Version)), nil
+}
+
+// Get reads the KV version ref refers to. It reports false for values the
+// backend did not write, such as secrets stored before the backend was
+// enabled.
+func (kv *VaultKV) Get(ctx context.Context, name string, ref []byte) ([]byte, bool, error) {
+	if !IsVaultKVRef(ref) {
+		return nil, false, nil
+	}
+	version, err := strconv.Atoi(string(ref[len(vaultKVRefPrefix):]))
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid Vault KV reference %q", ref)
+	}
+
+	var resp struct {
+		Data struct {
+			Data map[string]string `json:"data"`
+		} `json:"data"`
+	}
+	query := url.Values{"version": {strconv.Itoa(version)}}
+	if err := kv.client.do(ctx, "kv-get", name, http.MethodGet, kv.path("data", name), query, nil, &resp); err != nil {
+		return nil, true, err
+	}
+	if v, ok := resp.Data.Data["value"]; ok {
+		return []byte(v), true, nil
+	}
+	if v, ok := resp.Data.Data["value_base64"]; ok {
+		value, err := base64.StdEncoding.DecodeString(v)
+		return value, true, err
+	}
+	// A deleted or destroyed version has no data.
+	return nil, true, fmt.Errorf("version %d of %s has no value in Vault", version, name)
+}
+
+// Delete removes name with all its KV versions.
+func (kv *VaultKV) Delete(ctx context.Context, name string) error {
+	return kv.client.do(ctx, "kv-delete", name, http.MethodDelete, kv.path("metadata", name), nil, nil, nil)
+}
+
+// IsVaultKVRef reports whether a value of the secrets table is a reference
+// to a Vault KV version rather than a ciphertext.
+func IsVaultKVRef(stored []byte) bool {
+	return bytes.HasPrefix(stored, []byte(vaultKVRefPrefix))
+}
+
+func (kv *VaultKV) path(kind, name string) string {
+	return vaultPath(kv.mount, kind, kv.prefix, name)
+}
@@ -0,0 +1,130 @@
+package security
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/logging"
+)
+
+var vaultLogger = logging.SubsystemSugar("vault")
+
+// VaultProvider implements CryptoProvider using the Transit secrets engine
+// of HashiCorp Vault or OpenBao. It talks to the HTTP API directly, so it
+// needs no build tag.
+type VaultProvider struct {
+	client         *vaultClient
+	mount          string
+	defaultKeyName string
+}
+
+var _ CryptoProvider = (*VaultProvider)(nil)
+
+func newVaultProvider(kmsConfig config.KMSConfig) (CryptoProvider, error) {
+	if kmsConfig.KeyID == "" {
+		return nil, fmt.Errorf("new Vault provider: %w", ErrKMSInvalidKey)
+	}
+	client, err := newVaultClient(kmsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("new Vault provider: %w", err)
+	}
+	mount := firstNonEmpty(kmsConfig.Vault.TransitMount, "transit")
+
+	vaultLogger.Infow("Vault Transit provider initialized",
+		"address", client.addr,
+		"mount", mount,
+		"keyId", kmsConfig.KeyID,
+		"authMethod", client.authMethod,
+		"maxRetries", client.maxRetries,
+	)
+
+	return &VaultProvider{
+		client:         client,
+		mount:          mount,
+		defaultKeyName: kmsConfig.KeyID,
+	}, nil
+}
+
+// Sign signs the payload with the Transit key. The key type decides the
+// algorithm (e.g. ecdsa-p256 gives an ASN.1 DER ECDSA signature over the
+// SHA-256 digest). The raw signature is returned without Vault's
+// "vault:vN:" prefix.
+func (p *VaultProvider) Sign(ctx context.Context, keyID string, payload []byte) ([]byte, error) {
+	resolved := p.resolveKey(keyID)
+
+	var resp struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	body := map[string]string{"input": base64.StdEncoding.EncodeToString(payload)}
+	if err := p.client.do(ctx, "sign", resolved, http.MethodPost, vaultPath(p.mount, "sign", resolved), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	sig, err := decodeVaultValue(resp.Data.Signature)
+	if err != nil {
+		return nil, &KMSError{Provider: "vault", Op: "sign", KeyID: resolved, Err: err}
+	}
+	return sig, nil
+}
+
+// Encrypt encrypts plaintext with the Transit key. The result is Vault's
+// ciphertext string ("vault:vN:..."), which records the key version.
+func (p *VaultProvider) Encrypt(ctx context.Context, keyID string, plaintext []byte) ([]byte, error) {
+	resolved := p.resolveKey(keyID)
+
+	var resp struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	body := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(plaintext)}
+	if err := p.client.do(ctx, "encrypt", resolved, http.MethodPost, vaultPath(p.mount, "encrypt", resolved), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.Ciphertext == "" {
+		return nil, &KMSError{Provider: "vault", Op: "encrypt", KeyID: resolved, Err: fmt.Errorf("empty ciphertext in response")}
+	}
+	return []byte(resp.Data.Ciphertext), nil
+}
+
+// Decrypt decrypts a ciphertext produced by Encrypt.
+func (p *VaultProvider) Decrypt(ctx context.Context, keyID string, ciphertext []byte) ([]byte, error) {
+	resolved := p.resolveKey(keyID)
+
+	var resp struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	body := map[string]string{"ciphertext": string(ciphertext)}
+	if err := p.client.do(ctx, "decrypt", resolved, http.MethodPost, vaultPath(p.mount, "decrypt", resolved), nil, body, &resp); err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Data.Plaintext)
+	if err != nil {
+		return nil, &KMSError{Provider: "vault", Op: "decrypt", KeyID: resolved, Err: fmt.Errorf("decode plaintext: %w", err)}
+	}
+	return plaintext, nil
+}
+
+// resolveKey maps "local" and "default" aliases to the configured default key.
+func (p *VaultProvider) resolveKey(keyID string) string {
+	if keyID == "local" || keyID == "default" || keyID == "" {
+		return p.defaultKeyName
+	}
+	return keyID
+}
+
+// decodeVaultValue decodes a "vault:vN:<base64>" value.
+func decodeVaultValue(v string) ([]byte, error) {
+	i := strings.LastIndexByte(v, ':')
+	if !strings.HasPrefix(v, "vault:") || i < 0 {
+		return nil, fmt.Errorf("unexpected value format %q", v)
+	}
+	return base64.StdEncoding.DecodeString(v[i+1:])
+}
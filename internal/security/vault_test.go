@@ -0,0 +1,399 @@
+package security
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/config"
+)
+
+// fakeVault is a stand-in for a dev-mode Vault server with the Transit
+// engine at transit/, KV v2 at secret/ and AppRole auth at approle/.
+type fakeVault struct {
+	mu       sync.Mutex
+	tokens   map[string]bool
+	roleID   string
+	secretID string
+	kv       map[string][]map[string]string
+	failNext int // requests to answer with 503
+	logins   int
+	requests int
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, *httptest.Server) {
+	t.Helper()
+	fv := &fakeVault{
+		tokens:   map[string]bool{"root": true},
+		roleID:   "role-1",
+		secretID: "secret-1",
+		kv:       make(map[string][]map[string]string),
+	}
+	srv := httptest.NewServer(fv)
+	t.Cleanup(srv.Close)
+	return fv, srv
+}
+
+func (fv *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	fv.mu.Lock()
+	defer fv.mu.Unlock()
+	fv.requests++
+
+	if fv.failNext > 0 {
+		fv.failNext--
+		writeVaultJSON(w, http.StatusServiceUnavailable, map[string]interface{}{"errors": []string{"Vault is sealed"}})
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	raw, _ := io.ReadAll(r.Body)
+	var body map[string]string
+	_ = json.Unmarshal(raw, &body) // fails harmlessly for KV writes
+
+	if path == "auth/approle/login" {
+		if body["role_id"] != fv.roleID || body["secret_id"] != fv.secretID {
+			writeVaultJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{"invalid role or secret ID"}})
+			return
+		}
+		fv.logins++
+		token := "approle-" + strconv.Itoa(fv.logins)
+		fv.tokens[token] = true
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"auth": map[string]string{"client_token": token}})
+		return
+	}
+	if !fv.tokens[r.Header.Get("X-Vault-Token")] {
+		writeVaultJSON(w, http.StatusForbidden, map[string]interface{}{"errors": []string{"permission denied"}})
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(path, "transit/"):
+		fv.serveTransit(w, strings.TrimPrefix(path, "transit/"), body)
+	case strings.HasPrefix(path, "secret/data/"):
+		fv.serveKVData(w, r, strings.TrimPrefix(path, "secret/data/"), raw)
+	case strings.HasPrefix(path, "secret/metadata/") && r.Method == http.MethodDelete:
+		delete(fv.kv, strings.TrimPrefix(path, "secret/metadata/"))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeVaultJSON(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+	}
+}
+
+// serveTransit "encrypts" by reversing the plaintext, which is enough to
+// tell ciphertext from plaintext.
+func (fv *fakeVault) serveTransit(w http.ResponseWriter, path string, body map[string]string) {
+	op, key, _ := strings.Cut(path, "/")
+	if key != "lango-key" {
+		writeVaultJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{"encryption key not found"}})
+		return
+	}
+	switch op {
+	case "encrypt":
+		plaintext, _ := base64.StdEncoding.DecodeString(body["plaintext"])
+		ciphertext := "vault:v1:" + base64.StdEncoding.EncodeToString(reversed(plaintext))
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]string{"ciphertext": ciphertext}})
+	case "decrypt":
+		raw, err := decodeVaultValue(body["ciphertext"])
+		if err != nil {
+			writeVaultJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{"invalid ciphertext"}})
+			return
+		}
+		plaintext := base64.StdEncoding.EncodeToString(reversed(raw))
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]string{"plaintext": plaintext}})
+	case "sign":
+		input, _ := base64.StdEncoding.DecodeString(body["input"])
+		sum := sha256.Sum256(input)
+		sig := "vault:v1:" + base64.StdEncoding.EncodeToString(sum[:])
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]string{"signature": sig}})
+	default:
+		writeVaultJSON(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+	}
+}
+
+func (fv *fakeVault) serveKVData(w http.ResponseWriter, r *http.Request, name string, raw []byte) {
+	switch r.Method {
+	case http.MethodPost, http.MethodPut:
+		var req struct {
+			Data map[string]string `json:"data"`
+		}
+		if err := json.Unmarshal(raw, &req); err != nil || req.Data == nil {
+			writeVaultJSON(w, http.StatusBadRequest, map[string]interface{}{"errors": []string{"no data provided"}})
+			return
+		}
+		fv.kv[name] = append(fv.kv[name], req.Data)
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{"data": map[string]int{"version": len(fv.kv[name])}})
+	case http.MethodGet:
+		versions := fv.kv[name]
+		v, err := strconv.Atoi(r.URL.Query().Get("version"))
+		if err != nil || v == 0 {
+			v = len(versions)
+		}
+		if v < 1 || v > len(versions) {
+			writeVaultJSON(w, http.StatusNotFound, map[string]interface{}{"errors": []string{}})
+			return
+		}
+		writeVaultJSON(w, http.StatusOK, map[string]interface{}{
+			"data": map[string]interface{}{"data": versions[v-1]},
+		})
+	default:
+		writeVaultJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{"errors": []string{}})
+	}
+}
+
+func reversed(b []byte) []byte {
+	out := make([]byte, len(b))
+	for i := range b {
+		out[len(b)-1-i] = b[i]
+	}
+	return out
+}
+
+func writeVaultJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func testVaultConfig(addr string) config.KMSConfig {
+	return config.KMSConfig{
+		KeyID:      "lango-key",
+		MaxRetries: 2,
+		Vault: config.VaultConfig{
+			Address:    addr,
+			AuthMethod: "token",
+			Token:      "root",
+			KVPrefix:   "lango",
+		},
+	}
+}
+
+func TestVaultProvider_EncryptDecryptSign(t *testing.T) {
+	_, srv := newFakeVault(t)
+	p, err := NewKMSProvider(KMSProviderVault, testVaultConfig(srv.URL))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ciphertext, err := p.Encrypt(ctx, "default", []byte("hello"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(ciphertext), "vault:v1:"))
+
+	plaintext, err := p.Decrypt(ctx, "lango-key", ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(plaintext))
+
+	sig, err := p.Sign(ctx, "local", []byte("payload"))
+	require.NoError(t, err)
+	want := sha256.Sum256([]byte("payload"))
+	assert.Equal(t, want[:], sig)
+}
+
+func TestVaultProvider_RequiresKeyID(t *testing.T) {
+	cfg := testVaultConfig("http://127.0.0.1:8200")
+	cfg.KeyID = ""
+	_, err := NewKMSProvider(KMSProviderVault, cfg)
+	assert.ErrorIs(t, err, ErrKMSInvalidKey)
+}
+
+func TestVaultProvider_RetriesUnavailable(t *testing.T) {
+	fv, srv := newFakeVault(t)
+	p, err := NewKMSProvider(KMSProviderVault, testVaultConfig(srv.URL))
+	require.NoError(t, err)
+
+	fv.failNext = 2
+	_, err = p.Encrypt(context.Background(), "default", []byte("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, fv.requests)
+
+	fv.failNext = 5
+	_, err = p.Encrypt(context.Background(), "default", []byte("hello"))
+	assert.ErrorIs(t, err, ErrKMSUnavailable)
+}
+
+func TestVaultProvider_ErrorClassification(t *testing.T) {
+	_, srv := newFakeVault(t)
+
+	cfg := testVaultConfig(srv.URL)
+	cfg.Vault.Token = "wrong"
+	p, err := NewKMSProvider(KMSProviderVault, cfg)
+	require.NoError(t, err)
+	_, err = p.Encrypt(context.Background(), "default", []byte("x"))
+	assert.ErrorIs(t, err, ErrKMSAccessDenied)
+	assert.False(t, IsTransient(err))
+
+	var kmsErr *KMSError
+	require.True(t, errors.As(err, &kmsErr))
+	assert.Equal(t, "vault", kmsErr.Provider)
+	assert.Equal(t, "encrypt", kmsErr.Op)
+	assert.Equal(t, "lango-key", kmsErr.KeyID)
+
+	cfg = testVaultConfig(srv.URL)
+	cfg.Vault.TransitMount = "missing"
+	p, err = NewKMSProvider(KMSProviderVault, cfg)
+	require.NoError(t, err)
+	_, err = p.Encrypt(context.Background(), "default", []byte("x"))
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestVaultClient_AppRole(t *testing.T) {
+	fv, srv := newFakeVault(t)
+	cfg := testVaultConfig(srv.URL)
+	cfg.Vault = config.VaultConfig{
+		Address:    srv.URL,
+		AuthMethod: "approle",
+		RoleID:     "role-1",
+		SecretID:   "secret-1",
+	}
+	p, err := NewKMSProvider(KMSProviderVault, cfg)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = p.Encrypt(ctx, "default", []byte("x"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, fv.logins)
+
+	// An expired token is renewed with a new login.
+	fv.mu.Lock()
+	fv.tokens = map[string]bool{}
+	fv.mu.Unlock()
+	_, err = p.Encrypt(ctx, "default", []byte("x"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, fv.logins)
+
+	cfg.Vault.SecretID = "wrong"
+	p, err = NewKMSProvider(KMSProviderVault, cfg)
+	require.NoError(t, err)
+	_, err = p.Encrypt(ctx, "default", []byte("x"))
+	assert.Error(t, err)
+}
+
+func TestNewVaultClient_CredentialPrecedence(t *testing.T) {
+	t.Setenv("VAULT_TOKEN", "env-token")
+	t.Setenv("LANGO_VAULT_SECRET_ID", "env-secret")
+
+	tests := []struct {
+		give       config.VaultConfig
+		wantToken  string
+		wantSecret string
+	}{
+		{give: config.VaultConfig{Token: "cfg-token"}, wantToken: "cfg-token"},
+		{give: config.VaultConfig{}, wantToken: "env-token"},
+		{give: config.VaultConfig{AuthMethod: "approle", RoleID: "role-1", SecretID: "cfg-secret"}, wantSecret: "cfg-secret"},
+		{give: config.VaultConfig{AuthMethod: "approle", RoleID: "role-1"}, wantSecret: "env-secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantToken+tt.wantSecret, func(t *testing.T) {
+			tt.give.Address = "http://vault:8200"
+			c, err := newVaultClient(config.KMSConfig{Vault: tt.give})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantToken, c.token)
+			assert.Equal(t, tt.wantSecret, c.secretID)
+		})
+	}
+}
+
+func TestNewVaultClient_Validation(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "")
+	t.Setenv("VAULT_TOKEN", "")
+
+	tests := []struct {
+		give config.VaultConfig
+		want string
+	}{
+		{give: config.VaultConfig{Token: "root"}, want: "address is required"},
+		{give: config.VaultConfig{Address: "http://vault:8200"}, want: "token is required"},
+		{give: config.VaultConfig{Address: "http://vault:8200", AuthMethod: "approle"}, want: "roleId is required"},
+		{give: config.VaultConfig{Address: "http://vault:8200", AuthMethod: "ldap"}, want: "unknown auth method"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			_, err := newVaultClient(config.KMSConfig{Vault: tt.give})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
+
+func TestVaultKV_PutGetDelete(t *testing.T) {
+	fv, srv := newFakeVault(t)
+	kv, err := NewVaultKV(testVaultConfig(srv.URL))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ref1, err := kv.Put(ctx, "api-key", []byte("v1"))
+	require.NoError(t, err)
+	assert.Equal(t, "vault-kv:v1", string(ref1))
+	assert.True(t, IsVaultKVRef(ref1))
+
+	binary := []byte{0xff, 0x00, 0xfe}
+	ref2, err := kv.Put(ctx, "api-key", binary)
+	require.NoError(t, err)
+	assert.Equal(t, "vault-kv:v2", string(ref2))
+	assert.Contains(t, fv.kv["lango/api-key"][1], "value_base64")
+
+	got, ok, err := kv.Get(ctx, "api-key", ref1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "v1", string(got))
+
+	got, _, err = kv.Get(ctx, "api-key", ref2)
+	require.NoError(t, err)
+	assert.Equal(t, binary, got)
+
+	_, ok, err = kv.Get(ctx, "api-key", []byte("ciphertext"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	require.NoError(t, kv.Delete(ctx, "api-key"))
+	_, _, err = kv.Get(ctx, "api-key", ref1)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestSecretsStore_VaultBackend(t *testing.T) {
+	fv, srv := newFakeVault(t)
+	s := newTestSecretsStore(t)
+	ctx := context.Background()
+
+	// A secret stored before the backend was enabled stays readable.
+	require.NoError(t, s.Store(ctx, "legacy", []byte("old")))
+
+	backend, err := NewSecretBackend(config.SecurityConfig{
+		KMS:     testVaultConfig(srv.URL),
+		Secrets: config.SecretsConfig{Backend: "vault"},
+	})
+	require.NoError(t, err)
+	s.SetBackend(backend)
+
+	require.NoError(t, s.Store(ctx, "api-key", []byte("v1")))
+	require.NoError(t, s.Rotate(ctx, "api-key", []byte("v2")))
+	assert.Equal(t, "v2", mustGet(t, s, "api-key"))
+	assert.Equal(t, "old", mustGet(t, s, "legacy"))
+	assert.Len(t, fv.kv["lango/api-key"], 2)
+
+	require.NoError(t, s.Rollback(ctx, "api-key", 1))
+	assert.Equal(t, "v1", mustGet(t, s, "api-key"))
+	assert.Len(t, fv.kv["lango/api-key"], 3)
+
+	require.NoError(t, s.Delete(ctx, "api-key"))
+	assert.NotContains(t, fv.kv, "lango/api-key")
+}
+
+func TestNewSecretBackend(t *testing.T) {
+	backend, err := NewSecretBackend(config.SecurityConfig{})
+	require.NoError(t, err)
+	assert.Nil(t, backend)
+
+	_, err = NewSecretBackend(config.SecurityConfig{Secrets: config.SecretsConfig{Backend: "s3"}})
+	assert.Error(t, err)
+}
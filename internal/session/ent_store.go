@@ -56,6 +56,7 @@ type EntStore struct {
 	passphrase      string
 	maxHistoryTurns int
 	ttl             time.Duration
+	scrub           types.ScrubFunc
 }
 
 // NewEntStore creates a new ent-backed session store
@@ -145,6 +146,12 @@ func (s *EntStore) Client() *ent.Client {
 	return s.client
 }
 
+// DB returns the underlying database handle, or nil when the store was
+// created from an existing client via NewEntStoreWithClient.
+func (s *EntStore) DB() *sql.DB {
+	return s.db
+}
+
 // Create creates a new session
 func (s *EntStore) Create(session *Session) error {
 	s.mu.Lock()
@@ -187,6 +194,7 @@ func (s *EntStore) Create(session *Session) error {
 
 	// Create messages if any
 	for _, msg := range session.History {
+		msg = s.scrubMessage(msg)
 		toolCalls := make([]entschema.ToolCall, len(msg.ToolCalls))
 		for i, tc := range msg.ToolCalls {
 			toolCalls[i] = entschema.ToolCall{
@@ -341,6 +349,8 @@ func (s *EntStore) AppendMessage(key string, msg Message) error {
 		return fmt.Errorf("append message to session %q: %w", key, err)
 	}
 
+	msg = s.scrubMessage(msg)
+
 	// Convert tool calls
 	toolCalls := make([]entschema.ToolCall, len(msg.ToolCalls))
 	for i, tc := range msg.ToolCalls {
@@ -448,6 +458,10 @@ func (s *EntStore) CompactMessages(key string, upToIndex int, summary string) er
 		return fmt.Errorf("delete compacted messages: %w", err)
 	}
 
+	if s.scrub != nil {
+		summary = s.scrub(summary)
+	}
+
 	// Insert summary message at the beginning (with early timestamp)
 	_, err = s.client.Message.Create().
 		SetSession(entSession).
@@ -462,6 +476,32 @@ func (s *EntStore) CompactMessages(key string, upToIndex int, summary string) er
 	return nil
 }
 
+// SetScrubber sets the optional hook that removes secrets from message
+// content and tool call input/output before they are saved.
+func (s *EntStore) SetScrubber(fn types.ScrubFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scrub = fn
+}
+
+// scrubMessage applies the scrub hook to a message. The caller must hold s.mu.
+func (s *EntStore) scrubMessage(msg Message) Message {
+	if s.scrub == nil {
+		return msg
+	}
+	msg.Content = s.scrub(msg.Content)
+	if len(msg.ToolCalls) > 0 {
+		calls := make([]ToolCall, len(msg.ToolCalls))
+		for i, tc := range msg.ToolCalls {
+			tc.Input = s.scrub(tc.Input)
+			tc.Output = s.scrub(tc.Output)
+			calls[i] = tc
+		}
+		msg.ToolCalls = calls
+	}
+	return msg
+}
+
 // Close closes the ent client and underlying database connection.
 // When the client was provided externally via NewEntStoreWithClient, only the
 // ent client is closed; the raw DB connection is managed by the caller.
@@ -692,6 +732,23 @@ func (s *EntStore) MigrateSecrets(ctx context.Context, reencryptFn func([]byte)
 		}
 	}
 
+	// Earlier secret versions are kept for rollback and must stay readable.
+	versions, err := tx.SecretVersion.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("query secret versions: %w", err)
+	}
+
+	for _, v := range versions {
+		newVal, err := reencryptFn(v.EncryptedValue)
+		if err != nil {
+			return fmt.Errorf("re-encrypt secret %s version %d: %w", v.SecretName, v.Version, err)
+		}
+
+		if _, err := tx.SecretVersion.UpdateOne(v).SetEncryptedValue(newVal).Save(ctx); err != nil {
+			return fmt.Errorf("update secret %s version %d: %w", v.SecretName, v.Version, err)
+		}
+	}
+
 	// 3. Update Salt & Checksum using Raw SQL via underlying driver
 	// Access the driver using reflection as it is not exposed by ent.Tx
 	// tx.config.driver is the txDriver.
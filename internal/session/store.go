@@ -13,6 +13,17 @@ type Message struct {
 	Timestamp time.Time         `json:"timestamp"`
 	ToolCalls []ToolCall        `json:"toolCalls,omitempty"`
 	Author    string            `json:"author,omitempty"` // ADK agent name for multi-agent routing
+
+	// Attachments holds media sent with the message. It lives only in memory
+	// for the turn the message arrives in; stores persist the text only.
+	Attachments []Attachment `json:"-"`
+}
+
+// Attachment is an image, audio clip or file sent with a message.
+type Attachment struct {
+	MimeType string
+	Name     string
+	Data     []byte
 }
 
 // ToolCall represents a tool invocation
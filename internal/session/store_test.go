@@ -3,6 +3,7 @@ package session
 import (
 	"errors"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -156,6 +157,45 @@ func TestEntStore_AppendMessage_WithToolCalls(t *testing.T) {
 	}
 }
 
+func TestEntStore_Scrubber(t *testing.T) {
+	store := newTestEntStore(t)
+	store.SetScrubber(func(text string) string {
+		return strings.ReplaceAll(text, "hunter2", "{{secret:pw}}")
+	})
+
+	session := &Session{Key: "sess-scrub", History: []Message{{Role: "user", Content: "pw hunter2", Timestamp: time.Now()}}}
+	if err := store.Create(session); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	msg := Message{
+		Role:      "assistant",
+		Content:   "using hunter2",
+		Timestamp: time.Now().Add(time.Second),
+		ToolCalls: []ToolCall{{ID: "tc-1", Name: "exec", Input: `{"cmd":"login hunter2"}`, Output: "ok hunter2"}},
+	}
+	if err := store.AppendMessage("sess-scrub", msg); err != nil {
+		t.Fatalf("AppendMessage: %v", err)
+	}
+	if msg.ToolCalls[0].Input != `{"cmd":"login hunter2"}` {
+		t.Errorf("caller's tool call was modified: %q", msg.ToolCalls[0].Input)
+	}
+
+	got, err := store.Get("sess-scrub")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if len(got.History) != 2 {
+		t.Fatalf("History length: want 2, got %d", len(got.History))
+	}
+	if got.History[0].Content != "pw {{secret:pw}}" {
+		t.Errorf("Content: got %q", got.History[0].Content)
+	}
+	tc := got.History[1].ToolCalls[0]
+	if tc.Input != `{"cmd":"login {{secret:pw}}"}` || tc.Output != "ok {{secret:pw}}" {
+		t.Errorf("ToolCall: got input %q, output %q", tc.Input, tc.Output)
+	}
+}
+
 func TestEntStore_AppendMessage_NotFound(t *testing.T) {
 	store := newTestEntStore(t)
 
@@ -7,9 +7,12 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"strings"
 	"text/template"
 
 	"go.uber.org/zap"
+
+	"github.com/langoai/lango/internal/sandbox"
 )
 
 var _dangerousPatterns = []*regexp.Regexp{
@@ -23,7 +26,8 @@ var _dangerousPatterns = []*regexp.Regexp{
 
 // Executor safely executes skills.
 type Executor struct {
-	logger *zap.SugaredLogger
+	logger   *zap.SugaredLogger
+	isolator sandbox.CommandIsolator
 }
 
 // NewExecutor creates a new skill executor.
@@ -31,6 +35,11 @@ func NewExecutor(logger *zap.SugaredLogger) *Executor {
 	return &Executor{logger: logger}
 }
 
+// SetIsolator confines script skills with iso.
+func (e *Executor) SetIsolator(iso sandbox.CommandIsolator) {
+	e.isolator = iso
+}
+
 // Execute runs a skill with the given parameters.
 func (e *Executor) Execute(ctx context.Context, skill SkillEntry, params map[string]interface{}) (interface{}, error) {
 	switch skill.Type {
@@ -112,25 +121,40 @@ func (e *Executor) executeScript(ctx context.Context, skill SkillEntry) (interfa
 		return nil, fmt.Errorf("script skill %q: %w", skill.Name, err)
 	}
 
-	f, err := os.CreateTemp("", fmt.Sprintf("lango-skill-%s-*.sh", skill.Name))
-	if err != nil {
-		return nil, fmt.Errorf("create temp script: %w", err)
-	}
-	defer os.Remove(f.Name())
+	var cmd *exec.Cmd
+	if e.isolator != nil {
+		// The sandbox has a private /tmp, so the script goes through stdin.
+		cmd = exec.CommandContext(ctx, "sh", "-s")
+		cmd.Stdin = strings.NewReader(script)
+	} else {
+		f, err := os.CreateTemp("", fmt.Sprintf("lango-skill-%s-*.sh", skill.Name))
+		if err != nil {
+			return nil, fmt.Errorf("create temp script: %w", err)
+		}
+		defer os.Remove(f.Name())
 
-	if _, err := f.Write([]byte(script)); err != nil {
-		f.Close()
-		return nil, fmt.Errorf("write script: %w", err)
-	}
-	if err := f.Close(); err != nil {
-		return nil, fmt.Errorf("close script: %w", err)
-	}
+		if _, err := f.Write([]byte(script)); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("write script: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return nil, fmt.Errorf("close script: %w", err)
+		}
 
-	cmd := exec.CommandContext(ctx, "sh", f.Name())
+		cmd = exec.CommandContext(ctx, "sh", f.Name())
+	}
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	if e.isolator != nil {
+		release, err := e.isolator.Isolate(cmd)
+		if err != nil {
+			return nil, fmt.Errorf("sandbox script skill %q: %w", skill.Name, err)
+		}
+		defer release()
+	}
+
 	if err := cmd.Run(); err != nil {
 		return nil, fmt.Errorf("execute script skill %q: %w (stderr: %s)", skill.Name, err, stderr.String())
 	}
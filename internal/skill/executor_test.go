@@ -2,6 +2,7 @@ package skill
 
 import (
 	"context"
+	"os/exec"
 	"strings"
 	"testing"
 
@@ -248,6 +249,34 @@ func TestExecute_Script(t *testing.T) {
 	})
 }
 
+// markingIsolator marks commands through their environment.
+type markingIsolator struct{}
+
+func (markingIsolator) Isolate(cmd *exec.Cmd) (func(), error) {
+	cmd.Env = append(cmd.Environ(), "SANDBOXED=yes")
+	return func() {}, nil
+}
+
+func TestExecute_ScriptIsolated(t *testing.T) {
+	executor := newTestExecutor(t)
+	executor.SetIsolator(markingIsolator{})
+
+	sk := SkillEntry{
+		Name: "isolated",
+		Type: "script",
+		Definition: map[string]interface{}{
+			"script": "echo $SANDBOXED\necho done",
+		},
+	}
+	result, err := executor.Execute(context.Background(), sk, nil)
+	if err != nil {
+		t.Fatalf("Execute script: %v", err)
+	}
+	if got := strings.TrimSpace(result.(string)); got != "yes\ndone" {
+		t.Errorf("result = %q, want %q", got, "yes\ndone")
+	}
+}
+
 func TestExecute_UnknownType(t *testing.T) {
 	executor := newTestExecutor(t)
 	ctx := context.Background()
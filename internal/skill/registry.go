@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/langoai/lango/internal/agent"
+	"github.com/langoai/lango/internal/sandbox"
 )
 
 // Registry manages skill lifecycle and converts file-based skills to executable tools.
@@ -30,6 +31,11 @@ func NewRegistry(store SkillStore, baseTools []*agent.Tool, logger *zap.SugaredL
 	}
 }
 
+// SetIsolator confines script skills with iso.
+func (r *Registry) SetIsolator(iso sandbox.CommandIsolator) {
+	r.executor.SetIsolator(iso)
+}
+
 // LoadSkills loads active skills from the store and converts them to agent tools.
 func (r *Registry) LoadSkills(ctx context.Context) error {
 	skills, err := r.store.ListActive(ctx)
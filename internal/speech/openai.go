@@ -0,0 +1,49 @@
+package speech
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	openai "github.com/sashabaranov/go-openai"
+)
+
+// OpenAITranscriber transcribes audio with OpenAI's transcription API or a
+// compatible server such as a local Whisper deployment.
+type OpenAITranscriber struct {
+	client   *openai.Client
+	model    string
+	language string
+}
+
+// NewOpenAITranscriber creates a new OpenAI transcriber.
+func NewOpenAITranscriber(apiKey, baseURL, model, language string) *OpenAITranscriber {
+	if model == "" {
+		model = openai.Whisper1
+	}
+	config := openai.DefaultConfig(apiKey)
+	if baseURL != "" {
+		config.BaseURL = baseURL
+	}
+	return &OpenAITranscriber{
+		client:   openai.NewClientWithConfig(config),
+		model:    model,
+		language: language,
+	}
+}
+
+// Transcribe returns the text spoken in the recording.
+func (t *OpenAITranscriber) Transcribe(ctx context.Context, audio Audio) (string, error) {
+	resp, err := t.client.CreateTranscription(ctx, openai.AudioRequest{
+		Model:    t.model,
+		FilePath: fileName(audio),
+		Reader:   bytes.NewReader(audio.Data),
+		Language: t.language,
+		Format:   openai.AudioResponseFormatJSON,
+	})
+	if err != nil {
+		return "", fmt.Errorf("openai transcription: %w", err)
+	}
+	return strings.TrimSpace(resp.Text), nil
+}
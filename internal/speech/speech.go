@@ -0,0 +1,82 @@
+// Package speech converts voice messages to text so that models without
+// audio input can answer them.
+package speech
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strings"
+)
+
+// Audio is a recorded voice message.
+type Audio struct {
+	Data     []byte
+	MimeType string
+	Name     string
+}
+
+// Transcriber converts speech to text.
+type Transcriber interface {
+	// Transcribe returns the text spoken in the recording.
+	Transcribe(ctx context.Context, audio Audio) (string, error)
+}
+
+// Config selects and configures a speech-to-text backend.
+type Config struct {
+	// Provider selects the backend. Only "openai" (including
+	// OpenAI-compatible servers) is supported.
+	Provider string
+	APIKey   string
+	BaseURL  string
+	Model    string
+	Language string
+}
+
+// New creates a transcriber for the configured backend.
+func New(cfg Config) (Transcriber, error) {
+	switch cfg.Provider {
+	case "openai":
+		if cfg.APIKey == "" && cfg.BaseURL == "" {
+			return nil, fmt.Errorf("openai transcription requires an API key")
+		}
+		return NewOpenAITranscriber(cfg.APIKey, cfg.BaseURL, cfg.Model, cfg.Language), nil
+	default:
+		return nil, fmt.Errorf("unsupported transcription provider %q", cfg.Provider)
+	}
+}
+
+// audioExtensions maps common voice message formats to file extensions.
+var audioExtensions = map[string]string{
+	"audio/ogg":   ".ogg",
+	"audio/opus":  ".ogg",
+	"audio/mpeg":  ".mp3",
+	"audio/mp4":   ".m4a",
+	"audio/x-m4a": ".m4a",
+	"audio/aac":   ".m4a",
+	"audio/wav":   ".wav",
+	"audio/x-wav": ".wav",
+	"audio/webm":  ".webm",
+	"audio/flac":  ".flac",
+}
+
+// fileName returns a file name with an extension matching the audio format.
+// Transcription APIs detect the format from the extension.
+func fileName(a Audio) string {
+	name := a.Name
+	if name == "" {
+		name = "voice"
+	}
+	if filepath.Ext(name) != "" {
+		return name
+	}
+	mediaType, _, _ := mime.ParseMediaType(a.MimeType)
+	if ext, ok := audioExtensions[strings.ToLower(mediaType)]; ok {
+		return name + ext
+	}
+	if exts, _ := mime.ExtensionsByType(mediaType); len(exts) > 0 {
+		return name + exts[0]
+	}
+	return name + ".ogg"
+}
@@ -0,0 +1,78 @@
+package speech
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFileName(t *testing.T) {
+	tests := []struct {
+		give Audio
+		want string
+	}{
+		{give: Audio{MimeType: "audio/ogg"}, want: "voice.ogg"},
+		{give: Audio{MimeType: "audio/ogg; codecs=opus"}, want: "voice.ogg"},
+		{give: Audio{Name: "memo", MimeType: "audio/mpeg"}, want: "memo.mp3"},
+		{give: Audio{Name: "memo.m4a", MimeType: "audio/ogg"}, want: "memo.m4a"},
+		{give: Audio{}, want: "voice.ogg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := fileName(tt.give); got != tt.want {
+				t.Errorf("fileName(%+v) = %q, want %q", tt.give, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpenAITranscriber(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/audio/transcriptions" {
+			http.NotFound(w, r)
+			return
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("read file: %v", err)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		if header.Filename != "voice.ogg" || string(data) != "OggS" {
+			t.Errorf("unexpected upload %q: %q", header.Filename, data)
+		}
+		if got := r.FormValue("model"); got != "whisper-1" {
+			t.Errorf("expected model whisper-1, got %q", got)
+		}
+		if got := r.FormValue("language"); got != "ko" {
+			t.Errorf("expected language ko, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]string{"text": " hello there \n"})
+	}))
+	defer srv.Close()
+
+	tr, err := New(Config{Provider: "openai", APIKey: "key", BaseURL: srv.URL + "/v1", Language: "ko"})
+	if err != nil {
+		t.Fatalf("new transcriber: %v", err)
+	}
+	text, err := tr.Transcribe(context.Background(), Audio{Data: []byte("OggS"), MimeType: "audio/ogg"})
+	if err != nil {
+		t.Fatalf("transcribe: %v", err)
+	}
+	if text != "hello there" {
+		t.Errorf("expected trimmed transcript, got %q", text)
+	}
+}
+
+func TestNew_Unsupported(t *testing.T) {
+	if _, err := New(Config{Provider: "anthropic", APIKey: "key"}); err == nil {
+		t.Error("expected error for unsupported provider")
+	}
+	if _, err := New(Config{Provider: "openai"}); err == nil {
+		t.Error("expected error without API key")
+	}
+}
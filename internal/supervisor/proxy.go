@@ -96,6 +96,8 @@ func (p *ProviderProxy) Generate(ctx context.Context, params provider.GeneratePa
 	if err != nil && p.fallbackProviderID != "" {
 		logger.Warnw("primary provider failed, trying fallback", "provider", p.providerID, "fallback", p.fallbackProviderID, "error", err)
 
+		// A model chosen for the primary provider means nothing to the fallback.
+		params.Model = ""
 		stream, err = p.supervisor.Generate(ctx, p.fallbackProviderID, p.fallbackModel, params)
 		if err != nil {
 			return nil, fmt.Errorf("fallback provider %q: %w", p.fallbackProviderID, err)
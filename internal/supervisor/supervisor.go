@@ -11,6 +11,8 @@ import (
 	"github.com/langoai/lango/internal/provider/anthropic"
 	"github.com/langoai/lango/internal/provider/gemini"
 	"github.com/langoai/lango/internal/provider/openai"
+	"github.com/langoai/lango/internal/sandbox"
+	"github.com/langoai/lango/internal/security"
 	"github.com/langoai/lango/internal/tools/exec"
 	"github.com/langoai/lango/internal/types"
 )
@@ -41,6 +43,12 @@ func New(cfg *config.Config) (*Supervisor, error) {
 			"SHELL", "TMPDIR", "SSH_AUTH_SOCK",
 		},
 	}
+	if cfg.Tools.Exec.Sandbox {
+		if err := sandbox.ProbeLinux(); err != nil {
+			return nil, fmt.Errorf("tools.exec.sandbox: %w", err)
+		}
+		execConfig.Isolator = sandbox.NewLinuxRuntime(sandbox.Config{}, sandbox.NewPolicy(cfg.Sandbox))
+	}
 	s.execTool = exec.New(execConfig)
 
 	if err := s.initializeProviders(); err != nil {
@@ -62,9 +70,17 @@ func (s *Supervisor) initializeProviders() error {
 				logger.Warnw("provider has no API key configured", "id", id)
 			}
 
+			// Usage is only asked for when quotas record it; some
+			// OpenAI-compatible servers reject stream_options.
+			newOpenAI := func(baseURL string) provider.Provider {
+				op := openai.NewProvider(id, apiKey, baseURL)
+				op.SetIncludeUsage(s.Config.Quota.Enabled)
+				return op
+			}
+
 			switch pCfg.Type {
 			case types.ProviderOpenAI:
-				p = openai.NewProvider(id, apiKey, pCfg.BaseURL)
+				p = newOpenAI(pCfg.BaseURL)
 			case types.ProviderAnthropic:
 				p = anthropic.NewProvider(id, apiKey)
 			case types.ProviderGemini, types.ProviderGoogle: // Support "google" as alias
@@ -74,14 +90,14 @@ func (s *Supervisor) initializeProviders() error {
 				if baseURL == "" {
 					baseURL = "http://localhost:11434/v1"
 				}
-				p = openai.NewProvider(id, apiKey, baseURL)
+				p = newOpenAI(baseURL)
 			case types.ProviderGitHub:
 				// GitHub Models uses OpenAI compatible endpoint
 				baseURL := pCfg.BaseURL
 				if baseURL == "" {
 					baseURL = "https://models.inference.ai.azure.com"
 				}
-				p = openai.NewProvider(id, apiKey, baseURL)
+				p = newOpenAI(baseURL)
 			default:
 				logger.Warnw("unknown provider type", "id", id, "type", pCfg.Type)
 				continue
@@ -133,6 +149,19 @@ func (s *Supervisor) Generate(ctx context.Context, providerID, model string, par
 	return p.Generate(ctx, params)
 }
 
+// ListModels returns the models offered by a provider. An empty providerID
+// selects the default provider.
+func (s *Supervisor) ListModels(ctx context.Context, providerID string) ([]provider.ModelInfo, error) {
+	if providerID == "" {
+		providerID = s.Config.Agent.Provider
+	}
+	p, ok := s.registry.Get(providerID)
+	if !ok {
+		return nil, fmt.Errorf("provider not found: %s", providerID)
+	}
+	return p.ListModels(ctx)
+}
+
 // ExecuteTool forwards a command execution request to the internal exec tool.
 // Importantly, the exec tool is configured with an environment whitelist in New(),
 // ensuring that sensitive secrets (like API keys) are NOT passed to the command.
@@ -160,10 +189,15 @@ func (s *Supervisor) ExecuteTool(ctx context.Context, cmd string) (string, error
 	return res.Stdout + res.Stderr, nil
 }
 
+// SetSecretRefs lets executed commands use secret reference tokens.
+func (s *Supervisor) SetSecretRefs(refs *security.RefStore) {
+	s.execTool.SetRefs(refs)
+}
+
 // StartBackground starts a command in the background.
-func (s *Supervisor) StartBackground(cmd string) (string, error) {
+func (s *Supervisor) StartBackground(ctx context.Context, cmd string) (string, error) {
 	logger.Infow("supervisor starting background process", "command", cmd)
-	return s.execTool.StartBackground(cmd)
+	return s.execTool.StartBackground(ctx, cmd)
 }
 
 // GetBackgroundStatus returns the status of a background process.
@@ -413,7 +413,7 @@ func TestStartBackground_AndGetStatus(t *testing.T) {
 		t.Fatalf("New() returned error: %v", err)
 	}
 
-	id, err := sv.StartBackground("sleep 10")
+	id, err := sv.StartBackground(context.Background(), "sleep 10")
 	if err != nil {
 		t.Fatalf("StartBackground() returned error: %v", err)
 	}
@@ -451,7 +451,7 @@ func TestStopBackground_Success(t *testing.T) {
 		t.Fatalf("New() returned error: %v", err)
 	}
 
-	id, err := sv.StartBackground("sleep 30")
+	id, err := sv.StartBackground(context.Background(), "sleep 30")
 	if err != nil {
 		t.Fatalf("StartBackground() returned error: %v", err)
 	}
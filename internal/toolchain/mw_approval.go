@@ -3,11 +3,14 @@ package toolchain
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/langoai/lango/internal/agent"
 	"github.com/langoai/lango/internal/approval"
 	"github.com/langoai/lango/internal/config"
+	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/policy"
 	"github.com/langoai/lango/internal/session"
 	"github.com/langoai/lango/internal/wallet"
 )
@@ -15,12 +18,24 @@ import (
 // WithApproval returns a middleware that gates tool execution behind an approval flow.
 // Uses fail-closed: denies execution unless explicitly approved.
 // The Provider routes requests to the appropriate channel (Gateway, Telegram, Discord, Slack, TTY).
-// The GrantStore tracks "always allow" grants to auto-approve repeat invocations within a session.
+// The GrantStore tracks "always allow" grants to auto-approve repeat invocations.
 // When limiter is non-nil, payment tools with an amount below the auto-approve threshold
 // are executed without explicit user confirmation.
-func WithApproval(ic config.InterceptorConfig, ap approval.Provider, gs *approval.GrantStore, limiter wallet.SpendingLimiter) Middleware {
+// When pe is non-nil, its rules are evaluated first on every call; the first matching
+// rule allows, refuses or asks (ignoring grants). Calls no rule matches fall back to
+// NeedsApproval.
+// When audit is non-nil, every decision is written to the audit log.
+func WithApproval(
+	ic config.InterceptorConfig,
+	ap approval.Provider,
+	gs *approval.GrantStore,
+	limiter wallet.SpendingLimiter,
+	pe *policy.Engine,
+	audit approval.AuditRecorder,
+) Middleware {
 	return func(tool *agent.Tool, next agent.ToolHandler) agent.ToolHandler {
-		if !NeedsApproval(tool, ic) {
+		needsApproval := NeedsApproval(tool, ic)
+		if !needsApproval && !pe.Covers(tool.Name) {
 			return next
 		}
 
@@ -29,52 +44,123 @@ func WithApproval(ic config.InterceptorConfig, ap approval.Provider, gs *approva
 			if target := approval.ApprovalTargetFromContext(ctx); target != "" {
 				sessionKey = target
 			}
+			decision := approval.Decision{
+				SessionKey: sessionKey,
+				ToolName:   tool.Name,
+				Summary:    BuildApprovalSummary(tool.Name, params),
+			}
 
-			// Check persistent grant — auto-approve if previously "always allowed".
-			if gs != nil && gs.IsGranted(sessionKey, tool.Name) {
+			if d, ok := pe.Evaluate(policy.Request{
+				Tool:       tool.Name,
+				Params:     params,
+				SessionKey: session.SessionKeyFromContext(ctx),
+			}); ok {
+				decision.Rule = d.Rule
+				switch d.Action {
+				case config.PolicyActionAllow:
+					decision.Approved, decision.Source = true, approval.SourcePolicy
+					recordDecision(ctx, audit, decision)
+					return next(ctx, params)
+				case config.PolicyActionDeny:
+					decision.Source = approval.SourcePolicy
+					recordDecision(ctx, audit, decision)
+					return nil, fmt.Errorf("tool '%s' execution denied by policy %q", tool.Name, d.Rule)
+				default:
+					return requestApproval(ctx, tool, params, decision, ap, nil, audit, next)
+				}
+			}
+			if !needsApproval {
 				return next(ctx, params)
 			}
 
+			// Check persistent grant — auto-approve if previously "always allowed".
+			if gs != nil {
+				if g, ok := gs.Find(ctx, sessionKey, tool.Name); ok {
+					decision.Approved, decision.Source, decision.Grant = true, approval.SourceGrant, &g
+					recordDecision(ctx, audit, decision)
+					return next(ctx, params)
+				}
+			}
+
 			// Auto-approve small payments via spending limiter threshold.
 			if limiter != nil && (tool.Name == "p2p_pay" || tool.Name == "payment_send") {
 				if amountStr, ok := params["amount"].(string); ok && amountStr != "" {
 					amt, err := wallet.ParseUSDC(amountStr)
 					if err == nil {
 						if autoOK, checkErr := limiter.IsAutoApprovable(ctx, amt); checkErr == nil && autoOK {
+							decision.Approved, decision.Source = true, approval.SourceSpendingLimit
+							recordDecision(ctx, audit, decision)
 							return next(ctx, params)
 						}
 					}
 				}
 			}
 
-			req := approval.ApprovalRequest{
-				ID:         fmt.Sprintf("req-%d", time.Now().UnixNano()),
-				ToolName:   tool.Name,
-				SessionKey: sessionKey,
-				Params:     params,
-				Summary:    BuildApprovalSummary(tool.Name, params),
-				CreatedAt:  time.Now(),
-			}
-			resp, err := ap.RequestApproval(ctx, req)
-			if err != nil {
-				return nil, fmt.Errorf("tool '%s' approval: %w", tool.Name, err)
-			}
-			if !resp.Approved {
-				sk := session.SessionKeyFromContext(ctx)
-				if sk == "" {
-					return nil, fmt.Errorf("tool '%s' execution denied: no approval channel available (session key missing)", tool.Name)
-				}
-				return nil, fmt.Errorf("tool '%s' execution denied: user did not approve the action", tool.Name)
-			}
+			return requestApproval(ctx, tool, params, decision, ap, gs, audit, next)
+		}
+	}
+}
 
-			// Record persistent grant for this session+tool.
-			if resp.AlwaysAllow && gs != nil {
-				gs.Grant(sessionKey, tool.Name)
-			}
+// requestApproval asks ap to approve the call and runs it when approved.
+// An "always allow" answer is recorded in gs when gs is non-nil.
+func requestApproval(
+	ctx context.Context,
+	tool *agent.Tool,
+	params map[string]interface{},
+	decision approval.Decision,
+	ap approval.Provider,
+	gs *approval.GrantStore,
+	audit approval.AuditRecorder,
+	next agent.ToolHandler,
+) (interface{}, error) {
+	decision.Source = approval.SourceUser
+	req := approval.ApprovalRequest{
+		ID:         fmt.Sprintf("req-%d", time.Now().UnixNano()),
+		ToolName:   tool.Name,
+		SessionKey: decision.SessionKey,
+		Params:     params,
+		Summary:    decision.Summary,
+		CreatedAt:  time.Now(),
+	}
+	resp, err := ap.RequestApproval(ctx, req)
+	if err != nil {
+		decision.Error = err.Error()
+		recordDecision(ctx, audit, decision)
+		return nil, fmt.Errorf("tool '%s' approval: %w", tool.Name, err)
+	}
+	decision.Approved, decision.AlwaysAllow = resp.Approved, resp.AlwaysAllow
+	if !resp.Approved {
+		recordDecision(ctx, audit, decision)
+		sk := session.SessionKeyFromContext(ctx)
+		if sk == "" {
+			return nil, fmt.Errorf("tool '%s' execution denied: no approval channel available (session key missing)", tool.Name)
+		}
+		return nil, fmt.Errorf("tool '%s' execution denied: user did not approve the action", tool.Name)
+	}
 
-			return next(ctx, params)
+	// Record persistent grant for this session+tool.
+	if resp.AlwaysAllow && gs != nil {
+		g, err := gs.Create(ctx, decision.SessionKey, tool.Name)
+		if err != nil {
+			logging.App().Warnw("save approval grant", "tool", tool.Name, "error", err)
+		} else {
+			decision.Grant = &g
 		}
 	}
+	recordDecision(ctx, audit, decision)
+
+	return next(ctx, params)
+}
+
+// recordDecision writes d to the audit log. Failures are logged and do not
+// change the decision.
+func recordDecision(ctx context.Context, audit approval.AuditRecorder, d approval.Decision) {
+	if audit == nil {
+		return
+	}
+	if err := audit.RecordDecision(ctx, d); err != nil {
+		logging.App().Warnw("record approval decision", "tool", d.ToolName, "error", err)
+	}
 }
 
 // NeedsApproval determines whether a tool requires approval based on the
@@ -108,6 +194,15 @@ func NeedsApproval(t *agent.Tool, ic config.InterceptorConfig) bool {
 	}
 }
 
+// forceSuffix flags filesystem calls that override the uncommitted-changes
+// protection.
+func forceSuffix(params map[string]interface{}) string {
+	if force, _ := params["force"].(bool); force {
+		return " (force)"
+	}
+	return ""
+}
+
 // BuildApprovalSummary returns a human-readable description of what a tool
 // invocation will do, suitable for display in approval messages.
 func BuildApprovalSummary(toolName string, params map[string]interface{}) string {
@@ -119,13 +214,26 @@ func BuildApprovalSummary(toolName string, params map[string]interface{}) string
 	case "fs_write":
 		path, _ := params["path"].(string)
 		content, _ := params["content"].(string)
-		return fmt.Sprintf("Write to %s (%d bytes)", path, len(content))
+		return fmt.Sprintf("Write to %s (%d bytes)", path, len(content)) + forceSuffix(params)
 	case "fs_edit":
 		path, _ := params["path"].(string)
-		return "Edit file: " + path
+		return "Edit file: " + path + forceSuffix(params)
 	case "fs_delete":
 		path, _ := params["path"].(string)
-		return "Delete: " + path
+		return "Delete: " + path + forceSuffix(params)
+	case "fs_apply_patch":
+		patch, _ := params["patch"].(string)
+		files := strings.Count(patch, "\n+++ ")
+		if dryRun, _ := params["dryRun"].(bool); dryRun {
+			return fmt.Sprintf("Check patch of %d file(s)", files)
+		}
+		return fmt.Sprintf("Apply patch to %d file(s)", files) + forceSuffix(params)
+	case "fs_undo":
+		steps := 1
+		if n, ok := params["steps"].(float64); ok && n > 1 {
+			steps = int(n)
+		}
+		return fmt.Sprintf("Undo the last %d file change(s)", steps) + forceSuffix(params)
 	case "browser_navigate":
 		url, _ := params["url"].(string)
 		return "Navigate to: " + Truncate(url, 200)
@@ -0,0 +1,75 @@
+package toolchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+
+	"github.com/langoai/lango/internal/agent"
+)
+
+// WithInjectionGuard returns a middleware that screens tool output for
+// prompt-injection payloads before it reaches the model. It only applies to
+// tools whose name matches one of the patterns (path.Match globs such as
+// "browser_*"); other tools pass through unchanged.
+//
+// Flagged string results are replaced with their quarantined form; other
+// results are screened as JSON and, when flagged, replaced with the
+// quarantined JSON text. When the guard blocks, the tool returns an error
+// wrapping agent.ErrInjectionBlocked instead of its output.
+func WithInjectionGuard(guard *agent.InjectionGuard, patterns []string) Middleware {
+	return func(tool *agent.Tool, next agent.ToolHandler) agent.ToolHandler {
+		if !matchesAny(tool.Name, patterns) {
+			return next
+		}
+		return func(ctx context.Context, params map[string]interface{}) (interface{}, error) {
+			result, err := next(ctx, params)
+			if err != nil || result == nil {
+				return result, err
+			}
+
+			text, ok := result.(string)
+			if !ok {
+				raw, mErr := marshalUnescaped(result)
+				if mErr != nil {
+					return result, nil
+				}
+				text = raw
+			}
+
+			screened, err := guard.Screen(ctx, tool.Name, text)
+			if err != nil {
+				return nil, err
+			}
+			if screened == text {
+				return result, nil
+			}
+			return screened, nil
+		}
+	}
+}
+
+// marshalUnescaped encodes v as JSON without HTML escaping, so payloads such
+// as "<|im_start|>" stay visible to the detector instead of turning into
+// "\u003c|im_start|\u003e".
+func marshalUnescaped(v interface{}) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// matchesAny reports whether name matches one of the glob patterns.
+func matchesAny(name string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/creack/pty"
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/sandbox"
 	"github.com/langoai/lango/internal/security"
 )
 
@@ -24,9 +25,10 @@ type Config struct {
 	DefaultTimeout  time.Duration
 	AllowBackground bool
 	WorkDir         string
-	EnvFilter       []string           // environment variables to exclude
-	EnvWhitelist    []string           // if set, ONLY these vars are allowed
-	Refs            *security.RefStore // secret reference token resolver
+	EnvFilter       []string                // environment variables to exclude
+	EnvWhitelist    []string                // if set, ONLY these vars are allowed
+	Refs            *security.RefStore      // secret reference token resolver
+	Isolator        sandbox.CommandIsolator // confines commands, if set
 }
 
 // Tool provides shell command execution
@@ -85,14 +87,34 @@ func New(cfg Config) *Tool {
 	}
 }
 
+// SetRefs sets the secret reference resolver. It must be called before the
+// tool runs commands.
+func (t *Tool) SetRefs(refs *security.RefStore) {
+	t.config.Refs = refs
+}
+
 // resolveRefs resolves any secret reference tokens in the command string.
 // Tokens like {{secret:name}} and {{decrypt:id}} are replaced with actual values
 // just before execution. The resolved command is never logged or returned to the agent.
-func (t *Tool) resolveRefs(command string) string {
+// Each resolved token is recorded as accessed by accessor.
+func (t *Tool) resolveRefs(ctx context.Context, accessor, command string) string {
 	if t.config.Refs == nil {
 		return command
 	}
-	return t.config.Refs.ResolveAll(command)
+	return t.config.Refs.ResolveAllFor(ctx, accessor, command)
+}
+
+// isolate confines cmd with the configured isolator. The returned release
+// function must be called once cmd has exited.
+func (t *Tool) isolate(cmd *exec.Cmd) (func(), error) {
+	if t.config.Isolator == nil {
+		return func() {}, nil
+	}
+	release, err := t.config.Isolator.Isolate(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("sandbox command: %w", err)
+	}
+	return release, nil
 }
 
 // Run executes a command synchronously
@@ -106,7 +128,7 @@ func (t *Tool) Run(ctx context.Context, command string, timeout time.Duration) (
 
 	// Resolve secret reference tokens just before execution.
 	// The resolved command is never logged or sent back to the agent.
-	resolved := t.resolveRefs(command)
+	resolved := t.resolveRefs(ctx, "exec", command)
 	cmd := exec.CommandContext(ctx, "sh", "-c", resolved)
 	cmd.Dir = t.config.WorkDir
 	cmd.Env = t.filterEnv(os.Environ())
@@ -115,9 +137,15 @@ func (t *Tool) Run(ctx context.Context, command string, timeout time.Duration) (
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
+	release, err := t.isolate(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	logger.Infow("executing command", "command", command, "timeout", timeout)
 
-	err := cmd.Run()
+	err = cmd.Run()
 
 	result := &Result{
 		Stdout: stdout.String(),
@@ -150,11 +178,17 @@ func (t *Tool) RunWithPTY(ctx context.Context, command string, timeout time.Dura
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	resolved := t.resolveRefs(command)
+	resolved := t.resolveRefs(ctx, "exec", command)
 	cmd := exec.CommandContext(ctx, "sh", "-c", resolved)
 	cmd.Dir = t.config.WorkDir
 	cmd.Env = t.filterEnv(os.Environ())
 
+	release, err := t.isolate(cmd)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// Start with PTY
 	ptmx, err := pty.Start(cmd)
 	if err != nil {
@@ -200,15 +234,16 @@ func (t *Tool) RunWithPTY(ctx context.Context, command string, timeout time.Dura
 	return result, nil
 }
 
-// StartBackground starts a command in the background
-func (t *Tool) StartBackground(command string) (string, error) {
+// StartBackground starts a command in the background. ctx is only used to
+// attribute resolved secret references; the process outlives it.
+func (t *Tool) StartBackground(ctx context.Context, command string) (string, error) {
 	if !t.config.AllowBackground {
 		return "", fmt.Errorf("background processes not allowed")
 	}
 
 	id := fmt.Sprintf("bg-%d", time.Now().UnixNano())
 
-	resolved := t.resolveRefs(command)
+	resolved := t.resolveRefs(ctx, "exec_bg", command)
 	cmd := exec.Command("sh", "-c", resolved)
 	cmd.Dir = t.config.WorkDir
 	cmd.Env = t.filterEnv(os.Environ())
@@ -217,7 +252,12 @@ func (t *Tool) StartBackground(command string) (string, error) {
 	cmd.Stdout = output
 	cmd.Stderr = output
 
+	release, err := t.isolate(cmd)
+	if err != nil {
+		return "", err
+	}
 	if err := cmd.Start(); err != nil {
+		release()
 		return "", fmt.Errorf("start background process: %w", err)
 	}
 
@@ -236,6 +276,7 @@ func (t *Tool) StartBackground(command string) (string, error) {
 	// Monitor process completion
 	go func() {
 		err := cmd.Wait()
+		release()
 		t.bgMu.Lock()
 		bp.Done = true
 		if err != nil {
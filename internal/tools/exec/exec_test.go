@@ -2,6 +2,7 @@ package exec
 
 import (
 	"context"
+	"os/exec"
 	"testing"
 	"time"
 
@@ -63,7 +64,7 @@ func TestBackgroundProcess(t *testing.T) {
 	})
 	defer tool.Cleanup()
 
-	id, err := tool.StartBackground("sleep 10")
+	id, err := tool.StartBackground(context.Background(), "sleep 10")
 	if err != nil {
 		t.Fatalf("failed to start: %v", err)
 	}
@@ -129,3 +130,30 @@ func TestFilterEnvBlacklist(t *testing.T) {
 		})
 	}
 }
+
+// markingIsolator marks commands through their environment and counts
+// releases.
+type markingIsolator struct {
+	released int
+}
+
+func (m *markingIsolator) Isolate(cmd *exec.Cmd) (func(), error) {
+	cmd.Env = append(cmd.Env, "SANDBOXED=yes")
+	return func() { m.released++ }, nil
+}
+
+func TestRunIsolated(t *testing.T) {
+	iso := &markingIsolator{}
+	tool := New(Config{DefaultTimeout: 5 * time.Second, Isolator: iso})
+
+	result, err := tool.Run(context.Background(), "echo $SANDBOXED", 0)
+	if err != nil {
+		t.Fatalf("failed: %v", err)
+	}
+	if result.Stdout != "yes\n" {
+		t.Errorf("expected command to be isolated, got %q", result.Stdout)
+	}
+	if iso.released != 1 {
+		t.Errorf("expected 1 release, got %d", iso.released)
+	}
+}
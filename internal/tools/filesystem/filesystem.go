@@ -2,13 +2,18 @@ package filesystem
 
 import (
 	"bufio"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
 	"github.com/langoai/lango/internal/logging"
+	"github.com/langoai/lango/internal/session"
 )
 
 var logger = logging.SubsystemSugar("tool.filesystem")
@@ -17,7 +22,14 @@ var logger = logging.SubsystemSugar("tool.filesystem")
 type Config struct {
 	MaxReadSize  int64    // maximum file size to read
 	AllowedPaths []string // allowed base paths (empty = all)
-	BlockedPaths []string // paths that are always denied
+	BlockedPaths []string // globs of paths that are always denied
+
+	// ProtectUncommitted refuses changes to files with uncommitted git
+	// changes unless forced.
+	ProtectUncommitted bool
+
+	// Journal records the state before each change for undo (nil = off).
+	Journal Journal
 }
 
 // Tool provides filesystem operations
@@ -35,6 +47,21 @@ type FileInfo struct {
 	Mode    string `json:"mode"`
 }
 
+// StatInfo is file metadata with content details.
+type StatInfo struct {
+	FileInfo
+	IsSymlink  bool   `json:"isSymlink"`
+	LinkTarget string `json:"linkTarget,omitempty"`
+	MimeType   string `json:"mimeType,omitempty"`
+	Binary     bool   `json:"binary"`
+	Lines      int    `json:"lines,omitempty"`   // text files up to MaxReadSize
+	Entries    int    `json:"entries,omitempty"` // directories
+}
+
+// binarySniffLen is how much of a file is inspected for binary content,
+// as git does.
+const binarySniffLen = 8000
+
 // New creates a new filesystem tool
 func New(cfg Config) *Tool {
 	if cfg.MaxReadSize == 0 {
@@ -67,6 +94,9 @@ func (t *Tool) Read(path string) (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("read file: %w", err)
 	}
+	if isBinary(content) {
+		return "", fmt.Errorf("binary file: %s (%s, %d bytes); use fs_stat for its metadata", path, http.DetectContentType(content), len(content))
+	}
 
 	logger.Infow("file read", "path", absPath, "size", len(content))
 	return string(content), nil
@@ -106,37 +136,48 @@ func (t *Tool) ReadLines(path string, startLine, endLine int) (string, error) {
 	return strings.Join(lines, "\n"), nil
 }
 
-// Write writes content to a file (atomic write)
-func (t *Tool) Write(path, content string) error {
+// Write writes content to a file (atomic write). Unless force is set, it
+// refuses to overwrite a file with uncommitted git changes.
+func (t *Tool) Write(ctx context.Context, path, content string, force bool) error {
 	absPath, err := t.validatePath(path)
 	if err != nil {
 		return err
 	}
 
+	return t.mutate(ctx, absPath, OpWrite, force, func() ([]byte, error) {
+		if err := writeAtomic(absPath, []byte(content), 0644); err != nil {
+			return nil, err
+		}
+		logger.Infow("file written", "path", absPath, "size", len(content))
+		return []byte(content), nil
+	})
+}
+
+// writeAtomic writes content to a temp file next to path and renames it
+// over path, creating the parent directory if needed.
+func writeAtomic(path string, content []byte, perm os.FileMode) error {
 	// Ensure parent directory exists
-	dir := filepath.Dir(absPath)
-	if err := os.MkdirAll(dir, 0755); err != nil {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
 		return fmt.Errorf("create directory: %w", err)
 	}
 
 	// Write to temp file first (atomic write)
-	tempPath := absPath + ".tmp"
-	if err := os.WriteFile(tempPath, []byte(content), 0644); err != nil {
+	tempPath := path + ".tmp"
+	if err := os.WriteFile(tempPath, content, perm); err != nil {
 		return fmt.Errorf("write file: %w", err)
 	}
 
 	// Rename to final path
-	if err := os.Rename(tempPath, absPath); err != nil {
+	if err := os.Rename(tempPath, path); err != nil {
 		os.Remove(tempPath) // cleanup
 		return fmt.Errorf("rename file: %w", err)
 	}
-
-	logger.Infow("file written", "path", absPath, "size", len(content))
 	return nil
 }
 
-// Edit replaces content in a file between specified lines
-func (t *Tool) Edit(path string, startLine, endLine int, newContent string) error {
+// Edit replaces content in a file between specified lines. Unless force is
+// set, it refuses to edit a file with uncommitted git changes.
+func (t *Tool) Edit(ctx context.Context, path string, startLine, endLine int, newContent string, force bool) error {
 	absPath, err := t.validatePath(path)
 	if err != nil {
 		return err
@@ -180,7 +221,14 @@ func (t *Tool) Edit(path string, startLine, endLine int, newContent string) erro
 	}
 
 	// Write back
-	return t.Write(path, strings.Join(result, "\n"))
+	content := []byte(strings.Join(result, "\n"))
+	return t.mutate(ctx, absPath, OpEdit, force, func() ([]byte, error) {
+		if err := writeAtomic(absPath, content, 0644); err != nil {
+			return nil, err
+		}
+		logger.Infow("file edited", "path", absPath, "startLine", startLine, "endLine", endLine)
+		return content, nil
+	})
 }
 
 // ListDir lists contents of a directory
@@ -215,18 +263,142 @@ func (t *Tool) ListDir(path string) ([]FileInfo, error) {
 	return result, nil
 }
 
-// Delete removes a file or directory
-func (t *Tool) Delete(path string) error {
+// Stat returns the metadata of a path. Symlinks are reported and followed.
+func (t *Tool) Stat(path string) (*StatInfo, error) {
+	absPath, err := t.validatePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	linfo, err := os.Lstat(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("stat: %w", err)
+	}
+	stat := &StatInfo{FileInfo: newFileInfo(absPath, linfo)}
+	if linfo.Mode()&os.ModeSymlink != 0 {
+		stat.IsSymlink = true
+		stat.LinkTarget, _ = os.Readlink(absPath)
+		info, err := os.Stat(absPath)
+		if err != nil {
+			return stat, nil // dangling link
+		}
+		stat.FileInfo = newFileInfo(absPath, info)
+	}
+
+	if stat.IsDir {
+		entries, err := os.ReadDir(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("read directory: %w", err)
+		}
+		stat.Entries = len(entries)
+		return stat, nil
+	}
+
+	f, err := os.Open(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+	head := make([]byte, binarySniffLen)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("read file: %w", err)
+	}
+	head = head[:n]
+	stat.MimeType = http.DetectContentType(head)
+	stat.Binary = isBinary(head)
+
+	if !stat.Binary && stat.Size <= t.config.MaxReadSize {
+		content, err := os.ReadFile(absPath)
+		if err != nil {
+			return nil, fmt.Errorf("read file: %w", err)
+		}
+		stat.Lines = len(splitLines(content))
+	}
+	return stat, nil
+}
+
+// newFileInfo converts os.FileInfo into FileInfo.
+func newFileInfo(path string, info os.FileInfo) FileInfo {
+	return FileInfo{
+		Path:    path,
+		Name:    info.Name(),
+		Size:    info.Size(),
+		IsDir:   info.IsDir(),
+		ModTime: info.ModTime().Unix(),
+		Mode:    info.Mode().String(),
+	}
+}
+
+// isBinary reports whether content looks binary: a NUL byte within its
+// first binarySniffLen bytes.
+func isBinary(content []byte) bool {
+	if len(content) > binarySniffLen {
+		content = content[:binarySniffLen]
+	}
+	return bytes.IndexByte(content, 0) >= 0
+}
+
+// Delete removes a file or directory. Unless force is set, it refuses to
+// delete paths with uncommitted git changes.
+func (t *Tool) Delete(ctx context.Context, path string, force bool) error {
 	absPath, err := t.validatePath(path)
 	if err != nil {
 		return err
 	}
 
-	if err := os.RemoveAll(absPath); err != nil {
-		return fmt.Errorf("delete: %w", err)
+	return t.mutate(ctx, absPath, OpDelete, force, func() ([]byte, error) {
+		if err := os.RemoveAll(absPath); err != nil {
+			return nil, fmt.Errorf("delete: %w", err)
+		}
+		logger.Infow("file deleted", "path", absPath)
+		return nil, nil
+	})
+}
+
+// mutate runs apply, which changes absPath and returns the content it
+// wrote, after checking for uncommitted git changes and snapshotting the
+// previous state into the undo journal. A state too large to snapshot
+// blocks the change unless force is set.
+func (t *Tool) mutate(ctx context.Context, absPath string, op Operation, force bool, apply func() ([]byte, error)) error {
+	sessionKey := session.SessionKeyFromContext(ctx)
+	if t.config.ProtectUncommitted && !force {
+		if err := t.checkUncommitted(ctx, absPath, sessionKey); err != nil {
+			return err
+		}
+	}
+
+	var (
+		snap    Snapshot
+		journal = t.config.Journal
+	)
+	if journal != nil {
+		var err error
+		snap, err = takeSnapshot(absPath, t.config.MaxReadSize)
+		switch {
+		case errors.Is(err, ErrSnapshotTooLarge) && force:
+			logger.Warnw("change not recorded for undo", "path", absPath, "error", err)
+			journal = nil
+		case errors.Is(err, ErrSnapshotTooLarge):
+			return fmt.Errorf("%w; retry with force to change it without undo", err)
+		case err != nil:
+			return err
+		}
+	}
+
+	written, err := apply()
+	if err != nil || journal == nil {
+		return err
 	}
 
-	logger.Infow("file deleted", "path", absPath)
+	snap.SessionKey = sessionKey
+	snap.Operation = op
+	if op != OpDelete {
+		snap.AfterHash = hashContent(written)
+	}
+	if err := journal.Record(ctx, snap); err != nil {
+		return fmt.Errorf("record undo snapshot: %w", err)
+	}
 	return nil
 }
 
@@ -307,16 +479,9 @@ func (t *Tool) validatePath(path string) (string, error) {
 	// Clean the path to prevent traversal
 	absPath = filepath.Clean(absPath)
 
-	// Check against blocked paths
-	for _, blocked := range t.config.BlockedPaths {
-		absBlocked, err := filepath.Abs(blocked)
-		if err != nil {
-			continue
-		}
-		absBlocked = filepath.Clean(absBlocked)
-		if strings.HasPrefix(absPath, absBlocked) {
-			return "", fmt.Errorf("access denied: protected path")
-		}
+	// Check against blocked paths, also through symlinks
+	if t.isBlocked(absPath) || t.isBlocked(resolveExisting(absPath)) {
+		return "", fmt.Errorf("access denied: protected path")
 	}
 
 	// Check against allowed paths
@@ -345,3 +510,60 @@ func (t *Tool) validatePath(path string) (string, error) {
 
 	return absPath, nil
 }
+
+// isBlocked reports whether absPath matches one of the blocked globs. A
+// glob without a separator matches any element of the path (".env",
+// "*.pem"); other globs match the path or one of its parents ("~/.ssh").
+func (t *Tool) isBlocked(absPath string) bool {
+	for _, pattern := range t.config.BlockedPaths {
+		pattern = expandHome(pattern)
+		if !strings.ContainsRune(pattern, filepath.Separator) {
+			for _, elem := range strings.Split(absPath, string(filepath.Separator)) {
+				if ok, _ := filepath.Match(pattern, elem); ok {
+					return true
+				}
+			}
+			continue
+		}
+
+		absPattern, err := filepath.Abs(pattern)
+		if err != nil {
+			continue
+		}
+		for p := absPath; ; p = filepath.Dir(p) {
+			if ok, _ := filepath.Match(absPattern, p); ok {
+				return true
+			}
+			if filepath.Dir(p) == p {
+				break
+			}
+		}
+	}
+	return false
+}
+
+// resolveExisting resolves the symlinks of the longest existing prefix of
+// absPath.
+func resolveExisting(absPath string) string {
+	rest := ""
+	for p := absPath; ; p = filepath.Dir(p) {
+		if resolved, err := filepath.EvalSymlinks(p); err == nil {
+			return filepath.Join(resolved, rest)
+		}
+		if filepath.Dir(p) == p {
+			return absPath
+		}
+		rest = filepath.Join(filepath.Base(p), rest)
+	}
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
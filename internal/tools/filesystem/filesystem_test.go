@@ -1,6 +1,7 @@
 package filesystem
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"strings"
@@ -17,7 +18,7 @@ func TestReadWrite(t *testing.T) {
 
 	// Write
 	content := "hello\nworld"
-	if err := tool.Write(testFile, content); err != nil {
+	if err := tool.Write(context.Background(), testFile, content, false); err != nil {
 		t.Fatalf("write failed: %v", err)
 	}
 
@@ -38,7 +39,7 @@ func TestReadLines(t *testing.T) {
 	testFile := filepath.Join(tmpDir, "lines.txt")
 
 	content := "line1\nline2\nline3\nline4\nline5"
-	if err := tool.Write(testFile, content); err != nil {
+	if err := tool.Write(context.Background(), testFile, content, false); err != nil {
 		t.Fatalf("write failed: %v", err)
 	}
 
@@ -59,11 +60,11 @@ func TestEdit(t *testing.T) {
 	testFile := filepath.Join(tmpDir, "edit.txt")
 
 	content := "line1\nold\nline3"
-	if err := tool.Write(testFile, content); err != nil {
+	if err := tool.Write(context.Background(), testFile, content, false); err != nil {
 		t.Fatalf("write failed: %v", err)
 	}
 
-	if err := tool.Edit(testFile, 2, 2, "new"); err != nil {
+	if err := tool.Edit(context.Background(), testFile, 2, 2, "new", false); err != nil {
 		t.Fatalf("edit failed: %v", err)
 	}
 
@@ -132,6 +133,7 @@ func TestBlockedPaths(t *testing.T) {
 	require.NoError(t, os.WriteFile(
 		filepath.Join(allowedDir, "readme.txt"), []byte("hello"), 0644,
 	))
+	require.NoError(t, os.Symlink(blockedDir, filepath.Join(tmpDir, "link")))
 
 	tests := []struct {
 		give         string
@@ -155,6 +157,35 @@ func TestBlockedPaths(t *testing.T) {
 			giveBlocked: nil,
 			wantErr:     false,
 		},
+		{
+			give:         filepath.Join(allowedDir, ".env"),
+			giveBlocked:  []string{".env"},
+			wantErr:      true,
+			wantContains: "access denied: protected path",
+		},
+		{
+			give:         filepath.Join(blockedDir, "key.pem"),
+			giveBlocked:  []string{"*.pem"},
+			wantErr:      true,
+			wantContains: "access denied: protected path",
+		},
+		{
+			give:         filepath.Join(blockedDir, "key.pem"),
+			giveBlocked:  []string{filepath.Join(tmpDir, "sec*")},
+			wantErr:      true,
+			wantContains: "access denied: protected path",
+		},
+		{
+			give:        filepath.Join(tmpDir, "secrets-public", "a.txt"),
+			giveBlocked: []string{blockedDir},
+			wantErr:     false,
+		},
+		{
+			give:         filepath.Join(tmpDir, "link", "key.pem"),
+			giveBlocked:  []string{blockedDir},
+			wantErr:      true,
+			wantContains: "access denied: protected path",
+		},
 	}
 
 	for _, tt := range tests {
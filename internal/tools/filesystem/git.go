@@ -0,0 +1,88 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ErrUncommittedChanges is returned when a change would touch a file with
+// uncommitted git changes.
+var ErrUncommittedChanges = errors.New("file has uncommitted changes")
+
+// gitStatusTimeout bounds the git status call before each change.
+const gitStatusTimeout = 5 * time.Second
+
+// maxStatusLines caps the git status lines quoted in errors.
+const maxStatusLines = 5
+
+// gitStatus returns the porcelain status lines of absPath (a file or a
+// directory tree), or nil when the path is clean, not in a git work tree
+// or git is unavailable.
+func gitStatus(ctx context.Context, absPath string) []string {
+	// Run git inside a directory tree, so that deleting the root of a
+	// work tree is checked too.
+	dir, target := filepath.Dir(absPath), filepath.Base(absPath)
+	if info, err := os.Stat(absPath); err == nil && info.IsDir() {
+		dir, target = absPath, "."
+	} else if _, err := os.Stat(dir); err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, gitStatusTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "git", "-C", dir, "status", "--porcelain", "--", target)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+	var lines []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// checkUncommitted refuses changes to a path with uncommitted git changes,
+// unless the changes were made by the same session.
+func (t *Tool) checkUncommitted(ctx context.Context, absPath, sessionKey string) error {
+	status := gitStatus(ctx, absPath)
+	if len(status) == 0 {
+		return nil
+	}
+	if t.ownChange(ctx, absPath, sessionKey) {
+		return nil
+	}
+	if len(status) > maxStatusLines {
+		status = append(status[:maxStatusLines], "...")
+	}
+	return fmt.Errorf("%s: %w (%s); review them or retry with force", absPath, ErrUncommittedChanges, strings.Join(status, ", "))
+}
+
+// ownChange reports whether the current content of absPath was written by
+// the session, according to the undo journal.
+func (t *Tool) ownChange(ctx context.Context, absPath, sessionKey string) bool {
+	if t.config.Journal == nil {
+		return false
+	}
+	last, err := t.config.Journal.Latest(ctx, sessionKey, absPath)
+	if err != nil {
+		logger.Warnw("read undo journal", "path", absPath, "error", err)
+		return false
+	}
+	if last == nil || last.Undone || last.AfterHash == "" {
+		return false
+	}
+	content, err := os.ReadFile(absPath)
+	if err != nil {
+		return false
+	}
+	return hashContent(content) == last.AfterHash
+}
@@ -0,0 +1,335 @@
+package filesystem
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Operation is a filesystem change recorded in the undo journal.
+type Operation string
+
+const (
+	OpWrite  Operation = "write"
+	OpEdit   Operation = "edit"
+	OpDelete Operation = "delete"
+)
+
+// SnapshotKind is what existed at a path before a change.
+type SnapshotKind string
+
+const (
+	KindNone SnapshotKind = "none" // the path did not exist
+	KindFile SnapshotKind = "file"
+	KindDir  SnapshotKind = "dir"  // Content is a tar archive of the tree
+	KindLink SnapshotKind = "link" // Content is the link target
+)
+
+// Snapshot is an undo journal entry: the state of a path before a change.
+type Snapshot struct {
+	ID         uuid.UUID
+	SessionKey string
+	Path       string
+	Operation  Operation
+	Kind       SnapshotKind
+	Content    []byte
+	Mode       os.FileMode
+	AfterHash  string // SHA-256 of the content written, empty for deletions
+	Undone     bool
+	CreatedAt  time.Time
+}
+
+// Journal stores undo snapshots.
+type Journal interface {
+	// Record stores a snapshot.
+	Record(ctx context.Context, s Snapshot) error
+
+	// Latest returns the newest snapshot of path taken in the session, or
+	// nil if there is none.
+	Latest(ctx context.Context, sessionKey, path string) (*Snapshot, error)
+
+	// Pending returns up to limit snapshots of the session that have not
+	// been undone, newest first.
+	Pending(ctx context.Context, sessionKey string, limit int) ([]Snapshot, error)
+
+	// MarkUndone flags a snapshot as undone.
+	MarkUndone(ctx context.Context, id uuid.UUID) error
+
+	// List returns up to limit snapshots without their content, newest
+	// first. An empty sessionKey lists all sessions.
+	List(ctx context.Context, sessionKey string, limit int) ([]Snapshot, error)
+
+	// Prune deletes snapshots taken before the given time and returns how
+	// many were deleted.
+	Prune(ctx context.Context, before time.Time) (int, error)
+}
+
+// UndoResult describes one reverted change.
+type UndoResult struct {
+	Path      string    `json:"path"`
+	Operation Operation `json:"operation"`
+	Restored  string    `json:"restored"` // "file", "dir", "link" or "removed"
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// ErrSnapshotTooLarge is returned when the state before a change is too
+// large to keep in the undo journal.
+var ErrSnapshotTooLarge = errors.New("too large for the undo journal")
+
+// Undo reverts the last steps changes of a session that have not been
+// undone yet, newest first. Unless force is set, it stops at a path that
+// was changed again after the recorded change.
+func (t *Tool) Undo(ctx context.Context, sessionKey string, steps int, force bool) ([]UndoResult, error) {
+	if t.config.Journal == nil {
+		return nil, fmt.Errorf("undo journal unavailable")
+	}
+	if steps < 1 {
+		steps = 1
+	}
+
+	pending, err := t.config.Journal.Pending(ctx, sessionKey, steps)
+	if err != nil {
+		return nil, fmt.Errorf("load undo journal: %w", err)
+	}
+
+	results := make([]UndoResult, 0, len(pending))
+	for _, s := range pending {
+		if !force {
+			if err := checkUnchangedSince(s); err != nil {
+				return results, err
+			}
+		}
+		restored, err := restore(s)
+		if err != nil {
+			return results, fmt.Errorf("restore %s: %w", s.Path, err)
+		}
+		if err := t.config.Journal.MarkUndone(ctx, s.ID); err != nil {
+			return results, fmt.Errorf("mark snapshot undone: %w", err)
+		}
+		logger.Infow("file change undone", "path", s.Path, "operation", s.Operation, "session", sessionKey)
+		results = append(results, UndoResult{
+			Path:      s.Path,
+			Operation: s.Operation,
+			Restored:  restored,
+			ChangedAt: s.CreatedAt,
+		})
+	}
+	return results, nil
+}
+
+// checkUnchangedSince verifies that the path is still in the state the
+// recorded change left it in.
+func checkUnchangedSince(s Snapshot) error {
+	if s.Operation == OpDelete {
+		if _, err := os.Lstat(s.Path); err == nil {
+			return fmt.Errorf("%s was recreated after it was deleted; undo with force to replace it", s.Path)
+		}
+		return nil
+	}
+
+	content, err := os.ReadFile(s.Path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("read %s: %w", s.Path, err)
+	}
+	if err != nil || hashContent(content) != s.AfterHash {
+		return fmt.Errorf("%s changed after the %s; undo with force to overwrite it", s.Path, s.Operation)
+	}
+	return nil
+}
+
+// restore puts the snapshot state back at its path.
+func restore(s Snapshot) (string, error) {
+	switch s.Kind {
+	case KindNone:
+		if err := os.RemoveAll(s.Path); err != nil {
+			return "", err
+		}
+		return "removed", nil
+	case KindFile:
+		if err := writeAtomic(s.Path, s.Content, s.Mode.Perm()); err != nil {
+			return "", err
+		}
+		return "file", nil
+	case KindDir:
+		if err := os.RemoveAll(s.Path); err != nil {
+			return "", err
+		}
+		if err := extractTree(s.Path, s.Content, s.Mode.Perm()); err != nil {
+			return "", err
+		}
+		return "dir", nil
+	case KindLink:
+		if err := os.RemoveAll(s.Path); err != nil {
+			return "", err
+		}
+		if err := os.Symlink(string(s.Content), s.Path); err != nil {
+			return "", err
+		}
+		return "link", nil
+	default:
+		return "", fmt.Errorf("unknown snapshot kind %q", s.Kind)
+	}
+}
+
+// takeSnapshot captures the state of absPath, keeping at most maxSize
+// bytes of content.
+func takeSnapshot(absPath string, maxSize int64) (Snapshot, error) {
+	info, err := os.Lstat(absPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Snapshot{Path: absPath, Kind: KindNone}, nil
+	}
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("stat %s: %w", absPath, err)
+	}
+
+	s := Snapshot{Path: absPath, Mode: info.Mode()}
+	if info.IsDir() {
+		s.Kind = KindDir
+		s.Content, err = archiveTree(absPath, maxSize)
+		return s, err
+	}
+	if info.Mode()&fs.ModeSymlink != 0 {
+		target, err := os.Readlink(absPath)
+		if err != nil {
+			return Snapshot{}, fmt.Errorf("snapshot %s: %w", absPath, err)
+		}
+		s.Kind = KindLink
+		s.Content = []byte(target)
+		return s, nil
+	}
+	if !info.Mode().IsRegular() {
+		return Snapshot{}, fmt.Errorf("cannot snapshot %s: not a regular file", absPath)
+	}
+	if info.Size() > maxSize {
+		return Snapshot{}, fmt.Errorf("snapshot %s: %w (%d bytes, max %d)", absPath, ErrSnapshotTooLarge, info.Size(), maxSize)
+	}
+	s.Kind = KindFile
+	if s.Content, err = os.ReadFile(absPath); err != nil {
+		return Snapshot{}, fmt.Errorf("snapshot %s: %w", absPath, err)
+	}
+	return s, nil
+}
+
+// archiveTree returns a tar archive of the directory tree at root.
+func archiveTree(root string, maxSize int64) ([]byte, error) {
+	var (
+		buf   bytes.Buffer
+		total int64
+	)
+	tw := tar.NewWriter(&buf)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		var link string
+		if info.Mode()&fs.ModeSymlink != 0 {
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		} else if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil // sockets, devices and pipes cannot be restored
+		}
+
+		total += info.Size()
+		if total > maxSize {
+			return fmt.Errorf("snapshot %s: %w (max %d bytes)", root, ErrSnapshotTooLarge, maxSize)
+		}
+
+		hdr, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// extractTree recreates the directory tree of archive at root.
+func extractTree(root string, archive []byte, mode os.FileMode) error {
+	if err := os.MkdirAll(root, mode); err != nil {
+		return err
+	}
+	tr := tar.NewReader(bytes.NewReader(archive))
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !filepath.IsLocal(hdr.Name) {
+			return fmt.Errorf("invalid archive entry %q", hdr.Name)
+		}
+		target := filepath.Join(root, filepath.FromSlash(hdr.Name))
+		mode := hdr.FileInfo().Mode().Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, mode); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func hashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
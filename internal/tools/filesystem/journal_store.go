@@ -0,0 +1,148 @@
+package filesystem
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/langoai/lango/internal/ent"
+	"github.com/langoai/lango/internal/ent/filesnapshot"
+)
+
+// EntJournal stores undo snapshots using the Ent ORM client.
+type EntJournal struct {
+	client *ent.Client
+}
+
+var _ Journal = (*EntJournal)(nil)
+
+// NewEntJournal creates a new EntJournal backed by the given Ent client.
+func NewEntJournal(client *ent.Client) *EntJournal {
+	return &EntJournal{client: client}
+}
+
+// Record stores a snapshot.
+func (j *EntJournal) Record(ctx context.Context, s Snapshot) error {
+	// Times are kept in UTC: SQLite compares them as strings.
+	builder := j.client.FileSnapshot.Create().
+		SetSessionKey(s.SessionKey).
+		SetPath(s.Path).
+		SetOperation(filesnapshot.Operation(s.Operation)).
+		SetKind(filesnapshot.Kind(s.Kind)).
+		SetMode(uint32(s.Mode)).
+		SetAfterHash(s.AfterHash).
+		SetCreatedAt(time.Now().UTC())
+	if s.Content != nil {
+		builder.SetContent(s.Content)
+	}
+	if _, err := builder.Save(ctx); err != nil {
+		return fmt.Errorf("create file snapshot: %w", err)
+	}
+	return nil
+}
+
+// Latest returns the newest snapshot of path taken in the session.
+func (j *EntJournal) Latest(ctx context.Context, sessionKey, path string) (*Snapshot, error) {
+	row, err := j.client.FileSnapshot.Query().
+		Where(filesnapshot.SessionKey(sessionKey), filesnapshot.Path(path)).
+		Order(ent.Desc(filesnapshot.FieldCreatedAt)).
+		Select(metadataFields...).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query latest file snapshot: %w", err)
+	}
+	s := snapshotFromEnt(row)
+	return &s, nil
+}
+
+// Pending returns up to limit snapshots of the session that have not been
+// undone, newest first.
+func (j *EntJournal) Pending(ctx context.Context, sessionKey string, limit int) ([]Snapshot, error) {
+	rows, err := j.client.FileSnapshot.Query().
+		Where(filesnapshot.SessionKey(sessionKey), filesnapshot.Undone(false)).
+		Order(ent.Desc(filesnapshot.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query pending file snapshots: %w", err)
+	}
+	return snapshotsFromEnt(rows), nil
+}
+
+// MarkUndone flags a snapshot as undone.
+func (j *EntJournal) MarkUndone(ctx context.Context, id uuid.UUID) error {
+	if err := j.client.FileSnapshot.UpdateOneID(id).SetUndone(true).Exec(ctx); err != nil {
+		return fmt.Errorf("update file snapshot: %w", err)
+	}
+	return nil
+}
+
+// List returns up to limit snapshots without their content, newest first.
+func (j *EntJournal) List(ctx context.Context, sessionKey string, limit int) ([]Snapshot, error) {
+	query := j.client.FileSnapshot.Query().
+		Order(ent.Desc(filesnapshot.FieldCreatedAt))
+	if sessionKey != "" {
+		query = query.Where(filesnapshot.SessionKey(sessionKey))
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	rows, err := query.Select(metadataFields...).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list file snapshots: %w", err)
+	}
+	return snapshotsFromEnt(rows), nil
+}
+
+// Prune deletes snapshots taken before the given time.
+func (j *EntJournal) Prune(ctx context.Context, before time.Time) (int, error) {
+	n, err := j.client.FileSnapshot.Delete().
+		Where(filesnapshot.CreatedAtLT(before.UTC())).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("prune file snapshots: %w", err)
+	}
+	return n, nil
+}
+
+// metadataFields are the snapshot columns except the content.
+var metadataFields = []string{
+	filesnapshot.FieldID,
+	filesnapshot.FieldSessionKey,
+	filesnapshot.FieldPath,
+	filesnapshot.FieldOperation,
+	filesnapshot.FieldKind,
+	filesnapshot.FieldMode,
+	filesnapshot.FieldAfterHash,
+	filesnapshot.FieldUndone,
+	filesnapshot.FieldCreatedAt,
+}
+
+func snapshotsFromEnt(rows []*ent.FileSnapshot) []Snapshot {
+	snapshots := make([]Snapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshots = append(snapshots, snapshotFromEnt(row))
+	}
+	return snapshots
+}
+
+func snapshotFromEnt(row *ent.FileSnapshot) Snapshot {
+	return Snapshot{
+		ID:         row.ID,
+		SessionKey: row.SessionKey,
+		Path:       row.Path,
+		Operation:  Operation(row.Operation),
+		Kind:       SnapshotKind(row.Kind),
+		Content:    row.Content,
+		Mode:       os.FileMode(row.Mode),
+		AfterHash:  row.AfterHash,
+		Undone:     row.Undone,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -0,0 +1,162 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/ent/enttest"
+	"github.com/langoai/lango/internal/session"
+)
+
+func newJournaledTool(t *testing.T, cfg Config) *Tool {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	cfg.Journal = NewEntJournal(client)
+	return New(cfg)
+}
+
+func TestUndo_WriteAndEdit(t *testing.T) {
+	tool := newJournaledTool(t, Config{})
+	ctx := session.WithSessionKey(context.Background(), "s1")
+	path := filepath.Join(t.TempDir(), "a.txt")
+
+	require.NoError(t, tool.Write(ctx, path, "one\ntwo", false))
+	require.NoError(t, tool.Edit(ctx, path, 2, 2, "TWO", false))
+
+	results, err := tool.Undo(ctx, "s1", 1, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, OpEdit, results[0].Operation)
+	got, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "one\ntwo", string(got))
+
+	results, err = tool.Undo(ctx, "s1", 1, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "removed", results[0].Restored)
+	assert.NoFileExists(t, path)
+
+	results, err = tool.Undo(ctx, "s1", 1, false)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestUndo_DeleteDirectory(t *testing.T) {
+	tool := newJournaledTool(t, Config{})
+	ctx := session.WithSessionKey(context.Background(), "s1")
+	dir := filepath.Join(t.TempDir(), "tree")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("b"), 0600))
+	require.NoError(t, os.Symlink("sub/b.txt", filepath.Join(dir, "link")))
+
+	require.NoError(t, tool.Delete(ctx, dir, false))
+	assert.NoDirExists(t, dir)
+
+	results, err := tool.Undo(ctx, "s1", 1, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "dir", results[0].Restored)
+
+	got, err := os.ReadFile(filepath.Join(dir, "link"))
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(got))
+	info, err := os.Stat(filepath.Join(dir, "sub", "b.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestUndo_ChangedSince(t *testing.T) {
+	tool := newJournaledTool(t, Config{})
+	ctx := session.WithSessionKey(context.Background(), "s1")
+	path := filepath.Join(t.TempDir(), "a.txt")
+	require.NoError(t, os.WriteFile(path, []byte("original"), 0644))
+
+	require.NoError(t, tool.Write(ctx, path, "agent", false))
+	require.NoError(t, os.WriteFile(path, []byte("user"), 0644))
+
+	_, err := tool.Undo(ctx, "s1", 1, false)
+	require.Error(t, err)
+	got, _ := os.ReadFile(path)
+	assert.Equal(t, "user", string(got))
+
+	_, err = tool.Undo(ctx, "s1", 1, true)
+	require.NoError(t, err)
+	got, _ = os.ReadFile(path)
+	assert.Equal(t, "original", string(got))
+}
+
+func TestUndo_SessionsAreSeparate(t *testing.T) {
+	tool := newJournaledTool(t, Config{})
+	dir := t.TempDir()
+	require.NoError(t, tool.Write(session.WithSessionKey(context.Background(), "s1"), filepath.Join(dir, "a.txt"), "a", false))
+	require.NoError(t, tool.Write(session.WithSessionKey(context.Background(), "s2"), filepath.Join(dir, "b.txt"), "b", false))
+
+	results, err := tool.Undo(context.Background(), "s1", 5, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, filepath.Join(dir, "a.txt"), results[0].Path)
+	assert.FileExists(t, filepath.Join(dir, "b.txt"))
+}
+
+func TestSnapshotTooLarge(t *testing.T) {
+	tool := newJournaledTool(t, Config{MaxReadSize: 4})
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "big.txt")
+	require.NoError(t, os.WriteFile(path, []byte("too large"), 0644))
+
+	err := tool.Delete(ctx, path, false)
+	require.ErrorIs(t, err, ErrSnapshotTooLarge)
+	assert.FileExists(t, path)
+
+	require.NoError(t, tool.Delete(ctx, path, true))
+	assert.NoFileExists(t, path)
+}
+
+func TestProtectUncommitted(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	repo := t.TempDir()
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	path := filepath.Join(repo, "main.go")
+	git("init", "-q")
+	require.NoError(t, os.WriteFile(path, []byte("package main\n"), 0644))
+	git("add", "main.go")
+	git("commit", "-q", "-m", "init")
+
+	tool := newJournaledTool(t, Config{ProtectUncommitted: true})
+	s1 := session.WithSessionKey(context.Background(), "s1")
+	s2 := session.WithSessionKey(context.Background(), "s2")
+
+	// Committed files can be changed; the session may keep changing them.
+	require.NoError(t, tool.Write(s1, path, "package main\n\n// one\n", false))
+	require.NoError(t, tool.Write(s1, path, "package main\n\n// two\n", false))
+
+	// Another session's uncommitted changes are protected.
+	err := tool.Write(s2, path, "package other\n", false)
+	require.ErrorIs(t, err, ErrUncommittedChanges)
+	err = tool.Delete(s2, repo, false)
+	require.ErrorIs(t, err, ErrUncommittedChanges)
+
+	require.NoError(t, tool.Write(s2, path, "package other\n", true))
+
+	// Creating new files is not affected.
+	require.NoError(t, tool.Write(s2, filepath.Join(repo, "new.go"), "package main\n", false))
+}
@@ -0,0 +1,432 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/langoai/lango/internal/session"
+)
+
+// maxConflictLines caps the lines quoted in a patch conflict.
+const maxConflictLines = 5
+
+// devNull is the path of a missing side in a unified diff.
+const devNull = "/dev/null"
+
+var hunkHeader = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// PatchResult is the result of ApplyPatch.
+type PatchResult struct {
+	Applied bool              `json:"applied"`
+	DryRun  bool              `json:"dryRun"`
+	Files   []PatchFileResult `json:"files"`
+}
+
+// PatchFileResult describes the change of one file of a patch.
+type PatchFileResult struct {
+	Path      string          `json:"path"`
+	Operation string          `json:"operation"` // create, modify or delete
+	Hunks     int             `json:"hunks"`
+	Added     int             `json:"added"`
+	Removed   int             `json:"removed"`
+	Conflicts []PatchConflict `json:"conflicts,omitempty"`
+}
+
+// PatchConflict is a hunk that does not apply.
+type PatchConflict struct {
+	Hunk     int      `json:"hunk"` // 1-indexed
+	Line     int      `json:"line"` // line of the file where the hunk should apply
+	Reason   string   `json:"reason"`
+	Expected []string `json:"expected,omitempty"`
+	Actual   []string `json:"actual,omitempty"`
+}
+
+// filePatch is the part of a unified diff that changes one file.
+type filePatch struct {
+	oldPath, newPath string
+	hunks            []hunk
+}
+
+// hunk is one "@@" section. Lines keep their line endings, so that a
+// missing newline at the end of a file is part of the comparison.
+type hunk struct {
+	oldStart       int
+	old, new       []string
+	added, removed int
+}
+
+// ApplyPatch applies a unified diff (as produced by diff -u or git diff)
+// to the files below dir. Either every hunk applies or no file is changed;
+// the result lists the conflicts. With dryRun the result is computed
+// without changing files. Unless force is set, files with uncommitted git
+// changes are not patched.
+func (t *Tool) ApplyPatch(ctx context.Context, patch, dir string, dryRun, force bool) (*PatchResult, error) {
+	patches, err := parsePatch(patch)
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		dir = "."
+	}
+
+	type change struct {
+		absPath string
+		patchChange
+	}
+	var (
+		result   = &PatchResult{DryRun: dryRun, Files: make([]PatchFileResult, 0, len(patches))}
+		changes  []change
+		seen     = make(map[string]bool)
+		conflict bool
+	)
+	for _, fp := range patches {
+		target := fp.newPath
+		if target == devNull {
+			target = fp.oldPath
+		}
+		absPath, err := t.validatePath(filepath.Join(dir, filepath.FromSlash(target)))
+		if err != nil {
+			return nil, err
+		}
+		if seen[absPath] {
+			return nil, fmt.Errorf("patch changes %s more than once", target)
+		}
+		seen[absPath] = true
+
+		fr, c, err := t.patchFile(absPath, fp)
+		if err != nil {
+			return nil, err
+		}
+		result.Files = append(result.Files, fr)
+		if len(fr.Conflicts) > 0 {
+			conflict = true
+			continue
+		}
+		changes = append(changes, change{absPath: absPath, patchChange: c})
+	}
+	if conflict || dryRun {
+		return result, nil
+	}
+
+	// Check every file before changing any.
+	if t.config.ProtectUncommitted && !force {
+		sessionKey := session.SessionKeyFromContext(ctx)
+		for _, c := range changes {
+			if err := t.checkUncommitted(ctx, c.absPath, sessionKey); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i, c := range changes {
+		err := t.mutate(ctx, c.absPath, c.op, force, func() ([]byte, error) {
+			if c.op == OpDelete {
+				if err := os.Remove(c.absPath); err != nil {
+					return nil, fmt.Errorf("delete: %w", err)
+				}
+				return nil, nil
+			}
+			if err := writeAtomic(c.absPath, c.content, c.perm); err != nil {
+				return nil, err
+			}
+			return c.content, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("patch %s (%d of %d files already changed): %w", c.absPath, i, len(changes), err)
+		}
+	}
+
+	result.Applied = true
+	logger.Infow("patch applied", "dir", dir, "files", len(changes))
+	return result, nil
+}
+
+// patchChange is the content a file patch produces.
+type patchChange struct {
+	op      Operation
+	content []byte
+	perm    os.FileMode
+}
+
+// patchFile computes the new content of absPath, or the conflicts that
+// prevent it.
+func (t *Tool) patchFile(absPath string, fp filePatch) (PatchFileResult, patchChange, error) {
+	fr := PatchFileResult{Path: absPath, Hunks: len(fp.hunks)}
+	for _, h := range fp.hunks {
+		fr.Added += h.added
+		fr.Removed += h.removed
+	}
+
+	info, err := os.Stat(absPath)
+	exists := err == nil
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fr, patchChange{}, fmt.Errorf("stat %s: %w", absPath, err)
+	}
+
+	if fp.oldPath == devNull {
+		fr.Operation = "create"
+		if exists {
+			fr.Conflicts = []PatchConflict{{Hunk: 1, Line: 1, Reason: "file already exists"}}
+			return fr, patchChange{}, nil
+		}
+		var content []string
+		for _, h := range fp.hunks {
+			content = append(content, h.new...)
+		}
+		return fr, patchChange{op: OpWrite, content: []byte(strings.Join(content, "")), perm: 0644}, nil
+	}
+
+	fr.Operation = "modify"
+	if fp.newPath == devNull {
+		fr.Operation = "delete"
+	}
+	if !exists {
+		fr.Conflicts = []PatchConflict{{Hunk: 1, Line: 1, Reason: "file does not exist"}}
+		return fr, patchChange{}, nil
+	}
+	if info.IsDir() {
+		return fr, patchChange{}, fmt.Errorf("cannot patch directory: %s", absPath)
+	}
+	if info.Size() > t.config.MaxReadSize {
+		return fr, patchChange{}, fmt.Errorf("file too large: %s: %d bytes (max %d)", absPath, info.Size(), t.config.MaxReadSize)
+	}
+	data, err := os.ReadFile(absPath)
+	if err != nil {
+		return fr, patchChange{}, fmt.Errorf("read file: %w", err)
+	}
+	if isBinary(data) {
+		return fr, patchChange{}, fmt.Errorf("cannot patch binary file: %s", absPath)
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var (
+		out    []string
+		next   int // first line not yet copied to out
+		offset int // shift of the previous hunk from its stated position
+	)
+	for i, h := range fp.hunks {
+		want := h.oldStart - 1 + offset
+		if len(h.old) == 0 {
+			want = h.oldStart + offset // pure insertion after line oldStart
+		}
+		pos := findHunk(lines, h.old, want, next)
+		if pos < 0 {
+			reason := "context does not match"
+			if findHunk(lines, h.new, want, next) >= 0 && len(h.new) > 0 {
+				reason = "hunk is already applied"
+			}
+			fr.Conflicts = append(fr.Conflicts, PatchConflict{
+				Hunk:     i + 1,
+				Line:     h.oldStart,
+				Reason:   reason,
+				Expected: quoteLines(h.old),
+				Actual:   quoteLines(lines[min(max(want, 0), len(lines)):]),
+			})
+			continue
+		}
+		out = append(out, lines[next:pos]...)
+		out = append(out, h.new...)
+		next = pos + len(h.old)
+		offset = pos - (h.oldStart - 1)
+		if len(h.old) == 0 {
+			offset = pos - h.oldStart
+		}
+	}
+	if len(fr.Conflicts) > 0 {
+		return fr, patchChange{}, nil
+	}
+	out = append(out, lines[next:]...)
+
+	if fr.Operation == "delete" {
+		if len(out) > 0 {
+			fr.Conflicts = []PatchConflict{{Hunk: len(fp.hunks), Line: 1, Reason: "file has content the patch does not remove", Actual: quoteLines(out)}}
+			return fr, patchChange{}, nil
+		}
+		return fr, patchChange{op: OpDelete}, nil
+	}
+	return fr, patchChange{op: OpEdit, content: []byte(strings.Join(out, "")), perm: info.Mode().Perm()}, nil
+}
+
+// findHunk returns the line where old occurs in lines, searching outward
+// from want but not before from, or -1.
+func findHunk(lines, old []string, want, from int) int {
+	matchAt := func(pos int) bool {
+		if pos < from || pos+len(old) > len(lines) {
+			return false
+		}
+		for i, l := range old {
+			if lines[pos+i] != l {
+				return false
+			}
+		}
+		return true
+	}
+	want = min(max(want, from), len(lines))
+	for d := 0; want-d >= from || want+d <= len(lines); d++ {
+		if matchAt(want - d) {
+			return want - d
+		}
+		if d > 0 && matchAt(want+d) {
+			return want + d
+		}
+	}
+	return -1
+}
+
+// parsePatch parses a unified diff with one or more files.
+func parsePatch(patch string) ([]filePatch, error) {
+	lines := strings.Split(strings.TrimSuffix(patch, "\n"), "\n")
+	var (
+		patches []filePatch
+		cur     *filePatch
+	)
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case strings.HasPrefix(line, "Binary files ") || strings.HasPrefix(line, "GIT binary patch"):
+			return nil, fmt.Errorf("parse patch: line %d: binary patches are not supported", i+1)
+
+		case strings.HasPrefix(line, "--- ") && i+1 < len(lines) && strings.HasPrefix(lines[i+1], "+++ "):
+			patches = append(patches, filePatch{
+				oldPath: patchPath(line[4:], "a/"),
+				newPath: patchPath(lines[i+1][4:], "b/"),
+			})
+			cur = &patches[len(patches)-1]
+			if cur.oldPath != devNull && cur.newPath != devNull && cur.oldPath != cur.newPath {
+				return nil, fmt.Errorf("parse patch: line %d: renames are not supported (%s -> %s)", i+1, cur.oldPath, cur.newPath)
+			}
+			i++
+
+		case strings.HasPrefix(line, "@@"):
+			if cur == nil {
+				return nil, fmt.Errorf("parse patch: line %d: hunk without file header", i+1)
+			}
+			h, end, err := parseHunk(lines, i)
+			if err != nil {
+				return nil, err
+			}
+			cur.hunks = append(cur.hunks, h)
+			i = end
+		}
+	}
+	if len(patches) == 0 {
+		return nil, fmt.Errorf("parse patch: no file changes found")
+	}
+	for _, p := range patches {
+		if len(p.hunks) == 0 {
+			return nil, fmt.Errorf("parse patch: %s has no hunks", p.newPath)
+		}
+	}
+	return patches, nil
+}
+
+// parseHunk parses the hunk starting at lines[start] and returns the index
+// of its last line.
+func parseHunk(lines []string, start int) (hunk, int, error) {
+	m := hunkHeader.FindStringSubmatch(lines[start])
+	if m == nil {
+		return hunk{}, 0, fmt.Errorf("parse patch: line %d: malformed hunk header %q", start+1, lines[start])
+	}
+	h := hunk{oldStart: atoi(m[1], 0)}
+	oldCount, newCount := atoi(m[2], 1), atoi(m[4], 1)
+
+	i := start + 1
+	for ; i < len(lines) && (oldCount > 0 || newCount > 0); i++ {
+		line := lines[i]
+		if line == "" {
+			line = " " // some editors strip the space of empty context lines
+		}
+		text := line[1:] + "\n"
+		switch line[0] {
+		case ' ':
+			h.old = append(h.old, text)
+			h.new = append(h.new, text)
+			oldCount--
+			newCount--
+		case '-':
+			h.old = append(h.old, text)
+			h.removed++
+			oldCount--
+		case '+':
+			h.new = append(h.new, text)
+			h.added++
+			newCount--
+		case '\\':
+			noNewlineAtEnd(&h, lines[i-1])
+		default:
+			return hunk{}, 0, fmt.Errorf("parse patch: line %d: unexpected %q in hunk", i+1, line)
+		}
+	}
+	if oldCount > 0 || newCount > 0 {
+		return hunk{}, 0, fmt.Errorf("parse patch: line %d: hunk is shorter than its header", i+1)
+	}
+	if i < len(lines) && strings.HasPrefix(lines[i], `\`) {
+		noNewlineAtEnd(&h, lines[i-1])
+		i++
+	}
+	return h, i - 1, nil
+}
+
+// noNewlineAtEnd applies a "\ No newline at end of file" marker to the
+// hunk line before it.
+func noNewlineAtEnd(h *hunk, prev string) {
+	trim := func(s []string) {
+		if n := len(s); n > 0 {
+			s[n-1] = strings.TrimSuffix(s[n-1], "\n")
+		}
+	}
+	switch {
+	case strings.HasPrefix(prev, "-"):
+		trim(h.old)
+	case strings.HasPrefix(prev, "+"):
+		trim(h.new)
+	default:
+		trim(h.old)
+		trim(h.new)
+	}
+}
+
+// patchPath extracts the path of a "---" or "+++" header, dropping a
+// trailing timestamp and the git prefix.
+func patchPath(header, prefix string) string {
+	if i := strings.IndexByte(header, '\t'); i >= 0 {
+		header = header[:i]
+	}
+	header = strings.TrimSpace(header)
+	if header == devNull {
+		return header
+	}
+	return strings.TrimPrefix(header, prefix)
+}
+
+func quoteLines(lines []string) []string {
+	if len(lines) > maxConflictLines {
+		lines = lines[:maxConflictLines]
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		out[i] = truncateLine(strings.TrimRight(l, "\r\n"))
+	}
+	return out
+}
+
+func atoi(s string, def int) int {
+	if s == "" {
+		return def
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return def
+	}
+	return n
+}
@@ -0,0 +1,213 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/langoai/lango/internal/session"
+)
+
+const original = "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+
+func TestApplyPatch(t *testing.T) {
+	tests := []struct {
+		give        string
+		givePatch   string
+		wantApplied bool
+		wantFiles   map[string]string // "" = deleted
+		wantReason  string
+	}{
+		{
+			give: "modify with two hunks",
+			givePatch: `diff --git a/a.txt b/a.txt
+--- a/a.txt
++++ b/a.txt
+@@ -1,3 +1,3 @@
+ one
+-two
++TWO
+ three
+@@ -8,3 +8,4 @@
+ eight
+ nine
++nine and a half
+ ten
+`,
+			wantApplied: true,
+			wantFiles:   map[string]string{"a.txt": "one\nTWO\nthree\nfour\nfive\nsix\nseven\neight\nnine\nnine and a half\nten\n"},
+		},
+		{
+			give: "hunk with offset",
+			givePatch: `--- a.txt
++++ a.txt
+@@ -1,3 +1,3 @@
+ four
+-five
++FIVE
+ six
+`,
+			wantApplied: true,
+			wantFiles:   map[string]string{"a.txt": "one\ntwo\nthree\nfour\nFIVE\nsix\nseven\neight\nnine\nten\n"},
+		},
+		{
+			give: "create and delete",
+			givePatch: `--- /dev/null
++++ b/sub/new.txt
+@@ -0,0 +1,2 @@
++hello
++world
+\ No newline at end of file
+--- a/a.txt
++++ /dev/null
+@@ -1,10 +0,0 @@
+-one
+-two
+-three
+-four
+-five
+-six
+-seven
+-eight
+-nine
+-ten
+`,
+			wantApplied: true,
+			wantFiles:   map[string]string{"sub/new.txt": "hello\nworld", "a.txt": ""},
+		},
+		{
+			give: "conflict",
+			givePatch: `--- a/a.txt
++++ b/a.txt
+@@ -2,3 +2,3 @@
+ two
+-drei
++3
+ four
+`,
+			wantFiles:  map[string]string{"a.txt": original},
+			wantReason: "context does not match",
+		},
+		{
+			give: "already applied",
+			givePatch: `--- a/a.txt
++++ b/a.txt
+@@ -2,1 +2,1 @@
+-zwei
++two
+`,
+			wantFiles:  map[string]string{"a.txt": original},
+			wantReason: "hunk is already applied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			dir := t.TempDir()
+			writeTree(t, dir, map[string]string{"a.txt": original})
+			tool := New(Config{})
+
+			result, err := tool.ApplyPatch(context.Background(), tt.givePatch, dir, false, false)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantApplied, result.Applied)
+			if tt.wantReason != "" {
+				require.Len(t, result.Files, 1)
+				require.NotEmpty(t, result.Files[0].Conflicts)
+				assert.Equal(t, tt.wantReason, result.Files[0].Conflicts[0].Reason)
+			}
+
+			for name, want := range tt.wantFiles {
+				got, err := os.ReadFile(filepath.Join(dir, name))
+				if want == "" {
+					assert.True(t, os.IsNotExist(err), "%s should be deleted", name)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, want, string(got))
+			}
+		})
+	}
+}
+
+func TestApplyPatch_AllOrNothing(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"a.txt": original, "b.txt": "b\n"})
+	tool := New(Config{})
+
+	patch := `--- a/a.txt
++++ b/a.txt
+@@ -1 +1 @@
+-one
++ONE
+--- a/b.txt
++++ b/b.txt
+@@ -1 +1 @@
+-x
++y
+`
+	result, err := tool.ApplyPatch(context.Background(), patch, dir, false, false)
+	require.NoError(t, err)
+	assert.False(t, result.Applied)
+	require.Len(t, result.Files, 2)
+	assert.Empty(t, result.Files[0].Conflicts)
+	assert.Len(t, result.Files[1].Conflicts, 1)
+
+	got, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, original, string(got))
+}
+
+func TestApplyPatch_DryRunAndUndo(t *testing.T) {
+	tool := newJournaledTool(t, Config{})
+	ctx := session.WithSessionKey(context.Background(), "s1")
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"a.txt": original})
+
+	patch := "--- a/a.txt\n+++ b/a.txt\n@@ -1 +1 @@\n-one\n+ONE\n"
+	result, err := tool.ApplyPatch(ctx, patch, dir, true, false)
+	require.NoError(t, err)
+	assert.False(t, result.Applied)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.Files[0].Added)
+	assert.Equal(t, 1, result.Files[0].Removed)
+	got, _ := os.ReadFile(filepath.Join(dir, "a.txt"))
+	assert.Equal(t, original, string(got))
+
+	result, err = tool.ApplyPatch(ctx, patch, dir, false, false)
+	require.NoError(t, err)
+	assert.True(t, result.Applied)
+
+	_, err = tool.Undo(ctx, "s1", 1, false)
+	require.NoError(t, err)
+	got, _ = os.ReadFile(filepath.Join(dir, "a.txt"))
+	assert.Equal(t, original, string(got))
+}
+
+func TestApplyPatch_Rejects(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"a.txt": original, ".env": "TOKEN=1\n"})
+	tool := New(Config{BlockedPaths: []string{".env"}})
+
+	tests := []struct {
+		give      string
+		givePatch string
+		wantErr   string
+	}{
+		{give: "no files", givePatch: "just text", wantErr: "no file changes"},
+		{give: "blocked path", givePatch: "--- a/.env\n+++ b/.env\n@@ -1 +1 @@\n-TOKEN=1\n+TOKEN=2\n", wantErr: "access denied"},
+		{give: "rename", givePatch: "--- a/a.txt\n+++ b/b.txt\n@@ -1 +1 @@\n-one\n+ONE\n", wantErr: "renames are not supported"},
+		{give: "short hunk", givePatch: "--- a/a.txt\n+++ b/a.txt\n@@ -1,2 +1,2 @@\n-one\n+ONE\n", wantErr: "shorter than its header"},
+		{give: "binary", givePatch: "diff --git a/x b/x\nBinary files a/x and b/x differ\n", wantErr: "binary patches"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			_, err := tool.ApplyPatch(context.Background(), tt.givePatch, dir, false, false)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package filesystem
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Search limits.
+const (
+	defaultGlobLimit   = 200
+	defaultGrepMatches = 100
+	maxContextLines    = 10
+	maxMatchLineLength = 500
+)
+
+// errStopWalk ends a walk early without an error.
+var errStopWalk = errors.New("stop walk")
+
+// GlobResult is the result of Glob.
+type GlobResult struct {
+	Files     []FileInfo `json:"files"`
+	Truncated bool       `json:"truncated"`
+}
+
+// GrepOptions configures Grep.
+type GrepOptions struct {
+	Pattern        string // regular expression (RE2 syntax)
+	Path           string // file or directory to search (default ".")
+	Include        string // glob of files to search, e.g. "*.go" or "cmd/**/*.go"
+	IgnoreCase     bool
+	ContextLines   int  // lines of context before and after each match
+	MaxMatches     int  // default 100
+	IncludeIgnored bool // also search files excluded by .gitignore
+}
+
+// GrepMatch is a matching line.
+type GrepMatch struct {
+	Path   string   `json:"path"`
+	Line   int      `json:"line"`
+	Text   string   `json:"text"`
+	Before []string `json:"before,omitempty"`
+	After  []string `json:"after,omitempty"`
+}
+
+// GrepResult is the result of Grep.
+type GrepResult struct {
+	Matches       []GrepMatch `json:"matches"`
+	FilesSearched int         `json:"filesSearched"`
+	FilesSkipped  int         `json:"filesSkipped"` // binary, unreadable or larger than MaxReadSize
+	Truncated     bool        `json:"truncated"`
+}
+
+// Glob returns the files below root whose path relative to root matches
+// pattern, in lexical order. "**" matches any number of directories, so
+// "**/*.go" finds Go files at any depth while "*.go" only looks in root.
+func (t *Tool) Glob(ctx context.Context, root, pattern string, limit int, includeIgnored bool) (*GlobResult, error) {
+	pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+	if pattern == "" {
+		return nil, fmt.Errorf("pattern is required")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	if root == "" {
+		root = "."
+	}
+	absRoot, err := t.validatePath(root)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultGlobLimit
+	}
+
+	result := &GlobResult{Files: []FileInfo{}}
+	err = t.walk(ctx, absRoot, includeIgnored, func(p string, info fs.FileInfo) error {
+		if !matchGlob(pattern, relSlash(absRoot, p)) {
+			return nil
+		}
+		if len(result.Files) == limit {
+			result.Truncated = true
+			return errStopWalk
+		}
+		result.Files = append(result.Files, newFileInfo(p, info))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Grep searches files for lines matching a regular expression. Binary
+// files and files larger than MaxReadSize are skipped.
+func (t *Tool) Grep(ctx context.Context, opts GrepOptions) (*GrepResult, error) {
+	if opts.Pattern == "" {
+		return nil, fmt.Errorf("pattern is required")
+	}
+	expr := opts.Pattern
+	if opts.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+
+	include := strings.TrimPrefix(filepath.ToSlash(opts.Include), "./")
+	if include != "" {
+		if _, err := path.Match(include, ""); err != nil {
+			return nil, fmt.Errorf("invalid include %q: %w", include, err)
+		}
+	}
+
+	if opts.Path == "" {
+		opts.Path = "."
+	}
+	absRoot, err := t.validatePath(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	maxMatches := opts.MaxMatches
+	if maxMatches <= 0 {
+		maxMatches = defaultGrepMatches
+	}
+	contextLines := min(max(opts.ContextLines, 0), maxContextLines)
+
+	result := &GrepResult{Matches: []GrepMatch{}}
+	err = t.walk(ctx, absRoot, opts.IncludeIgnored, func(p string, info fs.FileInfo) error {
+		if include != "" && !matchInclude(include, relSlash(absRoot, p)) {
+			return nil
+		}
+		if info.Size() > t.config.MaxReadSize {
+			result.FilesSkipped++
+			return nil
+		}
+		content, err := os.ReadFile(p)
+		if err != nil || isBinary(content) {
+			result.FilesSkipped++
+			return nil
+		}
+		result.FilesSearched++
+
+		lines := splitLines(content)
+		for i, line := range lines {
+			if !re.MatchString(line) {
+				continue
+			}
+			if len(result.Matches) == maxMatches {
+				result.Truncated = true
+				return errStopWalk
+			}
+			m := GrepMatch{Path: p, Line: i + 1, Text: truncateLine(line)}
+			if contextLines > 0 {
+				m.Before = truncateLines(lines[max(i-contextLines, 0):i])
+				m.After = truncateLines(lines[i+1 : min(i+1+contextLines, len(lines))])
+			}
+			result.Matches = append(result.Matches, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Infow("files searched", "path", absRoot, "files", result.FilesSearched, "matches", len(result.Matches))
+	return result, nil
+}
+
+// walk calls fn for each regular file below root (or root itself if it is
+// a file). It skips .git directories, blocked paths and, unless
+// includeIgnored is set, paths excluded by .gitignore. Symlinks to files
+// are followed unless they point into a blocked path; symlinked
+// directories are not descended.
+func (t *Tool) walk(ctx context.Context, root string, includeIgnored bool, fn func(path string, info fs.FileInfo) error) error {
+	var ignore *ignoreMatcher
+	if !includeIgnored {
+		ignore = newIgnoreMatcher(root)
+	}
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == root {
+				return err
+			}
+			return nil // unreadable entries are skipped
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if p != root && (d.Name() == ".git" || t.isBlocked(p) || ignore != nil && ignore.ignored(p, true)) {
+				return filepath.SkipDir
+			}
+			if ignore != nil {
+				ignore.load(p)
+			}
+			return nil
+		}
+
+		if p != root && (t.isBlocked(p) || ignore != nil && ignore.ignored(p, false)) {
+			return nil
+		}
+		if d.Type()&fs.ModeSymlink != 0 && t.isBlocked(resolveExisting(p)) {
+			return nil
+		}
+		info, err := os.Stat(p)
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+		return fn(p, info)
+	})
+	if errors.Is(err, errStopWalk) {
+		return nil
+	}
+	return err
+}
+
+// matchInclude matches an include glob: a glob without a slash matches the
+// file name, others the path relative to the search root.
+func matchInclude(include, rel string) bool {
+	if !strings.Contains(include, "/") {
+		ok, _ := path.Match(include, path.Base(rel))
+		return ok
+	}
+	return matchGlob(include, rel)
+}
+
+// relSlash returns p relative to root with forward slashes, or the base
+// name of p when p is root.
+func relSlash(root, p string) string {
+	rel, err := filepath.Rel(root, p)
+	if err != nil || rel == "." {
+		return filepath.Base(p)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// splitLines splits content into lines without their line endings.
+func splitLines(content []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), len(content)+1)
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSuffix(scanner.Text(), "\r"))
+	}
+	return lines
+}
+
+func truncateLine(line string) string {
+	if len(line) <= maxMatchLineLength {
+		return line
+	}
+	cut := maxMatchLineLength
+	for cut > 0 && !utf8.RuneStart(line[cut]) {
+		cut--
+	}
+	return line[:cut] + "..."
+}
+
+func truncateLines(lines []string) []string {
+	out := make([]string, len(lines))
+	for i, line := range lines {
+		out[i] = truncateLine(line)
+	}
+	return out
+}
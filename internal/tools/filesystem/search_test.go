@@ -0,0 +1,162 @@
+package filesystem
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTree creates files below root, with their parent directories.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+func TestGlob(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"main.go":             "package main",
+		"cmd/app/app.go":      "package app",
+		"cmd/app/app_test.go": "package app",
+		"README.md":           "# readme",
+		"build/out.go":        "package out",
+		".gitignore":          "build/\n*.log\n",
+		"debug.log":           "log",
+		".git/HEAD":           "ref: refs/heads/main",
+		".env":                "TOKEN=1",
+	})
+	tool := New(Config{BlockedPaths: []string{".env"}})
+
+	tests := []struct {
+		give        string
+		giveIgnored bool
+		wantFiles   []string
+	}{
+		{give: "*.go", wantFiles: []string{"main.go"}},
+		{give: "**/*.go", wantFiles: []string{"cmd/app/app.go", "cmd/app/app_test.go", "main.go"}},
+		{give: "cmd/**/*_test.go", wantFiles: []string{"cmd/app/app_test.go"}},
+		{give: "**/*.go", giveIgnored: true, wantFiles: []string{"build/out.go", "cmd/app/app.go", "cmd/app/app_test.go", "main.go"}},
+		{give: "*", wantFiles: []string{".gitignore", "README.md", "main.go"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			result, err := tool.Glob(context.Background(), root, tt.give, 0, tt.giveIgnored)
+			require.NoError(t, err)
+			var got []string
+			for _, f := range result.Files {
+				got = append(got, relSlash(root, f.Path))
+			}
+			assert.Equal(t, tt.wantFiles, got)
+		})
+	}
+
+	result, err := tool.Glob(context.Background(), root, "**/*.go", 1, false)
+	require.NoError(t, err)
+	assert.Len(t, result.Files, 1)
+	assert.True(t, result.Truncated)
+}
+
+func TestGitignore_NestedAndNegated(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		".gitignore":          "*.gen.go\n/tmp\n",
+		"a.gen.go":            "x",
+		"pkg/.gitignore":      "!keep.gen.go\n",
+		"pkg/keep.gen.go":     "x",
+		"pkg/other.gen.go":    "x",
+		"pkg/tmp/file.go":     "x",
+		"tmp/file.go":         "x",
+		"pkg/sub/deep.gen.go": "x",
+	})
+	tool := New(Config{})
+
+	result, err := tool.Glob(context.Background(), root, "**/*.go", 0, false)
+	require.NoError(t, err)
+	var got []string
+	for _, f := range result.Files {
+		got = append(got, relSlash(root, f.Path))
+	}
+	assert.Equal(t, []string{"pkg/keep.gen.go", "pkg/tmp/file.go"}, got)
+}
+
+func TestGrep(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"a.go":       "package a\n\nfunc Hello() {}\n\nfunc world() {}\n",
+		"b.txt":      "hello there\n",
+		"ignored.go": "func Hello() {}\n",
+		".gitignore": "ignored.go\n",
+		"big.go":     "func Hello() {} // padding padding padding padding\n",
+		"bin.dat":    "Hello\x00\x01",
+	})
+	tool := New(Config{MaxReadSize: 48})
+
+	result, err := tool.Grep(context.Background(), GrepOptions{Pattern: `func \w+\(`, Path: root, ContextLines: 1})
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 2)
+	assert.Equal(t, filepath.Join(root, "a.go"), result.Matches[0].Path)
+	assert.Equal(t, 3, result.Matches[0].Line)
+	assert.Equal(t, "func Hello() {}", result.Matches[0].Text)
+	assert.Equal(t, []string{""}, result.Matches[0].Before)
+	assert.Equal(t, []string{""}, result.Matches[0].After)
+	assert.Equal(t, 5, result.Matches[1].Line)
+	assert.Equal(t, 2, result.FilesSkipped) // big.go and bin.dat
+
+	result, err = tool.Grep(context.Background(), GrepOptions{Pattern: "hello", Path: root, IgnoreCase: true, Include: "*.txt"})
+	require.NoError(t, err)
+	require.Len(t, result.Matches, 1)
+	assert.Equal(t, "hello there", result.Matches[0].Text)
+
+	result, err = tool.Grep(context.Background(), GrepOptions{Pattern: "Hello", Path: root, IncludeIgnored: true, MaxMatches: 1})
+	require.NoError(t, err)
+	assert.Len(t, result.Matches, 1)
+	assert.True(t, result.Truncated)
+
+	_, err = tool.Grep(context.Background(), GrepOptions{Pattern: "(", Path: root})
+	assert.Error(t, err)
+}
+
+func TestStat(t *testing.T) {
+	root := t.TempDir()
+	writeTree(t, root, map[string]string{
+		"text.txt":  "one\ntwo\nthree",
+		"image.png": "\x89PNG\r\n\x1a\n\x00\x00",
+	})
+	require.NoError(t, os.Symlink("text.txt", filepath.Join(root, "link")))
+	tool := New(Config{})
+
+	stat, err := tool.Stat(filepath.Join(root, "text.txt"))
+	require.NoError(t, err)
+	assert.False(t, stat.Binary)
+	assert.Equal(t, 3, stat.Lines)
+	assert.Equal(t, "text/plain; charset=utf-8", stat.MimeType)
+
+	stat, err = tool.Stat(filepath.Join(root, "image.png"))
+	require.NoError(t, err)
+	assert.True(t, stat.Binary)
+	assert.Equal(t, "image/png", stat.MimeType)
+	assert.Zero(t, stat.Lines)
+
+	stat, err = tool.Stat(filepath.Join(root, "link"))
+	require.NoError(t, err)
+	assert.True(t, stat.IsSymlink)
+	assert.Equal(t, "text.txt", stat.LinkTarget)
+	assert.Equal(t, int64(13), stat.Size)
+
+	stat, err = tool.Stat(root)
+	require.NoError(t, err)
+	assert.True(t, stat.IsDir)
+	assert.Equal(t, 3, stat.Entries)
+
+	_, err = tool.Read(filepath.Join(root, "image.png"))
+	assert.ErrorContains(t, err, "binary file")
+}
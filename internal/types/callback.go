@@ -18,3 +18,11 @@ type Triple struct {
 
 // TripleCallback is an optional hook for saving graph triples.
 type TripleCallback func(triples []Triple)
+
+// RemoveCallback is an optional hook called when content is deleted, enabling
+// cleanup of derived data (vectors, graph nodes) without importing external packages.
+type RemoveCallback func(id, collection string)
+
+// ScrubFunc is an optional hook that removes secrets from text before it
+// is persisted, without importing the agent package.
+type ScrubFunc func(text string) string
@@ -7,12 +7,15 @@ const (
 	ChannelTelegram ChannelType = "telegram"
 	ChannelDiscord  ChannelType = "discord"
 	ChannelSlack    ChannelType = "slack"
+	ChannelMatrix   ChannelType = "matrix"
+	ChannelEmail    ChannelType = "email"
+	ChannelWebhook  ChannelType = "webhook"
 )
 
 // Valid reports whether c is a known channel type.
 func (c ChannelType) Valid() bool {
 	switch c {
-	case ChannelTelegram, ChannelDiscord, ChannelSlack:
+	case ChannelTelegram, ChannelDiscord, ChannelSlack, ChannelMatrix, ChannelEmail, ChannelWebhook:
 		return true
 	}
 	return false
@@ -20,5 +23,5 @@ func (c ChannelType) Valid() bool {
 
 // Values returns all known channel types.
 func (c ChannelType) Values() []ChannelType {
-	return []ChannelType{ChannelTelegram, ChannelDiscord, ChannelSlack}
+	return []ChannelType{ChannelTelegram, ChannelDiscord, ChannelSlack, ChannelMatrix, ChannelEmail, ChannelWebhook}
 }